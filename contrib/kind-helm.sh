@@ -586,6 +586,7 @@ helm upgrade --install ovn-kubernetes . -f "${value_file}" ${extra_values_args}
           --set-string global.v4TransitSubnet="${TRANSIT_SUBNET_IPV4}" \
           --set-string global.v6TransitSubnet="${TRANSIT_SUBNET_IPV6}" \
           --set global.enableAdminNetworkPolicy=true \
+          --set global.enableClusterNetworkPolicy=true \
           --set global.enableMultiExternalGateway=true \
           --set global.enableMulticast=$(if [ "${OVN_MULTICAST_ENABLE}" == "true" ]; then echo "true"; else echo "false"; fi) \
           --set global.enableMultiNetwork=$(if [ "${ENABLE_MULTI_NET}" == "true" ]; then echo "true"; else echo "false"; fi) \
@@ -643,9 +644,11 @@ EOF
 }
 
 install_online_ovn_kubernetes_crds() {
-  # NOTE: When you update vendoring versions for the ANP & BANP APIs, we must update the version of the CRD we pull from in the below URL
+  # NOTE: When you update vendoring versions for the ANP, BANP & CNP APIs, we must update the version of the CRD we pull from in the below URL
+  # The ANP & BANP CRDs are no longer shipped upstream since v0.2.0 which only provides the ClusterNetworkPolicy CRD
   run_kubectl apply -f https://raw.githubusercontent.com/kubernetes-sigs/network-policy-api/v0.1.5/config/crd/experimental/policy.networking.k8s.io_adminnetworkpolicies.yaml
   run_kubectl apply -f https://raw.githubusercontent.com/kubernetes-sigs/network-policy-api/v0.1.5/config/crd/experimental/policy.networking.k8s.io_baselineadminnetworkpolicies.yaml
+  run_kubectl apply -f https://raw.githubusercontent.com/kubernetes-sigs/network-policy-api/v0.2.0/config/crd/experimental/policy.networking.k8s.io_clusternetworkpolicies.yaml
 }
 
 check_dependencies
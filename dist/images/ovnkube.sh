@@ -66,6 +66,7 @@ fi
 # OVN_LFLOW_CACHE_LIMIT - maximum number of logical flow cache entries of ovn-controller
 # OVN_LFLOW_CACHE_LIMIT_KB - maximum size of the logical flow cache of ovn-controller
 # OVN_ADMIN_NETWORK_POLICY_ENABLE - enable admin network policy for ovn-kubernetes
+# OVN_CLUSTER_NETWORK_POLICY_ENABLE - enable cluster network policy for ovn-kubernetes (requires OVN_ADMIN_NETWORK_POLICY_ENABLE)
 # OVN_EGRESSIP_ENABLE - enable egress IP for ovn-kubernetes
 # OVN_EGRESSIP_HEALTHCHECK_PORT - egress IP node check to use grpc on this port (0 ==> dial to port 9 instead)
 # OVN_EGRESSFIREWALL_ENABLE - enable egressFirewall for ovn-kubernetes
@@ -222,6 +223,7 @@ ovn_lflow_cache_limit=${OVN_LFLOW_CACHE_LIMIT:-}
 ovn_lflow_cache_limit_kb=${OVN_LFLOW_CACHE_LIMIT_KB:-}
 ovn_multicast_enable=${OVN_MULTICAST_ENABLE:-}
 ovn_admin_network_policy_enable=${OVN_ADMIN_NETWORK_POLICY_ENABLE:=false}
+ovn_cluster_network_policy_enable=${OVN_CLUSTER_NETWORK_POLICY_ENABLE:=false}
 #OVN_EGRESSIP_ENABLE - enable egress IP for ovn-kubernetes
 ovn_egressip_enable=${OVN_EGRESSIP_ENABLE:-false}
 #OVN_EGRESSIP_HEALTHCHECK_PORT - egress IP node check to use grpc on this port
@@ -244,6 +246,8 @@ ovn_pre_conf_udn_addr_enable=${OVN_PRE_CONF_UDN_ADDR_ENABLE:=false}
 ovn_route_advertisements_enable=${OVN_ROUTE_ADVERTISEMENTS_ENABLE:=false}
 #OVN_EVPN_ENABLE - enable EVPN for ovn-kubernetes
 ovn_evpn_enable=${OVN_EVPN_ENABLE:=false}
+#OVN_UPLINK_ENABLE - enable Uplinks for user defined networks
+ovn_uplink_enable=${OVN_UPLINK_ENABLE:=false}
 #OVN_ADVERTISED_UDN_ISOLATION_MODE - pod network isolation between advertised UDN networks.
 ovn_advertised_udn_isolation_mode=${OVN_ADVERTISED_UDN_ISOLATION_MODE:=strict}
 #OVN_DYNAMIC_UDN_ALLOCATION - dynamic UDN allocation when a node requires it (pod or egress IP)
@@ -293,6 +297,8 @@ ovn_enable_svc_template_support=${OVN_ENABLE_SVC_TEMPLATE_SUPPORT:-true}
 
 #OVN_NETWORK_QOS_ENABLE - enable network QoS for ovn-kubernetes
 ovn_network_qos_enable=${OVN_NETWORK_QOS_ENABLE:-false}
+#OVN_MULTICAST_GROUP_ENABLE - enable MulticastGroup for ovn-kubernetes
+ovn_multicast_group_enable=${OVN_MULTICAST_GROUP_ENABLE:-false}
 # OVN_ENABLE_DNSNAMERESOLVER - enable dns name resolver support
 ovn_enable_dnsnameresolver=${OVN_ENABLE_DNSNAMERESOLVER:-false}
 # OVN_ALLOW_ICMP_NETPOL - allow ICMP/ICMPv6 with network policy
@@ -1051,6 +1057,12 @@ ovnkube-controller() {
   fi
   echo "anp_enabled_flag=${anp_enabled_flag}"
 
+  cnp_enabled_flag=
+  if [[ ${ovn_cluster_network_policy_enable} == "true" ]]; then
+      cnp_enabled_flag="--enable-cluster-network-policy"
+  fi
+  echo "cnp_enabled_flag=${cnp_enabled_flag}"
+
   egressip_enabled_flag=
   if [[ ${ovn_egressip_enable} == "true" ]]; then
       egressip_enabled_flag="--enable-egress-ip"
@@ -1123,6 +1135,12 @@ ovnkube-controller() {
   fi
   echo "evpn_enabled_flag=${evpn_enabled_flag}"
 
+  uplink_enabled_flag=
+  if [[ ${ovn_uplink_enable} == "true" ]]; then
+	  uplink_enabled_flag="--enable-uplink"
+  fi
+  echo "uplink_enabled_flag=${uplink_enabled_flag}"
+
   advertised_udn_isolation_flag=
   if [[ -n ${ovn_advertised_udn_isolation_mode} ]]; then
       advertised_udn_isolation_flag="--advertised-udn-isolation-mode=${ovn_advertised_udn_isolation_mode}"
@@ -1198,6 +1216,12 @@ ovnkube-controller() {
   fi
   echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
 
+  multicast_group_enabled_flag=
+  if [[ ${ovn_multicast_group_enable} == "true" ]]; then
+      multicast_group_enabled_flag="--enable-multicast-group"
+  fi
+  echo "multicast_group_enabled_flag=${multicast_group_enabled_flag}"
+
   ovn_enable_dnsnameresolver_flag=
   if [[ ${ovn_enable_dnsnameresolver} == "true" ]]; then
 	  ovn_enable_dnsnameresolver_flag="--enable-dns-name-resolver"
@@ -1226,6 +1250,7 @@ ovnkube-controller() {
   echo "=============== ovnkube-controller =========="
   /usr/bin/ovnkube --init-ovnkube-controller ${K8S_NODE} \
     ${anp_enabled_flag} \
+    ${cnp_enabled_flag} \
     ${disable_snat_multiple_gws_flag} \
     ${egressfirewall_enabled_flag} \
     ${egressip_enabled_flag} \
@@ -1242,6 +1267,7 @@ ovnkube-controller() {
     ${pre_conf_udn_addr_enable_flag} \
     ${route_advertisements_enabled_flag} \
     ${evpn_enabled_flag} \
+    ${uplink_enabled_flag} \
     ${advertised_udn_isolation_flag} \
     ${ovnkube_config_file_flag} \
     ${ovn_acl_logging_rate_limit_flag} \
@@ -1258,6 +1284,7 @@ ovnkube-controller() {
     ${ovn_v6_join_subnet_opt} \
     ${ovn_v6_masquerade_subnet_opt} \
     ${network_qos_enabled_flag} \
+    ${multicast_group_enabled_flag} \
     ${ovn_enable_dnsnameresolver_flag} \
     ${dynamic_udn_allocation_flag} \
     ${dynamic_udn_grace_period} \
@@ -1452,6 +1479,12 @@ ovnkube-controller-with-node() {
   fi
   echo "evpn_enabled_flag=${evpn_enabled_flag}"
 
+  uplink_enabled_flag=
+  if [[ ${ovn_uplink_enable} == "true" ]]; then
+	  uplink_enabled_flag="--enable-uplink"
+  fi
+  echo "uplink_enabled_flag=${uplink_enabled_flag}"
+
   advertised_udn_isolation_flag=
   if [[ -n ${ovn_advertised_udn_isolation_mode} ]]; then
       advertised_udn_isolation_flag="--advertised-udn-isolation-mode=${ovn_advertised_udn_isolation_mode}"
@@ -1624,6 +1657,12 @@ ovnkube-controller-with-node() {
   fi
   echo "anp_enabled_flag=${anp_enabled_flag}"
 
+  cnp_enabled_flag=
+  if [[ ${ovn_cluster_network_policy_enable} == "true" ]]; then
+      cnp_enabled_flag="--enable-cluster-network-policy"
+  fi
+  echo "cnp_enabled_flag=${cnp_enabled_flag}"
+
   ovn_v4_masquerade_subnet_opt=
   if [[ -n ${ovn_v4_masquerade_subnet} ]]; then
       ovn_v4_masquerade_subnet_opt="--gateway-v4-masquerade-subnet=${ovn_v4_masquerade_subnet}"
@@ -1669,6 +1708,12 @@ ovnkube-controller-with-node() {
   fi
   echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
 
+  multicast_group_enabled_flag=
+  if [[ ${ovn_multicast_group_enable} == "true" ]]; then
+      multicast_group_enabled_flag="--enable-multicast-group"
+  fi
+  echo "multicast_group_enabled_flag=${multicast_group_enabled_flag}"
+
   ovn_enable_dnsnameresolver_flag=
   if [[ ${ovn_enable_dnsnameresolver} == "true" ]]; then
 	  ovn_enable_dnsnameresolver_flag="--enable-dns-name-resolver"
@@ -1737,6 +1782,7 @@ ovnkube-controller-with-node() {
   echo "=============== ovnkube-controller-with-node --init-ovnkube-controller-with-node=========="
   /usr/bin/ovnkube --init-ovnkube-controller ${K8S_NODE} --init-node ${K8S_NODE} \
     ${anp_enabled_flag} \
+    ${cnp_enabled_flag} \
     ${disable_forwarding_flag} \
     ${disable_pkt_mtu_check_flag} \
     ${disable_snat_multiple_gws_flag} \
@@ -1762,6 +1808,7 @@ ovnkube-controller-with-node() {
     ${pre_conf_udn_addr_enable_flag} \
     ${route_advertisements_enabled_flag} \
     ${evpn_enabled_flag} \
+    ${uplink_enabled_flag} \
     ${advertised_udn_isolation_flag} \
     ${ovnkube_config_file_flag} \
     ${netflow_targets} \
@@ -1791,6 +1838,7 @@ ovnkube-controller-with-node() {
     ${dynamic_udn_allocation_flag} \
     ${dynamic_udn_grace_period} \
     ${network_qos_enabled_flag} \
+    ${multicast_group_enabled_flag} \
     ${ovn_enable_dnsnameresolver_flag} \
     ${ovn_disable_requestedchassis_flag} \
     ${cluster_access_opts} \
@@ -1860,6 +1908,12 @@ ovn-cluster-manager() {
   fi
   echo "anp_enabled_flag=${anp_enabled_flag}"
 
+  cnp_enabled_flag=
+  if [[ ${ovn_cluster_network_policy_enable} == "true" ]]; then
+      cnp_enabled_flag="--enable-cluster-network-policy"
+  fi
+  echo "cnp_enabled_flag=${cnp_enabled_flag}"
+
   egressfirewall_enabled_flag=
   if [[ ${ovn_egressfirewall_enable} == "true" ]]; then
 	  egressfirewall_enabled_flag="--enable-egress-firewall"
@@ -1959,6 +2013,12 @@ ovn-cluster-manager() {
   fi
   echo "evpn_enabled_flag=${evpn_enabled_flag}"
 
+  uplink_enabled_flag=
+  if [[ ${ovn_uplink_enable} == "true" ]]; then
+	  uplink_enabled_flag="--enable-uplink"
+  fi
+  echo "uplink_enabled_flag=${uplink_enabled_flag}"
+
   advertised_udn_isolation_flag=
   if [[ -n ${ovn_advertised_udn_isolation_mode} ]]; then
       advertised_udn_isolation_flag="--advertised-udn-isolation-mode=${ovn_advertised_udn_isolation_mode}"
@@ -2003,6 +2063,12 @@ ovn-cluster-manager() {
   fi
   echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
 
+  multicast_group_enabled_flag=
+  if [[ ${ovn_multicast_group_enable} == "true" ]]; then
+      multicast_group_enabled_flag="--enable-multicast-group"
+  fi
+  echo "multicast_group_enabled_flag=${multicast_group_enabled_flag}"
+
   ovn_enable_dnsnameresolver_flag=
   if [[ ${ovn_enable_dnsnameresolver} == "true" ]]; then
 	  ovn_enable_dnsnameresolver_flag="--enable-dns-name-resolver"
@@ -2047,6 +2113,7 @@ ovn-cluster-manager() {
   echo "=============== ovn-cluster-manager ========== control plane node only"
   /usr/bin/ovnkube --init-cluster-manager ${K8S_NODE} \
     ${anp_enabled_flag} \
+    ${cnp_enabled_flag} \
     ${egressfirewall_enabled_flag} \
     ${egressip_enabled_flag} \
     ${egressip_healthcheck_port_flag} \
@@ -2061,6 +2128,7 @@ ovn-cluster-manager() {
     ${pre_conf_udn_addr_enable_flag} \
     ${route_advertisements_enabled_flag} \
     ${evpn_enabled_flag} \
+    ${uplink_enabled_flag} \
     ${advertised_udn_isolation_flag} \
     ${ovnkube_config_file_flag} \
     ${persistent_ips_enabled_flag} \
@@ -2075,6 +2143,7 @@ ovn-cluster-manager() {
     ${ovn_v4_transit_subnet_opt} \
     ${ovn_v6_transit_subnet_opt} \
     ${network_qos_enabled_flag} \
+    ${multicast_group_enabled_flag} \
     ${dynamic_udn_allocation_flag} \
     ${dynamic_udn_grace_period} \
     ${ovn_enable_dnsnameresolver_flag} \
@@ -2193,6 +2262,11 @@ ovn-node() {
       anp_enabled_flag="--enable-admin-network-policy"
   fi
 
+  cnp_enabled_flag=
+  if [[ ${ovn_cluster_network_policy_enable} == "true" ]]; then
+      cnp_enabled_flag="--enable-cluster-network-policy"
+  fi
+
   egressip_enabled_flag=
   if [[ ${ovn_egressip_enable} == "true" ]]; then
       egressip_enabled_flag="--enable-egress-ip"
@@ -2239,6 +2313,11 @@ ovn-node() {
 	  evpn_enabled_flag="--enable-evpn"
   fi
 
+  uplink_enabled_flag=
+  if [[ ${ovn_uplink_enable} == "true" ]]; then
+	  uplink_enabled_flag="--enable-uplink"
+  fi
+
   advertised_udn_isolation_flag=
   if [[ -n ${ovn_advertised_udn_isolation_mode} ]]; then
       advertised_udn_isolation_flag="--advertised-udn-isolation-mode=${ovn_advertised_udn_isolation_mode}"
@@ -2405,6 +2484,12 @@ ovn-node() {
   fi
   echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
 
+  multicast_group_enabled_flag=
+  if [[ ${ovn_multicast_group_enable} == "true" ]]; then
+      multicast_group_enabled_flag="--enable-multicast-group"
+  fi
+  echo "multicast_group_enabled_flag=${multicast_group_enabled_flag}"
+
   ovn_v4_masquerade_subnet_opt=
   if [[ -n ${ovn_v4_masquerade_subnet} ]]; then
       ovn_v4_masquerade_subnet_opt="--gateway-v4-masquerade-subnet=${ovn_v4_masquerade_subnet}"
@@ -2445,6 +2530,7 @@ ovn-node() {
   echo "=============== ovn-node   --init-node"
   /usr/bin/ovnkube --init-node ${K8S_NODE} \
         ${anp_enabled_flag} \
+        ${cnp_enabled_flag} \
         ${disable_forwarding_flag} \
         ${disable_pkt_mtu_check_flag} \
         ${disable_snat_multiple_gws_flag} \
@@ -2466,6 +2552,7 @@ ovn-node() {
         ${pre_conf_udn_addr_enable_flag} \
         ${route_advertisements_enabled_flag} \
         ${evpn_enabled_flag} \
+        ${uplink_enabled_flag} \
         ${advertised_udn_isolation_flag} \
         ${ovnkube_config_file_flag} \
         ${netflow_targets} \
@@ -2489,6 +2576,7 @@ ovn-node() {
         ${dynamic_udn_allocation_flag} \
         ${dynamic_udn_grace_period} \
         ${network_qos_enabled_flag} \
+        ${multicast_group_enabled_flag} \
         --cluster-subnets ${net_cidr} --k8s-service-cidr=${svc_cidr} \
         --export-ovs-metrics \
         --gateway-mode=${ovn_gateway_mode} ${ovn_gateway_opts} \
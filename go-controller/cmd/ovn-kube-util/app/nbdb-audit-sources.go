@@ -0,0 +1,330 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/dbfile"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/k8sdump"
+)
+
+// listNBObjects returns the audited objects of a live NB database
+func listNBObjects(nbClient libovsdbclient.Client) ([]*nbdbObject, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+	defer cancel()
+
+	objects := []*nbdbObject{}
+	acls := []*nbdb.ACL{}
+	if err := nbClient.List(ctx, &acls); err != nil {
+		return nil, fmt.Errorf("failed to list ACLs: %w", err)
+	}
+	for _, acl := range acls {
+		name := ""
+		if acl.Name != nil {
+			name = *acl.Name
+		}
+		objects = append(objects, newNBDBObject(nbdb.ACLTable, acl.UUID, name, acl.ExternalIDs))
+	}
+	addrSets := []*nbdb.AddressSet{}
+	if err := nbClient.List(ctx, &addrSets); err != nil {
+		return nil, fmt.Errorf("failed to list address sets: %w", err)
+	}
+	for _, as := range addrSets {
+		objects = append(objects, newNBDBObject(nbdb.AddressSetTable, as.UUID, as.Name, as.ExternalIDs))
+	}
+	dhcpOptions := []*nbdb.DHCPOptions{}
+	if err := nbClient.List(ctx, &dhcpOptions); err != nil {
+		return nil, fmt.Errorf("failed to list DHCP options: %w", err)
+	}
+	for _, opts := range dhcpOptions {
+		objects = append(objects, newNBDBObject(nbdb.DHCPOptionsTable, opts.UUID, opts.Cidr, opts.ExternalIDs))
+	}
+	lbs := []*nbdb.LoadBalancer{}
+	if err := nbClient.List(ctx, &lbs); err != nil {
+		return nil, fmt.Errorf("failed to list load balancers: %w", err)
+	}
+	for _, lb := range lbs {
+		objects = append(objects, newNBDBObject(nbdb.LoadBalancerTable, lb.UUID, lb.Name, lb.ExternalIDs))
+	}
+	pgs := []*nbdb.PortGroup{}
+	if err := nbClient.List(ctx, &pgs); err != nil {
+		return nil, fmt.Errorf("failed to list port groups: %w", err)
+	}
+	for _, pg := range pgs {
+		objects = append(objects, newNBDBObject(nbdb.PortGroupTable, pg.UUID, pg.Name, pg.ExternalIDs))
+	}
+	qoses := []*nbdb.QoS{}
+	if err := nbClient.List(ctx, &qoses); err != nil {
+		return nil, fmt.Errorf("failed to list QoSes: %w", err)
+	}
+	for _, qos := range qoses {
+		objects = append(objects, newNBDBObject(nbdb.QoSTable, qos.UUID, "", qos.ExternalIDs))
+	}
+	return objects, nil
+}
+
+// readNBSnapshot returns the audited objects of a standalone NB database file
+func readNBSnapshot(r io.Reader) ([]*nbdbObject, error) {
+	schema := nbdb.Schema()
+	rows, err := dbfile.Read(r, &schema)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []*nbdbObject{}
+	for table, nameColumn := range nbdbAuditTables {
+		for uuid, row := range rows[table] {
+			externalIDs, err := parseOVSDBStringMap(row["external_ids"])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse external_ids of %s %s: %w", table, uuid, err)
+			}
+			name := ""
+			if value, ok := row[nameColumn]; nameColumn != "" && ok {
+				// the name is optional in some tables, i.e. a set
+				names, err := parseOVSDBStrings(value)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse %s of %s %s: %w", nameColumn, table, uuid, err)
+				}
+				if len(names) > 0 {
+					name = names[0]
+				}
+			}
+			objects = append(objects, newNBDBObject(table, uuid, name, externalIDs))
+		}
+	}
+	return objects, nil
+}
+
+// parseOVSDBStringMap parses a map of strings in OVSDB JSON notation
+func parseOVSDBStringMap(value json.RawMessage) (map[string]string, error) {
+	pairs, err := dbfile.ParseMap(value)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		var k, v string
+		if err := json.Unmarshal(pair[0], &k); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(pair[1], &v); err != nil {
+			return nil, err
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+// parseOVSDBStrings parses a string or a set of strings in OVSDB JSON notation
+func parseOVSDBStrings(value json.RawMessage) ([]string, error) {
+	elements, err := dbfile.ParseSet(value)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, 0, len(elements))
+	for _, element := range elements {
+		var v string
+		if err := json.Unmarshal(element, &v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// ownerGVRs are the resources listed to look up the owners of the NB objects
+var ownerGVRs = map[string]schema.GroupVersionResource{
+	"Namespace":                  {Version: "v1", Resource: "namespaces"},
+	"Node":                       {Version: "v1", Resource: "nodes"},
+	"Service":                    {Version: "v1", Resource: "services"},
+	"NetworkPolicy":              {Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"},
+	"EgressFirewall":             {Group: "k8s.ovn.org", Version: "v1", Resource: "egressfirewalls"},
+	"EgressQoS":                  {Group: "k8s.ovn.org", Version: "v1", Resource: "egressqoses"},
+	"MulticastGroup":             {Group: "k8s.ovn.org", Version: "v1alpha1", Resource: "multicastgroups"},
+	"AdminNetworkPolicy":         {Group: "policy.networking.k8s.io", Version: "v1alpha1", Resource: "adminnetworkpolicies"},
+	"BaselineAdminNetworkPolicy": {Group: "policy.networking.k8s.io", Version: "v1alpha1", Resource: "baselineadminnetworkpolicies"},
+	"VirtualMachine":             {Group: "kubevirt.io", Version: "v1", Resource: "virtualmachines"},
+}
+
+// k8sOwners holds the keys of the existing Kubernetes objects, per kind.
+// Kinds that are not present couldn't be looked up.
+type k8sOwners struct {
+	kinds map[string]sets.Set[string]
+}
+
+func newK8sOwners() *k8sOwners {
+	return &k8sOwners{kinds: map[string]sets.Set[string]{}}
+}
+
+func (o *k8sOwners) add(kind, namespace, name string) {
+	if o.kinds[kind] == nil {
+		o.kinds[kind] = sets.New[string]()
+	}
+	key := name
+	if namespace != "" {
+		key = namespace + "/" + name
+	}
+	o.kinds[kind].Insert(key)
+}
+
+// has returns whether the object of the given kind and key exists, and whether
+// objects of that kind could be looked up at all
+func (o *k8sOwners) has(kind, key string) (found, checked bool) {
+	keys, checked := o.kinds[kind]
+	return checked && keys.Has(key), checked
+}
+
+// listK8sOwners lists the objects of the given kinds from the Kubernetes API
+func listK8sOwners(ctx context.Context, kubeconfig string, kinds sets.Set[string]) (*k8sOwners, error) {
+	var restConfig *rest.Config
+	var err error
+	if kubeconfig != "" {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+	} else {
+		restConfig, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			clientcmd.NewDefaultClientConfigLoadingRules(),
+			&clientcmd.ConfigOverrides{},
+		).ClientConfig()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the kubernetes client config: %w", err)
+	}
+	client, err := metadata.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := newK8sOwners()
+	for _, kind := range sets.List(kinds) {
+		gvr, ok := ownerGVRs[kind]
+		if !ok {
+			continue
+		}
+		list, err := client.Resource(gvr).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// the CRD is not installed, don't guess
+				klog.Warningf("Failed to list %s, the objects owned by %s won't be checked: %v", gvr.Resource, kind, err)
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s: %w", gvr.Resource, err)
+		}
+		owners.kinds[kind] = sets.New[string]()
+		for _, item := range list.Items {
+			owners.add(kind, item.Namespace, item.Name)
+		}
+	}
+	return owners, nil
+}
+
+// readK8sDump reads the Kubernetes objects of a JSON or YAML dump. Only the kinds
+// with at least one object in the dump are checked.
+func readK8sDump(path string) (*k8sOwners, error) {
+	objects, err := k8sdump.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	owners := newK8sOwners()
+	for _, obj := range objects {
+		owners.add(obj.GetKind(), obj.GetNamespace(), obj.GetName())
+	}
+	return owners, nil
+}
+
+// deleteNBObjects deletes the given objects from the NB database
+func deleteNBObjects(nbClient libovsdbclient.Client, objects []*nbdbObject) error {
+	acls := []*nbdb.ACL{}
+	addrSets := []*nbdb.AddressSet{}
+	lbs := []*nbdb.LoadBalancer{}
+	pgNames := []string{}
+	qosUUIDs := sets.New[string]()
+	for _, obj := range objects {
+		switch obj.Table {
+		case nbdb.ACLTable:
+			acls = append(acls, &nbdb.ACL{UUID: obj.UUID})
+		case nbdb.AddressSetTable:
+			addrSets = append(addrSets, &nbdb.AddressSet{UUID: obj.UUID})
+		case nbdb.DHCPOptionsTable:
+			if err := libovsdbops.DeleteDHCPOptions(nbClient, &nbdb.DHCPOptions{UUID: obj.UUID}); err != nil {
+				return err
+			}
+		case nbdb.LoadBalancerTable:
+			lbs = append(lbs, &nbdb.LoadBalancer{UUID: obj.UUID})
+		case nbdb.PortGroupTable:
+			pgNames = append(pgNames, obj.Name)
+		case nbdb.QoSTable:
+			qosUUIDs.Insert(obj.UUID)
+		}
+	}
+
+	// ACLs and QoSes are not root objects, they are garbage collected once they are
+	// not referenced anymore
+	if len(acls) > 0 {
+		if err := libovsdbops.DeleteACLsFromAllPortGroups(nbClient, acls...); err != nil {
+			return err
+		}
+		if err := libovsdbops.RemoveACLsFromLogicalSwitchesWithPredicate(nbClient,
+			func(*nbdb.LogicalSwitch) bool { return true }, acls...); err != nil {
+			return err
+		}
+	}
+	if qosUUIDs.Len() > 0 {
+		switches, err := libovsdbops.FindLogicalSwitchesWithPredicate(nbClient, func(ls *nbdb.LogicalSwitch) bool {
+			return qosUUIDs.HasAny(ls.QOSRules...)
+		})
+		if err != nil {
+			return err
+		}
+		qoses := []*nbdb.QoS{}
+		for _, uuid := range sets.List(qosUUIDs) {
+			qoses = append(qoses, &nbdb.QoS{UUID: uuid})
+		}
+		var ops []ovsdb.Operation
+		for _, ls := range switches {
+			if ops, err = libovsdbops.RemoveQoSesFromLogicalSwitchOps(nbClient, ops, ls.Name, qoses...); err != nil {
+				return err
+			}
+		}
+		if len(ops) > 0 {
+			if _, err := libovsdbops.TransactAndCheck(nbClient, ops); err != nil {
+				return err
+			}
+		}
+	}
+	if len(addrSets) > 0 {
+		if err := libovsdbops.DeleteAddressSets(nbClient, addrSets...); err != nil {
+			return err
+		}
+	}
+	if len(lbs) > 0 {
+		if err := libovsdbops.DeleteLoadBalancers(nbClient, lbs); err != nil {
+			return err
+		}
+	}
+	if len(pgNames) > 0 {
+		if err := libovsdbops.DeletePortGroups(nbClient, pgNames...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,375 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/urfave/cli/v2"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+)
+
+// NBDBAuditCommand reports NB objects whose owners no longer exist in Kubernetes,
+// objects without owner ids and objects sharing the same primary id.
+var NBDBAuditCommand = cli.Command{
+	Name:  "nbdb-audit",
+	Usage: "Audit the ownership of the OVN NB database objects and find leaked objects",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "nb-address",
+			Usage: "The address of the OVN NB database",
+			Value: "unix:/var/run/ovn/ovnnb_db.sock",
+		},
+		&cli.StringFlag{
+			Name: "db-file",
+			Usage: "Read the NB database from a standalone database file (e.g. the output of " +
+				"\"ovsdb-client backup\") instead of connecting to --nb-address",
+		},
+		&cli.StringFlag{
+			Name:  "kubeconfig",
+			Usage: "The kubeconfig used to look up the owners of the NB objects, defaults to $KUBECONFIG or the in-cluster config",
+		},
+		&cli.StringFlag{
+			Name: "k8s-dump",
+			Usage: "Look up the owners of the NB objects in a JSON or YAML dump of Kubernetes objects " +
+				"(e.g. the output of \"kubectl get -A -o json\") instead of the Kubernetes API",
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "The output format, one of: table, json",
+			Value: "table",
+		},
+		&cli.BoolFlag{
+			Name:  "delete",
+			Usage: "Delete the orphaned objects from the NB database, requires a connection to the NB database",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		output := ctx.String("output")
+		if output != "table" && output != "json" {
+			return fmt.Errorf("unsupported output format %q", output)
+		}
+		dbFile := ctx.String("db-file")
+		if ctx.Bool("delete") && dbFile != "" {
+			return fmt.Errorf("--delete can't be used with --db-file")
+		}
+
+		var objects []*nbdbObject
+		var nbClient libovsdbclient.Client
+		if dbFile != "" {
+			f, err := os.Open(dbFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if objects, err = readNBSnapshot(f); err != nil {
+				return fmt.Errorf("failed to read NB database file %s: %w", dbFile, err)
+			}
+		} else {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			c, err := libovsdb.NewNBClientWithEndpoint(ctx.String("nb-address"), prometheus.NewRegistry(), stopCh)
+			if err != nil {
+				return fmt.Errorf("failed to connect to the NB database %s: %w", ctx.String("nb-address"), err)
+			}
+			nbClient = c
+			if objects, err = listNBObjects(c); err != nil {
+				return err
+			}
+		}
+
+		var owners *k8sOwners
+		var err error
+		if dump := ctx.String("k8s-dump"); dump != "" {
+			owners, err = readK8sDump(dump)
+		} else {
+			owners, err = listK8sOwners(ctx.Context, ctx.String("kubeconfig"), ownerKinds(objects))
+		}
+		if err != nil {
+			return err
+		}
+
+		report := auditNBObjects(objects, owners)
+		if output == "json" {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(report); err != nil {
+				return err
+			}
+		} else {
+			report.printTable(os.Stdout)
+		}
+
+		if !ctx.Bool("delete") || len(report.Orphans) == 0 {
+			return nil
+		}
+		if err := deleteNBObjects(nbClient, report.Orphans); err != nil {
+			return fmt.Errorf("failed to delete orphaned objects: %w", err)
+		}
+		klog.Infof("Deleted %d orphaned objects from the NB database", len(report.Orphans))
+		return nil
+	},
+}
+
+// nbdbAuditTables are the NB tables audited by nbdb-audit, mapped to the column
+// that is reported as the object name
+var nbdbAuditTables = map[string]string{
+	nbdb.ACLTable:          "name",
+	nbdb.AddressSetTable:   "name",
+	nbdb.DHCPOptionsTable:  "cidr",
+	nbdb.LoadBalancerTable: "name",
+	nbdb.PortGroupTable:    "name",
+	nbdb.QoSTable:          "",
+}
+
+// nbdbObject is an audited NB object
+type nbdbObject struct {
+	Table           string `json:"table"`
+	UUID            string `json:"uuid"`
+	Name            string `json:"name,omitempty"`
+	OwnerType       string `json:"ownerType,omitempty"`
+	OwnerController string `json:"ownerController,omitempty"`
+	PrimaryID       string `json:"primaryID,omitempty"`
+	// Owner is the Kubernetes object owning the NB object, as "<kind> <namespace>/<name>"
+	Owner string `json:"owner,omitempty"`
+
+	ownerKind string
+	ownerKey  string
+}
+
+func newNBDBObject(table, uuid, name string, externalIDs map[string]string) *nbdbObject {
+	obj := &nbdbObject{
+		Table:           table,
+		UUID:            uuid,
+		Name:            name,
+		OwnerType:       externalIDs[libovsdbops.OwnerTypeKey.String()],
+		OwnerController: externalIDs[libovsdbops.OwnerControllerKey.String()],
+		PrimaryID:       externalIDs[libovsdbops.PrimaryIDKey.String()],
+	}
+	objectName := externalIDs[libovsdbops.ObjectNameKey.String()]
+	if table == nbdb.LoadBalancerTable && obj.OwnerType == "" {
+		// load balancers don't use DbObjectIDs, the owner is set by the services controller
+		obj.OwnerType = externalIDs[types.LoadBalancerKindExternalID]
+		objectName = externalIDs[types.LoadBalancerOwnerExternalID]
+	}
+	obj.ownerKind, obj.ownerKey = ownerOf(table, obj.OwnerType, objectName)
+	if obj.ownerKind != "" {
+		obj.Owner = obj.ownerKind + " " + obj.ownerKey
+	}
+	return obj
+}
+
+// ownerOf returns the kind and the namespace/name key of the Kubernetes object owning an
+// NB object, based on its owner type and object name. An empty kind is returned for the
+// owner types that are not backed by a Kubernetes object, or whose owner can't be
+// derived from the object name.
+func ownerOf(table, ownerType, objectName string) (kind, key string) {
+	if objectName == "" {
+		return "", ""
+	}
+	switch ownerType {
+	case libovsdbops.NetworkPolicyOwnerType:
+		namespace, name, err := libovsdbops.ParseNamespaceNameKey(objectName)
+		if err != nil {
+			return "", ""
+		}
+		return "NetworkPolicy", namespace + "/" + name
+	case libovsdbops.NamespaceOwnerType, libovsdbops.NetpolNamespaceOwnerType, libovsdbops.MulticastNamespaceOwnerType:
+		return "Namespace", objectName
+	case libovsdbops.EgressFirewallOwnerType:
+		// there can only be 1 egress firewall in every namespace, named "default"
+		return "EgressFirewall", objectName + "/default"
+	case libovsdbops.EgressQoSOwnerType:
+		// there can only be 1 egress qos in every namespace, named "default"
+		return "EgressQoS", objectName + "/default"
+	case libovsdbops.NetpolNodeOwnerType, libovsdbops.HybridNodeRouteOwnerType:
+		return "Node", objectName
+	case libovsdbops.AdminNetworkPolicyOwnerType:
+		return "AdminNetworkPolicy", objectName
+	case libovsdbops.BaselineAdminNetworkPolicyOwnerType:
+		return "BaselineAdminNetworkPolicy", objectName
+	case libovsdbops.VirtualMachineOwnerType:
+		return "VirtualMachine", objectName
+	case libovsdbops.MulticastGroupOwnerType:
+		return "MulticastGroup", objectName
+	case "Service":
+		if table == nbdb.LoadBalancerTable {
+			return "Service", objectName
+		}
+	}
+	return "", ""
+}
+
+// ownerKinds returns the kinds of the Kubernetes objects owning the given NB objects
+func ownerKinds(objects []*nbdbObject) sets.Set[string] {
+	kinds := sets.New[string]()
+	for _, obj := range objects {
+		if obj.ownerKind != "" {
+			kinds.Insert(obj.ownerKind)
+		}
+	}
+	return kinds
+}
+
+// nbdbOwnerSummary is the number of NB objects of a table with the same owner type and controller
+type nbdbOwnerSummary struct {
+	Table           string `json:"table"`
+	OwnerType       string `json:"ownerType"`
+	OwnerController string `json:"ownerController"`
+	Count           int    `json:"count"`
+}
+
+// nbdbDuplicatePrimaryID is a primary id shared by several NB objects of a table
+type nbdbDuplicatePrimaryID struct {
+	Table     string   `json:"table"`
+	PrimaryID string   `json:"primaryID"`
+	UUIDs     []string `json:"uuids"`
+}
+
+// nbdbAuditReport is the result of nbdb-audit
+type nbdbAuditReport struct {
+	Owners []nbdbOwnerSummary `json:"owners"`
+	// Orphans are the objects whose Kubernetes owner doesn't exist anymore
+	Orphans []*nbdbObject `json:"orphans"`
+	// NoOwnerIDs are the objects without owner type
+	NoOwnerIDs          []*nbdbObject            `json:"noOwnerIDs"`
+	DuplicatePrimaryIDs []nbdbDuplicatePrimaryID `json:"duplicatePrimaryIDs"`
+	// UncheckedOwnerKinds are the owner kinds that couldn't be looked up, objects owned
+	// by these kinds are never reported as orphans
+	UncheckedOwnerKinds []string `json:"uncheckedOwnerKinds,omitempty"`
+}
+
+// auditNBObjects cross-references the given NB objects with the Kubernetes owners
+func auditNBObjects(objects []*nbdbObject, owners *k8sOwners) *nbdbAuditReport {
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].Table != objects[j].Table {
+			return objects[i].Table < objects[j].Table
+		}
+		return objects[i].UUID < objects[j].UUID
+	})
+
+	report := &nbdbAuditReport{
+		Owners:              []nbdbOwnerSummary{},
+		Orphans:             []*nbdbObject{},
+		NoOwnerIDs:          []*nbdbObject{},
+		DuplicatePrimaryIDs: []nbdbDuplicatePrimaryID{},
+	}
+	summaries := map[nbdbOwnerSummary]int{}
+	primaryIDs := map[[2]string][]string{}
+	unchecked := sets.New[string]()
+	for _, obj := range objects {
+		summaries[nbdbOwnerSummary{Table: obj.Table, OwnerType: obj.OwnerType, OwnerController: obj.OwnerController}]++
+		if obj.OwnerType == "" {
+			report.NoOwnerIDs = append(report.NoOwnerIDs, obj)
+		}
+		if obj.PrimaryID != "" {
+			key := [2]string{obj.Table, obj.PrimaryID}
+			primaryIDs[key] = append(primaryIDs[key], obj.UUID)
+		}
+		if obj.ownerKind == "" {
+			continue
+		}
+		found, checked := owners.has(obj.ownerKind, obj.ownerKey)
+		if !checked {
+			unchecked.Insert(obj.ownerKind)
+			continue
+		}
+		if !found {
+			report.Orphans = append(report.Orphans, obj)
+		}
+	}
+
+	for summary, count := range summaries {
+		summary.Count = count
+		report.Owners = append(report.Owners, summary)
+	}
+	sort.Slice(report.Owners, func(i, j int) bool {
+		a, b := report.Owners[i], report.Owners[j]
+		if a.Table != b.Table {
+			return a.Table < b.Table
+		}
+		if a.OwnerType != b.OwnerType {
+			return a.OwnerType < b.OwnerType
+		}
+		return a.OwnerController < b.OwnerController
+	})
+	for key, uuids := range primaryIDs {
+		if len(uuids) > 1 {
+			report.DuplicatePrimaryIDs = append(report.DuplicatePrimaryIDs,
+				nbdbDuplicatePrimaryID{Table: key[0], PrimaryID: key[1], UUIDs: uuids})
+		}
+	}
+	sort.Slice(report.DuplicatePrimaryIDs, func(i, j int) bool {
+		a, b := report.DuplicatePrimaryIDs[i], report.DuplicatePrimaryIDs[j]
+		if a.Table != b.Table {
+			return a.Table < b.Table
+		}
+		return a.PrimaryID < b.PrimaryID
+	})
+	report.UncheckedOwnerKinds = sets.List(unchecked)
+	return report
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// printTable prints the report as human-readable tables
+func (report *nbdbAuditReport) printTable(out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "TABLE\tOWNER TYPE\tCONTROLLER\tCOUNT")
+	for _, summary := range report.Owners {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", summary.Table, orDash(summary.OwnerType), orDash(summary.OwnerController), summary.Count)
+	}
+
+	fmt.Fprintf(w, "\nORPHANED OBJECTS (%d)\n", len(report.Orphans))
+	if len(report.Orphans) > 0 {
+		fmt.Fprintln(w, "TABLE\tUUID\tNAME\tCONTROLLER\tMISSING OWNER")
+		for _, obj := range report.Orphans {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", obj.Table, obj.UUID, orDash(obj.Name), orDash(obj.OwnerController), obj.Owner)
+		}
+	}
+
+	fmt.Fprintf(w, "\nOBJECTS WITHOUT OWNER IDS (%d)\n", len(report.NoOwnerIDs))
+	if len(report.NoOwnerIDs) > 0 {
+		fmt.Fprintln(w, "TABLE\tUUID\tNAME")
+		for _, obj := range report.NoOwnerIDs {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", obj.Table, obj.UUID, orDash(obj.Name))
+		}
+	}
+
+	fmt.Fprintf(w, "\nDUPLICATE PRIMARY IDS (%d)\n", len(report.DuplicatePrimaryIDs))
+	if len(report.DuplicatePrimaryIDs) > 0 {
+		fmt.Fprintln(w, "TABLE\tPRIMARY ID\tUUIDS")
+		for _, dup := range report.DuplicatePrimaryIDs {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", dup.Table, dup.PrimaryID, strings.Join(dup.UUIDs, ","))
+		}
+	}
+
+	if len(report.UncheckedOwnerKinds) > 0 {
+		fmt.Fprintf(w, "\nWARNING: the following owner kinds could not be checked: %s\n",
+			strings.Join(report.UncheckedOwnerKinds, ", "))
+	}
+}
@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+)
+
+func netpolACLExternalIDs(namespace, name string) map[string]string {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLNetworkPolicy, types.DefaultNetworkControllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey:         libovsdbops.BuildNamespaceNameKey(namespace, name),
+			libovsdbops.PolicyDirectionKey:    "Ingress",
+			libovsdbops.GressIdxKey:           "0",
+			libovsdbops.PortPolicyProtocolKey: "None",
+			libovsdbops.IpBlockIndexKey:       "-1",
+		}).GetExternalIDs()
+}
+
+func namespaceASExternalIDs(namespace string) map[string]string {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetNamespace, types.DefaultNetworkControllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: namespace,
+			libovsdbops.IPFamilyKey:   "v4",
+		}).GetExternalIDs()
+}
+
+func serviceLBExternalIDs(namespace, name string) map[string]string {
+	return map[string]string{
+		types.LoadBalancerKindExternalID:  "Service",
+		types.LoadBalancerOwnerExternalID: namespace + "/" + name,
+	}
+}
+
+func TestAuditNBObjects(t *testing.T) {
+	objects := []*nbdbObject{
+		newNBDBObject(nbdb.ACLTable, "acl1", "", netpolACLExternalIDs("ns1", "live")),
+		newNBDBObject(nbdb.ACLTable, "acl2", "", netpolACLExternalIDs("ns1", "deleted")),
+		// same primary id as acl2
+		newNBDBObject(nbdb.ACLTable, "acl3", "", netpolACLExternalIDs("ns1", "deleted")),
+		newNBDBObject(nbdb.ACLTable, "acl4", "legacy", map[string]string{"foo": "bar"}),
+		newNBDBObject(nbdb.AddressSetTable, "as1", "a123", namespaceASExternalIDs("ns1")),
+		newNBDBObject(nbdb.AddressSetTable, "as2", "a456", namespaceASExternalIDs("ns2")),
+		newNBDBObject(nbdb.LoadBalancerTable, "lb1", "Service_ns1/svc_TCP_cluster", serviceLBExternalIDs("ns1", "svc")),
+		newNBDBObject(nbdb.LoadBalancerTable, "lb2", "Service_ns1/old_TCP_cluster", serviceLBExternalIDs("ns1", "old")),
+		newNBDBObject(nbdb.PortGroupTable, "pg1", "a789", libovsdbops.NewDbObjectIDs(libovsdbops.PortGroupAdminNetworkPolicy,
+			types.DefaultNetworkControllerName, map[libovsdbops.ExternalIDKey]string{
+				libovsdbops.ObjectNameKey: "anp",
+			}).GetExternalIDs()),
+	}
+	owners := newK8sOwners()
+	owners.add("Namespace", "", "ns1")
+	owners.add("NetworkPolicy", "ns1", "live")
+	owners.add("Service", "ns1", "svc")
+
+	report := auditNBObjects(objects, owners)
+
+	orphans := []string{}
+	for _, obj := range report.Orphans {
+		orphans = append(orphans, obj.UUID+" "+obj.Owner)
+	}
+	expectedOrphans := []string{
+		"acl2 NetworkPolicy ns1/deleted",
+		"acl3 NetworkPolicy ns1/deleted",
+		"as2 Namespace ns2",
+		"lb2 Service ns1/old",
+	}
+	if strings.Join(orphans, ",") != strings.Join(expectedOrphans, ",") {
+		t.Errorf("expected orphans %v, got %v", expectedOrphans, orphans)
+	}
+	if len(report.NoOwnerIDs) != 1 || report.NoOwnerIDs[0].UUID != "acl4" {
+		t.Errorf("expected acl4 to be reported without owner ids, got %+v", report.NoOwnerIDs)
+	}
+	if len(report.DuplicatePrimaryIDs) != 1 || strings.Join(report.DuplicatePrimaryIDs[0].UUIDs, ",") != "acl2,acl3" {
+		t.Errorf("expected acl2 and acl3 to be reported as duplicates, got %+v", report.DuplicatePrimaryIDs)
+	}
+	if strings.Join(report.UncheckedOwnerKinds, ",") != "AdminNetworkPolicy" {
+		t.Errorf("expected AdminNetworkPolicy to be unchecked, got %v", report.UncheckedOwnerKinds)
+	}
+	expectedOwners := []nbdbOwnerSummary{
+		{Table: nbdb.ACLTable, Count: 1},
+		{Table: nbdb.ACLTable, OwnerType: libovsdbops.NetworkPolicyOwnerType, OwnerController: types.DefaultNetworkControllerName, Count: 3},
+		{Table: nbdb.AddressSetTable, OwnerType: libovsdbops.NamespaceOwnerType, OwnerController: types.DefaultNetworkControllerName, Count: 2},
+		{Table: nbdb.LoadBalancerTable, OwnerType: "Service", Count: 2},
+		{Table: nbdb.PortGroupTable, OwnerType: libovsdbops.AdminNetworkPolicyOwnerType, OwnerController: types.DefaultNetworkControllerName, Count: 1},
+	}
+	if fmt.Sprint(report.Owners) != fmt.Sprint(expectedOwners) {
+		t.Errorf("expected owners %v, got %v", expectedOwners, report.Owners)
+	}
+}
+
+func snapshotRecord(data string) string {
+	return fmt.Sprintf("OVSDB JSON %d 0000000000000000000000000000000000000000\n%s\n", len(data), data)
+}
+
+func TestReadNBSnapshot(t *testing.T) {
+	snapshot := snapshotRecord(`{"name":"OVN_Northbound","version":"7.3.0","tables":{}}`) +
+		snapshotRecord(`{"ACL":{"acl1":{"name":"first","external_ids":["map",[["k8s.ovn.org/owner-type","NetworkPolicy"],["k8s.ovn.org/name","ns1:np"]]]},`+
+			`"acl2":{"external_ids":["map",[["foo","bar"]]]}},`+
+			`"Address_Set":{"as1":{"name":"a123","external_ids":["map",[["k8s.ovn.org/owner-type","Namespace"],["k8s.ovn.org/name","ns1"]]]}},`+
+			`"Logical_Switch":{"ls1":{"name":"node1"}},"_date":1700000000000}`) +
+		// acl1: rename and update its owner, acl2: delete
+		snapshotRecord(`{"ACL":{"acl1":{"name":["set",["first","second"]],"external_ids":["map",[["k8s.ovn.org/name","ns1:other"]]]},"acl2":null},"_is_diff":true}`) +
+		// as1: remove its owner name
+		snapshotRecord(`{"Address_Set":{"as1":{"external_ids":["map",[["k8s.ovn.org/name","ns1"]]]}},"_is_diff":true}`)
+
+	objects, err := readNBSnapshot(strings.NewReader(snapshot))
+	if err != nil {
+		t.Fatalf("failed to read snapshot: %v", err)
+	}
+	report := auditNBObjects(objects, newK8sOwners())
+	got := []string{}
+	for _, obj := range objects {
+		got = append(got, fmt.Sprintf("%s/%s/%s/%s/%s", obj.Table, obj.UUID, obj.Name, obj.OwnerType, obj.Owner))
+	}
+	expected := []string{
+		"ACL/acl1/second/NetworkPolicy/NetworkPolicy ns1/other",
+		"Address_Set/as1/a123/Namespace/",
+	}
+	if strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected objects %v, got %v", expected, got)
+	}
+	if len(report.NoOwnerIDs) != 0 {
+		t.Errorf("expected all objects to have owner ids, got %+v", report.NoOwnerIDs)
+	}
+
+	_, err = readNBSnapshot(strings.NewReader(snapshotRecord(`{"name":"OVN_Southbound","tables":{}}`)))
+	if err == nil {
+		t.Errorf("expected an error reading a southbound database")
+	}
+	_, err = readNBSnapshot(strings.NewReader("OVSDB CLUSTER 2 0000\n{}\n"))
+	if err == nil {
+		t.Errorf("expected an error reading a clustered database")
+	}
+}
+
+func TestReadK8sDump(t *testing.T) {
+	dump := filepath.Join(t.TempDir(), "dump.yaml")
+	err := os.WriteFile(dump, []byte(`apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Namespace
+  metadata:
+    name: ns1
+- apiVersion: networking.k8s.io/v1
+  kind: NetworkPolicy
+  metadata:
+    namespace: ns1
+    name: np
+---
+apiVersion: v1
+kind: Node
+metadata:
+  name: node1
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owners, err := readK8sDump(dump)
+	if err != nil {
+		t.Fatalf("failed to read dump: %v", err)
+	}
+	for _, tc := range []struct {
+		kind, key        string
+		found, isChecked bool
+	}{
+		{"Namespace", "ns1", true, true},
+		{"Namespace", "ns2", false, true},
+		{"NetworkPolicy", "ns1/np", true, true},
+		{"Node", "node1", true, true},
+		{"Service", "ns1/svc", false, false},
+	} {
+		found, checked := owners.has(tc.kind, tc.key)
+		if found != tc.found || checked != tc.isChecked {
+			t.Errorf("%s %s: expected found=%v checked=%v, got found=%v checked=%v",
+				tc.kind, tc.key, tc.found, tc.isChecked, found, checked)
+		}
+	}
+}
+
+func TestDeleteNBObjects(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	liveACL := &nbdb.ACL{UUID: "acl-live-UUID", Action: nbdb.ACLActionAllow, Direction: nbdb.ACLDirectionToLport,
+		Match: "1", Priority: 1, ExternalIDs: netpolACLExternalIDs("ns1", "live")}
+	orphanACL := &nbdb.ACL{UUID: "acl-orphan-UUID", Action: nbdb.ACLActionAllow, Direction: nbdb.ACLDirectionToLport,
+		Match: "2", Priority: 1, ExternalIDs: netpolACLExternalIDs("ns1", "deleted")}
+	orphanAS := &nbdb.AddressSet{UUID: "as-orphan-UUID", Name: "a456", ExternalIDs: namespaceASExternalIDs("ns2")}
+	orphanLB := &nbdb.LoadBalancer{UUID: "lb-orphan-UUID", Name: "Service_ns1/old_TCP_cluster",
+		ExternalIDs: serviceLBExternalIDs("ns1", "old")}
+	pg := &nbdb.PortGroup{UUID: "pg-UUID", Name: "pg", ACLs: []string{liveACL.UUID, orphanACL.UUID}}
+	ls := &nbdb.LogicalSwitch{UUID: "ls-UUID", Name: "node1", ACLs: []string{orphanACL.UUID},
+		LoadBalancer: []string{orphanLB.UUID}}
+
+	nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{
+		NBData: []libovsdbtest.TestData{liveACL, orphanACL, orphanAS, orphanLB, pg, ls},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to set up test harness: %v", err)
+	}
+	t.Cleanup(cleanup.Cleanup)
+
+	objects, err := listNBObjects(nbClient)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	owners := newK8sOwners()
+	owners.add("Namespace", "", "ns1")
+	owners.add("NetworkPolicy", "ns1", "live")
+	owners.add("Service", "ns1", "svc")
+	report := auditNBObjects(objects, owners)
+	g.Expect(report.Orphans).To(gomega.HaveLen(3))
+
+	g.Expect(deleteNBObjects(nbClient, report.Orphans)).To(gomega.Succeed())
+
+	expectedPG := pg.DeepCopy()
+	expectedPG.ACLs = []string{liveACL.UUID}
+	expectedLS := ls.DeepCopy()
+	expectedLS.ACLs = nil
+	expectedLS.LoadBalancer = nil
+	g.Eventually(nbClient).Should(libovsdbtest.HaveData(liveACL, expectedPG, expectedLS))
+}
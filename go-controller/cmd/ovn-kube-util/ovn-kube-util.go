@@ -34,6 +34,7 @@ func main() {
 		&app.BridgesToNicCommand,
 		&app.ReadinessProbeCommand,
 		&app.OvsExporterCommand,
+		&app.NBDBAuditCommand,
 	}
 
 	c.Before = func(ctx *cli.Context) error {
@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// mcpProtocolVersions are the supported versions of the Model Context Protocol, latest first
+var mcpProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// JSON-RPC error codes
+const (
+	jsonrpcParseError     = -32700
+	jsonrpcInvalidRequest = -32600
+	jsonrpcMethodNotFound = -32601
+	jsonrpcInvalidParams  = -32602
+)
+
+// maxMessageSize is the maximum size of a message received from a client
+const maxMessageSize = 16 * 1024 * 1024
+
+type jsonrpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonrpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+// toolProperty is a property of the input schema of a tool
+type toolProperty struct {
+	name        string
+	typ         string
+	description string
+	required    bool
+}
+
+// tool is a read-only tool exposed to the clients
+type tool struct {
+	name        string
+	title       string
+	description string
+	properties  []toolProperty
+	// handler runs the tool with the arguments provided by the client and returns
+	// a JSON serializable result
+	handler func(ctx context.Context, args json.RawMessage) (any, error)
+}
+
+func (t *tool) describe() map[string]any {
+	properties := map[string]any{}
+	required := []string{}
+	for _, p := range t.properties {
+		properties[p.name] = map[string]string{"type": p.typ, "description": p.description}
+		if p.required {
+			required = append(required, p.name)
+		}
+	}
+	return map[string]any{
+		"name":        t.name,
+		"title":       t.title,
+		"description": t.description,
+		"inputSchema": map[string]any{
+			"type":       "object",
+			"properties": properties,
+			"required":   required,
+		},
+		"annotations": map[string]any{
+			"title":          t.title,
+			"readOnlyHint":   true,
+			"idempotentHint": true,
+		},
+	}
+}
+
+// mcpServer handles the Model Context Protocol messages of the clients. It only
+// implements the tools capability.
+type mcpServer struct {
+	version string
+	tools   []*tool
+}
+
+func newMCPServer(version string, tools []*tool) *mcpServer {
+	return &mcpServer{version: version, tools: tools}
+}
+
+// handleMessage handles a JSON-RPC message and returns the response to send back to
+// the client, or nil if the message doesn't expect a response.
+func (s *mcpServer) handleMessage(ctx context.Context, data []byte) *jsonrpcResponse {
+	req := jsonrpcRequest{}
+	if err := json.Unmarshal(data, &req); err != nil {
+		return newErrorResponse(nil, jsonrpcParseError, fmt.Sprintf("failed to parse message: %v", err))
+	}
+	isNotification := len(req.ID) == 0
+	if req.Method == "" {
+		// responses to server requests are not expected as the server doesn't send any
+		if !isNotification {
+			return nil
+		}
+		return newErrorResponse(nil, jsonrpcInvalidRequest, "missing method")
+	}
+	if req.JSONRPC != "2.0" {
+		if isNotification {
+			return nil
+		}
+		return newErrorResponse(req.ID, jsonrpcInvalidRequest, fmt.Sprintf("unsupported JSON-RPC version %q", req.JSONRPC))
+	}
+	if isNotification {
+		// notifications/initialized and notifications/cancelled don't require any action
+		klog.V(5).Infof("Ignoring notification %s", req.Method)
+		return nil
+	}
+
+	var result any
+	var rpcErr *jsonrpcError
+	switch req.Method {
+	case "initialize":
+		result, rpcErr = s.initialize(req.Params)
+	case "ping":
+		result = struct{}{}
+	case "tools/list":
+		tools := make([]map[string]any, 0, len(s.tools))
+		for _, t := range s.tools {
+			tools = append(tools, t.describe())
+		}
+		result = map[string]any{"tools": tools}
+	case "tools/call":
+		result, rpcErr = s.callTool(ctx, req.Params)
+	default:
+		rpcErr = &jsonrpcError{Code: jsonrpcMethodNotFound, Message: fmt.Sprintf("method %q not found", req.Method)}
+	}
+	if rpcErr != nil {
+		return &jsonrpcResponse{JSONRPC: "2.0", ID: req.ID, Error: rpcErr}
+	}
+	return &jsonrpcResponse{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+func (s *mcpServer) initialize(params json.RawMessage) (any, *jsonrpcError) {
+	var initParams struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &initParams); err != nil {
+			return nil, &jsonrpcError{Code: jsonrpcInvalidParams, Message: fmt.Sprintf("invalid initialize params: %v", err)}
+		}
+	}
+	// reply with the requested version if supported, the client decides whether it
+	// supports the latest version otherwise
+	protocolVersion := mcpProtocolVersions[0]
+	if slices.Contains(mcpProtocolVersions, initParams.ProtocolVersion) {
+		protocolVersion = initParams.ProtocolVersion
+	}
+	return map[string]any{
+		"protocolVersion": protocolVersion,
+		"capabilities": map[string]any{
+			"tools": map[string]any{"listChanged": false},
+		},
+		"serverInfo": map[string]string{
+			"name":    "ovnkube-mcp",
+			"version": s.version,
+		},
+		"instructions": "Read-only tools to troubleshoot OVN-Kubernetes: user defined networks and their OVN " +
+			"northbound topology, the ACLs applied to pods, ovn-trace, and the state of the retry framework " +
+			"and status manager.",
+	}, nil
+}
+
+func (s *mcpServer) callTool(ctx context.Context, params json.RawMessage) (any, *jsonrpcError) {
+	var callParams struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &callParams); err != nil {
+		return nil, &jsonrpcError{Code: jsonrpcInvalidParams, Message: fmt.Sprintf("invalid tools/call params: %v", err)}
+	}
+	i := slices.IndexFunc(s.tools, func(t *tool) bool { return t.name == callParams.Name })
+	if i < 0 {
+		return nil, &jsonrpcError{Code: jsonrpcInvalidParams, Message: fmt.Sprintf("unknown tool %q", callParams.Name)}
+	}
+	args := callParams.Arguments
+	if len(args) == 0 || string(args) == "null" {
+		args = json.RawMessage("{}")
+	}
+
+	// tool errors are reported in the result so that they are visible to the model
+	result, err := s.tools[i].handler(ctx, args)
+	if err != nil {
+		klog.V(4).Infof("Tool %s failed: %v", callParams.Name, err)
+		return newToolResult(err.Error(), true), nil
+	}
+	text, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return newToolResult(fmt.Sprintf("failed to encode the result: %v", err), true), nil
+	}
+	return newToolResult(string(text), false), nil
+}
+
+func newToolResult(text string, isError bool) map[string]any {
+	return map[string]any{
+		"content": []map[string]string{{"type": "text", "text": text}},
+		"isError": isError,
+	}
+}
+
+func newErrorResponse(id json.RawMessage, code int, message string) *jsonrpcResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &jsonrpcResponse{JSONRPC: "2.0", ID: id, Error: &jsonrpcError{Code: code, Message: message}}
+}
+
+// serveStdio serves the messages read from in, one per line, and writes the responses
+// to out until in is closed or ctx is done.
+func (s *mcpServer) serveStdio(ctx context.Context, in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+	encoder := json.NewEncoder(out)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return nil
+		}
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		resp := s.handleMessage(ctx, line)
+		if resp == nil {
+			continue
+		}
+		// the encoder terminates every message with a newline
+		if err := encoder.Encode(resp); err != nil {
+			return fmt.Errorf("failed to write response: %w", err)
+		}
+	}
+	return scanner.Err()
+}
+
+// ServeHTTP implements the streamable HTTP transport without server-sent events:
+// every request is answered with a single JSON response.
+func (s *mcpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !isLocalOrigin(r.Header.Get("Origin")) {
+		// prevent DNS rebinding attacks from browsers
+		http.Error(w, "origin not allowed", http.StatusForbidden)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	if version := r.Header.Get("MCP-Protocol-Version"); version != "" && !slices.Contains(mcpProtocolVersions, version) {
+		http.Error(w, fmt.Sprintf("unsupported protocol version %q", version), http.StatusBadRequest)
+		return
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxMessageSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read request: %v", err), http.StatusBadRequest)
+		return
+	}
+	resp := s.handleMessage(r.Context(), data)
+	if resp == nil {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		klog.Errorf("Failed to write MCP response: %v", err)
+	}
+}
+
+// isLocalOrigin returns whether origin is empty, i.e. the request doesn't come from
+// a browser, or a loopback origin
+func isLocalOrigin(origin string) bool {
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if u.Hostname() == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(u.Hostname())
+	return ip != nil && ip.IsLoopback()
+}
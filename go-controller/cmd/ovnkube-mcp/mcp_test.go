@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func newTestMCPServer() *mcpServer {
+	return newMCPServer("test", []*tool{
+		{
+			name:        "echo",
+			title:       "Echo",
+			description: "Echo the message",
+			properties: []toolProperty{
+				{name: "message", typ: "string", description: "The message", required: true},
+			},
+			handler: func(_ context.Context, rawArgs json.RawMessage) (any, error) {
+				var args struct {
+					Message string `json:"message"`
+				}
+				if err := json.Unmarshal(rawArgs, &args); err != nil {
+					return nil, err
+				}
+				if args.Message == "" {
+					return nil, errors.New("message is required")
+				}
+				return map[string]string{"message": args.Message}, nil
+			},
+		},
+	})
+}
+
+// roundTrip handles message and returns the response as generic JSON
+func roundTrip(g *gomega.WithT, s *mcpServer, message string) map[string]any {
+	resp := s.handleMessage(context.Background(), []byte(message))
+	if resp == nil {
+		return nil
+	}
+	data, err := json.Marshal(resp)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	result := map[string]any{}
+	g.Expect(json.Unmarshal(data, &result)).To(gomega.Succeed())
+	return result
+}
+
+func TestHandleMessage(t *testing.T) {
+	g := gomega.NewWithT(t)
+	s := newTestMCPServer()
+
+	resp := roundTrip(g, s, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`)
+	g.Expect(resp["id"]).To(gomega.BeEquivalentTo(1))
+	g.Expect(resp).To(gomega.HaveKeyWithValue("result", gomega.And(
+		gomega.HaveKeyWithValue("protocolVersion", "2025-03-26"),
+		gomega.HaveKeyWithValue("capabilities", gomega.HaveKey("tools")),
+		gomega.HaveKeyWithValue("serverInfo", gomega.HaveKeyWithValue("name", "ovnkube-mcp")),
+	)))
+
+	// unknown versions are answered with the latest supported version
+	resp = roundTrip(g, s, `{"jsonrpc":"2.0","id":"init","method":"initialize","params":{"protocolVersion":"1999-01-01"}}`)
+	g.Expect(resp["id"]).To(gomega.Equal("init"))
+	g.Expect(resp).To(gomega.HaveKeyWithValue("result", gomega.HaveKeyWithValue("protocolVersion", mcpProtocolVersions[0])))
+
+	g.Expect(roundTrip(g, s, `{"jsonrpc":"2.0","method":"notifications/initialized"}`)).To(gomega.BeNil())
+
+	resp = roundTrip(g, s, `{"jsonrpc":"2.0","id":2,"method":"ping"}`)
+	g.Expect(resp).To(gomega.HaveKeyWithValue("result", gomega.BeEmpty()))
+
+	resp = roundTrip(g, s, `{"jsonrpc":"2.0","id":3,"method":"tools/list"}`)
+	g.Expect(resp).To(gomega.HaveKeyWithValue("result", gomega.HaveKeyWithValue("tools", gomega.ConsistOf(gomega.And(
+		gomega.HaveKeyWithValue("name", "echo"),
+		gomega.HaveKeyWithValue("inputSchema", gomega.HaveKeyWithValue("required", gomega.ConsistOf("message"))),
+		gomega.HaveKeyWithValue("annotations", gomega.HaveKeyWithValue("readOnlyHint", true)),
+	)))))
+
+	resp = roundTrip(g, s, `{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"echo","arguments":{"message":"hello"}}}`)
+	g.Expect(resp).To(gomega.HaveKeyWithValue("result", gomega.And(
+		gomega.HaveKeyWithValue("isError", false),
+		gomega.HaveKeyWithValue("content", gomega.ConsistOf(gomega.HaveKeyWithValue("text", gomega.ContainSubstring(`"message": "hello"`)))),
+	)))
+
+	// tool errors are reported in the result
+	resp = roundTrip(g, s, `{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"echo"}}`)
+	g.Expect(resp).To(gomega.HaveKeyWithValue("result", gomega.And(
+		gomega.HaveKeyWithValue("isError", true),
+		gomega.HaveKeyWithValue("content", gomega.ConsistOf(gomega.HaveKeyWithValue("text", "message is required"))),
+	)))
+
+	for _, tc := range []struct {
+		message string
+		code    int
+	}{
+		{`{"jsonrpc":"2.0","id":6,"method":"tools/call","params":{"name":"unknown"}}`, jsonrpcInvalidParams},
+		{`{"jsonrpc":"2.0","id":7,"method":"resources/list"}`, jsonrpcMethodNotFound},
+		{`{"jsonrpc":"1.0","id":8,"method":"ping"}`, jsonrpcInvalidRequest},
+		{`{"jsonrpc":`, jsonrpcParseError},
+	} {
+		resp = roundTrip(g, s, tc.message)
+		g.Expect(resp).To(gomega.HaveKeyWithValue("error", gomega.HaveKeyWithValue("code", gomega.BeEquivalentTo(tc.code))), tc.message)
+		g.Expect(resp).NotTo(gomega.HaveKey("result"), tc.message)
+	}
+}
+
+func TestServeStdio(t *testing.T) {
+	g := gomega.NewWithT(t)
+	s := newTestMCPServer()
+
+	in := strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"ping"}` + "\n\n" +
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}` + "\n" +
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo","arguments":{"message":"hi"}}}` + "\n")
+	out := &bytes.Buffer{}
+	g.Expect(s.serveStdio(context.Background(), in, out)).To(gomega.Succeed())
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	g.Expect(lines).To(gomega.HaveLen(2))
+	g.Expect(lines[0]).To(gomega.Equal(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+	g.Expect(lines[1]).To(gomega.ContainSubstring(`"id":2`))
+}
+
+func TestServeHTTP(t *testing.T) {
+	g := gomega.NewWithT(t)
+	server := httptest.NewServer(newTestMCPServer())
+	defer server.Close()
+
+	post := func(body string, headers map[string]string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return resp
+	}
+
+	resp := post(`{"jsonrpc":"2.0","id":1,"method":"ping"}`, map[string]string{"Origin": "http://localhost:6274"})
+	defer resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+	g.Expect(resp.Header.Get("Content-Type")).To(gomega.Equal("application/json"))
+	reply := map[string]any{}
+	g.Expect(json.NewDecoder(resp.Body).Decode(&reply)).To(gomega.Succeed())
+	g.Expect(reply).To(gomega.HaveKeyWithValue("result", gomega.BeEmpty()))
+
+	resp = post(`{"jsonrpc":"2.0","method":"notifications/initialized"}`, nil)
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusAccepted))
+
+	resp = post(`{"jsonrpc":"2.0","id":1,"method":"ping"}`, map[string]string{"Origin": "http://example.com"})
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusForbidden))
+
+	resp = post(`{"jsonrpc":"2.0","id":1,"method":"ping"}`, map[string]string{"MCP-Protocol-Version": "1999-01-01"})
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusBadRequest))
+
+	resp, err := http.Get(server.URL)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(gomega.Equal(http.StatusMethodNotAllowed))
+}
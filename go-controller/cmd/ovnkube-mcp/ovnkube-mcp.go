@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/sbdb"
+)
+
+const (
+	transportStdio = "stdio"
+	transportHTTP  = "http"
+)
+
+func main() {
+	transport := flag.String("transport", transportStdio, "MCP transport: stdio, or http to serve the streamable HTTP transport on --listen-address")
+	listenAddress := flag.String("listen-address", "127.0.0.1:8990", "address to serve the MCP endpoint /mcp on with the http transport")
+	kubeconfig := flag.String("kubeconfig", "", "absolute path to the kubeconfig file, defaults to $KUBECONFIG or the in-cluster config")
+	k8sDump := flag.String("k8s-dump", "", "comma separated JSON or YAML dumps of Kubernetes objects (e.g. the output of \"kubectl get -A -o json\") to read instead of a live cluster")
+	nbAddress := flag.String("nb-address", "", "address of the live OVN NB database, e.g. unix:/var/run/ovn/ovnnb_db.sock")
+	sbAddress := flag.String("sb-address", "", "address of the live OVN SB database used by ovn-trace, e.g. unix:/var/run/ovn/ovnsb_db.sock")
+	nbDBFile := flag.String("nb-db-file", "", "standalone OVN NB database file (e.g. the output of \"ovsdb-client backup\") to read instead of --nb-address")
+	sbDBFile := flag.String("sb-db-file", "", "standalone OVN SB database file to trace with instead of --sb-address")
+	metricsAddress := flag.String("metrics-address", "", "address of the metrics server of an ovnkube process to read the retry state from, e.g. 127.0.0.1:9410")
+	retryDump := flag.String("retry-dump", "", "file holding the output of the /debug/retry endpoint of an ovnkube process to read instead of --metrics-address")
+	ovnTrace := flag.String("ovn-trace", "ovn-trace", "path of the ovn-trace binary")
+	loglevel := flag.String("loglevel", "0", "loglevel: klog level")
+	flag.Parse()
+
+	// the stdio transport uses stdout, always log to stderr
+	klogFlags := flag.NewFlagSet("klog", flag.ExitOnError)
+	klog.InitFlags(klogFlags)
+	if err := klogFlags.Set("v", *loglevel); err != nil {
+		klog.Exitf("Failed to set klog log level %v", err)
+	}
+	klog.SetOutput(os.Stderr)
+
+	if *transport != transportStdio && *transport != transportHTTP {
+		klog.Exitf("Unsupported transport %q, expected one of: %s, %s", *transport, transportStdio, transportHTTP)
+	}
+	if *nbAddress != "" && *nbDBFile != "" {
+		klog.Exitf("--nb-address and --nb-db-file are mutually exclusive")
+	}
+	if *sbAddress != "" && *sbDBFile != "" {
+		klog.Exitf("--sb-address and --sb-db-file are mutually exclusive")
+	}
+	if *metricsAddress != "" && *retryDump != "" {
+		klog.Exitf("--metrics-address and --retry-dump are mutually exclusive")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	t, cleanup, err := newTroubleshooter(troubleshooterConfig{
+		kubeconfig:     *kubeconfig,
+		k8sDump:        *k8sDump,
+		nbAddress:      *nbAddress,
+		sbAddress:      *sbAddress,
+		nbDBFile:       *nbDBFile,
+		sbDBFile:       *sbDBFile,
+		metricsAddress: *metricsAddress,
+		retryDump:      *retryDump,
+		ovnTrace:       *ovnTrace,
+	})
+	if err != nil {
+		klog.Exit(err)
+	}
+	defer cleanup()
+
+	s := newMCPServer(config.Version, t.tools())
+	if *transport == transportStdio {
+		err = s.serveStdio(ctx, os.Stdin, os.Stdout)
+	} else {
+		err = serveHTTP(ctx, s, *listenAddress)
+	}
+	if err != nil {
+		klog.Errorf("MCP server failed: %v", err)
+		cleanup()
+		os.Exit(1)
+	}
+}
+
+type troubleshooterConfig struct {
+	kubeconfig     string
+	k8sDump        string
+	nbAddress      string
+	sbAddress      string
+	nbDBFile       string
+	sbDBFile       string
+	metricsAddress string
+	retryDump      string
+	ovnTrace       string
+}
+
+// newTroubleshooter sets up the sources of the tools. The returned function releases
+// them and must be called once done.
+func newTroubleshooter(cfg troubleshooterConfig) (*troubleshooter, func(), error) {
+	t := &troubleshooter{ovnTrace: cfg.ovnTrace}
+	stopCh := make(chan struct{})
+	dbFileServers := []*dbFileServer{}
+	cleanup := func() {
+		select {
+		case <-stopCh:
+			return
+		default:
+		}
+		close(stopCh)
+		for _, s := range dbFileServers {
+			s.close()
+		}
+	}
+
+	var err error
+	if cfg.k8sDump != "" {
+		t.k8s, err = newDumpK8sSource(strings.Split(cfg.k8sDump, ",")...)
+	} else {
+		t.k8s, err = newLiveK8sSource(cfg.kubeconfig)
+	}
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+
+	nbAddress := cfg.nbAddress
+	if cfg.nbDBFile != "" {
+		dbModel, err := nbdb.FullDatabaseModel()
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		s, err := serveDatabaseFile(cfg.nbDBFile, dbModel, nbdb.Schema())
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		dbFileServers = append(dbFileServers, s)
+		nbAddress = s.address
+	}
+	if nbAddress != "" {
+		t.nbClient, err = libovsdb.NewNBClientWithEndpoint(nbAddress, prometheus.NewRegistry(), stopCh)
+		if err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("failed to connect to the NB database %s: %w", nbAddress, err)
+		}
+	}
+
+	t.sbAddress = cfg.sbAddress
+	if cfg.sbDBFile != "" {
+		dbModel, err := sbdb.FullDatabaseModel()
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		s, err := serveDatabaseFile(cfg.sbDBFile, dbModel, sbdb.Schema())
+		if err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+		dbFileServers = append(dbFileServers, s)
+		t.sbAddress = s.address
+	}
+
+	if cfg.retryDump != "" {
+		t.retry = &dumpRetrySource{path: cfg.retryDump}
+	} else if cfg.metricsAddress != "" {
+		if t.retry, err = newLiveRetrySource(cfg.metricsAddress); err != nil {
+			cleanup()
+			return nil, nil, err
+		}
+	}
+	return t, cleanup, nil
+}
+
+// serveHTTP serves the MCP endpoint on address until ctx is done
+func serveHTTP(ctx context.Context, s *mcpServer, address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/mcp", s)
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			klog.Errorf("Failed to shut down the MCP server: %v", err)
+		}
+	}()
+	klog.Infof("Serving MCP on http://%s/mcp", address)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
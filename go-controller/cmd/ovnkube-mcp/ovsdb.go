@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/libovsdb/database"
+	"github.com/ovn-kubernetes/libovsdb/database/inmemory"
+	"github.com/ovn-kubernetes/libovsdb/model"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb/serverdb"
+	"github.com/ovn-kubernetes/libovsdb/server"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/dbfile"
+)
+
+// dbFileServer serves the content of a database file on a local unix socket, so that
+// it can be read with the same clients and tools as a live database
+type dbFileServer struct {
+	*server.OvsdbServer
+	dir string
+	// address is the address of the database, e.g. unix:/tmp/ovnkube-mcp-123/ovnnb_db.sock
+	address string
+}
+
+// serveDatabaseFile loads the database file at path in an in-memory database and serves
+// it on a unix socket in a temporary directory, until close is called.
+func serveDatabaseFile(path string, dbModel model.ClientDBModel, schema ovsdb.DatabaseSchema) (*dbFileServer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rows, err := dbfile.Read(f, &schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read database file %s: %w", path, err)
+	}
+
+	serverDBModel, err := serverdb.FullDatabaseModel()
+	if err != nil {
+		return nil, err
+	}
+	db := inmemory.NewDatabase(map[string]model.ClientDBModel{
+		schema.Name:            dbModel,
+		serverdb.Schema().Name: serverDBModel,
+	}, nil)
+	dbMod, errs := model.NewDatabaseModel(schema, dbModel)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to create the %s database model: %v", schema.Name, errs)
+	}
+	servMod, errs := model.NewDatabaseModel(serverdb.Schema(), serverDBModel)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed to create the %s database model: %v", serverdb.Schema().Name, errs)
+	}
+
+	// the server creates the databases the rows are loaded into
+	s, err := server.NewOvsdbServer(db, nil, dbMod, servMod)
+	if err != nil {
+		return nil, err
+	}
+	// the clients connect to the leader only
+	sid := uuid.NewString()
+	serverRow := map[string]any{
+		"name":      schema.Name,
+		"model":     serverdb.DatabaseModelStandalone,
+		"connected": true,
+		"leader":    true,
+		"sid":       []any{"uuid", sid},
+	}
+	if err := insertRows(db, serverdb.Schema().Name, dbfile.Rows{
+		serverdb.DatabaseTable: {uuid.NewString(): toRawColumns(serverRow)},
+	}); err != nil {
+		return nil, err
+	}
+	if err := insertRows(db, schema.Name, rows); err != nil {
+		return nil, fmt.Errorf("failed to load database file %s: %w", path, err)
+	}
+
+	dir, err := os.MkdirTemp("", "ovnkube-mcp-")
+	if err != nil {
+		return nil, err
+	}
+	sockPath := filepath.Join(dir, strings.ToLower(schema.Name)+".sock")
+	go func() {
+		if err := s.Serve("unix", sockPath); err != nil {
+			klog.Errorf("Failed to serve database file %s: %v", path, err)
+		}
+	}()
+	err = wait.PollUntilContextTimeout(context.Background(), 50*time.Millisecond, 5*time.Second, true,
+		func(context.Context) (bool, error) { return s.Ready(), nil })
+	if err != nil {
+		s.Close()
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("database file %s server not ready: %w", path, err)
+	}
+	klog.Infof("Serving %s database file %s on %s", schema.Name, path, sockPath)
+	return &dbFileServer{OvsdbServer: s, dir: dir, address: "unix:" + sockPath}, nil
+}
+
+func (s *dbFileServer) close() {
+	s.Close()
+	os.RemoveAll(s.dir)
+}
+
+func toRawColumns(row map[string]any) map[string]json.RawMessage {
+	columns := map[string]json.RawMessage{}
+	for column, value := range row {
+		columns[column], _ = json.Marshal(value)
+	}
+	return columns
+}
+
+// insertRows inserts the given rows in the database in a single transaction,
+// preserving their UUIDs
+func insertRows(db database.Database, dbName string, rows dbfile.Rows) error {
+	ops := []ovsdb.Operation{}
+	for table, tableRows := range rows {
+		for rowUUID, columns := range tableRows {
+			data, err := json.Marshal(map[string]any{
+				"op":    ovsdb.OperationInsert,
+				"table": table,
+				"uuid":  rowUUID,
+				"row":   columns,
+			})
+			if err != nil {
+				return err
+			}
+			var op ovsdb.Operation
+			if err := json.Unmarshal(data, &op); err != nil {
+				return fmt.Errorf("failed to parse %s row %s: %w", table, rowUUID, err)
+			}
+			ops = append(ops, op)
+		}
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+
+	txn := db.NewTransaction(dbName)
+	results, update := txn.Transact(ops...)
+	opResults := make([]ovsdb.OperationResult, 0, len(results))
+	for _, result := range results {
+		opResults = append(opResults, *result)
+	}
+	if opErrs, err := ovsdb.CheckOperationResults(opResults, ops); err != nil {
+		if len(opErrs) > 0 {
+			op := opErrs[0].Operation()
+			return fmt.Errorf("%w: %s row %s: %v", err, op.Table, op.UUID, opErrs[0])
+		}
+		return err
+	}
+	return db.Commit(dbName, uuid.New(), update)
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/retry"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/k8sdump"
+)
+
+// k8sResources are the Kubernetes resources read by the tools, per kind
+var k8sResources = map[string]schema.GroupVersionResource{
+	"Pod":                           {Version: "v1", Resource: "pods"},
+	"UserDefinedNetwork":            {Group: "k8s.ovn.org", Version: "v1", Resource: "userdefinednetworks"},
+	"ClusterUserDefinedNetwork":     {Group: "k8s.ovn.org", Version: "v1", Resource: "clusteruserdefinednetworks"},
+	"NetworkAttachmentDefinition":   {Group: "k8s.cni.cncf.io", Version: "v1", Resource: "network-attachment-definitions"},
+	"EgressFirewall":                {Group: "k8s.ovn.org", Version: "v1", Resource: "egressfirewalls"},
+	"AdminPolicyBasedExternalRoute": {Group: "k8s.ovn.org", Version: "v1", Resource: "adminpolicybasedexternalroutes"},
+	"EgressQoS":                     {Group: "k8s.ovn.org", Version: "v1", Resource: "egressqoses"},
+	"NetworkQoS":                    {Group: "k8s.ovn.org", Version: "v1alpha1", Resource: "networkqoses"},
+	"MulticastGroup":                {Group: "k8s.ovn.org", Version: "v1alpha1", Resource: "multicastgroups"},
+	"AdminNetworkPolicy":            {Group: "policy.networking.k8s.io", Version: "v1alpha1", Resource: "adminnetworkpolicies"},
+	"BaselineAdminNetworkPolicy":    {Group: "policy.networking.k8s.io", Version: "v1alpha1", Resource: "baselineadminnetworkpolicies"},
+	"ClusterNetworkPolicy":          {Group: "policy.networking.k8s.io", Version: "v1alpha2", Resource: "clusternetworkpolicies"},
+}
+
+// k8sSource lists the Kubernetes objects of a live cluster or of a dump
+type k8sSource interface {
+	// list returns the objects of the given kind in namespace, or in all namespaces if
+	// namespace is empty. Kinds that are not installed in the cluster have no objects.
+	list(ctx context.Context, kind, namespace string) ([]unstructured.Unstructured, error)
+}
+
+// liveK8sSource lists the objects from the Kubernetes API
+type liveK8sSource struct {
+	client dynamic.Interface
+}
+
+func newLiveK8sSource(kubeconfig string) (*liveK8sSource, error) {
+	var restConfig *rest.Config
+	var err error
+	if kubeconfig != "" {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+	} else {
+		restConfig, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			clientcmd.NewDefaultClientConfigLoadingRules(),
+			&clientcmd.ConfigOverrides{},
+		).ClientConfig()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the kubernetes client config: %w", err)
+	}
+	client, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &liveK8sSource{client: client}, nil
+}
+
+func (s *liveK8sSource) list(ctx context.Context, kind, namespace string) ([]unstructured.Unstructured, error) {
+	gvr, ok := k8sResources[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+	list, err := s.client.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// the CRD is not installed
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list %s: %w", gvr.Resource, err)
+	}
+	return list.Items, nil
+}
+
+// dumpK8sSource lists the objects of a JSON or YAML dump of Kubernetes objects
+type dumpK8sSource struct {
+	objects map[string][]unstructured.Unstructured
+}
+
+// newDumpK8sSource reads the objects of the given dump files, e.g. the output of
+// "kubectl get -A -o json pods,userdefinednetworks"
+func newDumpK8sSource(paths ...string) (*dumpK8sSource, error) {
+	objects, err := k8sdump.Read(paths...)
+	if err != nil {
+		return nil, err
+	}
+	s := &dumpK8sSource{objects: map[string][]unstructured.Unstructured{}}
+	for _, obj := range objects {
+		s.objects[obj.GetKind()] = append(s.objects[obj.GetKind()], obj)
+	}
+	return s, nil
+}
+
+func (s *dumpK8sSource) list(_ context.Context, kind, namespace string) ([]unstructured.Unstructured, error) {
+	if _, ok := k8sResources[kind]; !ok {
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+	objects := []unstructured.Unstructured{}
+	for _, obj := range s.objects[kind] {
+		if namespace == "" || obj.GetNamespace() == namespace {
+			objects = append(objects, obj)
+		}
+	}
+	return objects, nil
+}
+
+// retrySource returns the state of the retry frameworks of an ovnkube process
+type retrySource interface {
+	list(ctx context.Context) ([]retry.RetryFrameworkStatus, error)
+}
+
+// liveRetrySource reads the retry state from the /debug/retry endpoint of the
+// metrics server of an ovnkube process
+type liveRetrySource struct {
+	endpoint string
+	client   *http.Client
+}
+
+func newLiveRetrySource(metricsAddress string) (*liveRetrySource, error) {
+	if !strings.Contains(metricsAddress, "://") {
+		metricsAddress = "http://" + metricsAddress
+	}
+	u, err := url.Parse(metricsAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metrics address %q: %w", metricsAddress, err)
+	}
+	u.Path = "/debug/retry"
+	return &liveRetrySource{endpoint: u.String(), client: &http.Client{Timeout: 10 * time.Second}}, nil
+}
+
+func (s *liveRetrySource) list(ctx context.Context) ([]retry.RetryFrameworkStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the retry state from %s: %w", s.endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("failed to get the retry state from %s: %s: %s", s.endpoint, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return decodeRetryState(resp.Body)
+}
+
+// dumpRetrySource reads the retry state from a file holding the output of the
+// /debug/retry endpoint
+type dumpRetrySource struct {
+	path string
+}
+
+func (s *dumpRetrySource) list(context.Context) ([]retry.RetryFrameworkStatus, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return decodeRetryState(f)
+}
+
+func decodeRetryState(r io.Reader) ([]retry.RetryFrameworkStatus, error) {
+	frameworks := []retry.RetryFrameworkStatus{}
+	if err := json.NewDecoder(r).Decode(&frameworks); err != nil {
+		return nil, fmt.Errorf("failed to parse the retry state: %w", err)
+	}
+	sort.Slice(frameworks, func(i, j int) bool {
+		if frameworks[i].Name != frameworks[j].Name {
+			return frameworks[i].Name < frameworks[j].Name
+		}
+		return frameworks[i].ResourceType < frameworks[j].ResourceType
+	})
+	return frameworks, nil
+}
@@ -0,0 +1,868 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/retry"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// nbTimeout is the timeout of the NB database reads of a tool
+	nbTimeout = 30 * time.Second
+	// ovnTraceTimeout is the timeout of an ovn-trace run
+	ovnTraceTimeout = time.Minute
+
+	defaultNetworkName = types.DefaultNetworkName
+	readyInZonePrefix  = "Ready-In-Zone-"
+)
+
+// troubleshooter implements the read-only tools on top of the configured sources
+type troubleshooter struct {
+	k8s k8sSource
+	// nbClient is nil when no NB database is configured
+	nbClient libovsdbclient.Client
+	// sbAddress is the address of the SB database used by ovn-trace, empty if not configured
+	sbAddress string
+	// ovnTrace is the path of the ovn-trace binary
+	ovnTrace string
+	// retry is nil when no retry state is configured
+	retry retrySource
+}
+
+func (t *troubleshooter) tools() []*tool {
+	return []*tool{
+		{
+			name:  "list_user_defined_networks",
+			title: "List user defined networks",
+			description: "List the UserDefinedNetworks and ClusterUserDefinedNetworks with their OVN network name, " +
+				"topology, role, subnets and status conditions.",
+			properties: []toolProperty{
+				{name: "namespace", typ: "string", description: "Only list the UserDefinedNetworks of this namespace"},
+			},
+			handler: t.listUserDefinedNetworks,
+		},
+		{
+			name:  "describe_user_defined_network",
+			title: "Describe a user defined network",
+			description: "Describe a UserDefinedNetwork, or a ClusterUserDefinedNetwork when no namespace is given: " +
+				"its spec and status, the NetworkAttachmentDefinitions rendered for it and its OVN northbound topology.",
+			properties: []toolProperty{
+				{name: "name", typ: "string", description: "The name of the network", required: true},
+				{name: "namespace", typ: "string", description: "The namespace of the UserDefinedNetwork, empty for a ClusterUserDefinedNetwork"},
+			},
+			handler: t.describeUserDefinedNetwork,
+		},
+		{
+			name:  "list_network_attachment_definitions",
+			title: "List network attachment definitions",
+			description: "List the NetworkAttachmentDefinitions with their parsed OVN-Kubernetes network configuration " +
+				"and the object that owns them.",
+			properties: []toolProperty{
+				{name: "namespace", typ: "string", description: "Only list the NetworkAttachmentDefinitions of this namespace"},
+			},
+			handler: t.listNetworkAttachmentDefinitions,
+		},
+		{
+			name:  "get_network_topology",
+			title: "Get the OVN topology of a network",
+			description: "Get the logical switches and logical routers created in the OVN northbound database for a network, " +
+				"identified by its OVN network name (\"default\" for the cluster default network).",
+			properties: []toolProperty{
+				{name: "network", typ: "string", description: "The OVN network name, e.g. default, ns1_udn1 or cluster_udn_red", required: true},
+			},
+			handler: t.getNetworkTopology,
+		},
+		{
+			name:  "get_pod_acls",
+			title: "Get the ACLs of a pod",
+			description: "Get the OVN ACLs applied to the logical switch ports of a pod, through port groups or its logical switch, " +
+				"along with the owner of every ACL (e.g. NetworkPolicy, AdminNetworkPolicy, EgressFirewall) read from its DbObjectIDs.",
+			properties: []toolProperty{
+				{name: "namespace", typ: "string", description: "The namespace of the pod", required: true},
+				{name: "name", typ: "string", description: "The name of the pod", required: true},
+				{name: "nad", typ: "string", description: "Only the port of the pod on this NetworkAttachmentDefinition (<namespace>/<name>)"},
+			},
+			handler: t.getPodACLs,
+		},
+		{
+			name:  "ovn_trace",
+			title: "Run ovn-trace",
+			description: "Simulate the processing of a packet by the OVN logical flows of the southbound database with ovn-trace, " +
+				"e.g. datapath \"ovn-worker\" and microflow \"inport==\\\"ns1_pod1\\\" && eth.src==0a:58:0a:f4:01:05 && " +
+				"ip4.src==10.244.1.5 && ip4.dst==10.244.2.6 && ip.ttl==64 && tcp.dst==80\".",
+			properties: []toolProperty{
+				{name: "datapath", typ: "string", description: "The logical switch or router on which the packet enters", required: true},
+				{name: "microflow", typ: "string", description: "The packet headers, in the OVN match syntax", required: true},
+				{name: "format", typ: "string", description: "The output format: detailed (default), summary or minimal"},
+			},
+			handler: t.ovnTraceTool,
+		},
+		{
+			name:  "list_retry_state",
+			title: "List the retry framework state",
+			description: "List the Kubernetes objects that ovnkube failed to process and are waiting to be retried, " +
+				"with the number of failed attempts, the last error and the next retry time.",
+			properties: []toolProperty{
+				{name: "name", typ: "string", description: "Only the retry framework with this name, e.g. default-network-controller"},
+				{name: "resourceType", typ: "string", description: "Only the retry frameworks of this resource type, e.g. *v1.Pod"},
+			},
+			handler: t.listRetryState,
+		},
+		{
+			name:  "list_status_manager_state",
+			title: "List the status manager state",
+			description: "List the per zone status reported by ovnkube for the resources whose status is aggregated by the " +
+				"cluster manager status manager: EgressFirewall, AdminPolicyBasedExternalRoute, EgressQoS, NetworkQoS, " +
+				"MulticastGroup, AdminNetworkPolicy, BaselineAdminNetworkPolicy and ClusterNetworkPolicy.",
+			properties: []toolProperty{
+				{name: "kind", typ: "string", description: "Only the resources of this kind"},
+				{name: "namespace", typ: "string", description: "Only the resources of this namespace"},
+				{name: "failedOnly", typ: "boolean", description: "Only the resources that failed to be applied in at least one zone"},
+			},
+			handler: t.listStatusManagerState,
+		},
+	}
+}
+
+// condition is a status condition of a Kubernetes object
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// userDefinedNetwork summarizes a UserDefinedNetwork or a ClusterUserDefinedNetwork
+type userDefinedNetwork struct {
+	Kind        string      `json:"kind"`
+	Namespace   string      `json:"namespace,omitempty"`
+	Name        string      `json:"name"`
+	NetworkName string      `json:"networkName"`
+	Topology    string      `json:"topology,omitempty"`
+	Role        string      `json:"role,omitempty"`
+	Subnets     []string    `json:"subnets,omitempty"`
+	Conditions  []condition `json:"conditions,omitempty"`
+}
+
+func newUserDefinedNetwork(obj *unstructured.Unstructured) *userDefinedNetwork {
+	udn := &userDefinedNetwork{
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		Conditions: getConditions(obj),
+	}
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	if udn.Kind == "ClusterUserDefinedNetwork" {
+		udn.NetworkName = util.GenerateCUDNNetworkName(udn.Name)
+		spec, _, _ = unstructured.NestedMap(obj.Object, "spec", "network")
+	} else {
+		udn.NetworkName = util.GenerateUDNNetworkName(udn.Namespace, udn.Name)
+	}
+	udn.Topology, _, _ = unstructured.NestedString(spec, "topology")
+	for _, key := range []string{"layer3", "layer2", "localnet"} {
+		topologySpec, found, _ := unstructured.NestedMap(spec, key)
+		if !found {
+			continue
+		}
+		udn.Role, _, _ = unstructured.NestedString(topologySpec, "role")
+		subnets, _, _ := unstructured.NestedSlice(topologySpec, "subnets")
+		for _, subnet := range subnets {
+			switch s := subnet.(type) {
+			case string:
+				udn.Subnets = append(udn.Subnets, s)
+			case map[string]any:
+				// layer3 subnets
+				if cidr, ok := s["cidr"].(string); ok {
+					udn.Subnets = append(udn.Subnets, cidr)
+				}
+			}
+		}
+	}
+	return udn
+}
+
+func getConditions(obj *unstructured.Unstructured) []condition {
+	rawConditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	conditions := []condition{}
+	for _, raw := range rawConditions {
+		c, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		cond := condition{}
+		cond.Type, _, _ = unstructured.NestedString(c, "type")
+		cond.Status, _, _ = unstructured.NestedString(c, "status")
+		cond.Reason, _, _ = unstructured.NestedString(c, "reason")
+		cond.Message, _, _ = unstructured.NestedString(c, "message")
+		conditions = append(conditions, cond)
+	}
+	return conditions
+}
+
+func (t *troubleshooter) listUserDefinedNetworks(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+	var args struct {
+		Namespace string `json:"namespace"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	udns, err := t.k8s.list(ctx, "UserDefinedNetwork", args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	networks := []*userDefinedNetwork{}
+	for i := range udns {
+		networks = append(networks, newUserDefinedNetwork(&udns[i]))
+	}
+	if args.Namespace == "" {
+		cudns, err := t.k8s.list(ctx, "ClusterUserDefinedNetwork", "")
+		if err != nil {
+			return nil, err
+		}
+		for i := range cudns {
+			networks = append(networks, newUserDefinedNetwork(&cudns[i]))
+		}
+	}
+	sort.Slice(networks, func(i, j int) bool { return networks[i].NetworkName < networks[j].NetworkName })
+	return networks, nil
+}
+
+func (t *troubleshooter) describeUserDefinedNetwork(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+	var args struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if args.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	kind := "UserDefinedNetwork"
+	if args.Namespace == "" {
+		kind = "ClusterUserDefinedNetwork"
+	}
+	objects, err := t.k8s.list(ctx, kind, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var obj *unstructured.Unstructured
+	for i := range objects {
+		if objects[i].GetName() == args.Name {
+			obj = &objects[i]
+			break
+		}
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("%s %s not found", kind, keyOf(args.Namespace, args.Name))
+	}
+
+	description := struct {
+		*userDefinedNetwork
+		Spec                         any                            `json:"spec,omitempty"`
+		Status                       any                            `json:"status,omitempty"`
+		NetworkAttachmentDefinitions []*networkAttachmentDefinition `json:"networkAttachmentDefinitions"`
+		Topology                     *networkTopology               `json:"topology,omitempty"`
+		TopologyUnavailableReason    string                         `json:"topologyUnavailableReason,omitempty"`
+	}{
+		userDefinedNetwork: newUserDefinedNetwork(obj),
+		Spec:               obj.Object["spec"],
+		Status:             obj.Object["status"],
+	}
+	nads, err := t.getNetworkAttachmentDefinitions(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	description.NetworkAttachmentDefinitions = []*networkAttachmentDefinition{}
+	for _, nad := range nads {
+		if nad.Owner == kind+" "+keyOf(args.Namespace, args.Name) {
+			description.NetworkAttachmentDefinitions = append(description.NetworkAttachmentDefinitions, nad)
+		}
+	}
+	if t.nbClient == nil {
+		description.TopologyUnavailableReason = "no NB database configured"
+	} else if description.Topology, err = t.networkTopology(ctx, description.NetworkName); err != nil {
+		return nil, err
+	}
+	return description, nil
+}
+
+// networkAttachmentDefinition summarizes a NetworkAttachmentDefinition
+type networkAttachmentDefinition struct {
+	Namespace   string   `json:"namespace"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type,omitempty"`
+	NetworkName string   `json:"networkName,omitempty"`
+	Topology    string   `json:"topology,omitempty"`
+	Role        string   `json:"role,omitempty"`
+	Subnets     []string `json:"subnets,omitempty"`
+	// Owner is the "<kind> <key>" of the controller of the NAD, e.g. a UserDefinedNetwork
+	Owner string `json:"owner,omitempty"`
+	// Error is set when the network configuration can't be parsed
+	Error string `json:"error,omitempty"`
+}
+
+func (t *troubleshooter) getNetworkAttachmentDefinitions(ctx context.Context, namespace string) ([]*networkAttachmentDefinition, error) {
+	objects, err := t.k8s.list(ctx, "NetworkAttachmentDefinition", namespace)
+	if err != nil {
+		return nil, err
+	}
+	nads := []*networkAttachmentDefinition{}
+	for i := range objects {
+		obj := &objects[i]
+		nad := &networkAttachmentDefinition{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		if owner := metav1.GetControllerOfNoCopy(obj); owner != nil {
+			// NADs of ClusterUserDefinedNetworks are owned by a cluster scoped object
+			ownerNamespace := nad.Namespace
+			if owner.Kind == "ClusterUserDefinedNetwork" {
+				ownerNamespace = ""
+			}
+			nad.Owner = owner.Kind + " " + keyOf(ownerNamespace, owner.Name)
+		}
+		rawConfig, _, _ := unstructured.NestedString(obj.Object, "spec", "config")
+		var netConf struct {
+			Name     string `json:"name"`
+			Type     string `json:"type"`
+			Topology string `json:"topology"`
+			Role     string `json:"role"`
+			Subnets  string `json:"subnets"`
+		}
+		if err := json.Unmarshal([]byte(rawConfig), &netConf); err != nil {
+			nad.Error = fmt.Sprintf("failed to parse the network configuration: %v", err)
+		} else {
+			nad.Type = netConf.Type
+			nad.NetworkName = netConf.Name
+			nad.Topology = netConf.Topology
+			nad.Role = netConf.Role
+			if netConf.Subnets != "" {
+				nad.Subnets = strings.Split(netConf.Subnets, ",")
+			}
+		}
+		nads = append(nads, nad)
+	}
+	sort.Slice(nads, func(i, j int) bool {
+		return keyOf(nads[i].Namespace, nads[i].Name) < keyOf(nads[j].Namespace, nads[j].Name)
+	})
+	return nads, nil
+}
+
+func (t *troubleshooter) listNetworkAttachmentDefinitions(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+	var args struct {
+		Namespace string `json:"namespace"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	return t.getNetworkAttachmentDefinitions(ctx, args.Namespace)
+}
+
+// networkTopology is the OVN northbound topology of a network
+type networkTopology struct {
+	Network         string          `json:"network"`
+	LogicalSwitches []logicalSwitch `json:"logicalSwitches"`
+	LogicalRouters  []logicalRouter `json:"logicalRouters"`
+}
+
+type logicalSwitch struct {
+	Name          string            `json:"name"`
+	UUID          string            `json:"uuid"`
+	OtherConfig   map[string]string `json:"otherConfig,omitempty"`
+	Ports         int               `json:"ports"`
+	PodPorts      int               `json:"podPorts"`
+	ACLs          int               `json:"acls"`
+	LoadBalancers int               `json:"loadBalancers"`
+	QoSRules      int               `json:"qosRules"`
+}
+
+type logicalRouterPort struct {
+	Name     string   `json:"name"`
+	MAC      string   `json:"mac"`
+	Networks []string `json:"networks"`
+	Peer     string   `json:"peer,omitempty"`
+}
+
+type logicalRouter struct {
+	Name          string              `json:"name"`
+	UUID          string              `json:"uuid"`
+	Ports         []logicalRouterPort `json:"ports"`
+	StaticRoutes  int                 `json:"staticRoutes"`
+	Policies      int                 `json:"policies"`
+	NATs          int                 `json:"nats"`
+	LoadBalancers int                 `json:"loadBalancers"`
+}
+
+func (t *troubleshooter) getNetworkTopology(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+	var args struct {
+		Network string `json:"network"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if args.Network == "" {
+		return nil, fmt.Errorf("network is required")
+	}
+	if t.nbClient == nil {
+		return nil, fmt.Errorf("no NB database configured")
+	}
+	return t.networkTopology(ctx, args.Network)
+}
+
+// belongsToNetwork returns whether the NB object with the given external ids
+// belongs to network. Objects of the default network have no network id.
+func belongsToNetwork(externalIDs map[string]string, network string) bool {
+	objNetwork := externalIDs[types.NetworkExternalID]
+	if network == defaultNetworkName {
+		return objNetwork == ""
+	}
+	return objNetwork == network
+}
+
+func (t *troubleshooter) networkTopology(ctx context.Context, network string) (*networkTopology, error) {
+	ctx, cancel := context.WithTimeout(ctx, nbTimeout)
+	defer cancel()
+
+	switches := []*nbdb.LogicalSwitch{}
+	if err := t.nbClient.WhereCache(func(ls *nbdb.LogicalSwitch) bool {
+		return belongsToNetwork(ls.ExternalIDs, network)
+	}).List(ctx, &switches); err != nil {
+		return nil, fmt.Errorf("failed to list logical switches: %w", err)
+	}
+	ports := []*nbdb.LogicalSwitchPort{}
+	if err := t.nbClient.List(ctx, &ports); err != nil {
+		return nil, fmt.Errorf("failed to list logical switch ports: %w", err)
+	}
+	podPorts := sets.New[string]()
+	for _, port := range ports {
+		if port.ExternalIDs["pod"] == "true" {
+			podPorts.Insert(port.UUID)
+		}
+	}
+	routers := []*nbdb.LogicalRouter{}
+	if err := t.nbClient.WhereCache(func(lr *nbdb.LogicalRouter) bool {
+		return belongsToNetwork(lr.ExternalIDs, network)
+	}).List(ctx, &routers); err != nil {
+		return nil, fmt.Errorf("failed to list logical routers: %w", err)
+	}
+	routerPorts := []*nbdb.LogicalRouterPort{}
+	if err := t.nbClient.List(ctx, &routerPorts); err != nil {
+		return nil, fmt.Errorf("failed to list logical router ports: %w", err)
+	}
+	routerPortsByUUID := map[string]*nbdb.LogicalRouterPort{}
+	for _, port := range routerPorts {
+		routerPortsByUUID[port.UUID] = port
+	}
+
+	topology := &networkTopology{Network: network, LogicalSwitches: []logicalSwitch{}, LogicalRouters: []logicalRouter{}}
+	for _, ls := range switches {
+		topology.LogicalSwitches = append(topology.LogicalSwitches, logicalSwitch{
+			Name:          ls.Name,
+			UUID:          ls.UUID,
+			OtherConfig:   ls.OtherConfig,
+			Ports:         len(ls.Ports),
+			PodPorts:      len(podPorts.Intersection(sets.New(ls.Ports...))),
+			ACLs:          len(ls.ACLs),
+			LoadBalancers: len(ls.LoadBalancer),
+			QoSRules:      len(ls.QOSRules),
+		})
+	}
+	for _, lr := range routers {
+		router := logicalRouter{
+			Name:          lr.Name,
+			UUID:          lr.UUID,
+			Ports:         []logicalRouterPort{},
+			StaticRoutes:  len(lr.StaticRoutes),
+			Policies:      len(lr.Policies),
+			NATs:          len(lr.Nat),
+			LoadBalancers: len(lr.LoadBalancer),
+		}
+		for _, portUUID := range lr.Ports {
+			port, ok := routerPortsByUUID[portUUID]
+			if !ok {
+				continue
+			}
+			routerPort := logicalRouterPort{Name: port.Name, MAC: port.MAC, Networks: port.Networks}
+			if port.Peer != nil {
+				routerPort.Peer = *port.Peer
+			}
+			router.Ports = append(router.Ports, routerPort)
+		}
+		sort.Slice(router.Ports, func(i, j int) bool { return router.Ports[i].Name < router.Ports[j].Name })
+		topology.LogicalRouters = append(topology.LogicalRouters, router)
+	}
+	sort.Slice(topology.LogicalSwitches, func(i, j int) bool {
+		return topology.LogicalSwitches[i].Name < topology.LogicalSwitches[j].Name
+	})
+	sort.Slice(topology.LogicalRouters, func(i, j int) bool {
+		return topology.LogicalRouters[i].Name < topology.LogicalRouters[j].Name
+	})
+	return topology, nil
+}
+
+// podACL is an ACL applied to a port of a pod
+type podACL struct {
+	UUID      string `json:"uuid"`
+	Name      string `json:"name,omitempty"`
+	Direction string `json:"direction"`
+	Priority  int    `json:"priority"`
+	Match     string `json:"match"`
+	Action    string `json:"action"`
+	Tier      int    `json:"tier"`
+	// AppliedThrough is the port group or logical switch the ACL is applied to
+	AppliedThrough string `json:"appliedThrough"`
+	// OwnerType, OwnerController, ObjectName and PrimaryID are read from the DbObjectIDs
+	// of the ACL
+	OwnerType       string            `json:"ownerType,omitempty"`
+	OwnerController string            `json:"ownerController,omitempty"`
+	ObjectName      string            `json:"objectName,omitempty"`
+	PrimaryID       string            `json:"primaryID,omitempty"`
+	ExternalIDs     map[string]string `json:"externalIDs,omitempty"`
+}
+
+type podPortACLs struct {
+	Port    string   `json:"port"`
+	UUID    string   `json:"uuid"`
+	Network string   `json:"network"`
+	NAD     string   `json:"nad,omitempty"`
+	Switch  string   `json:"switch,omitempty"`
+	ACLs    []podACL `json:"acls"`
+}
+
+func (t *troubleshooter) getPodACLs(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+	var args struct {
+		Namespace string `json:"namespace"`
+		Name      string `json:"name"`
+		NAD       string `json:"nad"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if args.Namespace == "" || args.Name == "" {
+		return nil, fmt.Errorf("namespace and name are required")
+	}
+	if t.nbClient == nil {
+		return nil, fmt.Errorf("no NB database configured")
+	}
+	ctx, cancel := context.WithTimeout(ctx, nbTimeout)
+	defer cancel()
+
+	// the ports of the pod on the default network and on the user defined networks
+	ports := []*nbdb.LogicalSwitchPort{}
+	if err := t.nbClient.WhereCache(func(lsp *nbdb.LogicalSwitchPort) bool {
+		if lsp.ExternalIDs["pod"] != "true" || lsp.ExternalIDs["namespace"] != args.Namespace {
+			return false
+		}
+		nad := lsp.ExternalIDs[types.NADExternalID]
+		if args.NAD != "" && nad != args.NAD {
+			return false
+		}
+		if nad == "" {
+			return lsp.Name == util.GetLogicalPortName(args.Namespace, args.Name)
+		}
+		return lsp.Name == util.GetUserDefinedNetworkLogicalPortName(args.Namespace, args.Name, nad)
+	}).List(ctx, &ports); err != nil {
+		return nil, fmt.Errorf("failed to list logical switch ports: %w", err)
+	}
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("no logical switch port found for pod %s/%s", args.Namespace, args.Name)
+	}
+	portUUIDs := sets.New[string]()
+	for _, port := range ports {
+		portUUIDs.Insert(port.UUID)
+	}
+
+	portGroups := []*nbdb.PortGroup{}
+	if err := t.nbClient.WhereCache(func(pg *nbdb.PortGroup) bool {
+		return portUUIDs.HasAny(pg.Ports...)
+	}).List(ctx, &portGroups); err != nil {
+		return nil, fmt.Errorf("failed to list port groups: %w", err)
+	}
+	switches := []*nbdb.LogicalSwitch{}
+	if err := t.nbClient.WhereCache(func(ls *nbdb.LogicalSwitch) bool {
+		return portUUIDs.HasAny(ls.Ports...)
+	}).List(ctx, &switches); err != nil {
+		return nil, fmt.Errorf("failed to list logical switches: %w", err)
+	}
+	acls := []*nbdb.ACL{}
+	if err := t.nbClient.List(ctx, &acls); err != nil {
+		return nil, fmt.Errorf("failed to list ACLs: %w", err)
+	}
+	aclsByUUID := map[string]*nbdb.ACL{}
+	for _, acl := range acls {
+		aclsByUUID[acl.UUID] = acl
+	}
+
+	result := []*podPortACLs{}
+	for _, port := range ports {
+		portACLs := &podPortACLs{
+			Port:    port.Name,
+			UUID:    port.UUID,
+			Network: port.ExternalIDs[types.NetworkExternalID],
+			NAD:     port.ExternalIDs[types.NADExternalID],
+			ACLs:    []podACL{},
+		}
+		if portACLs.Network == "" {
+			portACLs.Network = defaultNetworkName
+		}
+		for _, pg := range portGroups {
+			if !sets.New(pg.Ports...).Has(port.UUID) {
+				continue
+			}
+			for _, aclUUID := range pg.ACLs {
+				if acl, ok := aclsByUUID[aclUUID]; ok {
+					portACLs.ACLs = append(portACLs.ACLs, newPodACL(acl, "port group "+pg.Name))
+				}
+			}
+		}
+		for _, ls := range switches {
+			if !sets.New(ls.Ports...).Has(port.UUID) {
+				continue
+			}
+			portACLs.Switch = ls.Name
+			for _, aclUUID := range ls.ACLs {
+				if acl, ok := aclsByUUID[aclUUID]; ok {
+					portACLs.ACLs = append(portACLs.ACLs, newPodACL(acl, "logical switch "+ls.Name))
+				}
+			}
+		}
+		// in the order they are evaluated
+		sort.SliceStable(portACLs.ACLs, func(i, j int) bool {
+			a, b := portACLs.ACLs[i], portACLs.ACLs[j]
+			if a.Direction != b.Direction {
+				return a.Direction < b.Direction
+			}
+			if a.Tier != b.Tier {
+				return a.Tier < b.Tier
+			}
+			return a.Priority > b.Priority
+		})
+		result = append(result, portACLs)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Port < result[j].Port })
+	return result, nil
+}
+
+func newPodACL(acl *nbdb.ACL, appliedThrough string) podACL {
+	a := podACL{
+		UUID:            acl.UUID,
+		Direction:       acl.Direction,
+		Priority:        acl.Priority,
+		Match:           acl.Match,
+		Action:          acl.Action,
+		Tier:            acl.Tier,
+		AppliedThrough:  appliedThrough,
+		OwnerType:       acl.ExternalIDs[libovsdbops.OwnerTypeKey.String()],
+		OwnerController: acl.ExternalIDs[libovsdbops.OwnerControllerKey.String()],
+		ObjectName:      acl.ExternalIDs[libovsdbops.ObjectNameKey.String()],
+		PrimaryID:       acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()],
+		ExternalIDs:     acl.ExternalIDs,
+	}
+	if acl.Name != nil {
+		a.Name = *acl.Name
+	}
+	return a
+}
+
+func (t *troubleshooter) ovnTraceTool(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+	var args struct {
+		Datapath  string `json:"datapath"`
+		Microflow string `json:"microflow"`
+		Format    string `json:"format"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if args.Datapath == "" || args.Microflow == "" {
+		return nil, fmt.Errorf("datapath and microflow are required")
+	}
+	// prevent the arguments from being parsed as options
+	if strings.HasPrefix(args.Datapath, "-") || strings.HasPrefix(strings.TrimSpace(args.Microflow), "-") {
+		return nil, fmt.Errorf("datapath and microflow can't start with '-'")
+	}
+	switch args.Format {
+	case "":
+		args.Format = "detailed"
+	case "detailed", "summary", "minimal":
+	default:
+		return nil, fmt.Errorf("unsupported format %q, expected one of: detailed, summary, minimal", args.Format)
+	}
+	if t.sbAddress == "" {
+		return nil, fmt.Errorf("no SB database configured")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ovnTraceTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, t.ovnTrace, "--db="+t.sbAddress, "--"+args.Format, args.Datapath, args.Microflow)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("ovn-trace failed: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return struct {
+		Command string `json:"command"`
+		Output  string `json:"output"`
+	}{
+		Command: strings.Join(cmd.Args, " "),
+		Output:  stdout.String(),
+	}, nil
+}
+
+func (t *troubleshooter) listRetryState(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+	var args struct {
+		Name         string `json:"name"`
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if t.retry == nil {
+		return nil, fmt.Errorf("no retry state configured")
+	}
+	frameworks, err := t.retry.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []retry.RetryFrameworkStatus{}
+	for _, framework := range frameworks {
+		if (args.Name != "" && framework.Name != args.Name) || (args.ResourceType != "" && framework.ResourceType != args.ResourceType) {
+			continue
+		}
+		result = append(result, framework)
+	}
+	return result, nil
+}
+
+// statusManagedKinds are the kinds whose status is aggregated by the status manager,
+// along with the message reported by the zones that failed to apply them. Kinds
+// without a failure message report their per zone status through conditions.
+var statusManagedKinds = map[string]string{
+	"EgressFirewall":                types.EgressFirewallErrorMsg,
+	"AdminPolicyBasedExternalRoute": types.APBRouteErrorMsg,
+	"EgressQoS":                     "",
+	"NetworkQoS":                    "",
+	"MulticastGroup":                "",
+	"AdminNetworkPolicy":            "",
+	"BaselineAdminNetworkPolicy":    "",
+	"ClusterNetworkPolicy":          "",
+}
+
+type zoneStatus struct {
+	Zone    string `json:"zone"`
+	Ready   bool   `json:"ready"`
+	Message string `json:"message"`
+}
+
+type statusManagedObject struct {
+	Kind      string       `json:"kind"`
+	Namespace string       `json:"namespace,omitempty"`
+	Name      string       `json:"name"`
+	Status    string       `json:"status,omitempty"`
+	Zones     []zoneStatus `json:"zones"`
+}
+
+func (t *troubleshooter) listStatusManagerState(ctx context.Context, rawArgs json.RawMessage) (any, error) {
+	var args struct {
+		Kind       string `json:"kind"`
+		Namespace  string `json:"namespace"`
+		FailedOnly bool   `json:"failedOnly"`
+	}
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	kinds := sets.List(sets.KeySet(statusManagedKinds))
+	if args.Kind != "" {
+		if _, ok := statusManagedKinds[args.Kind]; !ok {
+			return nil, fmt.Errorf("unsupported kind %q, expected one of: %s", args.Kind, strings.Join(kinds, ", "))
+		}
+		kinds = []string{args.Kind}
+	}
+
+	result := []*statusManagedObject{}
+	for _, kind := range kinds {
+		objects, err := t.k8s.list(ctx, kind, args.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		for i := range objects {
+			obj := newStatusManagedObject(&objects[i], statusManagedKinds[kind])
+			if args.FailedOnly && !obj.failed() {
+				continue
+			}
+			result = append(result, obj)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Kind != result[j].Kind {
+			return result[i].Kind < result[j].Kind
+		}
+		return keyOf(result[i].Namespace, result[i].Name) < keyOf(result[j].Namespace, result[j].Name)
+	})
+	return result, nil
+}
+
+func newStatusManagedObject(obj *unstructured.Unstructured, errorMsg string) *statusManagedObject {
+	status := &statusManagedObject{
+		Kind:      obj.GetKind(),
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Zones:     []zoneStatus{},
+	}
+	status.Status, _, _ = unstructured.NestedString(obj.Object, "status", "status")
+	messages, _, _ := unstructured.NestedStringSlice(obj.Object, "status", "messages")
+	for _, message := range messages {
+		zone := types.GetZoneFromStatus(message)
+		status.Zones = append(status.Zones, zoneStatus{
+			Zone:    zone,
+			Ready:   errorMsg == "" || !strings.Contains(message, errorMsg),
+			Message: strings.TrimSpace(strings.TrimPrefix(message, zone+":")),
+		})
+	}
+	for _, c := range getConditions(obj) {
+		if !strings.HasPrefix(c.Type, readyInZonePrefix) {
+			continue
+		}
+		status.Zones = append(status.Zones, zoneStatus{
+			Zone:    strings.TrimPrefix(c.Type, readyInZonePrefix),
+			Ready:   c.Status == string(metav1.ConditionTrue),
+			Message: c.Message,
+		})
+	}
+	sort.Slice(status.Zones, func(i, j int) bool { return status.Zones[i].Zone < status.Zones[j].Zone })
+	return status
+}
+
+func (o *statusManagedObject) failed() bool {
+	for _, zone := range o.Zones {
+		if !zone.Ready {
+			return true
+		}
+	}
+	return false
+}
+
+func keyOf(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+const (
+	lsUUID   = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a01"
+	lspUUID  = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a02"
+	udnLSP   = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a03"
+	udnLS    = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a04"
+	pgUUID   = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a05"
+	acl1UUID = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a06"
+	acl2UUID = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a07"
+	acl3UUID = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a08"
+	lrUUID   = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a09"
+	lrpUUID  = "8c5d4d6e-0d4f-4a8e-9a3c-6a2f7c4b1a10"
+)
+
+func dbFileRecord(data string) string {
+	return fmt.Sprintf("OVSDB JSON %d 0000000000000000000000000000000000000000\n%s\n", len(data), data)
+}
+
+func testACL(priority int, match, owner string) string {
+	return fmt.Sprintf(`{"action":"allow-related","direction":"to-lport","priority":%d,"match":%q,"tier":2,`+
+		`"external_ids":["map",[["k8s.ovn.org/owner-type","NetworkPolicy"],["k8s.ovn.org/owner-controller","default-network-controller"],`+
+		`["k8s.ovn.org/name",%q],["k8s.ovn.org/id","default-network-controller:NetworkPolicy:%s"]]]}`, priority, match, owner, owner)
+}
+
+// writeNBDatabaseFile writes a NB database file with a pod on the default network and
+// on a user defined network, the last transaction is a difference
+func writeNBDatabaseFile(t *testing.T) string {
+	t.Helper()
+	nbFile := dbFileRecord(`{"name":"OVN_Northbound","version":"7.3.0","tables":{}}`) +
+		dbFileRecord(`{"Logical_Switch":{`+
+			`"`+lsUUID+`":{"name":"node1","ports":["set",[["uuid","`+lspUUID+`"]]],"acls":["uuid","`+acl2UUID+`"],`+
+			`"other_config":["map",[["subnet","10.244.0.0/24"]]]},`+
+			`"`+udnLS+`":{"name":"ns1_blue_node1","ports":["uuid","`+udnLSP+`"],`+
+			`"external_ids":["map",[["k8s.ovn.org/network","ns1_blue"],["k8s.ovn.org/topology","layer3"]]]}},`+
+			`"Logical_Switch_Port":{`+
+			`"`+lspUUID+`":{"name":"ns1_pod1","external_ids":["map",[["namespace","ns1"],["pod","true"]]]},`+
+			`"`+udnLSP+`":{"name":"ns1.blue_ns1_pod1","external_ids":["map",[["namespace","ns1"],["pod","true"],`+
+			`["k8s.ovn.org/network","ns1_blue"],["k8s.ovn.org/nad","ns1/blue"]]]}},`+
+			`"Port_Group":{"`+pgUUID+`":{"name":"pg1","ports":["uuid","`+lspUUID+`"],"acls":["uuid","`+acl1UUID+`"]}},`+
+			`"ACL":{"`+acl1UUID+`":`+testACL(1001, "ip4.src == 10.0.0.1", "ns1:allow")+`,`+
+			`"`+acl2UUID+`":`+testACL(1002, "ip4", "ns1:switch")+`,`+
+			`"`+acl3UUID+`":`+testACL(1003, "tcp", "ns1:later")+`},`+
+			`"Logical_Router":{"`+lrUUID+`":{"name":"ovn_cluster_router","ports":["uuid","`+lrpUUID+`"]}},`+
+			`"Logical_Router_Port":{"`+lrpUUID+`":{"name":"rtos-node1","mac":"0a:58:0a:f4:00:01","networks":"10.244.0.1/24"}},`+
+			`"_date":1700000000000}`) +
+		// pg1: add acl3 and remove acl1, ns1_pod1: change the external ids
+		dbFileRecord(`{"Port_Group":{"`+pgUUID+`":{"acls":["set",[["uuid","`+acl1UUID+`"],["uuid","`+acl3UUID+`"]]]}},`+
+			`"Logical_Switch_Port":{"`+lspUUID+`":{"external_ids":["map",[["foo","bar"]]]}},`+
+			`"ACL":{"`+acl1UUID+`":null},"_is_diff":true}`)
+	path := filepath.Join(t.TempDir(), "ovnnb_db.db")
+	if err := os.WriteFile(path, []byte(nbFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func writeK8sDump(t *testing.T) string {
+	t.Helper()
+	dump := `apiVersion: v1
+kind: List
+items:
+- apiVersion: k8s.ovn.org/v1
+  kind: UserDefinedNetwork
+  metadata:
+    namespace: ns1
+    name: blue
+  spec:
+    topology: Layer3
+    layer3:
+      role: Primary
+      subnets:
+      - cidr: 10.20.0.0/16
+        hostSubnet: 24
+  status:
+    conditions:
+    - type: NetworkCreated
+      status: "True"
+      reason: NetworkAttachmentDefinitionCreated
+- apiVersion: k8s.cni.cncf.io/v1
+  kind: NetworkAttachmentDefinition
+  metadata:
+    namespace: ns1
+    name: blue
+    ownerReferences:
+    - apiVersion: k8s.ovn.org/v1
+      kind: UserDefinedNetwork
+      name: blue
+      uid: 1234
+      controller: true
+  spec:
+    config: '{"cniVersion":"1.0.0","name":"ns1_blue","type":"ovn-k8s-cni-overlay","topology":"layer3","role":"primary","subnets":"10.20.0.0/16/24"}'
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: red
+spec:
+  network:
+    topology: Layer2
+    layer2:
+      role: Secondary
+      subnets: [10.100.0.0/16]
+---
+apiVersion: k8s.ovn.org/v1
+kind: EgressFirewall
+metadata:
+  namespace: ns1
+  name: default
+status:
+  status: EgressFirewall Rules not correctly applied
+  messages:
+  - "node1: EgressFirewall Rules applied"
+  - "node2: EgressFirewall Rules not correctly applied"
+---
+apiVersion: k8s.ovn.org/v1
+kind: EgressQoS
+metadata:
+  namespace: ns2
+  name: default
+status:
+  conditions:
+  - type: Ready-In-Zone-node1
+    status: "True"
+    message: EgressQoS Rules applied
+`
+	path := filepath.Join(t.TempDir(), "dump.yaml")
+	if err := os.WriteFile(path, []byte(dump), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// callTool runs the named tool with args and returns its result as generic JSON
+func callTool(g *gomega.WithT, ts *troubleshooter, name string, args string) any {
+	for _, tool := range ts.tools() {
+		if tool.name != name {
+			continue
+		}
+		result, err := tool.handler(context.Background(), json.RawMessage(args))
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		data, err := json.Marshal(result)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		var generic any
+		g.Expect(json.Unmarshal(data, &generic)).To(gomega.Succeed())
+		return generic
+	}
+	g.Expect(name).To(gomega.BeEmpty(), "unknown tool")
+	return nil
+}
+
+func TestTroubleshooterWithDumps(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ts, cleanup, err := newTroubleshooter(troubleshooterConfig{
+		k8sDump:  writeK8sDump(t),
+		nbDBFile: writeNBDatabaseFile(t),
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	defer cleanup()
+
+	g.Expect(callTool(g, ts, "list_user_defined_networks", `{}`)).To(gomega.HaveExactElements(
+		gomega.And(
+			gomega.HaveKeyWithValue("networkName", "cluster_udn_red"),
+			gomega.HaveKeyWithValue("role", "Secondary"),
+			gomega.HaveKeyWithValue("subnets", gomega.ConsistOf("10.100.0.0/16")),
+		),
+		gomega.And(
+			gomega.HaveKeyWithValue("networkName", "ns1_blue"),
+			gomega.HaveKeyWithValue("topology", "Layer3"),
+			gomega.HaveKeyWithValue("subnets", gomega.ConsistOf("10.20.0.0/16")),
+			gomega.HaveKeyWithValue("conditions", gomega.ConsistOf(gomega.HaveKeyWithValue("type", "NetworkCreated"))),
+		),
+	))
+
+	g.Expect(callTool(g, ts, "describe_user_defined_network", `{"namespace":"ns1","name":"blue"}`)).To(gomega.And(
+		gomega.HaveKeyWithValue("networkAttachmentDefinitions", gomega.ConsistOf(gomega.And(
+			gomega.HaveKeyWithValue("networkName", "ns1_blue"),
+			gomega.HaveKeyWithValue("owner", "UserDefinedNetwork ns1/blue"),
+			gomega.HaveKeyWithValue("subnets", gomega.ConsistOf("10.20.0.0/16/24")),
+		))),
+		gomega.HaveKeyWithValue("topology", gomega.HaveKeyWithValue("logicalSwitches", gomega.ConsistOf(gomega.And(
+			gomega.HaveKeyWithValue("name", "ns1_blue_node1"),
+			gomega.HaveKeyWithValue("podPorts", gomega.BeEquivalentTo(1)),
+		)))),
+	))
+
+	g.Expect(callTool(g, ts, "get_network_topology", `{"network":"default"}`)).To(gomega.And(
+		gomega.HaveKeyWithValue("logicalSwitches", gomega.ConsistOf(gomega.And(
+			gomega.HaveKeyWithValue("name", "node1"),
+			gomega.HaveKeyWithValue("otherConfig", gomega.HaveKeyWithValue("subnet", "10.244.0.0/24")),
+			gomega.HaveKeyWithValue("acls", gomega.BeEquivalentTo(1)),
+		))),
+		gomega.HaveKeyWithValue("logicalRouters", gomega.ConsistOf(gomega.HaveKeyWithValue("ports", gomega.ConsistOf(
+			gomega.HaveKeyWithValue("networks", gomega.ConsistOf("10.244.0.1/24")),
+		)))),
+	))
+
+	g.Expect(callTool(g, ts, "get_pod_acls", `{"namespace":"ns1","name":"pod1"}`)).To(gomega.HaveExactElements(
+		gomega.And(
+			gomega.HaveKeyWithValue("port", "ns1.blue_ns1_pod1"),
+			gomega.HaveKeyWithValue("network", "ns1_blue"),
+			gomega.HaveKeyWithValue("acls", gomega.BeEmpty()),
+		),
+		gomega.And(
+			gomega.HaveKeyWithValue("port", "ns1_pod1"),
+			gomega.HaveKeyWithValue("network", "default"),
+			gomega.HaveKeyWithValue("switch", "node1"),
+			gomega.HaveKeyWithValue("acls", gomega.HaveExactElements(
+				gomega.And(
+					gomega.HaveKeyWithValue("objectName", "ns1:later"),
+					gomega.HaveKeyWithValue("ownerType", "NetworkPolicy"),
+					gomega.HaveKeyWithValue("appliedThrough", "port group pg1"),
+				),
+				gomega.And(
+					gomega.HaveKeyWithValue("objectName", "ns1:switch"),
+					gomega.HaveKeyWithValue("appliedThrough", "logical switch node1"),
+				),
+			)),
+		),
+	))
+
+	g.Expect(callTool(g, ts, "list_status_manager_state", `{"failedOnly":true}`)).To(gomega.ConsistOf(gomega.And(
+		gomega.HaveKeyWithValue("kind", "EgressFirewall"),
+		gomega.HaveKeyWithValue("zones", gomega.HaveExactElements(
+			gomega.And(gomega.HaveKeyWithValue("zone", "node1"), gomega.HaveKeyWithValue("ready", true)),
+			gomega.And(gomega.HaveKeyWithValue("zone", "node2"), gomega.HaveKeyWithValue("ready", false),
+				gomega.HaveKeyWithValue("message", "EgressFirewall Rules not correctly applied")),
+		)),
+	)))
+	g.Expect(callTool(g, ts, "list_status_manager_state", `{"kind":"EgressQoS"}`)).To(gomega.ConsistOf(
+		gomega.HaveKeyWithValue("zones", gomega.ConsistOf(gomega.And(
+			gomega.HaveKeyWithValue("zone", "node1"),
+			gomega.HaveKeyWithValue("ready", true),
+		))),
+	))
+
+	// the tools whose source is not configured fail
+	for _, tool := range ts.tools() {
+		if tool.name != "ovn_trace" && tool.name != "list_retry_state" {
+			continue
+		}
+		_, err := tool.handler(context.Background(), json.RawMessage(`{"datapath":"node1","microflow":"ip4"}`))
+		g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("configured")), tool.name)
+	}
+}
+
+func TestListRetryState(t *testing.T) {
+	g := gomega.NewWithT(t)
+	path := filepath.Join(t.TempDir(), "retry.json")
+	g.Expect(os.WriteFile(path, []byte(`[
+		{"name":"default-network-controller","resourceType":"*v1.Pod","entries":[
+			{"key":"ns1/pod1","pendingAdd":true,"pendingDelete":false,"failedAttempts":3,"lastError":"boom","nextRetry":"2026-01-01T00:00:00Z"}]},
+		{"name":"default-network-controller","resourceType":"*v1.Namespace","entries":[]}
+	]`), 0644)).To(gomega.Succeed())
+	ts := &troubleshooter{retry: &dumpRetrySource{path: path}}
+
+	g.Expect(callTool(g, ts, "list_retry_state", `{"resourceType":"*v1.Pod"}`)).To(gomega.ConsistOf(
+		gomega.HaveKeyWithValue("entries", gomega.ConsistOf(gomega.And(
+			gomega.HaveKeyWithValue("key", "ns1/pod1"),
+			gomega.HaveKeyWithValue("lastError", "boom"),
+		))),
+	))
+	g.Expect(callTool(g, ts, "list_retry_state", `{}`)).To(gomega.HaveLen(2))
+}
@@ -34,9 +34,16 @@ func main() {
 	outputFile := flag.String("output-file", "", "Output file to write the samples to.")
 	filterSrcIP := flag.String("filter-src-ip", "", "Filter in only packets from a given source ip.")
 	filterDstIP := flag.String("filter-dst-ip", "", "Filter in only packets to a given destination ip.")
+	outputFormat := flag.String("output-format", string(observ.OutputFormatText), "Samples output format: text or json. json prints one object per sample.")
+	otlpEndpoint := flag.String("otlp-endpoint", "", "OTLP/HTTP collector endpoint, e.g. http://localhost:4318, to export samples as OpenTelemetry logs to.")
 	flag.Parse()
 
 	reader := observ.NewSampleReader(*enableDecoder, *logCookie, *printPacket, *addOVSCollector, *filterSrcIP, *filterDstIP, *outputFile)
+	if err := reader.SetOutputFormat(observ.OutputFormat(*outputFormat)); err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	reader.SetOTLPEndpoint(*otlpEndpoint)
 	err := reader.ReadSamples(ctx)
 	if err != nil {
 		fmt.Println(err.Error())
@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+
+	// Trace stage types.
+	ovnTraceStage     = "ovn-trace"
+	ofprotoTraceStage = "ofproto/trace"
+	ovnDetraceStage   = "ovn-detrace"
+
+	// Trace verdicts.
+	traceSuccess = "success"
+	traceFailure = "failure"
+	traceError   = "error"
+)
+
+var (
+	// traceReport collects the trace stages when the JSON output is requested, it is nil otherwise.
+	traceReport *traceResult
+
+	// ovn-trace logical flow lines, e.g.
+	//  9. ls_in_acl_eval (northd.c:7134): ip4 && outport == @a123, priority 2001, uuid 5f3b92c1
+	ovnTraceFlowRegex = regexp.MustCompile(`^\s*\d+\. (\S+)(?: \([^)]*\))?: (.*), priority (\d+), uuid ([0-9a-f]+)$`)
+	// stages where ACLs are evaluated, with and without tiers: ls_in_acl, ls_in_acl_eval, ls_out_acl_after_lb_eval, ...
+	ovnTraceACLStageRegex = regexp.MustCompile(`^ls_(in|out)_acl(_after_lb)?(_eval)?$`)
+	// ofproto/trace bridge and OpenFlow lines, e.g.
+	// bridge("br-int")
+	//  8. metadata=0x3, priority 50, cookie 0xde664d3a
+	ofprotoBridgeRegex = regexp.MustCompile(`^bridge\("(.+)"\)$`)
+	ofprotoFlowRegex   = regexp.MustCompile(`^\s*(\d+)\. (.*)$`)
+)
+
+// traceResult is the JSON report of a trace.
+type traceResult struct {
+	Source      string       `json:"source"`
+	Destination string       `json:"destination"`
+	Network     string       `json:"network"`
+	Protocol    string       `json:"protocol"`
+	DstPort     string       `json:"dstPort"`
+	Stages      []traceStage `json:"stages"`
+	// Verdict is the final verdict: success if all the stages succeeded, otherwise the verdict of the first stage
+	// that didn't.
+	Verdict string `json:"verdict"`
+}
+
+// traceStage is the result of a single trace command.
+type traceStage struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Command     string `json:"command"`
+	// Expected is the regular expression the command output must match for the stage to succeed.
+	Expected string `json:"expected,omitempty"`
+	Verdict  string `json:"verdict"`
+	Error    string `json:"error,omitempty"`
+	// ACLs are the logical flows of the ACL stages matched by the packet, only set for ovn-trace stages.
+	ACLs []traceACL `json:"acls,omitempty"`
+	// OpenFlowPath and DatapathActions are only set for ofproto/trace stages.
+	OpenFlowPath    []openFlowStep `json:"openFlowPath,omitempty"`
+	DatapathActions []string       `json:"datapathActions,omitempty"`
+	Output          string         `json:"output"`
+}
+
+// traceACL is a logical flow of an ACL stage matched in ovn-trace. ACL, Action and Owner are only set if the logical
+// flow was generated for a northbound ACL that could be found.
+type traceACL struct {
+	Stage       string         `json:"stage"`
+	Priority    int            `json:"priority"`
+	Match       string         `json:"match"`
+	LogicalFlow string         `json:"logicalFlow"`
+	ACL         string         `json:"acl,omitempty"`
+	Action      string         `json:"action,omitempty"`
+	Owner       *traceACLOwner `json:"owner,omitempty"`
+}
+
+// traceACLOwner is the object that an ACL was created for, e.g. a NetworkPolicy.
+type traceACLOwner struct {
+	Type       string `json:"type"`
+	Name       string `json:"name,omitempty"`
+	Controller string `json:"controller,omitempty"`
+}
+
+// openFlowStep is an OpenFlow rule matched in ofproto/trace.
+type openFlowStep struct {
+	Bridge string `json:"bridge"`
+	Table  int    `json:"table"`
+	Flow   string `json:"flow"`
+}
+
+// addStage adds the stage to the report. The report is printed and the program exits on failures.
+func (r *traceResult) addStage(stage *traceStage) {
+	r.Stages = append(r.Stages, *stage)
+	if stage.Verdict != traceSuccess {
+		r.Verdict = stage.Verdict
+		r.print()
+		os.Exit(-1)
+	}
+}
+
+// print writes the report to stdout.
+func (r *traceResult) print() {
+	if r.Verdict == "" {
+		r.Verdict = traceSuccess
+	}
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		klog.Exitf("Failed to encode the trace report: %v", err)
+	}
+	fmt.Println(string(b))
+}
+
+// getTraceACLs returns the ACL logical flows matched in the ovn-trace output, along with the northbound ACLs they
+// were generated for when these can be found. Only used for the JSON report.
+func getTraceACLs(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo, ovnTraceOutput string) []traceACL {
+	if traceReport == nil {
+		return nil
+	}
+	acls := parseOvnTraceACLs(ovnTraceOutput)
+	for i := range acls {
+		if err := setTraceACLOwner(coreclient, restconfig, ovnNamespace, podInfo, &acls[i]); err != nil {
+			klog.V(4).Infof("Could not find the ACL of logical flow %s: %v", acls[i].LogicalFlow, err)
+		}
+	}
+	return acls
+}
+
+// setTraceACLOwner looks up the northbound ACL of the logical flow through its stage hint, and sets the ACL action
+// and owner from it. Logical flows that weren't generated for an ACL don't have a stage hint.
+func setTraceACLOwner(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo, acl *traceACL) error {
+	cmd := fmt.Sprintf("ovn-sbctl %s --if-exists get Logical_Flow %s external_ids:stage-hint", podInfo.SbCommand, acl.LogicalFlow)
+	stdout, stderr, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, cmd, "")
+	if err != nil {
+		return fmt.Errorf("%q failed with %v: %s", cmd, err, stderr)
+	}
+	hint := strings.Trim(strings.TrimSpace(stdout), `"`)
+	if hint == "" {
+		return nil
+	}
+
+	cmd = fmt.Sprintf("ovn-nbctl %s get ACL %s action external_ids", podInfo.NbCommand, hint)
+	stdout, stderr, err = execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, cmd, "")
+	if err != nil {
+		return fmt.Errorf("%q failed with %v: %s", cmd, err, stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 {
+		return fmt.Errorf("unexpected output of %q: %s", cmd, stdout)
+	}
+	externalIDs, err := parseOVSDBMap(lines[1])
+	if err != nil {
+		return err
+	}
+	acl.ACL = hint
+	acl.Action = lines[0]
+	if ownerType := externalIDs[libovsdbops.OwnerTypeKey.String()]; ownerType != "" {
+		acl.Owner = &traceACLOwner{
+			Type:       ownerType,
+			Name:       externalIDs[libovsdbops.ObjectNameKey.String()],
+			Controller: externalIDs[libovsdbops.OwnerControllerKey.String()],
+		}
+	}
+	return nil
+}
+
+// parseOvnTraceACLs returns the logical flows of the ACL evaluation stages in the ovn-trace output.
+func parseOvnTraceACLs(ovnTraceOutput string) []traceACL {
+	var acls []traceACL
+	scanner := bufio.NewScanner(strings.NewReader(ovnTraceOutput))
+	for scanner.Scan() {
+		subMatches := ovnTraceFlowRegex.FindStringSubmatch(scanner.Text())
+		if subMatches == nil || !ovnTraceACLStageRegex.MatchString(subMatches[1]) {
+			continue
+		}
+		priority, err := strconv.Atoi(subMatches[3])
+		if err != nil {
+			continue
+		}
+		acls = append(acls, traceACL{
+			Stage:       subMatches[1],
+			Match:       subMatches[2],
+			Priority:    priority,
+			LogicalFlow: subMatches[4],
+		})
+	}
+	return acls
+}
+
+// parseOfprotoTrace returns the OpenFlow rules matched in the ofproto/trace output and its datapath actions.
+func parseOfprotoTrace(ofprotoTraceOutput string) ([]openFlowStep, []string) {
+	var steps []openFlowStep
+	var datapathActions []string
+	var bridge string
+	scanner := bufio.NewScanner(strings.NewReader(ofprotoTraceOutput))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if actions, found := strings.CutPrefix(line, "Datapath actions: "); found {
+			datapathActions = append(datapathActions, actions)
+			continue
+		}
+		if subMatches := ofprotoBridgeRegex.FindStringSubmatch(line); subMatches != nil {
+			bridge = subMatches[1]
+			continue
+		}
+		subMatches := ofprotoFlowRegex.FindStringSubmatch(line)
+		if subMatches == nil {
+			continue
+		}
+		table, err := strconv.Atoi(subMatches[1])
+		if err != nil {
+			continue
+		}
+		steps = append(steps, openFlowStep{Bridge: bridge, Table: table, Flow: subMatches[2]})
+	}
+	return steps, datapathActions
+}
+
+// parseOVSDBMap parses a map column as printed by the ovsdb ctl utilities, e.g. {a=b, "k8s.ovn.org/name"="x:y"}.
+func parseOVSDBMap(value string) (map[string]string, error) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "{") || !strings.HasSuffix(value, "}") {
+		return nil, fmt.Errorf("invalid map %q", value)
+	}
+	m := map[string]string{}
+	rest := value[1 : len(value)-1]
+	for rest != "" {
+		key, afterKey, err := parseOVSDBAtom(rest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid map %q: %w", value, err)
+		}
+		afterEqual, found := strings.CutPrefix(afterKey, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid map %q: missing value of key %q", value, key)
+		}
+		val, afterValue, err := parseOVSDBAtom(afterEqual)
+		if err != nil {
+			return nil, fmt.Errorf("invalid map %q: %w", value, err)
+		}
+		m[key] = val
+		if afterValue != "" && !strings.HasPrefix(afterValue, ", ") {
+			return nil, fmt.Errorf("invalid map %q: unexpected %q", value, afterValue)
+		}
+		rest = strings.TrimPrefix(afterValue, ", ")
+	}
+	return m, nil
+}
+
+// parseOVSDBAtom parses the string atom at the start of value and returns it along with the rest of value. Atoms
+// are quoted, with C style escapes, unless they only contain safe characters.
+func parseOVSDBAtom(value string) (string, string, error) {
+	if !strings.HasPrefix(value, `"`) {
+		end := strings.IndexAny(value, "=,")
+		if end < 0 {
+			return value, "", nil
+		}
+		return value[:end], value[end:], nil
+	}
+	for end := 1; end < len(value); end++ {
+		switch value[end] {
+		case '\\':
+			end++
+		case '"':
+			atom, err := strconv.Unquote(value[:end+1])
+			return atom, value[end+1:], err
+		}
+	}
+	return "", "", fmt.Errorf("unterminated string %s", value)
+}
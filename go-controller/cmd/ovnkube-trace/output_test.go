@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOvnTraceOutput = `# tcp,reg14=0x3,vlan_tci=0x0000,dl_src=0a:58:0a:f4:02:03,dl_dst=0a:58:0a:f4:02:01,nw_src=10.244.2.3,nw_dst=10.244.1.6,nw_tos=0,nw_ecn=0,nw_ttl=64,nw_frag=no,tp_src=52888,tp_dst=80
+
+ingress(dp="ovn-worker2", inport="default_client")
+--------------------------------------------------
+ 0. ls_in_check_port_sec (northd.c:8583): 1, priority 50, uuid de664d3a
+    reg0[15] = check_in_port_sec();
+    next;
+ 4. ls_in_pre_acl (northd.c:5991): ip, priority 100, uuid d9a60156
+    reg0[0] = 1;
+    next;
+ 7. ls_in_acl_hint (northd.c:6297): ct.new && !ct.est, priority 7, uuid 0b20013d
+    reg0[7] = 1;
+    next;
+ 8. ls_in_acl_eval (northd.c:6707): reg0[7] == 1 && (inport == @a4743249366342378346 && ip4), priority 2001, uuid 5f3b92c1
+    reg8[16] = 1;
+    next;
+19. ls_in_acl_after_lb_eval (northd.c:6707): reg0[7] == 1 && (ip4.dst == 10.244.1.6), priority 1001, uuid a22021af
+    next;
+
+egress(dp="ovn-worker2", inport="stor-ovn-worker2", outport="default_server")
+------------------------------------------------------------------------------
+ 4. ls_out_acl_eval (northd.c:6707): reg0[7] == 1 && (outport == @a10148 && ip4.src == $a5154718082306775057 && tcp && tcp.dst==80), priority 2001, uuid 71ab2e3f
+    reg8[16] = 1;
+    next;
+ 9. ls_out_check_port_sec (northd.c:8626): 1, priority 0, uuid 5c0d6f10
+    reg0[15] = check_out_port_sec();
+    next;
+10. ls_out_apply_port_sec (northd.c:8631): 1, priority 0, uuid 2a8c6a1e
+    output;
+    /* output to "default_server", type "" */
+`
+
+const testOfprotoTraceOutput = `Flow: tcp,in_port=7,vlan_tci=0x0000,dl_src=0a:58:0a:f4:02:03,dl_dst=0a:58:0a:f4:02:01,nw_src=10.244.2.3,nw_dst=10.244.1.6,nw_tos=0,nw_ecn=0,nw_ttl=64,nw_frag=no,tp_src=12345,tp_dst=80
+
+bridge("br-int")
+----------------
+ 0. in_port=7, priority 100, cookie 0x6c1d0b4a
+    set_field:0x3->metadata
+    resubmit(,8)
+ 8. metadata=0x3, priority 50, cookie 0xde664d3a
+    resubmit(,73)
+    73. ip,reg14=0x3,metadata=0x3,dl_src=0a:58:0a:f4:02:03,nw_src=10.244.2.3, priority 90, cookie 0x6c1d0b4a
+            set_field:0/0x1000->reg10
+    resubmit(,9)
+        40. reg15=0x4,metadata=0xff0003, priority 100, cookie 0xb6badb74
+            output:4
+             -> output to kernel tunnel
+
+Final flow: unchanged
+Megaflow: recirc_id=0,eth,tcp,in_port=7,nw_frag=no
+Datapath actions: ct(zone=19),recirc(0x12)
+
+===============================================================================
+recirc(0x12) - resume conntrack with default ct_state=trk|new (use --ct-next to customize)
+===============================================================================
+
+bridge("br-ex")
+---------------
+ 0. priority 0
+    NORMAL
+
+Final flow: unchanged
+Megaflow: recirc_id=0x12,eth,ip,in_port=7,nw_frag=no
+Datapath actions: set(tunnel(tun_id=0xff0003,dst=172.18.0.2)),5
+`
+
+func TestParseOvnTraceACLs(t *testing.T) {
+	acls := parseOvnTraceACLs(testOvnTraceOutput)
+	assert.Equal(t, []traceACL{
+		{
+			Stage:       "ls_in_acl_eval",
+			Priority:    2001,
+			Match:       "reg0[7] == 1 && (inport == @a4743249366342378346 && ip4)",
+			LogicalFlow: "5f3b92c1",
+		},
+		{
+			Stage:       "ls_in_acl_after_lb_eval",
+			Priority:    1001,
+			Match:       "reg0[7] == 1 && (ip4.dst == 10.244.1.6)",
+			LogicalFlow: "a22021af",
+		},
+		{
+			Stage:       "ls_out_acl_eval",
+			Priority:    2001,
+			Match:       "reg0[7] == 1 && (outport == @a10148 && ip4.src == $a5154718082306775057 && tcp && tcp.dst==80)",
+			LogicalFlow: "71ab2e3f",
+		},
+	}, acls)
+}
+
+func TestParseOfprotoTrace(t *testing.T) {
+	steps, datapathActions := parseOfprotoTrace(testOfprotoTraceOutput)
+	assert.Equal(t, []openFlowStep{
+		{Bridge: "br-int", Table: 0, Flow: "in_port=7, priority 100, cookie 0x6c1d0b4a"},
+		{Bridge: "br-int", Table: 8, Flow: "metadata=0x3, priority 50, cookie 0xde664d3a"},
+		{Bridge: "br-int", Table: 73, Flow: "ip,reg14=0x3,metadata=0x3,dl_src=0a:58:0a:f4:02:03,nw_src=10.244.2.3, priority 90, cookie 0x6c1d0b4a"},
+		{Bridge: "br-int", Table: 40, Flow: "reg15=0x4,metadata=0xff0003, priority 100, cookie 0xb6badb74"},
+		{Bridge: "br-ex", Table: 0, Flow: "priority 0"},
+	}, steps)
+	assert.Equal(t, []string{
+		"ct(zone=19),recirc(0x12)",
+		"set(tunnel(tun_id=0xff0003,dst=172.18.0.2)),5",
+	}, datapathActions)
+}
+
+func TestParseOVSDBMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected map[string]string
+		err      string
+	}{
+		{
+			name:     "empty map",
+			value:    "{}",
+			expected: map[string]string{},
+		},
+		{
+			name: "ACL external IDs",
+			value: `{direction=Ingress, "k8s.ovn.org/id"="default-network-controller:NetworkPolicy:ns1:allow-web:Ingress:0", ` +
+				`"k8s.ovn.org/name"="ns1:allow-web", "k8s.ovn.org/owner-controller"=default-network-controller, ` +
+				`"k8s.ovn.org/owner-type"=NetworkPolicy}` + "\n",
+			expected: map[string]string{
+				"direction":                    "Ingress",
+				"k8s.ovn.org/id":               "default-network-controller:NetworkPolicy:ns1:allow-web:Ingress:0",
+				"k8s.ovn.org/name":             "ns1:allow-web",
+				"k8s.ovn.org/owner-controller": "default-network-controller",
+				"k8s.ovn.org/owner-type":       "NetworkPolicy",
+			},
+		},
+		{
+			name:     "escaped strings",
+			value:    `{a="x \"y\", z", b="1"}`,
+			expected: map[string]string{"a": `x "y", z`, "b": "1"},
+		},
+		{
+			name:  "not a map",
+			value: `"5f3b92c1"`,
+			err:   "invalid map",
+		},
+		{
+			name:  "missing value",
+			value: `{a, b=c}`,
+			err:   `missing value of key "a"`,
+		},
+		{
+			name:  "unterminated string",
+			value: `{a="b}`,
+			err:   "unterminated string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := parseOVSDBMap(tt.value)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, m)
+		})
+	}
+}
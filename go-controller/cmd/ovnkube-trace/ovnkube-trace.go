@@ -18,6 +18,8 @@ import (
 	"strconv"
 	"strings"
 
+	nadclientset "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/clientset/versioned"
+
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -117,6 +119,12 @@ type PodInfo struct {
 	SslCertKeys          string // ssl cert keys string to access ovn nbdb/sbdb
 	NbCommand            string // contains subset of nb command string to execute on ovn nbdb
 	SbCommand            string // contains subset of sb command string to execute on ovn sbdb
+
+	Network            util.NetInfo `json:"-"` // the network that is traced
+	NADName            string       // NAD the pod is attached to the traced network with, "default" for the default network
+	LogicalPortName    string       // the pod's logical switch port on the traced network
+	LogicalSwitchName  string       // the logical switch the pod is connected to on the traced network
+	LocalnetBridgeName string       // OVS bridge the traced network is mapped to, only for localnet networks
 }
 
 // String returns a JSON representation of the SvcInfo object, or "" on failure.
@@ -259,9 +267,9 @@ func isRoutingViaHost(coreclient *corev1client.CoreV1Client, nodeName string) (b
 	return false, fmt.Errorf("could not determine gateway mode from annotations on node %s, unknown mode in l3GwConfig: %s", node.Name, defaultL3GwConfigParsed.Mode)
 }
 
-// getPodMAC returns the pod's MAC address.
-func getPodMAC(pod *corev1.Pod) (podMAC string, err error) {
-	podAnnotation, err := util.UnmarshalPodAnnotation(pod.ObjectMeta.Annotations, types.DefaultNetworkName)
+// getPodMAC returns the pod's MAC address on the network of the given NAD.
+func getPodMAC(pod *corev1.Pod, nadName string) (podMAC string, err error) {
+	podAnnotation, err := util.UnmarshalPodAnnotation(pod.ObjectMeta.Annotations, nadName)
 	if err != nil {
 		return "", err
 	}
@@ -347,7 +355,7 @@ func getPodOvsInterfaceNameAndOfport(coreclient *corev1client.CoreV1Client, rest
 }
 
 // getSvcInfo builds the SvcInfo object for this service. PodName/PodNamespace/PodIP are for the first valid endpoint pod that can be found for this service.
-func getSvcInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, svcName string, ovnNamespace string, namespace, addressFamily string) (svcInfo *SvcInfo, err error) {
+func getSvcInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, nadClient nadclientset.Interface, network util.NetInfo, svcName string, ovnNamespace string, namespace, addressFamily string) (svcInfo *SvcInfo, err error) {
 	// Get service with the name supplied by svcName
 	svc, err := coreclient.Services(namespace).Get(context.TODO(), svcName, metav1.GetOptions{})
 	if err != nil {
@@ -381,7 +389,7 @@ func getSvcInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 	}
 	klog.V(5).Infof("==> Got EndpointSlices %v for service %s in namespace %s\n", es, svcName, namespace)
 
-	err = extractEndpointSliceInfo(coreclient, restconfig, es.Items, svcInfo, ovnNamespace, addressFamily)
+	err = extractEndpointSliceInfo(coreclient, restconfig, nadClient, network, es.Items, svcInfo, ovnNamespace, addressFamily)
 	if err != nil {
 		return nil, err
 	}
@@ -392,7 +400,7 @@ func getSvcInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 // extractEndpointSliceInfo copies information from the endpoint slices into the SvcInfo object.
 // Modifies the svcInfo object the pointer of which is passed to it.
 // slice is *discoveryv1.EndpointSlice slices is
-func extractEndpointSliceInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, slices []discoveryv1.EndpointSlice, svcInfo *SvcInfo, ovnNamespace, addressFamily string) error {
+func extractEndpointSliceInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, nadClient nadclientset.Interface, network util.NetInfo, slices []discoveryv1.EndpointSlice, svcInfo *SvcInfo, ovnNamespace, addressFamily string) error {
 
 	for _, slice := range slices {
 		klog.V(5).Infof("==> Trying to extract information for service %s in namespace %s from slice %v",
@@ -436,7 +444,7 @@ func extractEndpointSliceInfo(coreclient *corev1client.CoreV1Client, restconfig
 			}
 
 			// Get info needed for the src Pod
-			svcPodInfo, err := getPodInfo(coreclient, restconfig, endpoint.TargetRef.Name, ovnNamespace, endpoint.TargetRef.Namespace, addressFamily)
+			svcPodInfo, err := getPodInfo(coreclient, restconfig, nadClient, network, endpoint.TargetRef.Name, ovnNamespace, endpoint.TargetRef.Namespace, addressFamily)
 			if err != nil {
 				klog.Exitf("Failed to get information from pod %s: %v", endpoint.TargetRef.Name, err)
 			}
@@ -454,8 +462,8 @@ func extractEndpointSliceInfo(coreclient *corev1client.CoreV1Client, restconfig
 	return fmt.Errorf("could not extract pod and port information from endpointslice for service %s in namespace %s", svcInfo.SvcName, svcInfo.SvcNamespace)
 }
 
-// getPodInfo returns a pointer to a fully populated PodInfo struct, or error on failure.
-func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, podName string, ovnNamespace string, namespace, addressFamily string) (podInfo *PodInfo, err error) {
+// getPodInfo returns a pointer to a fully populated PodInfo struct for the given network, or error on failure.
+func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, nadClient nadclientset.Interface, network util.NetInfo, podName string, ovnNamespace string, namespace, addressFamily string) (podInfo *PodInfo, err error) {
 	// Create a PodInfo object with the base information already added, such as
 	// IP, PodName, ContainerName, NodeName, HostNetwork, Namespace, PrimaryInterfaceName
 	pod, err := coreclient.Pods(namespace).Get(context.TODO(), podName, metav1.GetOptions{})
@@ -463,8 +471,16 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 		klog.V(1).Infof("Pod %s in namespace %s not found\n", podName, namespace)
 		return nil, err
 	}
+	if pod.Spec.HostNetwork && !network.IsDefault() {
+		return nil, fmt.Errorf("host networked pod %s in namespace %s is not attached to network %s", podName, namespace, network.GetNetworkName())
+	}
+
+	nadName, err := getPodNADName(nadClient, pod, network)
+	if err != nil {
+		return nil, err
+	}
 
-	podIP, err := getDesiredPodIP(pod, addressFamily)
+	podIP, err := getDesiredPodIP(pod, nadName, addressFamily)
 	if err != nil {
 		klog.V(1).Infof("Pod %s in namespace %s doesn't have desired ip address configured\n", podName, namespace)
 		return nil, err
@@ -477,8 +493,15 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 		ContainerName: pod.Spec.Containers[0].Name,
 		HostNetwork:   pod.Spec.HostNetwork,
 		PodNamespace:  pod.Namespace,
+		Network:       network,
+		NADName:       nadName,
 	}
 	podInfo.NodeName = pod.Spec.NodeName
+	podInfo.LogicalSwitchName = getLogicalSwitchName(network, podInfo.NodeName)
+	podInfo.LogicalPortName = podInfo.FullyQualifiedPodName()
+	if !network.IsDefault() {
+		podInfo.LogicalPortName = util.GetUserDefinedNetworkLogicalPortName(pod.Namespace, pod.Name, nadName)
+	}
 
 	// Get the pod's ovnkubePod.
 	podInfo.OvnKubePodName, err = getOvnKubePodOnNode(coreclient, ovnNamespace, podInfo.NodeName)
@@ -510,7 +533,7 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 		localOutput = strings.ReplaceAll(localOutput, "\n", "")
 		podInfo.MAC = strings.ReplaceAll(localOutput, "\"", "")
 	} else {
-		podInfo.MAC, err = getPodMAC(pod)
+		podInfo.MAC, err = getPodMAC(pod, nadName)
 		if err != nil {
 			klog.V(1).Infof("Problem obtaining Ethernet address of Pod %s in namespace %s\n", podName, namespace)
 			return nil, err
@@ -518,26 +541,36 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 	}
 
 	// Find rtos MAC (this is the pod's first hop router).
-	podInfo.RtosMAC, err = getRouterPortMacAddress(coreclient, restconfig, podInfo, ovnNamespace, types.RouterToSwitchPrefix)
-	if err != nil {
-		return nil, err
+	if networkHasRouter(network) {
+		podInfo.RtosMAC, err = getRouterPortMacAddress(coreclient, restconfig, podInfo, ovnNamespace, network.GetNetworkScopedRouterToSwitchPortName(podInfo.NodeName))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Find rtots MAC (this is the pod's first hop router for the interconnected zone).
-	podInfo.RtotsMAC, err = getRouterPortMacAddress(coreclient, restconfig, podInfo, ovnNamespace, types.RouterToTransitSwitchPrefix)
-	if err != nil {
-		return nil, err
+	// Layer2 networks span zones with a single switch and don't have such a router port.
+	if network.TopologyType() == types.Layer3Topology {
+		podInfo.RtotsMAC, err = getRouterPortMacAddress(coreclient, restconfig, podInfo, ovnNamespace, network.GetNetworkScopedName(types.RouterToTransitSwitchPrefix+podInfo.NodeName))
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Set information specific to ovn-k8s-mp0. This info is required for routingViaHost gateway mode traffic to an external IP
-	// destination.
-	podInfo.OvnK8sMp0PortName = types.K8sMgmtIntfName
-	portCmd := fmt.Sprintf("ovs-vsctl get Interface %s ofport", podInfo.OvnK8sMp0PortName)
-	localOutput, localError, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, portCmd, "")
-	if err != nil {
-		return nil, fmt.Errorf("execInPod() failed. err: %s, stderr: %s, stdout: %s, podInfo: %v", err, localError, localOutput, podInfo)
+	// Set information specific to ovn-k8s-mp0, or the management port of the primary user defined network. This
+	// info is required for routingViaHost gateway mode traffic to an external IP destination.
+	if network.IsDefault() || network.IsPrimaryNetwork() {
+		podInfo.OvnK8sMp0PortName = types.K8sMgmtIntfName
+		if !network.IsDefault() {
+			podInfo.OvnK8sMp0PortName = util.GetNetworkScopedK8sMgmtHostIntfName(uint(network.GetNetworkID()))
+		}
+		portCmd := fmt.Sprintf("ovs-vsctl get Interface %s ofport", podInfo.OvnK8sMp0PortName)
+		localOutput, localError, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, portCmd, "")
+		if err != nil {
+			return nil, fmt.Errorf("execInPod() failed. err: %s, stderr: %s, stdout: %s, podInfo: %v", err, localError, localOutput, podInfo)
+		}
+		podInfo.OvnK8sMp0OfportNum = strings.Replace(localOutput, "\n", "", -1)
 	}
-	podInfo.OvnK8sMp0OfportNum = strings.Replace(localOutput, "\n", "", -1)
 
 	// Set information specific to host networked pods or non-host networked pods.
 	if podInfo.HostNetwork {
@@ -547,7 +580,7 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 		podInfo.OfportNum = podInfo.OvnK8sMp0OfportNum
 	} else {
 		// Get the pod's interface information
-		ovsInterfaceInformation, err := getPodOvsInterfaceNameAndOfport(coreclient, restconfig, podInfo, ovnNamespace, podInfo.FullyQualifiedPodName())
+		ovsInterfaceInformation, err := getPodOvsInterfaceNameAndOfport(coreclient, restconfig, podInfo, ovnNamespace, podInfo.LogicalPortName)
 		if err != nil {
 			return nil, err
 		}
@@ -561,11 +594,18 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 		return nil, err
 	}
 
+	if network.TopologyType() == types.LocalnetTopology {
+		podInfo.LocalnetBridgeName, err = getLocalnetBridgeName(coreclient, restconfig, ovnNamespace, podInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return podInfo, err
 }
 
-func getRouterPortMacAddress(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace, portPrefix string) (string, error) {
-	tspCmd := "ovn-sbctl " + podInfo.SbCommand + " --bare --no-heading --column=mac list Port_Binding " + portPrefix + podInfo.NodeName
+func getRouterPortMacAddress(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace, portName string) (string, error) {
+	tspCmd := "ovn-sbctl " + podInfo.SbCommand + " --bare --no-heading --column=mac list Port_Binding " + portName
 	ipOutput, ipError, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, tspCmd, "")
 	if err != nil {
 		return "", fmt.Errorf("execInPod() failed. err: %s, stderr: %s, stdout: %s, podInfo: %v", err, ipError, ipOutput, podInfo)
@@ -699,41 +739,63 @@ func getDatabaseURIs(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 	return podInfo, nil
 }
 
-// printSuccessOrFailure will print a success or failure message. If searchString is set, then we expect to find a match for the
-// regexp given in searchString.
-func printSuccessOrFailure(commandDescription, src, dst, commandStdout, commandStderr string, err error, searchString string) {
+// printSuccessOrFailure will print a success or failure message for the trace stage, or add the stage to the JSON
+// report. If stage.Expected is set, then we expect to find a match for the regexp given in it in the command output.
+func printSuccessOrFailure(stage *traceStage, commandStdout, commandStderr string, err error) {
+	stage.Output = commandStdout
+	if traceReport != nil && stage.Type == ofprotoTraceStage {
+		stage.OpenFlowPath, stage.DatapathActions = parseOfprotoTrace(commandStdout)
+	}
 	if err != nil {
-		klog.Exitf("%s error %v stdOut: %s\n stdErr: %s", commandDescription, err, commandStdout, commandStderr)
+		if traceReport != nil {
+			klog.Errorf("%s error %v stdOut: %s\n stdErr: %s", stage.Name, err, commandStdout, commandStderr)
+			stage.Verdict = traceError
+			stage.Error = fmt.Sprintf("%v: %s", err, commandStderr)
+			traceReport.addStage(stage)
+		}
+		klog.Exitf("%s error %v stdOut: %s\n stdErr: %s", stage.Name, err, commandStdout, commandStderr)
 	}
-	klog.V(2).Infof("%s Output:\n%s%s%s\n", commandDescription, italic, commandStdout, reset)
+	klog.V(2).Infof("%s Output:\n%s%s%s\n", stage.Name, italic, commandStdout, reset)
 
-	if searchString != "" {
-		match, err := regexp.MatchString(searchString, commandStdout)
+	stage.Verdict = traceSuccess
+	if stage.Expected != "" {
+		match, err := regexp.MatchString(stage.Expected, commandStdout)
 		if err != nil {
-			klog.Exitf("Unexpected failure matching regex '%s' to commandStdout '%s', err: %s", searchString, commandStdout, err)
+			klog.Exitf("Unexpected failure matching regex '%s' to commandStdout '%s', err: %s", stage.Expected, commandStdout, err)
 		}
-		if match {
-			// Write the result to stdout.
-			fmt.Printf("%s%s%s indicates success from %s to %s%s\n", green, bold, commandDescription, src, dst, reset)
-			// Log further info on log level 1.
-			klog.V(1).Infof("%sSearch string matched:\n%s%s\n", green, searchString, reset)
-		} else {
-			// Write the result to stdout.
-			fmt.Printf("%s%s%s indicates failure from %s to %s%s\n", red, bold, commandDescription, src, dst, reset)
-			// Log further info on log level 1.
-			klog.V(1).Infof("%sSearch string not matched:\n%s%s\n", red, searchString, reset)
-			os.Exit(-1)
+		if !match {
+			stage.Verdict = traceFailure
 		}
-	} else {
+	}
+
+	if traceReport == nil {
 		// Write the result to stdout.
-		fmt.Printf("%s%s%s indicates success from %s to %s%s\n", green, bold, commandDescription, src, dst, reset)
+		if stage.Verdict == traceSuccess {
+			fmt.Printf("%s%s%s indicates success from %s to %s%s\n", green, bold, stage.Name, stage.Source, stage.Destination, reset)
+		} else {
+			fmt.Printf("%s%s%s indicates failure from %s to %s%s\n", red, bold, stage.Name, stage.Source, stage.Destination, reset)
+		}
+	}
+	// Log further info on log level 1.
+	if stage.Expected != "" {
+		if stage.Verdict == traceSuccess {
+			klog.V(1).Infof("%sSearch string matched:\n%s%s\n", green, stage.Expected, reset)
+		} else {
+			klog.V(1).Infof("%sSearch string not matched:\n%s%s\n", red, stage.Expected, reset)
+		}
+	}
+
+	if traceReport != nil {
+		traceReport.addStage(stage)
+	} else if stage.Verdict != traceSuccess {
+		os.Exit(-1)
 	}
 }
 
 // runOvnTraceToService runs an ovntrace from src pod to dst service. If dstSvcInfo == nil, then skip all steps.
 func runOvnTraceToService(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, srcPodInfo *PodInfo, dstSvcInfo *SvcInfo, ovnNamespace, protocol, dstPort string) {
 	var inport string
-	inport = srcPodInfo.FullyQualifiedPodName()
+	inport = srcPodInfo.LogicalPortName
 	if srcPodInfo.HostNetwork {
 		inport = srcPodInfo.K8sNodeNamePort
 	}
@@ -744,38 +806,50 @@ func runOvnTraceToService(coreclient *corev1client.CoreV1Client, restconfig *res
 	}
 	cmd := fmt.Sprintf(`ovn-trace %[1]s %[2]s --ct=new `+
 		`'inport=="%[3]s" && eth.src==%[4]s && eth.dst==%[5]s && %[6]s.src==%[7]s && %[8]s.dst==%[9]s && ip.ttl==64 && %[10]s.dst==%[11]s && %[10]s.src==52888' --lb-dst %[12]s:%[13]s`,
-		srcPodInfo.SbCommand,  // 1
-		srcPodInfo.NodeName,   // 2
-		inport,                // 3
-		srcPodInfo.MAC,        // 4
-		srcPodInfo.RtosMAC,    // 5
-		srcPodInfo.IPVer,      // 6
-		srcPodInfo.IP,         // 7
-		svcL3Ver,              // 8
-		dstSvcInfo.ClusterIP,  // 9
-		protocol,              // 10
-		dstPort,               // 11
-		dstSvcInfo.PodInfo.IP, // 12
-		dstSvcInfo.PodPort,    // 13
+		srcPodInfo.SbCommand,         // 1
+		srcPodInfo.LogicalSwitchName, // 2
+		inport,                       // 3
+		srcPodInfo.MAC,               // 4
+		srcPodInfo.RtosMAC,           // 5
+		srcPodInfo.IPVer,             // 6
+		srcPodInfo.IP,                // 7
+		svcL3Ver,                     // 8
+		dstSvcInfo.ClusterIP,         // 9
+		protocol,                     // 10
+		dstPort,                      // 11
+		dstSvcInfo.PodInfo.IP,        // 12
+		dstSvcInfo.PodPort,           // 13
 	)
 	klog.V(4).Infof("ovn-trace command from src to service clusterIP is %s", cmd)
 
 	ovnSrcDstOut, ovnSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
 	var successString string
 	if podsInSameInterconnectZone(srcPodInfo, dstSvcInfo.PodInfo) {
-		successString = fmt.Sprintf(`output to "%s"`, dstSvcInfo.FullyQualifiedPodName())
+		successString = fmt.Sprintf(`output to "%s"`, dstSvcInfo.PodInfo.LogicalPortName)
 	} else {
-		successString = fmt.Sprintf(`output to "tstor-%s"`, dstSvcInfo.PodInfo.NodeName)
+		successString = fmt.Sprintf(`output to "%s"`, getRemoteZoneEgressPort(srcPodInfo, dstSvcInfo.PodInfo))
 	}
 	direction := "source pod to service clusterIP"
-	printSuccessOrFailure("ovn-trace "+direction, srcPodInfo.PodName, dstSvcInfo.SvcName, ovnSrcDstOut, ovnSrcDstErr, err, successString)
+	stage := &traceStage{
+		Type:        ovnTraceStage,
+		Name:        "ovn-trace " + direction,
+		Source:      srcPodInfo.PodName,
+		Destination: dstSvcInfo.SvcName,
+		Command:     cmd,
+		Expected:    successString,
+	}
+	if err == nil {
+		stage.ACLs = getTraceACLs(coreclient, restconfig, ovnNamespace, srcPodInfo, ovnSrcDstOut)
+	}
+	printSuccessOrFailure(stage, ovnSrcDstOut, ovnSrcDstErr, err)
 	runOvnTraceToRemotePod(coreclient, restconfig, direction, srcPodInfo, dstSvcInfo.PodInfo, ovnNamespace, protocol, dstPort)
 
 }
 
-// runOvnTraceToIP runs an ovntrace from src pod to dst IP address (should be external to the cluster).
+// runOvnTraceToIP runs an ovntrace from src pod to dst IP address (should be external to the cluster, or a node).
+// dstName describes the destination in the output.
 // Returns the node that the trace will exit on.
-func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, srcPodInfo *PodInfo, parsedDstIP net.IP, ovnNamespace, protocol, dstPort string) (string, string) {
+func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, srcPodInfo *PodInfo, parsedDstIP net.IP, dstName, ovnNamespace, protocol, dstPort string) (string, string) {
 	if srcPodInfo.HostNetwork {
 		klog.Exitf("Pod cannot be on Host Network when tracing to an IP address; use ping\n")
 	}
@@ -789,28 +863,42 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 
 	cmd := fmt.Sprintf(`ovn-trace %[1]s %[2]s `+
 		`'inport=="%[3]s" && eth.src==%[4]s && eth.dst==%[5]s && %[6]s.src==%[7]s && %[8]s.dst==%[9]s && ip.ttl==64 && %[10]s.dst==%[11]s && %[10]s.src==52888'`,
-		srcPodInfo.SbCommand,               // 1
-		srcPodInfo.NodeName,                // 2
-		srcPodInfo.FullyQualifiedPodName(), // 3
-		srcPodInfo.MAC,                     // 4
-		srcPodInfo.RtosMAC,                 // 5
-		l3ver,                              // 6
-		srcPodInfo.IP,                      // 7
-		l3ver,                              // 8
-		parsedDstIP,                        // 9
-		protocol,                           // 10
-		dstPort,                            // 11
+		srcPodInfo.SbCommand,         // 1
+		srcPodInfo.LogicalSwitchName, // 2
+		srcPodInfo.LogicalPortName,   // 3
+		srcPodInfo.MAC,               // 4
+		srcPodInfo.RtosMAC,           // 5
+		l3ver,                        // 6
+		srcPodInfo.IP,                // 7
+		l3ver,                        // 8
+		parsedDstIP,                  // 9
+		protocol,                     // 10
+		dstPort,                      // 11
 	)
 	klog.V(4).Infof("ovn-trace command from pod to IP is %s", cmd)
 
+	// The names of the logical ports of user defined networks are prefixed with the network name.
+	networkPrefix := regexp.QuoteMeta(srcPodInfo.Network.GetNetworkScopedName(""))
 	// This is different depending on:
 	// a) if this is routingViaHost gateway mode, output to "k8s-<nodename>"
 	// b) for routingViaHost gateway egressip and routingViaOVN gateway mode, go out of <bridge name>_<node name>
 	// c) when interconnect enabled and egressip available for the pod, then go out of tstor-<egress-node> with type "remote".
-	successString := fmt.Sprintf(`output to "(.*)_(.*)", type "localnet"|output to "k8s-%s"|remote`, srcPodInfo.NodeName)
+	localnetPortRegex := fmt.Sprintf(`output to "(.*)_%s(.*)", type "localnet"`, networkPrefix)
+	successString := fmt.Sprintf(`%s|output to "%s"|remote`, localnetPortRegex, regexp.QuoteMeta(srcPodInfo.Network.GetNetworkScopedK8sMgmtIntfName(srcPodInfo.NodeName)))
 	// Run the command and check if succesString was found.
 	ovnSrcDstOut, ovnSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
-	printSuccessOrFailure("ovn-trace from pod to IP", srcPodInfo.PodName, parsedDstIP.String(), ovnSrcDstOut, ovnSrcDstErr, err, successString)
+	stage := &traceStage{
+		Type:        ovnTraceStage,
+		Name:        "ovn-trace from pod to IP",
+		Source:      srcPodInfo.PodName,
+		Destination: dstName,
+		Command:     cmd,
+		Expected:    successString,
+	}
+	if err == nil {
+		stage.ACLs = getTraceACLs(coreclient, restconfig, ovnNamespace, srcPodInfo, ovnSrcDstOut)
+	}
+	printSuccessOrFailure(stage, ovnSrcDstOut, ovnSrcDstErr, err)
 
 	// Print some additional information about the node where this request leaves from as well
 	// as the SNAT IP address.
@@ -820,7 +908,7 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 	if len(subMatches) >= 2 {
 		klog.V(5).Infof("Could find SNAT for this trace command, this must be routingViaOVN gateway mode, any mode with EgressIP or any mode with EgressGW.")
 		snat := subMatches[len(subMatches)-1]
-		re = regexp.MustCompile(successString)
+		re = regexp.MustCompile(localnetPortRegex)
 		subMatches = re.FindSubmatch([]byte(ovnSrcDstOut))
 		// We should never hit this (printSuccessOrFailure checks the same already above).
 		if len(subMatches) < 3 {
@@ -834,7 +922,7 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 	}
 
 	// Try to find egress node name when ovnSrcDstOut contains "output to tstor-<egress-node>"".
-	nodeNameRegex := `output to "tstor-(.*)",`
+	nodeNameRegex := fmt.Sprintf(`output to "%s%s(.*)",`, networkPrefix, types.TransitSwitchToRouterPrefix)
 	re = regexp.MustCompile(nodeNameRegex)
 	subMatches = re.FindSubmatch([]byte(ovnSrcDstOut))
 	if len(subMatches) > 1 {
@@ -844,7 +932,7 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 	}
 
 	klog.V(5).Infof("Could not find SNAT for this trace command, this must be routingViaHost gateway mode without EgressIP.")
-	nodeNameRegex = `output to "k8s-(.*)",`
+	nodeNameRegex = fmt.Sprintf(`output to "%s%s(.*)",`, types.K8sPrefix, networkPrefix)
 	re = regexp.MustCompile(nodeNameRegex)
 	subMatches = re.FindSubmatch([]byte(ovnSrcDstOut))
 	if len(subMatches) < 2 {
@@ -858,23 +946,23 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 // runOvnTraceToPod runs an ovntrace from src pod to dst pod.
 func runOvnTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) {
 	var inport string
-	inport = srcPodInfo.FullyQualifiedPodName()
+	inport = srcPodInfo.LogicalPortName
 	if srcPodInfo.HostNetwork {
 		inport = srcPodInfo.K8sNodeNamePort
 	}
 	cmd := fmt.Sprintf(`ovn-trace %[1]s %[2]s `+
 		`'inport=="%[3]s" && eth.src==%[4]s && eth.dst==%[5]s && %[6]s.src==%[7]s && %[8]s.dst==%[9]s && ip.ttl==64 && %[10]s.dst==%[11]s && %[10]s.src==52888'`,
-		srcPodInfo.SbCommand, // 1
-		srcPodInfo.NodeName,  // 2
-		inport,               // 3
-		srcPodInfo.MAC,       // 4
-		srcPodInfo.RtosMAC,   // 5
-		srcPodInfo.IPVer,     // 6
-		srcPodInfo.IP,        // 7
-		dstPodInfo.IPVer,     // 8
-		dstPodInfo.IP,        // 9
-		protocol,             // 10
-		dstPort,              // 11
+		srcPodInfo.SbCommand,                  // 1
+		srcPodInfo.LogicalSwitchName,          // 2
+		inport,                                // 3
+		srcPodInfo.MAC,                        // 4
+		getNextHopMAC(srcPodInfo, dstPodInfo), // 5
+		srcPodInfo.IPVer,                      // 6
+		srcPodInfo.IP,                         // 7
+		dstPodInfo.IPVer,                      // 8
+		dstPodInfo.IP,                         // 9
+		protocol,                              // 10
+		dstPort,                               // 11
 	)
 	klog.V(4).Infof("ovn-trace command from %s is %s", direction, cmd)
 
@@ -889,12 +977,23 @@ func runOvnTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Co
 			successString = fmt.Sprintf(`output to "%s_%s"`, srcPodInfo.NodeExternalBridgeName, srcPodInfo.NodeName)
 		}
 	} else if podsInSameInterconnectZone(srcPodInfo, dstPodInfo) {
-		successString = fmt.Sprintf(`output to "%s"`, dstPodInfo.FullyQualifiedPodName())
+		successString = fmt.Sprintf(`output to "%s"`, dstPodInfo.LogicalPortName)
 	} else {
-		successString = fmt.Sprintf(`output to "tstor-%s"`, dstPodInfo.NodeName)
+		successString = fmt.Sprintf(`output to "%s"`, getRemoteZoneEgressPort(srcPodInfo, dstPodInfo))
 	}
 	ovnSrcDstOut, ovnSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
-	printSuccessOrFailure("ovn-trace "+direction, srcPodInfo.PodName, dstPodInfo.PodName, ovnSrcDstOut, ovnSrcDstErr, err, successString)
+	stage := &traceStage{
+		Type:        ovnTraceStage,
+		Name:        "ovn-trace " + direction,
+		Source:      srcPodInfo.PodName,
+		Destination: dstPodInfo.PodName,
+		Command:     cmd,
+		Expected:    successString,
+	}
+	if err == nil {
+		stage.ACLs = getTraceACLs(coreclient, restconfig, ovnNamespace, srcPodInfo, ovnSrcDstOut)
+	}
+	printSuccessOrFailure(stage, ovnSrcDstOut, ovnSrcDstErr, err)
 	runOvnTraceToRemotePod(coreclient, restconfig, direction, srcPodInfo, dstPodInfo, ovnNamespace, protocol, dstPort)
 }
 
@@ -902,45 +1001,94 @@ func runOvnTraceToRemotePod(coreclient *corev1client.CoreV1Client, restconfig *r
 	if dstPodInfo.HostNetwork || podsInSameInterconnectZone(srcPodInfo, dstPodInfo) {
 		return
 	}
+	inport, ethDst := getRemoteZoneIngress(srcPodInfo, dstPodInfo)
 	cmd := fmt.Sprintf(`ovn-trace %[1]s `+
 		`'inport=="%[2]s" && eth.src==%[3]s && eth.dst==%[4]s && %[5]s.src==%[6]s && %[7]s.dst==%[8]s && ip.ttl==64 && %[9]s.dst==%[10]s && %[9]s.src==52888'`,
 		dstPodInfo.SbCommand, // 1
-		types.TransitSwitchToRouterPrefix+srcPodInfo.NodeName, // 2
-		srcPodInfo.MAC,      // 3
-		dstPodInfo.RtotsMAC, // 4
-		srcPodInfo.IPVer,    // 5
-		srcPodInfo.IP,       // 6
-		dstPodInfo.IPVer,    // 7
-		dstPodInfo.IP,       // 8
-		protocol,            // 9
-		dstPort,             // 10
+		inport,               // 2
+		srcPodInfo.MAC,       // 3
+		ethDst,               // 4
+		srcPodInfo.IPVer,     // 5
+		srcPodInfo.IP,        // 6
+		dstPodInfo.IPVer,     // 7
+		dstPodInfo.IP,        // 8
+		protocol,             // 9
+		dstPort,              // 10
 	)
 	klog.V(4).Infof("ovn-trace command on destination pod node is %s", cmd)
-	successString := fmt.Sprintf(`output to "%s"`, dstPodInfo.FullyQualifiedPodName())
+	successString := fmt.Sprintf(`output to "%s"`, dstPodInfo.LogicalPortName)
 	ovnSrcDstOut, ovnSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, dstPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
-	printSuccessOrFailure("ovn-trace (remote) "+direction, srcPodInfo.PodName, dstPodInfo.PodName, ovnSrcDstOut, ovnSrcDstErr, err, successString)
+	stage := &traceStage{
+		Type:        ovnTraceStage,
+		Name:        "ovn-trace (remote) " + direction,
+		Source:      srcPodInfo.PodName,
+		Destination: dstPodInfo.PodName,
+		Command:     cmd,
+		Expected:    successString,
+	}
+	if err == nil {
+		stage.ACLs = getTraceACLs(coreclient, restconfig, ovnNamespace, dstPodInfo, ovnSrcDstOut)
+	}
+	printSuccessOrFailure(stage, ovnSrcDstOut, ovnSrcDstErr, err)
 }
 
 func podsInSameInterconnectZone(srcPodInfo, dstPodInfo *PodInfo) bool {
 	return srcPodInfo.InterConnectZoneName == dstPodInfo.InterConnectZoneName
 }
 
+// getNextHopMAC returns the destination MAC address of traffic from the source pod to the destination pod. That is
+// the MAC address of the source pod's first hop router, or of the destination pod if both pods are on the same
+// layer2 segment.
+func getNextHopMAC(srcPodInfo, dstPodInfo *PodInfo) string {
+	if srcPodInfo.Network.TopologyType() != types.Layer3Topology {
+		return dstPodInfo.MAC
+	}
+	return srcPodInfo.RtosMAC
+}
+
+// getRemoteZoneEgressPort returns the logical port that traffic to the destination pod leaves the source pod's
+// interconnect zone through:
+// a) for layer3 networks, the transit switch port of the destination node, e.g. tstor-<node>.
+// b) for layer2 networks, the remote port of the destination pod on the network switch.
+// c) for localnet networks, the localnet port.
+func getRemoteZoneEgressPort(srcPodInfo, dstPodInfo *PodInfo) string {
+	switch srcPodInfo.Network.TopologyType() {
+	case types.Layer2Topology:
+		return dstPodInfo.LogicalPortName
+	case types.LocalnetTopology:
+		return srcPodInfo.Network.GetNetworkScopedName(types.OVNLocalnetPort)
+	}
+	return srcPodInfo.Network.GetNetworkScopedName(types.TransitSwitchToRouterPrefix + dstPodInfo.NodeName)
+}
+
+// getRemoteZoneIngress returns the logical port that traffic from the source pod enters the destination pod's
+// interconnect zone through, along with the destination MAC address of that traffic.
+func getRemoteZoneIngress(srcPodInfo, dstPodInfo *PodInfo) (string, string) {
+	switch srcPodInfo.Network.TopologyType() {
+	case types.Layer2Topology:
+		return srcPodInfo.LogicalPortName, dstPodInfo.MAC
+	case types.LocalnetTopology:
+		return srcPodInfo.Network.GetNetworkScopedName(types.OVNLocalnetPort), dstPodInfo.MAC
+	}
+	return srcPodInfo.Network.GetNetworkScopedName(types.TransitSwitchToRouterPrefix + srcPodInfo.NodeName), dstPodInfo.RtotsMAC
+}
+
 // runOfprotoTraceToPod runs an ofproto/trace command from the src to the destination pod.
 func runOfprotoTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) string {
 	protocolSelector, nwSrc, nwDst := getOfprotoIPFamilyArgs(protocol, net.ParseIP(dstPodInfo.IP))
 	cmd := fmt.Sprintf(`ovs-appctl ofproto/trace br-int `+
 		`"in_port=%[1]s, %[9]s, dl_src=%[3]s, dl_dst=%[4]s, %[10]s=%[5]s, %[11]s=%[6]s, nw_ttl=64, %[7]s_dst=%[8]s, %[7]s_src=12345"`,
-		srcPodInfo.VethName, // 1
-		protocol,            // 2
-		srcPodInfo.MAC,      // 3
-		srcPodInfo.RtosMAC,  // 4
-		srcPodInfo.IP,       // 5
-		dstPodInfo.IP,       // 6
-		protocol,            // 7
-		dstPort,             // 8
-		protocolSelector,    // 9
-		nwSrc,               // 10
-		nwDst,               // 11
+		srcPodInfo.VethName,                   // 1
+		protocol,                              // 2
+		srcPodInfo.MAC,                        // 3
+		getNextHopMAC(srcPodInfo, dstPodInfo), // 4
+		srcPodInfo.IP,                         // 5
+		dstPodInfo.IP,                         // 6
+		protocol,                              // 7
+		dstPort,                               // 8
+		protocolSelector,                      // 9
+		nwSrc,                                 // 10
+		nwDst,                                 // 11
 	)
 	klog.V(4).Infof("ovs-appctl ofproto/trace command from %s is %s", direction, cmd)
 
@@ -960,12 +1108,23 @@ func runOfprotoTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *res
 		} else {
 			successString = fmt.Sprintf(`output:%s\n\nFinal flow:`, srcPodInfo.OvnK8sMp0OfportNum)
 		}
+	} else if srcPodInfo.Network.TopologyType() == types.LocalnetTopology {
+		klog.V(5).Infof("Pods are on node: %s and node %s, connected through bridge %s", srcPodInfo.NodeName, dstPodInfo.NodeName, srcPodInfo.LocalnetBridgeName)
+		// Traffic leaves through the physical network.
+		successString = fmt.Sprintf(`bridge\("%s"\)`, regexp.QuoteMeta(srcPodInfo.LocalnetBridgeName))
 	} else {
 		klog.V(5).Infof("Pods are on node: %s and node %s", srcPodInfo.NodeName, dstPodInfo.NodeName)
 		successString = "-> output to kernel tunnel"
 	}
 	appSrcDstOut, appSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
-	printSuccessOrFailure("ovs-appctl ofproto/trace "+direction, srcPodInfo.PodName, dstPodInfo.PodName, appSrcDstOut, appSrcDstErr, err, successString)
+	printSuccessOrFailure(&traceStage{
+		Type:        ofprotoTraceStage,
+		Name:        "ovs-appctl ofproto/trace " + direction,
+		Source:      srcPodInfo.PodName,
+		Destination: dstPodInfo.PodName,
+		Command:     cmd,
+		Expected:    successString,
+	}, appSrcDstOut, appSrcDstErr, err)
 
 	return appSrcDstOut
 }
@@ -974,7 +1133,7 @@ func runOfprotoTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *res
 // egressNodeName is the exit node, as determined by an ovn-trace command that was run earlier.
 // egressBridgeName is the name of the exit bridge (for EgressIPs, EgressGW and also for routingViaOVN mode).
 // If egressBridgeName == "", then this is routingViaHost Gateway mode without an EgressIP / EgressGW.
-func runOfprotoTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, srcPodInfo *PodInfo, dstIP net.IP, ovnNamespace, protocol, dstPort, egressNodeName, egressBridgeName string) string {
+func runOfprotoTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, srcPodInfo *PodInfo, dstIP net.IP, dstName, ovnNamespace, protocol, dstPort, egressNodeName, egressBridgeName string) string {
 	protocolSelector, nwSrc, nwDst := getOfprotoIPFamilyArgs(protocol, dstIP)
 	cmd := fmt.Sprintf(`ovs-appctl ofproto/trace br-int `+
 		`"in_port=%[1]s, %[8]s, dl_src=%[3]s, dl_dst=%[4]s, %[9]s=%[5]s, %[10]s=%[6]s, nw_ttl=64, %[2]s_dst=%[7]s, %[2]s_src=12345"`,
@@ -1008,7 +1167,14 @@ func runOfprotoTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest
 		}
 	}
 	appSrcDstOut, appSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
-	printSuccessOrFailure(fmt.Sprintf("ovs-appctl ofproto/trace %s", direction), srcPodInfo.PodName, dstIP.String(), appSrcDstOut, appSrcDstErr, err, successString)
+	printSuccessOrFailure(&traceStage{
+		Type:        ofprotoTraceStage,
+		Name:        fmt.Sprintf("ovs-appctl ofproto/trace %s", direction),
+		Source:      srcPodInfo.PodName,
+		Destination: dstName,
+		Command:     cmd,
+		Expected:    successString,
+	}, appSrcDstOut, appSrcDstErr, err)
 
 	return appSrcDstOut
 }
@@ -1093,7 +1259,13 @@ func runOvnDetrace(coreclient *corev1client.CoreV1Client, restconfig *rest.Confi
 	klog.V(4).Infof("ovn-detrace command from %s is %s", direction, cmd)
 
 	dtraceSrcDstOut, dtraceSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, appSrcDstOut)
-	printSuccessOrFailure("ovn-detrace "+direction, srcPodInfo.PodName, dstName, dtraceSrcDstOut, dtraceSrcDstErr, err, "")
+	printSuccessOrFailure(&traceStage{
+		Type:        ovnDetraceStage,
+		Name:        "ovn-detrace " + direction,
+		Source:      srcPodInfo.PodName,
+		Destination: dstName,
+		Command:     cmd,
+	}, dtraceSrcDstOut, dtraceSrcDstErr, err)
 
 	return nil
 }
@@ -1143,7 +1315,20 @@ func displayNodeInfo(coreclient *corev1client.CoreV1Client) {
 	}
 }
 
-func getDesiredPodIP(pod *corev1.Pod, addressFamily string) (string, error) {
+// getDesiredPodIP returns the pod's IP address of the given family on the network of the given NAD.
+func getDesiredPodIP(pod *corev1.Pod, nadName, addressFamily string) (string, error) {
+	if nadName != types.DefaultNetworkName {
+		podAnnotation, err := util.UnmarshalPodAnnotation(pod.Annotations, nadName)
+		if err != nil {
+			return "", err
+		}
+		for _, podIP := range podAnnotation.IPs {
+			if getIPVer(podIP.IP) == addressFamily {
+				return podIP.IP.String(), nil
+			}
+		}
+		return "", fmt.Errorf("could not find desired pod ip address for the given address family on network %s", nadName)
+	}
 	for _, podIP := range pod.Status.PodIPs {
 		ip := utilnet.ParseIPSloppy(podIP.IP)
 		if getIPVer(ip) == addressFamily {
@@ -1153,6 +1338,24 @@ func getDesiredPodIP(pod *corev1.Pod, addressFamily string) (string, error) {
 	return "", fmt.Errorf("could not find desired pod ip address for the given address family")
 }
 
+// getNodeInternalIP returns the node's internal IP address of the given family.
+func getNodeInternalIP(coreclient *corev1client.CoreV1Client, nodeName, addressFamily string) (net.IP, error) {
+	node, err := coreclient.Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range node.Status.Addresses {
+		if address.Type != corev1.NodeInternalIP {
+			continue
+		}
+		ip := utilnet.ParseIPSloppy(address.Address)
+		if ip != nil && getIPVer(ip) == addressFamily {
+			return ip, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find an internal ip address of node %s for the given address family", nodeName)
+}
+
 func getIPVer(ip net.IP) string {
 	if ip.To4() != nil {
 		return ip4
@@ -1185,11 +1388,14 @@ func main() {
 	dstPodName := flag.String("dst", "", "dest: destination pod name")
 	dstSvcName := flag.String("service", "", "service: destination service name")
 	dstIP := flag.String("dst-ip", "", "destination IP address (meant for tests to external targets)")
+	dstNodeName := flag.String("dst-node", "", "destination node name, traffic is traced to the node's internal IP address")
 	dstPort := flag.String("dst-port", "80", "dst-port: destination port")
 	tcp := flag.Bool("tcp", false, "use tcp transport protocol")
 	udp := flag.Bool("udp", false, "use udp transport protocol")
 	addressFamily := flag.String("addr-family", ip4, "Address family (ip4 or ip6) to be used for tracing")
 	skipOvnDetrace := flag.Bool("skip-detrace", false, "skip ovn-detrace command")
+	networkNAD := flag.String("network", "", "network attachment definition (<namespace>/<name>) of the primary or secondary user defined network to trace on, defaults to the primary network of the source pod")
+	output := flag.String("output", outputText, "output format: text or json")
 	dumpVRFTableIDs := flag.Bool("dump-udn-vrf-table-ids", false, "Dump the VRF table ID per node for all the user defined networks")
 	loglevel := flag.String("loglevel", "0", "loglevel: klog level")
 	flag.Parse()
@@ -1228,6 +1434,12 @@ func main() {
 		klog.Exitf(" Unexpected error: %v", err)
 	}
 
+	// Create a network attachment definition client, used to trace user defined networks.
+	nadClient, err := nadclientset.NewForConfig(restconfig)
+	if err != nil {
+		klog.Exitf(" Unexpected error: %v", err)
+	}
+
 	// Get the namespace that OVN pods reside in.
 	ovnNamespace, err := getOvnNamespace(coreclient, *cfgNamespace)
 	if err != nil {
@@ -1277,8 +1489,54 @@ func main() {
 			klog.Exitf("Usage: cannot parse IP address provided in -dst-ip")
 		}
 	}
+	if *dstNodeName != "" {
+		targetOptions++
+	}
 	if targetOptions != 1 {
-		klog.Exitf("Usage: exactly one of -dst, -service or -dst-ip must be set")
+		klog.Exitf("Usage: exactly one of -dst, -service, -dst-node or -dst-ip must be set")
+	}
+	if *output != outputText && *output != outputJSON {
+		klog.Exitf("Usage: -output must be either %s or %s", outputText, outputJSON)
+	}
+
+	// Get the network to trace on.
+	network, err := getTraceNetwork(coreclient, nadClient, *srcNamespace, *srcPodName, *networkNAD)
+	if err != nil {
+		klog.Exitf("Failed to get the network to trace on: %v", err)
+	}
+	klog.V(5).Infof("Tracing on network %s", network.GetNetworkName())
+	if !network.IsDefault() && !network.IsPrimaryNetwork() && *dstPodName == "" {
+		klog.Exitf("Usage: only -dst can be used to trace on secondary network %s", network.GetNetworkName())
+	}
+
+	if *dstNodeName != "" {
+		parsedDstIP, err = getNodeInternalIP(coreclient, *dstNodeName, *addressFamily)
+		if err != nil {
+			klog.Exitf("Failed to get the address of node %s: %v", *dstNodeName, err)
+		}
+	}
+
+	if *output == outputJSON {
+		var destination string
+		switch {
+		case *dstNodeName != "":
+			destination = *dstNodeName
+		case parsedDstIP != nil:
+			destination = parsedDstIP.String()
+		case *dstSvcName != "":
+			destination = *dstNamespace + "/" + *dstSvcName
+		default:
+			destination = *dstNamespace + "/" + *dstPodName
+		}
+		traceReport = &traceResult{
+			Source:      *srcNamespace + "/" + *srcPodName,
+			Destination: destination,
+			Network:     network.GetNetworkName(),
+			Protocol:    protocol,
+			DstPort:     *dstPort,
+		}
+		// Failed stages print the report and exit, print it here once all the stages succeeded.
+		defer traceReport.print()
 	}
 
 	// Show some information about the nodes in this cluster - only if log level 5 or higher.
@@ -1287,7 +1545,7 @@ func main() {
 	}
 
 	// Get info needed for the src Pod
-	srcPodInfo, err := getPodInfo(coreclient, restconfig, *srcPodName, ovnNamespace, *srcNamespace, *addressFamily)
+	srcPodInfo, err := getPodInfo(coreclient, restconfig, nadClient, network, *srcPodName, ovnNamespace, *srcNamespace, *addressFamily)
 	if err != nil {
 		klog.Exitf("Failed to get information from pod %s: %v", *srcPodName, err)
 	}
@@ -1296,12 +1554,16 @@ func main() {
 	// 1) Either run a trace from source pod to destination IP and return ...
 	if parsedDstIP != nil {
 		klog.V(5).Infof("Running a trace to an IP address")
-		egressNodeName, egressBridgeName := runOvnTraceToIP(coreclient, restconfig, srcPodInfo, parsedDstIP, ovnNamespace, protocol, *dstPort)
-		appSrcDstOut := runOfprotoTraceToIP(coreclient, restconfig, srcPodInfo, parsedDstIP, ovnNamespace, protocol, *dstPort, egressNodeName, egressBridgeName)
+		dstName := parsedDstIP.String()
+		if *dstNodeName != "" {
+			dstName = *dstNodeName
+		}
+		egressNodeName, egressBridgeName := runOvnTraceToIP(coreclient, restconfig, srcPodInfo, parsedDstIP, dstName, ovnNamespace, protocol, *dstPort)
+		appSrcDstOut := runOfprotoTraceToIP(coreclient, restconfig, srcPodInfo, parsedDstIP, dstName, ovnNamespace, protocol, *dstPort, egressNodeName, egressBridgeName)
 		if *skipOvnDetrace {
 			return
 		}
-		err = runOvnDetrace(coreclient, restconfig, "pod to external IP", srcPodInfo, parsedDstIP.String(), appSrcDstOut, ovnNamespace)
+		err = runOvnDetrace(coreclient, restconfig, "pod to external IP", srcPodInfo, dstName, appSrcDstOut, ovnNamespace)
 		if err != nil {
 			klog.Infof("Skipped ovn-detrace due to: %q", err)
 		}
@@ -1314,7 +1576,7 @@ func main() {
 	var dstSvcInfo *SvcInfo
 	if *dstSvcName != "" {
 		// Get dst service
-		dstSvcInfo, err = getSvcInfo(coreclient, restconfig, *dstSvcName, ovnNamespace, *dstNamespace, *addressFamily)
+		dstSvcInfo, err = getSvcInfo(coreclient, restconfig, nadClient, network, *dstSvcName, ovnNamespace, *dstNamespace, *addressFamily)
 		if err != nil {
 			klog.Exitf("Failed to get information from service %s: %v", *dstSvcName, err)
 		}
@@ -1325,7 +1587,7 @@ func main() {
 	}
 
 	// Now get info needed for the dst Pod
-	dstPodInfo, err := getPodInfo(coreclient, restconfig, *dstPodName, ovnNamespace, *dstNamespace, *addressFamily)
+	dstPodInfo, err := getPodInfo(coreclient, restconfig, nadClient, network, *dstPodName, ovnNamespace, *dstNamespace, *addressFamily)
 	if err != nil {
 		klog.Exitf("Failed to get information from pod %s: %v", *dstPodName, err)
 	}
@@ -7,12 +7,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
+	"strings"
+
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	nadclientset "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/clientset/versioned"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 
 	types "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
@@ -92,3 +99,134 @@ func findUDNNetworks(node *corev1.Node) (map[string]string, error) {
 	delete(networks, types.DefaultNetworkName)
 	return networks, nil
 }
+
+// getTraceNetwork returns the network to trace on: the network of the given NAD, in the <namespace>/<name> format,
+// or the primary network of the source pod if no NAD is given.
+func getTraceNetwork(coreclient *corev1client.CoreV1Client, nadClient nadclientset.Interface, srcNamespace, srcPodName, nadName string) (util.NetInfo, error) {
+	if nadName == "" {
+		pod, err := coreclient.Pods(srcNamespace).Get(context.TODO(), srcPodName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		nadName, err = getPodPrimaryNADName(pod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the primary network of pod %s/%s: %w", srcNamespace, srcPodName, err)
+		}
+	}
+	if nadName == types.DefaultNetworkName {
+		return &util.DefaultNetInfo{}, nil
+	}
+	nad, err := getNAD(nadClient, nadName)
+	if err != nil {
+		return nil, err
+	}
+	return util.ParseNADInfo(nad)
+}
+
+// getPodPrimaryNADName returns the NAD of the pod's primary user defined network, or the default network name if
+// the pod's primary network is the default network.
+func getPodPrimaryNADName(pod *corev1.Pod) (string, error) {
+	podNetworks, err := util.UnmarshalPodAnnotationAllNetworks(pod.Annotations)
+	if err != nil {
+		// host networked pods don't have the annotation
+		if util.IsAnnotationNotSetError(err) {
+			return types.DefaultNetworkName, nil
+		}
+		return "", err
+	}
+	for nadName, podNetwork := range podNetworks {
+		if nadName != types.DefaultNetworkName && podNetwork.Role == types.NetworkRolePrimary {
+			return nadName, nil
+		}
+	}
+	return types.DefaultNetworkName, nil
+}
+
+// getPodNADName returns the NAD the pod is attached to the network with. Pods in different namespaces are attached
+// to the same cluster user defined network with different NADs.
+func getPodNADName(nadClient nadclientset.Interface, pod *corev1.Pod, network util.NetInfo) (string, error) {
+	if network.IsDefault() {
+		return types.DefaultNetworkName, nil
+	}
+	podNetworks, err := util.UnmarshalPodAnnotationAllNetworks(pod.Annotations)
+	if err != nil {
+		return "", err
+	}
+	for _, nadName := range slices.Sorted(maps.Keys(podNetworks)) {
+		if nadName == types.DefaultNetworkName {
+			continue
+		}
+		nad, err := getNAD(nadClient, nadName)
+		if err != nil {
+			return "", err
+		}
+		netconf, err := util.ParseNetConf(nad)
+		if err != nil {
+			return "", err
+		}
+		if netconf.Name == network.GetNetworkName() {
+			return nadName, nil
+		}
+	}
+	return "", fmt.Errorf("pod %s/%s is not attached to network %s", pod.Namespace, pod.Name, network.GetNetworkName())
+}
+
+func getNAD(nadClient nadclientset.Interface, nadName string) (*nadapi.NetworkAttachmentDefinition, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(nadName)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("network attachment definition %q must be given as <namespace>/<name>", nadName)
+	}
+	return nadClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+// getLogicalSwitchName returns the logical switch of the network that pods on the given node are connected to.
+func getLogicalSwitchName(network util.NetInfo, nodeName string) string {
+	if network.TopologyType() == types.LocalnetTopology {
+		return network.GetNetworkScopedName(types.OVNLocalnetSwitch)
+	}
+	return network.GetNetworkScopedSwitchName(nodeName)
+}
+
+// networkHasRouter returns true if the pods of the network have a first hop router. Layer2 secondary networks and
+// localnet networks don't have one.
+func networkHasRouter(network util.NetInfo) bool {
+	switch network.TopologyType() {
+	case types.Layer3Topology:
+		return true
+	case types.Layer2Topology:
+		return network.IsPrimaryNetwork()
+	}
+	return false
+}
+
+// getLocalnetBridgeName returns the OVS bridge the localnet network is mapped to on the pod's node.
+func getLocalnetBridgeName(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo) (string, error) {
+	cmd := "ovs-vsctl --if-exists get Open_vSwitch . external_ids:ovn-bridge-mappings"
+	stdout, stderr, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, cmd, "")
+	if err != nil {
+		return "", fmt.Errorf("execInPod() failed with %s stderr %s stdout %s", err, stderr, stdout)
+	}
+	physicalNetworkName := podInfo.Network.PhysicalNetworkName()
+	if physicalNetworkName == "" {
+		physicalNetworkName = podInfo.Network.GetNetworkName()
+	}
+	bridgeName := getBridgeFromMappings(strings.Trim(strings.TrimSpace(stdout), `"`), physicalNetworkName)
+	if bridgeName == "" {
+		return "", fmt.Errorf("could not find the bridge of physical network %s on node %s", physicalNetworkName, podInfo.NodeName)
+	}
+	return bridgeName, nil
+}
+
+// getBridgeFromMappings returns the bridge of the physical network in ovn-bridge-mappings, e.g. physnet:breth0,localnet1:br-loc.
+func getBridgeFromMappings(bridgeMappings, physicalNetworkName string) string {
+	for _, mapping := range strings.Split(bridgeMappings, ",") {
+		network, bridge, found := strings.Cut(strings.TrimSpace(mapping), ":")
+		if found && network == physicalNetworkName {
+			return bridge
+		}
+	}
+	return ""
+}
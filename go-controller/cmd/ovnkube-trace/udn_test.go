@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	nadfake "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	ovntest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func newFakeNADClient(t *testing.T, nads ...*nadapi.NetworkAttachmentDefinition) *nadfake.Clientset {
+	require.NoError(t, config.PrepareTestConfig())
+	config.IPv4Mode = true
+	nadClient := nadfake.NewSimpleClientset()
+	for _, nad := range nads {
+		_, err := nadClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(nad.Namespace).Create(context.TODO(), nad, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	return nadClient
+}
+
+func TestUserDefinedNetworkNames(t *testing.T) {
+	layer3NAD := ovntest.GenerateNADWithConfig("blue", "ns1", `{
+		"cniVersion": "1.0.0",
+		"name": "ns1_blue",
+		"type": "ovn-k8s-cni-overlay",
+		"topology": "layer3",
+		"subnets": "10.20.0.0/16/24",
+		"role": "primary",
+		"netAttachDefName": "ns1/blue"
+	}`)
+	layer2NAD := ovntest.GenerateNADWithConfig("red", "ns2", `{
+		"cniVersion": "1.0.0",
+		"name": "cluster_udn_red",
+		"type": "ovn-k8s-cni-overlay",
+		"topology": "layer2",
+		"subnets": "10.200.0.0/16",
+		"netAttachDefName": "ns2/red"
+	}`)
+	localnetNAD := ovntest.GenerateNADWithConfig("physnet", "ns3", `{
+		"cniVersion": "1.0.0",
+		"name": "localnet1",
+		"type": "ovn-k8s-cni-overlay",
+		"topology": "localnet",
+		"subnets": "192.168.100.0/24",
+		"physicalNetworkName": "physnet",
+		"netAttachDefName": "ns3/physnet"
+	}`)
+
+	tests := []struct {
+		name                  string
+		nad                   string
+		expectedSwitch        string
+		expectedRouter        bool
+		expectedRemoteEgress  string
+		expectedRemoteIngress string
+		expectedNextHopMAC    string
+	}{
+		{
+			name:                  "default network",
+			expectedSwitch:        "node1",
+			expectedRouter:        true,
+			expectedRemoteEgress:  "tstor-node2",
+			expectedRemoteIngress: "tstor-node1",
+			expectedNextHopMAC:    "0a:58:0a:80:00:01",
+		},
+		{
+			name:                  "primary layer3 network",
+			nad:                   "ns1/blue",
+			expectedSwitch:        "ns1_blue_node1",
+			expectedRouter:        true,
+			expectedRemoteEgress:  "ns1_blue_tstor-node2",
+			expectedRemoteIngress: "ns1_blue_tstor-node1",
+			expectedNextHopMAC:    "0a:58:0a:80:00:01",
+		},
+		{
+			name:                  "secondary layer2 network",
+			nad:                   "ns2/red",
+			expectedSwitch:        "cluster_udn_red_ovn_layer2_switch",
+			expectedRouter:        false,
+			expectedRemoteEgress:  "ns2.red_ns2_server",
+			expectedRemoteIngress: "ns2.red_ns2_client",
+			expectedNextHopMAC:    "0a:58:0a:80:00:06",
+		},
+		{
+			name:                  "localnet network",
+			nad:                   "ns3/physnet",
+			expectedSwitch:        "localnet1_ovn_localnet_switch",
+			expectedRouter:        false,
+			expectedRemoteEgress:  "localnet1_ovn_localnet_port",
+			expectedRemoteIngress: "localnet1_ovn_localnet_port",
+			expectedNextHopMAC:    "0a:58:0a:80:00:06",
+		},
+	}
+	nadClient := newFakeNADClient(t, layer3NAD, layer2NAD, localnetNAD)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nadName := tt.nad
+			if nadName == "" {
+				nadName = types.DefaultNetworkName
+			}
+			network, err := getTraceNetwork(nil, nadClient, "", "", nadName)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSwitch, getLogicalSwitchName(network, "node1"))
+			assert.Equal(t, tt.expectedRouter, networkHasRouter(network))
+
+			src := &PodInfo{Network: network, LogicalPortName: "ns2.red_ns2_client", MAC: "0a:58:0a:80:00:05", RtosMAC: "0a:58:0a:80:00:01"}
+			src.NodeName = "node1"
+			dst := &PodInfo{Network: network, LogicalPortName: "ns2.red_ns2_server", MAC: "0a:58:0a:80:00:06"}
+			dst.NodeName = "node2"
+			assert.Equal(t, tt.expectedRemoteEgress, getRemoteZoneEgressPort(src, dst))
+			inport, _ := getRemoteZoneIngress(src, dst)
+			assert.Equal(t, tt.expectedRemoteIngress, inport)
+			assert.Equal(t, tt.expectedNextHopMAC, getNextHopMAC(src, dst))
+		})
+	}
+}
+
+func TestGetPodNADName(t *testing.T) {
+	blueNAD := ovntest.GenerateNADWithConfig("blue", "ns1", `{
+		"cniVersion": "1.0.0",
+		"name": "cluster_udn_blue",
+		"type": "ovn-k8s-cni-overlay",
+		"topology": "layer2",
+		"subnets": "10.100.0.0/16",
+		"role": "primary",
+		"netAttachDefName": "ns1/blue"
+	}`)
+	redNAD := ovntest.GenerateNADWithConfig("red", "ns1", `{
+		"cniVersion": "1.0.0",
+		"name": "red",
+		"type": "ovn-k8s-cni-overlay",
+		"topology": "layer2",
+		"subnets": "10.200.0.0/16",
+		"netAttachDefName": "ns1/red"
+	}`)
+	nadClient := newFakeNADClient(t, blueNAD, redNAD)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod1",
+			Namespace: "ns1",
+			Annotations: map[string]string{
+				types.OvnPodAnnotationName: `{
+					"default": {"ip_addresses": ["10.244.1.5/24"], "mac_address": "0a:58:0a:f4:01:05", "role": "infrastructure-locked"},
+					"ns1/blue": {"ip_addresses": ["10.100.0.5/16"], "mac_address": "0a:58:0a:64:00:05", "role": "primary"},
+					"ns1/red": {"ip_addresses": ["10.200.0.5/16"], "mac_address": "0a:58:0a:c8:00:05", "role": "secondary"}
+				}`,
+			},
+		},
+	}
+
+	primaryNADName, err := getPodPrimaryNADName(pod)
+	require.NoError(t, err)
+	assert.Equal(t, "ns1/blue", primaryNADName)
+	primaryNADName, err = getPodPrimaryNADName(&corev1.Pod{})
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultNetworkName, primaryNADName)
+
+	for nadName, expectedIP := range map[string]string{
+		types.DefaultNetworkName: "",
+		"ns1/blue":               "10.100.0.5",
+		"ns1/red":                "10.200.0.5",
+	} {
+		network, err := getTraceNetwork(nil, nadClient, "", "", nadName)
+		require.NoError(t, err)
+		podNADName, err := getPodNADName(nadClient, pod, network)
+		require.NoError(t, err)
+		assert.Equal(t, nadName, podNADName)
+		if expectedIP != "" {
+			ip, err := getDesiredPodIP(pod, podNADName, ip4)
+			require.NoError(t, err)
+			assert.Equal(t, expectedIP, ip)
+		}
+	}
+
+	network, err := util.ParseNADInfo(ovntest.GenerateNADWithConfig("green", "ns2", `{
+		"cniVersion": "1.0.0",
+		"name": "green",
+		"type": "ovn-k8s-cni-overlay",
+		"topology": "layer2",
+		"subnets": "10.210.0.0/16",
+		"netAttachDefName": "ns2/green"
+	}`))
+	require.NoError(t, err)
+	_, err = getPodNADName(nadClient, pod, network)
+	assert.ErrorContains(t, err, "pod ns1/pod1 is not attached to network green")
+}
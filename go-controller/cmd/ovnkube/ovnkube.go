@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -38,6 +39,7 @@ import (
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/metrics"
 	ovnnode "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/routemanager"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/retry"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
 	utilerrors "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/errors"
 )
@@ -329,6 +331,7 @@ func startOvnKube(ctx *cli.Context, cancel context.CancelFunc) error {
 			// Use default registry so existing metric registrations keep working.
 			Registerer:      prometheus.DefaultRegisterer,
 			ApplyTLSOptions: config.TLS.ApplyOptions,
+			DebugHandlers:   map[string]http.Handler{"/debug/retry": retry.DebugHandler()},
 		}
 
 		metrics.StartMetricsServer(opts, ctx.Done(), ovnKubeStartWg)
@@ -625,6 +628,7 @@ func runOvnKube(ctx context.Context, runMode *ovnkubeRunMode, ovnClientset *util
 				// Reuse the default registry (and its gatherer) so ovnkube-node metrics and OVN metrics share one endpoint.
 				opts.Registerer = prometheus.DefaultRegisterer
 				opts.EnablePprof = config.Metrics.EnablePprof
+				opts.DebugHandlers = map[string]http.Handler{"/debug/retry": retry.DebugHandler()}
 			}
 
 			metrics.StartMetricsServer(opts, ctx.Done(), wg)
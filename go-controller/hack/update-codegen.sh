@@ -37,7 +37,7 @@ fi
 # Helper function to get API version for a given CRD
 get_crd_version() {
   case "$1" in
-    networkqos)
+    networkqos|multicastgroup|uplink)
       echo "v1alpha1"
       ;;
     *)
@@ -152,3 +152,7 @@ echo "Copying clusterNetworkConnect CRD"
 cp _output/crds/k8s.ovn.org_clusternetworkconnects.yaml ../helm/ovn-kubernetes/crds/k8s.ovn.org_clusternetworkconnects.yaml
 echo "Copying vtep CRD"
 cp _output/crds/k8s.ovn.org_vteps.yaml ../helm/ovn-kubernetes/crds/k8s.ovn.org_vteps.yaml
+echo "Copying multicastGroup CRD"
+cp _output/crds/k8s.ovn.org_multicastgroups.yaml ../helm/ovn-kubernetes/crds/k8s.ovn.org_multicastgroups.yaml
+cp _output/crds/k8s.ovn.org_uplinks.yaml ../helm/ovn-kubernetes/crds/k8s.ovn.org_uplinks.yaml
+cp _output/crds/k8s.ovn.org_uplinkstates.yaml ../helm/ovn-kubernetes/crds/k8s.ovn.org_uplinkstates.yaml
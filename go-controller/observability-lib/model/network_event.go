@@ -35,13 +35,17 @@ type NetworkEvent interface {
 	String() string
 }
 
+// ACLEvent is the NetworkEvent built from a sampled ACL. It can be encoded as JSON for structured output.
 type ACLEvent struct {
-	NetworkEvent
-	Action    string
-	Actor     string
-	Name      string
-	Namespace string
-	Direction string
+	NetworkEvent `json:"-"`
+	Action       string `json:"action"`
+	Actor        string `json:"actor"`
+	Name         string `json:"name,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Direction    string `json:"direction,omitempty"`
+	// Network is the namespaced name of the (C)UDN the ACL belongs to, UDN namespace and name are joined by "/",
+	// CUDN just has a name. It is empty for the default network.
+	Network string `json:"network,omitempty"`
 }
 
 func (e *ACLEvent) String() string {
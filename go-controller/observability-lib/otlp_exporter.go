@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability_lib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+const (
+	otlpLogsPath         = "/v1/logs"
+	otlpServiceName      = "ovnkube-observ"
+	otlpSeverityInfo     = 9
+	otlpMaxBatchSize     = 512
+	otlpMaxQueuedBatches = 16
+	otlpExportInterval   = time.Second
+	otlpExportTimeout    = 10 * time.Second
+	otlpContentTypeJSON  = "application/json"
+)
+
+// otlpAnyValue is the OTLP AnyValue, only string and int values are used. Following the protobuf JSON mapping,
+// int values are encoded as strings.
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string         `json:"timeUnixNano"`
+	ObservedTimeUnixNano string         `json:"observedTimeUnixNano"`
+	SeverityNumber       int            `json:"severityNumber"`
+	SeverityText         string         `json:"severityText"`
+	Body                 otlpAnyValue   `json:"body"`
+	Attributes           []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeLogs struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	} `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+func otlpString(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: &value}}
+}
+
+func otlpInt(key string, value int) otlpKeyValue {
+	v := strconv.Itoa(value)
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{IntValue: &v}}
+}
+
+// otlpLogExporter exports samples as OTLP log records to an OTLP/HTTP collector using the JSON encoding.
+// Samples are batched and sent every otlpExportInterval, or as soon as otlpMaxBatchSize samples are queued.
+// Batches are sent by Run so that writing samples never waits for the collector; full batches are dropped
+// when otlpMaxQueuedBatches are already waiting to be sent.
+type otlpLogExporter struct {
+	endpoint string
+	client   *http.Client
+	resource []otlpKeyValue
+
+	lock    sync.Mutex
+	records []otlpLogRecord
+	batches chan []otlpLogRecord
+}
+
+// newOTLPLogExporter creates an exporter for the given collector endpoint, e.g. http://localhost:4318.
+// The default OTLP/HTTP logs path is used when the endpoint has no path.
+func newOTLPLogExporter(endpoint string) (*otlpLogExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: scheme must be http or https", endpoint)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = otlpLogsPath
+	}
+	resource := []otlpKeyValue{otlpString("service.name", otlpServiceName)}
+	if hostname, err := os.Hostname(); err == nil {
+		resource = append(resource, otlpString("host.name", hostname))
+	}
+	return &otlpLogExporter{
+		endpoint: u.String(),
+		client:   &http.Client{Timeout: otlpExportTimeout},
+		resource: resource,
+		batches:  make(chan []otlpLogRecord, otlpMaxQueuedBatches),
+	}, nil
+}
+
+// Run exports the full batches as they are queued and the other queued samples periodically until the
+// context is done, then exports the remaining ones.
+func (e *otlpLogExporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(otlpExportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := e.Flush(); err != nil {
+				klog.Errorf("OTLP export failed: %v", err)
+			}
+			return
+		case records := <-e.batches:
+			if err := e.export(records); err != nil {
+				klog.Errorf("OTLP export failed: %v", err)
+			}
+		case <-ticker.C:
+			if err := e.export(e.takeRecords()); err != nil {
+				klog.Errorf("OTLP export failed: %v", err)
+			}
+		}
+	}
+}
+
+func (e *otlpLogExporter) Write(sample *Sample) error {
+	e.lock.Lock()
+	e.records = append(e.records, newOTLPLogRecord(sample))
+	var records []otlpLogRecord
+	if len(e.records) >= otlpMaxBatchSize {
+		records = e.records
+		e.records = nil
+	}
+	e.lock.Unlock()
+	if records == nil {
+		return nil
+	}
+	select {
+	case e.batches <- records:
+	default:
+		klog.Warningf("Dropping %d OTLP log records, %d batches are already waiting to be exported",
+			len(records), otlpMaxQueuedBatches)
+	}
+	return nil
+}
+
+// Flush sends the full batches and all the other queued samples to the collector.
+func (e *otlpLogExporter) Flush() error {
+	var errs []error
+	for {
+		select {
+		case records := <-e.batches:
+			errs = append(errs, e.export(records))
+		default:
+			errs = append(errs, e.export(e.takeRecords()))
+			return errors.Join(errs...)
+		}
+	}
+}
+
+func (e *otlpLogExporter) takeRecords() []otlpLogRecord {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	records := e.records
+	e.records = nil
+	return records
+}
+
+// export sends the given log records to the collector in a single request.
+func (e *otlpLogExporter) export(records []otlpLogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	scopeLogs := otlpScopeLogs{LogRecords: records}
+	scopeLogs.Scope.Name = otlpServiceName
+	resourceLogs := otlpResourceLogs{ScopeLogs: []otlpScopeLogs{scopeLogs}}
+	resourceLogs.Resource.Attributes = e.resource
+	body, err := json.Marshal(&otlpLogsRequest{ResourceLogs: []otlpResourceLogs{resourceLogs}})
+	if err != nil {
+		return fmt.Errorf("failed to encode %d log records: %w", len(records), err)
+	}
+
+	resp, err := e.client.Post(e.endpoint, otlpContentTypeJSON, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to send %d log records to %s: %w", len(records), e.endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("collector %s rejected %d log records with status %d: %s",
+			e.endpoint, len(records), resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func newOTLPLogRecord(sample *Sample) otlpLogRecord {
+	timestamp := strconv.FormatInt(sample.Timestamp.UnixNano(), 10)
+	message := sample.message()
+	if message == "" {
+		message = fmt.Sprintf("src=%s, dst=%s", sample.SrcIP, sample.DstIP)
+	}
+	record := otlpLogRecord{
+		TimeUnixNano:         timestamp,
+		ObservedTimeUnixNano: timestamp,
+		SeverityNumber:       otlpSeverityInfo,
+		SeverityText:         "INFO",
+		Body:                 otlpAnyValue{StringValue: &message},
+	}
+	if sample.SrcIP != "" {
+		record.Attributes = append(record.Attributes, otlpString("source.address", sample.SrcIP))
+	}
+	if sample.SrcPort != 0 {
+		record.Attributes = append(record.Attributes, otlpInt("source.port", sample.SrcPort))
+	}
+	if sample.DstIP != "" {
+		record.Attributes = append(record.Attributes, otlpString("destination.address", sample.DstIP))
+	}
+	if sample.DstPort != 0 {
+		record.Attributes = append(record.Attributes, otlpInt("destination.port", sample.DstPort))
+	}
+	if sample.Protocol != "" {
+		record.Attributes = append(record.Attributes, otlpString("network.transport", strings.ToLower(sample.Protocol)))
+	}
+	if sample.DecodeError != "" {
+		record.Attributes = append(record.Attributes, otlpString("ovn.decode_error", sample.DecodeError))
+	}
+	if event, ok := sample.Event.(*model.ACLEvent); ok {
+		for _, kv := range []struct{ key, value string }{
+			{"ovn.acl.action", event.Action},
+			{"ovn.acl.actor", event.Actor},
+			{"ovn.acl.name", event.Name},
+			{"ovn.acl.namespace", event.Namespace},
+			{"ovn.acl.direction", event.Direction},
+			{"ovn.network", event.Network},
+		} {
+			if kv.value != "" {
+				record.Attributes = append(record.Attributes, otlpString(kv.key, kv.value))
+			}
+		}
+	}
+	return record
+}
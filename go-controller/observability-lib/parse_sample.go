@@ -12,12 +12,10 @@ import (
 	"io"
 	"log"
 	"os"
-	"strings"
 	"syscall"
+	"time"
 	"unsafe"
 
-	"github.com/google/gopacket"
-	"github.com/google/gopacket/layers"
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netlink/nl"
 	"golang.org/x/sys/unix"
@@ -57,9 +55,11 @@ type SampleReader struct {
 	addOVSCollector bool
 	srcIP, dstIP    string
 	outputFile      string
+	outputFormat    OutputFormat
+	otlpEndpoint    string
 
-	decoder   *sampledecoder.SampleDecoder
-	cookieStr []string
+	decoder *sampledecoder.SampleDecoder
+	writers []sampleWriter
 }
 
 func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector bool, srcIP, dstIP, outputFile string) *SampleReader {
@@ -71,13 +71,28 @@ func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector
 		srcIP:           srcIP,
 		dstIP:           dstIP,
 		outputFile:      outputFile,
-	}
-	if logCookie {
-		r.cookieStr = make([]string, 2)
+		outputFormat:    OutputFormatText,
 	}
 	return r
 }
 
+// SetOutputFormat sets the format used to print samples, OutputFormatText is used by default.
+func (r *SampleReader) SetOutputFormat(format OutputFormat) error {
+	switch format {
+	case OutputFormatText, OutputFormatJSON:
+		r.outputFormat = format
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+// SetOTLPEndpoint enables exporting samples as OTLP log records to the given OTLP/HTTP collector endpoint,
+// in addition to printing them.
+func (r *SampleReader) SetOTLPEndpoint(endpoint string) {
+	r.otlpEndpoint = endpoint
+}
+
 func (r *SampleReader) ReadSamples(ctx context.Context) error {
 	if r.enableDecoder {
 		var err error
@@ -103,14 +118,43 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 			return fmt.Errorf("error creating output file: %w", err)
 		}
 		defer file.Close()
-		writer = bufio.NewWriter(file)
+		bufWriter := bufio.NewWriter(file)
+		defer bufWriter.Flush()
+		writer = bufWriter
 	} else {
 		writer = os.Stdout
 	}
-	l := log.New(writer, "", log.Ldate|log.Ltime|log.Lmicroseconds)
+	// errors are mixed with the samples in text mode, but must not break the JSON stream
+	errWriter := writer
+	switch r.outputFormat {
+	case OutputFormatJSON:
+		r.writers = append(r.writers, newJSONSampleWriter(writer))
+		errWriter = os.Stderr
+	default:
+		r.writers = append(r.writers, newTextSampleWriter(writer, r.logCookie, r.decoder != nil, r.printFullPacket))
+	}
+	l := log.New(errWriter, "", log.Ldate|log.Ltime|log.Lmicroseconds)
 	printlnFunc := func(a ...any) {
 		l.Println(a...)
 	}
+	if r.otlpEndpoint != "" {
+		exporter, err := newOTLPLogExporter(r.otlpEndpoint)
+		if err != nil {
+			return err
+		}
+		exporterCtx, cancel := context.WithCancel(context.Background())
+		exporterDone := make(chan struct{})
+		go func() {
+			defer close(exporterDone)
+			exporter.Run(exporterCtx)
+		}()
+		// export the remaining samples on exit
+		defer func() {
+			cancel()
+			<-exporterDone
+		}()
+		r.writers = append(r.writers, exporter)
+	}
 
 	fam, err := netlink.GenlFamilyGet(PSAMPLE_GENL_NAME)
 	if err != nil {
@@ -182,7 +226,7 @@ var hostEndian = getHostEndian()
 
 func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(a ...any)) error {
 	for _, msg := range msgs {
-		var packetStr, sampleStr string
+		sample := &Sample{Timestamp: time.Now()}
 		data := msg.Data[nl.SizeofGenlmsg:]
 		for attr := range nl.ParseAttributes(data) {
 			if r.logCookie && attr.Type == PSAMPLE_ATTR_SAMPLE_GROUP {
@@ -193,7 +237,7 @@ func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(
 					if err != nil {
 						return err
 					}
-					r.cookieStr[0] = fmt.Sprintf("group_id=%v", g)
+					sample.GroupID = &g
 				}
 			}
 			if attr.Type == PSAMPLE_ATTR_USER_COOKIE && (r.logCookie || r.decoder != nil) {
@@ -204,43 +248,33 @@ func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(
 						return err
 					}
 					if r.logCookie {
-						r.cookieStr[1] = fmt.Sprintf("obs_domain=%v, obs_point=%v",
-							c.ObsDomainID, c.ObsPointID)
+						sample.ObsDomainID, sample.ObsPointID = &c.ObsDomainID, &c.ObsPointID
 					}
 					if r.decoder != nil {
 						decoded, err := r.decoder.DecodeCookieIDs(c.ObsDomainID, c.ObsPointID)
 						if err != nil {
-							sampleStr = fmt.Sprintf("decoding failed: %v", err)
+							sample.DecodeError = err.Error()
 						} else {
-							sampleStr = fmt.Sprintf("OVN-K message: %s", decoded.String())
+							sample.Event = decoded
 						}
 					}
 				}
 			}
 			if attr.Type == PSAMPLE_ATTR_DATA {
-				packet := gopacket.NewPacket(attr.Value, layers.LayerTypeEthernet, gopacket.Lazy)
-				networkLayer := packet.NetworkLayer().NetworkFlow()
-				if r.printFullPacket {
-					packetStr = packet.String()
-				} else {
-					packetStr = fmt.Sprintf("src=%s, dst=%v\n",
-						networkLayer.Src().String(), networkLayer.Dst().String())
-				}
-				if r.srcIP != "" && r.srcIP != networkLayer.Src().String() {
+				sample.setPacket(attr.Value, r.printFullPacket)
+				if r.srcIP != "" && r.srcIP != sample.SrcIP {
 					return nil
 				}
-				if r.dstIP != "" && r.dstIP != networkLayer.Dst().String() {
+				if r.dstIP != "" && r.dstIP != sample.DstIP {
 					return nil
 				}
 			}
 		}
-		if r.logCookie {
-			printlnFunc(strings.Join(r.cookieStr, ", "))
-		}
-		if r.decoder != nil {
-			printlnFunc(sampleStr)
+		for _, w := range r.writers {
+			if err := w.Write(sample); err != nil {
+				printlnFunc("ERROR: failed to write sample:", err)
+			}
 		}
-		printlnFunc(packetStr)
 	}
 	return nil
 }
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability_lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+// OutputFormat defines how the samples are written by the SampleReader.
+type OutputFormat string
+
+const (
+	// OutputFormatText prints human-readable lines for every sample.
+	OutputFormatText OutputFormat = "text"
+	// OutputFormatJSON prints one JSON object per sample.
+	OutputFormatJSON OutputFormat = "json"
+)
+
+// Sample is a decoded packet sample.
+type Sample struct {
+	Timestamp time.Time `json:"timestamp"`
+	SrcIP     string    `json:"srcIP,omitempty"`
+	DstIP     string    `json:"dstIP,omitempty"`
+	SrcPort   int       `json:"srcPort,omitempty"`
+	DstPort   int       `json:"dstPort,omitempty"`
+	Protocol  string    `json:"protocol,omitempty"`
+	// GroupID, ObsDomainID and ObsPointID are only set when the raw sample cookie is logged.
+	GroupID     *uint32 `json:"groupID,omitempty"`
+	ObsDomainID *uint32 `json:"obsDomainID,omitempty"`
+	ObsPointID  *uint32 `json:"obsPointID,omitempty"`
+	// Event is the OVN-Kubernetes event that generated the sample, set when enrichment is enabled.
+	Event model.NetworkEvent `json:"event,omitempty"`
+	// DecodeError is set when enrichment is enabled but the sample cookie couldn't be decoded.
+	DecodeError string `json:"decodeError,omitempty"`
+	// Packet is the full packet dump, only set when the full packet is requested.
+	Packet string `json:"packet,omitempty"`
+}
+
+// setPacket fills the sample packet fields from the sampled packet data.
+func (s *Sample) setPacket(data []byte, fullPacket bool) {
+	packet := gopacket.NewPacket(data, layers.LayerTypeEthernet, gopacket.Lazy)
+	if networkLayer := packet.NetworkLayer(); networkLayer != nil {
+		s.SrcIP = networkLayer.NetworkFlow().Src().String()
+		s.DstIP = networkLayer.NetworkFlow().Dst().String()
+	}
+	switch ipLayer := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		s.Protocol = ipLayer.Protocol.String()
+	case *layers.IPv6:
+		s.Protocol = ipLayer.NextHeader.String()
+	}
+	switch transportLayer := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		s.SrcPort, s.DstPort = int(transportLayer.SrcPort), int(transportLayer.DstPort)
+	case *layers.UDP:
+		s.SrcPort, s.DstPort = int(transportLayer.SrcPort), int(transportLayer.DstPort)
+	case *layers.SCTP:
+		s.SrcPort, s.DstPort = int(transportLayer.SrcPort), int(transportLayer.DstPort)
+	}
+	if fullPacket {
+		s.Packet = packet.String()
+	}
+}
+
+// message returns the human-readable description of the sample event.
+func (s *Sample) message() string {
+	if s.DecodeError != "" {
+		return fmt.Sprintf("decoding failed: %s", s.DecodeError)
+	}
+	if s.Event != nil {
+		return fmt.Sprintf("OVN-K message: %s", s.Event.String())
+	}
+	return ""
+}
+
+// sampleWriter outputs decoded samples.
+type sampleWriter interface {
+	Write(sample *Sample) error
+}
+
+// textSampleWriter prints every sample as human-readable lines.
+type textSampleWriter struct {
+	logger          *log.Logger
+	logCookie       bool
+	enableDecoder   bool
+	printFullPacket bool
+}
+
+func newTextSampleWriter(w io.Writer, logCookie, enableDecoder, printFullPacket bool) *textSampleWriter {
+	return &textSampleWriter{
+		logger:          log.New(w, "", log.Ldate|log.Ltime|log.Lmicroseconds),
+		logCookie:       logCookie,
+		enableDecoder:   enableDecoder,
+		printFullPacket: printFullPacket,
+	}
+}
+
+func (w *textSampleWriter) Write(sample *Sample) error {
+	if w.logCookie {
+		cookieStr := make([]string, 2)
+		if sample.GroupID != nil {
+			cookieStr[0] = fmt.Sprintf("group_id=%v", *sample.GroupID)
+		}
+		if sample.ObsDomainID != nil && sample.ObsPointID != nil {
+			cookieStr[1] = fmt.Sprintf("obs_domain=%v, obs_point=%v", *sample.ObsDomainID, *sample.ObsPointID)
+		}
+		w.logger.Println(strings.Join(cookieStr, ", "))
+	}
+	if w.enableDecoder {
+		w.logger.Println(sample.message())
+	}
+	if w.printFullPacket {
+		w.logger.Println(sample.Packet)
+	} else {
+		w.logger.Println(fmt.Sprintf("src=%s, dst=%v\n", sample.SrcIP, sample.DstIP))
+	}
+	return nil
+}
+
+// jsonSampleWriter prints one JSON object per sample.
+type jsonSampleWriter struct {
+	encoder *json.Encoder
+}
+
+func newJSONSampleWriter(w io.Writer) *jsonSampleWriter {
+	return &jsonSampleWriter{encoder: json.NewEncoder(w)}
+}
+
+func (w *jsonSampleWriter) Write(sample *Sample) error {
+	return w.encoder.Encode(sample)
+}
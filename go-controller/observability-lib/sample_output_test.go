@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package observability_lib
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+func newTestTCPPacket(t *testing.T) []byte {
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0x0a, 0x58, 0x0a, 0x81, 0x02, 0x02},
+		DstMAC:       net.HardwareAddr{0x0a, 0x58, 0x0a, 0x81, 0x02, 0x05},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP("10.129.2.2"),
+		DstIP:    net.ParseIP("10.129.2.5"),
+	}
+	tcp := &layers.TCP{SrcPort: 43210, DstPort: 8080, SYN: true}
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true},
+		eth, ip, tcp))
+	return buf.Bytes()
+}
+
+func newTestSample(t *testing.T) *Sample {
+	sample := &Sample{
+		Timestamp: time.Date(2024, 10, 1, 10, 0, 0, 0, time.UTC),
+		Event: &model.ACLEvent{
+			Action:    "allow",
+			Actor:     "NetworkPolicy",
+			Name:      "allow-web",
+			Namespace: "ns1",
+			Direction: "Ingress",
+			Network:   "ns1/blue",
+		},
+	}
+	sample.setPacket(newTestTCPPacket(t), false)
+	return sample
+}
+
+func TestJSONSampleWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := newJSONSampleWriter(buf)
+	require.NoError(t, w.Write(newTestSample(t)))
+	require.NoError(t, w.Write(&Sample{Timestamp: time.Date(2024, 10, 1, 10, 0, 1, 0, time.UTC), DecodeError: "not found"}))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{
+		"timestamp": "2024-10-01T10:00:00Z",
+		"srcIP": "10.129.2.2",
+		"dstIP": "10.129.2.5",
+		"srcPort": 43210,
+		"dstPort": 8080,
+		"protocol": "TCP",
+		"event": {
+			"action": "allow",
+			"actor": "NetworkPolicy",
+			"name": "allow-web",
+			"namespace": "ns1",
+			"direction": "Ingress",
+			"network": "ns1/blue"
+		}
+	}`, string(lines[0]))
+	assert.JSONEq(t, `{"timestamp": "2024-10-01T10:00:01Z", "decodeError": "not found"}`, string(lines[1]))
+}
+
+func TestOTLPLogExporter(t *testing.T) {
+	requests := make(chan otlpLogsRequest, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != otlpLogsPath || r.Header.Get("Content-Type") != otlpContentTypeJSON {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		req := otlpLogsRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests <- req
+	}))
+	defer collector.Close()
+
+	exporter, err := newOTLPLogExporter(collector.URL)
+	require.NoError(t, err)
+	// nothing queued, nothing sent
+	require.NoError(t, exporter.Flush())
+	require.NoError(t, exporter.Write(newTestSample(t)))
+	require.NoError(t, exporter.Flush())
+
+	var req otlpLogsRequest
+	select {
+	case req = <-requests:
+	default:
+		t.Fatal("collector didn't receive the log records")
+	}
+	require.Len(t, req.ResourceLogs, 1)
+	assert.Contains(t, req.ResourceLogs[0].Resource.Attributes, otlpString("service.name", otlpServiceName))
+	require.Len(t, req.ResourceLogs[0].ScopeLogs, 1)
+	records := req.ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 1)
+	assert.Equal(t, "1727776800000000000", records[0].TimeUnixNano)
+	require.NotNil(t, records[0].Body.StringValue)
+	assert.Equal(t, "OVN-K message: Allowed by network policy allow-web in namespace ns1, direction Ingress",
+		*records[0].Body.StringValue)
+	assert.ElementsMatch(t, []otlpKeyValue{
+		otlpString("source.address", "10.129.2.2"),
+		otlpInt("source.port", 43210),
+		otlpString("destination.address", "10.129.2.5"),
+		otlpInt("destination.port", 8080),
+		otlpString("network.transport", "tcp"),
+		otlpString("ovn.acl.action", "allow"),
+		otlpString("ovn.acl.actor", "NetworkPolicy"),
+		otlpString("ovn.acl.name", "allow-web"),
+		otlpString("ovn.acl.namespace", "ns1"),
+		otlpString("ovn.acl.direction", "Ingress"),
+		otlpString("ovn.network", "ns1/blue"),
+	}, records[0].Attributes)
+}
+
+func TestOTLPLogExporterErrors(t *testing.T) {
+	_, err := newOTLPLogExporter("localhost:4318")
+	require.ErrorContains(t, err, "scheme must be http or https")
+
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "bad request", http.StatusBadRequest)
+	}))
+	defer collector.Close()
+	exporter, err := newOTLPLogExporter(collector.URL)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Write(newTestSample(t)))
+	require.ErrorContains(t, exporter.Flush(), "rejected 1 log records with status 400: bad request")
+}
+
+func TestOTLPLogExporterQueue(t *testing.T) {
+	var exported atomic.Int64
+	collector := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		req := otlpLogsRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err == nil {
+			exported.Add(int64(len(req.ResourceLogs[0].ScopeLogs[0].LogRecords)))
+		}
+	}))
+	defer collector.Close()
+
+	exporter, err := newOTLPLogExporter(collector.URL)
+	require.NoError(t, err)
+	// full batches are queued without being sent, the one exceeding the queue is dropped
+	sample := newTestSample(t)
+	for i := 0; i < (otlpMaxQueuedBatches+1)*otlpMaxBatchSize+1; i++ {
+		require.NoError(t, exporter.Write(sample))
+	}
+	assert.Zero(t, exported.Load())
+	require.NoError(t, exporter.Flush())
+	assert.Equal(t, int64(otlpMaxQueuedBatches*otlpMaxBatchSize+1), exported.Load())
+}
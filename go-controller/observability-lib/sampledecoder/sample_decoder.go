@@ -39,6 +39,10 @@ type Cookie struct {
 const CookieSize = 8
 const bridgeName = "br-int"
 
+// networkControllerSuffix is appended to the network name to build the owner controller name of UDN db objects,
+// see also github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn
+const networkControllerSuffix = "-network-controller"
+
 var SampleEndian = getEndian()
 
 func getEndian() binary.ByteOrder {
@@ -175,8 +179,9 @@ func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (model.N
 func newACLEvent(o *nbdb.ACL) (*model.ACLEvent, error) {
 	actor := o.ExternalIDs[libovsdbops.OwnerTypeKey.String()]
 	event := model.ACLEvent{
-		Action: o.Action,
-		Actor:  actor,
+		Action:  o.Action,
+		Actor:   actor,
+		Network: ownerControllerToUDNNamespacedName(o.ExternalIDs[libovsdbops.OwnerControllerKey.String()]),
 	}
 	switch actor {
 	case libovsdbops.NetworkPolicyOwnerType:
@@ -309,6 +314,16 @@ func networkNameToUDNNamespacedName(networkName string) string {
 	return namespacedName
 }
 
+// ownerControllerToUDNNamespacedName returns the (C)UDN namespaced name of the network owning a db object
+// with the given owner controller. Default network is represented by an empty string.
+func ownerControllerToUDNNamespacedName(ownerController string) string {
+	networkName, found := strings.CutSuffix(ownerController, networkControllerSuffix)
+	if !found {
+		return ""
+	}
+	return networkNameToUDNNamespacedName(networkName)
+}
+
 // GetInterfaceUDNs returns a map of all pod interface names to their corresponding (C)UDN namespaced names.
 // default network or NAD that is not created by (C)UDN is represented by an empty string.
 // UDN namespace+name are joined by "/", CUDN will just have a name.
@@ -69,3 +69,26 @@ func TestCreateOrUpdateACL(t *testing.T) {
 	assert.Equal(t, "Allowed by default allow from local node policy, direction Ingress", event.String())
 	assert.Equal(t, "Ingress", event.Direction)
 }
+
+func TestACLEventNetwork(t *testing.T) {
+	for _, tc := range []struct {
+		ownerController string
+		expected        string
+	}{
+		{ownerController: "default-network-controller", expected: ""},
+		{ownerController: "ns1_blue-network-controller", expected: "ns1/blue"},
+		{ownerController: "cluster_udn_red-network-controller", expected: "red"},
+		{ownerController: "", expected: ""},
+	} {
+		event, err := newACLEvent(&nbdb.ACL{
+			Action: nbdb.ACLActionDrop,
+			ExternalIDs: map[string]string{
+				libovsdbops.OwnerControllerKey.String(): tc.ownerController,
+				libovsdbops.OwnerTypeKey.String():       libovsdbops.UDNIsolationOwnerType,
+				libovsdbops.ObjectNameKey.String():      "AllowHostARPPrimaryUDN",
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, event.Network, "owner controller %q", tc.ownerController)
+	}
+}
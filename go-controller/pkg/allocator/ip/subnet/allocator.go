@@ -26,6 +26,11 @@ type SubnetConfig struct {
 	Subnets         []*net.IPNet
 	ReservedSubnets []*net.IPNet
 	ExcludeSubnets  []*net.IPNet
+	// AllocatePerIPFamily allocates a single IP per IP family instead of a
+	// single IP per subnet, trying the subnets of each family in order. This
+	// allows subnets to be appended to a set without having allocations span
+	// all of them.
+	AllocatePerIPFamily bool
 }
 
 // Allocator manages the allocation of IP within specific set of subnets
@@ -34,6 +39,7 @@ type Allocator interface {
 	AddOrUpdateSubnet(config SubnetConfig) error
 	DeleteSubnet(name string)
 	GetSubnets(name string) ([]*net.IPNet, error)
+	GetSubnetsUsage(name string) ([]SubnetUsage, error)
 	AllocateUntilFull(name string) error
 	AllocateIPPerSubnet(name string, ips []*net.IPNet) error
 	AllocateNextIPs(name string) ([]*net.IPNet, error)
@@ -50,6 +56,15 @@ type NamedAllocator interface {
 	ReleaseIPs(ips []*net.IPNet) error
 }
 
+// SubnetUsage holds the number of used IPs and the total number of IPs that
+// can be allocated in a subnet. IPs excluded from dynamic allocation, like
+// reserved or infrastructure IPs, count as used.
+type SubnetUsage struct {
+	Subnet   *net.IPNet
+	Used     int
+	Capacity int
+}
+
 // ErrSubnetNotFound is used to inform the subnet is not being managed
 var ErrSubnetNotFound = errors.New("subnet not found")
 
@@ -62,6 +77,9 @@ type subnetInfo struct {
 	ipams []ipallocator.ContinuousAllocator
 	// staticIPAMs holds static IP allocators for reserved subnets that support static IP allocation (currently only supported for Layer2 primary networks)
 	staticIPAMs []ipallocator.StaticAllocator
+	// allocatePerIPFamily allocates a single IP per IP family rather than per
+	// subnet
+	allocatePerIPFamily bool
 }
 
 type continuousIPAMFactoryFunc func(*net.IPNet) (ipallocator.ContinuousAllocator, error)
@@ -106,23 +124,40 @@ func NewAllocator() *allocator {
 }
 
 // AddOrUpdateSubnet set to the allocator for IPAM management, or update it.
+// If the subnet set is already managed and the update only appends subnets to
+// it, the IPAM instances of the existing subnets are preserved along with their
+// allocations; otherwise all the IPAM instances are replaced.
 func (allocator *allocator) AddOrUpdateSubnet(config SubnetConfig) error {
 	allocator.Lock()
 	defer allocator.Unlock()
+	var existing *subnetInfo
 	if subnetInfo, ok := allocator.cache[config.Name]; ok && !reflect.DeepEqual(subnetInfo.subnets, config.Subnets) {
-		klog.Warningf("Replacing subnets %v with %v for %s", util.StringSlice(subnetInfo.subnets), util.StringSlice(config.Subnets), config.Name)
+		if isSubnetsExpansion(subnetInfo.subnets, config.Subnets) {
+			klog.Infof("Expanding subnets %v to %v for %s", util.StringSlice(subnetInfo.subnets), util.StringSlice(config.Subnets), config.Name)
+			existing = &subnetInfo
+		} else {
+			klog.Warningf("Replacing subnets %v with %v for %s", util.StringSlice(subnetInfo.subnets), util.StringSlice(config.Subnets), config.Name)
+		}
 	}
 	var ipams []ipallocator.ContinuousAllocator
+	// preserved tracks the indexes of the IPAM instances carried over from the
+	// existing subnets, exclusions were already applied to those.
+	preserved := map[int]bool{}
 
 	// subnetBoundaryIPs holds network and broadcast addresses for IPv4 subnets.
 	// These are automatically excluded from reserved subnet allocators to prevent allocation.
 	var subnetBoundaryIPs []net.IP
-	for _, subnet := range config.Subnets {
-		ipam, err := allocator.ipamFunc(subnet)
-		if err != nil {
-			return fmt.Errorf("failed to initialize IPAM of subnet %s for %s: %w", subnet, config.Name, err)
+	for i, subnet := range config.Subnets {
+		if ipam := existing.ipamFor(subnet); ipam != nil {
+			ipams = append(ipams, ipam)
+			preserved[i] = true
+		} else {
+			ipam, err := allocator.ipamFunc(subnet)
+			if err != nil {
+				return fmt.Errorf("failed to initialize IPAM of subnet %s for %s: %w", subnet, config.Name, err)
+			}
+			ipams = append(ipams, ipam)
 		}
-		ipams = append(ipams, ipam)
 
 		if utilnet.IsIPv4CIDR(subnet) {
 			subnetBoundaryIPs = append(subnetBoundaryIPs, subnet.IP, util.SubnetBroadcastIP(*subnet))
@@ -134,6 +169,10 @@ func (allocator *allocator) AddOrUpdateSubnet(config SubnetConfig) error {
 		var excluded bool
 		for i, subnet := range config.Subnets {
 			if util.ContainsCIDR(subnet, excludeFromIPAM) {
+				if preserved[i] {
+					excluded = true
+					continue
+				}
 				err := reserveSubnets(excludeFromIPAM, ipams[i])
 				if err != nil {
 					return fmt.Errorf("failed to exclude subnet %s for %s: %w", excludeFromIPAM, config.Name, err)
@@ -148,6 +187,10 @@ func (allocator *allocator) AddOrUpdateSubnet(config SubnetConfig) error {
 
 	var staticIPAMs []ipallocator.StaticAllocator
 	for _, reservedSubnet := range config.ReservedSubnets {
+		if ipam := existing.staticIPAMFor(reservedSubnet); ipam != nil {
+			staticIPAMs = append(staticIPAMs, ipam)
+			continue
+		}
 		ipam, err := allocator.reservedIPAMFunc(reservedSubnet)
 		if err != nil {
 			return fmt.Errorf("failed to initialize IPAM of reserved subnet %s for %s: %w", reservedSubnet, config.Name, err)
@@ -164,9 +207,63 @@ func (allocator *allocator) AddOrUpdateSubnet(config SubnetConfig) error {
 		}
 	}
 	allocator.cache[config.Name] = subnetInfo{
-		subnets:     config.Subnets,
-		ipams:       ipams,
-		staticIPAMs: staticIPAMs,
+		subnets:             config.Subnets,
+		ipams:               ipams,
+		staticIPAMs:         staticIPAMs,
+		allocatePerIPFamily: config.AllocatePerIPFamily,
+	}
+	return nil
+}
+
+// isSubnetsExpansion returns true if updated contains all the subnets in
+// current plus at least an additional one.
+func isSubnetsExpansion(current, updated []*net.IPNet) bool {
+	if len(current) == 0 || len(updated) <= len(current) {
+		return false
+	}
+	for _, subnet := range current {
+		if !containsSubnet(updated, subnet) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsSubnet(subnets []*net.IPNet, subnet *net.IPNet) bool {
+	for _, s := range subnets {
+		if s.String() == subnet.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// ipamFor returns the IPAM instance managing exactly the provided subnet, if
+// any.
+func (s *subnetInfo) ipamFor(subnet *net.IPNet) ipallocator.ContinuousAllocator {
+	if s == nil {
+		return nil
+	}
+	for _, ipam := range s.ipams {
+		cidr := ipam.CIDR()
+		if cidr.String() == subnet.String() {
+			return ipam
+		}
+	}
+	return nil
+}
+
+// staticIPAMFor returns the static IPAM instance managing exactly the provided
+// reserved subnet, if any.
+func (s *subnetInfo) staticIPAMFor(subnet *net.IPNet) ipallocator.StaticAllocator {
+	if s == nil {
+		return nil
+	}
+	for _, ipam := range s.staticIPAMs {
+		cidr := ipam.CIDR()
+		if cidr.String() == subnet.String() {
+			return ipam
+		}
 	}
 	return nil
 }
@@ -196,6 +293,27 @@ func (allocator *allocator) GetSubnets(name string) ([]*net.IPNet, error) {
 	return nil, ErrSubnetNotFound
 }
 
+// GetSubnetsUsage returns the IP usage of each of the subnets of the set
+func (allocator *allocator) GetSubnetsUsage(name string) ([]SubnetUsage, error) {
+	allocator.RLock()
+	defer allocator.RUnlock()
+	subnetInfo, ok := allocator.cache[name]
+	if !ok {
+		return nil, ErrSubnetNotFound
+	}
+	usage := make([]SubnetUsage, 0, len(subnetInfo.ipams))
+	for i, ipam := range subnetInfo.ipams {
+		subnet := *subnetInfo.subnets[i]
+		used := ipam.Used()
+		usage = append(usage, SubnetUsage{
+			Subnet:   &subnet,
+			Used:     used,
+			Capacity: used + ipam.Free(),
+		})
+	}
+	return usage, nil
+}
+
 // AllocateUntilFull used for unit testing only, allocates the rest of the subnet
 func (allocator *allocator) AllocateUntilFull(name string) error {
 	allocator.RLock()
@@ -321,6 +439,7 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 	allocator.RLock()
 	defer allocator.RUnlock()
 	var ipnets []*net.IPNet
+	var allocatedIdx []int
 	var ip net.IP
 	var err error
 	subnetInfo, ok := allocator.cache[name]
@@ -342,8 +461,8 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 		if err != nil {
 			// iterate over range of already allocated indices and release
 			// ips allocated before the error occurred.
-			for relIdx, relIPNet := range ipnets {
-				subnetInfo.ipams[relIdx].Release(relIPNet.IP)
+			for i, relIPNet := range ipnets {
+				subnetInfo.ipams[allocatedIdx[i]].Release(relIPNet.IP)
 				if relIPNet.IP != nil {
 					klog.Warningf("Reserved IP %s was released for %s", relIPNet.IP, name)
 				}
@@ -351,23 +470,58 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 		}
 	}()
 
-	for idx, ipam := range subnetInfo.ipams {
-		ip, err = ipam.AllocateNext()
-		if err != nil {
+	for _, group := range subnetInfo.allocationGroups() {
+		var allocated bool
+		for _, idx := range group {
+			ip, err = subnetInfo.ipams[idx].AllocateNext()
 			if errors.Is(err, ipallocator.ErrFull) {
-				err = fmt.Errorf("failed to allocate new IPs for %s: %w", name, ipallocator.ErrFull)
+				continue
 			}
-			return nil, err
+			if err != nil {
+				return nil, err
+			}
+			allocatedIdx = append(allocatedIdx, idx)
+			ipnets = append(ipnets, &net.IPNet{
+				IP:   ip,
+				Mask: subnetInfo.subnets[idx].Mask,
+			})
+			allocated = true
+			break
 		}
-		ipnet := &net.IPNet{
-			IP:   ip,
-			Mask: subnetInfo.subnets[idx].Mask,
+		if !allocated {
+			err = fmt.Errorf("failed to allocate new IPs for %s: %w", name, ipallocator.ErrFull)
+			return nil, err
 		}
-		ipnets = append(ipnets, ipnet)
 	}
 	return ipnets, nil
 }
 
+// allocationGroups returns the indexes of the subnets grouped by the IP that
+// will be allocated from them: a single IP is allocated from each group, trying
+// its subnets in order until one of them is not full. Unless a single IP per
+// IP family is to be allocated, each subnet is its own group.
+func (s *subnetInfo) allocationGroups() [][]int {
+	groups := make([][]int, 0, len(s.subnets))
+	if !s.allocatePerIPFamily {
+		for idx := range s.subnets {
+			groups = append(groups, []int{idx})
+		}
+		return groups
+	}
+	familyGroup := map[bool]int{}
+	for idx, subnet := range s.subnets {
+		isIPv6 := utilnet.IsIPv6CIDR(subnet)
+		group, ok := familyGroup[isIPv6]
+		if !ok {
+			group = len(groups)
+			familyGroup[isIPv6] = group
+			groups = append(groups, nil)
+		}
+		groups[group] = append(groups[group], idx)
+	}
+	return groups
+}
+
 // ReleaseIPs marks the IPs in ipnets slice as available for allocation by
 // releasing them from the IPAM pool of allocated IPs of the given subnet set.
 // If there aren't IPs to release the method does not return an error.
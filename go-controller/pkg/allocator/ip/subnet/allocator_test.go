@@ -104,6 +104,50 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			}
 		})
 
+		ginkgo.It("preserves existing allocations when subnets are appended", func() {
+			err := allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:           subnetName,
+				Subnets:        ovntest.MustParseIPNets("10.1.1.0/24"),
+				ExcludeSubnets: ovntest.MustParseIPNets("10.1.1.1/32"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			ips, err := allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.2/24"}))
+
+			err = allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:           subnetName,
+				Subnets:        ovntest.MustParseIPNets("10.1.1.0/24", "10.1.2.0/24"),
+				ExcludeSubnets: ovntest.MustParseIPNets("10.1.1.1/32", "10.1.2.1/32"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Expect(allocator.AllocateIPPerSubnet(subnetName, ips)).To(gomega.MatchError(ipam.ErrAllocated))
+			ips, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.3/24", "10.1.2.2/24"}))
+		})
+
+		ginkgo.It("replaces existing allocations when subnets are removed", func() {
+			err := allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:    subnetName,
+				Subnets: ovntest.MustParseIPNets("10.1.1.0/24", "10.1.2.0/24"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			ips, err := allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			err = allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:    subnetName,
+				Subnets: ovntest.MustParseIPNets("10.1.1.0/24"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Expect(allocator.AllocateIPPerSubnet(subnetName, ips[:1])).To(gomega.Succeed())
+		})
+
 	})
 
 	ginkgo.Context("when allocating IP addresses", func() {
@@ -219,6 +263,27 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			gomega.Expect(ips).To(gomega.BeEmpty())
 		})
 
+		ginkgo.It("allocates a single IP per IP family when requested", func() {
+			err := allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:                subnetName,
+				Subnets:             ovntest.MustParseIPNets("10.1.1.0/30", "2000::/64", "10.1.2.0/24"),
+				AllocatePerIPFamily: true,
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			expectedIPAllocations := [][]string{
+				{"10.1.1.1/30", "2000::1/64"},
+				{"10.1.1.2/30", "2000::2/64"},
+				{"10.1.2.1/24", "2000::3/64"},
+				{"10.1.2.2/24", "2000::4/64"},
+			}
+			for _, expectedIPs := range expectedIPAllocations {
+				ips, err := allocator.AllocateNextIPs(subnetName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(util.StringSlice(ips)).To(gomega.Equal(expectedIPs))
+			}
+		})
+
 		ginkgo.It("fails correctly when trying to block a previously allocated IP", func() {
 			subnets := []string{
 				"10.1.1.0/24",
@@ -258,6 +323,31 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("not contained in any known subnet")))
 		})
 
+		ginkgo.It("reports the usage of each subnet", func() {
+			err := allocator.AddOrUpdateSubnet(SubnetConfig{
+				Name:           subnetName,
+				Subnets:        ovntest.MustParseIPNets("10.1.1.0/24", "2000::/64"),
+				ExcludeSubnets: ovntest.MustParseIPNets("10.1.1.0/30"),
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			for range 2 {
+				_, err = allocator.AllocateNextIPs(subnetName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+
+			usage, err := allocator.GetSubnetsUsage(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(usage).To(gomega.Equal([]SubnetUsage{
+				// excluded IPs count as used
+				{Subnet: ovntest.MustParseIPNet("10.1.1.0/24"), Used: 5, Capacity: 254},
+				// IPv6 subnets are capped to 65536 addresses
+				{Subnet: ovntest.MustParseIPNet("2000::/64"), Used: 2, Capacity: 65535},
+			}))
+
+			_, err = allocator.GetSubnetsUsage("unknown")
+			gomega.Expect(err).To(gomega.MatchError(ErrSubnetNotFound))
+		})
 	})
 
 	// Reserved subnets test cases
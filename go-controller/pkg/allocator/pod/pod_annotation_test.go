@@ -101,6 +101,14 @@ func (c *persistentIPsStub) UpdateIPAMClaimStatus(ipamClaim *ipamclaimsapi.IPAMC
 	return updatedClaim
 }
 
+func (c *persistentIPsStub) EnsureStatefulSetIPAMClaim(_ *corev1.Pod, _ *nadapi.NetworkSelectionElement) error {
+	return nil
+}
+
+func (c *persistentIPsStub) ReleaseStatefulSetIPAMClaim(_, _ string) error {
+	return nil
+}
+
 func ipamClaimKey(namespace string, claimName string) string {
 	return fmt.Sprintf("%s/%s", namespace, claimName)
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/clustermanager/nooverlay"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/clustermanager/routeadvertisements"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/clustermanager/status_manager"
+	uplinkcontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/clustermanager/uplink"
 	udncontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork"
 	udntemplate "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
 	vtepcontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/clustermanager/vtep"
@@ -77,6 +78,7 @@ type ClusterManager struct {
 	noOverlayController  *nooverlay.Controller
 	managedBGPController *managedbgp.Controller
 	vtepController       *vtepcontroller.Controller
+	uplinkController     *uplinkcontroller.Controller
 }
 
 // NewClusterManager creates a new cluster manager to manage the cluster nodes.
@@ -224,6 +226,11 @@ func NewClusterManager(
 		cm.vtepController = vtepcontroller.NewController(wf, ovnClient, recorder)
 	}
 
+	if util.IsUplinkEnabled() {
+		cm.uplinkController = uplinkcontroller.NewController(wf, ovnClient, cm.networkManager.Interface(),
+			cm.userDefinedNetworkController.UpdateSubsystemCondition)
+	}
+
 	return cm, nil
 }
 
@@ -320,6 +327,12 @@ func (cm *ClusterManager) Start(ctx context.Context) error {
 		}
 	}
 
+	if cm.uplinkController != nil {
+		if err := cm.uplinkController.Start(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -352,6 +365,10 @@ func (cm *ClusterManager) Stop() {
 		cm.vtepController.Stop()
 		cm.vtepController = nil
 	}
+	if cm.uplinkController != nil {
+		cm.uplinkController.Stop()
+		cm.uplinkController = nil
+	}
 	if cm.raController != nil {
 		if cm.managedBGPController != nil {
 			cm.managedBGPController.Stop()
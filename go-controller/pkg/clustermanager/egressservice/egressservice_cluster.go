@@ -6,6 +6,7 @@ package egressservice
 import (
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -15,6 +16,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -76,7 +78,8 @@ type Controller struct {
 }
 
 type svcState struct {
-	node     string
+	// nodes holds the names of the nodes selected for the service, in selection order
+	nodes    []string
 	selector labels.Selector
 	stale    bool
 }
@@ -275,20 +278,8 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		nodeSelector := &es.Spec.NodeSelector
-		svcHost := es.Status.Host
-
-		if svcHost == "" {
-			continue
-		}
-
-		node, err := c.watchFactory.GetNode(svcHost)
-		if err != nil {
-			klog.Errorf("Node %s could not be retrieved from lister, err: %v", svcHost, err)
-			continue
-		}
-		if !nodeIsReady(node) {
-			klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+		svcHosts := util.GetEgressServiceHosts(es)
+		if len(svcHosts) == 0 {
 			continue
 		}
 
@@ -303,9 +294,10 @@ func (c *Controller) repair() error {
 			continue
 		}
 
+		nodeSelector := &es.Spec.NodeSelector
 		if len(epsNodes) != 0 && svc.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyTypeLocal {
 			// If the service is ETP=Local only a node with local eps can be used.
-			// We want to verify that the current selected node has a local ep.
+			// We want to verify that the current selected nodes have a local ep.
 			matchEpsNodes := metav1.LabelSelectorRequirement{
 				Key:      "kubernetes.io/hostname",
 				Operator: metav1.LabelSelectorOpIn,
@@ -320,24 +312,45 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		if !selector.Matches(labels.Set(node.Labels)) {
-			klog.Infof("Node %s does no longer match service %s selectors %s", svcHost, key, selector.String())
-			continue
-		}
+		svcState := &svcState{selector: selector, stale: false}
+		activeHosts := util.GetEgressServiceActiveHosts(es)
+		for _, svcHost := range svcHosts {
+			if len(svcState.nodes) == activeHosts {
+				break
+			}
 
-		nodeState, ok := c.nodes[svcHost]
-		if !ok {
-			nodeState, err = c.nodeStateFor(svcHost)
+			node, err := c.watchFactory.GetNode(svcHost)
 			if err != nil {
-				klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+				klog.Errorf("Node %s could not be retrieved from lister, err: %v", svcHost, err)
 				continue
 			}
+			if !nodeIsReady(node) {
+				klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+				continue
+			}
+
+			if !selector.Matches(labels.Set(node.Labels)) {
+				klog.Infof("Node %s does no longer match service %s selectors %s", svcHost, key, selector.String())
+				continue
+			}
+
+			nodeState, ok := c.nodes[svcHost]
+			if !ok {
+				nodeState, err = c.nodeStateFor(svcHost)
+				if err != nil {
+					klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+					continue
+				}
+			}
+
+			svcState.nodes = append(svcState.nodes, svcHost)
+			nodeState.allocations[key] = svcState
+			c.nodes[svcHost] = nodeState
 		}
 
-		svcState := &svcState{node: svcHost, selector: selector, stale: false}
-		nodeState.allocations[key] = svcState
-		c.nodes[svcHost] = nodeState
-		c.services[key] = svcState
+		if len(svcState.nodes) > 0 {
+			c.services[key] = svcState
+		}
 	}
 
 	errorList := []error{}
@@ -372,16 +385,16 @@ func (c *Controller) repair() error {
 
 	// now remove any stale egress service labels on nodes
 	nodes, _ := c.watchFactory.GetNodes()
-	svcLabelToNode := map[string]string{}
+	svcLabelToNodes := map[string]sets.Set[string]{}
 	for key, state := range c.services {
 		namespace, name, _ := cache.SplitMetaNamespaceKey(key)
-		svcLabelToNode[c.nodeLabelForService(namespace, name)] = state.node
+		svcLabelToNodes[c.nodeLabelForService(namespace, name)] = sets.New(state.nodes...)
 	}
 
 	for _, node := range nodes {
 		labelsToRemove := map[string]any{}
 		for labelKey := range node.Labels {
-			if strings.HasPrefix(labelKey, egressSVCLabelPrefix) && svcLabelToNode[labelKey] != node.Name {
+			if strings.HasPrefix(labelKey, egressSVCLabelPrefix) && !svcLabelToNodes[labelKey].Has(node.Name) {
 				labelsToRemove[labelKey] = nil // Patching with a nil value results in the delete of the key
 			}
 		}
@@ -591,44 +604,88 @@ func (c *Controller) syncEgressService(key string) error {
 		return c.clearServiceResourcesAndRequeue(key, state, noHost)
 	}
 
-	if state == nil {
-		// The service has a valid EgressService and wasn't configured before.
-		// This means we need to select a node for it that matches its selector.
-		c.unallocatedServices[key] = selector
+	// We keep the nodes already selected for the service that still match its selector,
+	// up to the number of hosts requested, and select new nodes for the missing ones.
+	// The nodes that can't be kept are released.
+	activeHosts := util.GetEgressServiceActiveHosts(es)
+	hosts := []string{}
+	released := []string{}
+	if state != nil {
+		for _, nodeName := range state.nodes {
+			node := c.nodes[nodeName]
+			if len(hosts) < activeHosts && node != nil && node.reachable && !node.draining && selector.Matches(labels.Set(node.labels)) {
+				hosts = append(hosts, nodeName)
+				continue
+			}
+			released = append(released, nodeName)
+		}
+	}
 
-		node, err := c.selectNodeFor(selector)
-		if err != nil {
-			return err
+	selected := map[string]*nodeState{}
+	var selectErr error
+	for len(hosts) < activeHosts {
+		var node *nodeState
+		node, selectErr = c.selectNodeFor(selector, sets.New(hosts...))
+		if selectErr != nil {
+			break
 		}
+		hosts = append(hosts, node.name)
+		selected[node.name] = node
+	}
+
+	if len(hosts) == 0 {
+		// No node can handle the service's traffic. We clear its existing configuration
+		// and keep it in the unallocated cache to retry once a node becomes available.
+		c.unallocatedServices[key] = selector
+		if state != nil {
+			return c.clearServiceResourcesAndRequeue(key, state, noHost)
+		}
+		return selectErr
+	}
 
-		// We found a node - update the caches with the new objects.
+	if len(hosts) < activeHosts {
+		// Fewer nodes than requested can handle the service's traffic, we keep it
+		// in the unallocated cache to select more nodes when they become available.
+		klog.V(4).Infof("EgressService %s/%s has %d hosts instead of %d: %v", namespace, name, len(hosts), activeHosts, selectErr)
+		c.unallocatedServices[key] = selector
+	} else {
 		delete(c.unallocatedServices, key)
-		newState := &svcState{node: node.name, selector: selector, stale: false}
-		c.services[key] = newState
-		node.allocations[key] = newState
-		c.nodes[node.name] = node
-		state = newState
 	}
 
-	state.selector = selector
-	node := c.nodes[state.node]
+	// Node allocation is done - set the status to mark the nodes as the ones holding the service,
+	// this will also override manual changes. Then update the caches and the node labels.
+	err = c.setEgressServiceHosts(namespace, name, hosts)
+	if err != nil {
+		return err
+	}
 
-	if !state.selector.Matches(labels.Set(node.labels)) {
-		// The node no longer matches the selector.
-		// We clear its configured resources and requeue it to attempt
-		// selecting a new node for it.
-		return c.clearServiceResourcesAndRequeue(key, state, noHost)
+	if state == nil {
+		state = &svcState{stale: false}
+		c.services[key] = state
 	}
+	state.selector = selector
+	state.nodes = hosts
 
-	// Node allocation is done - the last step is to label the node and set the status
-	// to mark it as the node holding the service.
+	for _, nodeName := range released {
+		if node, found := c.nodes[nodeName]; found {
+			delete(node.allocations, key)
+		}
+		if err := c.removeNodeServiceLabel(namespace, name, nodeName); err != nil {
+			return fmt.Errorf("failed to remove svc node label for %s, err: %v", nodeName, err)
+		}
+	}
 
-	err = c.setEgressServiceHost(namespace, name, state.node) // set the EgressService status, will also override manual changes
-	if err != nil {
-		return err
+	for _, nodeName := range hosts {
+		if node, found := selected[nodeName]; found {
+			c.nodes[nodeName] = node
+		}
+		c.nodes[nodeName].allocations[key] = state
+		if err := c.labelNodeForService(namespace, name, nodeName); err != nil {
+			return err
+		}
 	}
 
-	return c.labelNodeForService(namespace, name, node.name)
+	return nil
 }
 
 // Removes the status of an egress service.
@@ -649,21 +706,65 @@ func (c *Controller) clearServiceResourcesAndRequeue(key string, svcState *svcSt
 		return err
 	}
 
-	nodeState, found := c.nodes[svcState.node]
+	for _, nodeName := range svcState.nodes {
+		nodeState, found := c.nodes[nodeName]
+		if found {
+			if err := c.removeNodeServiceLabel(namespace, name, nodeName); err != nil {
+				return fmt.Errorf("failed to remove svc node label for %s, err: %v", nodeName, err)
+			}
+			delete(nodeState.allocations, key)
+		}
+	}
+
+	delete(c.services, key)
+	c.egressServiceQueue.Add(key)
+	return nil
+}
+
+// Removes the given node from the nodes selected for an egress service.
+// This includes updating the status with the remaining nodes,
+// removing the label from the node and updating the caches.
+// If the node was the only one selected for the service all of its
+// resources are cleared instead.
+// This also requeues the service to attempt selecting a new node for it.
+// This should only be called with the controller locked.
+func (c *Controller) removeServiceNodeAndRequeue(key string, svcState *svcState, nodeName string) error {
+	hosts := slices.DeleteFunc(slices.Clone(svcState.nodes), func(n string) bool {
+		return n == nodeName
+	})
+	if len(hosts) == 0 {
+		return c.clearServiceResourcesAndRequeue(key, svcState, noHost)
+	}
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := c.setEgressServiceHosts(namespace, name, hosts); err != nil {
+		return err
+	}
+
+	nodeState, found := c.nodes[nodeName]
 	if found {
-		if err := c.removeNodeServiceLabel(namespace, name, svcState.node); err != nil {
-			return fmt.Errorf("failed to remove svc node label for %s, err: %v", svcState.node, err)
+		if err := c.removeNodeServiceLabel(namespace, name, nodeName); err != nil {
+			return fmt.Errorf("failed to remove svc node label for %s, err: %v", nodeName, err)
 		}
 		delete(nodeState.allocations, key)
 	}
 
-	delete(c.services, key)
+	svcState.nodes = hosts
 	c.egressServiceQueue.Add(key)
 	return nil
 }
 
+// Sets the status of an egress service to the given nodes, the first one being set as its host.
+func (c *Controller) setEgressServiceHosts(namespace, name string, hosts []string) error {
+	return c.kubeOVN.UpdateEgressServiceStatus(namespace, name, hosts[0], hosts)
+}
+
 func (c *Controller) setEgressServiceHost(namespace, name, host string) error {
-	err := c.kubeOVN.UpdateEgressServiceStatus(namespace, name, host)
+	err := c.kubeOVN.UpdateEgressServiceStatus(namespace, name, host, nil)
 	if err != nil {
 		if host != "" {
 			return err
@@ -18,6 +18,7 @@ import (
 	discovery "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	egressserviceapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
@@ -1370,6 +1371,163 @@ var _ = ginkgo.Describe("Cluster manager Egress Service operations", func() {
 			gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("should select multiple hosts and replace the one that is not ready", func() {
+			app.Action = func(*cli.Context) error {
+				namespaceT := *newNamespace("testns")
+				config.IPv6Mode = true
+				node1 := nodeFor(node1Name, node1IPv4, node1IPv6, node1IPv4Subnet, node1IPv6Subnet)
+				node1.Labels["home"] = "pineapple"
+				node2 := nodeFor(node2Name, node2IPv4, node2IPv6, node2IPv4Subnet, node2IPv6Subnet)
+				node2.Labels["home"] = "pineapple"
+				node3 := nodeFor("node3", "150.150.150.0", "fc00:f853:ccd:e793::3", "10.128.3.0/24", "fe00:10:128:3::/64")
+				node3.Labels["home"] = "rock"
+
+				ginkgo.By("creating a service with two active hosts")
+				esvc1 := egressserviceapi.EgressService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1",
+						Namespace: "testns",
+					},
+					Spec: egressserviceapi.EgressServiceSpec{
+						SourceIPBy:  egressserviceapi.SourceIPLoadBalancer,
+						ActiveHosts: 2,
+						NodeSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"home": "pineapple",
+							},
+						},
+					},
+				}
+				svc1 := lbSvcFor("testns", "svc1")
+
+				svc1V4EpSlice := discovery.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1-ipv4-epslice",
+						Namespace: "testns",
+						Labels: map[string]string{
+							discovery.LabelServiceName: "svc1",
+						},
+					},
+					AddressType: discovery.AddressTypeIPv4,
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"10.128.3.5"},
+							NodeName:  &node3.Name,
+						},
+					},
+				}
+
+				objs := []runtime.Object{
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{
+							*node1,
+							*node2,
+							*node3,
+						},
+					},
+					&corev1.ServiceList{
+						Items: []corev1.Service{
+							svc1,
+						},
+					},
+					&discovery.EndpointSliceList{
+						Items: []discovery.EndpointSlice{
+							svc1V4EpSlice,
+						},
+					},
+					&egressserviceapi.EgressServiceList{
+						Items: []egressserviceapi.EgressService{
+							esvc1,
+						},
+					},
+				}
+
+				fakeCM.start(objs...)
+
+				svcLabel := fmt.Sprintf("%s/testns-svc1", egressSVCLabelPrefix)
+				checkHosts := func(expectedHosts []string, unexpectedHosts []string) func() error {
+					return func() error {
+						es, err := fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Get(context.TODO(), esvc1.Name, metav1.GetOptions{})
+						if err != nil {
+							return err
+						}
+
+						if !sets.New(es.Status.Hosts...).Equal(sets.New(expectedHosts...)) {
+							return fmt.Errorf("expected svc1's hosts %v to be %v", es.Status.Hosts, expectedHosts)
+						}
+
+						if es.Status.Host != es.Status.Hosts[0] {
+							return fmt.Errorf("expected svc1's host value %s to be the first of %v", es.Status.Host, es.Status.Hosts)
+						}
+
+						for _, name := range expectedHosts {
+							node, err := fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+							if err != nil {
+								return err
+							}
+							if _, found := node.Labels[svcLabel]; !found {
+								return fmt.Errorf("expected %s's labels %v to contain %s", name, node.Labels, svcLabel)
+							}
+						}
+
+						for _, name := range unexpectedHosts {
+							node, err := fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+							if err != nil {
+								return err
+							}
+							if _, found := node.Labels[svcLabel]; found {
+								return fmt.Errorf("expected %s's labels %v to not contain %s", name, node.Labels, svcLabel)
+							}
+						}
+
+						return nil
+					}
+				}
+
+				gomega.Eventually(checkHosts([]string{node1.Name, node2.Name}, []string{node3.Name})).ShouldNot(gomega.HaveOccurred())
+
+				ginkgo.By("updating the first node to be not ready the service will keep only the second node")
+				node1.Status.Conditions = []corev1.NodeCondition{
+					{
+						Type:   corev1.NodeReady,
+						Status: corev1.ConditionFalse,
+					},
+				}
+				node1.ResourceVersion = "2"
+				_, err := fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node1, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				gomega.Eventually(checkHosts([]string{node2.Name}, []string{node1.Name, node3.Name})).ShouldNot(gomega.HaveOccurred())
+
+				ginkgo.By("updating the third node's labels to match the service it will be selected as the second host")
+				node3.Labels["home"] = "pineapple"
+				node3.ResourceVersion = "2"
+				_, err = fakeCM.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node3, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				gomega.Eventually(checkHosts([]string{node2.Name, node3.Name}, []string{node1.Name})).ShouldNot(gomega.HaveOccurred())
+
+				ginkgo.By("lowering the number of active hosts the service will keep only one of the nodes")
+				es, err := fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Get(context.TODO(), esvc1.Name, metav1.GetOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				es.Spec.ActiveHosts = 1
+				es.ResourceVersion = "2"
+				_, err = fakeCM.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Update(context.TODO(), es, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				gomega.Eventually(checkHosts([]string{node2.Name}, []string{node1.Name, node3.Name})).ShouldNot(gomega.HaveOccurred())
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
 		ginkgo.It("should update labels and status on reachability failure", func() {
 			app.Action = func(*cli.Context) error {
 				namespaceT := *newNamespace("testns")
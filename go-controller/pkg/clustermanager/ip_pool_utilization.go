@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustermanager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/metrics"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// ipPoolUtilizationPeriod is the period at which the IP pools utilization
+	// of a network is recorded and checked against the configured threshold
+	ipPoolUtilizationPeriod = 30 * time.Second
+
+	conditionTypeIPPoolPressure = "IPPoolPressure"
+	// ipPoolUtilizationFieldManager is the field manager of the IPPoolPressure
+	// condition, it must be unique per subsystem reporting network conditions
+	ipPoolUtilizationFieldManager = "IPPoolUtilization"
+)
+
+// ipPoolUsage holds the usage of one of the IP pools of a network
+type ipPoolUsage struct {
+	// name identifies the pool in the reported events and conditions
+	name     string
+	used     uint64
+	capacity uint64
+}
+
+func (p ipPoolUsage) aboveThreshold(threshold int) bool {
+	return p.capacity > 0 && p.used*100 >= uint64(threshold)*p.capacity
+}
+
+// runIPPoolUtilizationUpdater periodically records the IP pools usage of the
+// network until the controller is stopped.
+func (ncc *networkClusterController) runIPPoolUtilizationUpdater() {
+	stopChan := ncc.stopChan
+	ncc.wg.Add(1)
+	go func() {
+		defer ncc.wg.Done()
+		ticker := time.NewTicker(ipPoolUtilizationPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				ncc.updateIPPoolUtilization()
+			case <-stopChan:
+				metrics.DeletePodIPUsage(ncc.GetNetworkName())
+				return
+			}
+		}
+	}()
+}
+
+// updateIPPoolUtilization records the pod IP usage of the network and reports
+// the IP pools whose utilization is above the configured threshold through a
+// network status condition. A warning event is reported every time a pool
+// crosses the threshold.
+func (ncc *networkClusterController) updateIPPoolUtilization() {
+	pools := ncc.getIPPoolsUsage()
+
+	threshold := config.Metrics.IPPoolUtilizationThreshold
+	if threshold == 0 || ncc.statusReporter == nil {
+		return
+	}
+
+	aboveThreshold := sets.New[string]()
+	events := []*util.EventDetails{}
+	for _, pool := range pools {
+		if !pool.aboveThreshold(threshold) {
+			continue
+		}
+		aboveThreshold.Insert(pool.name)
+		if ncc.ipPoolsAboveThreshold.Has(pool.name) {
+			continue
+		}
+		events = append(events, &util.EventDetails{
+			EventType: util.EventTypeWarning,
+			Reason:    "IPPoolUtilizationHigh",
+			Note: fmt.Sprintf("Utilization of %s is %d%% (%d/%d), above the %d%% threshold",
+				pool.name, pool.used*100/pool.capacity, pool.used, pool.capacity, threshold),
+		})
+	}
+	// the condition is always reported the first time, to be up to date after
+	// a restart
+	if ncc.ipPoolsAboveThreshold != nil && ncc.ipPoolsAboveThreshold.Equal(aboveThreshold) {
+		return
+	}
+
+	condition := getIPPoolPressureCondition(threshold, sets.List(aboveThreshold))
+	if err := ncc.statusReporter(ncc.GetNetworkName(), ipPoolUtilizationFieldManager, condition, events...); err != nil {
+		// retried on the next period
+		klog.Errorf("Failed to report IP pool utilization for network %s: %v", ncc.GetNetworkName(), err)
+		return
+	}
+	ncc.ipPoolsAboveThreshold = aboveThreshold
+}
+
+// getIPPoolsUsage returns the usage of the pod IP pools, for networks whose pod
+// IPs are allocated by cluster manager, and of the host subnet pools of the
+// network, per IP family. The usage of each pod subnet is recorded as a metric.
+func (ncc *networkClusterController) getIPPoolsUsage() []ipPoolUsage {
+	var pools []ipPoolUsage
+	networkName := ncc.GetNetworkName()
+
+	if ncc.subnetAllocator != nil {
+		usage, err := ncc.subnetAllocator.GetSubnetsUsage(networkName)
+		if err != nil {
+			klog.Warningf("Failed to get pod IP usage for network %s: %v", networkName, err)
+		}
+		v4Pool := ipPoolUsage{name: "pod IPv4 addresses"}
+		v6Pool := ipPoolUsage{name: "pod IPv6 addresses"}
+		for _, subnetUsage := range usage {
+			metrics.RecordPodIPUsage(networkName, subnetUsage.Subnet.String(), float64(subnetUsage.Used), float64(subnetUsage.Capacity))
+			pool := &v4Pool
+			if utilnet.IsIPv6CIDR(subnetUsage.Subnet) {
+				pool = &v6Pool
+			}
+			pool.used += uint64(subnetUsage.Used)
+			pool.capacity += uint64(subnetUsage.Capacity)
+		}
+		pools = append(pools, v4Pool, v6Pool)
+	}
+
+	if ncc.nodeAllocator != nil && ncc.nodeAllocator.HasNodeSubnetAllocation() {
+		// host subnet usage metrics are already recorded by the node allocator
+		v4used, v4count, v6used, v6count := ncc.nodeAllocator.HostSubnetUsage()
+		pools = append(pools,
+			ipPoolUsage{name: "IPv4 host subnets", used: v4used, capacity: v4count},
+			ipPoolUsage{name: "IPv6 host subnets", used: v6used, capacity: v6count},
+		)
+	}
+
+	return pools
+}
+
+// getIPPoolPressureCondition returns the IPPoolPressure condition for the
+// provided pools above the utilization threshold.
+func getIPPoolPressureCondition(threshold int, poolsAboveThreshold []string) *metav1.Condition {
+	condition := &metav1.Condition{
+		Type:               conditionTypeIPPoolPressure,
+		LastTransitionTime: metav1.Now(),
+	}
+	if len(poolsAboveThreshold) == 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "UtilizationBelowThreshold"
+		condition.Message = fmt.Sprintf("Utilization of all IP pools is below the %d%% threshold", threshold)
+	} else {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "UtilizationAboveThreshold"
+		condition.Message = fmt.Sprintf("Utilization of %s is above the %d%% threshold",
+			strings.Join(poolsAboveThreshold, ", "), threshold)
+	}
+	return condition
+}
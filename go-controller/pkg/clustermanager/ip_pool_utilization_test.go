@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package clustermanager
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ovncnitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/metrics"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func TestUpdateIPPoolUtilizationReportsThresholdCrossing(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	err := config.PrepareTestConfig()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+	config.OVNKubernetesFeature.EnableMultiNetwork = true
+	config.OVNKubernetesFeature.EnableDynamicUDNAllocation = true
+	config.Metrics.IPPoolUtilizationThreshold = 50
+
+	metrics.RegisterClusterManagerFunctional()
+
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf: cnitypes.NetConf{
+			Name: "ns1_udn1_ip_pool_test",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Topology: types.Layer2Topology,
+		Role:     types.NetworkRolePrimary,
+		Subnets:  "10.1.0.0/28",
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	networkName := netInfo.GetNetworkName()
+	defer metrics.DeletePodIPUsage(networkName)
+
+	ipAllocator, err := newIPAllocatorForNetwork(netInfo)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	var conditions []*metav1.Condition
+	var events []*util.EventDetails
+	ncc := &networkClusterController{
+		ReconcilableNetInfo: util.NewReconcilableNetInfo(netInfo),
+		subnetAllocator:     ipAllocator,
+		statusReporter: func(_ string, fieldManager string, condition *metav1.Condition, e ...*util.EventDetails) error {
+			g.Expect(fieldManager).To(gomega.Equal(ipPoolUtilizationFieldManager))
+			conditions = append(conditions, condition)
+			events = append(events, e...)
+			return nil
+		},
+	}
+
+	// the gateway and management port IPs are excluded from allocation
+	ncc.updateIPPoolUtilization()
+	g.Expect(getPodIPsAllocatedMetric(t, networkName, "10.1.0.0/28")).To(gomega.Equal(2.0))
+	g.Expect(conditions).To(gomega.HaveLen(1))
+	g.Expect(conditions[0].Type).To(gomega.Equal(conditionTypeIPPoolPressure))
+	g.Expect(conditions[0].Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(events).To(gomega.BeEmpty())
+
+	var allocated [][]*net.IPNet
+	for range 5 {
+		ips, err := ipAllocator.AllocateNextIPs(networkName)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		allocated = append(allocated, ips)
+	}
+	ncc.updateIPPoolUtilization()
+	g.Expect(getPodIPsAllocatedMetric(t, networkName, "10.1.0.0/28")).To(gomega.Equal(7.0))
+	g.Expect(conditions).To(gomega.HaveLen(2))
+	g.Expect(conditions[1].Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(conditions[1].Message).To(gomega.Equal("Utilization of pod IPv4 addresses is above the 50% threshold"))
+	g.Expect(events).To(gomega.HaveLen(1))
+	g.Expect(events[0].EventType).To(gomega.Equal(util.EventTypeWarning))
+	g.Expect(events[0].Note).To(gomega.Equal("Utilization of pod IPv4 addresses is 50% (7/14), above the 50% threshold"))
+
+	// no update while the pools above the threshold don't change
+	ips, err := ipAllocator.AllocateNextIPs(networkName)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	allocated = append(allocated, ips)
+	ncc.updateIPPoolUtilization()
+	g.Expect(getPodIPsAllocatedMetric(t, networkName, "10.1.0.0/28")).To(gomega.Equal(8.0))
+	g.Expect(conditions).To(gomega.HaveLen(2))
+	g.Expect(events).To(gomega.HaveLen(1))
+
+	for _, ips := range allocated {
+		g.Expect(ipAllocator.ReleaseIPs(networkName, ips)).To(gomega.Succeed())
+	}
+	ncc.updateIPPoolUtilization()
+	g.Expect(getPodIPsAllocatedMetric(t, networkName, "10.1.0.0/28")).To(gomega.Equal(2.0))
+	g.Expect(conditions).To(gomega.HaveLen(3))
+	g.Expect(conditions[2].Status).To(gomega.Equal(metav1.ConditionFalse))
+	g.Expect(events).To(gomega.HaveLen(1))
+}
+
+func getPodIPsAllocatedMetric(t *testing.T, networkName, subnet string) float64 {
+	t.Helper()
+
+	metricName := fmt.Sprintf("%s_%s_%s",
+		types.MetricOvnkubeNamespace,
+		types.MetricOvnkubeSubsystemClusterManager,
+		"allocated_pod_ips",
+	)
+	mfs, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, mf := range mfs {
+		if mf.GetName() != metricName {
+			continue
+		}
+		for _, metric := range mf.GetMetric() {
+			if labelValue(metric.GetLabel(), "network_name") == networkName && labelValue(metric.GetLabel(), "subnet") == subnet {
+				return metric.GetGauge().GetValue()
+			}
+		}
+	}
+	t.Fatalf("metric %s with network_name=%s subnet=%s not found", metricName, networkName, subnet)
+	return 0
+}
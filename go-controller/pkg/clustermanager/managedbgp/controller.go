@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"hash/fnv"
 	"reflect"
+	"slices"
 	"sort"
+	"strings"
 
 	frrtypes "github.com/metallb/frr-k8s/api/v1beta1"
 	frrclientset "github.com/metallb/frr-k8s/pkg/client/clientset/versioned"
@@ -22,10 +24,12 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	controllerutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/controller"
 	ratypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+	raapply "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/applyconfiguration/routeadvertisements/v1"
 	raclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned"
 	ralisters "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/listers/routeadvertisements/v1"
 	apitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/types"
@@ -70,6 +74,14 @@ func BaseFRRConfigName() string {
 	return managedHashedName(fmt.Sprintf("%d", config.ManagedBGP.ASNumber))
 }
 
+// RouteReflectorFRRConfigName returns the name of the FRRConfiguration that
+// configures the route reflector nodes to peer with their clients when the
+// managed BGP topology is route-reflector. The name follows the pattern
+// "ovnk-managed-<hash>" where <hash> is derived from the configured AS number.
+func RouteReflectorFRRConfigName() string {
+	return managedHashedName(fmt.Sprintf("%d/%s", config.ManagedBGP.ASNumber, config.ManagedBGPTopologyRouteReflector))
+}
+
 // Controller manages the BGP topology for no-overlay networks with managed routing
 type Controller struct {
 	frrClient            frrclientset.Interface
@@ -159,7 +171,18 @@ func (c *Controller) nodeNeedsUpdate(oldNode, newNode *corev1.Node) bool {
 	// We care about node IP changes
 	oldV4, oldV6 := util.GetNodeInternalAddrs(oldNode)
 	newV4, newV6 := util.GetNodeInternalAddrs(newNode)
-	return !reflect.DeepEqual(oldV4, newV4) || !reflect.DeepEqual(oldV6, newV6)
+	if !reflect.DeepEqual(oldV4, newV4) || !reflect.DeepEqual(oldV6, newV6) {
+		return true
+	}
+	// and about nodes becoming or ceasing to be route reflectors
+	if config.ManagedBGP.Topology == config.ManagedBGPTopologyRouteReflector && !reflect.DeepEqual(oldNode.Labels, newNode.Labels) {
+		selector, err := routeReflectorSelector()
+		if err != nil {
+			return true
+		}
+		return selector.Matches(labels.Set(oldNode.Labels)) != selector.Matches(labels.Set(newNode.Labels))
+	}
+	return false
 }
 
 func (c *Controller) managedRANeedsUpdate(oldRA, newRA *ratypes.RouteAdvertisements) bool {
@@ -176,7 +199,7 @@ func (c *Controller) managedRANeedsUpdate(oldRA, newRA *ratypes.RouteAdvertiseme
 }
 
 func (c *Controller) managedFRRConfigNeedsUpdate(oldConfig, newConfig *frrtypes.FRRConfiguration) bool {
-	if newConfig == nil || newConfig.Namespace != config.ManagedBGP.FRRNamespace || newConfig.Name != BaseFRRConfigName() {
+	if newConfig == nil || newConfig.Namespace != config.ManagedBGP.FRRNamespace || !isManagedFRRConfigName(newConfig.Name) {
 		return false
 	}
 	if isOwnUpdate(newConfig.ManagedFields) {
@@ -199,22 +222,29 @@ func (c *Controller) reconcileManagedRouteAdvertisement(key string) error {
 	if err := c.ensureManagedRouteAdvertisement(types.DefaultNetworkName); err != nil {
 		return fmt.Errorf("failed to ensure managed RouteAdvertisement: %w", err)
 	}
-	return nil
+	// (re)report the route reflectors in the status of the managed RouteAdvertisements
+	return c.ensureManagedBaseFRRConfiguration()
 }
 
 func (c *Controller) reconcileManagedFRRConfiguration(key string) error {
-	if key != c.managedBaseFRRConfigKey() {
+	namespace, name, found := strings.Cut(key, "/")
+	if !found || namespace != config.ManagedBGP.FRRNamespace || !isManagedFRRConfigName(name) {
 		return nil
 	}
 	return c.ensureManagedBaseFRRConfiguration()
 }
 
-func (c *Controller) defaultManagedRAName() string {
-	return ManagedRouteAdvertisementName(types.DefaultNetworkName)
+// isManagedFRRConfigName returns whether name is one of the FRRConfigurations
+// this controller maintains for the configured topology.
+func isManagedFRRConfigName(name string) bool {
+	if name == BaseFRRConfigName() {
+		return true
+	}
+	return config.ManagedBGP.Topology == config.ManagedBGPTopologyRouteReflector && name == RouteReflectorFRRConfigName()
 }
 
-func (c *Controller) managedBaseFRRConfigKey() string {
-	return fmt.Sprintf("%s/%s", config.ManagedBGP.FRRNamespace, BaseFRRConfigName())
+func (c *Controller) defaultManagedRAName() string {
+	return ManagedRouteAdvertisementName(types.DefaultNetworkName)
 }
 
 func (c *Controller) ensureManagedResources() error {
@@ -230,31 +260,146 @@ func (c *Controller) reconcileNode(_ string) error {
 }
 
 func (c *Controller) ensureManagedBaseFRRConfiguration() error {
-	if config.ManagedBGP.Topology != config.ManagedBGPTopologyFullMesh {
+	nodes, err := c.wf.GetNodes()
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	switch config.ManagedBGP.Topology {
+	case config.ManagedBGPTopologyFullMesh:
+		// For full-mesh, we ensure there is a single base FRRConfiguration peering with all nodes.
+		// The RouteAdvertisements controller will then generate per-node configs based on this,
+		// excluding self-peering.
+		if err := c.cleanupStaleFRRConfigurations(BaseFRRConfigName()); err != nil {
+			return err
+		}
+		if err := c.ensureFRRConfiguration(BaseFRRConfigName(), metav1.LabelSelector{}, nodeNeighbors(nodes), ""); err != nil {
+			klog.Errorf("Failed to ensure base FRRConfiguration: %v", err)
+			return err
+		}
+		return c.updateRouteReflectorsStatus(nil)
+	case config.ManagedBGPTopologyRouteReflector:
+		if err := c.ensureRouteReflectorFRRConfigurations(nodes); err != nil {
+			klog.Errorf("Failed to ensure route reflector FRRConfigurations: %v", err)
+			return err
+		}
+		return nil
+	default:
 		return fmt.Errorf("unsupported managed BGP topology: %s", config.ManagedBGP.Topology)
 	}
+}
 
-	nodes, err := c.wf.GetNodes()
+// routeReflectorSelector returns the selector of the route reflector nodes.
+func routeReflectorSelector() (labels.Selector, error) {
+	labelSelector, err := routeReflectorLabelSelector()
 	if err != nil {
-		return fmt.Errorf("failed to list nodes: %w", err)
+		return nil, err
 	}
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
 
-	// For full-mesh, we ensure there is a single base FRRConfiguration peering with all nodes.
-	// The RouteAdvertisements controller will then generate per-node configs based on this,
-	// excluding self-peering.
-	if err := c.ensureBaseFRRConfiguration(nodes); err != nil {
-		klog.Errorf("Failed to ensure base FRRConfiguration: %v", err)
+func routeReflectorLabelSelector() (*metav1.LabelSelector, error) {
+	labelSelector, err := metav1.ParseToLabelSelector(config.ManagedBGP.RouteReflectorNodeSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route reflector node selector %q: %w", config.ManagedBGP.RouteReflectorNodeSelector, err)
+	}
+	// normalize to the form stored by the API server, so that it compares
+	// equal to the existing FRRConfiguration node selector
+	if len(labelSelector.MatchExpressions) == 0 {
+		labelSelector.MatchExpressions = nil
+	}
+	return labelSelector, nil
+}
+
+// ensureRouteReflectorFRRConfigurations creates or updates the
+// FRRConfigurations for an iBGP topology where the nodes selected by the route
+// reflector node selector reflect routes to all other nodes:
+//   - the base FRRConfiguration applies to all nodes and peers them with the
+//     route reflectors, route reflectors are thus fully meshed between them.
+//   - the route reflector FRRConfiguration applies to the route reflectors and
+//     peers them with all other nodes, configured as route reflector clients.
+func (c *Controller) ensureRouteReflectorFRRConfigurations(allNodes []*corev1.Node) error {
+	labelSelector, err := routeReflectorLabelSelector()
+	if err != nil {
 		return err
 	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return fmt.Errorf("invalid route reflector node selector %q: %w", config.ManagedBGP.RouteReflectorNodeSelector, err)
+	}
 
-	return nil
+	var reflectors, clients []*corev1.Node
+	for _, node := range allNodes {
+		if selector.Matches(labels.Set(node.Labels)) {
+			reflectors = append(reflectors, node)
+		} else {
+			clients = append(clients, node)
+		}
+	}
+	if len(reflectors) == 0 {
+		klog.Warningf("No node matches the route reflector node selector %q, nodes won't have any BGP peer",
+			config.ManagedBGP.RouteReflectorNodeSelector)
+	}
+
+	if err := c.cleanupStaleFRRConfigurations(BaseFRRConfigName(), RouteReflectorFRRConfigName()); err != nil {
+		return err
+	}
+	if err := c.ensureFRRConfiguration(BaseFRRConfigName(), metav1.LabelSelector{}, nodeNeighbors(reflectors), ""); err != nil {
+		return err
+	}
+	clientNeighbors := nodeNeighbors(clients)
+	if err := c.ensureFRRConfiguration(RouteReflectorFRRConfigName(), *labelSelector, clientNeighbors,
+		routeReflectorClientRawConfig(clientNeighbors)); err != nil {
+		return err
+	}
+
+	reflectorNames := make([]string, 0, len(reflectors))
+	for _, node := range reflectors {
+		reflectorNames = append(reflectorNames, node.Name)
+	}
+	sort.Strings(reflectorNames)
+	return c.updateRouteReflectorsStatus(reflectorNames)
+}
+
+// routeReflectorClientRawConfig generates the raw FRR configuration setting the
+// given neighbors as route reflector clients.
+// TODO: once frr-k8s provides a typed API for this config, we can use that instead of raw config
+func routeReflectorClientRawConfig(neighbors []frrtypes.Neighbor) string {
+	if len(neighbors) == 0 {
+		return ""
+	}
+	var v4, v6 []string
+	for _, neighbor := range neighbors {
+		if utilnet.IsIPv6String(neighbor.Address) {
+			v6 = append(v6, neighbor.Address)
+		} else {
+			v4 = append(v4, neighbor.Address)
+		}
+	}
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "router bgp %d\n", config.ManagedBGP.ASNumber)
+	for _, family := range []struct {
+		name      string
+		addresses []string
+	}{{"ipv4", v4}, {"ipv6", v6}} {
+		if len(family.addresses) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buf, " address-family %s unicast\n", family.name)
+		for _, address := range family.addresses {
+			fmt.Fprintf(&buf, "  neighbor %s route-reflector-client\n", address)
+		}
+		buf.WriteString(" exit-address-family\n")
+	}
+	buf.WriteString("exit\n!\n")
+	return buf.String()
 }
 
-// ensureBaseFRRConfiguration creates or updates the base FRRConfiguration
-// for iBGP full-mesh peering between all cluster nodes.
-func (c *Controller) ensureBaseFRRConfiguration(allNodes []*corev1.Node) error {
+// nodeNeighbors returns the iBGP neighbors for the internal addresses of the
+// given nodes, sorted by address.
+func nodeNeighbors(nodes []*corev1.Node) []frrtypes.Neighbor {
 	neighbors := []frrtypes.Neighbor{}
-	for _, node := range allNodes {
+	for _, node := range nodes {
 		v4, v6 := util.GetNodeInternalAddrs(node)
 		if v4 != nil {
 			neighbors = append(neighbors, frrtypes.Neighbor{
@@ -273,15 +418,15 @@ func (c *Controller) ensureBaseFRRConfiguration(allNodes []*corev1.Node) error {
 	sort.Slice(neighbors, func(i, j int) bool {
 		return neighbors[i].Address < neighbors[j].Address
 	})
+	return neighbors
+}
 
-	baseName := BaseFRRConfigName()
-	if err := c.cleanupStaleFRRConfigurations(baseName); err != nil {
-		return err
-	}
-
+// ensureFRRConfiguration creates or updates a managed FRRConfiguration applying
+// to the nodes selected by nodeSelector and peering with the given neighbors.
+func (c *Controller) ensureFRRConfiguration(name string, nodeSelector metav1.LabelSelector, neighbors []frrtypes.Neighbor, rawConfig string) error {
 	frrConfig := &frrtypes.FRRConfiguration{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      baseName,
+			Name:      name,
 			Namespace: config.ManagedBGP.FRRNamespace,
 			Labels: map[string]string{
 				FRRConfigManagedLabel: FRRConfigManagedValue,
@@ -289,7 +434,7 @@ func (c *Controller) ensureBaseFRRConfiguration(allNodes []*corev1.Node) error {
 		},
 		Spec: frrtypes.FRRConfigurationSpec{
 			// Empty NodeSelector means it applies as a base for all nodes by RouteAdvertisements controller
-			NodeSelector: metav1.LabelSelector{},
+			NodeSelector: nodeSelector,
 			BGP: frrtypes.BGPConfig{
 				Routers: []frrtypes.Router{
 					{
@@ -298,13 +443,16 @@ func (c *Controller) ensureBaseFRRConfiguration(allNodes []*corev1.Node) error {
 					},
 				},
 			},
+			Raw: frrtypes.RawConfig{
+				Config: rawConfig,
+			},
 		},
 	}
 
-	existing, err := c.frrClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).Get(context.TODO(), baseName, metav1.GetOptions{})
+	existing, err := c.frrClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			klog.Infof("Creating base FRRConfiguration %s", baseName)
+			klog.Infof("Creating managed FRRConfiguration %s", name)
 			_, err = c.frrClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).Create(context.TODO(), frrConfig, metav1.CreateOptions{
 				FieldManager: fieldManager,
 			})
@@ -319,7 +467,7 @@ func (c *Controller) ensureBaseFRRConfiguration(allNodes []*corev1.Node) error {
 	needsUpdate := !reflect.DeepEqual(existing.Spec, frrConfig.Spec) ||
 		existing.Labels[FRRConfigManagedLabel] != FRRConfigManagedValue
 	if needsUpdate {
-		klog.Infof("Updating base FRRConfiguration %s", baseName)
+		klog.Infof("Updating managed FRRConfiguration %s", name)
 		updated := existing.DeepCopy()
 		if updated.Labels == nil {
 			updated.Labels = map[string]string{}
@@ -335,9 +483,41 @@ func (c *Controller) ensureBaseFRRConfiguration(allNodes []*corev1.Node) error {
 	return nil
 }
 
+// updateRouteReflectorsStatus reports the route reflector nodes in the status
+// of the managed RouteAdvertisements, all of which select the managed
+// FRRConfigurations.
+func (c *Controller) updateRouteReflectorsStatus(reflectors []string) error {
+	managedRAReq, _ := labels.NewRequirement(ManagedRANetworkLabel, selection.Exists, nil)
+	managedRAs, err := c.raLister.List(labels.NewSelector().Add(*managedRAReq))
+	if err != nil {
+		return fmt.Errorf("failed to list RouteAdvertisements: %w", err)
+	}
+	for _, ra := range managedRAs {
+		if reflect.DeepEqual(ra.Status.RouteReflectors, reflectors) ||
+			(len(ra.Status.RouteReflectors) == 0 && len(reflectors) == 0) {
+			continue
+		}
+		klog.Infof("Updating route reflectors of managed RouteAdvertisement %s to %v", ra.Name, reflectors)
+		_, err = c.raClient.K8sV1().RouteAdvertisements().ApplyStatus(
+			context.TODO(),
+			raapply.RouteAdvertisements(ra.Name).WithStatus(
+				raapply.RouteAdvertisementsStatus().WithRouteReflectors(reflectors...),
+			),
+			metav1.ApplyOptions{
+				FieldManager: fieldManager,
+				Force:        true,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to apply status for RouteAdvertisements %q: %w", ra.Name, err)
+		}
+	}
+	return nil
+}
+
 // cleanupStaleFRRConfigurations removes any FRRConfigurations with the managed label
-// whose name doesn't match the current base name (e.g. after an AS number change).
-func (c *Controller) cleanupStaleFRRConfigurations(currentBaseName string) error {
+// whose name doesn't match the current names (e.g. after an AS number or topology change).
+func (c *Controller) cleanupStaleFRRConfigurations(currentNames ...string) error {
 	managedConfigs, err := c.frrLister.FRRConfigurations(config.ManagedBGP.FRRNamespace).List(
 		labels.SelectorFromSet(labels.Set{FRRConfigManagedLabel: FRRConfigManagedValue}),
 	)
@@ -345,7 +525,7 @@ func (c *Controller) cleanupStaleFRRConfigurations(currentBaseName string) error
 		return err
 	}
 	for _, cfg := range managedConfigs {
-		if cfg.Name == currentBaseName {
+		if slices.Contains(currentNames, cfg.Name) {
 			continue
 		}
 		if err := c.frrClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).Delete(
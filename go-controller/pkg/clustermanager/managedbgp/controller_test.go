@@ -41,6 +41,7 @@ var _ = ginkgo.Describe("Managed BGP Controller", func() {
 
 		// Save original config
 		oldTopology             string
+		oldRRNodeSelector       string
 		oldASNumber             uint32
 		oldFRRNamespace         string
 		oldOVNConfigNamespace   string
@@ -53,6 +54,7 @@ var _ = ginkgo.Describe("Managed BGP Controller", func() {
 	ginkgo.BeforeEach(func() {
 		// Save original config
 		oldTopology = config.ManagedBGP.Topology
+		oldRRNodeSelector = config.ManagedBGP.RouteReflectorNodeSelector
 		oldASNumber = config.ManagedBGP.ASNumber
 		oldFRRNamespace = config.ManagedBGP.FRRNamespace
 		oldOVNConfigNamespace = config.Kubernetes.OVNConfigNamespace
@@ -77,6 +79,7 @@ var _ = ginkgo.Describe("Managed BGP Controller", func() {
 	ginkgo.AfterEach(func() {
 		// Restore original config
 		config.ManagedBGP.Topology = oldTopology
+		config.ManagedBGP.RouteReflectorNodeSelector = oldRRNodeSelector
 		config.ManagedBGP.ASNumber = oldASNumber
 		config.ManagedBGP.FRRNamespace = oldFRRNamespace
 		config.Kubernetes.OVNConfigNamespace = oldOVNConfigNamespace
@@ -567,6 +570,212 @@ var _ = ginkgo.Describe("Managed BGP Controller", func() {
 		})
 	})
 
+	ginkgo.Context("Route-reflector topology", func() {
+		const rrLabel = "node-role.kubernetes.io/route-reflector"
+
+		ginkgo.BeforeEach(func() {
+			config.ManagedBGP.Topology = config.ManagedBGPTopologyRouteReflector
+			config.ManagedBGP.RouteReflectorNodeSelector = rrLabel + "="
+		})
+
+		getNeighbors := func(frrFakeClient *frrfake.Clientset, name string) []string {
+			frrConfig, err := frrFakeClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil || len(frrConfig.Spec.BGP.Routers) == 0 {
+				return nil
+			}
+			addresses := make([]string, 0, len(frrConfig.Spec.BGP.Routers[0].Neighbors))
+			for _, n := range frrConfig.Spec.BGP.Routers[0].Neighbors {
+				addresses = append(addresses, n.Address)
+			}
+			return addresses
+		}
+
+		getRouteReflectors := func(raFakeClient *rafake.Clientset) []string {
+			ra, err := raFakeClient.K8sV1().RouteAdvertisements().Get(context.TODO(), ManagedRouteAdvertisementName(types.DefaultNetworkName), metav1.GetOptions{})
+			if err != nil {
+				return nil
+			}
+			return ra.Status.RouteReflectors
+		}
+
+		ginkgo.It("should peer all nodes with the route reflectors and report them in the RouteAdvertisements status", func() {
+			rr1 := createNode("rr1", "10.0.0.1", "")
+			rr1.Labels[rrLabel] = ""
+			rr2 := createNode("rr2", "10.0.0.2", "")
+			rr2.Labels[rrLabel] = ""
+			node3 := createNode("node3", "10.0.0.3", "")
+			node4 := createNode("node4", "10.0.0.4", "")
+
+			fakeClient := fake.NewSimpleClientset(rr1, rr2, node3, node4)
+			frrFakeClient := frrfake.NewSimpleClientset()
+			raFakeClient := rafake.NewSimpleClientset()
+
+			wf, err := factory.NewClusterManagerWatchFactory(&util.OVNClusterManagerClientset{
+				KubeClient:                fakeClient,
+				NetworkAttchDefClient:     nadfake.NewSimpleClientset(),
+				RouteAdvertisementsClient: raFakeClient,
+				FRRClient:                 frrFakeClient,
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer wf.Shutdown()
+
+			err = wf.Start()
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			controller := NewController(wf, frrFakeClient, raFakeClient, recorder)
+			err = controller.Start()
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer controller.Stop()
+
+			gomega.Eventually(func() int {
+				list, _ := frrFakeClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).List(context.TODO(), metav1.ListOptions{})
+				return len(list.Items)
+			}, 2*time.Second).Should(gomega.Equal(2))
+
+			// all nodes peer with the route reflectors
+			baseConfig, err := frrFakeClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).Get(context.TODO(), BaseFRRConfigName(), metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(baseConfig.Spec.NodeSelector).To(gomega.Equal(metav1.LabelSelector{}))
+			gomega.Expect(baseConfig.Spec.Raw.Config).To(gomega.BeEmpty())
+			gomega.Expect(getNeighbors(frrFakeClient, BaseFRRConfigName())).To(gomega.Equal([]string{"10.0.0.1", "10.0.0.2"}))
+
+			// route reflectors peer with their clients
+			rrConfig, err := frrFakeClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).Get(context.TODO(), RouteReflectorFRRConfigName(), metav1.GetOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(rrConfig.Labels).To(gomega.HaveKeyWithValue(FRRConfigManagedLabel, FRRConfigManagedValue))
+			gomega.Expect(rrConfig.Spec.NodeSelector).To(gomega.Equal(metav1.LabelSelector{MatchLabels: map[string]string{rrLabel: ""}}))
+			gomega.Expect(getNeighbors(frrFakeClient, RouteReflectorFRRConfigName())).To(gomega.Equal([]string{"10.0.0.3", "10.0.0.4"}))
+			gomega.Expect(rrConfig.Spec.Raw.Config).To(gomega.Equal("router bgp 64512\n" +
+				" address-family ipv4 unicast\n" +
+				"  neighbor 10.0.0.3 route-reflector-client\n" +
+				"  neighbor 10.0.0.4 route-reflector-client\n" +
+				" exit-address-family\n" +
+				"exit\n!\n"))
+
+			gomega.Eventually(func() []string {
+				return getRouteReflectors(raFakeClient)
+			}, 2*time.Second).Should(gomega.Equal([]string{"rr1", "rr2"}))
+		})
+
+		ginkgo.It("should re-converge when route reflector nodes come or go", func() {
+			rr1 := createNode("rr1", "10.0.0.1", "fd00::1")
+			rr1.Labels[rrLabel] = ""
+			node2 := createNode("node2", "10.0.0.2", "fd00::2")
+			node3 := createNode("node3", "10.0.0.3", "fd00::3")
+
+			fakeClient := fake.NewSimpleClientset(rr1, node2, node3)
+			frrFakeClient := frrfake.NewSimpleClientset()
+			raFakeClient := rafake.NewSimpleClientset()
+
+			wf, err := factory.NewClusterManagerWatchFactory(&util.OVNClusterManagerClientset{
+				KubeClient:                fakeClient,
+				NetworkAttchDefClient:     nadfake.NewSimpleClientset(),
+				RouteAdvertisementsClient: raFakeClient,
+				FRRClient:                 frrFakeClient,
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer wf.Shutdown()
+
+			err = wf.Start()
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			controller := NewController(wf, frrFakeClient, raFakeClient, recorder)
+			err = controller.Start()
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer controller.Stop()
+
+			gomega.Eventually(func() []string {
+				return getNeighbors(frrFakeClient, BaseFRRConfigName())
+			}, 2*time.Second).Should(gomega.Equal([]string{"10.0.0.1", "fd00::1"}))
+			gomega.Eventually(func() []string {
+				return getRouteReflectors(raFakeClient)
+			}, 2*time.Second).Should(gomega.Equal([]string{"rr1"}))
+
+			// node2 becomes a route reflector
+			node2.Labels[rrLabel] = ""
+			_, err = fakeClient.CoreV1().Nodes().Update(context.TODO(), node2, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Eventually(func() []string {
+				return getNeighbors(frrFakeClient, BaseFRRConfigName())
+			}, 2*time.Second).Should(gomega.Equal([]string{"10.0.0.1", "10.0.0.2", "fd00::1", "fd00::2"}))
+			gomega.Eventually(func() []string {
+				return getNeighbors(frrFakeClient, RouteReflectorFRRConfigName())
+			}, 2*time.Second).Should(gomega.Equal([]string{"10.0.0.3", "fd00::3"}))
+			gomega.Eventually(func() []string {
+				return getRouteReflectors(raFakeClient)
+			}, 2*time.Second).Should(gomega.Equal([]string{"node2", "rr1"}))
+
+			// rr1 goes away
+			err = fakeClient.CoreV1().Nodes().Delete(context.TODO(), "rr1", metav1.DeleteOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Eventually(func() []string {
+				return getNeighbors(frrFakeClient, BaseFRRConfigName())
+			}, 2*time.Second).Should(gomega.Equal([]string{"10.0.0.2", "fd00::2"}))
+			gomega.Eventually(func() []string {
+				return getRouteReflectors(raFakeClient)
+			}, 2*time.Second).Should(gomega.Equal([]string{"node2"}))
+		})
+
+		ginkgo.It("should remove the route reflector FRRConfiguration when switching to full-mesh", func() {
+			node1 := createNode("node1", "10.0.0.1", "")
+			rrConfig := &frrtypes.FRRConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      RouteReflectorFRRConfigName(),
+					Namespace: config.ManagedBGP.FRRNamespace,
+					Labels: map[string]string{
+						FRRConfigManagedLabel: FRRConfigManagedValue,
+					},
+				},
+			}
+			config.ManagedBGP.Topology = config.ManagedBGPTopologyFullMesh
+
+			fakeClient := fake.NewSimpleClientset(node1)
+			frrFakeClient := frrfake.NewSimpleClientset(rrConfig)
+			raFakeClient := rafake.NewSimpleClientset()
+
+			wf, err := factory.NewClusterManagerWatchFactory(&util.OVNClusterManagerClientset{
+				KubeClient:                fakeClient,
+				NetworkAttchDefClient:     nadfake.NewSimpleClientset(),
+				RouteAdvertisementsClient: raFakeClient,
+				FRRClient:                 frrFakeClient,
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer wf.Shutdown()
+
+			err = wf.Start()
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			controller := NewController(wf, frrFakeClient, raFakeClient, recorder)
+			err = controller.Start()
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			defer controller.Stop()
+
+			gomega.Eventually(func() bool {
+				_, err := frrFakeClient.ApiV1beta1().FRRConfigurations(config.ManagedBGP.FRRNamespace).Get(context.TODO(), RouteReflectorFRRConfigName(), metav1.GetOptions{})
+				return apierrors.IsNotFound(err)
+			}, 2*time.Second).Should(gomega.BeTrue())
+			gomega.Expect(getNeighbors(frrFakeClient, BaseFRRConfigName())).To(gomega.Equal([]string{"10.0.0.1"}))
+		})
+
+		ginkgo.It("should generate route reflector client config per address family", func() {
+			rawConfig := routeReflectorClientRawConfig([]frrtypes.Neighbor{
+				{Address: "10.0.0.2"},
+				{Address: "fd00::2"},
+			})
+			gomega.Expect(rawConfig).To(gomega.Equal("router bgp 64512\n" +
+				" address-family ipv4 unicast\n" +
+				"  neighbor 10.0.0.2 route-reflector-client\n" +
+				" exit-address-family\n" +
+				" address-family ipv6 unicast\n" +
+				"  neighbor fd00::2 route-reflector-client\n" +
+				" exit-address-family\n" +
+				"exit\n!\n"))
+			gomega.Expect(routeReflectorClientRawConfig(nil)).To(gomega.BeEmpty())
+		})
+	})
+
 	ginkgo.Context("Non-full-mesh topology", func() {
 		ginkgo.It("should fail to start when topology is not full-mesh", func() {
 			config.ManagedBGP.Topology = ""
@@ -654,6 +863,18 @@ var _ = ginkgo.Describe("Managed BGP Controller", func() {
 			newNode.Labels["foo"] = "bar"
 			gomega.Expect(controller.nodeNeedsUpdate(oldNode, newNode)).To(gomega.BeFalse())
 		})
+
+		ginkgo.It("should return true when the node route reflector role changes", func() {
+			config.ManagedBGP.Topology = config.ManagedBGPTopologyRouteReflector
+			config.ManagedBGP.RouteReflectorNodeSelector = "rr=true"
+			oldNode := createNode("node1", "10.0.0.1", "")
+			newNode := createNode("node1", "10.0.0.1", "")
+			newNode.Labels["foo"] = "bar"
+			gomega.Expect(controller.nodeNeedsUpdate(oldNode, newNode)).To(gomega.BeFalse())
+			newNode.Labels["rr"] = "true"
+			gomega.Expect(controller.nodeNeedsUpdate(oldNode, newNode)).To(gomega.BeTrue())
+			gomega.Expect(controller.nodeNeedsUpdate(newNode, oldNode)).To(gomega.BeTrue())
+		})
 	})
 
 	ginkgo.Context("RouteAdvertisements management", func() {
@@ -14,9 +14,11 @@ import (
 
 	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	cache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -63,6 +65,10 @@ type networkClusterController struct {
 	// retry framework for persistent ip allocation
 	ipamClaimHandler *factory.Handler
 	retryIPAMClaims  *objretry.RetryFramework
+
+	// retry framework for releasing the persistent ips of StatefulSet pods
+	statefulSetHandler *factory.Handler
+	retryStatefulSets  *objretry.RetryFramework
 	// tunnelIDAllocator of tunnelIDs within the network
 	tunnelIDAllocator   id.Allocator
 	podAllocator        *pod.PodAllocator
@@ -96,6 +102,10 @@ type networkClusterController struct {
 	nadKeysLock sync.Mutex
 	lastNADKeys sets.Set[string]
 
+	// ipPoolsAboveThreshold holds the IP pools last reported above the
+	// utilization threshold, nil if not reported yet
+	ipPoolsAboveThreshold sets.Set[string]
+
 	util.ReconcilableNetInfo
 }
 
@@ -461,8 +471,12 @@ func (ncc *networkClusterController) init() error {
 				ncc.kube,
 				ncc.GetNetInfo(),
 				ncc.watchFactory.IPAMClaimsInformer().Lister(),
+				ncc.watchFactory.StatefulSetInformer().Lister(),
 			)
 			ipamClaimsReconciler = ncc.ipamClaimReconciler
+			if !ncc.IsPrimaryNetwork() {
+				ncc.retryStatefulSets = ncc.newRetryFramework(factory.StatefulSetType, true)
+			}
 		}
 
 		var podAllocOpts []annotationalloc.AllocatorOption
@@ -727,6 +741,8 @@ func (ncc *networkClusterController) Start(_ context.Context) error {
 
 	klog.Infof("Cluster manager network controller %q initialized. Took: %v", ncc.GetNetworkName(), time.Since(start))
 
+	ncc.runIPPoolUtilizationUpdater()
+
 	if ncc.hasNodeAllocation() {
 		start = time.Now()
 		klog.Infof("Cluster manager network controller %q registering shared node handler...", ncc.GetNetworkName())
@@ -762,6 +778,17 @@ func (ncc *networkClusterController) Start(_ context.Context) error {
 		}
 		ncc.podHandler = podHandler
 		klog.Infof("Cluster manager network controller %q completed watch Pods. Took: %v", ncc.GetNetworkName(), time.Since(start))
+
+		if ncc.retryStatefulSets != nil {
+			start = time.Now()
+			klog.Infof("Cluster manager network controller %q starting StatefulSet watcher...", ncc.GetNetworkName())
+			statefulSetHandler, err := ncc.retryStatefulSets.WatchResource()
+			if err != nil {
+				return fmt.Errorf("unable to watch StatefulSets: %w", err)
+			}
+			ncc.statefulSetHandler = statefulSetHandler
+			klog.Infof("Cluster manager network controller %q completed watch StatefulSets. Took: %v", ncc.GetNetworkName(), time.Since(start))
+		}
 	}
 
 	return nil
@@ -775,6 +802,10 @@ func (ncc *networkClusterController) Stop() {
 		ncc.watchFactory.RemoveIPAMClaimsHandler(ncc.ipamClaimHandler)
 	}
 
+	if ncc.statefulSetHandler != nil {
+		ncc.watchFactory.RemoveStatefulSetHandler(ncc.statefulSetHandler)
+	}
+
 	if ncc.hasNodeAllocation() && ncc.nodeReconciler != nil {
 		ncc.nodeReconciler.DeregisterNetworkController(ncc.GetNetworkName())
 	}
@@ -837,12 +868,9 @@ func getNewSubnets(old, new []config.CIDRNetworkEntry) []config.CIDRNetworkEntry
 
 func (ncc *networkClusterController) Reconcile(netInfo util.NetInfo) error {
 	nadKeys := ncc.networkManager.GetNADKeysForNetwork(netInfo.GetNetworkName())
+	// Find subnets that are in the new network information but not in the old
+	addedSubnets := getNewSubnets(ncc.GetNetInfo().Subnets(), netInfo.Subnets())
 	if ncc.nodeAllocator != nil {
-		oldSubnets := ncc.GetNetInfo().Subnets()
-		newSubnets := netInfo.Subnets()
-
-		// Find subnets that are in newSubnets but not in oldSubnets
-		addedSubnets := getNewSubnets(oldSubnets, newSubnets)
 		if len(addedSubnets) > 0 {
 			if err := ncc.nodeAllocator.AddSubnets(addedSubnets); err != nil {
 				return fmt.Errorf("failed to add new subnets to node allocator for network %s: %w", ncc.GetNetworkName(), err)
@@ -863,6 +891,14 @@ func (ncc *networkClusterController) Reconcile(netInfo util.NetInfo) error {
 		}
 	}
 	reconcilePendingPods := ncc.updateNADKeysChanged(nadKeys)
+	if ncc.subnetAllocator != nil && len(addedSubnets) > 0 {
+		// existing allocations are preserved as the new subnets are appended
+		if err := ncc.subnetAllocator.AddOrUpdateSubnet(ipAllocatorConfigForNetwork(netInfo)); err != nil {
+			return fmt.Errorf("failed to add new subnets to IP allocator for network %s: %w", ncc.GetNetworkName(), err)
+		}
+		// retry pods that failed allocation due to subnet exhaustion
+		reconcilePendingPods = true
+	}
 	// update network information, point of no return
 	err := util.ReconcileNetInfo(ncc.ReconcilableNetInfo, netInfo)
 	if err != nil {
@@ -916,7 +952,17 @@ func (h *networkClusterControllerEventHandler) AddResource(obj interface{}, _ bo
 			return err
 		}
 	case factory.IPAMClaimsType:
-		return nil
+		ipamClaim, ok := obj.(*ipamclaimsapi.IPAMClaim)
+		if !ok {
+			return fmt.Errorf("could not cast obj of type %T to *ipamclaimsapi.IPAMClaim", obj)
+		}
+		return h.ncc.releaseStaleStatefulSetIPAMClaim(ipamClaim)
+	case factory.StatefulSetType:
+		statefulSet, ok := obj.(*appsv1.StatefulSet)
+		if !ok {
+			return fmt.Errorf("could not cast obj of type %T to *appsv1.StatefulSet", obj)
+		}
+		return h.ncc.releaseStatefulSetIPAMClaims(statefulSet.Namespace, statefulSet.Name)
 	default:
 		return fmt.Errorf("no add function for object type %s", h.objType)
 	}
@@ -943,6 +989,21 @@ func (h *networkClusterControllerEventHandler) UpdateResource(oldObj, newObj int
 		}
 	case factory.IPAMClaimsType:
 		return nil
+	case factory.StatefulSetType:
+		old, ok := oldObj.(*appsv1.StatefulSet)
+		if !ok {
+			return fmt.Errorf("could not cast %T old object to *appsv1.StatefulSet", oldObj)
+		}
+		new, ok := newObj.(*appsv1.StatefulSet)
+		if !ok {
+			return fmt.Errorf("could not cast %T new object to *appsv1.StatefulSet", newObj)
+		}
+		// only a scale down or a change of the ordinals can release IPAMClaims
+		if new.DeletionTimestamp == nil && reflect.DeepEqual(old.Spec.Replicas, new.Spec.Replicas) &&
+			reflect.DeepEqual(old.Spec.Ordinals, new.Spec.Ordinals) {
+			return nil
+		}
+		return h.ncc.releaseStatefulSetIPAMClaims(new.Namespace, new.Name)
 	default:
 		return fmt.Errorf("no update function for object type %s", h.objType)
 	}
@@ -976,6 +1037,12 @@ func (h *networkClusterControllerEventHandler) DeleteResource(obj, _ interface{}
 			return nil // let's avoid the log below, since nothing was released.
 		}
 		klog.Infof("Released IPs %q for network %q", ipamClaim.Status.IPs, ipamClaim.Spec.Network)
+	case factory.StatefulSetType:
+		statefulSet, ok := obj.(*appsv1.StatefulSet)
+		if !ok {
+			return fmt.Errorf("could not cast obj of type %T to *appsv1.StatefulSet", obj)
+		}
+		return h.ncc.releaseStatefulSetIPAMClaims(statefulSet.Namespace, statefulSet.Name)
 	}
 	return nil
 }
@@ -997,6 +1064,8 @@ func (h *networkClusterControllerEventHandler) SyncFunc(objs []interface{}) erro
 					h.ncc.subnetAllocator.ForSubnet(h.ncc.GetNetworkName()),
 				)
 			}
+		case factory.StatefulSetType:
+			// stale IPAMClaims are released when the IPAMClaims are added
 
 		default:
 			return fmt.Errorf("no sync function for object type %s", h.objType)
@@ -1029,6 +1098,8 @@ func (h *networkClusterControllerEventHandler) GetResourceFromInformerCache(key
 		obj, err = h.ncc.watchFactory.GetPod(namespace, name)
 	case factory.IPAMClaimsType:
 		obj, err = h.ncc.watchFactory.GetIPAMClaim(namespace, name)
+	case factory.StatefulSetType:
+		obj, err = h.ncc.watchFactory.GetStatefulSet(namespace, name)
 	default:
 		err = fmt.Errorf("object type %s not supported, cannot retrieve it from informers cache",
 			h.objType)
@@ -1036,6 +1107,43 @@ func (h *networkClusterControllerEventHandler) GetResourceFromInformerCache(key
 	return obj, err
 }
 
+// releaseStaleStatefulSetIPAMClaim releases an IPAMClaim derived for a
+// StatefulSet pod that is gone if the StatefulSet was scaled down or deleted in
+// the meantime, e.g. while cluster manager was not running.
+func (ncc *networkClusterController) releaseStaleStatefulSetIPAMClaim(ipamClaim *ipamclaimsapi.IPAMClaim) error {
+	podName, ok := persistentips.StatefulSetIPAMClaimPodName(ipamClaim)
+	if !ok || ipamClaim.Spec.Network != ncc.GetNetworkName() {
+		return nil
+	}
+	_, err := ncc.watchFactory.GetPod(ipamClaim.Namespace, podName)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get pod %s/%s: %w", ipamClaim.Namespace, podName, err)
+	}
+	return ncc.ipamClaimReconciler.ReleaseStatefulSetIPAMClaim(ipamClaim.Namespace, ipamClaim.Name)
+}
+
+// releaseStatefulSetIPAMClaims releases the IPAMClaims derived for the pods of
+// a StatefulSet that are gone once the StatefulSet no longer has their
+// ordinal, i.e. it was scaled down or deleted. The IPAMClaims of the pods that
+// are still terminating are released when the pods are deleted.
+func (ncc *networkClusterController) releaseStatefulSetIPAMClaims(namespace, name string) error {
+	ipamClaims, err := ncc.watchFactory.IPAMClaimsInformer().Lister().IPAMClaims(namespace).List(
+		labels.SelectorFromSet(labels.Set{persistentips.StatefulSetLabel: name}))
+	if err != nil {
+		return fmt.Errorf("failed to list IPAMClaims of StatefulSet %s/%s: %w", namespace, name, err)
+	}
+	var errs []error
+	for _, ipamClaim := range ipamClaims {
+		if err := ncc.releaseStaleStatefulSetIPAMClaim(ipamClaim); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // OVN uses an overlay and doesn't need GCE Routes, we need to
 // clear the NetworkUnavailable condition that kubelet adds to initial node
 // status when using GCE (done here: https://github.com/kubernetes/kubernetes/blob/master/pkg/controller/cloud/node_controller.go#L237).
@@ -1088,7 +1196,15 @@ func (ncc *networkClusterController) clearInitialNodeNetworkUnavailableCondition
 // subnets / excluded subnets provided in `netInfo`
 func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
 	ipAllocator := subnet.NewAllocator()
+	if err := ipAllocator.AddOrUpdateSubnet(ipAllocatorConfigForNetwork(netInfo)); err != nil {
+		return nil, err
+	}
+	return ipAllocator, nil
+}
 
+// ipAllocatorConfigForNetwork returns the subnet allocator configuration for
+// the subnets / excluded subnets provided in `netInfo`
+func ipAllocatorConfigForNetwork(netInfo util.NetInfo) subnet.SubnetConfig {
 	subnets := netInfo.Subnets()
 	ipNets := make([]*net.IPNet, 0, len(subnets))
 	excludeSubnets := append(netInfo.ExcludeSubnets(), netInfo.InfrastructureSubnets()...)
@@ -1097,20 +1213,25 @@ func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
 		ipNets = append(ipNets, subnet.CIDR)
 	}
 
-	if isLayer2UserDefinedPrimaryNetwork(netInfo) && len(netInfo.InfrastructureSubnets()) == 0 {
-		excludeSubnets = append(excludeSubnets, infrastructureExcludeCIDRs(netInfo)...)
+	if isLayer2UserDefinedPrimaryNetwork(netInfo) {
+		// gateway and management port IPs are already excluded through the
+		// infrastructure subnets, except for subnets appended to the network
+		for _, excludeCIDR := range infrastructureExcludeCIDRs(netInfo) {
+			if !util.IsContainedInAnyCIDR(excludeCIDR, excludeSubnets...) {
+				excludeSubnets = append(excludeSubnets, excludeCIDR)
+			}
+		}
 	}
 
-	if err := ipAllocator.AddOrUpdateSubnet(subnet.SubnetConfig{
+	return subnet.SubnetConfig{
 		Name:            netInfo.GetNetworkName(),
 		Subnets:         ipNets,
 		ReservedSubnets: netInfo.ReservedSubnets(),
 		ExcludeSubnets:  excludeSubnets,
-	}); err != nil {
-		return nil, err
+		// subnets can be appended to layer2 primary networks, pods still get
+		// a single IP per IP family
+		AllocatePerIPFamily: isLayer2UserDefinedPrimaryNetwork(netInfo),
 	}
-
-	return ipAllocator, nil
 }
 
 func isLayer2UserDefinedPrimaryNetwork(netInfo util.NetInfo) bool {
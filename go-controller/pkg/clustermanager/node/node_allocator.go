@@ -720,6 +720,17 @@ func (na *NodeAllocator) HasNodeSubnetAllocation() bool {
 	return na.hasNodeSubnetAllocation()
 }
 
+// HostSubnetUsage returns the number of allocated and available (both used and
+// unused) v4 and v6 host subnets
+func (na *NodeAllocator) HostSubnetUsage() (v4used, v4count, v6used, v6count uint64) {
+	if !na.hasNodeSubnetAllocation() {
+		return 0, 0, 0, 0
+	}
+	v4used, v6used = na.clusterSubnetAllocator.Usage()
+	v4count, v6count = na.clusterSubnetAllocator.Count()
+	return v4used, v4count, v6used, v6count
+}
+
 func (na *NodeAllocator) HasNodeTunnelIDAllocation() bool {
 	return util.IsNetworkSegmentationSupportEnabled() &&
 		na.netInfo.IsPrimaryNetwork() &&
@@ -268,8 +268,30 @@ func (a *PodAllocator) reconcileForNAD(old, new *corev1.Pod, nadKey string, netw
 	podDeleted := new == nil
 	podCompleted := util.PodCompleted(pod)
 
+	// pods of a StatefulSet attached to a secondary network get an IPAMClaim
+	// derived from their ordinal so that they keep their IPs
+	statefulSetIPAMClaim := a.ipamClaimsReconciler != nil && !a.netInfo.IsPrimaryNetwork() &&
+		persistentips.SetStatefulSetIPAMClaimReference(pod, network)
+
 	if podCompleted || podDeleted {
-		return a.releasePodOnNAD(pod, nadKey, network, podDeleted, releaseIPsFromAllocator)
+		if err := a.releasePodOnNAD(pod, nadKey, network, podDeleted, releaseIPsFromAllocator); err != nil {
+			return err
+		}
+		if statefulSetIPAMClaim && podDeleted && releaseIPsFromAllocator {
+			// the pod resources are already released, so don't fail and retry:
+			// a stale IPAMClaim is deleted again when cluster manager restarts
+			if err := a.ipamClaimsReconciler.ReleaseStatefulSetIPAMClaim(pod.Namespace, network.IPAMClaimReference); err != nil {
+				klog.Errorf("Failed to release IPAMClaim of pod %s/%s on network %s: %v",
+					pod.Namespace, pod.Name, a.netInfo.GetNetworkName(), err)
+			}
+		}
+		return nil
+	}
+
+	if statefulSetIPAMClaim {
+		if err := a.ipamClaimsReconciler.EnsureStatefulSetIPAMClaim(pod, network); err != nil {
+			return err
+		}
 	}
 
 	return a.allocatePodOnNAD(pod, nadKey, network)
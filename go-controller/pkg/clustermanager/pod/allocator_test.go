@@ -22,12 +22,16 @@ import (
 	"github.com/stretchr/testify/mock"
 	kubevirtv1 "kubevirt.io/api/core/v1"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/allocator/id"
 	ipallocator "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/allocator/ip"
@@ -107,6 +111,10 @@ func (a *ipAllocatorStub) GetSubnets(string) ([]*net.IPNet, error) {
 	panic("not implemented") // TODO: Implement
 }
 
+func (a *ipAllocatorStub) GetSubnetsUsage(string) ([]subnet.SubnetUsage, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (a *ipAllocatorStub) AllocateUntilFull(string) error {
 	a.fullIPPool = true
 	return nil
@@ -927,7 +935,7 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 			if tt.ipam && tt.args.ipamClaim != nil {
 				ctx, cancel := context.WithCancel(context.Background())
 				ipamClaimsLister, teardownFn := generateIPAMClaimsListerAndTeardownFunc(ctx.Done(), tt.args.ipamClaim)
-				ipamClaimsReconciler = persistentips.NewIPAMClaimReconciler(kubeMock, netInfo, ipamClaimsLister)
+				ipamClaimsReconciler = persistentips.NewIPAMClaimReconciler(kubeMock, netInfo, ipamClaimsLister, nil)
 
 				t.Cleanup(func() {
 					cancel()
@@ -1101,3 +1109,198 @@ func generateIPAMClaimsListerAndTeardownFunc(stopChannel <-chan struct{}, ipamCl
 		informerFactory.Shutdown()
 	}
 }
+
+func TestPodAllocator_reconcileStatefulSetPod(t *testing.T) {
+	const claimName = "web-1.nad"
+	statefulSetPod := func() *corev1.Pod {
+		pod := testPod{
+			scheduled: true,
+			network:   &nadapi.NetworkSelectionElement{Name: "nad", Namespace: "namespace"},
+		}.getPod(t)
+		pod.Name = "web-1"
+		pod.OwnerReferences = []metav1.OwnerReference{
+			{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "StatefulSet",
+				Name:       "web",
+				Controller: ptr.To(true),
+			},
+		}
+		return pod
+	}
+	statefulSetIPAMClaim := &ipamclaimsapi.IPAMClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      claimName,
+			Namespace: "namespace",
+			Labels: map[string]string{
+				persistentips.StatefulSetLabel:        "web",
+				persistentips.StatefulSetOrdinalLabel: "1",
+			},
+		},
+		Spec: ipamclaimsapi.IPAMClaimSpec{
+			Network: "nad",
+		},
+		Status: ipamclaimsapi.IPAMClaimStatus{
+			IPs: []string{"10.1.130.10/24"},
+		},
+	}
+	statefulSet := func(replicas int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "namespace"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: ptr.To(replicas)},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		old            *corev1.Pod
+		new            *corev1.Pod
+		ipamClaim      *ipamclaimsapi.IPAMClaim
+		statefulSet    *appsv1.StatefulSet
+		expectCreated  bool
+		expectDeleted  bool
+		expectAllocate bool
+		expectError    string
+	}{
+		{
+			name:           "Pod added, IPAMClaim does not exist, IPAMClaim created, IPs allocated",
+			new:            statefulSetPod(),
+			statefulSet:    statefulSet(3),
+			expectCreated:  true,
+			expectAllocate: true,
+		},
+		{
+			name:           "Pod added, IPAMClaim exists, IPs allocated",
+			new:            statefulSetPod(),
+			ipamClaim:      statefulSetIPAMClaim,
+			statefulSet:    statefulSet(3),
+			expectAllocate: true,
+		},
+		{
+			name:        "Pod deleted, StatefulSet has the pod ordinal, IPAMClaim kept",
+			old:         statefulSetPod(),
+			ipamClaim:   statefulSetIPAMClaim,
+			statefulSet: statefulSet(3),
+		},
+		{
+			name:          "Pod deleted, StatefulSet scaled down, IPAMClaim deleted",
+			old:           statefulSetPod(),
+			ipamClaim:     statefulSetIPAMClaim,
+			statefulSet:   statefulSet(1),
+			expectDeleted: true,
+		},
+		{
+			name:          "Pod deleted, StatefulSet deleted, IPAMClaim deleted",
+			old:           statefulSetPod(),
+			ipamClaim:     statefulSetIPAMClaim,
+			expectDeleted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			config.IPv4Mode = true
+			config.OVNKubernetesFeature.EnableMultiNetwork = true
+
+			netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+				NetConf:            cnitypes.NetConf{Name: "nad"},
+				Topology:           types.Layer2Topology,
+				Subnets:            "10.1.130.0/24",
+				AllowPersistentIPs: true,
+			})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			mutableNetInfo := util.NewMutableNetInfo(netInfo)
+			mutableNetInfo.AddNADs("namespace/nad")
+			netInfo = mutableNetInfo
+
+			var ipamClaims []runtime.Object
+			if tt.ipamClaim != nil {
+				ipamClaims = append(ipamClaims, tt.ipamClaim)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			ipamClaimsLister, teardownFn := generateIPAMClaimsListerAndTeardownFunc(ctx.Done(), ipamClaims...)
+			t.Cleanup(func() {
+				cancel()
+				teardownFn()
+			})
+			statefulSetIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			if tt.statefulSet != nil {
+				g.Expect(statefulSetIndexer.Add(tt.statefulSet)).To(gomega.Succeed())
+			}
+
+			kubeMock := &kubemocks.InterfaceOVN{}
+			var created *ipamclaimsapi.IPAMClaim
+			kubeMock.On("CreateIPAMClaim", mock.AnythingOfType(fmt.Sprintf("%T", &ipamclaimsapi.IPAMClaim{}))).Return(
+				func(ipamClaim *ipamclaimsapi.IPAMClaim) (*ipamclaimsapi.IPAMClaim, error) {
+					created = ipamClaim
+					return ipamClaim, nil
+				},
+			)
+			var deleted bool
+			kubeMock.On("DeleteIPAMClaim", "namespace", claimName).Run(
+				func(mock.Arguments) {
+					deleted = true
+				},
+			).Return(nil)
+			var allocated bool
+			kubeMock.On(
+				"PatchPodStatusAnnotations",
+				mock.AnythingOfType(fmt.Sprintf("%T", &corev1.Pod{})),
+				mock.AnythingOfType(fmt.Sprintf("%T", &corev1.Pod{})),
+			).Run(
+				func(mock.Arguments) {
+					allocated = true
+				},
+			).Return(nil)
+			kubeMock.On("UpdateIPAMClaimIPs", mock.AnythingOfType(fmt.Sprintf("%T", &ipamclaimsapi.IPAMClaim{}))).Return(nil)
+
+			podListerMock := &v1mocks.PodLister{}
+			podNamespaceLister := &v1mocks.PodNamespaceLister{}
+			podListerMock.On("Pods", mock.AnythingOfType("string")).Return(podNamespaceLister)
+			if tt.new != nil {
+				podNamespaceLister.On("Get", mock.AnythingOfType("string")).Return(tt.new, nil)
+			}
+			nodeListerMock := &v1mocks.NodeLister{}
+			nodeListerMock.On("Get", mock.AnythingOfType("string")).Return(&corev1.Node{}, nil)
+
+			ipamClaimsReconciler := persistentips.NewIPAMClaimReconciler(kubeMock, netInfo, ipamClaimsLister,
+				appslisters.NewStatefulSetLister(statefulSetIndexer))
+			a := &PodAllocator{
+				netInfo:     netInfo,
+				ipAllocator: &ipAllocatorStub{},
+				idAllocator: &idAllocatorStub{},
+				podAnnotationAllocator: pod.NewPodAnnotationAllocator(
+					netInfo,
+					podListerMock,
+					kubeMock,
+					ipamClaimsReconciler,
+				),
+				releasedPods:         map[string]sets.Set[string]{},
+				ipamClaimsReconciler: ipamClaimsReconciler,
+				networkManager: &networkmanager.FakeNetworkManager{
+					NADNetworks: map[string]util.NetInfo{"namespace/nad": netInfo},
+				},
+				recorder:   record.NewFakeRecorder(10),
+				nodeLister: nodeListerMock,
+			}
+
+			err = a.reconcile(tt.old, tt.new, true)
+			if tt.expectError != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tt.expectError)))
+			} else {
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+
+			g.Expect(allocated).To(gomega.Equal(tt.expectAllocate))
+			g.Expect(deleted).To(gomega.Equal(tt.expectDeleted))
+			if tt.expectCreated {
+				g.Expect(created).NotTo(gomega.BeNil())
+				g.Expect(created.Name).To(gomega.Equal(claimName))
+				g.Expect(created.Labels).To(gomega.Equal(statefulSetIPAMClaim.Labels))
+				g.Expect(created.Spec.Network).To(gomega.Equal("nad"))
+			} else {
+				g.Expect(created).To(gomega.BeNil())
+			}
+		})
+	}
+}
@@ -1158,10 +1158,10 @@ func (c *Controller) generateFRRConfiguration(
 		},
 	}
 	if rawConfig != "" {
-		new.Spec.Raw = frrtypes.RawConfig{
+		new.Spec.Raw = mergeRawConfigs(source.Spec.Raw, frrtypes.RawConfig{
 			Priority: rawConfigPriority,
 			Config:   rawConfig,
-		}
+		})
 	}
 
 	return new, nil
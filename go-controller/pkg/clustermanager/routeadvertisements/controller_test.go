@@ -293,7 +293,8 @@ func (tf testFRRConfig) FRRConfiguration() *frrapi.FRRConfiguration {
 		f.Spec.BGP.Routers = append(f.Spec.BGP.Routers, r.Router())
 	}
 	rawConfig := tf.RawConfig
-	if rawConfig == "" {
+	// only the generated FRRConfigurations have the generated raw config
+	if _, generated := tf.Labels[types.OvnRouteAdvertisementsKey]; rawConfig == "" && generated {
 		rawConfig = tf.generateUnicastRawConfig()
 	}
 	if rawConfig != "" {
@@ -1358,6 +1359,66 @@ exit
  exit-address-family
 exit
 !
+`,
+				},
+			},
+			expectNADAnnotations: map[string]map[string]string{"red": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
+		},
+		{
+			name: "reconciles EVPN MAC-VRF l2 network merging the raw config of a managed route reflector FRRConfiguration",
+			ra:   &testRA{Name: "ra", TargetVRF: "auto", AdvertisePods: true, NetworkSelector: map[string]string{"selected": "true"}},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 65000, Neighbors: []*testNeighbor{
+							{ASN: 65000, Address: "192.168.1.1"},
+						}},
+					},
+					RawConfig: `router bgp 65000
+ address-family ipv4 unicast
+  neighbor 192.168.1.1 route-reflector-client
+ exit-address-family
+exit
+!
+`,
+				},
+			},
+			nads: []*testNAD{
+				{Name: "red", Namespace: "red", Network: util.GenerateCUDNNetworkName("red"),
+					Topology: "layer2", Subnet: "10.1.0.0/16", Labels: map[string]string{"selected": "true"},
+					EVPNMACVRFVNI: 1000, EVPNMACVRFRouteTarget: "65000:1000"},
+			},
+			nodes:                []*testNode{{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.0.0/24\"}"}},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
+					RawConfig: `router bgp 65000
+ address-family ipv4 unicast
+  neighbor 192.168.1.1 route-reflector-client
+ exit-address-family
+exit
+!
+router bgp 65000
+ address-family ipv4 unicast
+  neighbor 192.168.1.1 allowas-in origin
+ exit-address-family
+ address-family l2vpn evpn
+  neighbor 192.168.1.1 activate
+  neighbor 192.168.1.1 allowas-in origin
+  advertise-all-vni
+  vni 1000
+   route-target import 65000:1000
+   route-target export 65000:1000
+  exit-vni
+ exit-address-family
+exit
+!
 `,
 				},
 			},
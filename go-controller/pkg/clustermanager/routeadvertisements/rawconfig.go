@@ -9,6 +9,8 @@ import (
 	"slices"
 	"strings"
 
+	frrtypes "github.com/metallb/frr-k8s/api/v1beta1"
+
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
 )
 
@@ -253,3 +255,25 @@ func genNonDefaultVRFEVPNSection(cfg *ipVRFConfig) string {
 
 	return buf.String()
 }
+
+// mergeRawConfigs merges the raw configuration of the source FRRConfiguration,
+// if any, with the generated one. As frr-k8s would, the configurations are
+// appended in priority order, and the merged configuration takes the highest
+// priority of the two.
+func mergeRawConfigs(source, generated frrtypes.RawConfig) frrtypes.RawConfig {
+	if source.Config == "" {
+		return generated
+	}
+	first, last := source, generated
+	if first.Priority > last.Priority {
+		first, last = last, first
+	}
+	config := first.Config
+	if !strings.HasSuffix(config, "\n") {
+		config += "\n"
+	}
+	return frrtypes.RawConfig{
+		Priority: last.Priority,
+		Config:   config + last.Config,
+	}
+}
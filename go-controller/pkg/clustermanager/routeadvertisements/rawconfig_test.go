@@ -5,6 +5,8 @@ package routeadvertisements
 
 import (
 	"testing"
+
+	frrtypes "github.com/metallb/frr-k8s/api/v1beta1"
 )
 
 func TestGenerateRawConfig(t *testing.T) {
@@ -273,3 +275,39 @@ exit
 		})
 	}
 }
+
+func TestMergeRawConfigs(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    frrtypes.RawConfig
+		generated frrtypes.RawConfig
+		want      frrtypes.RawConfig
+	}{
+		{
+			name:      "no source raw config",
+			generated: frrtypes.RawConfig{Priority: 10, Config: "generated\n"},
+			want:      frrtypes.RawConfig{Priority: 10, Config: "generated\n"},
+		},
+		{
+			name:      "source raw config with lower priority goes first",
+			source:    frrtypes.RawConfig{Config: "source\n"},
+			generated: frrtypes.RawConfig{Priority: 10, Config: "generated\n"},
+			want:      frrtypes.RawConfig{Priority: 10, Config: "source\ngenerated\n"},
+		},
+		{
+			name:      "source raw config with higher priority goes last",
+			source:    frrtypes.RawConfig{Priority: 20, Config: "source"},
+			generated: frrtypes.RawConfig{Priority: 10, Config: "generated"},
+			want:      frrtypes.RawConfig{Priority: 20, Config: "generated\nsource"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeRawConfigs(tt.source, tt.generated)
+			if got != tt.want {
+				t.Errorf("mergeRawConfigs() mismatch\nGot:\n%+v\nWant:\n%+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -13,7 +13,9 @@ import (
 	"k8s.io/client-go/tools/pager"
 	"k8s.io/klog/v2"
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+	cnpapi "sigs.k8s.io/network-policy-api/apis/v1alpha2"
 	anpapiapply "sigs.k8s.io/network-policy-api/pkg/client/applyconfiguration/apis/v1alpha1"
+	cnpapiapply "sigs.k8s.io/network-policy-api/pkg/client/applyconfiguration/apis/v1alpha2"
 	anpclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
 )
 
@@ -22,11 +24,14 @@ import (
 // zone deletion
 type anpZoneDeleteCleanupManager struct {
 	client anpclientset.Interface
+	// cnpEnabled is true if ClusterNetworkPolicies are handled alongside ANPs and BANP
+	cnpEnabled bool
 }
 
-func newANPManager(client anpclientset.Interface) *anpZoneDeleteCleanupManager {
+func newANPManager(client anpclientset.Interface, cnpEnabled bool) *anpZoneDeleteCleanupManager {
 	return &anpZoneDeleteCleanupManager{
-		client: client,
+		client:     client,
+		cnpEnabled: cnpEnabled,
 	}
 }
 
@@ -58,11 +63,30 @@ func (m *anpZoneDeleteCleanupManager) GetBANPs() ([]*anpapi.BaselineAdminNetwork
 	return list, err
 }
 
+// GetCNPs returns the list of all ClusterNetworkPolicy objects from kubernetes API Server
+// If ClusterNetworkPolicy support is not enabled an empty list is returned.
+func (m *anpZoneDeleteCleanupManager) GetCNPs() ([]*cnpapi.ClusterNetworkPolicy, error) {
+	list := []*cnpapi.ClusterNetworkPolicy{}
+	if !m.cnpEnabled {
+		return list, nil
+	}
+	err := pager.New(func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		return m.client.PolicyV1alpha2().ClusterNetworkPolicies().List(ctx, opts)
+	}).EachListItem(context.TODO(), metav1.ListOptions{
+		ResourceVersion: "0",
+	}, func(obj runtime.Object) error {
+		list = append(list, obj.(*cnpapi.ClusterNetworkPolicy))
+		return nil
+	})
+	return list, err
+}
+
 // removeZoneStatusFromAllANPs removes the condition managed by zone
-// in the conditions status of all ANPs and BANP in the cluster
+// in the conditions status of all ANPs, BANP and CNPs in the cluster
 // This is best effort, so errors are silently ignored by emitting
 // warning messages.
-func (m *anpZoneDeleteCleanupManager) removeZoneStatusFromAllANPs(existingANPs []*anpapi.AdminNetworkPolicy, existingBANPs []*anpapi.BaselineAdminNetworkPolicy, zone string) {
+func (m *anpZoneDeleteCleanupManager) removeZoneStatusFromAllANPs(existingANPs []*anpapi.AdminNetworkPolicy, existingBANPs []*anpapi.BaselineAdminNetworkPolicy,
+	existingCNPs []*cnpapi.ClusterNetworkPolicy, zone string) {
 	klog.Infof("Deleting status for zone %s from existing admin network policies", zone)
 	for _, existingANP := range existingANPs {
 		applyObj := anpapiapply.AdminNetworkPolicy(existingANP.Name)
@@ -80,12 +104,20 @@ func (m *anpZoneDeleteCleanupManager) removeZoneStatusFromAllANPs(existingANPs [
 			klog.Warningf("Unable to remove zone %s's status from BANP %s: %v", zone, existingBANP.Name, err)
 		}
 	}
+	for _, existingCNP := range existingCNPs {
+		applyObj := cnpapiapply.ClusterNetworkPolicy(existingCNP.Name)
+		_, err := m.client.PolicyV1alpha2().ClusterNetworkPolicies().
+			ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: zone, Force: true})
+		if err != nil {
+			klog.Warningf("Unable to remove zone %s's status from CNP %s: %v", zone, existingCNP.Name, err)
+		}
+	}
 }
 
 // cleanupDeletedZoneStatuses loops through the provided zones and cleans the statuses of those
-// zones from existing ANPs and BANPs
+// zones from existing ANPs, BANPs and CNPs
 func (m *anpZoneDeleteCleanupManager) cleanupDeletedZoneStatuses(deletedZones sets.Set[string]) {
-	// let us try to fetch all the ANPs/BANPs in one go so that we don't query API server for each zone
+	// let us try to fetch all the ANPs/BANPs/CNPs in one go so that we don't query API server for each zone
 	existingANPs, err := m.GetANPs()
 	if err != nil {
 		klog.Warningf("Unable to fetch ANPs: %v", err)
@@ -94,18 +126,22 @@ func (m *anpZoneDeleteCleanupManager) cleanupDeletedZoneStatuses(deletedZones se
 	if err != nil {
 		klog.Warningf("Unable to fetch BANPs: %v", err)
 	}
-	if len(existingANPs) > 0 || len(existingBANPs) > 0 {
+	existingCNPs, err := m.GetCNPs()
+	if err != nil {
+		klog.Warningf("Unable to fetch CNPs: %v", err)
+	}
+	if len(existingANPs) > 0 || len(existingBANPs) > 0 || len(existingCNPs) > 0 {
 		for _, zone := range deletedZones.UnsortedList() {
-			m.removeZoneStatusFromAllANPs(existingANPs, existingBANPs, zone)
+			m.removeZoneStatusFromAllANPs(existingANPs, existingBANPs, existingCNPs, zone)
 		}
 	}
 }
 
-// doStartupCleanup performs a one-time cleanup of stale ANP/BANP managedFields at startup.
+// doStartupCleanup performs a one-time cleanup of stale ANP/BANP/CNP managedFields at startup.
 // This is similar to the cleanup done in cleanupDeletedZoneStatuses when zones are deleted at runtime.
 // It detects stale zones by checking for managedFields from zones that no longer exist.
 func (m *anpZoneDeleteCleanupManager) doStartupCleanup(currentZones sets.Set[string]) error {
-	klog.Infof("StatusManager: performing one-time startup cleanup for ANP/BANP managedFields")
+	klog.Infof("StatusManager: performing one-time startup cleanup for ANP/BANP/CNP managedFields")
 
 	existingANPs, err := m.GetANPs()
 	if err != nil {
@@ -115,13 +151,17 @@ func (m *anpZoneDeleteCleanupManager) doStartupCleanup(currentZones sets.Set[str
 	if err != nil {
 		return fmt.Errorf("failed to fetch BANPs for startup cleanup: %w", err)
 	}
+	existingCNPs, err := m.GetCNPs()
+	if err != nil {
+		return fmt.Errorf("failed to fetch CNPs for startup cleanup: %w", err)
+	}
 
-	if len(existingANPs) == 0 && len(existingBANPs) == 0 {
-		klog.V(5).Infof("StatusManager: no ANPs or BANPs found, skipping startup cleanup")
+	if len(existingANPs) == 0 && len(existingBANPs) == 0 && len(existingCNPs) == 0 {
+		klog.V(5).Infof("StatusManager: no ANPs, BANPs or CNPs found, skipping startup cleanup")
 		return nil
 	}
 
-	// Find stale zones by checking managedFields on ANPs/BANPs
+	// Find stale zones by checking managedFields on ANPs/BANPs/CNPs
 	staleZones := sets.New[string]()
 	for _, anp := range existingANPs {
 		for _, mf := range anp.ManagedFields {
@@ -138,13 +178,21 @@ func (m *anpZoneDeleteCleanupManager) doStartupCleanup(currentZones sets.Set[str
 		}
 	}
 
+	for _, cnp := range existingCNPs {
+		for _, mf := range cnp.ManagedFields {
+			if mf.Subresource == "status" && !currentZones.Has(mf.Manager) && isEmptyStatusManagedField(mf) {
+				staleZones.Insert(mf.Manager)
+			}
+		}
+	}
+
 	if len(staleZones) > 0 {
-		klog.Infof("StatusManager: found stale zones in ANP/BANP managedFields: %v", staleZones.UnsortedList())
+		klog.Infof("StatusManager: found stale zones in ANP/BANP/CNP managedFields: %v", staleZones.UnsortedList())
 		for _, zone := range staleZones.UnsortedList() {
-			m.removeZoneStatusFromAllANPs(existingANPs, existingBANPs, zone)
+			m.removeZoneStatusFromAllANPs(existingANPs, existingBANPs, existingCNPs, zone)
 		}
 	}
 
-	klog.Infof("StatusManager: ANP/BANP startup cleanup complete")
+	klog.Infof("StatusManager: ANP/BANP/CNP startup cleanup complete")
 	return nil
 }
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package status_manager
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	multicastgroupapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1"
+	multicastgroupapply "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/applyconfiguration/multicastgroup/v1alpha1"
+	multicastgroupclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/clientset/versioned"
+	multicastgrouplisters "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/listers/multicastgroup/v1alpha1"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+)
+
+type multicastGroupManager struct {
+	lister multicastgrouplisters.MulticastGroupLister
+	client multicastgroupclientset.Interface
+}
+
+func newMulticastGroupManager(lister multicastgrouplisters.MulticastGroupLister, client multicastgroupclientset.Interface) *multicastGroupManager {
+	return &multicastGroupManager{
+		lister: lister,
+		client: client,
+	}
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives https://github.com/dominikh/go-tools/issues/1440
+func (m *multicastGroupManager) get(_, name string) (*multicastgroupapi.MulticastGroup, error) {
+	return m.lister.Get(name)
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *multicastGroupManager) getMessages(multicastGroup *multicastgroupapi.MulticastGroup) []string {
+	var messages []string
+	for _, condition := range multicastGroup.Status.Conditions {
+		// Extract zone name from condition Type (format: "Ready-In-Zone-zoneName")
+		if strings.HasPrefix(condition.Type, readyInZonePrefix) {
+			zoneName := strings.TrimPrefix(condition.Type, readyInZonePrefix)
+			messages = append(messages, types.GetZoneStatus(zoneName, condition.Message))
+		}
+	}
+	return messages
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *multicastGroupManager) getManagedFields(multicastGroup *multicastgroupapi.MulticastGroup) []metav1.ManagedFieldsEntry {
+	return multicastGroup.ManagedFields
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *multicastGroupManager) updateStatus(multicastGroup *multicastgroupapi.MulticastGroup, applyOpts *metav1.ApplyOptions,
+	applyEmptyOrFailed bool) error {
+	if multicastGroup == nil {
+		return nil
+	}
+	newStatus := "MulticastGroup applied"
+	for _, condition := range multicastGroup.Status.Conditions {
+		if strings.Contains(condition.Message, types.MulticastGroupErrorMsg) {
+			newStatus = types.MulticastGroupErrorMsg
+			break
+		}
+	}
+	if applyEmptyOrFailed && newStatus != types.MulticastGroupErrorMsg {
+		newStatus = ""
+	}
+
+	if multicastGroup.Status.Status == newStatus {
+		// already set to the same value
+		return nil
+	}
+
+	applyStatus := multicastgroupapply.MulticastGroupStatus()
+	if newStatus != "" {
+		applyStatus.WithStatus(newStatus)
+	}
+
+	applyObj := multicastgroupapply.MulticastGroup(multicastGroup.Name).
+		WithStatus(applyStatus)
+
+	_, err := m.client.K8sV1alpha1().MulticastGroups().ApplyStatus(context.TODO(), applyObj, *applyOpts)
+	return err
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *multicastGroupManager) cleanupStatus(multicastGroup *multicastgroupapi.MulticastGroup, applyOpts *metav1.ApplyOptions) error {
+	applyObj := multicastgroupapply.MulticastGroup(multicastGroup.Name)
+
+	_, err := m.client.K8sV1alpha1().MulticastGroups().ApplyStatus(context.TODO(), applyObj, *applyOpts)
+	return err
+}
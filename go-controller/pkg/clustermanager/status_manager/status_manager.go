@@ -22,6 +22,7 @@ import (
 	adminpolicybasedrouteapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	egressfirewallapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
 	egressqosapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1"
+	multicastgroupapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1"
 	networkqosapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
@@ -319,6 +320,16 @@ func NewStatusManager(wf *factory.WatchFactory, ovnClient *util.OVNClusterManage
 		)
 		sm.typedManagers["networkqoses"] = networkQoSManager
 	}
+	if config.OVNKubernetesFeature.EnableMulticastGroup {
+		multicastGroupManager := newStatusManager[multicastgroupapi.MulticastGroup](
+			"multicastgroups_statusmanager",
+			wf.MulticastGroupInformer().Informer(),
+			wf.MulticastGroupInformer().Lister().List,
+			newMulticastGroupManager(wf.MulticastGroupInformer().Lister(), ovnClient.MulticastGroupClient),
+			sm.withZonesRLock,
+		)
+		sm.typedManagers["multicastgroups"] = multicastGroupManager
+	}
 	return sm
 }
 
@@ -336,16 +347,16 @@ func (sm *StatusManager) Start() error {
 		}
 	}
 
-	// Perform one-time startup cleanup for ANP/BANP managedFields
+	// Perform one-time startup cleanup for ANP/BANP/CNP managedFields
 	// This handles the upgrade scenario where nodes were deleted before cluster-manager restart
 	if config.OVNKubernetesFeature.EnableAdminNetworkPolicy {
 		sm.zonesLock.RLock()
 		zones := sm.zones.Clone()
 		sm.zonesLock.RUnlock()
 
-		anpManager := newANPManager(sm.ovnClient.ANPClient)
+		anpManager := newANPManager(sm.ovnClient.ANPClient, config.OVNKubernetesFeature.EnableClusterNetworkPolicy)
 		if err := anpManager.doStartupCleanup(zones); err != nil {
-			return fmt.Errorf("failed to run ANP/BANP startup cleanup: %w", err)
+			return fmt.Errorf("failed to run ANP/BANP/CNP startup cleanup: %w", err)
 		}
 	}
 
@@ -381,7 +392,7 @@ func (sm *StatusManager) onZoneUpdate(newZones sets.Set[string]) {
 		// we don't anticipate too many node deletes in an env, it should be a rare operation which is why we are
 		// not maintaining local caches for ANP/BANP
 		// we must try to clean up statuses across all ANPs that were managed by that zone
-		anpZoneDeleteCleanupManager := newANPManager(sm.ovnClient.ANPClient)
+		anpZoneDeleteCleanupManager := newANPManager(sm.ovnClient.ANPClient, config.OVNKubernetesFeature.EnableClusterNetworkPolicy)
 		anpZoneDeleteCleanupManager.cleanupDeletedZoneStatuses(deletedZones)
 	}
 }
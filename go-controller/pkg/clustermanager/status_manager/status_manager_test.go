@@ -29,6 +29,7 @@ import (
 	egressfirewallapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
 	egressfirewallfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/clientset/versioned/fake"
 	egressqosapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1"
+	multicastgroupapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1"
 	networkqosapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	crdtypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
@@ -296,6 +297,45 @@ func checkEmptyNQStatusConsistently(networkQoS *networkqosapi.NetworkQoS, fakeCl
 	}).Should(BeTrue(), "expected Status to be consistently empty")
 }
 
+func newMulticastGroup(name string) *multicastgroupapi.MulticastGroup {
+	return &multicastgroupapi.MulticastGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: multicastgroupapi.MulticastGroupSpec{
+			Producers: []multicastgroupapi.MulticastGroupPeer{{}},
+			Consumers: []multicastgroupapi.MulticastGroupPeer{{}},
+		},
+	}
+}
+
+func updateMulticastGroupStatus(multicastGroup *multicastgroupapi.MulticastGroup, status *multicastgroupapi.MulticastGroupStatus,
+	fakeClient *util.OVNClusterManagerClientset) {
+	multicastGroup.Status = *status
+	_, err := fakeClient.MulticastGroupClient.K8sV1alpha1().MulticastGroups().
+		Update(context.TODO(), multicastGroup, metav1.UpdateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func checkMCGStatusEventually(multicastGroup *multicastgroupapi.MulticastGroup, expectFailure bool, fakeClient *util.OVNClusterManagerClientset) {
+	Eventually(func() bool {
+		mcg, err := fakeClient.MulticastGroupClient.K8sV1alpha1().MulticastGroups().
+			Get(context.TODO(), multicastGroup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		if expectFailure {
+			return strings.Contains(mcg.Status.Status, types.MulticastGroupErrorMsg)
+		}
+		return strings.Contains(mcg.Status.Status, "applied")
+	}).Should(BeTrue(), fmt.Sprintf("expected multicast group status with expectFailure=%v", expectFailure))
+}
+
+func checkEmptyMCGStatusConsistently(multicastGroup *multicastgroupapi.MulticastGroup, fakeClient *util.OVNClusterManagerClientset) {
+	Consistently(func() bool {
+		mcg, err := fakeClient.MulticastGroupClient.K8sV1alpha1().MulticastGroups().
+			Get(context.TODO(), multicastGroup.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return mcg.Status.Status == ""
+	}).Should(BeTrue(), "expected Status to be consistently empty")
+}
+
 var _ = Describe("Cluster Manager Status Manager", func() {
 	var (
 		statusManager *StatusManager
@@ -902,4 +942,51 @@ var _ = Describe("Cluster Manager Status Manager", func() {
 		checkNQStatusEventually(networkQoS, false, false, fakeClient)
 	})
 
+	It("updates MulticastGroup status with 2 zones", func() {
+		config.OVNKubernetesFeature.EnableMulticastGroup = true
+		zones := sets.New[string]("zone1", "zone2")
+		multicastGroup := newMulticastGroup("group1")
+		start(zones, multicastGroup)
+
+		updateMulticastGroupStatus(multicastGroup, &multicastgroupapi.MulticastGroupStatus{
+			Conditions: []metav1.Condition{{
+				Type:    "Ready-In-Zone-zone1",
+				Status:  metav1.ConditionTrue,
+				Reason:  "Success",
+				Message: "MulticastGroup applied",
+			}},
+		}, fakeClient)
+
+		checkEmptyMCGStatusConsistently(multicastGroup, fakeClient)
+
+		updateMulticastGroupStatus(multicastGroup, &multicastgroupapi.MulticastGroupStatus{
+			Conditions: []metav1.Condition{{
+				Type:    "Ready-In-Zone-zone1",
+				Status:  metav1.ConditionTrue,
+				Reason:  "Success",
+				Message: "MulticastGroup applied",
+			}, {
+				Type:    "Ready-In-Zone-zone2",
+				Status:  metav1.ConditionFalse,
+				Reason:  "Failed",
+				Message: types.MulticastGroupErrorMsg + ": failed to get logical switch port",
+			}},
+		}, fakeClient)
+		checkMCGStatusEventually(multicastGroup, true, fakeClient)
+
+		updateMulticastGroupStatus(multicastGroup, &multicastgroupapi.MulticastGroupStatus{
+			Conditions: []metav1.Condition{{
+				Type:    "Ready-In-Zone-zone1",
+				Status:  metav1.ConditionTrue,
+				Reason:  "Success",
+				Message: "MulticastGroup applied",
+			}, {
+				Type:    "Ready-In-Zone-zone2",
+				Status:  metav1.ConditionTrue,
+				Reason:  "Success",
+				Message: "MulticastGroup applied",
+			}},
+		}, fakeClient)
+		checkMCGStatusEventually(multicastGroup, false, fakeClient)
+	})
 })
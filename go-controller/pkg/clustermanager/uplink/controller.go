@@ -0,0 +1,426 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package uplink
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	k8scontrollerutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	controllerutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/controller"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinkapply "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/applyconfiguration/uplink/v1alpha1"
+	uplinkclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/clientset/versioned"
+	uplinklisters "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/listers/uplink/v1alpha1"
+	udnv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	udnlisters "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	finalizerUplink = "k8s.ovn.org/uplink-protection"
+)
+
+// NetworkStatusReporter reports a condition on the (C)UDN backing the given
+// network.
+type NetworkStatusReporter func(networkName string, fieldManager string, condition *metav1.Condition, events ...*util.EventDetails) error
+
+// Controller manages Uplink resources in the cluster manager: it protects
+// referenced Uplinks from deletion, aggregates the per node UplinkStates into
+// the Uplink Degraded condition, garbage collects stale UplinkStates and
+// reports the UplinksReady condition of CUDNs.
+type Controller struct {
+	uplinkClient      uplinkclientset.Interface
+	uplinkLister      uplinklisters.UplinkLister
+	uplinkStateLister uplinklisters.UplinkStateLister
+	cudnLister        udnlisters.ClusterUserDefinedNetworkLister
+	nodeLister        corelisters.NodeLister
+	networkManager    networkmanager.Interface
+	statusReporter    NetworkStatusReporter
+
+	wf                      *factory.WatchFactory
+	uplinkController        controllerutil.Controller
+	cudnController          controllerutil.Controller
+	nodeController          controllerutil.Controller
+	uplinkStateEventHandler cache.ResourceEventHandlerRegistration
+
+	// cudnUplinkIndex tracks which Uplinks each CUDN references
+	// (cudnName → uplinkNames), so that the Uplinks can be requeued when the
+	// CUDN is deleted and is no longer available in the lister.
+	cudnUplinkIndexMu sync.RWMutex
+	cudnUplinkIndex   map[string][]string
+}
+
+// NewController creates a new Uplink controller.
+func NewController(
+	wf *factory.WatchFactory,
+	ovnClient *util.OVNClusterManagerClientset,
+	networkManager networkmanager.Interface,
+	statusReporter NetworkStatusReporter,
+) *Controller {
+	uplinkLister := wf.UplinkInformer().Lister()
+	c := &Controller{
+		uplinkClient:      ovnClient.UplinkClient,
+		uplinkLister:      uplinkLister,
+		uplinkStateLister: wf.UplinkStateInformer().Lister(),
+		cudnLister:        wf.ClusterUserDefinedNetworkInformer().Lister(),
+		nodeLister:        wf.NodeCoreInformer().Lister(),
+		networkManager:    networkManager,
+		statusReporter:    statusReporter,
+		wf:                wf,
+		cudnUplinkIndex:   make(map[string][]string),
+	}
+
+	uplinkCfg := &controllerutil.ControllerConfig[uplinkv1alpha1.Uplink]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       wf.UplinkInformer().Informer(),
+		Lister:         uplinkLister.List,
+		Reconcile:      c.reconcileUplink,
+		ObjNeedsUpdate: uplinkNeedsUpdate,
+		Threadiness:    1,
+	}
+	c.uplinkController = controllerutil.NewController(
+		"clustermanager-uplink-controller",
+		uplinkCfg,
+	)
+
+	cudnLister := wf.ClusterUserDefinedNetworkInformer().Lister()
+	cudnCfg := &controllerutil.ControllerConfig[udnv1.ClusterUserDefinedNetwork]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       wf.ClusterUserDefinedNetworkInformer().Informer(),
+		Lister:         cudnLister.List,
+		Reconcile:      c.reconcileCUDN,
+		ObjNeedsUpdate: cudnNeedsUpdate,
+		Threadiness:    1,
+	}
+	c.cudnController = controllerutil.NewController(
+		"clustermanager-uplink-cudn-controller",
+		cudnCfg,
+	)
+
+	nodeLister := wf.NodeCoreInformer().Lister()
+	nodeCfg := &controllerutil.ControllerConfig[corev1.Node]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       wf.NodeCoreInformer().Informer(),
+		Lister:         nodeLister.List,
+		Reconcile:      c.reconcileNode,
+		ObjNeedsUpdate: nodeNeedsUpdate,
+		Threadiness:    1,
+	}
+	c.nodeController = controllerutil.NewController(
+		"clustermanager-uplink-node-controller",
+		nodeCfg,
+	)
+
+	return c
+}
+
+// Start begins the Uplink controller.
+func (c *Controller) Start() error {
+	defer klog.Infof("Cluster manager Uplink controller started")
+	var err error
+	c.uplinkStateEventHandler, err = c.wf.UplinkStateInformer().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.onUplinkStateEvent,
+			UpdateFunc: func(_, newObj interface{}) { c.onUplinkStateEvent(newObj) },
+			DeleteFunc: c.onUplinkStateEvent,
+		})
+	if err != nil {
+		return fmt.Errorf("failed to add uplink state event handler: %w", err)
+	}
+	return controllerutil.Start(
+		c.uplinkController,
+		c.cudnController,
+		c.nodeController,
+	)
+}
+
+// Stop shuts down the Uplink controller.
+func (c *Controller) Stop() {
+	if c.uplinkStateEventHandler != nil {
+		if err := c.wf.UplinkStateInformer().Informer().RemoveEventHandler(c.uplinkStateEventHandler); err != nil {
+			klog.Errorf("Failed to remove uplink state event handler: %v", err)
+		}
+	}
+	controllerutil.Stop(c.uplinkController, c.cudnController, c.nodeController)
+}
+
+// onUplinkStateEvent requeues the Uplink an UplinkState belongs to, or all
+// Uplinks if its identity can't be determined.
+func (c *Controller) onUplinkStateEvent(obj interface{}) {
+	state, ok := obj.(*uplinkv1alpha1.UplinkState)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		state, ok = tombstone.Obj.(*uplinkv1alpha1.UplinkState)
+		if !ok {
+			c.uplinkController.ReconcileAll()
+			return
+		}
+	}
+	uplinkName, _ := util.GetUplinkStateIdentity(state)
+	if uplinkName == "" {
+		c.uplinkController.ReconcileAll()
+		return
+	}
+	c.uplinkController.Reconcile(uplinkName)
+}
+
+func (c *Controller) reconcileUplink(key string) error {
+	startTime := time.Now()
+	uplinkName := key
+	klog.V(5).Infof("Reconciling Uplink %s", uplinkName)
+	defer func() {
+		klog.V(5).Infof("Reconciling Uplink %s took %v", uplinkName, time.Since(startTime))
+	}()
+
+	uplink, err := c.uplinkLister.Get(uplinkName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get Uplink %s: %w", uplinkName, err)
+	}
+
+	// the CUDNs referencing the Uplink are requeued in any case as their
+	// readiness depends on it
+	defer c.requeueCUDNsReferencingUplink(uplinkName)
+
+	if uplink == nil {
+		return c.deleteStaleUplinkStates(uplinkName, true)
+	}
+
+	if !uplink.DeletionTimestamp.IsZero() {
+		return c.handleUplinkDeletion(uplink)
+	}
+
+	if err := c.ensureFinalizer(uplink); err != nil {
+		return err
+	}
+
+	if err := c.deleteStaleUplinkStates(uplinkName, false); err != nil {
+		return err
+	}
+
+	condition, err := c.getDegradedCondition(uplink)
+	if err != nil {
+		return err
+	}
+	return c.updateStatusCondition(uplink, condition)
+}
+
+// ensureFinalizer adds the Uplink protection finalizer unconditionally on
+// every non-deleted Uplink, to avoid a race where the Uplink is deleted
+// between the time a CUDN starts referencing it and the controller notices.
+// Deletion is only blocked while CUDNs reference the Uplink, see
+// handleUplinkDeletion.
+func (c *Controller) ensureFinalizer(uplink *uplinkv1alpha1.Uplink) error {
+	if k8scontrollerutil.ContainsFinalizer(uplink, finalizerUplink) {
+		return nil
+	}
+	_, err := c.uplinkClient.K8sV1alpha1().Uplinks().Apply(
+		context.Background(),
+		uplinkapply.Uplink(uplink.Name).WithFinalizers(finalizerUplink),
+		metav1.ApplyOptions{FieldManager: fieldManager, Force: true},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add finalizer to Uplink %s: %w", uplink.Name, err)
+	}
+	klog.Infof("Added finalizer to Uplink %s", uplink.Name)
+	return nil
+}
+
+func (c *Controller) handleUplinkDeletion(uplink *uplinkv1alpha1.Uplink) error {
+	if !k8scontrollerutil.ContainsFinalizer(uplink, finalizerUplink) {
+		return nil
+	}
+
+	referencingCUDNs, err := c.getCUDNsReferencingUplink(uplink.Name)
+	if err != nil {
+		return fmt.Errorf("failed to check CUDN references for Uplink %s: %w", uplink.Name, err)
+	}
+	if len(referencingCUDNs) > 0 {
+		// no retry, the Uplink is requeued when a referencing CUDN is deleted
+		klog.Infof("Uplink %s is still referenced by CUDNs [%s], blocking deletion", uplink.Name, strings.Join(referencingCUDNs, ", "))
+		return nil
+	}
+
+	_, err = c.uplinkClient.K8sV1alpha1().Uplinks().Apply(
+		context.Background(),
+		uplinkapply.Uplink(uplink.Name),
+		metav1.ApplyOptions{FieldManager: fieldManager, Force: true},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove finalizer from Uplink %s: %w", uplink.Name, err)
+	}
+	klog.Infof("Removed finalizer from Uplink %s, deletion unblocked", uplink.Name)
+	return nil
+}
+
+// deleteStaleUplinkStates deletes the UplinkStates of the Uplink that belong
+// to nodes that no longer exist, or all of them if the Uplink was deleted.
+// UplinkStates are otherwise owned by the node controller, but these would be
+// left behind if the node is gone or down.
+func (c *Controller) deleteStaleUplinkStates(uplinkName string, uplinkDeleted bool) error {
+	states, err := util.GetUplinkStatesForUplink(c.uplinkStateLister, uplinkName)
+	if err != nil {
+		return fmt.Errorf("failed to list states of Uplink %s: %w", uplinkName, err)
+	}
+	for _, state := range states {
+		if !uplinkDeleted {
+			_, nodeName := util.GetUplinkStateIdentity(state)
+			_, err := c.nodeLister.Get(nodeName)
+			if err == nil {
+				continue
+			}
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get node %s: %w", nodeName, err)
+			}
+		}
+		err := c.uplinkClient.K8sV1alpha1().UplinkStates().Delete(context.Background(), state.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete stale uplink state %s: %w", state.Name, err)
+		}
+		klog.Infof("Deleted stale uplink state %s", state.Name)
+	}
+	return nil
+}
+
+// getCUDNsReferencingUplink returns the names of CUDNs that reference the
+// given Uplink.
+func (c *Controller) getCUDNsReferencingUplink(uplinkName string) ([]string, error) {
+	cudns, err := c.cudnLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CUDNs: %w", err)
+	}
+	var referencing []string
+	for _, cudn := range cudns {
+		if cudnReferencesUplink(cudn, uplinkName) {
+			referencing = append(referencing, cudn.Name)
+		}
+	}
+	return referencing, nil
+}
+
+func (c *Controller) requeueCUDNsReferencingUplink(uplinkName string) {
+	referencing, err := c.getCUDNsReferencingUplink(uplinkName)
+	if err != nil {
+		klog.Errorf("Failed to requeue CUDNs referencing Uplink %s: %v", uplinkName, err)
+		return
+	}
+	for _, cudnName := range referencing {
+		c.cudnController.Reconcile(cudnName)
+	}
+}
+
+func cudnReferencesUplink(cudn *udnv1.ClusterUserDefinedNetwork, uplinkName string) bool {
+	for _, name := range cudn.Spec.Uplinks {
+		if string(name) == uplinkName {
+			return true
+		}
+	}
+	return false
+}
+
+func cudnUplinkNames(cudn *udnv1.ClusterUserDefinedNetwork) []string {
+	names := make([]string, 0, len(cudn.Spec.Uplinks))
+	for _, name := range cudn.Spec.Uplinks {
+		names = append(names, string(name))
+	}
+	return names
+}
+
+// reconcileCUDN reports the UplinksReady condition of the CUDN. On create it
+// also populates the reverse index and requeues the referenced Uplinks, and
+// on delete it requeues them to allow finalizer removal.
+func (c *Controller) reconcileCUDN(key string) error {
+	cudnName := key
+	cudn, err := c.cudnLister.Get(cudnName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			c.cudnUplinkIndexMu.Lock()
+			uplinkNames := c.cudnUplinkIndex[cudnName]
+			delete(c.cudnUplinkIndex, cudnName)
+			c.cudnUplinkIndexMu.Unlock()
+			for _, uplinkName := range uplinkNames {
+				klog.V(5).Infof("CUDN %s deleted, re-queuing Uplink %s", cudnName, uplinkName)
+				c.uplinkController.Reconcile(uplinkName)
+			}
+			return nil
+		}
+		return fmt.Errorf("failed to get CUDN %s: %w", cudnName, err)
+	}
+
+	if len(cudn.Spec.Uplinks) > 0 {
+		uplinkNames := cudnUplinkNames(cudn)
+		c.cudnUplinkIndexMu.Lock()
+		_, alreadyIndexed := c.cudnUplinkIndex[cudnName]
+		c.cudnUplinkIndex[cudnName] = uplinkNames
+		c.cudnUplinkIndexMu.Unlock()
+		if !alreadyIndexed {
+			// fresh create event
+			klog.V(5).Infof("Indexed CUDN %s -> Uplinks %v, re-queuing them", cudnName, uplinkNames)
+			for _, uplinkName := range uplinkNames {
+				c.uplinkController.Reconcile(uplinkName)
+			}
+		}
+	}
+
+	condition, err := c.getUplinksReadyCondition(cudn)
+	if err != nil {
+		return err
+	}
+	return c.reportUplinksReady(cudn, condition)
+}
+
+// cudnNeedsUpdate lets CUDN creates through so reconcileCUDN can populate the
+// reverse index and report the initial condition. The uplinks are immutable
+// so updates don't matter; readiness changes are driven by Uplink
+// reconciliation. Deletions bypass ObjNeedsUpdate entirely.
+func cudnNeedsUpdate(oldObj, newObj *udnv1.ClusterUserDefinedNetwork) bool {
+	return oldObj == nil && newObj != nil
+}
+
+// reconcileNode re-queues all Uplinks when a node is added, deleted or its
+// labels change, as the node configs selecting it might have changed and its
+// UplinkStates might need to be garbage collected.
+func (c *Controller) reconcileNode(_ string) error {
+	c.uplinkController.ReconcileAll()
+	return nil
+}
+
+// nodeNeedsUpdate triggers Uplink reconciliation on node creation and labels
+// changes. Deletes bypass ObjNeedsUpdate in the controller framework.
+func nodeNeedsUpdate(oldObj, newObj *corev1.Node) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Labels, newObj.Labels)
+}
+
+func uplinkNeedsUpdate(oldObj, newObj *uplinkv1alpha1.Uplink) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	if !reflect.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return true
+	}
+	// Delete comes as an update event with a non-zero DeletionTimestamp.
+	// Always reconcile deleting Uplinks so finalizer removal can be retried.
+	return !newObj.DeletionTimestamp.IsZero()
+}
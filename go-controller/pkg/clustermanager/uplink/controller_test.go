@@ -0,0 +1,436 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package uplink
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinkfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/clientset/versioned/fake"
+	udnv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
+	ovntest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const poolLabel = "pool"
+
+func newUplink(name string, pools ...string) *uplinkv1alpha1.Uplink {
+	uplink := &uplinkv1alpha1.Uplink{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for _, pool := range pools {
+		uplink.Spec.NodeConfigs = append(uplink.Spec.NodeConfigs, uplinkv1alpha1.UplinkNodeConfig{
+			Type:              uplinkv1alpha1.UplinkTypeOVSBridge,
+			NodeSelector:      metav1.LabelSelector{MatchLabels: map[string]string{poolLabel: pool}},
+			HostInterfaceName: "br-" + name,
+		})
+	}
+	return uplink
+}
+
+func newNode(name, pool string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{poolLabel: pool},
+		},
+	}
+}
+
+func newUplinkState(uplinkName, nodeName, reason string) *uplinkv1alpha1.UplinkState {
+	status := metav1.ConditionFalse
+	if reason == uplinkv1alpha1.UplinkStateReasonReady {
+		status = metav1.ConditionTrue
+	}
+	return &uplinkv1alpha1.UplinkState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   util.GetUplinkStateName(uplinkName, nodeName),
+			Labels: util.GetUplinkStateLabels(uplinkName, nodeName),
+		},
+		Status: uplinkv1alpha1.UplinkStateStatus{
+			UplinkName:        uplinkName,
+			NodeName:          nodeName,
+			Type:              uplinkv1alpha1.UplinkTypeOVSBridge,
+			HostInterfaceName: "br-" + uplinkName,
+			OVSBridge:         &uplinkv1alpha1.OVSBridgeState{Name: "br-" + uplinkName},
+			MACAddress:        "0a:58:0a:00:00:01",
+			IPAddresses:       []string{"172.20.0.2/24"},
+			DefaultGateways:   []string{"172.20.0.1"},
+			Conditions: []metav1.Condition{{
+				Type:   uplinkv1alpha1.UplinkStateReady,
+				Status: status,
+				Reason: reason,
+			}},
+		},
+	}
+}
+
+func newCUDN(name string, uplinks ...string) *udnv1.ClusterUserDefinedNetwork {
+	cudn := &udnv1.ClusterUserDefinedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: udnv1.ClusterUserDefinedNetworkSpec{
+			Network: udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{
+					Role:    udnv1.NetworkRolePrimary,
+					Subnets: []udnv1.Layer3Subnet{{CIDR: "10.0.0.0/16"}},
+				},
+			},
+		},
+	}
+	for _, uplink := range uplinks {
+		cudn.Spec.Uplinks = append(cudn.Spec.Uplinks, udnv1.UplinkName(uplink))
+	}
+	return cudn
+}
+
+// fakeStatusReporter records the last condition reported per network.
+type fakeStatusReporter struct {
+	sync.Mutex
+	conditions map[string]metav1.Condition
+}
+
+func (r *fakeStatusReporter) report(networkName string, _ string, condition *metav1.Condition, _ ...*util.EventDetails) error {
+	r.Lock()
+	defer r.Unlock()
+	r.conditions[networkName] = *condition
+	return nil
+}
+
+func (r *fakeStatusReporter) get(cudnName string) *metav1.Condition {
+	r.Lock()
+	defer r.Unlock()
+	condition, ok := r.conditions[util.GenerateCUDNNetworkName(cudnName)]
+	if !ok {
+		return nil
+	}
+	return &condition
+}
+
+var _ = ginkgo.Describe("Uplink Controller", func() {
+	var (
+		controller    *Controller
+		fakeUplink    *uplinkfake.Clientset
+		fakeClientset *util.OVNClusterManagerClientset
+		fakeNM        *networkmanager.FakeNetworkManager
+		reporter      *fakeStatusReporter
+		wf            *factory.WatchFactory
+	)
+
+	start := func(objects ...runtime.Object) {
+		uplinkObjects := []runtime.Object{}
+		otherObjects := []runtime.Object{}
+		for _, obj := range objects {
+			switch obj.(type) {
+			case *uplinkv1alpha1.Uplink, *uplinkv1alpha1.UplinkState:
+				uplinkObjects = append(uplinkObjects, obj)
+			default:
+				otherObjects = append(otherObjects, obj)
+			}
+		}
+
+		fakeUplink = uplinkfake.NewSimpleClientset(uplinkObjects...)
+		ovntest.AddUplinkApplyReactor(fakeUplink)
+
+		fakeClientset = util.GetOVNClientset(otherObjects...).GetClusterManagerClientset()
+		fakeClientset.UplinkClient = fakeUplink
+
+		var err error
+		wf, err = factory.NewClusterManagerWatchFactory(fakeClientset)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		controller = NewController(wf, fakeClientset, fakeNM, reporter.report)
+
+		err = wf.Start()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		err = controller.Start()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	getDegraded := func(name string) (*metav1.Condition, error) {
+		uplink, err := fakeUplink.K8sV1alpha1().Uplinks().Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return meta.FindStatusCondition(uplink.Status.Conditions, uplinkv1alpha1.UplinkDegraded), nil
+	}
+
+	getFinalizers := func(name string) ([]string, error) {
+		uplink, err := fakeUplink.K8sV1alpha1().Uplinks().Get(context.Background(), name, metav1.GetOptions{})
+		// NotFound means the object was garbage-collected after its
+		// finalizers were cleared
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Uplink %s: %w", name, err)
+		}
+		return uplink.Finalizers, nil
+	}
+
+	markUplinkForDeletion := func(name string) {
+		ginkgo.GinkgoHelper()
+		uplink, err := fakeUplink.K8sV1alpha1().Uplinks().Get(context.Background(), name, metav1.GetOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		now := metav1.Now()
+		uplink.DeletionTimestamp = &now
+		_, err = fakeUplink.K8sV1alpha1().Uplinks().Update(context.Background(), uplink, metav1.UpdateOptions{})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	ginkgo.BeforeEach(func() {
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+		config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+		config.OVNKubernetesFeature.EnableUplink = true
+		config.Gateway.Mode = config.GatewayModeShared
+		fakeNM = &networkmanager.FakeNetworkManager{}
+		reporter = &fakeStatusReporter{conditions: map[string]metav1.Condition{}}
+	})
+
+	ginkgo.AfterEach(func() {
+		if controller != nil {
+			controller.Stop()
+		}
+		if wf != nil {
+			wf.Shutdown()
+		}
+	})
+
+	ginkgo.Context("Degraded condition", func() {
+		ginkgo.It("is False when the uplink is ready on all selected nodes", func() {
+			start(
+				newUplink("blue", "a"),
+				newNode("node1", "a"),
+				newNode("node2", "b"),
+				newUplinkState("blue", "node1", uplinkv1alpha1.UplinkStateReasonReady),
+			)
+
+			gomega.Eventually(getDegraded).WithArguments("blue").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionFalse),
+				gomega.HaveField("Reason", reasonDegradedReady),
+				gomega.HaveField("Message", gomega.ContainSubstring("1 selected node(s)")),
+			))
+			gomega.Eventually(getFinalizers).WithArguments("blue").Should(gomega.ConsistOf(finalizerUplink))
+		})
+
+		ginkgo.It("is True when an UplinkState is missing or not ready", func() {
+			start(
+				newUplink("blue", "a"),
+				newNode("node1", "a"),
+				newNode("node2", "a"),
+				newUplinkState("blue", "node1", uplinkv1alpha1.UplinkStateReasonBridgeNotFound),
+			)
+
+			gomega.Eventually(getDegraded).WithArguments("blue").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionTrue),
+				gomega.HaveField("Reason", reasonDegradedStateNotReady),
+				gomega.HaveField("Message", gomega.ContainSubstring("node1 (BridgeNotFound), node2 (UplinkStateMissing)")),
+			))
+
+			ginkgo.By("the node reporting a ready state")
+			for _, nodeName := range []string{"node1", "node2"} {
+				state := newUplinkState("blue", nodeName, uplinkv1alpha1.UplinkStateReasonReady)
+				_, err := fakeUplink.K8sV1alpha1().UplinkStates().Update(context.Background(), state, metav1.UpdateOptions{})
+				if apierrors.IsNotFound(err) {
+					_, err = fakeUplink.K8sV1alpha1().UplinkStates().Create(context.Background(), state, metav1.CreateOptions{})
+				}
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+
+			gomega.Eventually(getDegraded).WithArguments("blue").WithTimeout(5 * time.Second).Should(
+				gomega.HaveField("Status", metav1.ConditionFalse),
+			)
+		})
+
+		ginkgo.It("is True when node configs overlap on a node", func() {
+			uplink := newUplink("blue", "a")
+			uplink.Spec.NodeConfigs = append(uplink.Spec.NodeConfigs, uplinkv1alpha1.UplinkNodeConfig{
+				Type:              uplinkv1alpha1.UplinkTypeOVSBridge,
+				HostInterfaceName: "br-other",
+			})
+			start(
+				uplink,
+				newNode("node1", "a"),
+				newUplinkState("blue", "node1", uplinkv1alpha1.UplinkStateReasonReady),
+			)
+
+			gomega.Eventually(getDegraded).WithArguments("blue").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionTrue),
+				gomega.HaveField("Reason", reasonDegradedNodeSelectorOverlap),
+				gomega.HaveField("Message", gomega.ContainSubstring("1 node(s): node1")),
+			))
+		})
+
+		ginkgo.It("is updated when node labels change", func() {
+			start(
+				newUplink("blue", "a"),
+				newNode("node1", "b"),
+			)
+
+			gomega.Eventually(getDegraded).WithArguments("blue").WithTimeout(5 * time.Second).Should(
+				gomega.HaveField("Status", metav1.ConditionFalse),
+			)
+
+			node := newNode("node1", "a")
+			_, err := fakeClientset.KubeClient.CoreV1().Nodes().Update(context.Background(), node, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Eventually(getDegraded).WithArguments("blue").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionTrue),
+				gomega.HaveField("Reason", reasonDegradedStateNotReady),
+			))
+		})
+	})
+
+	ginkgo.Context("UplinkState garbage collection", func() {
+		ginkgo.It("deletes the states of deleted nodes and uplinks", func() {
+			start(
+				newUplink("blue", "a"),
+				newNode("node1", "a"),
+				newUplinkState("blue", "node1", uplinkv1alpha1.UplinkStateReasonReady),
+				newUplinkState("blue", "gone", uplinkv1alpha1.UplinkStateReasonReady),
+				newUplinkState("red", "node1", uplinkv1alpha1.UplinkStateReasonReady),
+			)
+
+			listStates := func() ([]string, error) {
+				states, err := fakeUplink.K8sV1alpha1().UplinkStates().List(context.Background(), metav1.ListOptions{})
+				if err != nil {
+					return nil, err
+				}
+				names := []string{}
+				for _, state := range states.Items {
+					names = append(names, state.Name)
+				}
+				return names, nil
+			}
+			gomega.Eventually(listStates).WithTimeout(5 * time.Second).Should(gomega.ConsistOf(
+				util.GetUplinkStateName("blue", "node1"),
+			))
+		})
+	})
+
+	ginkgo.Context("Finalizer", func() {
+		ginkgo.It("blocks deletion while a CUDN references the uplink", func() {
+			start(
+				newUplink("blue", "a"),
+				newCUDN("net1", "blue"),
+			)
+
+			gomega.Eventually(getFinalizers).WithArguments("blue").WithTimeout(5 * time.Second).Should(gomega.ConsistOf(finalizerUplink))
+
+			markUplinkForDeletion("blue")
+			gomega.Consistently(getFinalizers).WithArguments("blue").WithTimeout(time.Second).Should(gomega.ConsistOf(finalizerUplink))
+
+			err := fakeClientset.UserDefinedNetworkClient.K8sV1().ClusterUserDefinedNetworks().Delete(context.Background(), "net1", metav1.DeleteOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Eventually(getFinalizers).WithArguments("blue").WithTimeout(5 * time.Second).Should(gomega.BeEmpty())
+		})
+
+		ginkgo.It("unblocks deletion of an unreferenced uplink", func() {
+			start(newUplink("blue", "a"))
+
+			gomega.Eventually(getFinalizers).WithArguments("blue").WithTimeout(5 * time.Second).Should(gomega.ConsistOf(finalizerUplink))
+
+			markUplinkForDeletion("blue")
+			gomega.Eventually(getFinalizers).WithArguments("blue").WithTimeout(5 * time.Second).Should(gomega.BeEmpty())
+		})
+	})
+
+	ginkgo.Context("CUDN UplinksReady condition", func() {
+		ginkgo.It("is True for a CUDN without uplinks", func() {
+			start(newCUDN("net1"))
+
+			gomega.Eventually(reporter.get).WithArguments("net1").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Type", conditionTypeUplinksReady),
+				gomega.HaveField("Status", metav1.ConditionTrue),
+				gomega.HaveField("Reason", reasonUplinksReady),
+			))
+		})
+
+		ginkgo.It("reports a missing uplink until it is created and ready", func() {
+			start(
+				newNode("node1", "a"),
+				newCUDN("net1", "blue"),
+			)
+
+			gomega.Eventually(reporter.get).WithArguments("net1").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionFalse),
+				gomega.HaveField("Reason", reasonUplinkNotFound),
+			))
+
+			_, err := fakeUplink.K8sV1alpha1().Uplinks().Create(context.Background(), newUplink("blue", "a"), metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Eventually(reporter.get).WithArguments("net1").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionFalse),
+				gomega.HaveField("Reason", reasonUplinkNotReadyForNode),
+				gomega.HaveField("Message", gomega.ContainSubstring("node1 (UplinkStateMissing)")),
+			))
+
+			_, err = fakeUplink.K8sV1alpha1().UplinkStates().Create(context.Background(),
+				newUplinkState("blue", "node1", uplinkv1alpha1.UplinkStateReasonReady), metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Eventually(reporter.get).WithArguments("net1").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionTrue),
+				gomega.HaveField("Reason", reasonUplinksReady),
+			))
+		})
+
+		ginkgo.It("reports active nodes not selected by the uplink", func() {
+			config.OVNKubernetesFeature.EnableDynamicUDNAllocation = true
+			fakeNM.SetNodeActive(util.GenerateCUDNNetworkName("net1"), "node2", true)
+			start(
+				newUplink("blue", "a"),
+				newNode("node1", "a"),
+				newNode("node2", "b"),
+				newNode("node3", "b"),
+				newUplinkState("blue", "node1", uplinkv1alpha1.UplinkStateReasonReady),
+				newCUDN("net1", "blue"),
+			)
+
+			gomega.Eventually(reporter.get).WithArguments("net1").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionFalse),
+				gomega.HaveField("Reason", reasonUplinkNotFoundForNode),
+				gomega.HaveField("Message", gomega.HaveSuffix("1 node(s): node2")),
+			))
+		})
+
+		ginkgo.It("reports unsupported gateway mode", func() {
+			config.Gateway.Mode = config.GatewayModeLocal
+			start(
+				newUplink("blue", "a"),
+				newCUDN("net1", "blue"),
+			)
+
+			gomega.Eventually(reporter.get).WithArguments("net1").WithTimeout(5 * time.Second).Should(gomega.SatisfyAll(
+				gomega.HaveField("Status", metav1.ConditionFalse),
+				gomega.HaveField("Reason", reasonUplinkUnsupportedGatewayMode),
+			))
+		})
+	})
+})
+
+var _ = ginkgo.Describe("nodeSample", func() {
+	ginkgo.It("bounds the number of listed nodes", func() {
+		gomega.Expect(nodeSample([]string{"c", "a", "b"})).To(gomega.Equal("3 node(s): a, b, c"))
+		gomega.Expect(nodeSample([]string{"g", "f", "e", "d", "c", "b", "a"})).To(gomega.Equal("7 node(s): a, b, c, d, e, ..."))
+	})
+})
@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package uplink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	metaapply "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinkapply "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/applyconfiguration/uplink/v1alpha1"
+	udnv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	fieldManager = "clustermanager-uplink-controller"
+
+	// Uplink Degraded condition reasons
+	reasonDegradedReady               = "Ready"
+	reasonDegradedInvalidNodeSelector = "InvalidNodeSelector"
+	reasonDegradedNodeSelectorOverlap = "NodeSelectorOverlap"
+	reasonDegradedStateNotReady       = "UplinkStateNotReady"
+
+	// reasonStateMissing is reported for nodes selected by the Uplink that
+	// have no UplinkState yet.
+	reasonStateMissing = "UplinkStateMissing"
+
+	conditionTypeUplinksReady = "UplinksReady"
+
+	// CUDN UplinksReady condition reasons
+	reasonUplinksReady                 = "UplinksReady"
+	reasonUplinkUnsupportedGatewayMode = "UplinkUnsupportedGatewayMode"
+	reasonUplinkUnsupportedTransport   = "UplinkUnsupportedTransport"
+	reasonUplinkNotFound               = "UplinkNotFound"
+	reasonUplinkOverlapOnNode          = "UplinkOverlapOnNode"
+	reasonUplinkNotFoundForNode        = "UplinkNotFoundForNode"
+	reasonUplinkNotReadyForNode        = "UplinkNotReadyForNode"
+
+	// maxNodeSample is the maximum number of node names listed in condition
+	// messages, full details are available in the UplinkStates.
+	maxNodeSample = 5
+)
+
+// uplinkNodeIssues holds the nodes with problems of an Uplink.
+type uplinkNodeIssues struct {
+	// overlap are nodes selected by more than one node config
+	overlap []string
+	// unselected are nodes not selected by any node config
+	unselected []string
+	// notReady are nodes selected by a single node config whose UplinkState
+	// is missing or not ready, with the reason
+	notReady []string
+	// selected is the number of nodes selected by the Uplink
+	selected int
+}
+
+// getUplinkNodeIssues evaluates the Uplink on the nodes for which nodeFilter
+// returns true.
+func (c *Controller) getUplinkNodeIssues(uplink *uplinkv1alpha1.Uplink, nodeFilter func(node *corev1.Node) bool) (*uplinkNodeIssues, error) {
+	nodes, err := c.nodeLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	states, err := util.GetUplinkStatesForUplink(c.uplinkStateLister, uplink.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list states of Uplink %s: %w", uplink.Name, err)
+	}
+	statesByNode := make(map[string]*uplinkv1alpha1.UplinkState, len(states))
+	for _, state := range states {
+		_, nodeName := util.GetUplinkStateIdentity(state)
+		statesByNode[nodeName] = state
+	}
+
+	issues := &uplinkNodeIssues{}
+	for _, node := range nodes {
+		if nodeFilter != nil && !nodeFilter(node) {
+			continue
+		}
+		nodeConfigs, err := util.MatchingUplinkNodeConfigs(uplink, node)
+		if err != nil {
+			return nil, err
+		}
+		switch len(nodeConfigs) {
+		case 0:
+			issues.unselected = append(issues.unselected, node.Name)
+			continue
+		case 1:
+		default:
+			issues.selected++
+			issues.overlap = append(issues.overlap, node.Name)
+			continue
+		}
+		issues.selected++
+		state := statesByNode[node.Name]
+		switch {
+		case state == nil:
+			issues.notReady = append(issues.notReady, fmt.Sprintf("%s (%s)", node.Name, reasonStateMissing))
+		case !util.IsUplinkStateReady(state):
+			reason := util.GetUplinkStateReadyReason(state)
+			if reason == uplinkv1alpha1.UplinkStateReasonNodeSelectorOverlap {
+				// the node reported the overlap before the labels change
+				// reached this controller
+				issues.overlap = append(issues.overlap, node.Name)
+				continue
+			}
+			if reason == "" {
+				reason = reasonStateMissing
+			}
+			issues.notReady = append(issues.notReady, fmt.Sprintf("%s (%s)", node.Name, reason))
+		}
+	}
+	return issues, nil
+}
+
+// nodeSample returns a bounded summary of the given nodes.
+func nodeSample(nodes []string) string {
+	sort.Strings(nodes)
+	sample := nodes
+	suffix := ""
+	if len(sample) > maxNodeSample {
+		sample = sample[:maxNodeSample]
+		suffix = ", ..."
+	}
+	return fmt.Sprintf("%d node(s): %s%s", len(nodes), strings.Join(sample, ", "), suffix)
+}
+
+// getDegradedCondition returns the aggregate Degraded condition of the Uplink
+// across the nodes it selects.
+func (c *Controller) getDegradedCondition(uplink *uplinkv1alpha1.Uplink) (*metav1.Condition, error) {
+	condition := &metav1.Condition{
+		Type:   uplinkv1alpha1.UplinkDegraded,
+		Status: metav1.ConditionTrue,
+	}
+	issues, err := c.getUplinkNodeIssues(uplink, nil)
+	if err != nil {
+		condition.Reason = reasonDegradedInvalidNodeSelector
+		condition.Message = err.Error()
+		return condition, nil
+	}
+
+	var messages []string
+	if len(issues.overlap) > 0 {
+		condition.Reason = reasonDegradedNodeSelectorOverlap
+		messages = append(messages, "more than one node config selects "+nodeSample(issues.overlap))
+	}
+	if len(issues.notReady) > 0 {
+		if condition.Reason == "" {
+			condition.Reason = reasonDegradedStateNotReady
+		}
+		messages = append(messages, "uplink is not ready on "+nodeSample(issues.notReady))
+	}
+	if len(messages) > 0 {
+		condition.Message = strings.Join(messages, "; ")
+		return condition, nil
+	}
+
+	condition.Status = metav1.ConditionFalse
+	condition.Reason = reasonDegradedReady
+	condition.Message = fmt.Sprintf("uplink is ready on the %d selected node(s)", issues.selected)
+	return condition, nil
+}
+
+// updateStatusCondition applies the status condition to the Uplink resource.
+// The API update is skipped if the condition already matches.
+func (c *Controller) updateStatusCondition(uplink *uplinkv1alpha1.Uplink, condition *metav1.Condition) error {
+	existingCondition := meta.FindStatusCondition(uplink.Status.Conditions, condition.Type)
+	if existingCondition != nil &&
+		existingCondition.Status == condition.Status &&
+		existingCondition.Reason == condition.Reason &&
+		existingCondition.Message == condition.Message {
+		return nil
+	}
+
+	now := metav1.NewTime(time.Now())
+	if existingCondition != nil && existingCondition.Status == condition.Status {
+		now = existingCondition.LastTransitionTime
+	}
+	applyCondition := metaapply.Condition().
+		WithType(condition.Type).
+		WithStatus(condition.Status).
+		WithReason(condition.Reason).
+		WithMessage(condition.Message).
+		WithLastTransitionTime(now)
+
+	// NOTE: this field manager owns a single condition type, see the VTEP
+	// controller for the caveats of applying more than one.
+	_, err := c.uplinkClient.K8sV1alpha1().Uplinks().ApplyStatus(
+		context.Background(),
+		uplinkapply.Uplink(uplink.Name).WithStatus(
+			uplinkapply.UplinkStatus().WithConditions(applyCondition),
+		),
+		metav1.ApplyOptions{
+			FieldManager: fieldManager,
+			Force:        true,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update status condition %q for Uplink %s: %w", condition.Type, uplink.Name, err)
+	}
+	return nil
+}
+
+// getUplinksReadyCondition returns the UplinksReady condition of the CUDN.
+// Only the nodes where the network is active are considered. When there are
+// several failures, the reason is selected in the order they are checked.
+func (c *Controller) getUplinksReadyCondition(cudn *udnv1.ClusterUserDefinedNetwork) (*metav1.Condition, error) {
+	condition := &metav1.Condition{
+		Type:   conditionTypeUplinksReady,
+		Status: metav1.ConditionFalse,
+	}
+	if len(cudn.Spec.Uplinks) == 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reasonUplinksReady
+		condition.Message = "Network does not use uplinks"
+		return condition, nil
+	}
+	if config.Gateway.Mode != config.GatewayModeShared {
+		condition.Reason = reasonUplinkUnsupportedGatewayMode
+		condition.Message = fmt.Sprintf("Uplinks are not supported in %s gateway mode", config.Gateway.Mode)
+		return condition, nil
+	}
+	if cudn.Spec.Network.Transport == udnv1.TransportOptionEVPN {
+		condition.Reason = reasonUplinkUnsupportedTransport
+		condition.Message = "Uplinks are not supported with EVPN transport"
+		return condition, nil
+	}
+
+	networkName := util.GenerateCUDNNetworkName(cudn.Name)
+	isActive := func(node *corev1.Node) bool {
+		return c.networkManager.NodeHasNetwork(node.Name, networkName)
+	}
+	for _, uplinkName := range cudnUplinkNames(cudn) {
+		uplink, err := c.uplinkLister.Get(uplinkName)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get Uplink %s: %w", uplinkName, err)
+			}
+			condition.Reason = reasonUplinkNotFound
+			condition.Message = fmt.Sprintf("Uplink %s not found", uplinkName)
+			return condition, nil
+		}
+		issues, err := c.getUplinkNodeIssues(uplink, isActive)
+		if err != nil {
+			condition.Reason = reasonUplinkNotFoundForNode
+			condition.Message = fmt.Sprintf("Uplink %s: %v", uplinkName, err)
+			return condition, nil
+		}
+		switch {
+		case len(issues.overlap) > 0:
+			condition.Reason = reasonUplinkOverlapOnNode
+			condition.Message = fmt.Sprintf("Uplink %s has more than one node config selecting %s", uplinkName, nodeSample(issues.overlap))
+		case len(issues.unselected) > 0:
+			condition.Reason = reasonUplinkNotFoundForNode
+			condition.Message = fmt.Sprintf("Uplink %s has no node config selecting %s", uplinkName, nodeSample(issues.unselected))
+		case len(issues.notReady) > 0:
+			condition.Reason = reasonUplinkNotReadyForNode
+			condition.Message = fmt.Sprintf("Uplink %s is not ready on %s", uplinkName, nodeSample(issues.notReady))
+		default:
+			continue
+		}
+		return condition, nil
+	}
+
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = reasonUplinksReady
+	condition.Message = "Uplinks are ready on all the nodes where the network is active"
+	return condition, nil
+}
+
+// reportUplinksReady reports the UplinksReady condition of the CUDN if it
+// changed, with a warning event when it is not ready.
+func (c *Controller) reportUplinksReady(cudn *udnv1.ClusterUserDefinedNetwork, condition *metav1.Condition) error {
+	if c.statusReporter == nil {
+		return nil
+	}
+	existingCondition := meta.FindStatusCondition(cudn.Status.Conditions, condition.Type)
+	if existingCondition != nil &&
+		existingCondition.Status == condition.Status &&
+		existingCondition.Reason == condition.Reason &&
+		existingCondition.Message == condition.Message {
+		return nil
+	}
+
+	condition.LastTransitionTime = metav1.NewTime(time.Now())
+	if existingCondition != nil && existingCondition.Status == condition.Status {
+		condition.LastTransitionTime = existingCondition.LastTransitionTime
+	}
+	var events []*util.EventDetails
+	if condition.Status == metav1.ConditionFalse {
+		events = append(events, &util.EventDetails{
+			EventType: util.EventTypeWarning,
+			Reason:    condition.Reason,
+			Note:      condition.Message,
+		})
+	}
+	err := c.statusReporter(util.GenerateCUDNNetworkName(cudn.Name), fieldManager, condition, events...)
+	if err != nil {
+		return fmt.Errorf("failed to report %s condition of CUDN %s: %w", condition.Type, cudn.Name, err)
+	}
+	klog.V(4).Infof("Reported %s condition of CUDN %s: %s", condition.Type, cudn.Name, condition.Reason)
+	return nil
+}
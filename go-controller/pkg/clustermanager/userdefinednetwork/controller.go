@@ -59,10 +59,11 @@ const (
 	conditionTypeNetworkCreated = "NetworkCreated"
 
 	// Condition reasons
-	reasonNADCreated   = "NetworkAttachmentDefinitionCreated"
-	reasonSyncError    = "SyncError"
-	reasonNADDeleted   = "NetworkAttachmentDefinitionDeleted"
-	reasonNADSyncError = "NetworkAttachmentDefinitionSyncError"
+	reasonNADCreated     = "NetworkAttachmentDefinitionCreated"
+	reasonSyncError      = "SyncError"
+	reasonNADDeleted     = "NetworkAttachmentDefinitionDeleted"
+	reasonNADSyncError   = "NetworkAttachmentDefinitionSyncError"
+	reasonSubnetsRemoved = "SubnetRemovalNotSupported"
 
 	// MaxEVPNVIDs is the maximum number of VIDs available for EVPN networks (0-4094, but 0 and 1 are reserved).
 	MaxEVPNVIDs = 4095
@@ -116,6 +117,16 @@ func (e *vtepNotAcceptedError) Error() string {
 	return fmt.Sprintf("VTEP %q is not accepted", e.vtepName)
 }
 
+// subnetsRemovedError indicates that subnets were removed from the network
+// spec, which is not supported: subnets can only be appended.
+type subnetsRemovedError struct {
+	subnets []string
+}
+
+func (e *subnetsRemovedError) Error() string {
+	return fmt.Sprintf("removing subnets %v from the network is not supported", e.subnets)
+}
+
 // evpnConfigError indicates an EVPN configuration issue (e.g. feature not enabled, wrong gateway mode).
 type evpnConfigError struct {
 	msg string
@@ -756,6 +767,13 @@ func (c *Controller) reconcileUDN(key string) error {
 		return updateStatusErr
 	}
 
+	// subnetsRemovedError is non-fatal: the status has been updated to
+	// reflect it and retrying won't help until the spec is fixed.
+	var subnetsRemoved *subnetsRemovedError
+	if errors.As(syncErr, &subnetsRemoved) {
+		return updateStatusErr
+	}
+
 	return errors.Join(syncErr, updateStatusErr)
 }
 
@@ -866,6 +884,12 @@ func newNetworkCreatedCondition(nad *netv1.NetworkAttachmentDefinition, syncErro
 		networkCreatedCondition.Status = metav1.ConditionFalse
 		networkCreatedCondition.Reason = reasonSyncError
 		networkCreatedCondition.Message = syncError.Error()
+
+		var subnetsRemoved *subnetsRemovedError
+		if errors.As(syncError, &subnetsRemoved) {
+			networkCreatedCondition.Reason = reasonSubnetsRemoved
+			networkCreatedCondition.Message = subnetsRemovedMessage(subnetsRemoved)
+		}
 	}
 
 	return networkCreatedCondition
@@ -927,6 +951,13 @@ func (c *Controller) reconcileCUDN(key string) error {
 		return updateStatusErr
 	}
 
+	// subnetsRemovedError is non-fatal: the status has been updated to
+	// reflect it and retrying won't help until the spec is fixed.
+	var subnetsRemoved *subnetsRemovedError
+	if errors.As(syncErr, &subnetsRemoved) {
+		return updateStatusErr
+	}
+
 	return errors.Join(syncErr, updateStatusErr)
 }
 
@@ -1162,6 +1193,7 @@ func newClusterNetworkCreatedCondition(nads []netv1.NetworkAttachmentDefinition,
 		var vtepNotFound *vtepNotFoundError
 		var vtepNotAccepted *vtepNotAcceptedError
 		var evpnCfgErr *evpnConfigError
+		var subnetsRemoved *subnetsRemovedError
 		if errors.As(syncError, &vtepNotFound) {
 			condition.Reason = ReasonVTEPNotFound
 			condition.Message = fmt.Sprintf("Cannot create network: VTEP '%s' does not exist. "+
@@ -1175,6 +1207,9 @@ func newClusterNetworkCreatedCondition(nads []netv1.NetworkAttachmentDefinition,
 		} else if errors.As(syncError, &evpnCfgErr) {
 			condition.Reason = ReasonEVPNConfigError
 			condition.Message = evpnCfgErr.Error()
+		} else if errors.As(syncError, &subnetsRemoved) {
+			condition.Reason = reasonSubnetsRemoved
+			condition.Message = subnetsRemovedMessage(subnetsRemoved)
 		} else {
 			condition.Reason = reasonNADSyncError
 			condition.Message = syncError.Error()
@@ -1184,6 +1219,11 @@ func newClusterNetworkCreatedCondition(nads []netv1.NetworkAttachmentDefinition,
 	return condition
 }
 
+func subnetsRemovedMessage(err *subnetsRemovedError) string {
+	return fmt.Sprintf("Cannot update network: subnets %v were removed, but subnets can only be appended. "+
+		"The network keeps using its current subnets until they are restored in the spec.", err.subnets)
+}
+
 // validateEVPN validates EVPN configuration for a CUDN.
 // Returns an error if EVPN is requested but disabled, if the referenced VTEP
 // doesn't exist, or if the VTEP is not yet accepted.
@@ -98,6 +98,10 @@ func (c *Controller) updateNAD(obj client.Object, namespace string) (*netv1.Netw
 		}
 	}
 
+	if removedSubnets := NetAttachDefRemovedSubnets(nadCopy, desiredNAD); len(removedSubnets) > 0 {
+		return nil, &subnetsRemovedError{subnets: removedSubnets}
+	}
+
 	if reflect.DeepEqual(nadCopy.Spec.Config, desiredNAD.Spec.Config) && reflect.DeepEqual(nadCopy.ObjectMeta.Labels, desiredNAD.ObjectMeta.Labels) &&
 		reflect.DeepEqual(desiredNAD.Annotations, nadCopy.Annotations) {
 		return nadCopy, nil
@@ -364,6 +364,32 @@ var _ = Describe("User Defined Network Controller", func() {
 				}).Should(Equal(mutatedNAD))
 			})
 
+			It("should not remove subnets from NAD and report it in status", func() {
+				udn := testPrimaryUDN()
+				existingNAD := testNAD()
+				existingNAD.Spec.Config = `{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/16/24,10.1.0.0/16/24"}`
+				desiredNAD := testNAD()
+				desiredNAD.Spec.Config = `{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/16/24"}`
+				c = newTestController(renderNadStub(desiredNAD), udn, existingNAD, testNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:   "NetworkCreated",
+					Status: "False",
+					Reason: "SubnetRemovalNotSupported",
+					Message: "Cannot update network: subnets [10.1.0.0/16/24] were removed, but subnets can only be appended. " +
+						"The network keeps using its current subnets until they are restored in the spec.",
+				}}))
+
+				nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nad).To(Equal(existingNAD))
+			})
+
 			It("given primary UDN, should fail when primary NAD already exist", func() {
 				primaryUDN := testPrimaryUDN()
 				primaryUDN.Spec.Topology = udnv1.NetworkTopologyLayer2
@@ -3075,7 +3101,7 @@ func testEVPNClusterUdnNADWithEVPNCfg(name, namespace string, evpnCfg *ovncnityp
 	if err != nil {
 		panic(fmt.Sprintf("failed to marshal EVPN config: %v", err))
 	}
-	nad.Spec.Config = fmt.Sprintf(`{"cniVersion":"1.1.0","name":"cluster_udn_%s","type":"ovn-k8s-cni-overlay","netAttachDefName":"%s/%s","topology":"layer2","role":"primary","subnets":"10.10.0.0/16","transport":"evpn","evpn":%s}`, name, namespace, name, evpnJSON)
+	nad.Spec.Config = fmt.Sprintf(`{"cniVersion":"1.1.0","name":"cluster_udn_%s","type":"ovn-k8s-cni-overlay","netAttachDefName":"%s/%s","topology":"layer2","role":"primary","subnets":"10.10.10.0/24","transport":"evpn","evpn":%s}`, name, namespace, name, evpnJSON)
 	return nad
 }
 
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 
 	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
@@ -56,3 +57,32 @@ func PrimaryNetAttachDefNotExist(nads []*netv1.NetworkAttachmentDefinition) erro
 	}
 	return nil
 }
+
+// NetAttachDefRemovedSubnets returns the subnets of the existing NAD that are
+// not present in the desired NAD. Subnets can be appended to a network but not
+// removed or modified. An existing NAD that can't be parsed has no subnets to
+// preserve, it is overwritten with the desired one.
+func NetAttachDefRemovedSubnets(existing, desired *netv1.NetworkAttachmentDefinition) []string {
+	desiredSubnets := netAttachDefSubnets(desired)
+	var removed []string
+	for _, subnet := range netAttachDefSubnets(existing) {
+		if !slices.Contains(desiredSubnets, subnet) {
+			removed = append(removed, subnet)
+		}
+	}
+	return removed
+}
+
+func netAttachDefSubnets(nad *netv1.NetworkAttachmentDefinition) []string {
+	var netConf *ovncnitypes.NetConf
+	if err := json.Unmarshal([]byte(nad.Spec.Config), &netConf); err != nil || netConf == nil {
+		return nil
+	}
+	var subnets []string
+	for _, subnet := range strings.Split(netConf.Subnets, ",") {
+		if subnet = strings.TrimSpace(subnet); subnet != "" {
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets
+}
@@ -125,3 +125,42 @@ var _ = Describe("PrimaryNetAttachDefNotExist", func() {
 		Expect(PrimaryNetAttachDefNotExist(nads)).ToNot(Succeed())
 	})
 })
+
+var _ = Describe("NetAttachDefRemovedSubnets", func() {
+	nadWithConfig := func(config string) *netv1.NetworkAttachmentDefinition {
+		return &netv1.NetworkAttachmentDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-net", Namespace: "blue"},
+			Spec:       netv1.NetworkAttachmentDefinitionSpec{Config: config},
+		}
+	}
+	DescribeTable("should return the removed subnets",
+		func(existingConfig, desiredConfig string, expectedRemoved []string) {
+			Expect(NetAttachDefRemovedSubnets(nadWithConfig(existingConfig), nadWithConfig(desiredConfig))).To(Equal(expectedRemoved))
+		},
+		Entry("when subnets are unchanged",
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/24,2001:db8::/64"}`,
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/24,2001:db8::/64"}`,
+			nil,
+		),
+		Entry("when subnets are appended",
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/24"}`,
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/24,10.0.1.0/24"}`,
+			nil,
+		),
+		Entry("when the existing NAD config is invalid",
+			`MUTATED`,
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/24"}`,
+			nil,
+		),
+		Entry("when subnets are removed",
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/24,10.0.1.0/24"}`,
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/24"}`,
+			[]string{"10.0.1.0/24"},
+		),
+		Entry("when the host subnet of a layer3 subnet is modified",
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/16/24"}`,
+			`{"type": "ovn-k8s-cni-overlay","subnets": "10.0.0.0/16/26"}`,
+			[]string{"10.0.0.0/16/24"},
+		),
+	)
+})
@@ -60,6 +60,9 @@ func RenderNetAttachDefManifest(obj client.Object, targetNamespace string, opts
 		ownerRef = *metav1.NewControllerRef(obj, userdefinednetworkv1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetwork"))
 		spec = &o.Spec.Network
 		networkName = util.GenerateCUDNNetworkName(obj.GetName())
+		if len(o.Spec.Uplinks) > 0 {
+			opts = append(opts, withUplink(string(o.Spec.Uplinks[0])))
+		}
 	default:
 		return nil, fmt.Errorf("unknown type %T", obj)
 	}
@@ -179,6 +182,7 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter, opts *
 			netConfSpec.DefaultGatewayIPs = ipString(cfg.DefaultGatewayIPs)
 		}
 		netConfSpec.JoinSubnet = cidrString(renderJoinSubnets(cfg.Role, cfg.JoinSubnets))
+		netConfSpec.DHCPOptions = renderDHCPOptions(cfg.DHCPOptions)
 		// now generate transit subnet for layer2 topology
 		if cfg.Role == userdefinednetworkv1.NetworkRolePrimary {
 			err := util.SetTransitSubnets(netConfSpec)
@@ -198,6 +202,12 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter, opts *
 		if cfg.VLAN != nil && cfg.VLAN.Access != nil {
 			netConfSpec.VLANID = int(cfg.VLAN.Access.ID)
 		}
+		if cfg.VLAN != nil && cfg.VLAN.Trunk != nil {
+			netConfSpec.VLANID = int(cfg.VLAN.Trunk.NativeVLAN)
+			for _, vid := range cfg.VLAN.Trunk.AllowedVLANs {
+				netConfSpec.VLANTrunks = append(netConfSpec.VLANTrunks, int(vid))
+			}
+		}
 	}
 
 	if spec.GetTransport() == userdefinednetworkv1.TransportOptionEVPN {
@@ -207,6 +217,13 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter, opts *
 		netConfSpec.EVPN = renderEVPNConfig(spec, opts)
 	}
 
+	if opts != nil && opts.Uplink != "" {
+		if !util.IsUplinkEnabled() {
+			return nil, fmt.Errorf("uplink requested but Uplink feature is not enabled")
+		}
+		netConfSpec.Uplink = opts.Uplink
+	}
+
 	if spec.GetTransport() == userdefinednetworkv1.TransportOptionNoOverlay {
 		noOverlayCfg := spec.GetNoOverlay()
 		if noOverlayCfg != nil {
@@ -266,6 +283,9 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter, opts *
 	if netConfSpec.VLANID != 0 {
 		cniNetConf["vlanID"] = netConfSpec.VLANID
 	}
+	if len(netConfSpec.VLANTrunks) > 0 {
+		cniNetConf["vlanTrunks"] = netConfSpec.VLANTrunks
+	}
 	if util.IsPreconfiguredUDNAddressesEnabled() {
 		if len(netConfSpec.ReservedSubnets) > 0 {
 			cniNetConf["reservedSubnets"] = netConfSpec.ReservedSubnets
@@ -287,6 +307,12 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter, opts *
 	if netConfSpec.EVPN != nil {
 		cniNetConf["evpn"] = netConfSpec.EVPN
 	}
+	if netConfSpec.Uplink != "" {
+		cniNetConf["uplink"] = netConfSpec.Uplink
+	}
+	if netConfSpec.DHCPOptions != nil {
+		cniNetConf["dhcpOptions"] = netConfSpec.DHCPOptions
+	}
 
 	return cniNetConf, nil
 }
@@ -408,6 +434,35 @@ func renderEVPNConfig(spec SpecGetter, opts *RenderOptions) *ovncnitypes.EVPNCon
 	return evpnConfig
 }
 
+func renderDHCPOptions(dhcpOptions *userdefinednetworkv1.DHCPOptions) *ovncnitypes.DHCPOptions {
+	if dhcpOptions == nil {
+		return nil
+	}
+	opts := &ovncnitypes.DHCPOptions{}
+	for _, domain := range dhcpOptions.DomainSearch {
+		opts.DomainSearch = append(opts.DomainSearch, string(domain))
+	}
+	for _, server := range dhcpOptions.DNSServers {
+		opts.DNSServers = append(opts.DNSServers, string(server))
+	}
+	for _, server := range dhcpOptions.NTPServers {
+		opts.NTPServers = append(opts.NTPServers, string(server))
+	}
+	for _, route := range dhcpOptions.Routes {
+		opts.Routes = append(opts.Routes, ovncnitypes.DHCPRoute{
+			Destination: string(route.Destination),
+			NextHop:     string(route.NextHop),
+		})
+	}
+	for _, option := range dhcpOptions.ExtraOptions {
+		opts.ExtraOptions = append(opts.ExtraOptions, ovncnitypes.DHCPExtraOption{
+			Name:  string(option.Name),
+			Value: option.Value,
+		})
+	}
+	return opts
+}
+
 func GetSpec(obj client.Object) SpecGetter {
 	switch o := obj.(type) {
 	case *userdefinednetworkv1.UserDefinedNetwork:
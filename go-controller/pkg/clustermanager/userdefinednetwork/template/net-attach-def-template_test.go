@@ -642,6 +642,74 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
 			}`,
 		),
+		Entry("secondary network, localnet, trunk VLAN mode",
+			udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLocalnet,
+				Localnet: &udnv1.LocalnetConfig{
+					Role:                udnv1.NetworkRoleSecondary,
+					PhysicalNetworkName: "mylocalnet1",
+					VLAN: &udnv1.VLANConfig{Mode: udnv1.VLANModeTrunk, Trunk: &udnv1.TrunkVLANConfig{
+						AllowedVLANs: []int32{100, 200},
+						NativeVLAN:   10,
+					}},
+					IPAM: &udnv1.IPAMConfig{Mode: udnv1.IPAMDisabled},
+				},
+			},
+			`{
+			  "cniVersion": "1.1.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "cluster_udn_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "secondary",
+			  "topology": "localnet",
+			  "physicalNetworkName": "mylocalnet1",
+			  "mtu": 1500,
+			  "vlanID": 10,
+			  "vlanTrunks": [100, 200]
+			}`,
+		),
+		Entry("primary network, layer2 with DHCP options",
+			udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer2,
+				Layer2: &udnv1.Layer2Config{
+					Role:    udnv1.NetworkRolePrimary,
+					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					MTU:     1500,
+					DHCPOptions: &udnv1.DHCPOptions{
+						DomainSearch: []udnv1.DomainName{"example.com"},
+						NTPServers:   []udnv1.IPv4{"10.1.1.1"},
+						Routes: []udnv1.DHCPRoute{
+							{Destination: "10.2.0.0/16", NextHop: "192.168.100.254"},
+							{Destination: "fd00:10::/64"},
+						},
+						ExtraOptions: []udnv1.DHCPExtraOption{
+							{Name: udnv1.DHCPExtraOptionBootFileName, Value: "pxelinux.0"},
+						},
+					},
+				},
+			},
+			`{
+			  "cniVersion": "1.1.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "cluster_udn_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "primary",
+			  "topology": "layer2",
+			  "joinSubnet": "100.65.0.0/16,fd99::/64",
+			  "transitSubnet": "100.88.0.0/16,fd97::/64",
+			  "subnets": "192.168.100.0/24,2001:dbb::/64",
+			  "mtu": 1500,
+			  "dhcpOptions": {
+			    "domainSearch": ["example.com"],
+			    "ntpServers": ["10.1.1.1"],
+			    "routes": [
+			      {"destination": "10.2.0.0/16", "nextHop": "192.168.100.254"},
+			      {"destination": "fd00:10::/64"}
+			    ],
+			    "extraOptions": [{"name": "BootFileName", "value": "pxelinux.0"}]
+			  }
+			}`,
+		),
 		Entry("primary network, layer2 with EVPN transport and MAC-VRF",
 			udnv1.NetworkSpec{
 				Topology: udnv1.NetworkTopologyLayer2,
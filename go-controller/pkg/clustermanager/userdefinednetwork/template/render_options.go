@@ -9,6 +9,8 @@ type RenderOption func(*RenderOptions)
 // RenderOptions contains optional configuration for NAD rendering.
 type RenderOptions struct {
 	EVPNVIDs *EVPNVIDs
+	// Uplink is the name of the Uplink selected by a ClusterUserDefinedNetwork.
+	Uplink string
 }
 
 // EVPNVIDs contains pre-allocated VLAN IDs for EVPN MAC-VRF and IP-VRF.
@@ -31,6 +33,13 @@ func WithEVPNVIDs(macVRFVID, ipVRFVID int) RenderOption {
 	}
 }
 
+// withUplink returns a RenderOption that sets the Uplink the network uses.
+func withUplink(uplink string) RenderOption {
+	return func(opts *RenderOptions) {
+		opts.Uplink = uplink
+	}
+}
+
 // applyOptions applies the given functional options and returns the resulting RenderOptions.
 // Nil options in the slice are safely skipped to prevent panics.
 func applyOptions(opts []RenderOption) *RenderOptions {
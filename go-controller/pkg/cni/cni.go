@@ -34,6 +34,10 @@ var (
 
 const dpuNotReadyMsg = "DPU Not Ready"
 
+// cniErrPluginNotAvailable is the CNI error code returned by STATUS when the
+// plugin cannot service ADD requests
+const cniErrPluginNotAvailable = 50
+
 type direction int
 
 func (d direction) String() string {
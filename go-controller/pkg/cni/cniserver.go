@@ -73,6 +73,7 @@ func NewCNIServer(
 	networkManager networkmanager.Interface,
 	ovsClient client.Client,
 	dpuHealth DPUStatusProvider,
+	nodeStatus NodeStatusProvider,
 ) (*Server, error) {
 	var nadLister nadv1Listers.NetworkAttachmentDefinitionLister
 
@@ -103,6 +104,7 @@ func NewCNIServer(
 		networkManager: networkManager,
 		ovsClient:      ovsClient,
 		dpuHealth:      dpuHealth,
+		nodeStatus:     nodeStatus,
 	}
 
 	if len(config.Kubernetes.CAData) > 0 {
@@ -274,16 +276,27 @@ func (s *Server) handleCNIRequest(r *http.Request) (result []byte, err error) {
 		return nil, err
 	}
 
-	if command == CNICheck || command == CNIStatus || command == CNIUpdate {
+	if command == CNICheck || command == CNIUpdate {
 		// CNICheck is not considered useful, and has a considerable performance impact
 		// to pod bring up times with CRIO. This is due to the fact that CRIO currently calls check
 		// after CNI ADD before it finishes bringing the container up
 		// CNIUpdate is no-op today
-		// CNIStatus is handled by DPU health check gating before reaching here
 		klog.Infof("%s finished CNI request, err=nil", command)
 		return nil, nil
 	}
 
+	if command == CNIStatus {
+		err = s.checkStatus()
+		klog.V(5).Infof("%s finished CNI request, err %v", command, err)
+		return nil, err
+	}
+
+	if command == CNIGC {
+		err = s.cmdGC(&cr)
+		klog.Infof("%s finished CNI request, err %v", command, err)
+		return nil, err
+	}
+
 	request, err := cniRequestToPodRequest(&cr, ctx)
 	if err != nil {
 		klog.Infof("Failed to convert CNI request %+v to PodRequest, err %v", cr, err)
@@ -502,7 +515,38 @@ func (s *Server) checkDPUHealth(cmd command) error {
 		msg = fmt.Sprintf("%s: %s", msg, reason)
 	}
 	if cmd == CNIStatus {
-		return &cnitypes.Error{Code: 50, Msg: msg}
+		return &cnitypes.Error{Code: cniErrPluginNotAvailable, Msg: msg}
 	}
 	return fmt.Errorf("%s", msg)
 }
+
+// checkStatus reports whether the node is ready to service CNI ADD requests:
+// the OVS database must be reachable and the node's management port and
+// gateway must be ready. DPU hosts have no local OVS, their readiness is
+// covered by the DPU health check instead.
+func (s *Server) checkStatus() error {
+	if !config.IsModeDPUHost() && (s.ovsClient == nil || !s.ovsClient.Connected()) {
+		return &cnitypes.Error{Code: cniErrPluginNotAvailable, Msg: "not connected to the OVS database"}
+	}
+	if s.nodeStatus == nil {
+		return nil
+	}
+	if ready, reason := s.nodeStatus.Ready(); !ready {
+		return &cnitypes.Error{Code: cniErrPluginNotAvailable, Msg: reason}
+	}
+	return nil
+}
+
+// cmdGC handles the CNI GC command by removing the pod interfaces of the
+// sandboxes that are not in the runtime's list of valid attachments.
+func (s *Server) cmdGC(cr *Request) error {
+	conf, err := config.ReadCNIConfig(cr.Config)
+	if err != nil {
+		return fmt.Errorf("broken stdin args")
+	}
+	if config.IsModeDPUHost() {
+		// pod interfaces are plugged into OVS on the DPU and cleaned up from there
+		return nil
+	}
+	return gcPodInterfaces(s.ovsClient, conf)
+}
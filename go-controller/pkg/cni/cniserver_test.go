@@ -120,7 +120,7 @@ func TestCNIServer(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to call newOVSClientWithExternalIDs: %v", err)
 	}
-	s, err := NewCNIServer(wf, fakeClient, networkmanager.Default().Interface(), ovsClient, nil)
+	s, err := NewCNIServer(wf, fakeClient, networkmanager.Default().Interface(), ovsClient, nil, nil)
 	if err != nil {
 		t.Fatalf("error creating CNI server: %v", err)
 	}
@@ -348,7 +348,7 @@ func TestCNIServerStatusNotReady(t *testing.T) {
 		t.Fatalf("failed to call newOVSClientWithExternalIDs: %v", err)
 	}
 	dpuHealth := &fakeDPUHealth{ready: false, reason: "lease expired"}
-	s, err := NewCNIServer(wf, fakeClient, networkmanager.Default().Interface(), ovsClient, dpuHealth)
+	s, err := NewCNIServer(wf, fakeClient, networkmanager.Default().Interface(), ovsClient, dpuHealth, nil)
 	if err != nil {
 		t.Fatalf("error creating CNI server: %v", err)
 	}
@@ -415,6 +415,116 @@ func TestCNIServerStatusNotReady(t *testing.T) {
 	}
 }
 
+func TestCNIServerStatus(t *testing.T) {
+	err := config.PrepareTestConfig()
+	if err != nil {
+		t.Fatalf("failed to prepare test config: %v", err)
+	}
+	fakeClient := fake.NewSimpleClientset()
+	wf, err := factory.NewNodeWatchFactory(&util.OVNNodeClientset{KubeClient: fakeClient}, nodeName)
+	if err != nil {
+		t.Fatalf("failed to create watch factory: %v", err)
+	}
+	if err := wf.Start(); err != nil {
+		t.Fatalf("failed to start watch factory: %v", err)
+	}
+	ovsClient, err := newOVSClientWithExternalIDs(map[string]string{})
+	if err != nil {
+		t.Fatalf("failed to call newOVSClientWithExternalIDs: %v", err)
+	}
+
+	testcases := []struct {
+		name       string
+		mode       string
+		noOVS      bool
+		nodeStatus *fakeDPUHealth
+		expectMsg  string
+	}{
+		{
+			name:       "Ready",
+			mode:       ovntypes.NodeModeFull,
+			nodeStatus: &fakeDPUHealth{ready: true},
+		},
+		{
+			name:      "OVSNotConnected",
+			mode:      ovntypes.NodeModeFull,
+			noOVS:     true,
+			expectMsg: "not connected to the OVS database",
+		},
+		{
+			name:       "NodeNotReady",
+			mode:       ovntypes.NodeModeFull,
+			nodeStatus: &fakeDPUHealth{ready: false, reason: "management port ovn-k8s-mp0 is down"},
+			expectMsg:  "management port ovn-k8s-mp0 is down",
+		},
+		{
+			name:       "DPUHostIgnoresOVS",
+			mode:       ovntypes.NodeModeDPUHost,
+			noOVS:      true,
+			nodeStatus: &fakeDPUHealth{ready: false, reason: "gateway is not ready"},
+			expectMsg:  "gateway is not ready",
+		},
+	}
+
+	for i, tc := range testcases {
+		tmpDir, err := utiltesting.MkTmpdir(fmt.Sprintf("cniserver-status-%d", i))
+		if err != nil {
+			t.Fatalf("failed to create temp directory: %v", err)
+		}
+		defer os.RemoveAll(tmpDir)
+
+		config.OvnKubeNode.Mode = tc.mode
+		serverOVSClient := ovsClient
+		if tc.noOVS {
+			serverOVSClient = nil
+		}
+		var nodeStatus NodeStatusProvider
+		if tc.nodeStatus != nil {
+			nodeStatus = tc.nodeStatus
+		}
+		s, err := NewCNIServer(wf, fakeClient, networkmanager.Default().Interface(), serverOVSClient, nil, nodeStatus)
+		if err != nil {
+			t.Fatalf("[%s] error creating CNI server: %v", tc.name, err)
+		}
+		if err := s.Start(tmpDir); err != nil {
+			t.Fatalf("[%s] error starting CNI server: %v", tc.name, err)
+		}
+		client := &http.Client{
+			Transport: &http.Transport{
+				Dial: func(_, _ string) (net.Conn, error) {
+					return net.Dial("unix", filepath.Join(tmpDir, serverSocketName))
+				},
+			},
+		}
+
+		body, code := clientDoCNI(t, client, &Request{
+			Env: map[string]string{
+				"CNI_COMMAND": string(CNIStatus),
+			},
+			Config: []byte(cniConfig),
+		})
+		if tc.expectMsg == "" {
+			if code != http.StatusOK {
+				t.Fatalf("[%s] expected status %v but got %v: %s", tc.name, http.StatusOK, code, string(body))
+			}
+			continue
+		}
+		if code != http.StatusBadRequest {
+			t.Fatalf("[%s] expected status %v but got %v", tc.name, http.StatusBadRequest, code)
+		}
+		var cniErr cnitypes.Error
+		if err := json.Unmarshal(body, &cniErr); err != nil {
+			t.Fatalf("[%s] failed to unmarshal error response: %v", tc.name, err)
+		}
+		if cniErr.Code != cniErrPluginNotAvailable {
+			t.Fatalf("[%s] expected CNI error code %d but got %d", tc.name, cniErrPluginNotAvailable, cniErr.Code)
+		}
+		if cniErr.Msg != tc.expectMsg {
+			t.Fatalf("[%s] expected error %q, got %q", tc.name, tc.expectMsg, cniErr.Msg)
+		}
+	}
+}
+
 type fakeDPUHealth struct {
 	ready  bool
 	reason string
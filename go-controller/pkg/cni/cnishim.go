@@ -390,8 +390,11 @@ func (p *Plugin) CmdGC(args *skel.CmdArgs) error {
 	}
 	setupLogging(conf)
 
-	// OVN-Kubernetes does not maintain independent local plugin state that needs GC.
-	return nil
+	// ovnkube-node removes the pod interfaces of the sandboxes that are not part
+	// of the valid attachments passed by the runtime in the stdin config
+	req := newCNIRequest(args, nadapi.DeviceInfo{})
+	_, err = p.doCNIFunc("http://dummy/", req)
+	return err
 }
 
 // CmdCheck is the callback for 'checking' container's networking is as expected.
@@ -302,12 +302,23 @@ func TestCmdDel_UnprivilegedMode(t *testing.T) {
 
 func TestCmdGC(t *testing.T) {
 	p := &Plugin{}
-	args := &skel.CmdArgs{
-		StdinData: []byte(`{"cniVersion":"1.1.0","name":"mynet","type":"ovn-k8s-cni-overlay"}`),
+	stdinData := []byte(`{"cniVersion":"1.1.0","name":"mynet","type":"ovn-k8s-cni-overlay",` +
+		`"cni.dev/valid-attachments":[{"containerID":"cid","ifname":"eth0"}]}`)
+
+	// the valid attachments must be forwarded to the server with the stdin config
+	var forwarded *Request
+	p.doCNIFunc = func(_ string, req interface{}) ([]byte, error) {
+		forwarded = req.(*Request)
+		return nil, nil
 	}
 
+	args := &skel.CmdArgs{
+		StdinData: stdinData,
+	}
 	err := p.CmdGC(args)
 	require.NoError(t, err)
+	require.NotNil(t, forwarded)
+	require.Equal(t, stdinData, forwarded.Config)
 }
 
 func withCNIEnv(t *testing.T, fn func()) {
@@ -8,10 +8,12 @@ package cni
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -22,11 +24,17 @@ import (
 	"github.com/safchain/ethtool"
 	"github.com/vishvananda/netlink"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/knftables"
 
+	"github.com/ovn-kubernetes/libovsdb/client"
+
+	ovncnitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops/ovs"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/vswitchd"
 )
 
 func addRoute(ipn *net.IPNet, gw net.IP, dev netlink.Link, mtu, table int) error {
@@ -956,6 +964,81 @@ func (pr *PodRequest) deletePorts(ifaces []string, podNamespace, podName string)
 	}
 }
 
+// gcPodInterfaces removes the OVS interfaces, and their host-side links, of
+// the pod sandboxes that are not part of the valid attachments the runtime
+// provided for the network of the given CNI GC request.
+func gcPodInterfaces(ovsClient client.Client, conf *ovncnitypes.NetConf) error {
+	validSandboxes := sets.New[string]()
+	for _, attachment := range conf.ValidAttachments {
+		validSandboxes.Insert(attachment.ContainerID)
+	}
+	ifaces, err := ovs.FindInterfacesWithPredicate(ovsClient, func(iface *vswitchd.Interface) bool {
+		sandboxID := iface.ExternalIDs["sandbox"]
+		if sandboxID == "" || iface.ExternalIDs["iface-id"] == "" || validSandboxes.Has(sandboxID) {
+			return false
+		}
+		if conf.Topology == "" {
+			// every pod sandbox is attached to the default network, so a sandbox
+			// that is not valid for it is gone for all of its networks
+			return true
+		}
+		nadKey := iface.ExternalIDs[types.NADExternalID]
+		return iface.ExternalIDs[types.NetworkExternalID] == conf.Name &&
+			(nadKey == conf.NADName || strings.HasPrefix(nadKey, conf.NADName+"/"))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find pod interfaces in OVS: %w", err)
+	}
+	slices.SortFunc(ifaces, func(a, b *vswitchd.Interface) int { return strings.Compare(a.Name, b.Name) })
+
+	var errs []error
+	sandboxIDs := sets.New[string]()
+	for _, iface := range ifaces {
+		sandboxID := iface.ExternalIDs["sandbox"]
+		klog.Infof("Removing OVS interface %s with iface-id %s of stale sandbox %s",
+			iface.Name, iface.ExternalIDs["iface-id"], sandboxID)
+		if err := deleteStalePodInterface(iface); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		sandboxIDs.Insert(sandboxID)
+	}
+	// the QoS rows are only unreferenced once all the ports of the sandbox are gone
+	for _, sandboxID := range sets.List(sandboxIDs) {
+		if err := clearPodBandwidthForPorts(nil, sandboxID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to clear bandwidth of stale sandbox %s: %w", sandboxID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deleteStalePodInterface removes a pod interface from br-int. Veths are
+// deleted, while VF and SF representors are only brought down since they are
+// handed back to the device plugin.
+func deleteStalePodInterface(iface *vswitchd.Interface) error {
+	if out, err := ovsExec("--if-exists", "del-port", "br-int", iface.Name); err != nil {
+		return fmt.Errorf("failed to delete stale OVS port %s: %v\n  %q", iface.Name, err, out)
+	}
+
+	link, err := util.GetNetLinkOps().LinkByName(iface.Name)
+	if err != nil {
+		if util.GetNetLinkOps().IsLinkNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to find host-side link %s: %v", iface.Name, err)
+	}
+	if iface.ExternalIDs["vf-netdev-name"] != "" || iface.ExternalIDs["vf-is-vfio"] == "true" {
+		if err = util.GetNetLinkOps().LinkSetDown(link); err != nil {
+			return fmt.Errorf("failed to bring down representor %s: %v", iface.Name, err)
+		}
+		return nil
+	}
+	if err = util.GetNetLinkOps().LinkDelete(link); err != nil {
+		return fmt.Errorf("failed to delete host-side link %s: %v", iface.Name, err)
+	}
+	return nil
+}
+
 // setupIngressFilter sets up an ingress filter using nftables to block
 // unwanted ICMPv6 Router Advertisement (RA) packets on a specific device.
 // It creates a new nftables table, chain, and rule to drop RA packets
@@ -28,6 +28,7 @@ import (
 
 	ovncnitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
 	ovntest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
+	libovsdbtest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
 	cni_type_mocks "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/mocks/github.com/containernetworking/cni/pkg/types"
 	cni_ns_mocks "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/mocks/github.com/containernetworking/plugins/pkg/ns"
 	netlink_mocks "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/mocks/github.com/vishvananda/netlink"
@@ -36,6 +37,7 @@ import (
 	ovntypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
 	util_mocks "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/mocks"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/vswitchd"
 )
 
 func TestRenameLink(t *testing.T) {
@@ -1749,3 +1751,112 @@ func genIfaceID(podNamespace, podName string) string {
 func genOfctlDumpFlowsCmd(queryStr string) string {
 	return fmt.Sprintf("ovs-ofctl --timeout=10 --no-stats --strict dump-flows br-int %s", queryStr)
 }
+
+func TestGCPodInterfaces(t *testing.T) {
+	mockLink := new(netlink_mocks.Link)
+	mockNetLinkOps := new(util_mocks.NetLinkOps)
+	util.SetNetLinkOpMockInst(mockNetLinkOps)
+	defer util.ResetNetLinkOpMockInst()
+
+	newIface := func(name string, externalIDs map[string]string) *vswitchd.Interface {
+		return &vswitchd.Interface{UUID: name + "-uuid", Name: name, ExternalIDs: externalIDs}
+	}
+	interfaces := []*vswitchd.Interface{
+		newIface("ovn-k8s-mp0", map[string]string{"iface-id": "k8s-mynode"}),
+		newIface("live", map[string]string{"iface-id": "ns_live", "sandbox": "alive"}),
+		newIface("stale-veth", map[string]string{"iface-id": "ns_dead", "sandbox": "dead"}),
+		newIface("stale-vf", map[string]string{"iface-id": "ns_dead-vf", "sandbox": "dead-vf",
+			"vf-netdev-name": "ens1f0v1"}),
+		newIface("stale-nad1", map[string]string{"iface-id": "ns1-nad1_ns_dead", "sandbox": "dead",
+			ovntypes.NetworkExternalID: "tenant", ovntypes.NADExternalID: "ns1/nad1"}),
+		newIface("stale-nad2", map[string]string{"iface-id": "ns1-nad2_ns_dead", "sandbox": "dead",
+			ovntypes.NetworkExternalID: "tenant", ovntypes.NADExternalID: "ns1/nad2"}),
+	}
+
+	tests := []struct {
+		desc                 string
+		conf                 *ovncnitypes.NetConf
+		expectedDeletedPorts []string
+		expectedQoSSandboxes []string
+		netLinkOpsMockHelper []ovntest.TestifyMockHelper
+	}{
+		{
+			desc: "default network removes all the interfaces of stale sandboxes",
+			conf: &ovncnitypes.NetConf{
+				NetConf: cnitypes.NetConf{
+					Name:             "default",
+					ValidAttachments: []cnitypes.GCAttachment{{ContainerID: "alive", IfName: "eth0"}},
+				},
+			},
+			expectedDeletedPorts: []string{"stale-nad1", "stale-nad2", "stale-veth", "stale-vf"},
+			expectedQoSSandboxes: []string{"dead", "dead-vf"},
+			netLinkOpsMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "LinkByName", OnCallMethodArgType: []string{"string"}, RetArgList: []interface{}{nil, fmt.Errorf("link not found")}},
+				{OnCallMethodName: "IsLinkNotFoundError", OnCallMethodArgType: []string{"*errors.errorString"}, RetArgList: []interface{}{true}},
+				{OnCallMethodName: "LinkByName", OnCallMethodArgType: []string{"string"}, RetArgList: []interface{}{nil, fmt.Errorf("link not found")}},
+				{OnCallMethodName: "IsLinkNotFoundError", OnCallMethodArgType: []string{"*errors.errorString"}, RetArgList: []interface{}{true}},
+				{OnCallMethodName: "LinkByName", OnCallMethodArgType: []string{"string"}, RetArgList: []interface{}{mockLink, nil}},
+				{OnCallMethodName: "LinkDelete", OnCallMethodArgType: []string{"*mocks.Link"}, RetArgList: []interface{}{nil}},
+				{OnCallMethodName: "LinkByName", OnCallMethodArgType: []string{"string"}, RetArgList: []interface{}{mockLink, nil}},
+				{OnCallMethodName: "LinkSetDown", OnCallMethodArgType: []string{"*mocks.Link"}, RetArgList: []interface{}{nil}},
+			},
+		},
+		{
+			desc: "secondary network only removes the stale interfaces of its NAD",
+			conf: &ovncnitypes.NetConf{
+				NetConf: cnitypes.NetConf{
+					Name:             "tenant",
+					ValidAttachments: []cnitypes.GCAttachment{{ContainerID: "alive", IfName: "net1"}},
+				},
+				Topology: ovntypes.Layer2Topology,
+				NADName:  "ns1/nad1",
+			},
+			expectedDeletedPorts: []string{"stale-nad1"},
+			expectedQoSSandboxes: []string{"dead"},
+			netLinkOpsMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "LinkByName", OnCallMethodArgType: []string{"string"}, RetArgList: []interface{}{mockLink, nil}},
+				{OnCallMethodName: "LinkDelete", OnCallMethodArgType: []string{"*mocks.Link"}, RetArgList: []interface{}{nil}},
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%s", i, tc.desc), func(t *testing.T) {
+			mockNetLinkOps.Mock = mock.Mock{}
+			mockLink.Mock = mock.Mock{}
+			ovntest.ProcessMockFnList(&mockNetLinkOps.Mock, tc.netLinkOpsMockHelper)
+
+			// only the Open_vSwitch table is a root table, the other rows must be referenced
+			bridge := &vswitchd.Bridge{UUID: "br-int-uuid", Name: "br-int"}
+			ovsData := []libovsdbtest.TestData{&vswitchd.OpenvSwitch{Bridges: []string{bridge.UUID}}, bridge}
+			for _, iface := range interfaces {
+				port := &vswitchd.Port{UUID: iface.Name + "-port-uuid", Name: iface.Name, Interfaces: []string{iface.UUID}}
+				bridge.Ports = append(bridge.Ports, port.UUID)
+				ovsData = append(ovsData, port, iface)
+			}
+			ovsClient, cleanup, err := libovsdbtest.NewOVSTestHarness(libovsdbtest.TestSetup{OVSData: ovsData})
+			require.NoError(t, err)
+			defer cleanup.Cleanup()
+
+			execMock := ovntest.NewFakeExec()
+			require.NoError(t, SetExec(execMock))
+			defer ResetRunner()
+			for _, port := range tc.expectedDeletedPorts {
+				execMock.AddFakeCmd(&ovntest.ExpectedCmd{
+					Cmd: "ovs-vsctl --timeout=30 --if-exists del-port br-int " + port,
+				})
+			}
+			for _, sandboxID := range tc.expectedQoSSandboxes {
+				execMock.AddFakeCmd(&ovntest.ExpectedCmd{
+					Cmd: genOVSFindCmd("30", "qos", "_uuid", "external-ids:sandbox="+sandboxID),
+				})
+			}
+
+			err = gcPodInterfaces(ovsClient, tc.conf)
+			require.NoError(t, err)
+			assert.True(t, execMock.CalledMatchesExpected(), execMock.ErrorDesc())
+			mockNetLinkOps.AssertExpectations(t)
+			mockLink.AssertExpectations(t)
+		})
+	}
+}
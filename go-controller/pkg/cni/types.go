@@ -210,6 +210,12 @@ type DPUStatusProvider interface {
 	Ready() (bool, string)
 }
 
+// NodeStatusProvider reports whether the node's management port and gateway
+// are ready to service CNI requests, along with the reason when they are not.
+type NodeStatusProvider interface {
+	Ready() (bool, string)
+}
+
 // Server object that listens for JSON-marshaled Request objects
 // on a private root-only Unix domain socket.
 type Server struct {
@@ -219,4 +225,5 @@ type Server struct {
 	networkManager networkmanager.Interface
 	ovsClient      client.Client
 	dpuHealth      DPUStatusProvider
+	nodeStatus     NodeStatusProvider
 }
@@ -70,6 +70,11 @@ type NetConf struct {
 	DefaultGatewayIPs string `json:"defaultGatewayIPs,omitempty"`
 	// VLANID, valid in localnet topology network only
 	VLANID int `json:"vlanID,omitempty"`
+	// VLANTrunks is the list of VLAN IDs whose tagged traffic is carried by
+	// the network, valid in localnet topology network only. When set, the
+	// network is in trunk mode and VLANID is the native VLAN untagged traffic
+	// is assigned to.
+	VLANTrunks []int `json:"vlanTrunks,omitempty"`
 	// AllowPersistentIPs is valid on both localnet / layer topologies.
 	// It allows for having IP allocations that outlive the pod for which
 	// they are originally created - e.g. a KubeVirt VM's migration, or
@@ -99,6 +104,16 @@ type NetConf struct {
 	// Only valid when Transport is "evpn".
 	EVPN *EVPNConfig `json:"evpn,omitempty"`
 
+	// Uplink is the name of the Uplink the network uses for its north/south
+	// traffic instead of the default external bridge. Only valid for
+	// layer2 and layer3 primary networks.
+	Uplink string `json:"uplink,omitempty"`
+
+	// DHCPOptions contains additional DHCP and IPv6 router advertisement
+	// options served to KubeVirt virtual machines. Only valid for layer2
+	// primary networks.
+	DHCPOptions *DHCPOptions `json:"dhcpOptions,omitempty"`
+
 	// PciAddrs in case of using sriov or Auxiliry device name in case of SF
 	DeviceID string `json:"deviceID,omitempty"`
 	// LogFile to log all the messages from cni shim binary to
@@ -131,29 +146,32 @@ func (n NetConf) MarshalJSON() ([]byte, error) {
 	type cniConf cnitypes.PluginConf
 	type netConf struct {
 		cniConf
-		Role                  string      `json:"role,omitempty"`
-		Topology              string      `json:"topology,omitempty"`
-		NADName               string      `json:"netAttachDefName,omitempty"`
-		MTU                   int         `json:"mtu,omitempty"`
-		Subnets               string      `json:"subnets,omitempty"`
-		ExcludeSubnets        string      `json:"excludeSubnets,omitempty"`
-		ReservedSubnets       string      `json:"reservedSubnets,omitempty"`
-		InfrastructureSubnets string      `json:"infrastructureSubnets,omitempty"`
-		JoinSubnet            string      `json:"joinSubnet,omitempty"`
-		TransitSubnet         string      `json:"transitSubnet,omitempty"`
-		DefaultGatewayIPs     string      `json:"defaultGatewayIPs,omitempty"`
-		VLANID                int         `json:"vlanID,omitempty"`
-		AllowPersistentIPs    bool        `json:"allowPersistentIPs,omitempty"`
-		PhysicalNetworkName   string      `json:"physicalNetworkName,omitempty"`
-		Transport             string      `json:"transport,omitempty"`
-		OutboundSNAT          string      `json:"outboundSNAT,omitempty"`
-		EVPN                  *EVPNConfig `json:"evpn,omitempty"`
-		DeviceID              string      `json:"deviceID,omitempty"`
-		LogFile               string      `json:"logFile,omitempty"`
-		LogLevel              string      `json:"logLevel,omitempty"`
-		LogFileMaxSize        int         `json:"logfile-maxsize"`
-		LogFileMaxBackups     int         `json:"logfile-maxbackups"`
-		LogFileMaxAge         int         `json:"logfile-maxage"`
+		Role                  string       `json:"role,omitempty"`
+		Topology              string       `json:"topology,omitempty"`
+		NADName               string       `json:"netAttachDefName,omitempty"`
+		MTU                   int          `json:"mtu,omitempty"`
+		Subnets               string       `json:"subnets,omitempty"`
+		ExcludeSubnets        string       `json:"excludeSubnets,omitempty"`
+		ReservedSubnets       string       `json:"reservedSubnets,omitempty"`
+		InfrastructureSubnets string       `json:"infrastructureSubnets,omitempty"`
+		JoinSubnet            string       `json:"joinSubnet,omitempty"`
+		TransitSubnet         string       `json:"transitSubnet,omitempty"`
+		DefaultGatewayIPs     string       `json:"defaultGatewayIPs,omitempty"`
+		VLANID                int          `json:"vlanID,omitempty"`
+		VLANTrunks            []int        `json:"vlanTrunks,omitempty"`
+		AllowPersistentIPs    bool         `json:"allowPersistentIPs,omitempty"`
+		PhysicalNetworkName   string       `json:"physicalNetworkName,omitempty"`
+		Transport             string       `json:"transport,omitempty"`
+		OutboundSNAT          string       `json:"outboundSNAT,omitempty"`
+		EVPN                  *EVPNConfig  `json:"evpn,omitempty"`
+		Uplink                string       `json:"uplink,omitempty"`
+		DHCPOptions           *DHCPOptions `json:"dhcpOptions,omitempty"`
+		DeviceID              string       `json:"deviceID,omitempty"`
+		LogFile               string       `json:"logFile,omitempty"`
+		LogLevel              string       `json:"logLevel,omitempty"`
+		LogFileMaxSize        int          `json:"logfile-maxsize"`
+		LogFileMaxBackups     int          `json:"logfile-maxbackups"`
+		LogFileMaxAge         int          `json:"logfile-maxage"`
 		RuntimeConfig         struct {
 			CNIDeviceInfoFile string `json:"CNIDeviceInfoFile,omitempty"`
 		} `json:"runtimeConfig,omitempty"`
@@ -172,11 +190,14 @@ func (n NetConf) MarshalJSON() ([]byte, error) {
 		TransitSubnet:         n.TransitSubnet,
 		DefaultGatewayIPs:     n.DefaultGatewayIPs,
 		VLANID:                n.VLANID,
+		VLANTrunks:            n.VLANTrunks,
 		AllowPersistentIPs:    n.AllowPersistentIPs,
 		PhysicalNetworkName:   n.PhysicalNetworkName,
 		Transport:             n.Transport,
 		OutboundSNAT:          n.OutboundSNAT,
 		EVPN:                  n.EVPN,
+		Uplink:                n.Uplink,
+		DHCPOptions:           n.DHCPOptions,
 		DeviceID:              n.DeviceID,
 		LogFile:               n.LogFile,
 		LogLevel:              n.LogLevel,
@@ -208,6 +229,38 @@ type VRFConfig struct {
 	VID int `json:"vid,omitempty"`
 }
 
+// DHCPOptions contains additional DHCP and IPv6 router advertisement options
+// served to KubeVirt virtual machines.
+type DHCPOptions struct {
+	// DomainSearch is the list of DNS search domains.
+	DomainSearch []string `json:"domainSearch,omitempty"`
+	// DNSServers overrides the cluster DNS service IPs as DNS servers.
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// NTPServers is the list of IPv4 NTP servers.
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// Routes are the static routes served to the virtual machines.
+	Routes []DHCPRoute `json:"routes,omitempty"`
+	// ExtraOptions are additional named DHCP options.
+	ExtraOptions []DHCPExtraOption `json:"extraOptions,omitempty"`
+}
+
+// DHCPRoute is a static route served to KubeVirt virtual machines.
+type DHCPRoute struct {
+	// Destination is the destination CIDR of the route.
+	Destination string `json:"destination"`
+	// NextHop is the IPv4 next hop of the route. IPv6 routes are advertised
+	// through the network gateway and have no next hop.
+	NextHop string `json:"nextHop,omitempty"`
+}
+
+// DHCPExtraOption is an additional named DHCP option.
+type DHCPExtraOption struct {
+	// Name is the name of the option, e.g. "BootFileName".
+	Name string `json:"name"`
+	// Value is the value of the option.
+	Value string `json:"value"`
+}
+
 // NetworkSelectionElement represents one element of the JSON format
 // Network Attachment Selection Annotation as described in section 4.1.2
 // of the CRD specification.
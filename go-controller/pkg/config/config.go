@@ -158,7 +158,9 @@ var (
 	}
 
 	// Metrics holds Prometheus metrics-related parameters.
-	Metrics MetricsConfig
+	Metrics = MetricsConfig{
+		IPPoolUtilizationThreshold: 90,
+	}
 
 	// TLS holds TLS-related configuration parameters.
 	TLS TLSConfig
@@ -274,6 +276,9 @@ const (
 
 	// ManagedBGPTopologyFullMesh represents a full-mesh BGP topology
 	ManagedBGPTopologyFullMesh string = "full-mesh"
+	// ManagedBGPTopologyRouteReflector represents a BGP topology where nodes
+	// only peer with a set of route reflector nodes
+	ManagedBGPTopologyRouteReflector string = "route-reflector"
 )
 
 // DefaultConfig holds parsed config file parameters and command-line overrides
@@ -475,6 +480,10 @@ type MetricsConfig struct {
 	// configuration duration and optionally, its application to all nodes
 	EnableConfigDuration bool `gcfg:"enable-config-duration"`
 	EnableScaleMetrics   bool `gcfg:"enable-scale-metrics"`
+	// IPPoolUtilizationThreshold is the percentage of used IPs of a network
+	// IP pool above which the pool is reported as close to exhaustion.
+	// Set to 0 to disable the reporting.
+	IPPoolUtilizationThreshold int `gcfg:"ip-pool-utilization-threshold"`
 }
 
 // TLSConfig holds TLS-related configuration parameters.
@@ -505,6 +514,8 @@ func (c *TLSConfig) ParseCipherSuites() []string {
 type OVNKubernetesFeatureConfig struct {
 	// Admin Network Policy feature is enabled
 	EnableAdminNetworkPolicy bool `gcfg:"enable-admin-network-policy"`
+	// Cluster Network Policy feature is enabled, requires Admin Network Policy feature
+	EnableClusterNetworkPolicy bool `gcfg:"enable-cluster-network-policy"`
 	// EgressIP feature is enabled
 	EnableEgressIP bool `gcfg:"enable-egress-ip"`
 	// EgressIP node reachability total timeout in seconds
@@ -527,6 +538,8 @@ type OVNKubernetesFeatureConfig struct {
 	EnableServiceTemplateSupport    bool `gcfg:"enable-svc-template-support"`
 	EnableObservability             bool `gcfg:"enable-observability"`
 	EnableNetworkQoS                bool `gcfg:"enable-network-qos"`
+	EnableMulticastGroup            bool `gcfg:"enable-multicast-group"`
+	EnableUplink                    bool `gcfg:"enable-uplink"`
 	AllowICMPNetworkPolicy          bool `gcfg:"allow-icmp-network-policy"`
 	// This feature requires a kernel fix https://github.com/torvalds/linux/commit/7f3287db654395f9c5ddd246325ff7889f550286
 	// to work on a kind cluster. Flag allows to disable it for current CI, will be turned on when github runners have this fix.
@@ -711,9 +724,13 @@ type ManagedBGPConfig struct {
 	// Optional. Defaults to 64512 if not specified.
 	ASNumber uint32 `gcfg:"as-number"`
 	// Topology configures the BGP peering topology when routing is managed.
-	// Supported values: "full-mesh".
+	// Supported values: "full-mesh" or "route-reflector".
 	// Required when transport=no-overlay and routing=managed.
 	Topology string `gcfg:"topology"`
+	// RouteReflectorNodeSelector is the label selector of the nodes acting as
+	// route reflectors, e.g. "node-role.kubernetes.io/route-reflector=".
+	// Required when topology=route-reflector.
+	RouteReflectorNodeSelector string `gcfg:"route-reflector-node-selector"`
 	// FRRNamespace specifies the namespace where FRR-K8s FRRConfiguration resources are created
 	// when routing is managed.
 	// In unmanaged mode, the namespace is determined automatically by detecting user-created
@@ -1211,6 +1228,12 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableAdminNetworkPolicy,
 		Value:       OVNKubernetesFeature.EnableAdminNetworkPolicy,
 	},
+	&cli.BoolFlag{
+		Name:        "enable-cluster-network-policy",
+		Usage:       "Use Cluster Network Policy CRD feature with ovn-kubernetes. Requires enable-admin-network-policy.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableClusterNetworkPolicy,
+		Value:       OVNKubernetesFeature.EnableClusterNetworkPolicy,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-egress-ip",
 		Usage:       "Use EgressIP CRD feature with ovn-kubernetes.",
@@ -1282,6 +1305,12 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableEVPN,
 		Value:       OVNKubernetesFeature.EnableEVPN,
 	},
+	&cli.BoolFlag{
+		Name:        "enable-uplink",
+		Usage:       "Use Uplink feature with ovn-kubernetes to let primary cluster user defined networks use a dedicated external bridge in shared gateway mode. Requires network segmentation.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableUplink,
+		Value:       OVNKubernetesFeature.EnableUplink,
+	},
 	&cli.StringFlag{
 		Name:        "advertised-udn-isolation-mode",
 		Usage:       "Use pod isolation for BGP advertised UDN networks. Valid values are 'strict' or 'loose'.",
@@ -1342,6 +1371,12 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableNetworkQoS,
 		Value:       OVNKubernetesFeature.EnableNetworkQoS,
 	},
+	&cli.BoolFlag{
+		Name:        "enable-multicast-group",
+		Usage:       "Use MulticastGroup CRD feature with ovn-kubernetes. Requires multicast to be enabled.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableMulticastGroup,
+		Value:       OVNKubernetesFeature.EnableMulticastGroup,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-dynamic-udn-allocation",
 		Usage:       "Configure to use the dynamic UDN allocation feature with ovn-kubernetes.",
@@ -1505,7 +1540,7 @@ var MetricsFlags = []cli.Flag{
 	},
 	&cli.BoolFlag{
 		Name:        "metrics-enable-pprof",
-		Usage:       "If true, then also accept pprof requests on the metrics port.",
+		Usage:       "If true, then also accept pprof and debug requests on the metrics port.",
 		Destination: &cliConfig.Metrics.EnablePprof,
 		Value:       Metrics.EnablePprof,
 	},
@@ -1529,6 +1564,13 @@ var MetricsFlags = []cli.Flag{
 		Usage:       "Enables metrics related to scaling",
 		Destination: &cliConfig.Metrics.EnableScaleMetrics,
 	},
+	&cli.IntFlag{
+		Name: "metrics-ip-pool-utilization-threshold",
+		Usage: "The percentage (1-100) of used pod IPs or host subnets of a network above which a warning event " +
+			"and a status condition are reported on the network. Set to 0 to disable.",
+		Destination: &cliConfig.Metrics.IPPoolUtilizationThreshold,
+		Value:       Metrics.IPPoolUtilizationThreshold,
+	},
 }
 
 // TLSFlags capture TLS-related options
@@ -2143,6 +2185,11 @@ func buildMetricsConfig(cli, file *config) error {
 		return err
 	}
 
+	if Metrics.IPPoolUtilizationThreshold < 0 || Metrics.IPPoolUtilizationThreshold > 100 {
+		return fmt.Errorf("invalid IP pool utilization threshold %d: must be between 0 and 100",
+			Metrics.IPPoolUtilizationThreshold)
+	}
+
 	return nil
 }
 
@@ -2288,9 +2335,15 @@ func buildOVNKubernetesFeatureConfig(cli, file *config) error {
 	if OVNKubernetesFeature.EnableEVPN && !OVNKubernetesFeature.EnableRouteAdvertisements {
 		return fmt.Errorf("invalid feature configuration: EVPN requires route advertisements but route advertisements are disabled")
 	}
+	if OVNKubernetesFeature.EnableClusterNetworkPolicy && !OVNKubernetesFeature.EnableAdminNetworkPolicy {
+		return fmt.Errorf("the Cluster Network Policy feature cannot be enabled without also enabling Admin Network Policy")
+	}
 	if OVNKubernetesFeature.EnableDynamicUDNAllocation && !OVNKubernetesFeature.EnableNetworkSegmentation {
 		return fmt.Errorf("the Dynamic UDN Allocation feature cannot be enabled without also enabling Network Segmentation")
 	}
+	if OVNKubernetesFeature.EnableUplink && !OVNKubernetesFeature.EnableNetworkSegmentation {
+		return fmt.Errorf("the Uplink feature cannot be enabled without also enabling Network Segmentation")
+	}
 	return nil
 }
 
@@ -2455,8 +2508,17 @@ func validateNoOverlayConfig() error {
 			if ManagedBGP.Topology == "" {
 				return fmt.Errorf("topology is required when routing=managed")
 			}
-			if ManagedBGP.Topology != ManagedBGPTopologyFullMesh {
-				return fmt.Errorf("invalid topology %q: must be %q", ManagedBGP.Topology, ManagedBGPTopologyFullMesh)
+			switch ManagedBGP.Topology {
+			case ManagedBGPTopologyFullMesh:
+			case ManagedBGPTopologyRouteReflector:
+				if ManagedBGP.RouteReflectorNodeSelector == "" {
+					return fmt.Errorf("route-reflector-node-selector is required when topology=%s", ManagedBGPTopologyRouteReflector)
+				}
+				if _, err := metav1.ParseToLabelSelector(ManagedBGP.RouteReflectorNodeSelector); err != nil {
+					return fmt.Errorf("invalid route-reflector-node-selector %q: %v", ManagedBGP.RouteReflectorNodeSelector, err)
+				}
+			default:
+				return fmt.Errorf("invalid topology %q: must be %q or %q", ManagedBGP.Topology, ManagedBGPTopologyFullMesh, ManagedBGPTopologyRouteReflector)
 			}
 			if errs := validation.ValidateNamespaceName(ManagedBGP.FRRNamespace, false); len(errs) != 0 {
 				return fmt.Errorf("invalid frr-namespace %q: %s", ManagedBGP.FRRNamespace, strings.Join(errs, ", "))
@@ -2680,6 +2742,7 @@ func initConfigWithPath(ctx *cli.Context, exec kexec.Interface, saPath string, d
 		CNI:                  savedCNI,
 		OVNKubernetesFeature: savedOVNKubernetesFeature,
 		Kubernetes:           savedKubernetes,
+		Metrics:              savedMetrics,
 		OvnNorth:             savedOvnNorth,
 		OvnSouth:             savedOvnSouth,
 		Gateway:              savedGateway,
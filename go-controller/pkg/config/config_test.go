@@ -169,6 +169,7 @@ node-server-privkey=/path/to/node-metrics-private.key
 node-server-cert=/path/to/node-metrics.crt
 enable-config-duration=true
 enable-scale-metrics=true
+ip-pool-utilization-threshold=80
 
 [tls]
 tls-min-version=VersionTLS12
@@ -245,6 +246,7 @@ enable-route-advertisements=false
 advertised-udn-isolation-mode=strict
 enable-multi-external-gateway=false
 enable-admin-network-policy=false
+enable-cluster-network-policy=false
 enable-persistent-ips=false
 
 [clustermanager]
@@ -334,6 +336,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Kubernetes.DNSServiceName).To(gomega.Equal("kube-dns"))
 			gomega.Expect(Metrics.NodeServerPrivKey).To(gomega.Equal(""))
 			gomega.Expect(Metrics.NodeServerCert).To(gomega.Equal(""))
+			gomega.Expect(Metrics.IPPoolUtilizationThreshold).To(gomega.Equal(90))
 			gomega.Expect(TLS.MinVersion).To(gomega.Equal(""))
 			gomega.Expect(TLS.ParseCipherSuites()).To(gomega.BeEmpty())
 			gomega.Expect(Default.ClusterSubnets).To(gomega.Equal([]CIDRNetworkEntry{
@@ -360,6 +363,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(OVNKubernetesFeature.EnableMultiNetworkPolicy).To(gomega.BeFalse())
 			gomega.Expect(OVNKubernetesFeature.EnableMultiExternalGateway).To(gomega.BeFalse())
 			gomega.Expect(OVNKubernetesFeature.EnableAdminNetworkPolicy).To(gomega.BeFalse())
+			gomega.Expect(OVNKubernetesFeature.EnableClusterNetworkPolicy).To(gomega.BeFalse())
 			gomega.Expect(OVNKubernetesFeature.EnablePersistentIPs).To(gomega.BeFalse())
 			gomega.Expect(OVNKubernetesFeature.AdvertisedUDNIsolationMode).To(gomega.Equal(AdvertisedUDNIsolationModeStrict))
 
@@ -476,6 +480,7 @@ var _ = Describe("Config Operations", func() {
 			"advertised-udn-isolation-mode=loose",
 			"enable-multi-external-gateway=true",
 			"enable-admin-network-policy=true",
+			"enable-cluster-network-policy=true",
 			"enable-persistent-ips=true",
 			"zone=foo",
 		)
@@ -528,6 +533,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Metrics.NodeServerCert).To(gomega.Equal("/path/to/node-metrics.crt"))
 			gomega.Expect(Metrics.EnableConfigDuration).To(gomega.BeTrue())
 			gomega.Expect(Metrics.EnableScaleMetrics).To(gomega.BeTrue())
+			gomega.Expect(Metrics.IPPoolUtilizationThreshold).To(gomega.Equal(80))
 
 			gomega.Expect(TLS.MinVersion).To(gomega.Equal("VersionTLS12"))
 			gomega.Expect(TLS.ParseCipherSuites()).To(gomega.Equal([]string{
@@ -576,6 +582,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(OVNKubernetesFeature.AdvertisedUDNIsolationMode).To(gomega.Equal(AdvertisedUDNIsolationModeLoose))
 			gomega.Expect(OVNKubernetesFeature.EnableMultiExternalGateway).To(gomega.BeTrue())
 			gomega.Expect(OVNKubernetesFeature.EnableAdminNetworkPolicy).To(gomega.BeTrue())
+			gomega.Expect(OVNKubernetesFeature.EnableClusterNetworkPolicy).To(gomega.BeTrue())
 			gomega.Expect(OVNKubernetesFeature.EnablePersistentIPs).To(gomega.BeTrue())
 			gomega.Expect(HybridOverlay.ClusterSubnets).To(gomega.Equal([]CIDRNetworkEntry{
 				{ovntest.MustParseIPNet("11.132.0.0/14"), 23},
@@ -650,6 +657,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Metrics.NodeServerCert).To(gomega.Equal("/tls/nodecert"))
 			gomega.Expect(Metrics.EnableConfigDuration).To(gomega.BeTrue())
 			gomega.Expect(Metrics.EnableScaleMetrics).To(gomega.BeTrue())
+			gomega.Expect(Metrics.IPPoolUtilizationThreshold).To(gomega.Equal(0))
 
 			gomega.Expect(TLS.MinVersion).To(gomega.Equal("VersionTLS13"))
 			gomega.Expect(TLS.ParseCipherSuites()).To(gomega.Equal([]string{
@@ -690,6 +698,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(OVNKubernetesFeature.EnableMultiNetworkPolicy).To(gomega.BeTrue())
 			gomega.Expect(OVNKubernetesFeature.EnableMultiExternalGateway).To(gomega.BeTrue())
 			gomega.Expect(OVNKubernetesFeature.EnableAdminNetworkPolicy).To(gomega.BeTrue())
+			gomega.Expect(OVNKubernetesFeature.EnableClusterNetworkPolicy).To(gomega.BeTrue())
 			gomega.Expect(OVNKubernetesFeature.EnablePersistentIPs).To(gomega.BeTrue())
 			gomega.Expect(HybridOverlay.ClusterSubnets).To(gomega.Equal([]CIDRNetworkEntry{
 				{ovntest.MustParseIPNet("11.132.0.0/14"), 23},
@@ -749,6 +758,7 @@ var _ = Describe("Config Operations", func() {
 			"-metrics-enable-pprof=false",
 			"-ofctrl-wait-before-clear=5000",
 			"-metrics-enable-config-duration=true",
+			"-metrics-ip-pool-utilization-threshold=0",
 			"-tls-min-version=VersionTLS13",
 			"-tls-cipher-suites=TLS_AES_128_GCM_SHA256,TLS_AES_256_GCM_SHA384",
 			"-egressip-reachability-total-timeout=5",
@@ -762,6 +772,7 @@ var _ = Describe("Config Operations", func() {
 			"-advertised-udn-isolation-mode=loose",
 			"-enable-multi-external-gateway=true",
 			"-enable-admin-network-policy=true",
+			"-enable-cluster-network-policy=true",
 			"-enable-persistent-ips=true",
 			"-healthz-bind-address=0.0.0.0:4321",
 			"-zone=bar",
@@ -1902,12 +1913,36 @@ udn-allowed-default-services= ns/svc, ns1/svc1
 			err := validateNoOverlayConfig()
 			gomega.Expect(err).ToNot(gomega.HaveOccurred())
 
+			// Test valid route-reflector
+			ManagedBGP.Topology = ManagedBGPTopologyRouteReflector
+			ManagedBGP.RouteReflectorNodeSelector = "node-role.kubernetes.io/route-reflector="
+			err = validateNoOverlayConfig()
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
 			// Test invalid value
-			ManagedBGP.Topology = "route-reflector"
+			ManagedBGP.Topology = "partial-mesh"
 			err = validateNoOverlayConfig()
 			gomega.Expect(err).To(gomega.HaveOccurred())
 			gomega.Expect(err.Error()).To(gomega.ContainSubstring("invalid topology"))
-			gomega.Expect(err.Error()).To(gomega.ContainSubstring(`must be "full-mesh"`))
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring(`must be "full-mesh" or "route-reflector"`))
+		})
+
+		It("requires a valid route reflector node selector when topology is route-reflector", func() {
+			Default.Transport = types.NetworkTransportNoOverlay
+			NoOverlay.OutboundSNAT = types.NoOverlaySNATEnabled
+			NoOverlay.Routing = NoOverlayRoutingManaged
+			ManagedBGP.Topology = ManagedBGPTopologyRouteReflector
+			ManagedBGP.FRRNamespace = "frr-k8s-system"
+
+			ManagedBGP.RouteReflectorNodeSelector = ""
+			err := validateNoOverlayConfig()
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("route-reflector-node-selector is required"))
+
+			ManagedBGP.RouteReflectorNodeSelector = "role in (rr"
+			err = validateNoOverlayConfig()
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("invalid route-reflector-node-selector"))
 		})
 
 		It("does not require topology when routing is unmanaged", func() {
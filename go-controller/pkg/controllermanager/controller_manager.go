@@ -282,6 +282,8 @@ func NewControllerManager(ovnClient *util.OVNClientset, wf *factory.WatchFactory
 			EgressQoSClient:      ovnClient.EgressQoSClient,
 			IPAMClaimsClient:     ovnClient.IPAMClaimsClient,
 			NetworkQoSClient:     ovnClient.NetworkQoSClient,
+			MulticastGroupClient: ovnClient.MulticastGroupClient,
+			NetworkConnectClient: ovnClient.NetworkConnectClient,
 			NADClient:            ovnClient.NetworkAttchDefClient,
 		},
 		stopChan:         stopCh,
@@ -32,6 +32,7 @@ import (
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/controllers/evpn"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/controllers/uplink"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/iprulemanager"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/managementport"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/netlinkdevicemanager"
@@ -76,6 +77,11 @@ type NodeControllerManager struct {
 	ndm *netlinkdevicemanager.Controller
 	// evpn controller that manages EVPN datapath
 	evpnController *evpn.Controller
+	// uplink controller that discovers the uplinks of this node
+	uplinkController *uplink.Controller
+	// localnet native VLAN controller that configures the native VLAN of
+	// localnet networks in trunk mode
+	localnetNativeVLANController *node.LocalnetNativeVLANController
 }
 
 // NewNetworkController create node user-defined network controllers for the given NetInfo
@@ -92,7 +98,8 @@ func (ncm *NodeControllerManager) NewNetworkController(nInfo util.NetInfo) (netw
 		// Pass a shallow clone of the watch factory, this allows multiplexing
 		// informers for UDNs.
 		udnc, err := node.NewUserDefinedNodeNetworkController(ncm.newCommonNetworkControllerInfo(ncm.watchFactory.(*factory.WatchFactory).ShallowClone()),
-			nInfo, ncm.networkManager.Interface(), ncm.vrfManager, ncm.ruleManager, ncm.mpdm, ncm.defaultNodeNetworkController.Gateway)
+			nInfo, ncm.networkManager.Interface(), ncm.vrfManager, ncm.ruleManager, ncm.mpdm, ncm.defaultNodeNetworkController.Gateway,
+			ncm.localnetNativeVLANController)
 		if err != nil && ncm.mpdm != nil && util.IsNetworkSegmentationSupportEnabled() && nInfo.IsPrimaryNetwork() {
 			_ = ncm.mpdm.ReleaseDeviceIDForNetwork(nInfo.GetNetworkName())
 		}
@@ -262,7 +269,7 @@ func NewNodeControllerManager(ovnClient *util.OVNClientset, wf factory.NodeWatch
 	wg *sync.WaitGroup, eventRecorder record.EventRecorder, routeManager *routemanager.Controller, ovsClient client.Client) (*NodeControllerManager, error) {
 	ncm := &NodeControllerManager{
 		name:          name,
-		ovnNodeClient: &util.OVNNodeClientset{KubeClient: ovnClient.KubeClient, AdminPolicyRouteClient: ovnClient.AdminPolicyRouteClient},
+		ovnNodeClient: &util.OVNNodeClientset{KubeClient: ovnClient.KubeClient, AdminPolicyRouteClient: ovnClient.AdminPolicyRouteClient, UplinkClient: ovnClient.UplinkClient},
 		Kube:          &kube.Kube{KClient: ovnClient.KubeClient},
 		watchFactory:  wf,
 		stopChan:      make(chan struct{}),
@@ -271,6 +278,9 @@ func NewNodeControllerManager(ovnClient *util.OVNClientset, wf factory.NodeWatch
 		routeManager:  routeManager,
 		ovsClient:     ovsClient,
 	}
+	if ncm.routeManager != nil {
+		ncm.routeManager.SetEventRecorder(eventRecorder, name)
+	}
 
 	// need to configure OVS interfaces for Pods on UDNs in the DPU mode
 	// need to start NAD controller on node side for programming gateway pieces for UDNs
@@ -304,9 +314,14 @@ func NewNodeControllerManager(ovnClient *util.OVNClientset, wf factory.NodeWatch
 		// device as the table anchor for reflecting FRR-learned BGP
 		// routes into OVN. Full mode uses one VRF manager for both.
 		ncm.vrfManager = vrfmanager.NewController(ncm.routeManager)
+		ncm.vrfManager.SetEventRecorder(eventRecorder, name)
 	}
 	if util.IsNetworkSegmentationSupportEnabled() && config.OvnKubeNode.Mode != ovntypes.NodeModeDPU {
 		ncm.ruleManager = iprulemanager.NewController(config.IPv4Mode, config.IPv6Mode)
+		ncm.ruleManager.SetEventRecorder(eventRecorder, name)
+	}
+	if config.OVNKubernetesFeature.EnableMultiNetwork && config.OvnKubeNode.Mode != ovntypes.NodeModeDPUHost && ovsClient != nil {
+		ncm.localnetNativeVLANController = node.NewLocalnetNativeVLANController(ovsClient)
 	}
 
 	return ncm, nil
@@ -401,6 +416,12 @@ func (ncm *NodeControllerManager) Start(ctx context.Context, isOVNKubeController
 		return fmt.Errorf("failed to init default node network controller: %v", err)
 	}
 
+	if ncm.localnetNativeVLANController != nil {
+		if err = ncm.localnetNativeVLANController.Start(); err != nil {
+			return fmt.Errorf("failed to start localnet native VLAN controller: %w", err)
+		}
+	}
+
 	if ncm.networkManager != nil {
 		err = ncm.networkManager.Start()
 		if err != nil {
@@ -457,6 +478,16 @@ func (ncm *NodeControllerManager) Start(ctx context.Context, isOVNKubeController
 		}
 	}
 
+	if util.IsUplinkSupported() && config.IsModeFull() {
+		ncm.uplinkController, err = uplink.NewController(ncm.name, ncm.watchFactory, ncm.ovnNodeClient.UplinkClient)
+		if err != nil {
+			return fmt.Errorf("failed to create Uplink controller: %w", err)
+		}
+		if err := ncm.uplinkController.Start(); err != nil {
+			return fmt.Errorf("failed to start Uplink controller: %w", err)
+		}
+	}
+
 	// start workaround and remove when ovn has native support for silencing GARPs for LRPs
 	// https://issues.redhat.com/browse/FDP-1537
 	// when in mode ovnkube controller with node, wait until ovnkube controller is syncd before removing drop flows for GARPs
@@ -491,6 +522,9 @@ func (ncm *NodeControllerManager) Stop(isOVNKubeControllerSyncd *atomic.Bool) {
 	if ncm.evpnController != nil {
 		ncm.evpnController.Stop()
 	}
+	if ncm.uplinkController != nil {
+		ncm.uplinkController.Stop()
+	}
 
 	// stop stale ovs ports cleanup
 	close(ncm.stopChan)
@@ -509,6 +543,10 @@ func (ncm *NodeControllerManager) Stop(isOVNKubeControllerSyncd *atomic.Bool) {
 	if ncm.networkManager != nil {
 		ncm.networkManager.Stop()
 	}
+
+	if ncm.localnetNativeVLANController != nil {
+		ncm.localnetNativeVLANController.Stop()
+	}
 }
 
 // checkForStaleOVSPodInterfaces checks for stale Pod OVS ports, including SR-IOV
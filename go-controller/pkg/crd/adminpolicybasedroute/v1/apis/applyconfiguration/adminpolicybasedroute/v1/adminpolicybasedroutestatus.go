@@ -21,6 +21,9 @@ type AdminPolicyBasedRouteStatusApplyConfiguration struct {
 	Messages []string `json:"messages,omitempty"`
 	// A concise indication of whether the AdminPolicyBasedRoute resource is applied with success
 	Status *adminpolicybasedroutev1.StatusType `json:"status,omitempty"`
+	// NextHops contains the state of the BFD sessions to the next hops that have BFD enabled, from every node gateway
+	// router that routes traffic to them.
+	NextHops []NextHopStatusApplyConfiguration `json:"nextHops,omitempty"`
 }
 
 // AdminPolicyBasedRouteStatusApplyConfiguration constructs a declarative configuration of the AdminPolicyBasedRouteStatus type for use with
@@ -54,3 +57,16 @@ func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithStatus(value adminpo
 	b.Status = &value
 	return b
 }
+
+// WithNextHops adds the given value to the NextHops field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NextHops field.
+func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithNextHops(values ...*NextHopStatusApplyConfiguration) *AdminPolicyBasedRouteStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNextHops")
+		}
+		b.NextHops = append(b.NextHops, *values[i])
+	}
+	return b
+}
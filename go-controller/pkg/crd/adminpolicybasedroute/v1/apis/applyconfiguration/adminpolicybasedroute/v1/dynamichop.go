@@ -26,6 +26,8 @@ type DynamicHopApplyConfiguration struct {
 	NetworkAttachmentName *string `json:"networkAttachmentName,omitempty"`
 	// BFDEnabled determines if the interface implements the Bidirectional Forward Detection protocol. Defaults to false.
 	BFDEnabled *bool `json:"bfdEnabled,omitempty"`
+	// BFD defines the timers of the Bidirectional Forward Detection session. Can only be set when BFDEnabled is true.
+	BFD *BFDConfigApplyConfiguration `json:"bfd,omitempty"`
 }
 
 // DynamicHopApplyConfiguration constructs a declarative configuration of the DynamicHop type for use with
@@ -65,3 +67,11 @@ func (b *DynamicHopApplyConfiguration) WithBFDEnabled(value bool) *DynamicHopApp
 	b.BFDEnabled = &value
 	return b
 }
+
+// WithBFD sets the BFD field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BFD field is set to the value of the last call.
+func (b *DynamicHopApplyConfiguration) WithBFD(value *BFDConfigApplyConfiguration) *DynamicHopApplyConfiguration {
+	b.BFD = value
+	return b
+}
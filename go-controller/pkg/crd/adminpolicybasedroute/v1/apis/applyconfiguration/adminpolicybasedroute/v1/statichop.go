@@ -14,6 +14,8 @@ type StaticHopApplyConfiguration struct {
 	IP *string `json:"ip,omitempty"`
 	// BFDEnabled determines if the interface implements the Bidirectional Forward Detection protocol. Defaults to false.
 	BFDEnabled *bool `json:"bfdEnabled,omitempty"`
+	// BFD defines the timers of the Bidirectional Forward Detection session. Can only be set when BFDEnabled is true.
+	BFD *BFDConfigApplyConfiguration `json:"bfd,omitempty"`
 }
 
 // StaticHopApplyConfiguration constructs a declarative configuration of the StaticHop type for use with
@@ -37,3 +39,11 @@ func (b *StaticHopApplyConfiguration) WithBFDEnabled(value bool) *StaticHopApply
 	b.BFDEnabled = &value
 	return b
 }
+
+// WithBFD sets the BFD field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BFD field is set to the value of the last call.
+func (b *StaticHopApplyConfiguration) WithBFD(value *BFDConfigApplyConfiguration) *StaticHopApplyConfiguration {
+	b.BFD = value
+	return b
+}
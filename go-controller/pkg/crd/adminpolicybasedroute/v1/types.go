@@ -67,6 +67,7 @@ type ExternalNextHops struct {
 }
 
 // StaticHop defines the configuration of a static IP that acts as an external Gateway Interface. IP field is mandatory.
+// +kubebuilder:validation:XValidation:rule="!has(self.bfd) || self.bfdEnabled",message="bfd can only be set when bfdEnabled is true"
 type StaticHop struct {
 	//IP defines the static IP to be used for egress traffic. The IP can be either IPv4 or IPv6.
 	// + Regex taken from: https://blog.markhatton.co.uk/2011/03/15/regular-expressions-for-ip-addresses-cidr-ranges-and-hostnames/
@@ -79,6 +80,9 @@ type StaticHop struct {
 	// +kubebuilder:default:=false
 	// +default=false
 	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+	// BFD defines the timers of the Bidirectional Forward Detection session. Can only be set when BFDEnabled is true.
+	// +optional
+	BFD *BFDConfig `json:"bfd,omitempty"`
 	// SkipHostSNAT determines whether to disable Source NAT to the host IP. Defaults to false.
 	// +optional
 	// +kubebuilder:default:=false
@@ -90,6 +94,7 @@ type StaticHop struct {
 // These interfaces are wrapped around a pod object that resides inside the cluster.
 // The field NetworkAttachmentName captures the name of the multus network name to use when retrieving the gateway IP to use.
 // The PodSelector and the NamespaceSelector are mandatory fields.
+// +kubebuilder:validation:XValidation:rule="!has(self.bfd) || self.bfdEnabled",message="bfd can only be set when bfdEnabled is true"
 type DynamicHop struct {
 	// PodSelector defines the selector to filter the pods that are external gateways.
 	// +kubebuilder:validation:Required
@@ -110,6 +115,9 @@ type DynamicHop struct {
 	// +kubebuilder:default:=false
 	// +default=false
 	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+	// BFD defines the timers of the Bidirectional Forward Detection session. Can only be set when BFDEnabled is true.
+	// +optional
+	BFD *BFDConfig `json:"bfd,omitempty"`
 	// SkipHostSNAT determines whether to disable Source NAT to the host IP. Defaults to false
 	// +optional
 	// +kubebuilder:default:=false
@@ -117,6 +125,24 @@ type DynamicHop struct {
 	// SkipHostSNAT bool `json:"skipHostSNAT,omitempty"`
 }
 
+// BFDConfig defines the timers of a Bidirectional Forward Detection session. Unset timers use the OVN defaults.
+type BFDConfig struct {
+	// MinTx is the minimum interval, in milliseconds, between transmitted BFD control packets.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinTx *int32 `json:"minTx,omitempty"`
+	// MinRx is the minimum interval, in milliseconds, between received BFD control packets that this system is capable
+	// of supporting.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinRx *int32 `json:"minRx,omitempty"`
+	// DetectMult is the number of BFD control packets that can be missed before the session is declared down.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	DetectMult *int32 `json:"detectMult,omitempty"`
+}
+
 // AdminPolicyBasedExternalRouteList contains a list of AdminPolicyBasedExternalRoutes
 // +kubebuilder:object:root=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -139,8 +165,39 @@ type AdminPolicyBasedRouteStatus struct {
 	// A concise indication of whether the AdminPolicyBasedRoute resource is applied with success
 	// +optional
 	Status StatusType `json:"status,omitempty"`
+	// NextHops contains the state of the BFD sessions to the next hops that have BFD enabled, from every node gateway
+	// router that routes traffic to them.
+	// +listType=map
+	// +listMapKey=ip
+	// +listMapKey=node
+	// +optional
+	NextHops []NextHopStatus `json:"nextHops,omitempty"`
+}
+
+// NextHopStatus contains the observed state of the BFD session to a next hop from a node.
+type NextHopStatus struct {
+	// IP is the IP of the next hop.
+	// +required
+	IP string `json:"ip"`
+	// Node is the name of the node whose gateway router has the BFD session with the next hop.
+	// +required
+	Node string `json:"node"`
+	// BFDStatus is the state of the BFD session, as reported by OVN. Empty if OVN hasn't reported it yet.
+	// +optional
+	BFDStatus BFDStatus `json:"bfdStatus,omitempty"`
 }
 
+// BFDStatus is the state of a BFD session.
+// +kubebuilder:validation:Enum="";up;down;init;admin_down
+type BFDStatus string
+
+const (
+	BFDStatusUp        BFDStatus = "up"
+	BFDStatusDown      BFDStatus = "down"
+	BFDStatusInit      BFDStatus = "init"
+	BFDStatusAdminDown BFDStatus = "admin_down"
+)
+
 // StatusType defines the types of status used in the Status field. The value determines if the
 // deployment of the CR was successful or if it failed.
 type StatusType string
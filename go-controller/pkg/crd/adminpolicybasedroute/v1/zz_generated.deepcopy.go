@@ -100,6 +100,11 @@ func (in *AdminPolicyBasedRouteStatus) DeepCopyInto(out *AdminPolicyBasedRouteSt
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NextHops != nil {
+		in, out := &in.NextHops, &out.NextHops
+		*out = make([]NextHopStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -113,11 +118,47 @@ func (in *AdminPolicyBasedRouteStatus) DeepCopy() *AdminPolicyBasedRouteStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDConfig) DeepCopyInto(out *BFDConfig) {
+	*out = *in
+	if in.MinTx != nil {
+		in, out := &in.MinTx, &out.MinTx
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRx != nil {
+		in, out := &in.MinRx, &out.MinRx
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DetectMult != nil {
+		in, out := &in.DetectMult, &out.DetectMult
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDConfig.
+func (in *BFDConfig) DeepCopy() *BFDConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicHop) DeepCopyInto(out *DynamicHop) {
 	*out = *in
 	in.PodSelector.DeepCopyInto(&out.PodSelector)
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BFDConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -158,7 +199,7 @@ func (in *ExternalNextHops) DeepCopyInto(out *ExternalNextHops) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(StaticHop)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -186,9 +227,30 @@ func (in *ExternalNextHops) DeepCopy() *ExternalNextHops {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NextHopStatus) DeepCopyInto(out *NextHopStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NextHopStatus.
+func (in *NextHopStatus) DeepCopy() *NextHopStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NextHopStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticHop) DeepCopyInto(out *StaticHop) {
 	*out = *in
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BFDConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
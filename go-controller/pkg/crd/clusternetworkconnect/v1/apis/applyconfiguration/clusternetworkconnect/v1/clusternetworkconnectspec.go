@@ -36,6 +36,13 @@ type ClusterNetworkConnectSpecApplyConfiguration struct {
 	ConnectSubnets []ConnectSubnetApplyConfiguration `json:"connectSubnets,omitempty"`
 	// connectivity specifies which connectivity types should be enabled for the connected networks.
 	Connectivity []clusternetworkconnectv1.ConnectivityType `json:"connectivity,omitempty"`
+	// allowedTraffic optionally restricts the traffic that is allowed between the connected networks.
+	// When set, traffic crossing the connect router towards a connected network is only allowed
+	// if its destination is a pod in a namespace selected by at least one rule, and it matches
+	// one of the ports of that rule. Reply traffic of the allowed connections is allowed as well.
+	// Traffic within a network is never affected.
+	// When not set, all traffic is allowed between the connected networks as requested by connectivity.
+	AllowedTraffic []AllowedTrafficRuleApplyConfiguration `json:"allowedTraffic,omitempty"`
 }
 
 // ClusterNetworkConnectSpecApplyConfiguration constructs a declarative configuration of the ClusterNetworkConnectSpec type for use with
@@ -74,3 +81,16 @@ func (b *ClusterNetworkConnectSpecApplyConfiguration) WithConnectivity(values ..
 	}
 	return b
 }
+
+// WithAllowedTraffic adds the given value to the AllowedTraffic field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedTraffic field.
+func (b *ClusterNetworkConnectSpecApplyConfiguration) WithAllowedTraffic(values ...*AllowedTrafficRuleApplyConfiguration) *ClusterNetworkConnectSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAllowedTraffic")
+		}
+		b.AllowedTraffic = append(b.AllowedTraffic, *values[i])
+	}
+	return b
+}
@@ -19,6 +19,10 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("AllowedPort"):
+		return &clusternetworkconnectv1.AllowedPortApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("AllowedTrafficRule"):
+		return &clusternetworkconnectv1.AllowedTrafficRuleApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterNetworkConnect"):
 		return &clusternetworkconnectv1.ClusterNetworkConnectApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterNetworkConnectSpec"):
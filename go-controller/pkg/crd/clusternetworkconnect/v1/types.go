@@ -20,6 +20,7 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/types"
@@ -90,6 +91,67 @@ type ClusterNetworkConnectSpec struct {
 	// +kubebuilder:validation:MaxItems=2
 	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, x == y))",message="connectivity cannot contain duplicate values"
 	Connectivity []ConnectivityType `json:"connectivity"`
+
+	// allowedTraffic optionally restricts the traffic that is allowed between the connected networks.
+	// When set, traffic crossing the connect router towards a connected network is only allowed
+	// if its destination is a pod in a namespace selected by at least one rule, and it matches
+	// one of the ports of that rule. Reply traffic of the allowed connections is allowed as well.
+	// Traffic within a network is never affected.
+	// When not set, all traffic is allowed between the connected networks as requested by connectivity.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	AllowedTraffic []AllowedTrafficRule `json:"allowedTraffic,omitempty"`
+}
+
+// AllowedTrafficRule allows traffic towards the pods of the selected namespaces.
+type AllowedTrafficRule struct {
+	// namespaceSelector selects the namespaces, among the ones using the connected networks
+	// as their primary network, whose pods can be reached from the other connected networks.
+	// An empty selector selects all namespaces of the connected networks.
+	//
+	// +kubebuilder:validation:Required
+	// +required
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// ports restricts the allowed traffic to the given protocol and port tuples.
+	// The ports are the ones the destination pods listen on, which also applies to
+	// service traffic after it is load balanced to a pod.
+	// When not set, traffic of any protocol and port is allowed.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=atomic
+	// +optional
+	Ports []AllowedPort `json:"ports,omitempty"`
+}
+
+// AllowedPort is a protocol, port or port range tuple.
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || (has(self.port) && self.endPort >= self.port)", message="endPort requires port and must be greater than or equal to port"
+type AllowedPort struct {
+	// protocol is the L4 protocol of the allowed traffic.
+	//
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+	// +kubebuilder:validation:Required
+	// +required
+	Protocol corev1.Protocol `json:"protocol"`
+
+	// port is the allowed destination port.
+	// When not set, all the ports of the protocol are allowed.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// endPort, when set, allows the range of ports from port to endPort, inclusive.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	EndPort int32 `json:"endPort,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="isCIDR(self) && cidr(self) == cidr(self).masked()", message="CIDR must be a valid network address"
@@ -14,6 +14,44 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedPort) DeepCopyInto(out *AllowedPort) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedPort.
+func (in *AllowedPort) DeepCopy() *AllowedPort {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedTrafficRule) DeepCopyInto(out *AllowedTrafficRule) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]AllowedPort, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedTrafficRule.
+func (in *AllowedTrafficRule) DeepCopy() *AllowedTrafficRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedTrafficRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterNetworkConnect) DeepCopyInto(out *ClusterNetworkConnect) {
 	*out = *in
@@ -95,6 +133,13 @@ func (in *ClusterNetworkConnectSpec) DeepCopyInto(out *ClusterNetworkConnectSpec
 		*out = make([]ConnectivityType, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedTraffic != nil {
+		in, out := &in.AllowedTraffic, &out.AllowedTraffic
+		*out = make([]AllowedTrafficRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -24,6 +24,14 @@ type EgressFirewallDestinationApplyConfiguration struct {
 	// nodeSelector will allow/deny traffic to the Kubernetes node IP of selected nodes. If this is set,
 	// cidrSelector and DNSName must be unset.
 	NodeSelector *metav1.LabelSelectorApplyConfiguration `json:"nodeSelector,omitempty"`
+	// namespaceSelector will allow/deny traffic to the IPs of pods in the selected namespaces. Only pod IPs
+	// outside the cluster subnets of the EgressFirewall's network are matched, which makes it possible to
+	// target pods attached to secondary networks (e.g. localnet). If this is set, cidrSelector, dnsName
+	// and nodeSelector must be unset.
+	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	// podSelector narrows down the pods selected by namespaceSelector. If it is unset, all the pods in
+	// the selected namespaces are selected. It can only be set together with namespaceSelector.
+	PodSelector *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
 }
 
 // EgressFirewallDestinationApplyConfiguration constructs a declarative configuration of the EgressFirewallDestination type for use with
@@ -55,3 +63,19 @@ func (b *EgressFirewallDestinationApplyConfiguration) WithNodeSelector(value *me
 	b.NodeSelector = value
 	return b
 }
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *EgressFirewallDestinationApplyConfiguration) WithNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *EgressFirewallDestinationApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
+
+// WithPodSelector sets the PodSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSelector field is set to the value of the last call.
+func (b *EgressFirewallDestinationApplyConfiguration) WithPodSelector(value *metav1.LabelSelectorApplyConfiguration) *EgressFirewallDestinationApplyConfiguration {
+	b.PodSelector = value
+	return b
+}
@@ -14,6 +14,9 @@ type EgressFirewallPortApplyConfiguration struct {
 	Protocol *string `json:"protocol,omitempty"`
 	// port that the traffic must match
 	Port *int32 `json:"port,omitempty"`
+	// endPort, if set, makes the rule match the range of ports from port to endPort, inclusive.
+	// It must be greater than or equal to port.
+	EndPort *int32 `json:"endPort,omitempty"`
 }
 
 // EgressFirewallPortApplyConfiguration constructs a declarative configuration of the EgressFirewallPort type for use with
@@ -37,3 +40,11 @@ func (b *EgressFirewallPortApplyConfiguration) WithPort(value int32) *EgressFire
 	b.Port = &value
 	return b
 }
+
+// WithEndPort sets the EndPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EndPort field is set to the value of the last call.
+func (b *EgressFirewallPortApplyConfiguration) WithEndPort(value int32) *EgressFirewallPortApplyConfiguration {
+	b.EndPort = &value
+	return b
+}
@@ -64,6 +64,7 @@ type EgressFirewallRule struct {
 }
 
 // EgressFirewallPort specifies the port to allow or deny traffic to
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || self.endPort >= self.port",message="endPort must be greater than or equal to port"
 type EgressFirewallPort struct {
 	// protocol (tcp, udp, sctp) that the traffic must match.
 	// +kubebuilder:validation:Pattern=^TCP|UDP|SCTP$
@@ -72,10 +73,18 @@ type EgressFirewallPort struct {
 	// +kubebuilder:validation:Minimum:=1
 	// +kubebuilder:validation:Maximum:=65535
 	Port int32 `json:"port"`
+	// endPort, if set, makes the rule match the range of ports from port to endPort, inclusive.
+	// It must be greater than or equal to port.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties:=1
-// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MaxProperties:=2
+// +kubebuilder:validation:XValidation:rule="[has(self.cidrSelector), has(self.dnsName), has(self.nodeSelector), has(self.namespaceSelector)].exists_one(x, x)",message="exactly one of cidrSelector, dnsName, nodeSelector or namespaceSelector must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.podSelector) || has(self.namespaceSelector)",message="podSelector can only be set together with namespaceSelector"
 // EgressFirewallDestination is the target that traffic is either allowed or denied to
 type EgressFirewallDestination struct {
 	// cidrSelector is the CIDR range to allow/deny traffic to. If this is set, dnsName and nodeSelector must be unset.
@@ -90,6 +99,16 @@ type EgressFirewallDestination struct {
 	// cidrSelector and DNSName must be unset.
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+	// namespaceSelector will allow/deny traffic to the IPs of pods in the selected namespaces. Only pod IPs
+	// outside the cluster subnets of the EgressFirewall's network are matched, which makes it possible to
+	// target pods attached to secondary networks (e.g. localnet). If this is set, cidrSelector, dnsName
+	// and nodeSelector must be unset.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// podSelector narrows down the pods selected by namespaceSelector. If it is unset, all the pods in
+	// the selected namespaces are selected. It can only be set together with namespaceSelector.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
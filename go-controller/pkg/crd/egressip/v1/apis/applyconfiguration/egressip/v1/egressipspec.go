@@ -6,6 +6,7 @@
 package v1
 
 import (
+	egressipv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -28,6 +29,12 @@ type EgressIPSpecApplyConfiguration struct {
 	// (in the namespace(s) already matched by the NamespaceSelector) which
 	// match this pod selector.
 	PodSelector *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	// DistributionMode selects how the traffic of the matching pods is spread across the egress IPs.
+	// "ECMP", the default, balances the traffic of every pod across all the assigned egress IPs.
+	// "PerPodSticky" pins every pod to a single assigned egress IP per IP family, picked deterministically
+	// from the pod namespace and name, so that all the pod traffic leaves the cluster with the same source IP.
+	// The pod is only moved to another egress IP once its egress IP is not assigned to any node anymore.
+	DistributionMode *egressipv1.DistributionMode `json:"distributionMode,omitempty"`
 }
 
 // EgressIPSpecApplyConfiguration constructs a declarative configuration of the EgressIPSpec type for use with
@@ -61,3 +68,11 @@ func (b *EgressIPSpecApplyConfiguration) WithPodSelector(value *metav1.LabelSele
 	b.PodSelector = value
 	return b
 }
+
+// WithDistributionMode sets the DistributionMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DistributionMode field is set to the value of the last call.
+func (b *EgressIPSpecApplyConfiguration) WithDistributionMode(value egressipv1.DistributionMode) *EgressIPSpecApplyConfiguration {
+	b.DistributionMode = &value
+	return b
+}
@@ -63,8 +63,26 @@ type EgressIPSpec struct {
 	// match this pod selector.
 	// +optional
 	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+	// DistributionMode selects how the traffic of the matching pods is spread across the egress IPs.
+	// "ECMP", the default, balances the traffic of every pod across all the assigned egress IPs.
+	// "PerPodSticky" pins every pod to a single assigned egress IP per IP family, picked deterministically
+	// from the pod namespace and name, so that all the pod traffic leaves the cluster with the same source IP.
+	// The pod is only moved to another egress IP once its egress IP is not assigned to any node anymore.
+	// +kubebuilder:validation:Enum=ECMP;PerPodSticky
+	// +optional
+	DistributionMode DistributionMode `json:"distributionMode,omitempty"`
 }
 
+// DistributionMode is the way the traffic of the pods matching an EgressIP is spread across its egress IPs.
+type DistributionMode string
+
+const (
+	// ECMPDistributionMode balances the traffic of every pod across all the assigned egress IPs.
+	ECMPDistributionMode DistributionMode = "ECMP"
+	// PerPodStickyDistributionMode pins every pod to a single assigned egress IP per IP family.
+	PerPodStickyDistributionMode DistributionMode = "PerPodSticky"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +resource:path=egressip
 // EgressIPList is the list of EgressIPList.
@@ -23,6 +23,11 @@ type EgressQoSRuleApplyConfiguration struct {
 	// matches this definition. This field is optional, and in case it is not set
 	// results in the rule being applied to all pods in the namespace.
 	PodSelector *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	// Bandwidth limits the rate of the matching pods' traffic. The limit is
+	// applied to the aggregated traffic of the matching pods on each node.
+	// This field is optional, and in case it is not set the traffic is not
+	// rate limited.
+	Bandwidth *BandwidthApplyConfiguration `json:"bandwidth,omitempty"`
 }
 
 // EgressQoSRuleApplyConfiguration constructs a declarative configuration of the EgressQoSRule type for use with
@@ -54,3 +59,11 @@ func (b *EgressQoSRuleApplyConfiguration) WithPodSelector(value *metav1.LabelSel
 	b.PodSelector = value
 	return b
 }
+
+// WithBandwidth sets the Bandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bandwidth field is set to the value of the last call.
+func (b *EgressQoSRuleApplyConfiguration) WithBandwidth(value *BandwidthApplyConfiguration) *EgressQoSRuleApplyConfiguration {
+	b.Bandwidth = value
+	return b
+}
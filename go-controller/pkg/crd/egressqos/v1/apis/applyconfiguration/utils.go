@@ -19,6 +19,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("Bandwidth"):
+		return &egressqosv1.BandwidthApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressQoS"):
 		return &egressqosv1.EgressQoSApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressQoSRule"):
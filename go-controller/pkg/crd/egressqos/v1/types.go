@@ -71,6 +71,28 @@ type EgressQoSRule struct {
 	// results in the rule being applied to all pods in the namespace.
 	// +optional
 	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// Bandwidth limits the rate of the matching pods' traffic. The limit is
+	// applied to the aggregated traffic of the matching pods on each node.
+	// This field is optional, and in case it is not set the traffic is not
+	// rate limited.
+	// +optional
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+}
+
+// Bandwidth controls the maximum rate of the traffic matching an EgressQoSRule.
+type Bandwidth struct {
+	// Rate is the value of the rate limit in kbps. Traffic over the limit
+	// is dropped.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=4294967295
+	Rate uint32 `json:"rate"`
+
+	// Burst is the value of the burst rate limit in kilobits.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=4294967295
+	// +optional
+	Burst uint32 `json:"burst,omitempty"`
 }
 
 // EgressQoSStatus defines the observed state of EgressQoS
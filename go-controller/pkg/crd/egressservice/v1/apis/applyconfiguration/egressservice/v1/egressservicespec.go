@@ -31,6 +31,12 @@ type EgressServiceSpecApplyConfiguration struct {
 	// This is typically implemented as VRF mapping, representing a numeric id or string name
 	// of a routing table which by omission uses the default host routing.
 	Network *string `json:"network,omitempty"`
+	// The number of nodes selected to handle the service's traffic when sourceIPBy=LoadBalancerIP.
+	// When more than one node is selected, the egress traffic of the service's endpoints is spread
+	// across all of them using ECMP, and the remaining nodes keep handling it when one of them fails.
+	// Fewer nodes are selected when not enough nodes match the nodeSelector.
+	// When it is not specified a single node is selected.
+	ActiveHosts *int32 `json:"activeHosts,omitempty"`
 }
 
 // EgressServiceSpecApplyConfiguration constructs a declarative configuration of the EgressServiceSpec type for use with
@@ -62,3 +68,11 @@ func (b *EgressServiceSpecApplyConfiguration) WithNetwork(value string) *EgressS
 	b.Network = &value
 	return b
 }
+
+// WithActiveHosts sets the ActiveHosts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveHosts field is set to the value of the last call.
+func (b *EgressServiceSpecApplyConfiguration) WithActiveHosts(value int32) *EgressServiceSpecApplyConfiguration {
+	b.ActiveHosts = &value
+	return b
+}
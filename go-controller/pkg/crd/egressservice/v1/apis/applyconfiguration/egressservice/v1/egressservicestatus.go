@@ -11,8 +11,12 @@ package v1
 // EgressServiceStatus defines the observed state of EgressService
 type EgressServiceStatusApplyConfiguration struct {
 	// The name of the node selected to handle the service's traffic.
+	// When several nodes are selected it is set to the first one of hosts.
 	// In case sourceIPBy=Network the field will be set to "ALL".
 	Host *string `json:"host,omitempty"`
+	// The names of all the nodes selected to handle the service's traffic.
+	// It is not set when sourceIPBy=Network.
+	Hosts []string `json:"hosts,omitempty"`
 }
 
 // EgressServiceStatusApplyConfiguration constructs a declarative configuration of the EgressServiceStatus type for use with
@@ -28,3 +32,13 @@ func (b *EgressServiceStatusApplyConfiguration) WithHost(value string) *EgressSe
 	b.Host = &value
 	return b
 }
+
+// WithHosts adds the given value to the Hosts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Hosts field.
+func (b *EgressServiceStatusApplyConfiguration) WithHosts(values ...string) *EgressServiceStatusApplyConfiguration {
+	for i := range values {
+		b.Hosts = append(b.Hosts, values[i])
+	}
+	return b
+}
@@ -30,6 +30,7 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Assigned Host",type=string,JSONPath=".status.host"
+// +kubebuilder:printcolumn:name="Assigned Hosts",type=string,JSONPath=".status.hosts",priority=1
 // EgressService is a CRD that allows the user to request that the source
 // IP of egress packets originating from all of the pods that are endpoints
 // of the corresponding LoadBalancer Service would be its ingress IP.
@@ -66,6 +67,16 @@ type EgressServiceSpec struct {
 	// of a routing table which by omission uses the default host routing.
 	// +optional
 	Network string `json:"network,omitempty"`
+
+	// The number of nodes selected to handle the service's traffic when sourceIPBy=LoadBalancerIP.
+	// When more than one node is selected, the egress traffic of the service's endpoints is spread
+	// across all of them using ECMP, and the remaining nodes keep handling it when one of them fails.
+	// Fewer nodes are selected when not enough nodes match the nodeSelector.
+	// When it is not specified a single node is selected.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +optional
+	ActiveHosts int32 `json:"activeHosts,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=LoadBalancerIP;Network
@@ -82,8 +93,15 @@ const (
 // EgressServiceStatus defines the observed state of EgressService
 type EgressServiceStatus struct {
 	// The name of the node selected to handle the service's traffic.
+	// When several nodes are selected it is set to the first one of hosts.
 	// In case sourceIPBy=Network the field will be set to "ALL".
 	Host string `json:"host"`
+
+	// The names of all the nodes selected to handle the service's traffic.
+	// It is not set when sourceIPBy=Network.
+	// +optional
+	// +listType=set
+	Hosts []string `json:"hosts,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
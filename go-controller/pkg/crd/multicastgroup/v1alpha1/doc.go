@@ -0,0 +1,7 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package v1alpha1 contains API Schema definitions for the network v1alpha1 API group
+// +k8s:deepcopy-gen=package
+// +groupName=k8s.ovn.org
+package v1alpha1
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MulticastGroup allows multicast traffic between producer and consumer pods
+// that may live in different namespaces.
+// Multicast is otherwise only allowed between the pods of a namespace that has
+// multicast enabled. The group is applied on the primary network of the selected
+// pods, either the default cluster network or a primary user defined network, so
+// producers and consumers are expected to share the same primary network.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=multicastgroups,scope=Cluster,shortName=mcg,singular=multicastgroup
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.status"
+type MulticastGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +required
+	Spec MulticastGroupSpec `json:"spec"`
+	// +optional
+	Status MulticastGroupStatus `json:"status,omitempty"`
+}
+
+// MulticastGroupSpec defines the desired state of MulticastGroup.
+type MulticastGroupSpec struct {
+	// producers selects the pods that are allowed to send multicast traffic
+	// to the consumers of this group.
+	// A pod is selected if it matches any of the entries.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Producers []MulticastGroupPeer `json:"producers"`
+
+	// consumers selects the pods that are allowed to join the multicast groups
+	// and receive the traffic sent by the producers of this group.
+	// A pod is selected if it matches any of the entries.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Consumers []MulticastGroupPeer `json:"consumers"`
+
+	// groups optionally limits the multicast traffic allowed from producers to
+	// consumers to the given multicast group addresses, IPv4 and/or IPv6.
+	// When groups is not set, traffic to any multicast group is allowed.
+	// For an IP family without any group listed here, no multicast traffic
+	// is allowed by this MulticastGroup when groups is set.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Groups []MulticastGroupAddress `json:"groups,omitempty"`
+}
+
+// MulticastGroupPeer selects pods across namespaces.
+type MulticastGroupPeer struct {
+	// namespaceSelector selects the namespaces of the pods.
+	// An empty selector selects all namespaces.
+	// +kubebuilder:validation:Required
+	// +required
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// podSelector selects the pods in the namespaces selected by namespaceSelector.
+	// When not set, all pods in the selected namespaces are selected.
+	// +optional
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// MulticastGroupAddress is an IPv4 (224.0.0.0/4) or IPv6 (ff00::/8) multicast group address.
+// +kubebuilder:validation:XValidation:rule="isIP(self) && (cidr('224.0.0.0/4').containsIP(self) || cidr('ff00::/8').containsIP(self))", message="group must be a valid IPv4 or IPv6 multicast address"
+// +kubebuilder:validation:MaxLength=45
+type MulticastGroupAddress string
+
+// MulticastGroupStatus defines the observed state of MulticastGroup.
+type MulticastGroupStatus struct {
+	// status is a concise indication of whether the MulticastGroup
+	// resource is applied with success in all zones.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// conditions is an array of condition objects indicating details about
+	// status of MulticastGroup object, one per zone.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// MulticastGroupList contains a list of MulticastGroup.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MulticastGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MulticastGroup `json:"items"`
+}
@@ -12,7 +12,12 @@ import (
 // ClassifierApplyConfiguration represents a declarative configuration of the Classifier type for use
 // with apply.
 type ClassifierApplyConfiguration struct {
-	To    []DestinationApplyConfiguration `json:"to,omitempty"`
+	// to the destinations of the egress traffic the rule applies to.
+	// Only allowed in egress rules.
+	To []DestinationApplyConfiguration `json:"to,omitempty"`
+	// from the sources of the ingress traffic the rule applies to.
+	// Only allowed in ingress rules.
+	From  []DestinationApplyConfiguration `json:"from,omitempty"`
 	Ports []*networkqosv1alpha1.Port      `json:"ports,omitempty"`
 }
 
@@ -35,6 +40,19 @@ func (b *ClassifierApplyConfiguration) WithTo(values ...*DestinationApplyConfigu
 	return b
 }
 
+// WithFrom adds the given value to the From field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the From field.
+func (b *ClassifierApplyConfiguration) WithFrom(values ...*DestinationApplyConfiguration) *ClassifierApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFrom")
+		}
+		b.From = append(b.From, *values[i])
+	}
+	return b
+}
+
 // WithPorts adds the given value to the Ports field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Ports field.
@@ -13,7 +13,8 @@ import (
 // DestinationApplyConfiguration represents a declarative configuration of the Destination type for use
 // with apply.
 //
-// Destination describes a peer to apply NetworkQoS configuration for the outgoing traffic.
+// Destination describes a peer to apply NetworkQoS configuration for the outgoing traffic,
+// or for the incoming traffic when used in the from list of an ingress rule.
 // Only certain combinations of fields are allowed.
 type DestinationApplyConfiguration struct {
 	// podSelector is a label selector which selects pods. This field follows standard label
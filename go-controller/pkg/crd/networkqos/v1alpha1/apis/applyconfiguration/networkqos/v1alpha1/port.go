@@ -9,7 +9,8 @@ package v1alpha1
 // with apply.
 //
 // Port specifies destination protocol and port on which NetworkQoS
-// rule is applied
+// rule is applied. For ingress rules, this is the protocol and port
+// of the selected pods.
 type PortApplyConfiguration struct {
 	// protocol (tcp, udp, sctp) that the traffic must match.
 	Protocol *string `json:"protocol,omitempty"`
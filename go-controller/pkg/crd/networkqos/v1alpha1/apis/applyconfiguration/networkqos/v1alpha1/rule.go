@@ -13,7 +13,8 @@ type RuleApplyConfiguration struct {
 	// classifier The classifier on which packets should match
 	// to apply the NetworkQoS Rule.
 	// This field is optional, and in case it is not set the rule is applied
-	// to all egress traffic regardless of the destination.
+	// to all egress traffic regardless of the destination, or to all ingress
+	// traffic regardless of the source.
 	Classifier *ClassifierApplyConfiguration `json:"classifier,omitempty"`
 	Bandwidth  *BandwidthApplyConfiguration  `json:"bandwidth,omitempty"`
 }
@@ -30,7 +30,14 @@ type SpecApplyConfiguration struct {
 	// within a single NetworkQos object (all of which share the priority) will be
 	// determined by the order in which the rule is written. Thus, a rule that appears
 	// first in the list of egress rules would take the lower precedence.
+	// Egress rules classify the traffic by destination, so classifier.from can't be set.
 	Egress []RuleApplyConfiguration `json:"egress,omitempty"`
+	// ingress a collection of Ingress NetworkQoS rule objects, applied to the traffic
+	// received by the selected pods. A total of 20 rules will be allowed in each
+	// NetworkQoS instance. The relative precedence of ingress rules within a single
+	// NetworkQoS object follows the same order semantics as the egress rules.
+	// Ingress rules classify the traffic by source, so classifier.to can't be set.
+	Ingress []RuleApplyConfiguration `json:"ingress,omitempty"`
 }
 
 // SpecApplyConfiguration constructs a declarative configuration of the Spec type for use with
@@ -75,3 +82,16 @@ func (b *SpecApplyConfiguration) WithEgress(values ...*RuleApplyConfiguration) *
 	}
 	return b
 }
+
+// WithIngress adds the given value to the Ingress field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ingress field.
+func (b *SpecApplyConfiguration) WithIngress(values ...*RuleApplyConfiguration) *SpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIngress")
+		}
+		b.Ingress = append(b.Ingress, *values[i])
+	}
+	return b
+}
@@ -48,6 +48,7 @@ type NetworkQoS struct {
 }
 
 // Spec defines the desired state of NetworkQoS
+// +kubebuilder:validation:XValidation:rule="has(self.egress) && size(self.egress) > 0 || has(self.ingress) && size(self.ingress) > 0", message="at least one egress or ingress rule is required"
 type Spec struct {
 	// networkSelector selects the networks on which the pod IPs need to be added to the source address set.
 	// NetworkQoS controller currently supports `NetworkAttachmentDefinitions` type only.
@@ -73,8 +74,21 @@ type Spec struct {
 	// within a single NetworkQos object (all of which share the priority) will be
 	// determined by the order in which the rule is written. Thus, a rule that appears
 	// first in the list of egress rules would take the lower precedence.
+	// Egress rules classify the traffic by destination, so classifier.from can't be set.
 	// +kubebuilder:validation:MaxItems=20
-	Egress []Rule `json:"egress"`
+	// +kubebuilder:validation:XValidation:rule="self.all(rule, !has(rule.classifier) || !has(rule.classifier.from) || size(rule.classifier.from) == 0)", message="classifier.from is not allowed in egress rules"
+	// +optional
+	Egress []Rule `json:"egress,omitempty"`
+
+	// ingress a collection of Ingress NetworkQoS rule objects, applied to the traffic
+	// received by the selected pods. A total of 20 rules will be allowed in each
+	// NetworkQoS instance. The relative precedence of ingress rules within a single
+	// NetworkQoS object follows the same order semantics as the egress rules.
+	// Ingress rules classify the traffic by source, so classifier.to can't be set.
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:XValidation:rule="self.all(rule, !has(rule.classifier) || !has(rule.classifier.to) || size(rule.classifier.to) == 0)", message="classifier.to is not allowed in ingress rules"
+	// +optional
+	Ingress []Rule `json:"ingress,omitempty"`
 }
 
 type Rule struct {
@@ -86,7 +100,8 @@ type Rule struct {
 	// classifier The classifier on which packets should match
 	// to apply the NetworkQoS Rule.
 	// This field is optional, and in case it is not set the rule is applied
-	// to all egress traffic regardless of the destination.
+	// to all egress traffic regardless of the destination, or to all ingress
+	// traffic regardless of the source.
 	// +optional
 	Classifier Classifier `json:"classifier"`
 
@@ -95,9 +110,16 @@ type Rule struct {
 }
 
 type Classifier struct {
+	// to the destinations of the egress traffic the rule applies to.
+	// Only allowed in egress rules.
 	// +optional
 	To []Destination `json:"to"`
 
+	// from the sources of the ingress traffic the rule applies to.
+	// Only allowed in ingress rules.
+	// +optional
+	From []Destination `json:"from,omitempty"`
+
 	// +optional
 	Ports []*Port `json:"ports"`
 }
@@ -121,7 +143,8 @@ type Bandwidth struct {
 }
 
 // Port specifies destination protocol and port on which NetworkQoS
-// rule is applied
+// rule is applied. For ingress rules, this is the protocol and port
+// of the selected pods.
 type Port struct {
 	// protocol (tcp, udp, sctp) that the traffic must match.
 	// +kubebuilder:validation:Pattern=^TCP|UDP|SCTP$
@@ -135,7 +158,8 @@ type Port struct {
 	Port *int32 `json:"port"`
 }
 
-// Destination describes a peer to apply NetworkQoS configuration for the outgoing traffic.
+// Destination describes a peer to apply NetworkQoS configuration for the outgoing traffic,
+// or for the incoming traffic when used in the from list of an ingress rule.
 // Only certain combinations of fields are allowed.
 // +kubebuilder:validation:XValidation:rule="!(has(self.ipBlock) && (has(self.podSelector) || has(self.namespaceSelector)))",message="Can't specify both podSelector/namespaceSelector and ipBlock"
 type Destination struct {
@@ -41,6 +41,13 @@ func (in *Classifier) DeepCopyInto(out *Classifier) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]Destination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]*Port, len(*in))
@@ -214,6 +221,13 @@ func (in *Spec) DeepCopyInto(out *Spec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -22,6 +22,11 @@ type RouteAdvertisementsStatusApplyConfiguration struct {
 	// conditions is an array of condition objects indicating details about
 	// status of RouteAdvertisements object.
 	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// routeReflectors lists the names of the nodes acting as BGP route
+	// reflectors for the cluster internal BGP fabric. Only set on the
+	// RouteAdvertisements managed by ovn-kubernetes when the managed BGP
+	// topology is route-reflector.
+	RouteReflectors []string `json:"routeReflectors,omitempty"`
 }
 
 // RouteAdvertisementsStatusApplyConfiguration constructs a declarative configuration of the RouteAdvertisementsStatus type for use with
@@ -50,3 +55,13 @@ func (b *RouteAdvertisementsStatusApplyConfiguration) WithConditions(values ...*
 	}
 	return b
 }
+
+// WithRouteReflectors adds the given value to the RouteReflectors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RouteReflectors field.
+func (b *RouteAdvertisementsStatusApplyConfiguration) WithRouteReflectors(values ...string) *RouteAdvertisementsStatusApplyConfiguration {
+	for i := range values {
+		b.RouteReflectors = append(b.RouteReflectors, values[i])
+	}
+	return b
+}
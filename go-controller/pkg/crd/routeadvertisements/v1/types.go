@@ -93,6 +93,14 @@ type RouteAdvertisementsStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// routeReflectors lists the names of the nodes acting as BGP route
+	// reflectors for the cluster internal BGP fabric. Only set on the
+	// RouteAdvertisements managed by ovn-kubernetes when the managed BGP
+	// topology is route-reflector.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	RouteReflectors []string `json:"routeReflectors,omitempty"`
 }
 
 // RouteAdvertisementsList contains a list of RouteAdvertisements
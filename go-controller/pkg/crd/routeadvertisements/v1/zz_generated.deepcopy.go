@@ -115,6 +115,11 @@ func (in *RouteAdvertisementsStatus) DeepCopyInto(out *RouteAdvertisementsStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RouteReflectors != nil {
+		in, out := &in.RouteReflectors, &out.RouteReflectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Uplink is a named, cluster-scoped connectivity target that primary
+// ClusterUserDefinedNetworks can select for their north/south traffic in
+// shared gateway mode, instead of the default external bridge.
+// The backing OVS bridges are pre-provisioned by the administrator;
+// OVN-Kubernetes only discovers and validates them and reports the per node
+// result in UplinkState objects.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=uplinks,scope=Cluster,singular=uplink
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Degraded",type=string,JSONPath=".status.conditions[?(@.type==\"Degraded\")].status"
+type Uplink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +required
+	Spec UplinkSpec `json:"spec"`
+	// +optional
+	Status UplinkStatus `json:"status,omitempty"`
+}
+
+// UplinkSpec defines the desired state of Uplink.
+type UplinkSpec struct {
+	// nodeConfigs is a per node selection table describing the link this
+	// Uplink uses on the nodes selected by each entry. At most one entry is
+	// expected to select a given node; nodes selected by more than one entry
+	// are reported as degraded.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +required
+	NodeConfigs []UplinkNodeConfig `json:"nodeConfigs"`
+}
+
+// UplinkType is the type of an uplink node config.
+// +kubebuilder:validation:Enum=OVSBridge
+type UplinkType string
+
+const (
+	// UplinkTypeOVSBridge is an uplink backed by a pre-existing OVS bridge.
+	UplinkTypeOVSBridge UplinkType = "OVSBridge"
+)
+
+// UplinkNodeConfig describes the link an Uplink uses on a group of nodes.
+// +union
+type UplinkNodeConfig struct {
+	// type is the uplink type. Only OVSBridge is supported.
+	// +kubebuilder:validation:Required
+	// +required
+	// +unionDiscriminator
+	Type UplinkType `json:"type"`
+
+	// nodeSelector selects the nodes this config applies to. An empty
+	// selector matches all nodes.
+	// +kubebuilder:validation:Required
+	// +required
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+
+	// hostInterfaceName is the host visible Linux interface carrying the
+	// gateway L3 identity of this uplink on the selected nodes, typically the
+	// LOCAL interface of the OVS bridge or an internal port of it. The backing
+	// OVS bridge is resolved from this interface.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_.-]+$`
+	// +required
+	HostInterfaceName string `json:"hostInterfaceName"`
+}
+
+const (
+	// UplinkDegraded is the Uplink condition type reporting that at least one
+	// selected node has a problem with its UplinkState.
+	UplinkDegraded = "Degraded"
+)
+
+// UplinkStatus defines the observed state of Uplink.
+type UplinkStatus struct {
+	// conditions reports the aggregate health of the Uplink across the nodes
+	// it selects.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// UplinkList contains a list of Uplink.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type UplinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Uplink `json:"items"`
+}
+
+// UplinkState holds the discovery and gateway state of an Uplink on a node.
+// It is created and updated by OVN-Kubernetes, one per Uplink and node.
+// Controllers use status.uplinkName and status.nodeName as the identity of
+// the object rather than its name.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=uplinkstates,scope=Cluster,singular=uplinkstate
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Uplink",type=string,JSONPath=".status.uplinkName"
+// +kubebuilder:printcolumn:name="Node",type=string,JSONPath=".status.nodeName"
+// +kubebuilder:printcolumn:name="Bridge",type=string,JSONPath=".status.ovsBridge.name"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+type UplinkState struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Status UplinkStateStatus `json:"status,omitempty"`
+}
+
+// OVSBridgeState is the resolved state of an OVSBridge uplink.
+type OVSBridgeState struct {
+	// name is the OVS bridge backing the uplink on the node.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+const (
+	// UplinkStateReady is the UplinkState condition type reporting whether
+	// discovery completed for the Uplink on the node.
+	UplinkStateReady = "Ready"
+
+	// UplinkStateReasonReady means discovery is complete.
+	UplinkStateReasonReady = "Ready"
+	// UplinkStateReasonHostInterfaceNotFound means the host interface does not
+	// exist on the node.
+	UplinkStateReasonHostInterfaceNotFound = "HostInterfaceNotFound"
+	// UplinkStateReasonBridgeNotFound means the host interface is not backed
+	// by an OVS bridge.
+	UplinkStateReasonBridgeNotFound = "BridgeNotFound"
+	// UplinkStateReasonBridgeInvalid means the bridge has an unsupported
+	// layout.
+	UplinkStateReasonBridgeInvalid = "BridgeInvalid"
+	// UplinkStateReasonMTUInvalid means the host interface MTU is smaller than
+	// the MTU the networks need to carry.
+	UplinkStateReasonMTUInvalid = "MTUInvalid"
+	// UplinkStateReasonGatewayInfoUnavailable means the MAC or IP addresses
+	// needed for the gateway could not be discovered.
+	UplinkStateReasonGatewayInfoUnavailable = "GatewayInfoUnavailable"
+	// UplinkStateReasonNodeSelectorOverlap means more than one node config of
+	// the Uplink selects the node.
+	UplinkStateReasonNodeSelectorOverlap = "NodeSelectorOverlap"
+)
+
+// UplinkStateStatus defines the observed state of an Uplink on a node.
+type UplinkStateStatus struct {
+	// uplinkName is the Uplink this state belongs to.
+	// +optional
+	UplinkName string `json:"uplinkName,omitempty"`
+
+	// nodeName is the node this state belongs to.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+
+	// type is the resolved uplink type.
+	// +optional
+	Type UplinkType `json:"type,omitempty"`
+
+	// hostInterfaceName is the host interface selected by the Uplink node
+	// config for this node.
+	// +optional
+	HostInterfaceName string `json:"hostInterfaceName,omitempty"`
+
+	// ovsBridge is the resolved OVS bridge data, set when type is OVSBridge.
+	// +optional
+	OVSBridge *OVSBridgeState `json:"ovsBridge,omitempty"`
+
+	// macAddress is the MAC address of the host interface, used for the OVN
+	// gateway router port on this bridge.
+	// +optional
+	MACAddress string `json:"macAddress,omitempty"`
+
+	// ipAddresses are the host gateway IP addresses, in CIDR notation,
+	// discovered from the host interface.
+	// +listType=atomic
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// defaultGateways are the next hops of the default routes through the
+	// host interface, when present.
+	// +listType=atomic
+	// +optional
+	DefaultGateways []string `json:"defaultGateways,omitempty"`
+
+	// conditions reports the node local discovery state with a single Ready
+	// condition.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// UplinkStateList contains a list of UplinkState.
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type UplinkStateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UplinkState `json:"items"`
+}
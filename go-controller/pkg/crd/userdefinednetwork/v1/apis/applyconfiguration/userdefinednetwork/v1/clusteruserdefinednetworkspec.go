@@ -6,6 +6,7 @@
 package v1
 
 import (
+	userdefinednetworkv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -18,6 +19,10 @@ type ClusterUserDefinedNetworkSpecApplyConfiguration struct {
 	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
 	// Network is the user-defined-network spec
 	Network *NetworkSpecApplyConfiguration `json:"network,omitempty"`
+	// Uplinks references the Uplinks the network uses for its north/south
+	// traffic instead of the default external bridge.
+	// Only supported for primary Layer2 and Layer3 networks in shared gateway mode.
+	Uplinks []userdefinednetworkv1.UplinkName `json:"uplinks,omitempty"`
 }
 
 // ClusterUserDefinedNetworkSpecApplyConfiguration constructs a declarative configuration of the ClusterUserDefinedNetworkSpec type for use with
@@ -41,3 +46,13 @@ func (b *ClusterUserDefinedNetworkSpecApplyConfiguration) WithNetwork(value *Net
 	b.Network = value
 	return b
 }
+
+// WithUplinks adds the given value to the Uplinks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Uplinks field.
+func (b *ClusterUserDefinedNetworkSpecApplyConfiguration) WithUplinks(values ...userdefinednetworkv1.UplinkName) *ClusterUserDefinedNetworkSpecApplyConfiguration {
+	for i := range values {
+		b.Uplinks = append(b.Uplinks, values[i])
+	}
+	return b
+}
@@ -21,11 +21,16 @@ type Layer2ConfigApplyConfiguration struct {
 	// MTU is optional, if not provided, the globally configured value in OVN-Kubernetes (defaults to 1400) is used for the network.
 	MTU *int32 `json:"mtu,omitempty"`
 	// Subnets are used for the pod network across the cluster.
-	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
+	// Secondary networks may set 2 subnets in dual-stack clusters (one for each IP family), otherwise only 1 subnet is allowed.
+	// Primary networks may set multiple subnets for each IP family. Pods get a single IP for each IP family, allocated
+	// from the first subnet of that family that is not full.
+	//
+	// Subnets of Primary networks can be appended for the existing IP families, but can't be removed.
+	// Subnets of Secondary networks are immutable.
 	//
 	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
 	// This field must be omitted if `ipam.mode` is `Disabled`.
-	Subnets *userdefinednetworkv1.DualStackCIDRs `json:"subnets,omitempty"`
+	Subnets []userdefinednetworkv1.CIDR `json:"subnets,omitempty"`
 	// reservedSubnets specifies a list of CIDRs reserved for static IP assignment, excluded from automatic allocation.
 	// reservedSubnets is optional. When omitted, all IP addresses in `subnets` are available for automatic assignment.
 	// IPs from these ranges can still be requested through static IP assignment.
@@ -51,6 +56,7 @@ type Layer2ConfigApplyConfiguration struct {
 	// This field is only allowed for "Primary" network.
 	// It is not recommended to set this field without explicit need and understanding of the OVN network topology.
 	// When omitted, an IP from the subnets field is used.
+	// Subnets appended to the network use an IP from the appended subnet.
 	DefaultGatewayIPs *userdefinednetworkv1.DualStackIPs `json:"defaultGatewayIPs,omitempty"`
 	// JoinSubnets are used inside the OVN network topology.
 	//
@@ -61,6 +67,11 @@ type Layer2ConfigApplyConfiguration struct {
 	JoinSubnets *userdefinednetworkv1.DualStackCIDRs `json:"joinSubnets,omitempty"`
 	// IPAM section contains IPAM-related configuration for the network.
 	IPAM *IPAMConfigApplyConfiguration `json:"ipam,omitempty"`
+	// dhcpOptions configures additional DHCP and IPv6 router advertisement options served to the KubeVirt
+	// virtual machines attached to the network.
+	// Virtual machines may override them with the `k8s.ovn.org/dhcp-options` annotation.
+	// This field is only allowed for "Primary" network.
+	DHCPOptions *DHCPOptionsApplyConfiguration `json:"dhcpOptions,omitempty"`
 }
 
 // Layer2ConfigApplyConfiguration constructs a declarative configuration of the Layer2Config type for use with
@@ -85,11 +96,13 @@ func (b *Layer2ConfigApplyConfiguration) WithMTU(value int32) *Layer2ConfigApply
 	return b
 }
 
-// WithSubnets sets the Subnets field in the declarative configuration to the given value
-// and returns the receiver, so that objects can be built by chaining "With" function invocations.
-// If called multiple times, the Subnets field is set to the value of the last call.
-func (b *Layer2ConfigApplyConfiguration) WithSubnets(value userdefinednetworkv1.DualStackCIDRs) *Layer2ConfigApplyConfiguration {
-	b.Subnets = &value
+// WithSubnets adds the given value to the Subnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Subnets field.
+func (b *Layer2ConfigApplyConfiguration) WithSubnets(values ...userdefinednetworkv1.CIDR) *Layer2ConfigApplyConfiguration {
+	for i := range values {
+		b.Subnets = append(b.Subnets, values[i])
+	}
 	return b
 }
 
@@ -136,3 +149,11 @@ func (b *Layer2ConfigApplyConfiguration) WithIPAM(value *IPAMConfigApplyConfigur
 	b.IPAM = value
 	return b
 }
+
+// WithDHCPOptions sets the DHCPOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DHCPOptions field is set to the value of the last call.
+func (b *Layer2ConfigApplyConfiguration) WithDHCPOptions(value *DHCPOptionsApplyConfiguration) *Layer2ConfigApplyConfiguration {
+	b.DHCPOptions = value
+	return b
+}
@@ -71,8 +71,12 @@ type LocalnetConfigApplyConfiguration struct {
 	// vlan configuration for the network.
 	// vlan.mode is the VLAN mode.
 	// When "Access" is set, OVN-Kubernetes configures the network logical switch port in access mode.
+	// When "Trunk" is set, OVN-Kubernetes configures the network logical switch port in trunk mode.
 	// vlan.access is the access VLAN configuration.
 	// vlan.access.id is the VLAN ID (VID) to be set on the network logical switch port.
+	// vlan.trunk is the trunk VLAN configuration.
+	// vlan.trunk.allowedVLANs are the VLAN IDs (VIDs) whose tagged traffic is carried by the network logical switch port.
+	// vlan.trunk.nativeVLAN is the VLAN ID (VID) untagged traffic is assigned to.
 	// vlan is optional, when omitted the underlying network default VLAN will be used (usually `1`).
 	// When set, OVN-Kubernetes will apply VLAN configuration to the SDN infra and to the connected pods.
 	VLAN *VLANConfigApplyConfiguration `json:"vlan,omitempty"`
@@ -15,11 +15,14 @@ import (
 // VLANConfig describes the network VLAN configuration.
 type VLANConfigApplyConfiguration struct {
 	// mode describe the network VLAN mode.
-	// Allowed value is "Access".
+	// Allowed values are "Access" and "Trunk".
 	// Access sets the network logical switch port in access mode, according to the config.
+	// Trunk sets the network logical switch port in trunk mode, according to the config.
 	Mode *userdefinednetworkv1.VLANMode `json:"mode,omitempty"`
 	// Access is the access VLAN configuration
 	Access *AccessVLANConfigApplyConfiguration `json:"access,omitempty"`
+	// Trunk is the trunk VLAN configuration
+	Trunk *TrunkVLANConfigApplyConfiguration `json:"trunk,omitempty"`
 }
 
 // VLANConfigApplyConfiguration constructs a declarative configuration of the VLANConfig type for use with
@@ -43,3 +46,11 @@ func (b *VLANConfigApplyConfiguration) WithAccess(value *AccessVLANConfigApplyCo
 	b.Access = value
 	return b
 }
+
+// WithTrunk sets the Trunk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Trunk field is set to the value of the last call.
+func (b *VLANConfigApplyConfiguration) WithTrunk(value *TrunkVLANConfigApplyConfiguration) *VLANConfigApplyConfiguration {
+	b.Trunk = value
+	return b
+}
@@ -27,6 +27,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &userdefinednetworkv1.ClusterUserDefinedNetworkSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetworkStatus"):
 		return &userdefinednetworkv1.ClusterUserDefinedNetworkStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DHCPExtraOption"):
+		return &userdefinednetworkv1.DHCPExtraOptionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DHCPOptions"):
+		return &userdefinednetworkv1.DHCPOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DHCPRoute"):
+		return &userdefinednetworkv1.DHCPRouteApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EVPNConfig"):
 		return &userdefinednetworkv1.EVPNConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("IPAMConfig"):
@@ -43,6 +49,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &userdefinednetworkv1.NetworkSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NoOverlayConfig"):
 		return &userdefinednetworkv1.NoOverlayConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TrunkVLANConfig"):
+		return &userdefinednetworkv1.TrunkVLANConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetwork"):
 		return &userdefinednetworkv1.UserDefinedNetworkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkSpec"):
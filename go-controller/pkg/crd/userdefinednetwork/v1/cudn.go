@@ -25,6 +25,9 @@ type ClusterUserDefinedNetwork struct {
 }
 
 // ClusterUserDefinedNetworkSpec defines the desired state of ClusterUserDefinedNetwork.
+// +kubebuilder:validation:XValidation:rule="!has(self.uplinks) || size(self.uplinks) == 0 || ((self.network.topology == 'Layer2' && has(self.network.layer2) && self.network.layer2.role == 'Primary') || (self.network.topology == 'Layer3' && has(self.network.layer3) && self.network.layer3.role == 'Primary'))", message="uplinks are only supported for Layer2 or Layer3 primary networks"
+// +kubebuilder:validation:XValidation:rule="!has(self.uplinks) || size(self.uplinks) == 0 || !has(self.network.transport) || self.network.transport != 'EVPN'", message="uplinks are not supported with transport 'EVPN'"
+// +kubebuilder:validation:XValidation:rule="has(self.uplinks) == has(oldSelf.uplinks)", message="uplinks is immutable"
 type ClusterUserDefinedNetworkSpec struct {
 	// NamespaceSelector Label selector for which namespace network should be available for.
 	// +kubebuilder:validation:Required
@@ -47,8 +50,22 @@ type ClusterUserDefinedNetworkSpec struct {
 	// +kubebuilder:validation:XValidation:rule="!has(self.transport) || self.transport != 'EVPN' || self.topology != 'Layer3' || !has(self.evpn) || !has(self.evpn.macVRF)", message="spec.evpn.macVRF field is forbidden for Layer3 topology when transport is 'EVPN'"
 	// +required
 	Network NetworkSpec `json:"network"`
+
+	// Uplinks references the Uplinks the network uses for its north/south
+	// traffic instead of the default external bridge.
+	// Only supported for primary Layer2 and Layer3 networks in shared gateway mode.
+	// +kubebuilder:validation:MaxItems=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="uplinks is immutable"
+	// +listType=atomic
+	// +optional
+	Uplinks []UplinkName `json:"uplinks,omitempty"`
 }
 
+// UplinkName is the name of an Uplink.
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+type UplinkName string
+
 // NetworkSpec defines the desired state of UserDefinedNetworkSpec.
 // +union
 type NetworkSpec struct {
@@ -71,7 +88,6 @@ type NetworkSpec struct {
 	Layer3 *Layer3Config `json:"layer3,omitempty"`
 
 	// Layer2 is the Layer2 topology configuration.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Layer2 is immutable"
 	// +optional
 	Layer2 *Layer2Config `json:"layer2,omitempty"`
 
@@ -213,8 +229,12 @@ type LocalnetConfig struct {
 	// vlan configuration for the network.
 	// vlan.mode is the VLAN mode.
 	//   When "Access" is set, OVN-Kubernetes configures the network logical switch port in access mode.
+	//   When "Trunk" is set, OVN-Kubernetes configures the network logical switch port in trunk mode.
 	// vlan.access is the access VLAN configuration.
 	// vlan.access.id is the VLAN ID (VID) to be set on the network logical switch port.
+	// vlan.trunk is the trunk VLAN configuration.
+	// vlan.trunk.allowedVLANs are the VLAN IDs (VIDs) whose tagged traffic is carried by the network logical switch port.
+	// vlan.trunk.nativeVLAN is the VLAN ID (VID) untagged traffic is assigned to.
 	// vlan is optional, when omitted the underlying network default VLAN will be used (usually `1`).
 	// When set, OVN-Kubernetes will apply VLAN configuration to the SDN infra and to the connected pods.
 	//
@@ -232,18 +252,46 @@ type AccessVLANConfig struct {
 	ID int32 `json:"id"`
 }
 
-// +kubebuilder:validation:Enum=Access
+// TrunkVLANConfig describes a trunk VLAN configuration.
+// +kubebuilder:validation:XValidation:rule="!has(self.nativeVLAN) || !(self.nativeVLAN in self.allowedVLANs)", message="nativeVLAN must not be one of the allowedVLANs"
+type TrunkVLANConfig struct {
+	// allowedVLANs is the list of VLAN IDs (VIDs) whose tagged traffic is carried by the network.
+	// Tagged traffic of any other VLAN is dropped.
+	// Every VLAN ID should be higher than 0 and lower than 4095.
+	// +required
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4094
+	// +kubebuilder:validation:items:Minimum=1
+	// +kubebuilder:validation:items:Maximum=4094
+	AllowedVLANs []int32 `json:"allowedVLANs"`
+
+	// nativeVLAN is the VLAN ID (VID) untagged traffic of the network is assigned to.
+	// nativeVLAN is optional, when omitted untagged traffic is sent untagged to the underlying network.
+	// nativeVLAN should be higher than 0 and lower than 4095.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	NativeVLAN int32 `json:"nativeVLAN,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Access;Trunk
 type VLANMode string
 
-const VLANModeAccess VLANMode = "Access"
+const (
+	VLANModeAccess VLANMode = "Access"
+	VLANModeTrunk  VLANMode = "Trunk"
+)
 
 // VLANConfig describes the network VLAN configuration.
 // +union
 // +kubebuilder:validation:XValidation:rule="has(self.mode) && self.mode == 'Access' ? has(self.access): !has(self.access)", message="vlan access config is required when vlan mode is 'Access', and forbidden otherwise"
+// +kubebuilder:validation:XValidation:rule="has(self.mode) && self.mode == 'Trunk' ? has(self.trunk): !has(self.trunk)", message="vlan trunk config is required when vlan mode is 'Trunk', and forbidden otherwise"
 type VLANConfig struct {
 	// mode describe the network VLAN mode.
-	// Allowed value is "Access".
+	// Allowed values are "Access" and "Trunk".
 	// Access sets the network logical switch port in access mode, according to the config.
+	// Trunk sets the network logical switch port in trunk mode, according to the config.
 	// +required
 	// +unionDiscriminator
 	Mode VLANMode `json:"mode"`
@@ -251,6 +299,10 @@ type VLANConfig struct {
 	// Access is the access VLAN configuration
 	// +optional
 	Access *AccessVLANConfig `json:"access"`
+
+	// Trunk is the trunk VLAN configuration
+	// +optional
+	Trunk *TrunkVLANConfig `json:"trunk,omitempty"`
 }
 
 type TransportOption string
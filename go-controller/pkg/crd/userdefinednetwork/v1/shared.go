@@ -107,7 +107,7 @@ type Layer3Subnet struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i) && cidr(i).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
 // +kubebuilder:validation:XValidation:rule="!has(self.defaultGatewayIPs) || has(self.role) && self.role == 'Primary'", message="defaultGatewayIPs is only supported for Primary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.defaultGatewayIPs) || self.defaultGatewayIPs.all(ip, self.subnets.exists(subnet, cidr(subnet).containsIP(ip)))", message="defaultGatewayIPs must belong to one of the subnets specified in the subnets field"
-// +kubebuilder:validation:XValidation:rule="!has(self.defaultGatewayIPs) || size(self.defaultGatewayIPs) == size(self.subnets)", message="defaultGatewayIPs must be specified for all IP families"
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultGatewayIPs) || self.subnets.all(subnet, self.defaultGatewayIPs.exists(gw, ip(gw).family() == cidr(subnet).ip().family()))", message="defaultGatewayIPs must be specified for all IP families"
 // +kubebuilder:validation:XValidation:rule="!has(self.reservedSubnets) || has(self.subnets)", message="reservedSubnets must be unset when subnets is unset"
 // +kubebuilder:validation:XValidation:rule="!has(self.reservedSubnets) || has(self.role) && self.role == 'Primary'", message="reservedSubnets is only supported for Primary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.infrastructureSubnets) || has(self.subnets)", message="infrastructureSubnets must be unset when subnets is unset"
@@ -118,6 +118,17 @@ type Layer3Subnet struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.infrastructureSubnets) || !has(self.reservedSubnets) || self.infrastructureSubnets.all(infra, !self.reservedSubnets.exists(reserved, cidr(infra).containsCIDR(reserved) || cidr(reserved).containsCIDR(infra)))", message="infrastructureSubnets and reservedSubnets must not overlap"
 // +kubebuilder:validation:XValidation:rule="!has(self.infrastructureSubnets) || self.infrastructureSubnets.all(s, isCIDR(s) && cidr(s) == cidr(s).masked())", message="infrastructureSubnets must be a masked network address (no host bits set)"
 // +kubebuilder:validation:XValidation:rule="!has(self.reservedSubnets) || self.reservedSubnets.all(s, isCIDR(s) && cidr(s) == cidr(s).masked())", message="reservedSubnets must be a masked network address (no host bits set)"
+// +kubebuilder:validation:XValidation:rule="self.role != 'Secondary' || has(self.subnets) == has(oldSelf.subnets) && (!has(self.subnets) || self.subnets == oldSelf.subnets)", message="Subnets is immutable for Secondary role"
+// +kubebuilder:validation:XValidation:rule="self.role != 'Secondary' || !has(self.subnets) || self.subnets.size() <= 2", message="Secondary networks may define at most 2 subnets"
+// +kubebuilder:validation:XValidation:rule="self.role != 'Secondary' || !has(self.subnets) || size(self.subnets) != 2 || !isCIDR(self.subnets[0]) || !isCIDR(self.subnets[1]) || cidr(self.subnets[0]).ip().family() != cidr(self.subnets[1]).ip().family()", message="When 2 CIDRs are set for Secondary networks, they must be from different IP families"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.subnets) || has(self.subnets) && oldSelf.subnets.all(old, self.subnets.exists(new, new == old))", message="Removing existing subnets is not allowed"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.subnets) || !has(self.subnets) || self.subnets.all(new, oldSelf.subnets.exists(old, cidr(old).ip().family() == cidr(new).ip().family()))", message="Subnets can only be added for existing IP families"
+// +kubebuilder:validation:XValidation:rule="!has(self.subnets) || self.subnets.size() == 1 || !self.subnets.exists(i, self.subnets.exists(j, i != j && cidr(i).containsCIDR(j)))", message="Subnets must not overlap or contain each other"
+// +kubebuilder:validation:XValidation:rule="!has(self.subnets) || self.subnets.size() == 1 || !self.subnets.exists(i, self.subnets.filter(j, j == i).size() > 1)", message="Subnets with same CIDR are not allowed"
+// +kubebuilder:validation:XValidation:rule="has(self.mtu) == has(oldSelf.mtu) && has(self.joinSubnets) == has(oldSelf.joinSubnets) && has(self.ipam) == has(oldSelf.ipam)", message="mtu, joinSubnets and ipam cannot be added or removed"
+// +kubebuilder:validation:XValidation:rule="has(self.reservedSubnets) == has(oldSelf.reservedSubnets) && has(self.infrastructureSubnets) == has(oldSelf.infrastructureSubnets) && has(self.defaultGatewayIPs) == has(oldSelf.defaultGatewayIPs)", message="reservedSubnets, infrastructureSubnets and defaultGatewayIPs cannot be added or removed"
+// +kubebuilder:validation:XValidation:rule="!has(self.dhcpOptions) || has(self.role) && self.role == 'Primary'", message="dhcpOptions is only supported for Primary network"
+// +kubebuilder:validation:XValidation:rule="has(self.dhcpOptions) == has(oldSelf.dhcpOptions)", message="dhcpOptions cannot be added or removed"
 type Layer2Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -126,6 +137,7 @@ type Layer2Config struct {
 	//
 	// +kubebuilder:validation:Enum=Primary;Secondary
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="role is immutable"
 	// +required
 	Role NetworkRole `json:"role"`
 
@@ -134,17 +146,25 @@ type Layer2Config struct {
 	//
 	// +kubebuilder:validation:Minimum=576
 	// +kubebuilder:validation:Maximum=65536
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="mtu is immutable"
 	// +optional
 	MTU int32 `json:"mtu,omitempty"`
 
 	// Subnets are used for the pod network across the cluster.
-	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
+	// Secondary networks may set 2 subnets in dual-stack clusters (one for each IP family), otherwise only 1 subnet is allowed.
+	// Primary networks may set multiple subnets for each IP family. Pods get a single IP for each IP family, allocated
+	// from the first subnet of that family that is not full.
+	//
+	// Subnets of Primary networks can be appended for the existing IP families, but can't be removed.
+	// Subnets of Secondary networks are immutable.
 	//
 	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
 	// This field must be omitted if `ipam.mode` is `Disabled`.
 	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
 	// +optional
-	Subnets DualStackCIDRs `json:"subnets,omitempty"`
+	Subnets []CIDR `json:"subnets,omitempty"`
 
 	// reservedSubnets specifies a list of CIDRs reserved for static IP assignment, excluded from automatic allocation.
 	// reservedSubnets is optional. When omitted, all IP addresses in `subnets` are available for automatic assignment.
@@ -156,6 +176,7 @@ type Layer2Config struct {
 	// +optional
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=25
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="reservedSubnets is immutable"
 	ReservedSubnets []CIDR `json:"reservedSubnets,omitempty"`
 
 	// infrastructureSubnets specifies a list of internal CIDR ranges that OVN-Kubernetes will reserve for internal network infrastructure.
@@ -171,6 +192,7 @@ type Layer2Config struct {
 	// +optional
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=4
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="infrastructureSubnets is immutable"
 	InfrastructureSubnets []CIDR `json:"infrastructureSubnets,omitempty"`
 
 	// defaultGatewayIPs specifies the default gateway IP used in the internal OVN topology.
@@ -179,7 +201,9 @@ type Layer2Config struct {
 	// This field is only allowed for "Primary" network.
 	// It is not recommended to set this field without explicit need and understanding of the OVN network topology.
 	// When omitted, an IP from the subnets field is used.
+	// Subnets appended to the network use an IP from the appended subnet.
 	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="defaultGatewayIPs is immutable"
 	// +optional
 	DefaultGatewayIPs DualStackIPs `json:"defaultGatewayIPs,omitempty"`
 
@@ -190,14 +214,133 @@ type Layer2Config struct {
 	// It is not recommended to set this field without explicit need and understanding of the OVN network topology.
 	// When omitted, the platform will choose a reasonable default which is subject to change over time.
 	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="joinSubnets is immutable"
 	// +optional
 	JoinSubnets DualStackCIDRs `json:"joinSubnets,omitempty"`
 
 	// IPAM section contains IPAM-related configuration for the network.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="ipam is immutable"
 	// +optional
 	IPAM *IPAMConfig `json:"ipam,omitempty"`
+
+	// dhcpOptions configures additional DHCP and IPv6 router advertisement options served to the KubeVirt
+	// virtual machines attached to the network.
+	// Virtual machines may override them with the `k8s.ovn.org/dhcp-options` annotation.
+	// This field is only allowed for "Primary" network.
+	//
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="dhcpOptions is immutable"
+	// +optional
+	DHCPOptions *DHCPOptions `json:"dhcpOptions,omitempty"`
 }
 
+// DHCPOptions configures additional DHCP and IPv6 router advertisement options served to KubeVirt virtual machines.
+// +kubebuilder:validation:MinProperties=1
+type DHCPOptions struct {
+	// domainSearch is the list of DNS search domains.
+	// It is served with the DHCPv4 domain search option (119), the DHCPv6 domain search list option (24)
+	// and as DNSSL in IPv6 router advertisements.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=6
+	// +listType=set
+	// +optional
+	DomainSearch []DomainName `json:"domainSearch,omitempty"`
+
+	// dnsServers are the DNS servers served instead of the cluster DNS service.
+	// IPv4 servers are served with DHCPv4 and IPv6 servers with DHCPv6. The first IPv6 server is also advertised
+	// as RDNSS in IPv6 router advertisements.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=set
+	// +optional
+	DNSServers []IP `json:"dnsServers,omitempty"`
+
+	// ntpServers are the NTP servers served with the DHCPv4 NTP servers option (42).
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=set
+	// +optional
+	NTPServers []IPv4 `json:"ntpServers,omitempty"`
+
+	// routes are static routes served to the virtual machines.
+	// IPv4 routes are served with the DHCPv4 classless static route option (121). As clients ignore the router
+	// option when this option is served, a default route through the network gateway is added unless one is set.
+	// IPv6 routes are advertised through the network gateway with the route information option of IPv6 router
+	// advertisements.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	Routes []DHCPRoute `json:"routes,omitempty"`
+
+	// extraOptions are additional DHCP options, mostly needed for network boot.
+	// BootFileName and BootFileNameAlt are served with DHCPv4 and DHCPv6, the other options only with DHCPv4.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ExtraOptions []DHCPExtraOption `json:"extraOptions,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!isCIDR(self.destination) || cidr(self.destination).ip().family() != 4 || has(self.nextHop)", message="nextHop is required for IPv4 routes"
+// +kubebuilder:validation:XValidation:rule="!isCIDR(self.destination) || cidr(self.destination).ip().family() != 6 || !has(self.nextHop)", message="nextHop is not allowed for IPv6 routes"
+type DHCPRoute struct {
+	// destination is the destination CIDR of the route.
+	//
+	// +required
+	Destination CIDR `json:"destination"`
+
+	// nextHop is the next hop of an IPv4 route.
+	// IPv6 routes are advertised through the network gateway and must not set it.
+	//
+	// +optional
+	NextHop IPv4 `json:"nextHop,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!(self.name in ['TFTPServerAddress', 'NextServer']) || isIP(self.value) && ip(self.value).family() == 4", message="value must be an IPv4 address for TFTPServerAddress and NextServer"
+type DHCPExtraOption struct {
+	// name is the name of the option.
+	//
+	// +required
+	Name DHCPExtraOptionName `json:"name"`
+
+	// value is the value of the option. Quotes, backslashes and control characters are not allowed.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[^"\\\x00-\x1F\x7F]+$`
+	// +required
+	Value string `json:"value"`
+}
+
+// +kubebuilder:validation:Enum=BootFileName;BootFileNameAlt;TFTPServer;TFTPServerAddress;NextServer;DomainName;PathPrefix;WPAD
+type DHCPExtraOptionName string
+
+const (
+	DHCPExtraOptionBootFileName      DHCPExtraOptionName = "BootFileName"
+	DHCPExtraOptionBootFileNameAlt   DHCPExtraOptionName = "BootFileNameAlt"
+	DHCPExtraOptionTFTPServer        DHCPExtraOptionName = "TFTPServer"
+	DHCPExtraOptionTFTPServerAddress DHCPExtraOptionName = "TFTPServerAddress"
+	DHCPExtraOptionNextServer        DHCPExtraOptionName = "NextServer"
+	DHCPExtraOptionDomainName        DHCPExtraOptionName = "DomainName"
+	DHCPExtraOptionPathPrefix        DHCPExtraOptionName = "PathPrefix"
+	DHCPExtraOptionWPAD              DHCPExtraOptionName = "WPAD"
+)
+
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+type DomainName string
+
+// +kubebuilder:validation:XValidation:rule="isIP(self) && ip(self).family() == 4", message="IPv4 address is invalid"
+// +kubebuilder:validation:MaxLength=15
+type IPv4 string
+
 // +kubebuilder:validation:XValidation:rule="!has(self.lifecycle) || self.lifecycle != 'Persistent' || !has(self.mode) || self.mode == 'Enabled'", message="lifecycle Persistent is only supported when ipam.mode is Enabled"
 // +kubebuilder:validation:MinProperties=1
 type IPAMConfig struct {
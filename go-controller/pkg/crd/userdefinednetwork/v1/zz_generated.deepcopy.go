@@ -95,6 +95,11 @@ func (in *ClusterUserDefinedNetworkSpec) DeepCopyInto(out *ClusterUserDefinedNet
 	*out = *in
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
 	in.Network.DeepCopyInto(&out.Network)
+	if in.Uplinks != nil {
+		in, out := &in.Uplinks, &out.Uplinks
+		*out = make([]UplinkName, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -131,6 +136,79 @@ func (in *ClusterUserDefinedNetworkStatus) DeepCopy() *ClusterUserDefinedNetwork
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPExtraOption) DeepCopyInto(out *DHCPExtraOption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPExtraOption.
+func (in *DHCPExtraOption) DeepCopy() *DHCPExtraOption {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPExtraOption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
+	*out = *in
+	if in.DomainSearch != nil {
+		in, out := &in.DomainSearch, &out.DomainSearch
+		*out = make([]DomainName, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]IP, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]IPv4, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]DHCPRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraOptions != nil {
+		in, out := &in.ExtraOptions, &out.ExtraOptions
+		*out = make([]DHCPExtraOption, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptions.
+func (in *DHCPOptions) DeepCopy() *DHCPOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRoute) DeepCopyInto(out *DHCPRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRoute.
+func (in *DHCPRoute) DeepCopy() *DHCPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in DualStackCIDRs) DeepCopyInto(out *DualStackCIDRs) {
 	{
@@ -218,7 +296,7 @@ func (in *Layer2Config) DeepCopyInto(out *Layer2Config) {
 	*out = *in
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
-		*out = make(DualStackCIDRs, len(*in))
+		*out = make([]CIDR, len(*in))
 		copy(*out, *in)
 	}
 	if in.ReservedSubnets != nil {
@@ -246,6 +324,11 @@ func (in *Layer2Config) DeepCopyInto(out *Layer2Config) {
 		*out = new(IPAMConfig)
 		**out = **in
 	}
+	if in.DHCPOptions != nil {
+		in, out := &in.DHCPOptions, &out.DHCPOptions
+		*out = new(DHCPOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -394,6 +477,27 @@ func (in *NoOverlayConfig) DeepCopy() *NoOverlayConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrunkVLANConfig) DeepCopyInto(out *TrunkVLANConfig) {
+	*out = *in
+	if in.AllowedVLANs != nil {
+		in, out := &in.AllowedVLANs, &out.AllowedVLANs
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrunkVLANConfig.
+func (in *TrunkVLANConfig) DeepCopy() *TrunkVLANConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TrunkVLANConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserDefinedNetwork) DeepCopyInto(out *UserDefinedNetwork) {
 	*out = *in
@@ -512,6 +616,11 @@ func (in *VLANConfig) DeepCopyInto(out *VLANConfig) {
 		*out = new(AccessVLANConfig)
 		**out = **in
 	}
+	if in.Trunk != nil {
+		in, out := &in.Trunk, &out.Trunk
+		*out = new(TrunkVLANConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
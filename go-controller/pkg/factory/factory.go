@@ -37,6 +37,7 @@ import (
 	ocpnetworkinformerfactory "github.com/openshift/client-go/network/informers/externalversions"
 	ocpnetworkinformerv1alpha1 "github.com/openshift/client-go/network/informers/externalversions/network/v1alpha1"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	knet "k8s.io/api/networking/v1"
@@ -47,10 +48,12 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	utilwait "k8s.io/apimachinery/pkg/util/wait"
 	informerfactory "k8s.io/client-go/informers"
+	appsinformers "k8s.io/client-go/informers/apps/v1"
 	certificatesinformers "k8s.io/client-go/informers/certificates/v1"
 	v1coreinformers "k8s.io/client-go/informers/core/v1"
 	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
 	"k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
 	listers "k8s.io/client-go/listers/core/v1"
 	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	netlisters "k8s.io/client-go/listers/networking/v1"
@@ -60,6 +63,7 @@ import (
 	anpscheme "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/scheme"
 	anpinformerfactory "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions"
 	anpinformer "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha1"
+	cnpinformer "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha2"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	adminbasedpolicyapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
@@ -88,6 +92,10 @@ import (
 	egressservicescheme "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned/scheme"
 	egressserviceinformerfactory "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/informers/externalversions"
 	egressserviceinformer "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/informers/externalversions/egressservice/v1"
+	multicastgroupapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1"
+	multicastgroupscheme "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/clientset/versioned/scheme"
+	multicastgroupinformerfactory "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/informers/externalversions"
+	multicastgroupinformer "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/informers/externalversions/multicastgroup/v1alpha1"
 	networkqosapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	networkqosscheme "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/clientset/versioned/scheme"
 	networkqosinformerfactory "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/informers/externalversions"
@@ -97,6 +105,10 @@ import (
 	routeadvertisementsscheme "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/scheme"
 	routeadvertisementsinformerfactory "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions"
 	routeadvertisementsinformer "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions/routeadvertisements/v1"
+	uplinkapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinkscheme "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/clientset/versioned/scheme"
+	uplinkinformerfactory "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/informers/externalversions"
+	uplinkinformer "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/informers/externalversions/uplink/v1alpha1"
 	userdefinednetworkapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	userdefinednetworkscheme "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/scheme"
 	userdefinednetworkapiinformerfactory "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/informers/externalversions"
@@ -134,7 +146,9 @@ type WatchFactory struct {
 	raFactory            routeadvertisementsinformerfactory.SharedInformerFactory
 	frrFactory           frrinformerfactory.SharedInformerFactory
 	networkQoSFactory    networkqosinformerfactory.SharedInformerFactory
+	mcgFactory           multicastgroupinformerfactory.SharedInformerFactory
 	vtepFactory          vtepinformerfactory.SharedInformerFactory
+	uplinkFactory        uplinkinformerfactory.SharedInformerFactory
 	informers            map[reflect.Type]*informer
 
 	stopChan chan struct{}
@@ -164,7 +178,9 @@ func (wf *WatchFactory) ShallowClone() *WatchFactory {
 		raFactory:            wf.raFactory,
 		frrFactory:           wf.frrFactory,
 		networkQoSFactory:    wf.networkQoSFactory,
+		mcgFactory:           wf.mcgFactory,
 		vtepFactory:          wf.vtepFactory,
+		uplinkFactory:        wf.uplinkFactory,
 		informers:            wf.informers,
 		stopChan:             wf.stopChan,
 
@@ -251,6 +267,7 @@ var (
 	NetworkAttachmentDefinitionType reflect.Type = reflect.TypeOf(&nadapi.NetworkAttachmentDefinition{})
 	MultiNetworkPolicyType          reflect.Type = reflect.TypeOf(&mnpapi.MultiNetworkPolicy{})
 	IPAMClaimsType                  reflect.Type = reflect.TypeOf(&ipamclaimsapi.IPAMClaim{})
+	StatefulSetType                 reflect.Type = reflect.TypeOf(&appsv1.StatefulSet{})
 	UserDefinedNetworkType          reflect.Type = reflect.TypeOf(&userdefinednetworkapi.UserDefinedNetwork{})
 	ClusterUserDefinedNetworkType   reflect.Type = reflect.TypeOf(&userdefinednetworkapi.ClusterUserDefinedNetwork{})
 	NetworkQoSType                  reflect.Type = reflect.TypeOf(&networkqosapi.NetworkQoS{})
@@ -275,7 +292,12 @@ func informerObjectTrim(obj interface{}) (interface{}, error) {
 		pod.Spec.Tolerations = nil
 		pod.Spec.Affinity = nil
 		pod.Spec.NodeSelector = nil
-		pod.OwnerReferences = nil
+		// Only the controller reference is read, to identify StatefulSet pods.
+		if controller := metav1.GetControllerOfNoCopy(pod); controller != nil {
+			pod.OwnerReferences = []metav1.OwnerReference{*controller}
+		} else {
+			pod.OwnerReferences = nil
+		}
 		// OVN-K only walks pod containers for named ports, so trim the per-container
 		// runtime and resource payload that is not read from the informer cache.
 		for i := range pod.Spec.Containers {
@@ -307,6 +329,11 @@ func informerObjectTrim(obj interface{}) (interface{}, error) {
 			pod.Status.Conditions[i].ObservedGeneration = 0
 		}
 	}
+	if statefulSet, ok := obj.(*appsv1.StatefulSet); ok {
+		// Only the replicas and ordinals of StatefulSets are read.
+		statefulSet.Spec.Template = corev1.PodTemplateSpec{}
+		statefulSet.Spec.VolumeClaimTemplates = nil
+	}
 	return obj, nil
 }
 
@@ -330,6 +357,7 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		egressServiceFactory: egressserviceinformerfactory.NewSharedInformerFactory(ovnClientset.EgressServiceClient, resyncInterval),
 		apbRouteFactory:      adminbasedpolicyinformerfactory.NewSharedInformerFactory(ovnClientset.AdminPolicyRouteClient, resyncInterval),
 		networkQoSFactory:    networkqosinformerfactory.NewSharedInformerFactory(ovnClientset.NetworkQoSClient, resyncInterval),
+		mcgFactory:           multicastgroupinformerfactory.NewSharedInformerFactory(ovnClientset.MulticastGroupClient, resyncInterval),
 		informers:            make(map[reflect.Type]*informer),
 		stopChan:             make(chan struct{}),
 	}
@@ -379,10 +407,18 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		return nil, err
 	}
 
+	if err := multicastgroupapi.AddToScheme(multicastgroupscheme.Scheme); err != nil {
+		return nil, err
+	}
+
 	if err := networkconnectapi.AddToScheme(networkconnectscheme.Scheme); err != nil {
 		return nil, err
 	}
 
+	if err := uplinkapi.AddToScheme(uplinkscheme.Scheme); err != nil {
+		return nil, err
+	}
+
 	// For Services and Endpoints, pre-populate the shared Informer with one that
 	// has a label selector excluding headless services.
 	wf.iFactory.InformerFor(&corev1.Service{}, func(c kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
@@ -446,6 +482,10 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		if err != nil {
 			return nil, err
 		}
+		if config.OVNKubernetesFeature.EnableClusterNetworkPolicy {
+			// make sure shared informer is created for a factory, so on wf.anpFactory.Start() it is initialized and caches are synced.
+			wf.anpFactory.Policy().V1alpha2().ClusterNetworkPolicies().Informer()
+		}
 	}
 	if config.OVNKubernetesFeature.EnableEgressIP {
 		wf.informers[EgressIPType], err = newQueuedInformer(eventQueueSize, EgressIPType, wf.eipFactory.K8s().V1().EgressIPs().Informer(), wf.stopChan,
@@ -532,6 +572,18 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 			return nil, err
 		}
 	}
+
+	if config.EnableMulticast && config.OVNKubernetesFeature.EnableMulticastGroup {
+		// make sure shared informer is created for a factory, so on wf.mcgFactory.Start() it is initialized and caches are synced.
+		wf.mcgFactory.K8s().V1alpha1().MulticastGroups().Informer()
+	}
+
+	if util.IsUplinkEnabled() {
+		wf.uplinkFactory = uplinkinformerfactory.NewSharedInformerFactory(ovnClientset.UplinkClient, resyncInterval)
+		// make sure shared informers are created for a factory, so on wf.uplinkFactory.Start() they are initialized and caches are synced.
+		wf.uplinkFactory.K8s().V1alpha1().Uplinks().Informer()
+		wf.uplinkFactory.K8s().V1alpha1().UplinkStates().Informer()
+	}
 	if util.IsNetworkConnectEnabled() {
 		wf.cncFactory = networkconnectinformerfactory.NewSharedInformerFactory(ovnClientset.NetworkConnectClient, resyncInterval)
 		wf.informers[ClusterNetworkConnectType], err = newQueuedInformer(eventQueueSize,
@@ -639,6 +691,13 @@ func (wf *WatchFactory) Start() error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableMulticastGroup && wf.mcgFactory != nil {
+		wf.mcgFactory.Start(wf.stopChan)
+		if err := waitForCacheSyncWithTimeout(wf.mcgFactory, wf.stopChan); err != nil {
+			return err
+		}
+	}
+
 	if util.IsNetworkSegmentationSupportEnabled() && wf.udnFactory != nil {
 		wf.udnFactory.Start(wf.stopChan)
 		if err := waitForCacheSyncWithTimeout(wf.udnFactory, wf.stopChan); err != nil {
@@ -667,6 +726,13 @@ func (wf *WatchFactory) Start() error {
 		}
 	}
 
+	if wf.uplinkFactory != nil {
+		wf.uplinkFactory.Start(wf.stopChan)
+		if err := waitForCacheSyncWithTimeout(wf.uplinkFactory, wf.stopChan); err != nil {
+			return err
+		}
+	}
+
 	if wf.frrFactory != nil {
 		wf.frrFactory.Start(wf.stopChan)
 		if err := waitForCacheSyncWithTimeout(wf.frrFactory, wf.stopChan); err != nil {
@@ -728,6 +794,10 @@ func (wf *WatchFactory) Stop() {
 		wf.raFactory.Shutdown()
 	}
 
+	if wf.uplinkFactory != nil {
+		wf.uplinkFactory.Shutdown()
+	}
+
 	if wf.frrFactory != nil {
 		wf.frrFactory.Shutdown()
 	}
@@ -735,6 +805,10 @@ func (wf *WatchFactory) Stop() {
 	if wf.networkQoSFactory != nil {
 		wf.networkQoSFactory.Shutdown()
 	}
+
+	if wf.mcgFactory != nil {
+		wf.mcgFactory.Shutdown()
+	}
 }
 
 // NewNodeWatchFactory initializes a watch factory with significantly fewer
@@ -769,6 +843,9 @@ func NewNodeWatchFactory(ovnClientset *util.OVNNodeClientset, nodeName string) (
 	if err := routeadvertisementsapi.AddToScheme(routeadvertisementsscheme.Scheme); err != nil {
 		return nil, err
 	}
+	if err := uplinkapi.AddToScheme(uplinkscheme.Scheme); err != nil {
+		return nil, err
+	}
 
 	var err error
 	wf.informers[PodType], err = newQueuedInformer(eventQueueSize, PodType, wf.iFactory.Core().V1().Pods().Informer(), wf.stopChan,
@@ -879,6 +956,12 @@ func NewNodeWatchFactory(ovnClientset *util.OVNNodeClientset, nodeName string) (
 		// make sure shared informer is created for a factory, so on wf.vtepFactory.Start() it is initialized and caches are synced.
 		wf.vtepFactory.K8s().V1().VTEPs().Informer()
 	}
+	if util.IsUplinkEnabled() {
+		wf.uplinkFactory = uplinkinformerfactory.NewSharedInformerFactory(ovnClientset.UplinkClient, resyncInterval)
+		// make sure shared informers are created for a factory, so on wf.uplinkFactory.Start() they are initialized and caches are synced.
+		wf.uplinkFactory.K8s().V1alpha1().Uplinks().Informer()
+		wf.uplinkFactory.K8s().V1alpha1().UplinkStates().Informer()
+	}
 
 	// need to configure OVS interfaces for Pods on secondary networks in the DPU mode
 	// need to know what is the primary network for a namespace on the CNI side, which
@@ -928,6 +1011,7 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		apbRouteFactory:      adminbasedpolicyinformerfactory.NewSharedInformerFactory(ovnClientset.AdminPolicyRouteClient, resyncInterval),
 		egressQoSFactory:     egressqosinformerfactory.NewSharedInformerFactory(ovnClientset.EgressQoSClient, resyncInterval),
 		networkQoSFactory:    networkqosinformerfactory.NewSharedInformerFactory(ovnClientset.NetworkQoSClient, resyncInterval),
+		mcgFactory:           multicastgroupinformerfactory.NewSharedInformerFactory(ovnClientset.MulticastGroupClient, resyncInterval),
 		informers:            make(map[reflect.Type]*informer),
 		stopChan:             make(chan struct{}),
 	}
@@ -960,6 +1044,9 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 	if err := routeadvertisementsapi.AddToScheme(routeadvertisementsscheme.Scheme); err != nil {
 		return nil, err
 	}
+	if err := uplinkapi.AddToScheme(uplinkscheme.Scheme); err != nil {
+		return nil, err
+	}
 	if err := frrapi.AddToScheme(frrscheme.Scheme); err != nil {
 		return nil, err
 	}
@@ -1061,6 +1148,16 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 			if err != nil {
 				return nil, err
 			}
+
+			// IPAMClaims derived for StatefulSet pods are released when the
+			// StatefulSet is scaled down or deleted
+			wf.informers[StatefulSetType], err = newQueuedInformer(eventQueueSize,
+				StatefulSetType,
+				wf.iFactory.Apps().V1().StatefulSets().Informer(),
+				wf.stopChan, minNumEventQueues)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -1110,6 +1207,13 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 		wf.vtepFactory.K8s().V1().VTEPs().Informer()
 	}
 
+	if util.IsUplinkEnabled() {
+		wf.uplinkFactory = uplinkinformerfactory.NewSharedInformerFactory(ovnClientset.UplinkClient, resyncInterval)
+		// make sure shared informers are created for a factory, so on wf.uplinkFactory.Start() they are initialized and caches are synced.
+		wf.uplinkFactory.K8s().V1alpha1().Uplinks().Informer()
+		wf.uplinkFactory.K8s().V1alpha1().UplinkStates().Informer()
+	}
+
 	if util.IsNetworkConnectEnabled() {
 		wf.cncFactory = networkconnectinformerfactory.NewSharedInformerFactory(ovnClientset.NetworkConnectClient, resyncInterval)
 		wf.informers[ClusterNetworkConnectType], err = newQueuedInformer(eventQueueSize,
@@ -1211,6 +1315,10 @@ func getObjectMeta(objType reflect.Type, obj interface{}) (*metav1.ObjectMeta, e
 		if persistentips, ok := obj.(*ipamclaimsapi.IPAMClaim); ok {
 			return &persistentips.ObjectMeta, nil
 		}
+	case StatefulSetType:
+		if statefulSet, ok := obj.(*appsv1.StatefulSet); ok {
+			return &statefulSet.ObjectMeta, nil
+		}
 	case EgressQoSType:
 		if egressQoS, ok := obj.(*egressqosapi.EgressQoS); ok {
 			return &egressQoS.ObjectMeta, nil
@@ -1328,6 +1436,11 @@ func (wf *WatchFactory) GetResourceHandlerFunc(objType reflect.Type) (AddHandler
 		return func(_ string, _ labels.Selector, funcs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
 			return wf.AddIPAMClaimsHandler(funcs, processExisting)
 		}, nil
+
+	case StatefulSetType:
+		return func(_ string, _ labels.Selector, funcs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
+			return wf.AddStatefulSetHandler(funcs, processExisting)
+		}, nil
 	}
 	return nil, fmt.Errorf("cannot get ObjectMeta from type %v", objType)
 }
@@ -1429,6 +1542,16 @@ func (wf *WatchFactory) AddIPAMClaimsHandler(handlerFuncs cache.ResourceEventHan
 	return wf.addHandler(IPAMClaimsType, "", nil, handlerFuncs, processExisting, defaultHandlerPriority)
 }
 
+// RemoveStatefulSetHandler removes a StatefulSet object event handler function
+func (wf *WatchFactory) RemoveStatefulSetHandler(handler *Handler) {
+	wf.removeHandler(StatefulSetType, handler)
+}
+
+// AddStatefulSetHandler adds a handler function that will be executed on StatefulSet object changes
+func (wf *WatchFactory) AddStatefulSetHandler(handlerFuncs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
+	return wf.addHandler(StatefulSetType, "", nil, handlerFuncs, processExisting, defaultHandlerPriority)
+}
+
 // AddServiceHandler adds a handler function that will be executed on Service object changes
 func (wf *WatchFactory) AddServiceHandler(handlerFuncs cache.ResourceEventHandler, processExisting func([]interface{}) error) (*Handler, error) {
 	return wf.addHandler(ServiceType, "", nil, handlerFuncs, processExisting, defaultHandlerPriority)
@@ -1738,6 +1861,12 @@ func (wf *WatchFactory) GetIPAMClaim(namespace, name string) (*ipamclaimsapi.IPA
 	return ipamClaimsLister.IPAMClaims(namespace).Get(name)
 }
 
+// GetStatefulSet gets a specific StatefulSet by the namespace/name
+func (wf *WatchFactory) GetStatefulSet(namespace, name string) (*appsv1.StatefulSet, error) {
+	statefulSetLister := wf.informers[StatefulSetType].lister.(appslisters.StatefulSetLister)
+	return statefulSetLister.StatefulSets(namespace).Get(name)
+}
+
 // GetNAD gets a specific NAD by the namespace/name
 func (wf *WatchFactory) GetNAD(namespace, name string) (*nadapi.NetworkAttachmentDefinition, error) {
 	nadLister := wf.informers[NetworkAttachmentDefinitionType].lister.(nadlister.NetworkAttachmentDefinitionLister)
@@ -1816,6 +1945,10 @@ func (wf *WatchFactory) BANPInformer() anpinformer.BaselineAdminNetworkPolicyInf
 	return wf.anpFactory.Policy().V1alpha1().BaselineAdminNetworkPolicies()
 }
 
+func (wf *WatchFactory) CNPInformer() cnpinformer.ClusterNetworkPolicyInformer {
+	return wf.anpFactory.Policy().V1alpha2().ClusterNetworkPolicies()
+}
+
 func (wf *WatchFactory) EgressIPInformer() egressipinformer.EgressIPInformer {
 	return wf.eipFactory.K8s().V1().EgressIPs()
 }
@@ -1828,6 +1961,10 @@ func (wf *WatchFactory) IPAMClaimsInformer() ipamclaimsinformer.IPAMClaimInforme
 	return wf.ipamClaimsFactory.K8s().V1alpha1().IPAMClaims()
 }
 
+func (wf *WatchFactory) StatefulSetInformer() appsinformers.StatefulSetInformer {
+	return wf.iFactory.Apps().V1().StatefulSets()
+}
+
 func (wf *WatchFactory) NADInformer() nadinformer.NetworkAttachmentDefinitionInformer {
 	return wf.nadFactory.K8sCniCncfIo().V1().NetworkAttachmentDefinitions()
 }
@@ -1864,6 +2001,18 @@ func (wf *WatchFactory) NetworkQoSInformer() networkqosinformer.NetworkQoSInform
 	return wf.networkQoSFactory.K8s().V1alpha1().NetworkQoSes()
 }
 
+func (wf *WatchFactory) MulticastGroupInformer() multicastgroupinformer.MulticastGroupInformer {
+	return wf.mcgFactory.K8s().V1alpha1().MulticastGroups()
+}
+
+func (wf *WatchFactory) UplinkInformer() uplinkinformer.UplinkInformer {
+	return wf.uplinkFactory.K8s().V1alpha1().Uplinks()
+}
+
+func (wf *WatchFactory) UplinkStateInformer() uplinkinformer.UplinkStateInformer {
+	return wf.uplinkFactory.K8s().V1alpha1().UplinkStates()
+}
+
 // withServiceNameAndNoHeadlessServiceSelector returns a LabelSelector (added to the
 // watcher for EndpointSlices) that will only choose EndpointSlices with a non-empty
 // "kubernetes.io/service-name" label and without "service.kubernetes.io/headless"
@@ -2255,3 +2255,31 @@ var _ = Describe("Watch Factory Operations", func() {
 		wf.RemovePodHandler(h)
 	})
 })
+
+var _ = Describe("Informer object trim", func() {
+	It("keeps only the controller reference of pods", func() {
+		controller := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web", Controller: ptr.To(true)}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "web-0",
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "ConfigMap", Name: "cm"},
+					controller,
+				},
+			},
+		}
+		obj, err := informerObjectTrim(pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.(*corev1.Pod).OwnerReferences).To(Equal([]metav1.OwnerReference{controller}))
+
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "pod",
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "cm"}},
+			},
+		}
+		obj, err = informerObjectTrim(pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.(*corev1.Pod).OwnerReferences).To(BeNil())
+	})
+})
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ktypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
+	appslisters "k8s.io/client-go/listers/apps/v1"
 	listers "k8s.io/client-go/listers/core/v1"
 	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	netlisters "k8s.io/client-go/listers/networking/v1"
@@ -509,6 +510,8 @@ func newInformerLister(oType reflect.Type, sharedInformer cache.SharedIndexInfor
 		return egressservicelister.NewEgressServiceLister(sharedInformer.GetIndexer()), nil
 	case IPAMClaimsType:
 		return ipamclaimslister.NewIPAMClaimLister(sharedInformer.GetIndexer()), nil
+	case StatefulSetType:
+		return appslisters.NewStatefulSetLister(sharedInformer.GetIndexer()), nil
 	case UserDefinedNetworkType:
 		return userdefinednetworklister.NewUserDefinedNetworkLister(sharedInformer.GetIndexer()), nil
 	case ClusterUserDefinedNetworkType:
@@ -27,12 +27,14 @@ import (
 	anpclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
 
 	adminpolicybasedrouteclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned"
+	networkconnectclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1/apis/clientset/versioned"
 	egressfirewall "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
 	egressfirewallclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/clientset/versioned"
 	egressipv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
 	egressipclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned"
 	egressqosclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned"
 	egressserviceclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned"
+	multicastgroupclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/clientset/versioned"
 	networkqosclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/clientset/versioned"
 )
 
@@ -49,8 +51,10 @@ type InterfaceOVN interface {
 	CreateCloudPrivateIPConfig(cloudPrivateIPConfig *ocpcloudnetworkapi.CloudPrivateIPConfig) (*ocpcloudnetworkapi.CloudPrivateIPConfig, error)
 	UpdateCloudPrivateIPConfig(cloudPrivateIPConfig *ocpcloudnetworkapi.CloudPrivateIPConfig) (*ocpcloudnetworkapi.CloudPrivateIPConfig, error)
 	DeleteCloudPrivateIPConfig(name string) error
-	UpdateEgressServiceStatus(namespace, name, host string) error
+	UpdateEgressServiceStatus(namespace, name, host string, hosts []string) error
 	UpdateIPAMClaimIPs(updatedIPAMClaim *ipamclaimsapi.IPAMClaim) error
+	CreateIPAMClaim(ipamClaim *ipamclaimsapi.IPAMClaim) (*ipamclaimsapi.IPAMClaim, error)
+	DeleteIPAMClaim(namespace, name string) error
 }
 
 // Interface represents the exported methods for dealing with getting/setting
@@ -91,6 +95,8 @@ type KubeOVN struct {
 	IPAMClaimsClient     ipamclaimssclientset.Interface
 	NADClient            nadclientset.Interface
 	NetworkQoSClient     networkqosclientset.Interface
+	MulticastGroupClient multicastgroupclientset.Interface
+	NetworkConnectClient networkconnectclientset.Interface
 }
 
 // SetAnnotationsOnPod takes the pod object and map of key/value string pairs to set as annotations
@@ -479,13 +485,14 @@ func (k *KubeOVN) DeleteCloudPrivateIPConfig(name string) error {
 	return k.CloudNetworkClient.CloudV1().CloudPrivateIPConfigs().Delete(context.TODO(), name, metav1.DeleteOptions{})
 }
 
-func (k *KubeOVN) UpdateEgressServiceStatus(namespace, name, host string) error {
+func (k *KubeOVN) UpdateEgressServiceStatus(namespace, name, host string, hosts []string) error {
 	es, err := k.EgressServiceClient.K8sV1().EgressServices(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
 
 	es.Status.Host = host
+	es.Status.Hosts = hosts
 
 	_, err = k.EgressServiceClient.K8sV1().EgressServices(es.Namespace).UpdateStatus(context.TODO(), es, metav1.UpdateOptions{})
 	return err
@@ -496,6 +503,14 @@ func (k *KubeOVN) UpdateIPAMClaimIPs(updatedIPAMClaim *ipamclaimsapi.IPAMClaim)
 	return err
 }
 
+func (k *KubeOVN) CreateIPAMClaim(ipamClaim *ipamclaimsapi.IPAMClaim) (*ipamclaimsapi.IPAMClaim, error) {
+	return k.IPAMClaimsClient.K8sV1alpha1().IPAMClaims(ipamClaim.Namespace).Create(context.TODO(), ipamClaim, metav1.CreateOptions{})
+}
+
+func (k *KubeOVN) DeleteIPAMClaim(namespace, name string) error {
+	return k.IPAMClaimsClient.K8sV1alpha1().IPAMClaims(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
 // SetAnnotationsOnNAD takes a NAD namespace and name and a map of key/value string pairs to set as annotations
 func (k *KubeOVN) SetAnnotationsOnNAD(namespace, name string, annotations map[string]string, fieldManager string) error {
 	var err error
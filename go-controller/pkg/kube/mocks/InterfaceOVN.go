@@ -54,6 +54,36 @@ func (_m *InterfaceOVN) CreateCloudPrivateIPConfig(cloudPrivateIPConfig *v1.Clou
 	return r0, r1
 }
 
+// CreateIPAMClaim provides a mock function with given fields: ipamClaim
+func (_m *InterfaceOVN) CreateIPAMClaim(ipamClaim *v1alpha1.IPAMClaim) (*v1alpha1.IPAMClaim, error) {
+	ret := _m.Called(ipamClaim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIPAMClaim")
+	}
+
+	var r0 *v1alpha1.IPAMClaim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*v1alpha1.IPAMClaim) (*v1alpha1.IPAMClaim, error)); ok {
+		return rf(ipamClaim)
+	}
+	if rf, ok := ret.Get(0).(func(*v1alpha1.IPAMClaim) *v1alpha1.IPAMClaim); ok {
+		r0 = rf(ipamClaim)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.IPAMClaim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*v1alpha1.IPAMClaim) error); ok {
+		r1 = rf(ipamClaim)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteCloudPrivateIPConfig provides a mock function with given fields: name
 func (_m *InterfaceOVN) DeleteCloudPrivateIPConfig(name string) error {
 	ret := _m.Called(name)
@@ -72,6 +102,24 @@ func (_m *InterfaceOVN) DeleteCloudPrivateIPConfig(name string) error {
 	return r0
 }
 
+// DeleteIPAMClaim provides a mock function with given fields: namespace, name
+func (_m *InterfaceOVN) DeleteIPAMClaim(namespace string, name string) error {
+	ret := _m.Called(namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIPAMClaim")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Events provides a mock function with no fields
 func (_m *InterfaceOVN) Events() corev1.EventInterface {
 	ret := _m.Called()
@@ -470,17 +518,17 @@ func (_m *InterfaceOVN) UpdateEgressIP(eIP *egressipv1.EgressIP) error {
 	return r0
 }
 
-// UpdateEgressServiceStatus provides a mock function with given fields: namespace, name, host
-func (_m *InterfaceOVN) UpdateEgressServiceStatus(namespace string, name string, host string) error {
-	ret := _m.Called(namespace, name, host)
+// UpdateEgressServiceStatus provides a mock function with given fields: namespace, name, host, hosts
+func (_m *InterfaceOVN) UpdateEgressServiceStatus(namespace string, name string, host string, hosts []string) error {
+	ret := _m.Called(namespace, name, host, hosts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateEgressServiceStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
-		r0 = rf(namespace, name, host)
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) error); ok {
+		r0 = rf(namespace, name, host, hosts)
 	} else {
 		r0 = ret.Error(0)
 	}
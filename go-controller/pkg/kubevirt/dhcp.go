@@ -4,6 +4,7 @@
 package kubevirt
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"strings"
@@ -14,6 +15,7 @@ import (
 
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
 
+	ovncnitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
@@ -23,6 +25,10 @@ import (
 
 const (
 	dhcpLeaseTime = 3500
+
+	// raRoutePreference is the preference of the routes advertised with the
+	// route information option of IPv6 router advertisements.
+	raRoutePreference = "MEDIUM"
 )
 
 // DHCPConfigsOpt mutates generated DHCP options before they are written to OVN.
@@ -79,6 +85,146 @@ func WithIPv6DNSServer(dnsServer string) func(*dhcpConfigs) {
 	}
 }
 
+// WithDHCPOptions configures the additional DHCP options of a network or a
+// virtual machine. The options it sets replace the ones set before, so the
+// options of the virtual machine override the ones of the network.
+func WithDHCPOptions(opts *ovncnitypes.DHCPOptions) func(*dhcpConfigs) {
+	return func(configs *dhcpConfigs) {
+		if opts == nil {
+			return
+		}
+		if configs.V4 != nil {
+			composeDHCPv4ExtraOptions(configs.V4.Options, opts)
+		}
+		if configs.V6 != nil {
+			composeDHCPv6ExtraOptions(configs.V6.Options, opts)
+		}
+	}
+}
+
+func composeDHCPv4ExtraOptions(options map[string]string, opts *ovncnitypes.DHCPOptions) {
+	if len(opts.DomainSearch) > 0 {
+		options["domain_search_list"] = fmt.Sprintf("%q", strings.Join(opts.DomainSearch, ","))
+	}
+	// keep the default DNS server if none of the family is configured
+	if dnsServers := filterIPs(opts.DNSServers, false /*ipv4*/); len(dnsServers) > 0 {
+		options["dns_server"] = ovnIPList(dnsServers)
+	}
+	if len(opts.NTPServers) > 0 {
+		options["ntp_server"] = ovnIPList(opts.NTPServers)
+	}
+	routes := []string{}
+	hasDefaultRoute := false
+	for _, route := range opts.Routes {
+		if !utilnet.IsIPv4CIDRString(route.Destination) {
+			continue
+		}
+		routes = append(routes, route.Destination, route.NextHop)
+		if _, cidr, _ := net.ParseCIDR(route.Destination); cidr != nil && isDefaultRoute(cidr) {
+			hasDefaultRoute = true
+		}
+	}
+	if len(routes) > 0 {
+		// Clients ignore the router option when classless static routes are
+		// served (RFC 3442), so keep the default route in the list.
+		if router := options["router"]; router != "" && !hasDefaultRoute {
+			routes = append(routes, "0.0.0.0/0", router)
+		}
+		options["classless_static_route"] = "{" + strings.Join(routes, ",") + "}"
+	}
+	for _, option := range opts.ExtraOptions {
+		if info, ok := util.GetDHCPExtraOptionInfo(option.Name); ok {
+			options[info.OVNName] = ovnOptionValue(info, option.Value)
+		}
+	}
+}
+
+func composeDHCPv6ExtraOptions(options map[string]string, opts *ovncnitypes.DHCPOptions) {
+	if len(opts.DomainSearch) > 0 {
+		options["domain_search"] = fmt.Sprintf("%q", strings.Join(opts.DomainSearch, ","))
+	}
+	if dnsServers := filterIPs(opts.DNSServers, true /*ipv6*/); len(dnsServers) > 0 {
+		options["dns_server"] = ovnIPList(dnsServers)
+	}
+	for _, option := range opts.ExtraOptions {
+		if info, ok := util.GetDHCPExtraOptionInfo(option.Name); ok && info.IPv6 {
+			options[info.OVNName] = ovnOptionValue(info, option.Value)
+		}
+	}
+}
+
+// AddIPv6RAConfigs adds the IPv6 router advertisement equivalents of the
+// network additional DHCP options to the router port ipv6_ra_configs: the
+// search domains as DNSSL, the first IPv6 DNS server as RDNSS and the IPv6
+// routes as route information.
+func AddIPv6RAConfigs(raConfigs map[string]string, opts *ovncnitypes.DHCPOptions) {
+	if opts == nil {
+		return
+	}
+	if len(opts.DomainSearch) > 0 {
+		raConfigs["dnssl"] = strings.Join(opts.DomainSearch, ",")
+	}
+	// OVN only announces a single RDNSS server
+	if dnsServers := filterIPs(opts.DNSServers, true /*ipv6*/); len(dnsServers) > 0 {
+		raConfigs["rdnss"] = dnsServers[0]
+	}
+	routeInfo := []string{}
+	for _, route := range opts.Routes {
+		if utilnet.IsIPv6CIDRString(route.Destination) {
+			routeInfo = append(routeInfo, raRoutePreference+"-"+route.Destination)
+		}
+	}
+	if len(routeInfo) > 0 {
+		raConfigs["route_info"] = strings.Join(routeInfo, ",")
+	}
+}
+
+// DHCPOptionsFromPod returns the additional DHCP options the virtual machine
+// of the pod requests with the DHCPOptionsAnnotation, or nil if it has none.
+func DHCPOptionsFromPod(pod *corev1.Pod) (*ovncnitypes.DHCPOptions, error) {
+	annotation, ok := pod.Annotations[DHCPOptionsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	opts := &ovncnitypes.DHCPOptions{}
+	if err := json.Unmarshal([]byte(annotation), opts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation: %w", DHCPOptionsAnnotation, err)
+	}
+	if err := util.ValidateDHCPOptions(opts); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", DHCPOptionsAnnotation, err)
+	}
+	return opts, nil
+}
+
+func filterIPs(ips []string, ipv6 bool) []string {
+	filtered := []string{}
+	for _, ip := range ips {
+		if utilnet.IsIPv6String(ip) == ipv6 {
+			filtered = append(filtered, ip)
+		}
+	}
+	return filtered
+}
+
+func ovnIPList(ips []string) string {
+	if len(ips) == 1 {
+		return ips[0]
+	}
+	return "{" + strings.Join(ips, ", ") + "}"
+}
+
+func ovnOptionValue(info util.DHCPExtraOptionInfo, value string) string {
+	if info.IPv4Address {
+		return value
+	}
+	return fmt.Sprintf("%q", value)
+}
+
+func isDefaultRoute(cidr *net.IPNet) bool {
+	ones, _ := cidr.Mask.Size()
+	return ones == 0
+}
+
 // EnsureDHCPOptionsForLSP creates or updates DHCP options for a VM logical switch port.
 func EnsureDHCPOptionsForLSP(controllerName string, nbClient libovsdbclient.Client, pod *corev1.Pod, ips []*net.IPNet, lsp *nbdb.LogicalSwitchPort, opts ...DHCPConfigsOpt) error {
 	vmDescription, err := NewVMDescriptionFromPod(pod)
@@ -88,6 +234,11 @@ func EnsureDHCPOptionsForLSP(controllerName string, nbClient libovsdbclient.Clie
 	if vmDescription == nil {
 		return fmt.Errorf("missing vm label at pod %s/%s", pod.Namespace, pod.Name)
 	}
+	vmDHCPOptions, err := DHCPOptionsFromPod(pod)
+	if err != nil {
+		return fmt.Errorf("failed reading vm dhcp options at pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	opts = append(opts, WithDHCPOptions(vmDHCPOptions))
 	dhcpConfigs, err := composeDHCPConfigs(controllerName, vmDescription.Key(), ips, opts...)
 	if err != nil {
 		return fmt.Errorf("failed composing DHCP options: %v", err)
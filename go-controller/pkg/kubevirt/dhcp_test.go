@@ -6,8 +6,11 @@ package kubevirt
 import (
 	"net"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ktypes "k8s.io/apimachinery/pkg/types"
 
+	ovncnitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -192,6 +195,168 @@ var _ = Describe("Kubevirt", func() {
 				},
 			},
 		}),
+
+		Entry("Dual stack with network and vm dhcp options", dhcpTest{
+			cidrs:          []string{"192.168.25.0/24", "2002:0:0:1234::/64"},
+			controllerName: "defaultController",
+			namespace:      "namespace1",
+			vmName:         "foo1",
+			opts: []DHCPConfigsOpt{
+				WithIPv4Router("192.168.25.1"),
+				WithIPv4DNSServer("192.167.23.44"),
+				WithIPv6DNSServer("2001:1:2:3:4:5:6:7"),
+				WithDHCPOptions(&ovncnitypes.DHCPOptions{
+					DomainSearch: []string{"example.com", "lab.example.com"},
+					DNSServers:   []string{"10.1.1.1", "10.1.1.2"},
+					NTPServers:   []string{"10.1.1.3"},
+					Routes: []ovncnitypes.DHCPRoute{
+						{Destination: "10.2.0.0/16", NextHop: "192.168.25.254"},
+						{Destination: "fd00:10::/64"},
+					},
+					ExtraOptions: []ovncnitypes.DHCPExtraOption{
+						{Name: "BootFileName", Value: "pxelinux.0"},
+						{Name: "NextServer", Value: "10.1.1.4"},
+					},
+				}),
+				// the vm options override the network ones
+				WithDHCPOptions(&ovncnitypes.DHCPOptions{
+					NTPServers: []string{"10.1.1.5", "10.1.1.6"},
+					ExtraOptions: []ovncnitypes.DHCPExtraOption{
+						{Name: "BootFileName", Value: "ipxe.efi"},
+					},
+				}),
+			},
+			expectedDHCPConfigs: dhcpConfigs{
+				V4: &nbdb.DHCPOptions{
+					Cidr: "192.168.25.0/24",
+					ExternalIDs: map[string]string{
+						"k8s.ovn.org/owner-controller": "defaultController",
+						"k8s.ovn.org/owner-type":       "VirtualMachine",
+						"k8s.ovn.org/name":             "namespace1/foo1",
+						"k8s.ovn.org/cidr":             "192.168.25.0/24",
+						"k8s.ovn.org/id":               "defaultController:VirtualMachine:namespace1/foo1:192.168.25.0/24",
+						"k8s.ovn.org/zone":             "local",
+					},
+					Options: map[string]string{
+						"lease_time":             "3500",
+						"server_id":              ARPProxyIPv4,
+						"server_mac":             ARPProxyMAC,
+						"hostname":               `"foo1"`,
+						"router":                 "192.168.25.1",
+						"dns_server":             "{10.1.1.1, 10.1.1.2}",
+						"domain_search_list":     `"example.com,lab.example.com"`,
+						"ntp_server":             "{10.1.1.5, 10.1.1.6}",
+						"classless_static_route": "{10.2.0.0/16,192.168.25.254,0.0.0.0/0,192.168.25.1}",
+						"bootfile_name":          `"ipxe.efi"`,
+						"next_server":            "10.1.1.4",
+					},
+				},
+				V6: &nbdb.DHCPOptions{
+					Cidr: "2002:0:0:1234::/64",
+					ExternalIDs: map[string]string{
+						"k8s.ovn.org/owner-controller": "defaultController",
+						"k8s.ovn.org/owner-type":       "VirtualMachine",
+						"k8s.ovn.org/name":             "namespace1/foo1",
+						"k8s.ovn.org/cidr":             "2002.0.0.1234../64",
+						"k8s.ovn.org/id":               "defaultController:VirtualMachine:namespace1/foo1:2002.0.0.1234../64",
+						"k8s.ovn.org/zone":             "local",
+					},
+					Options: map[string]string{
+						"server_id":     "0a:58:6d:6d:c1:50",
+						"fqdn":          `"foo1"`,
+						"dns_server":    "2001:1:2:3:4:5:6:7",
+						"domain_search": `"example.com,lab.example.com"`,
+						"bootfile_name": `"ipxe.efi"`,
+					},
+				},
+			},
+		}),
+		Entry("Dual stack with network IPv6 dns servers only", dhcpTest{
+			cidrs:          []string{"192.168.25.0/24", "2002:0:0:1234::/64"},
+			controllerName: "defaultController",
+			namespace:      "namespace1",
+			vmName:         "foo1",
+			opts: []DHCPConfigsOpt{
+				WithIPv4Router("192.168.25.1"),
+				WithIPv4DNSServer("192.167.23.44"),
+				WithIPv6DNSServer("2001:1:2:3:4:5:6:7"),
+				WithDHCPOptions(&ovncnitypes.DHCPOptions{
+					DNSServers: []string{"fd00::53", "fd00::54"},
+				}),
+			},
+			expectedDHCPConfigs: dhcpConfigs{
+				V4: &nbdb.DHCPOptions{
+					Cidr: "192.168.25.0/24",
+					ExternalIDs: map[string]string{
+						"k8s.ovn.org/owner-controller": "defaultController",
+						"k8s.ovn.org/owner-type":       "VirtualMachine",
+						"k8s.ovn.org/name":             "namespace1/foo1",
+						"k8s.ovn.org/cidr":             "192.168.25.0/24",
+						"k8s.ovn.org/id":               "defaultController:VirtualMachine:namespace1/foo1:192.168.25.0/24",
+						"k8s.ovn.org/zone":             "local",
+					},
+					Options: map[string]string{
+						"lease_time": "3500",
+						"server_id":  ARPProxyIPv4,
+						"server_mac": ARPProxyMAC,
+						"hostname":   `"foo1"`,
+						"router":     "192.168.25.1",
+						"dns_server": "192.167.23.44",
+					},
+				},
+				V6: &nbdb.DHCPOptions{
+					Cidr: "2002:0:0:1234::/64",
+					ExternalIDs: map[string]string{
+						"k8s.ovn.org/owner-controller": "defaultController",
+						"k8s.ovn.org/owner-type":       "VirtualMachine",
+						"k8s.ovn.org/name":             "namespace1/foo1",
+						"k8s.ovn.org/cidr":             "2002.0.0.1234../64",
+						"k8s.ovn.org/id":               "defaultController:VirtualMachine:namespace1/foo1:2002.0.0.1234../64",
+						"k8s.ovn.org/zone":             "local",
+					},
+					Options: map[string]string{
+						"server_id":  "0a:58:6d:6d:c1:50",
+						"fqdn":       `"foo1"`,
+						"dns_server": "{fd00::53, fd00::54}",
+					},
+				},
+			},
+		}),
+		Entry("IPv4 Single stack with a default classless static route", dhcpTest{
+			cidrs:          []string{"192.168.25.0/24"},
+			controllerName: "defaultController",
+			namespace:      "namespace1",
+			vmName:         "foo1",
+			opts: []DHCPConfigsOpt{
+				WithIPv4Router("192.168.25.1"),
+				WithDHCPOptions(&ovncnitypes.DHCPOptions{
+					Routes: []ovncnitypes.DHCPRoute{
+						{Destination: "0.0.0.0/0", NextHop: "192.168.25.254"},
+					},
+				}),
+			},
+			expectedDHCPConfigs: dhcpConfigs{
+				V4: &nbdb.DHCPOptions{
+					Cidr: "192.168.25.0/24",
+					ExternalIDs: map[string]string{
+						"k8s.ovn.org/owner-controller": "defaultController",
+						"k8s.ovn.org/owner-type":       "VirtualMachine",
+						"k8s.ovn.org/name":             "namespace1/foo1",
+						"k8s.ovn.org/cidr":             "192.168.25.0/24",
+						"k8s.ovn.org/id":               "defaultController:VirtualMachine:namespace1/foo1:192.168.25.0/24",
+						"k8s.ovn.org/zone":             "local",
+					},
+					Options: map[string]string{
+						"lease_time":             "3500",
+						"server_id":              ARPProxyIPv4,
+						"server_mac":             ARPProxyMAC,
+						"hostname":               `"foo1"`,
+						"router":                 "192.168.25.1",
+						"classless_static_route": "{0.0.0.0/0,192.168.25.254}",
+					},
+				},
+			},
+		}),
 	)
 
 	DescribeTable("composing dhcp options should fail", func(t dhcpTest) {
@@ -212,4 +377,46 @@ var _ = Describe("Kubevirt", func() {
 		}),
 	)
 
+	It("should add the network dhcp options to the IPv6 router advertisements", func() {
+		raConfigs := map[string]string{"address_mode": "dhcpv6_stateful"}
+		AddIPv6RAConfigs(raConfigs, &ovncnitypes.DHCPOptions{
+			DomainSearch: []string{"example.com", "lab.example.com"},
+			DNSServers:   []string{"10.1.1.1", "fd00::53", "fd00::54"},
+			Routes: []ovncnitypes.DHCPRoute{
+				{Destination: "10.2.0.0/16", NextHop: "192.168.25.254"},
+				{Destination: "fd00:10::/64"},
+				{Destination: "fd00:20::/64"},
+			},
+		})
+		Expect(raConfigs).To(Equal(map[string]string{
+			"address_mode": "dhcpv6_stateful",
+			"dnssl":        "example.com,lab.example.com",
+			"rdnss":        "fd00::53",
+			"route_info":   "MEDIUM-fd00:10::/64,MEDIUM-fd00:20::/64",
+		}))
+	})
+
+	DescribeTable("reading the vm dhcp options", func(annotations map[string]string, expected *ovncnitypes.DHCPOptions, expectedError string) {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace1", Name: "virt-launcher-foo1", Annotations: annotations}}
+		opts, err := DHCPOptionsFromPod(pod)
+		if expectedError != "" {
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(opts).To(Equal(expected))
+	},
+		Entry("without annotation", nil, nil, ""),
+		Entry("with a valid annotation",
+			map[string]string{DHCPOptionsAnnotation: `{"domainSearch":["example.com"],"extraOptions":[{"name":"BootFileName","value":"ipxe.efi"}]}`},
+			&ovncnitypes.DHCPOptions{
+				DomainSearch: []string{"example.com"},
+				ExtraOptions: []ovncnitypes.DHCPExtraOption{{Name: "BootFileName", Value: "ipxe.efi"}},
+			}, ""),
+		Entry("with a malformed annotation",
+			map[string]string{DHCPOptionsAnnotation: `{"domainSearch":`}, nil, "failed to unmarshal"),
+		Entry("with an unsupported option",
+			map[string]string{DHCPOptionsAnnotation: `{"extraOptions":[{"name":"Router","value":"10.0.0.1"}]}`}, nil, `unsupported extra option "Router"`),
+	)
+
 })
@@ -19,4 +19,9 @@ const (
 	NamespaceExternalIDsKey = "k8s.ovn.org/namespace"
 	// VirtualMachineExternalIDsKey stores the VM name in OVN external IDs.
 	VirtualMachineExternalIDsKey = "k8s.ovn.org/vm"
+
+	// DHCPOptionsAnnotation holds the additional DHCP options of a VM pod, as a
+	// JSON object in the format of the network dhcpOptions. They override the
+	// options of the network.
+	DHCPOptionsAnnotation = types.OvnK8sPrefix + "/dhcp-options"
 )
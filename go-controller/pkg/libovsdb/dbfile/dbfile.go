@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dbfile reads standalone OVSDB database files, e.g. to troubleshoot
+// a database offline.
+package dbfile
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+)
+
+// Rows holds the rows of the tables of a database file, per table and UUID.
+// The columns are kept in OVSDB JSON notation.
+type Rows map[string]map[string]map[string]json.RawMessage
+
+// Read returns the rows of a standalone database file for the given schema.
+// The file is a sequence of records, each made of a "OVSDB JSON <length> <hash>" header
+// followed by <length> bytes of JSON: the first record is the schema, the others are
+// the transactions committed to the database. Tables and columns that are not part of
+// the given schema are ignored.
+func Read(r io.Reader, schema *ovsdb.DatabaseSchema) (Rows, error) {
+	reader := bufio.NewReader(r)
+	rows := Rows{}
+	schemaRead := false
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if strings.TrimSpace(header) == "" {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			continue
+		}
+		fields := strings.Fields(header)
+		if len(fields) != 4 || fields[0] != "OVSDB" {
+			return nil, fmt.Errorf("unexpected record header %q", strings.TrimSpace(header))
+		}
+		if fields[1] == "CLUSTER" {
+			return nil, fmt.Errorf("clustered database files are not supported, " +
+				"convert it with \"ovsdb-tool cluster-to-standalone\" first")
+		}
+		if fields[1] != "JSON" {
+			return nil, fmt.Errorf("unsupported record type %s", fields[1])
+		}
+		length, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid record length %q: %w", fields[2], err)
+		}
+		data := make([]byte, length)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, fmt.Errorf("failed to read record: %w", err)
+		}
+
+		if !schemaRead {
+			var fileSchema struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(data, &fileSchema); err != nil {
+				return nil, fmt.Errorf("failed to parse database schema: %w", err)
+			}
+			if fileSchema.Name != schema.Name {
+				return nil, fmt.Errorf("expected a %s database, got %s", schema.Name, fileSchema.Name)
+			}
+			schemaRead = true
+			continue
+		}
+		if err := rows.applyTransaction(schema, data); err != nil {
+			return nil, err
+		}
+	}
+	if !schemaRead {
+		return nil, fmt.Errorf("no database schema found")
+	}
+	return rows, nil
+}
+
+// applyTransaction applies a transaction record of a database file
+func (rows Rows) applyTransaction(schema *ovsdb.DatabaseSchema, data []byte) error {
+	txn := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &txn); err != nil {
+		return fmt.Errorf("failed to parse transaction: %w", err)
+	}
+	// when set, the set and map columns of the modified rows only hold the difference
+	// with the previous value
+	isDiff := false
+	if raw, ok := txn["_is_diff"]; ok {
+		if err := json.Unmarshal(raw, &isDiff); err != nil {
+			return fmt.Errorf("failed to parse transaction: %w", err)
+		}
+	}
+	for table, raw := range txn {
+		tableSchema := schema.Table(table)
+		if tableSchema == nil {
+			continue
+		}
+		tableRows := map[string]map[string]json.RawMessage{}
+		if err := json.Unmarshal(raw, &tableRows); err != nil {
+			return fmt.Errorf("failed to parse %s rows: %w", table, err)
+		}
+		if rows[table] == nil {
+			rows[table] = map[string]map[string]json.RawMessage{}
+		}
+		for rowUUID, columns := range tableRows {
+			if columns == nil {
+				delete(rows[table], rowUUID)
+				continue
+			}
+			row, exists := rows[table][rowUUID]
+			if !exists {
+				row = map[string]json.RawMessage{}
+				rows[table][rowUUID] = row
+			}
+			for column, value := range columns {
+				columnSchema := tableSchema.Column(column)
+				if columnSchema == nil || column == "_uuid" || column == "_version" {
+					continue
+				}
+				if !isDiff || !exists || (columnSchema.Type != ovsdb.TypeSet && columnSchema.Type != ovsdb.TypeMap) {
+					row[column] = value
+					continue
+				}
+				merged, err := applyColumnDiff(columnSchema.Type, row[column], value)
+				if err != nil {
+					return fmt.Errorf("failed to apply the difference of %s of %s %s: %w", column, table, rowUUID, err)
+				}
+				row[column] = merged
+			}
+		}
+	}
+	return nil
+}
+
+// applyColumnDiff applies the difference of a set or map column to its previous value.
+// A set difference adds the missing elements and removes the existing ones, a map
+// difference adds the missing keys, updates the keys with a different value and removes
+// the keys with the same value.
+func applyColumnDiff(columnType ovsdb.ExtendedType, previous, diff json.RawMessage) (json.RawMessage, error) {
+	if columnType == ovsdb.TypeMap {
+		values, err := ParseMap(previous)
+		if err != nil {
+			return nil, err
+		}
+		diffValues, err := ParseMap(diff)
+		if err != nil {
+			return nil, err
+		}
+		result := [][2]json.RawMessage{}
+		for _, pair := range values {
+			i := indexOfKey(diffValues, pair[0])
+			switch {
+			case i < 0:
+				result = append(result, pair)
+			case string(diffValues[i][1]) != string(pair[1]):
+				result = append(result, diffValues[i])
+			}
+		}
+		for _, pair := range diffValues {
+			if indexOfKey(values, pair[0]) < 0 {
+				result = append(result, pair)
+			}
+		}
+		return json.Marshal([]any{"map", result})
+	}
+
+	elements, err := ParseSet(previous)
+	if err != nil {
+		return nil, err
+	}
+	diffElements, err := ParseSet(diff)
+	if err != nil {
+		return nil, err
+	}
+	result := []json.RawMessage{}
+	for _, element := range elements {
+		if indexOf(diffElements, element) < 0 {
+			result = append(result, element)
+		}
+	}
+	for _, element := range diffElements {
+		if indexOf(elements, element) < 0 {
+			result = append(result, element)
+		}
+	}
+	return json.Marshal([]any{"set", result})
+}
+
+func indexOf(elements []json.RawMessage, element json.RawMessage) int {
+	for i := range elements {
+		if string(elements[i]) == string(element) {
+			return i
+		}
+	}
+	return -1
+}
+
+func indexOfKey(pairs [][2]json.RawMessage, key json.RawMessage) int {
+	for i := range pairs {
+		if string(pairs[i][0]) == string(key) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseSet parses a set in OVSDB JSON notation: ["set", [elements...]] or a single
+// element. A missing value is an empty set.
+func ParseSet(value json.RawMessage) ([]json.RawMessage, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	var notation []json.RawMessage
+	if err := json.Unmarshal(value, &notation); err == nil && len(notation) == 2 {
+		var kind string
+		if json.Unmarshal(notation[0], &kind) == nil && kind == "set" {
+			var elements []json.RawMessage
+			if err := json.Unmarshal(notation[1], &elements); err != nil {
+				return nil, err
+			}
+			return elements, nil
+		}
+	}
+	return []json.RawMessage{value}, nil
+}
+
+// ParseMap parses a map in OVSDB JSON notation: ["map", [[key, value], ...]].
+// A missing value is an empty map.
+func ParseMap(value json.RawMessage) ([][2]json.RawMessage, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	var notation []json.RawMessage
+	if err := json.Unmarshal(value, &notation); err != nil {
+		return nil, err
+	}
+	var kind string
+	if len(notation) != 2 || json.Unmarshal(notation[0], &kind) != nil || kind != "map" {
+		return nil, fmt.Errorf("expected a map, got %s", string(value))
+	}
+	var pairs [][2]json.RawMessage
+	if err := json.Unmarshal(notation[1], &pairs); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
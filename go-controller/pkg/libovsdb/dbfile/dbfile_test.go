@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package dbfile
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+func record(data string) string {
+	return fmt.Sprintf("OVSDB JSON %d 0000000000000000000000000000000000000000\n%s\n", len(data), data)
+}
+
+func TestRead(t *testing.T) {
+	g := gomega.NewWithT(t)
+	file := record(`{"name":"OVN_Northbound","version":"7.3.0","tables":{}}`) +
+		record(`{"Port_Group":{"pg1":{"name":"pg1","acls":["set",[["uuid","acl1"],["uuid","acl2"]]]}},`+
+			`"Logical_Switch_Port":{"lsp1":{"name":"ns1_pod1","external_ids":["map",[["namespace","ns1"],["pod","true"]]]}},`+
+			`"ACL":{"acl1":{"priority":1001},"acl2":{"priority":1002},"acl3":{"priority":1003}},`+
+			`"Unknown_Table":{"row1":{"name":"ignored"}},"_date":1700000000000}`) +
+		// pg1: add acl3 and remove acl1, lsp1: add an external id, acl1: delete
+		record(`{"Port_Group":{"pg1":{"acls":["set",[["uuid","acl1"],["uuid","acl3"]]]}},`+
+			`"Logical_Switch_Port":{"lsp1":{"external_ids":["map",[["foo","bar"]]]}},`+
+			`"ACL":{"acl1":null},"_is_diff":true}`)
+
+	schema := nbdb.Schema()
+	rows, err := Read(strings.NewReader(file), &schema)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(rows).NotTo(gomega.HaveKey("Unknown_Table"))
+	g.Expect(rows[nbdb.ACLTable]).To(gomega.HaveLen(2))
+	g.Expect(string(rows[nbdb.PortGroupTable]["pg1"]["acls"])).To(gomega.Equal(`["set",[["uuid","acl2"],["uuid","acl3"]]]`))
+	g.Expect(string(rows[nbdb.LogicalSwitchPortTable]["lsp1"]["external_ids"])).To(gomega.Equal(
+		`["map",[["namespace","ns1"],["pod","true"],["foo","bar"]]]`))
+
+	_, err = Read(strings.NewReader(record(`{"name":"OVN_Southbound","tables":{}}`)), &schema)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("expected a OVN_Northbound database")))
+	_, err = Read(strings.NewReader("OVSDB CLUSTER 2 0000\n{}\n"), &schema)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("clustered database files are not supported")))
+}
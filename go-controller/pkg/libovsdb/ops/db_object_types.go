@@ -25,6 +25,10 @@ const (
 	AdminNetworkPolicyOwnerType         ownerType = "AdminNetworkPolicy"
 	BaselineAdminNetworkPolicyOwnerType ownerType = "BaselineAdminNetworkPolicy"
 	NetworkQoSOwnerType                 ownerType = "NetworkQoS"
+	MulticastGroupOwnerType             ownerType = "MulticastGroup"
+	// ClusterNetworkPolicy objects are owned per tier, so that ACLs end up in the matching OVN ACL tier
+	ClusterNetworkPolicyAdminOwnerType    ownerType = "ClusterNetworkPolicyAdmin"
+	ClusterNetworkPolicyBaselineOwnerType ownerType = "ClusterNetworkPolicyBaseline"
 	// NetworkPolicyOwnerType is deprecated for address sets, should only be used for sync.
 	// New owner of network policy address sets, is PodSelectorOwnerType.
 	NetworkPolicyOwnerType ownerType = "NetworkPolicy"
@@ -50,6 +54,9 @@ const (
 	// UDNIsolationOwnerType means the object is needed to implement UserDefinedNetwork isolation
 	UDNIsolationOwnerType          ownerType = "UDNIsolation"
 	ClusterNetworkConnectOwnerType ownerType = "ClusterNetworkConnect"
+	// ClusterNetworkConnectAllowedTrafficOwnerType is used for the objects implementing the allowedTraffic
+	// rules of a ClusterNetworkConnect on the switches of the connected networks
+	ClusterNetworkConnectAllowedTrafficOwnerType ownerType = "ClusterNetworkConnectAllowedTraffic"
 
 	// owner extra IDs, make sure to define only 1 ExternalIDKey for every string value
 	PriorityKey             ExternalIDKey = "priority"
@@ -93,12 +100,40 @@ var AddressSetBaselineAdminNetworkPolicy = newObjectIDsType(addressSet, Baseline
 	IPFamilyKey,
 })
 
+var AddressSetClusterNetworkPolicyAdmin = newObjectIDsType(addressSet, ClusterNetworkPolicyAdminOwnerType, []ExternalIDKey{
+	// cnp name
+	ObjectNameKey,
+	// egress or ingress
+	PolicyDirectionKey,
+	// gress rule's index
+	GressIdxKey,
+	IPFamilyKey,
+})
+
+var AddressSetClusterNetworkPolicyBaseline = newObjectIDsType(addressSet, ClusterNetworkPolicyBaselineOwnerType, []ExternalIDKey{
+	// cnp name
+	ObjectNameKey,
+	// egress or ingress
+	PolicyDirectionKey,
+	// gress rule's index
+	GressIdxKey,
+	IPFamilyKey,
+})
+
 var AddressSetEgressFirewallDNS = newObjectIDsType(addressSet, EgressFirewallDNSOwnerType, []ExternalIDKey{
 	// dnsName
 	ObjectNameKey,
 	IPFamilyKey,
 })
 
+var AddressSetEgressFirewallPodSelector = newObjectIDsType(addressSet, EgressFirewallOwnerType, []ExternalIDKey{
+	// namespace
+	ObjectNameKey,
+	// egress firewall rule index
+	RuleIndex,
+	IPFamilyKey,
+})
+
 var AddressSetHybridNodeRoute = newObjectIDsType(addressSet, HybridNodeRouteOwnerType, []ExternalIDKey{
 	// nodeName
 	ObjectNameKey,
@@ -165,12 +200,20 @@ var AddressSetUDNEnabledService = newObjectIDsType(addressSet, UDNEnabledService
 var AddressSetNetworkQoS = newObjectIDsType(addressSet, NetworkQoSOwnerType, []ExternalIDKey{
 	// nqos namespace:name
 	ObjectNameKey,
+	// Egress or Ingress, empty for the source address set
+	PolicyDirectionKey,
 	// rule index
 	RuleIndex,
 	IpBlockIndexKey,
 	IPFamilyKey,
 })
 
+var AddressSetMulticastGroup = newObjectIDsType(addressSet, MulticastGroupOwnerType, []ExternalIDKey{
+	// MulticastGroup name, the address set holds the IPs of the group producers
+	ObjectNameKey,
+	IPFamilyKey,
+})
+
 var AddressSetAdvertisedNetwork = newObjectIDsType(addressSet, AdvertisedNetworkOwnerType, []ExternalIDKey{
 	// cluster-wide address set name
 	ObjectNameKey,
@@ -184,6 +227,15 @@ var AddressSetClusterNetworkConnect = newObjectIDsType(addressSet, ClusterNetwor
 	IPFamilyKey,
 })
 
+var AddressSetClusterNetworkConnectAllowedTraffic = newObjectIDsType(addressSet, ClusterNetworkConnectAllowedTrafficOwnerType, []ExternalIDKey{
+	// CNC name
+	ObjectNameKey,
+	// index of the allowedTraffic rule whose selected pod IPs are stored in the address set
+	RuleIndex,
+	// IP family: v4 or v6
+	IPFamilyKey,
+})
+
 var AddressSetNoOverlaySNATExemption = newObjectIDsType(addressSet, ClusterOwnerType, []ExternalIDKey{
 	// Address set for no-overlay SNAT exemption containing cluster pod subnet CIDRs and local zone node IPs
 	ObjectNameKey,
@@ -220,6 +272,28 @@ var ACLBaselineAdminNetworkPolicy = newObjectIDsType(acl, BaselineAdminNetworkPo
 	PortPolicyProtocolKey,
 })
 
+var ACLClusterNetworkPolicyAdmin = newObjectIDsType(acl, ClusterNetworkPolicyAdminOwnerType, []ExternalIDKey{
+	// cnp name
+	ObjectNameKey,
+	// egress or ingress
+	PolicyDirectionKey,
+	// gress rule's index
+	GressIdxKey,
+	// gress rule's peer port's protocol index
+	PortPolicyProtocolKey,
+})
+
+var ACLClusterNetworkPolicyBaseline = newObjectIDsType(acl, ClusterNetworkPolicyBaselineOwnerType, []ExternalIDKey{
+	// cnp name
+	ObjectNameKey,
+	// egress or ingress
+	PolicyDirectionKey,
+	// gress rule's index
+	GressIdxKey,
+	// gress rule's peer port's protocol index
+	PortPolicyProtocolKey,
+})
+
 var ACLNetpolDefault = newObjectIDsType(acl, NetpolDefaultOwnerType, []ExternalIDKey{
 	// for now there is only 1 acl of this type, but we use a name in case more types are needed in the future
 	ObjectNameKey,
@@ -324,6 +398,24 @@ var ACLClusterNetworkConnect = newObjectIDsType(acl, ClusterNetworkConnectOwnerT
 	TypeKey,
 })
 
+var ACLClusterNetworkConnectAllowedTraffic = newObjectIDsType(acl, ClusterNetworkConnectAllowedTrafficOwnerType, []ExternalIDKey{
+	// CNC name
+	ObjectNameKey,
+	// ID of the connected network whose switch the ACL is applied on
+	NetworkIDKey,
+	// type of ACL: pass-<rule index> or drop
+	TypeKey,
+})
+
+var ACLMulticastGroup = newObjectIDsType(acl, MulticastGroupOwnerType, []ExternalIDKey{
+	// MulticastGroup name
+	ObjectNameKey,
+	// Producers or Consumers
+	TypeKey,
+	// egress or ingress
+	PolicyDirectionKey,
+})
+
 var VirtualMachineDHCPOptions = newObjectIDsType(dhcpOptions, VirtualMachineOwnerType, []ExternalIDKey{
 	// We can have multiple VMs with same CIDR they  may have different
 	// hostname.
@@ -362,6 +454,23 @@ var PortGroupBaselineAdminNetworkPolicy = newObjectIDsType(portGroup, BaselineAd
 	ObjectNameKey,
 })
 
+var PortGroupClusterNetworkPolicyAdmin = newObjectIDsType(portGroup, ClusterNetworkPolicyAdminOwnerType, []ExternalIDKey{
+	// CNP name
+	ObjectNameKey,
+})
+
+var PortGroupClusterNetworkPolicyBaseline = newObjectIDsType(portGroup, ClusterNetworkPolicyBaselineOwnerType, []ExternalIDKey{
+	// CNP name
+	ObjectNameKey,
+})
+
+var PortGroupMulticastGroup = newObjectIDsType(portGroup, MulticastGroupOwnerType, []ExternalIDKey{
+	// MulticastGroup name
+	ObjectNameKey,
+	// Producers or Consumers
+	TypeKey,
+})
+
 var PortGroupCluster = newObjectIDsType(portGroup, ClusterOwnerType, []ExternalIDKey{
 	// name of a global port group
 	// currently ClusterPortGroup and ClusterRtrPortGroup are present
@@ -412,6 +521,8 @@ var QoSRuleEgressIP = newObjectIDsType(qos, EgressIPOwnerType, []ExternalIDKey{
 
 var NetworkQoS = newObjectIDsType(qos, NetworkQoSOwnerType, []ExternalIDKey{
 	ObjectNameKey,
+	// Egress or Ingress
+	PolicyDirectionKey,
 	// rule index
 	RuleIndex,
 })
@@ -912,14 +912,15 @@ func DeleteLogicalRouterStaticRoutes(nbClient libovsdbclient.Client, routerName
 // BFD ops
 
 // CreateOrUpdateBFDOps creates or updates the provided BFDs and returns
-// the corresponding ops
+// the corresponding ops. The session timers are always updated, so that
+// unset timers fall back to the OVN defaults.
 func CreateOrUpdateBFDOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation, bfds ...*nbdb.BFD) ([]ovsdb.Operation, error) {
 	opModels := make([]operationModel, 0, len(bfds))
 	for i := range bfds {
 		bfd := bfds[i]
 		opModel := operationModel{
 			Model:          bfd,
-			OnModelUpdates: onModelUpdatesAllNonDefault(),
+			OnModelUpdates: []interface{}{&bfd.MinTx, &bfd.MinRx, &bfd.DetectMult},
 			ErrNotFound:    false,
 			BulkOp:         false,
 		}
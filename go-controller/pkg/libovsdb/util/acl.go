@@ -87,6 +87,9 @@ func GetACLName(dbIDs *libovsdbops.DbObjectIDs) string {
 	case t.IsSameType(libovsdbops.ACLBaselineAdminNetworkPolicy):
 		aclName = "BANP:" + dbIDs.GetObjectID(libovsdbops.ObjectNameKey) + ":" + dbIDs.GetObjectID(libovsdbops.PolicyDirectionKey) +
 			":" + dbIDs.GetObjectID(libovsdbops.GressIdxKey)
+	case t.IsSameType(libovsdbops.ACLClusterNetworkPolicyAdmin), t.IsSameType(libovsdbops.ACLClusterNetworkPolicyBaseline):
+		aclName = "CNP:" + dbIDs.GetObjectID(libovsdbops.ObjectNameKey) + ":" + dbIDs.GetObjectID(libovsdbops.PolicyDirectionKey) +
+			":" + dbIDs.GetObjectID(libovsdbops.GressIdxKey)
 	}
 	return fmt.Sprintf("%.63s", aclName)
 }
@@ -145,9 +148,9 @@ func BuildANPACL(dbIDs *libovsdbops.DbObjectIDs, priority int, match, action str
 func GetACLTier(dbIDs *libovsdbops.DbObjectIDs) int {
 	t := dbIDs.GetIDsType()
 	switch {
-	case t.IsSameType(libovsdbops.ACLAdminNetworkPolicy):
+	case t.IsSameType(libovsdbops.ACLAdminNetworkPolicy), t.IsSameType(libovsdbops.ACLClusterNetworkPolicyAdmin):
 		return types.DefaultANPACLTier
-	case t.IsSameType(libovsdbops.ACLBaselineAdminNetworkPolicy):
+	case t.IsSameType(libovsdbops.ACLBaselineAdminNetworkPolicy), t.IsSameType(libovsdbops.ACLClusterNetworkPolicyBaseline):
 		return types.DefaultBANPACLTier
 	default:
 		return types.DefaultACLTier
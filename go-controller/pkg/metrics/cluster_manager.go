@@ -74,6 +74,28 @@ var metricV6AllocatedHostSubnetCount = prometheus.NewGaugeVec(prometheus.GaugeOp
 	},
 )
 
+var metricPodIPsCapacity = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemClusterManager,
+	Name:      "pod_ips_capacity",
+	Help:      "The total number of pod IPs that can be allocated per network subnet, for networks whose pod IPs are allocated by cluster manager"},
+	[]string{
+		"network_name",
+		"subnet",
+	},
+)
+
+var metricPodIPsAllocated = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemClusterManager,
+	Name:      "allocated_pod_ips",
+	Help:      "The number of pod IPs currently allocated per network subnet, including IPs excluded from allocation, for networks whose pod IPs are allocated by cluster manager"},
+	[]string{
+		"network_name",
+		"subnet",
+	},
+)
+
 /** EgressIP metrics recorded from cluster-manager begins**/
 var metricEgressIPCount = prometheus.NewGauge(prometheus.GaugeOpts{
 	Namespace: types.MetricOvnkubeNamespace,
@@ -195,6 +217,8 @@ func RegisterClusterManagerFunctional() {
 		prometheus.MustRegister(metricV6HostSubnetCount)
 		prometheus.MustRegister(metricV4AllocatedHostSubnetCount)
 		prometheus.MustRegister(metricV6AllocatedHostSubnetCount)
+		prometheus.MustRegister(metricPodIPsCapacity)
+		prometheus.MustRegister(metricPodIPsAllocated)
 		if config.OVNKubernetesFeature.EnableEgressIP {
 			prometheus.MustRegister(metricEgressIPNodeUnreacheableCount)
 			prometheus.MustRegister(metricEgressIPRebalanceCount)
@@ -218,6 +242,12 @@ func RegisterClusterManagerFunctional() {
 				panic(err)
 			}
 		}
+		if err := prometheus.Register(MetricResourceRetryEntries); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if !errors.As(err, &alreadyRegistered) {
+				panic(err)
+			}
+		}
 	})
 }
 
@@ -234,6 +264,19 @@ func RecordSubnetCount(v4SubnetCount, v6SubnetCount float64, networkName string)
 	metricV6HostSubnetCount.WithLabelValues(networkName).Set(v6SubnetCount)
 }
 
+// RecordPodIPUsage records the number of allocated and allocatable pod IPs of
+// a network subnet
+func RecordPodIPUsage(networkName, subnet string, allocated, capacity float64) {
+	metricPodIPsAllocated.WithLabelValues(networkName, subnet).Set(allocated)
+	metricPodIPsCapacity.WithLabelValues(networkName, subnet).Set(capacity)
+}
+
+// DeletePodIPUsage removes the pod IP usage of all the subnets of a network
+func DeletePodIPUsage(networkName string) {
+	metricPodIPsAllocated.DeletePartialMatch(prometheus.Labels{"network_name": networkName})
+	metricPodIPsCapacity.DeletePartialMatch(prometheus.Labels{"network_name": networkName})
+}
+
 // RecordEgressIPReachableNode records how many times EgressIP detected an unuseable node.
 func RecordEgressIPUnreachableNode() {
 	metricEgressIPNodeUnreacheableCount.Inc()
@@ -69,6 +69,14 @@ var MetricResourceRetryFailuresCount = prometheus.NewCounter(prometheus.CounterO
 	Help:      "The total number of times processing a Kubernetes resource reached the maximum retry limit and was no longer processed",
 })
 
+// MetricResourceRetryEntries is the number of Kubernetes resources waiting to be retried, per
+// resource type. It is sampled every time the retry frameworks go over their retry entries.
+var MetricResourceRetryEntries = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Name:      "resource_retry_entries",
+	Help:      "The number of Kubernetes resources waiting to be retried, per resource type",
+}, []string{"resource_type"})
+
 // OVN/OVS components, namely ovn-northd, ovn-controller, and ovs-vswitchd provide various
 // metrics through the 'coverage/show' command. The following data structure holds all the
 // metrics we are interested in that output for a given component. We generalize capturing
@@ -50,6 +50,55 @@ var metricOvnKubeNodeLogFileSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	},
 )
 
+// metricNetlinkDriftDetected is the number of times a node manager found the
+// kernel state it manages removed or altered by something else
+var metricNetlinkDriftDetected = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemNode,
+	Name:      "netlink_drift_detected_total",
+	Help: "The number of times a route, VRF or IP rule managed by ovnkube-node was found " +
+		"removed or altered by something else, by manager and routing table or VRF."},
+	[]string{
+		"manager",
+		"table",
+	},
+)
+
+// metricNetlinkDriftRepaired is the number of times a node manager restored
+// the kernel state it manages
+var metricNetlinkDriftRepaired = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemNode,
+	Name:      "netlink_drift_repaired_total",
+	Help: "The number of times a route, VRF or IP rule managed by ovnkube-node was restored " +
+		"after it was removed or altered by something else, by manager and routing table or VRF."},
+	[]string{
+		"manager",
+		"table",
+	},
+)
+
+var metricNetlinkErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemNode,
+	Name:      "netlink_errors_total",
+	Help:      "The number of failed netlink operations of the route, VRF and IP rule managers."},
+	[]string{
+		"manager",
+		"operation",
+	},
+)
+
+var metricNetlinkLastSync = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemNode,
+	Name:      "netlink_last_sync_timestamp_seconds",
+	Help:      "The time of the last successful sync of the route, VRF and IP rule managers."},
+	[]string{
+		"manager",
+	},
+)
+
 var registerNodeMetricsOnce sync.Once
 
 func RegisterNodeMetrics(stopChan <-chan struct{}) {
@@ -82,7 +131,40 @@ func RegisterNodeMetrics(stopChan <-chan struct{}) {
 				panic(err)
 			}
 		}
+		if err := prometheus.Register(MetricResourceRetryEntries); err != nil {
+			if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+				panic(err)
+			}
+		}
 		prometheus.MustRegister(metricOvnKubeNodeLogFileSize)
+		prometheus.MustRegister(metricNetlinkDriftDetected)
+		prometheus.MustRegister(metricNetlinkDriftRepaired)
+		prometheus.MustRegister(metricNetlinkErrors)
+		prometheus.MustRegister(metricNetlinkLastSync)
 		go ovnKubeLogFileSizeMetricsUpdater(metricOvnKubeNodeLogFileSize, stopChan)
 	})
 }
+
+// RecordNetlinkDriftDetected records that the given node manager found the
+// kernel state it manages in a routing table or VRF removed or altered
+func RecordNetlinkDriftDetected(manager, table string) {
+	metricNetlinkDriftDetected.WithLabelValues(manager, table).Inc()
+}
+
+// RecordNetlinkDriftRepaired records that the given node manager restored the
+// kernel state it manages in a routing table or VRF
+func RecordNetlinkDriftRepaired(manager, table string) {
+	metricNetlinkDriftRepaired.WithLabelValues(manager, table).Inc()
+}
+
+// RecordNetlinkError records a failed netlink operation of the given node
+// manager
+func RecordNetlinkError(manager, operation string) {
+	metricNetlinkErrors.WithLabelValues(manager, operation).Inc()
+}
+
+// RecordNetlinkSync records the time of the last successful sync of the given
+// node manager
+func RecordNetlinkSync(manager string) {
+	metricNetlinkLastSync.WithLabelValues(manager).SetToCurrentTime()
+}
@@ -278,6 +278,40 @@ var metricBANPCount = prometheus.NewGauge(prometheus.GaugeOpts{
 	Help:      "The total number of baseline admin network policies in the cluster",
 })
 
+var metricCNPCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "cluster_network_policies",
+	Help:      "The total number of cluster network policies in the cluster"},
+	[]string{
+		"tier", // tier is either "Admin" or "Baseline"; so cardinality is max 2 for this label
+	},
+)
+
+var metricNodePodIPsCapacity = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "node_pod_ips_capacity",
+	Help:      "The total number of pod IPs that can be allocated per network host subnet of the local zone nodes"},
+	[]string{
+		"network_name",
+		"node",
+		"subnet",
+	},
+)
+
+var metricNodePodIPsAllocated = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "node_allocated_pod_ips",
+	Help:      "The number of pod IPs currently allocated per network host subnet of the local zone nodes, including IPs excluded from allocation"},
+	[]string{
+		"network_name",
+		"node",
+		"subnet",
+	},
+)
+
 var metricANPDBObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	Namespace: types.MetricOvnkubeNamespace,
 	Subsystem: types.MetricOvnkubeSubsystemController,
@@ -408,11 +442,19 @@ func RegisterOVNKubeControllerFunctional(stopChan <-chan struct{}) {
 	prometheus.MustRegister(metricEgressRoutingViaHost)
 	prometheus.MustRegister(metricANPCount)
 	prometheus.MustRegister(metricBANPCount)
+	prometheus.MustRegister(metricCNPCount)
+	prometheus.MustRegister(metricNodePodIPsCapacity)
+	prometheus.MustRegister(metricNodePodIPsAllocated)
 	if err := prometheus.Register(MetricResourceRetryFailuresCount); err != nil {
 		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
 			panic(err)
 		}
 	}
+	if err := prometheus.Register(MetricResourceRetryEntries); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			panic(err)
+		}
+	}
 	// ovnkube-controller logfile size metric
 	prometheus.MustRegister(metricOvnKubeControllerLogFileSize)
 	go ovnKubeLogFileSizeMetricsUpdater(metricOvnKubeControllerLogFileSize, stopChan)
@@ -565,6 +607,24 @@ func UpdateEgressFirewallRuleCount(count float64) {
 	metricEgressFirewallRuleCount.Add(count)
 }
 
+// RecordNodePodIPUsage records the number of allocated and allocatable pod IPs
+// of a network host subnet of a node
+func RecordNodePodIPUsage(networkName, nodeName, subnet string, allocated, capacity float64) {
+	metricNodePodIPsAllocated.WithLabelValues(networkName, nodeName, subnet).Set(allocated)
+	metricNodePodIPsCapacity.WithLabelValues(networkName, nodeName, subnet).Set(capacity)
+}
+
+// DeleteNodePodIPUsage removes the pod IP usage of the network host subnets of
+// a node, or of all the nodes if nodeName is empty
+func DeleteNodePodIPUsage(networkName, nodeName string) {
+	labels := prometheus.Labels{"network_name": networkName}
+	if nodeName != "" {
+		labels["node"] = nodeName
+	}
+	metricNodePodIPsAllocated.DeletePartialMatch(labels)
+	metricNodePodIPsCapacity.DeletePartialMatch(labels)
+}
+
 // RecordEgressRoutingViaHost records the egress gateway mode of the cluster
 // The values are:
 // 0: If it is shared gateway mode
@@ -643,6 +703,16 @@ func DecrementBANPCount() {
 	metricBANPCount.Dec()
 }
 
+// IncrementCNPCount increments the number of Cluster Network Policies in the given tier
+func IncrementCNPCount(tier string) {
+	metricCNPCount.WithLabelValues(tier).Inc()
+}
+
+// DecrementCNPCount decrements the number of Cluster Network Policies in the given tier
+func DecrementCNPCount(tier string) {
+	metricCNPCount.WithLabelValues(tier).Dec()
+}
+
 type (
 	timestampType int
 	operation     int
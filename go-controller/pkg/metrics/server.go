@@ -42,6 +42,10 @@ type MetricServerOptions struct {
 	EnableOVNNorthdMetrics     bool
 	EnablePprof                bool
 
+	// DebugHandlers are additional handlers served by the MetricServer, keyed by path pattern,
+	// along with the pprof handlers when EnablePprof is set
+	DebugHandlers map[string]http.Handler
+
 	OVSDBClient libovsdbclient.Client
 
 	// OnFatalError is called when an unrecoverable error occurs (e.g., failed to bind to address).
@@ -104,6 +108,10 @@ func NewMetricServer(opts MetricServerOptions) *MetricServer {
 
 		// Allow changes to log level at runtime
 		server.mux.HandleFunc("/debug/flags/v", stringFlagPutHandler(klogSetter))
+
+		for pattern, handler := range opts.DebugHandlers {
+			server.mux.Handle(pattern, handler)
+		}
 	}
 
 	return server
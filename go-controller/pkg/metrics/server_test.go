@@ -115,6 +115,18 @@ var _ = Describe("MetricServer", func() {
 	When("EnablePprof", func() {
 		BeforeEach(func() {
 			t.opts.EnablePprof = true
+			t.opts.DebugHandlers = map[string]http.Handler{"/debug/test": http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})}
+		})
+
+		It("should serve the debug handlers", func() {
+			Eventually(func(g Gomega) {
+				resp, err := http.Get(fmt.Sprintf("http://%s/debug/test", t.opts.BindAddress))
+				g.Expect(err).NotTo(HaveOccurred())
+				defer resp.Body.Close()
+				g.Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			}).Within(5 * time.Second).Should(Succeed())
 		})
 
 		It("should serve pprof endpoints", func() {
@@ -145,6 +157,23 @@ var _ = Describe("MetricServer", func() {
 		})
 	})
 
+	When("EnablePprof is not set", func() {
+		BeforeEach(func() {
+			t.opts.DebugHandlers = map[string]http.Handler{"/debug/test": http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})}
+		})
+
+		It("should not serve the debug handlers", func() {
+			Eventually(func(g Gomega) {
+				resp, err := http.Get(fmt.Sprintf("http://%s/debug/test", t.opts.BindAddress))
+				g.Expect(err).NotTo(HaveOccurred())
+				defer resp.Body.Close()
+				g.Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+			}).Within(5 * time.Second).Should(Succeed())
+		})
+	})
+
 	Context("", func() {
 		var onFatalInvoked <-chan struct{}
 
@@ -90,6 +90,9 @@ type BridgeConfiguration struct {
 	// the representor of the configured gateway VF/SF.
 	gwIfaceRep  string
 	interfaceID string
+	// isUplinkBridge is set for the bridges of Uplinks, which only carry
+	// the user defined networks attached to them and never the default network.
+	isUplinkBridge bool
 
 	// variables that can be updated (read/write access should be done with mutex held)
 	ofPortHost string
@@ -266,6 +269,60 @@ func NewBridgeConfiguration(ovsClient libovsdbclient.Client, intfName, nodeName,
 	return &res, nil
 }
 
+// NewUplinkBridgeConfiguration returns the configuration of the pre-provisioned
+// OVS bridge of an Uplink. Unlike the default gateway bridge it starts with no
+// network configured, networks are added as they are attached to the Uplink.
+func NewUplinkBridgeConfiguration(bridgeName, hostInterfaceName, nodeName string,
+	ips []*net.IPNet, macAddress net.HardwareAddr) (*BridgeConfiguration, error) {
+	uplinkName, err := util.GetNicName(bridgeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find nic name for bridge %s: %w", bridgeName, err)
+	}
+	// IPv6 forwarding is enabled globally
+	if config.IPv4Mode {
+		if err := util.SetForwardingModeForInterface(hostInterfaceName); err != nil {
+			return nil, err
+		}
+	}
+	res := &BridgeConfiguration{
+		nodeName:       nodeName,
+		bridgeName:     bridgeName,
+		uplinkName:     uplinkName,
+		gwIface:        hostInterfaceName,
+		interfaceID:    bridgeName + "_" + nodeName,
+		isUplinkBridge: true,
+		ips:            ips,
+		macAddress:     macAddress,
+		netConfig:      map[string]*BridgeUDNConfiguration{},
+		eipMarkIPs:     egressip.NewMarkIPsCache(),
+	}
+	if err := res.ConfigureBridgePorts(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsUplinkBridge returns true if this is the bridge of an Uplink.
+func (b *BridgeConfiguration) IsUplinkBridge() bool {
+	return b.isUplinkBridge
+}
+
+// HasNetworks returns true if any network is configured on the bridge.
+func (b *BridgeConfiguration) HasNetworks() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.netConfig) > 0
+}
+
+// vlanID returns the VLAN of the gateway interface. Uplink bridges are
+// untagged, the gateway VLAN only applies to the default gateway bridge.
+func (b *BridgeConfiguration) vlanID() uint {
+	if b.isUplinkBridge {
+		return 0
+	}
+	return config.Gateway.VLANID
+}
+
 func (b *BridgeConfiguration) setDPUHostGatewayConfiguration(nodeName string) error {
 	if b.gwIfaceRep == "" {
 		b.gwIfaceRep = config.Gateway.DPUHostGatewayRepresentorInterface
@@ -470,7 +527,7 @@ func (b *BridgeConfiguration) ConfigureBridgePorts() error {
 	}
 
 	// Ensure the host port on the bridge carries the configured VLAN tag when requested.
-	if hostOVSInterfaceName != "" && config.Gateway.VLANID != 0 {
+	if vlanID := b.vlanID(); hostOVSInterfaceName != "" && vlanID != 0 {
 		ifaceUUID, stderr, err := util.RunOVSVsctl("--data=bare", "--no-heading", "--columns=_uuid",
 			"find", "Interface", fmt.Sprintf("name=%s", hostOVSInterfaceName))
 		if err != nil {
@@ -493,7 +550,7 @@ func (b *BridgeConfiguration) ConfigureBridgePorts() error {
 			return fmt.Errorf("failed to determine port for host interface %s on bridge %s", hostOVSInterfaceName, b.bridgeName)
 		}
 		if _, stderr, err = util.RunOVSVsctl("set", "Port", portName,
-			fmt.Sprintf("tag=%d", config.Gateway.VLANID)); err != nil {
+			fmt.Sprintf("tag=%d", vlanID)); err != nil {
 			return fmt.Errorf("failed to set VLAN tag on port %s for bridge %s, stderr: %q, error: %v",
 				portName, b.bridgeName, stderr, err)
 		}
@@ -573,7 +630,6 @@ func gatewayReady(patchPort string) bool {
 	if err != nil || len(ofport) == 0 {
 		return false
 	}
-	klog.Info("Gateway is ready")
 	return true
 }
 
@@ -605,46 +661,72 @@ func bridgedGatewayNodeSetup(ovsClient libovsdbclient.Client, nodeName, bridgeNa
 		}
 	}
 
+	if err := SetBridgeMapping(ovsClient, physicalNetworkName, bridgeName); err != nil {
+		return "", err
+	}
+
+	ifaceID := bridgeName + "_" + nodeName
+	return ifaceID, nil
+}
+
+// SetBridgeMapping maps the physical network name to the given OVS bridge in
+// ovn-bridge-mappings, leaving the mappings of other physical networks intact.
+func SetBridgeMapping(ovsClient libovsdbclient.Client, physicalNetworkName, bridgeName string) error {
 	// ovn-bridge-mappings maps a physical network name to a local ovs bridge
 	// that provides connectivity to that network. It is in the form of physnet1:br1,physnet2:br2.
 	// Note that there may be multiple ovs bridge mappings, be sure not to override
 	// the mappings for the other physical network
+	mappings, err := getOtherBridgeMappings(ovsClient, physicalNetworkName)
+	if err != nil {
+		return err
+	}
+	mappings = append(mappings, physicalNetworkName+":"+bridgeName)
+	if err := ovsops.UpdateOpenvSwitchExternalIDs(ovsClient, map[string]string{
+		"ovn-bridge-mappings": strings.Join(mappings, ","),
+	}); err != nil {
+		return fmt.Errorf("failed to set ovn-bridge-mappings for ovs bridge %s: %w", bridgeName, err)
+	}
+	return nil
+}
+
+// RemoveBridgeMapping removes the mapping of the physical network name from
+// ovn-bridge-mappings, if present.
+func RemoveBridgeMapping(ovsClient libovsdbclient.Client, physicalNetworkName string) error {
+	mappings, err := getOtherBridgeMappings(ovsClient, physicalNetworkName)
+	if err != nil {
+		return err
+	}
+	if err := ovsops.UpdateOpenvSwitchExternalIDs(ovsClient, map[string]string{
+		"ovn-bridge-mappings": strings.Join(mappings, ","),
+	}); err != nil {
+		return fmt.Errorf("failed to remove ovn-bridge-mappings for physical network %s: %w", physicalNetworkName, err)
+	}
+	return nil
+}
+
+// getOtherBridgeMappings returns the existing ovn-bridge-mappings of every
+// physical network other than the given one.
+func getOtherBridgeMappings(ovsClient libovsdbclient.Client, physicalNetworkName string) ([]string, error) {
 	existing := ""
 	if ovs, err := ovsops.GetOpenvSwitch(ovsClient); err != nil {
 		if !errors.Is(err, libovsdbclient.ErrNotFound) {
-			return "", fmt.Errorf("failed to get Open_vSwitch row: %w", err)
+			return nil, fmt.Errorf("failed to get Open_vSwitch row: %w", err)
 		}
 		// no row yet => no existing mappings
 	} else {
 		existing = ovs.ExternalIDs["ovn-bridge-mappings"]
 	}
 
-	// skip the existing mapping setting for the specified physicalNetworkName
-	mapString := ""
+	var mappings []string
 	if existing != "" {
 		for _, bridgeMapping := range strings.Split(existing, ",") {
 			m := strings.Split(bridgeMapping, ":")
 			if network := m[0]; network != physicalNetworkName {
-				if len(mapString) != 0 {
-					mapString += ","
-				}
-				mapString += bridgeMapping
+				mappings = append(mappings, bridgeMapping)
 			}
 		}
 	}
-	if len(mapString) != 0 {
-		mapString += ","
-	}
-	mapString += physicalNetworkName + ":" + bridgeName
-
-	if err := ovsops.UpdateOpenvSwitchExternalIDs(ovsClient, map[string]string{
-		"ovn-bridge-mappings": mapString,
-	}); err != nil {
-		return "", fmt.Errorf("failed to set ovn-bridge-mappings for ovs bridge %s: %w", bridgeName, err)
-	}
-
-	ifaceID := bridgeName + "_" + nodeName
-	return ifaceID, nil
+	return mappings, nil
 }
 
 func getRepresentor(intfName string) (string, error) {
@@ -69,10 +69,10 @@ func (b *BridgeConfiguration) flowsForDefaultBridge(extraIPs []net.IP) ([]string
 	strip_vlan := ""
 	mod_vlan_id := ""
 	match_vlan := ""
-	if config.Gateway.VLANID != 0 {
+	if vlanID := b.vlanID(); vlanID != 0 {
 		strip_vlan = "strip_vlan,"
-		match_vlan = fmt.Sprintf("dl_vlan=%d,", config.Gateway.VLANID)
-		mod_vlan_id = fmt.Sprintf("mod_vlan_vid:%d,", config.Gateway.VLANID)
+		match_vlan = fmt.Sprintf("dl_vlan=%d,", vlanID)
+		mod_vlan_id = fmt.Sprintf("mod_vlan_vid:%d,", vlanID)
 	}
 
 	// Problem: ovn-controller connects to SB DB and then GARPs for any EIPs configured however for IC, SB DB maybe stale if
@@ -409,13 +409,14 @@ func (b *BridgeConfiguration) flowsForDefaultBridge(extraIPs []net.IP) ([]string
 				nodetypes.DefaultOpenFlowCookie, match_vlan, bridgeMacAddress, strip_vlan, ofPortHost))
 	}
 
-	defaultNetConfig := b.netConfig[types.DefaultNetworkName]
-
 	// table 2, dispatch from Host -> OVN
-	dftFlows = append(dftFlows,
-		fmt.Sprintf("cookie=%s, priority=100, table=2, "+
-			"actions=set_field:%s->eth_dst,%soutput:%s", nodetypes.DefaultOpenFlowCookie,
-			bridgeMacAddress, mod_vlan_id, defaultNetConfig.OfPortPatch))
+	// uplink bridges do not carry the default network
+	if defaultNetConfig := b.netConfig[types.DefaultNetworkName]; defaultNetConfig != nil {
+		dftFlows = append(dftFlows,
+			fmt.Sprintf("cookie=%s, priority=100, table=2, "+
+				"actions=set_field:%s->eth_dst,%soutput:%s", nodetypes.DefaultOpenFlowCookie,
+				bridgeMacAddress, mod_vlan_id, defaultNetConfig.OfPortPatch))
+	}
 
 	// table 2, priority 200, dispatch from UDN -> Host -> OVN. These packets have
 	// already been SNATed to the UDN's masquerade IP or have been marked with the UDN's packet mark.
@@ -775,14 +776,14 @@ func (b *BridgeConfiguration) commonFlows(hostSubnets []*net.IPNet) ([]string, e
 	stripVLAN := ""
 	matchVLAN := ""
 	modVLANID := ""
-	if config.Gateway.VLANID != 0 {
+	if vlanID := b.vlanID(); vlanID != 0 {
 		// When VLANID is specified, the gateway interface (i.e. LOCAL port) is considered an untagged
 		// access port in the VLAN. The physical port on the bridge is a trunk, carrying tagged VLAN packets,
 		// and the patch port to OVN is a tagged access port, where OVN expects to receive packets with the VLANID
 		// tag.
 		stripVLAN = "strip_vlan,"
-		matchVLAN = fmt.Sprintf("dl_vlan=%d,", config.Gateway.VLANID)
-		modVLANID = fmt.Sprintf("mod_vlan_vid:%d,", config.Gateway.VLANID)
+		matchVLAN = fmt.Sprintf("dl_vlan=%d,", vlanID)
+		modVLANID = fmt.Sprintf("mod_vlan_vid:%d,", vlanID)
 	}
 
 	if ofPortPhys != "" {
@@ -1150,7 +1151,7 @@ func (b *BridgeConfiguration) commonFlows(hostSubnets []*net.IPNet) ([]string, e
 					fmt.Sprintf("cookie=%s, priority=14, table=1,icmp6,icmpv6_type=%d actions=FLOOD",
 						nodetypes.DefaultOpenFlowCookie, icmpType))
 			}
-			if ofPortPhys != "" {
+			if ofPortPhys != "" && defaultNetConfig != nil {
 				// We send BFD traffic both on the host and in ovn
 				dftFlows = append(dftFlows,
 					fmt.Sprintf("cookie=%s, priority=13, table=1, in_port=%s, udp6, tp_dst=3784, actions=output:%s,output:%s",
@@ -1159,7 +1160,7 @@ func (b *BridgeConfiguration) commonFlows(hostSubnets []*net.IPNet) ([]string, e
 		}
 
 		if config.IPv4Mode {
-			if ofPortPhys != "" {
+			if ofPortPhys != "" && defaultNetConfig != nil {
 				// We send BFD traffic both on the host and in ovn
 				dftFlows = append(dftFlows,
 					fmt.Sprintf("cookie=%s, priority=13, table=1, in_port=%s, udp, tp_dst=3784, actions=output:%s,output:%s",
@@ -1185,9 +1186,11 @@ func (b *BridgeConfiguration) commonFlows(hostSubnets []*net.IPNet) ([]string, e
 				}
 			}
 
-			dftFlows = append(dftFlows,
-				fmt.Sprintf("cookie=%s, priority=1, table=11, "+
-					"actions=output:%s", nodetypes.DefaultOpenFlowCookie, defaultNetConfig.OfPortPatch))
+			if defaultNetConfig != nil {
+				dftFlows = append(dftFlows,
+					fmt.Sprintf("cookie=%s, priority=1, table=11, "+
+						"actions=output:%s", nodetypes.DefaultOpenFlowCookie, defaultNetConfig.OfPortPatch))
+			}
 		}
 
 		// table 1, all other connections do normal processing
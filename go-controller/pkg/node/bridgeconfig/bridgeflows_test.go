@@ -6,6 +6,7 @@ package bridgeconfig
 import (
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
@@ -228,6 +229,52 @@ func TestLocalNoOverlayServiceHairpinUsesUDNGatewayMasqueradeIP(t *testing.T) {
 	expectFlow(t, flows, expectedIPv6Table5)
 }
 
+func TestUplinkBridgeFlowsIgnoreDefaultNetworkAndGatewayVLAN(t *testing.T) {
+	if err := config.PrepareTestConfig(); err != nil {
+		t.Fatalf("failed to prepare test config: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = config.PrepareTestConfig()
+	})
+	config.IPv4Mode = true
+	config.Gateway.Mode = config.GatewayModeShared
+	config.Gateway.VLANID = 100
+
+	bridgeMAC := mustParseMAC(t, "02:42:c0:00:02:06")
+	bridge := &BridgeConfiguration{
+		bridgeName:     "br-blue",
+		uplinkName:     "eth1",
+		isUplinkBridge: true,
+		ofPortPhys:     "1",
+		ofPortHost:     nodetypes.OvsLocalPort,
+		ips:            []*net.IPNet{mustParseIPNet(t, "192.0.2.6/24")},
+		macAddress:     bridgeMAC,
+		netConfig: map[string]*BridgeUDNConfiguration{
+			"bluenet": {
+				OfPortPatch: "patch-br-blue_bluenet",
+				MasqCTMark:  "0x4",
+				PktMark:     "0x3",
+				V4MasqIPs: &udngenerator.MasqueradeIPs{
+					GatewayRouter:  mustParseIPNet(t, "169.254.0.11/32"),
+					ManagementPort: mustParseIPNet(t, "169.254.0.12/32"),
+				},
+			},
+		},
+	}
+
+	flows, err := bridge.DefaultBridgeFlows(nil, nil)
+	if err != nil {
+		t.Fatalf("failed to render bridge flows: %v", err)
+	}
+	for _, flow := range flows {
+		if strings.Contains(flow, "vlan") {
+			t.Fatalf("unexpected VLAN flow on uplink bridge: %s", flow)
+		}
+	}
+	expectFlow(t, flows, fmt.Sprintf("cookie=%s, priority=10, table=0,  dl_dst=%s, actions=output:patch-br-blue_bluenet,NORMAL",
+		nodetypes.DefaultOpenFlowCookie, bridgeMAC))
+}
+
 func mustParseMAC(t *testing.T, value string) net.HardwareAddr {
 	t.Helper()
 	mac, err := net.ParseMAC(value)
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// gatewayReadyCheckInterval is the minimum interval between two runs of the
+// gateway readiness check for CNI STATUS, the check runs OVS commands.
+const gatewayReadyCheckInterval = 10 * time.Second
+
+// cniNodeStatus reports the readiness of the node's management port and
+// gateway to the CNI server, which surfaces it through the CNI STATUS command.
+type cniNodeStatus struct {
+	nc *DefaultNodeNetworkController
+}
+
+// Ready implements cni.NodeStatusProvider
+func (s *cniNodeStatus) Ready() (bool, string) {
+	if s.nc.mgmtPortController == nil {
+		return false, "management port is not initialized"
+	}
+	mgmtPortName := s.nc.mgmtPortController.GetInterfaceName()
+	link, err := util.GetNetLinkOps().LinkByName(mgmtPortName)
+	if err != nil {
+		return false, fmt.Sprintf("management port %s not found: %v", mgmtPortName, err)
+	}
+	if link.Attrs().Flags&net.FlagUp == 0 {
+		return false, fmt.Sprintf("management port %s is down", mgmtPortName)
+	}
+
+	gw, ok := s.nc.Gateway.(*gateway)
+	if !ok || gw == nil || gw.readiness == nil {
+		return false, "gateway is not initialized"
+	}
+	ready, err := gw.readiness.Ready()
+	if err != nil {
+		return false, fmt.Sprintf("gateway is not ready: %v", err)
+	}
+	if !ready {
+		return false, "gateway is not ready"
+	}
+	return true, ""
+}
+
+// readinessCheck caches the result of the gateway readiness check, which is
+// run again once the result is older than interval.
+type readinessCheck struct {
+	sync.Mutex
+	check    func() (bool, error)
+	interval time.Duration
+	// checked is the last time the check was run, zero if it never ran
+	checked time.Time
+	ready   bool
+	err     error
+}
+
+func newReadinessCheck(check func() (bool, error)) *readinessCheck {
+	return &readinessCheck{
+		check:    check,
+		interval: gatewayReadyCheckInterval,
+	}
+}
+
+// Ready returns the cached result of the check, running it again first if
+// the result is outdated.
+func (r *readinessCheck) Ready() (bool, error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.checked.IsZero() || time.Since(r.checked) >= r.interval {
+		r.run()
+	}
+	return r.ready, r.err
+}
+
+// Run runs the check regardless of the cached result and returns its result.
+func (r *readinessCheck) Run() (bool, error) {
+	r.Lock()
+	defer r.Unlock()
+	r.run()
+	return r.ready, r.err
+}
+
+func (r *readinessCheck) run() {
+	ready, err := r.check()
+	ready = ready && err == nil
+	if ready && !r.ready {
+		klog.Info("Gateway is ready")
+	} else if !ready && r.ready {
+		if err != nil {
+			klog.Warningf("Gateway is not ready anymore: %v", err)
+		} else {
+			klog.Warning("Gateway is not ready anymore")
+		}
+	}
+	r.ready, r.err, r.checked = ready, err, time.Now()
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestReadinessCheckReportsGatewayNoLongerReady(t *testing.T) {
+	ready, checks := true, 0
+	var checkErr error
+	r := newReadinessCheck(func() (bool, error) {
+		checks++
+		return ready, checkErr
+	})
+
+	if ok, err := r.Run(); !ok || err != nil {
+		t.Fatalf("expected the gateway to be ready at startup, got %t, %v", ok, err)
+	}
+
+	// the patch port goes away: the cached result is served until it is outdated
+	ready = false
+	if ok, _ := r.Ready(); !ok {
+		t.Fatalf("expected the cached readiness to be served within the check interval")
+	}
+	if checks != 1 {
+		t.Fatalf("expected the check to run once, ran %d times", checks)
+	}
+
+	r.checked = time.Now().Add(-gatewayReadyCheckInterval)
+	if ok, _ := r.Ready(); ok {
+		t.Fatalf("expected the gateway not to be ready anymore once the check ran again")
+	}
+	if checks != 2 {
+		t.Fatalf("expected the check to run twice, ran %d times", checks)
+	}
+
+	// OVN controller can't be reached
+	ready, checkErr = true, fmt.Errorf("could not get connection status")
+	r.checked = time.Now().Add(-gatewayReadyCheckInterval)
+	if ok, err := r.Ready(); ok || err == nil {
+		t.Fatalf("expected the check error to be reported, got %t, %v", ok, err)
+	}
+
+	// the gateway recovers
+	checkErr = nil
+	r.checked = time.Now().Add(-gatewayReadyCheckInterval)
+	if ok, err := r.Ready(); !ok || err != nil {
+		t.Fatalf("expected the gateway to be ready again, got %t, %v", ok, err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -273,7 +274,7 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		if !c.shouldConfigureEgressSVC(svc, es.Status.Host) {
+		if !c.shouldConfigureEgressSVC(svc, es) {
 			continue
 		}
 
@@ -691,7 +692,7 @@ func (c *Controller) syncEgressService(key string) error {
 	}
 
 	// At this point both the svc and es are not nil
-	shouldConfigure := c.shouldConfigureEgressSVC(svc, es.Status.Host)
+	shouldConfigure := c.shouldConfigureEgressSVC(svc, es)
 	if cachedState == nil && !shouldConfigure {
 		return nil
 	}
@@ -1054,9 +1055,10 @@ func (c *Controller) clearServiceRulesAndRequeue(key string, state *svcState) er
 	return nil
 }
 
-// Returns true if the controller should configure the given service as an "Egress Service"
-func (c *Controller) shouldConfigureEgressSVC(svc *corev1.Service, svcHost string) bool {
-	return (svcHost == c.thisNode || svcHost == types.EgressServiceNoSNATHost) &&
+// Returns true if the controller should configure the given service as an "Egress Service",
+// that is when this node is one of the hosts of its EgressService or the service does not need SNAT.
+func (c *Controller) shouldConfigureEgressSVC(svc *corev1.Service, es *egressserviceapi.EgressService) bool {
+	return (es.Status.Host == types.EgressServiceNoSNATHost || slices.Contains(util.GetEgressServiceHosts(es), c.thisNode)) &&
 		svc.Spec.Type == corev1.ServiceTypeLoadBalancer &&
 		len(svc.Status.LoadBalancer.Ingress) > 0
 }
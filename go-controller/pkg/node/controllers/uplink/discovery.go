@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package uplink
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	nodeutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// discovery is the node local state of an uplink host interface.
+type discovery struct {
+	bridge          string
+	macAddress      string
+	ipAddresses     []string
+	defaultGateways []string
+}
+
+// discoveryError is a discovery failure along with the UplinkState Ready
+// reason it is reported with.
+type discoveryError struct {
+	reason string
+	msg    string
+}
+
+func (e *discoveryError) Error() string {
+	return e.msg
+}
+
+func newDiscoveryError(reason, format string, args ...interface{}) error {
+	return &discoveryError{reason: reason, msg: fmt.Sprintf(format, args...)}
+}
+
+// discoverOVSBridgeUplink resolves the pre-provisioned OVS bridge backing the
+// given host interface, and the gateway data of the interface. The host
+// interface is either the bridge LOCAL interface or an internal port of it.
+func discoverOVSBridgeUplink(hostInterfaceName string) (*discovery, error) {
+	d := &discovery{}
+	link, err := util.GetNetLinkOps().LinkByName(hostInterfaceName)
+	if err != nil {
+		return nil, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonHostInterfaceNotFound,
+			"failed to find host interface %s: %v", hostInterfaceName, err)
+	}
+
+	if _, _, err := util.RunOVSVsctl("br-exists", hostInterfaceName); err == nil {
+		d.bridge = hostInterfaceName
+	} else if bridge, _, err := util.RunOVSVsctl("port-to-br", hostInterfaceName); err == nil && bridge != "" {
+		d.bridge = bridge
+	} else {
+		return nil, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonBridgeNotFound,
+			"host interface %s is not backed by an OVS bridge", hostInterfaceName)
+	}
+
+	if d.bridge == "br-int" {
+		return d, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonBridgeInvalid,
+			"OVS bridge %s of host interface %s cannot be used as an uplink", d.bridge, hostInterfaceName)
+	}
+	if bridgeMappings, err := getBridgeMappings(); err != nil {
+		return d, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonBridgeInvalid,
+			"failed to get OVS bridge mappings: %v", err)
+	} else if bridgeMappings[d.bridge] == types.PhysicalNetworkName {
+		return d, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonBridgeInvalid,
+			"OVS bridge %s is the default external bridge", d.bridge)
+	}
+	if _, err := util.GetNicName(d.bridge); err != nil {
+		return d, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonBridgeInvalid,
+			"failed to find the physical port of OVS bridge %s: %v", d.bridge, err)
+	}
+
+	if link.Attrs().MTU < config.Default.MTU {
+		return d, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonMTUInvalid,
+			"host interface %s MTU %d is smaller than the network MTU %d", hostInterfaceName, link.Attrs().MTU, config.Default.MTU)
+	}
+
+	if len(link.Attrs().HardwareAddr) == 0 {
+		return d, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonGatewayInfoUnavailable,
+			"host interface %s has no MAC address", hostInterfaceName)
+	}
+	d.macAddress = link.Attrs().HardwareAddr.String()
+
+	ips, err := nodeutil.GetNetworkInterfaceIPAddresses(hostInterfaceName)
+	if err != nil {
+		return d, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonGatewayInfoUnavailable,
+			"failed to get IP addresses of host interface %s: %v", hostInterfaceName, err)
+	}
+	for _, ip := range ips {
+		d.ipAddresses = append(d.ipAddresses, ip.String())
+	}
+
+	gateways, err := getDefaultGateways(link)
+	if err != nil {
+		return d, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonGatewayInfoUnavailable,
+			"failed to get default gateways of host interface %s: %v", hostInterfaceName, err)
+	}
+	d.defaultGateways = gateways
+
+	return d, nil
+}
+
+// getBridgeMappings returns the OVS bridge mappings indexed by bridge.
+func getBridgeMappings() (map[string]string, error) {
+	stdout, _, err := util.RunOVSVsctl("--if-exists", "get", "Open_vSwitch", ".", "external_ids:ovn-bridge-mappings")
+	if err != nil {
+		return nil, err
+	}
+	mappings := map[string]string{}
+	for _, mapping := range strings.Split(strings.Trim(stdout, "\""), ",") {
+		physnet, bridge, found := strings.Cut(mapping, ":")
+		if found {
+			mappings[bridge] = physnet
+		}
+	}
+	return mappings, nil
+}
+
+// getDefaultGateways returns the next hops of the default routes through the
+// given link, in the main routing table, for the enabled IP families.
+func getDefaultGateways(link netlink.Link) ([]string, error) {
+	var families []int
+	if config.IPv4Mode {
+		families = append(families, netlink.FAMILY_V4)
+	}
+	if config.IPv6Mode {
+		families = append(families, netlink.FAMILY_V6)
+	}
+	var gateways []string
+	for _, family := range families {
+		routes, err := util.GetNetLinkOps().RouteListFiltered(family, &netlink.Route{Dst: nil}, netlink.RT_FILTER_DST)
+		if err != nil {
+			return nil, err
+		}
+		for _, route := range routes {
+			if route.LinkIndex == link.Attrs().Index && route.Gw != nil {
+				gateways = append(gateways, route.Gw.String())
+			}
+			for _, nh := range route.MultiPath {
+				if nh.LinkIndex == link.Attrs().Index && nh.Gw != nil {
+					gateways = append(gateways, nh.Gw.String())
+				}
+			}
+		}
+	}
+	return gateways, nil
+}
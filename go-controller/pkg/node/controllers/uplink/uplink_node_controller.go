@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package uplink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/controller"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinkclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/clientset/versioned"
+	uplinklisters "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/listers/uplink/v1alpha1"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// resyncInterval is how often an Uplink is rediscovered on the node, so that
+// admin side changes to the host interface or bridge are eventually reflected
+// in the UplinkState even if no event triggers a reconciliation.
+const resyncInterval = time.Minute
+
+// Controller discovers and validates on this node the links selected by
+// Uplinks and reports the result in one UplinkState per Uplink.
+type Controller struct {
+	nodeName     string
+	watchFactory factory.NodeWatchFactory
+	client       uplinkclientset.Interface
+
+	uplinkLister      uplinklisters.UplinkLister
+	uplinkStateLister uplinklisters.UplinkStateLister
+
+	uplinkController controller.Controller
+	// nodeEventHandler requeues all Uplinks when this node labels change, as
+	// they determine which Uplink node config applies to it.
+	nodeEventHandler cache.ResourceEventHandlerRegistration
+	// uplinkStateEventHandler requeues the Uplink of an UplinkState of this
+	// node changed or removed by someone else.
+	uplinkStateEventHandler cache.ResourceEventHandlerRegistration
+
+	// discover resolves a host interface to the data reported in the
+	// UplinkState, replaceable for testing.
+	discover func(hostInterfaceName string) (*discovery, error)
+}
+
+// NewController returns a new node Uplink controller.
+func NewController(nodeName string, wf factory.NodeWatchFactory, client uplinkclientset.Interface) (*Controller, error) {
+	c := &Controller{
+		nodeName:          nodeName,
+		watchFactory:      wf,
+		client:            client,
+		uplinkLister:      wf.UplinkInformer().Lister(),
+		uplinkStateLister: wf.UplinkStateInformer().Lister(),
+		discover:          discoverOVSBridgeUplink,
+	}
+
+	uplinkInformer := wf.UplinkInformer()
+	c.uplinkController = controller.NewController("node-uplink-controller", &controller.ControllerConfig[uplinkv1alpha1.Uplink]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcile,
+		ObjNeedsUpdate: uplinkNeedsUpdate,
+		Threadiness:    1,
+		Informer:       uplinkInformer.Informer(),
+		Lister:         uplinkInformer.Lister().List,
+	})
+
+	var err error
+	c.nodeEventHandler, err = wf.NodeCoreInformer().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: c.onNodeUpdate,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add node event handler: %w", err)
+	}
+	c.uplinkStateEventHandler, err = wf.UplinkStateInformer().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(_, newObj interface{}) { c.onUplinkStateEvent(newObj) },
+			DeleteFunc: c.onUplinkStateEvent,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add uplink state event handler: %w", err)
+	}
+
+	return c, nil
+}
+
+// Start starts the controller.
+func (c *Controller) Start() error {
+	klog.Info("Starting node Uplink controller")
+	return controller.StartWithInitialSync(c.initialSync, c.uplinkController)
+}
+
+// Stop stops the controller.
+func (c *Controller) Stop() {
+	klog.Info("Stopping node Uplink controller")
+	if c.nodeEventHandler != nil {
+		if err := c.watchFactory.NodeCoreInformer().Informer().RemoveEventHandler(c.nodeEventHandler); err != nil {
+			klog.Errorf("Failed to remove node event handler: %v", err)
+		}
+	}
+	if c.uplinkStateEventHandler != nil {
+		if err := c.watchFactory.UplinkStateInformer().Informer().RemoveEventHandler(c.uplinkStateEventHandler); err != nil {
+			klog.Errorf("Failed to remove uplink state event handler: %v", err)
+		}
+	}
+	controller.Stop(c.uplinkController)
+}
+
+// initialSync removes the UplinkStates of this node whose Uplink was deleted
+// while the controller was not running.
+func (c *Controller) initialSync() error {
+	selector := labels.SelectorFromSet(labels.Set{util.UplinkNodeLabel: util.GetUplinkStateLabelValue(c.nodeName)})
+	states, err := c.uplinkStateLister.List(selector)
+	if err != nil {
+		return fmt.Errorf("failed to list uplink states: %w", err)
+	}
+	for _, state := range states {
+		uplinkName, nodeName := util.GetUplinkStateIdentity(state)
+		if nodeName != c.nodeName {
+			continue
+		}
+		_, err := c.uplinkLister.Get(uplinkName)
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err := c.deleteUplinkState(state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func uplinkNeedsUpdate(oldObj, newObj *uplinkv1alpha1.Uplink) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Spec, newObj.Spec)
+}
+
+func (c *Controller) onNodeUpdate(oldObj, newObj interface{}) {
+	oldNode, ok := oldObj.(*corev1.Node)
+	if !ok {
+		return
+	}
+	newNode, ok := newObj.(*corev1.Node)
+	if !ok || newNode.Name != c.nodeName {
+		return
+	}
+	if reflect.DeepEqual(oldNode.Labels, newNode.Labels) {
+		return
+	}
+	c.uplinkController.ReconcileAll()
+}
+
+func (c *Controller) onUplinkStateEvent(obj interface{}) {
+	state, ok := obj.(*uplinkv1alpha1.UplinkState)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		state, ok = tombstone.Obj.(*uplinkv1alpha1.UplinkState)
+		if !ok {
+			return
+		}
+	}
+	uplinkName, nodeName := util.GetUplinkStateIdentity(state)
+	if nodeName != c.nodeName || uplinkName == "" {
+		return
+	}
+	c.uplinkController.Reconcile(uplinkName)
+}
+
+func (c *Controller) reconcile(uplinkName string) error {
+	state, err := util.GetUplinkStateForNode(c.uplinkStateLister, uplinkName, c.nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to get uplink state of uplink %s: %w", uplinkName, err)
+	}
+
+	uplink, err := c.uplinkLister.Get(uplinkName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if uplink == nil {
+		return c.deleteUplinkState(state)
+	}
+
+	node, err := c.watchFactory.GetNode(c.nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to get node %s: %w", c.nodeName, err)
+	}
+	nodeConfigs, err := util.MatchingUplinkNodeConfigs(uplink, node)
+	if err != nil {
+		return err
+	}
+
+	status := uplinkv1alpha1.UplinkStateStatus{
+		UplinkName: uplinkName,
+		NodeName:   c.nodeName,
+	}
+	var condition metav1.Condition
+	switch len(nodeConfigs) {
+	case 0:
+		return c.deleteUplinkState(state)
+	case 1:
+		status.Type = nodeConfigs[0].Type
+		status.HostInterfaceName = nodeConfigs[0].HostInterfaceName
+		condition = c.discoverNodeConfig(nodeConfigs[0], &status)
+	default:
+		condition = metav1.Condition{
+			Type:    uplinkv1alpha1.UplinkStateReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  uplinkv1alpha1.UplinkStateReasonNodeSelectorOverlap,
+			Message: fmt.Sprintf("%d node configs of uplink %s select node %s", len(nodeConfigs), uplinkName, c.nodeName),
+		}
+	}
+
+	if err := c.updateUplinkState(state, status, condition); err != nil {
+		return err
+	}
+	c.uplinkController.ReconcileAfter(uplinkName, resyncInterval)
+	return nil
+}
+
+func (c *Controller) discoverNodeConfig(nodeConfig uplinkv1alpha1.UplinkNodeConfig, status *uplinkv1alpha1.UplinkStateStatus) metav1.Condition {
+	if nodeConfig.Type != uplinkv1alpha1.UplinkTypeOVSBridge {
+		return metav1.Condition{
+			Type:    uplinkv1alpha1.UplinkStateReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  uplinkv1alpha1.UplinkStateReasonBridgeInvalid,
+			Message: fmt.Sprintf("unsupported uplink type %q", nodeConfig.Type),
+		}
+	}
+	d, err := c.discover(nodeConfig.HostInterfaceName)
+	if d != nil {
+		// keep whatever was discovered even if incomplete, as it helps to
+		// troubleshoot
+		if d.bridge != "" {
+			status.OVSBridge = &uplinkv1alpha1.OVSBridgeState{Name: d.bridge}
+		}
+		status.MACAddress = d.macAddress
+		status.IPAddresses = d.ipAddresses
+		status.DefaultGateways = d.defaultGateways
+	}
+	if err != nil {
+		var discoveryErr *discoveryError
+		reason := uplinkv1alpha1.UplinkStateReasonGatewayInfoUnavailable
+		if errors.As(err, &discoveryErr) {
+			reason = discoveryErr.reason
+		}
+		return metav1.Condition{
+			Type:    uplinkv1alpha1.UplinkStateReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  reason,
+			Message: err.Error(),
+		}
+	}
+	return metav1.Condition{
+		Type:    uplinkv1alpha1.UplinkStateReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  uplinkv1alpha1.UplinkStateReasonReady,
+		Message: fmt.Sprintf("uplink resolved to OVS bridge %s", d.bridge),
+	}
+}
+
+func (c *Controller) updateUplinkState(state *uplinkv1alpha1.UplinkState, status uplinkv1alpha1.UplinkStateStatus, condition metav1.Condition) error {
+	uplinkName := status.UplinkName
+	if state == nil {
+		var err error
+		state, err = c.client.K8sV1alpha1().UplinkStates().Create(context.TODO(), &uplinkv1alpha1.UplinkState{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   util.GetUplinkStateName(uplinkName, c.nodeName),
+				Labels: util.GetUplinkStateLabels(uplinkName, c.nodeName),
+				Annotations: map[string]string{
+					util.UplinkAnnotation:     uplinkName,
+					util.UplinkNodeAnnotation: c.nodeName,
+				},
+			},
+		}, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			state, err = c.client.K8sV1alpha1().UplinkStates().Get(context.TODO(), util.GetUplinkStateName(uplinkName, c.nodeName), metav1.GetOptions{})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create uplink state of uplink %s: %w", uplinkName, err)
+		}
+	}
+
+	status.Conditions = make([]metav1.Condition, len(state.Status.Conditions))
+	copy(status.Conditions, state.Status.Conditions)
+	meta.SetStatusCondition(&status.Conditions, condition)
+	if apiequality.Semantic.DeepEqual(state.Status, status) {
+		return nil
+	}
+
+	updated := state.DeepCopy()
+	updated.Status = status
+	_, err := c.client.K8sV1alpha1().UplinkStates().UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update status of uplink state %s: %w", state.Name, err)
+	}
+	klog.V(4).Infof("Updated uplink state %s: %s", state.Name, condition.Reason)
+	return nil
+}
+
+func (c *Controller) deleteUplinkState(state *uplinkv1alpha1.UplinkState) error {
+	if state == nil {
+		return nil
+	}
+	err := c.client.K8sV1alpha1().UplinkStates().Delete(context.TODO(), state.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete uplink state %s: %w", state.Name, err)
+	}
+	klog.Infof("Deleted uplink state %s", state.Name)
+	return nil
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package uplink
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Uplink node controller", func() {
+	const (
+		nodeName   = "node1"
+		uplinkName = "blue"
+	)
+
+	var (
+		fakeClient *util.OVNClientset
+		wf         *factory.WatchFactory
+		c          *Controller
+		discovered map[string]*discovery
+	)
+
+	newUplink := func(hostInterfaces map[string]string) *uplinkv1alpha1.Uplink {
+		uplink := &uplinkv1alpha1.Uplink{ObjectMeta: metav1.ObjectMeta{Name: uplinkName}}
+		for pool, hostInterface := range hostInterfaces {
+			uplink.Spec.NodeConfigs = append(uplink.Spec.NodeConfigs, uplinkv1alpha1.UplinkNodeConfig{
+				Type:              uplinkv1alpha1.UplinkTypeOVSBridge,
+				NodeSelector:      metav1.LabelSelector{MatchLabels: map[string]string{pool: ""}},
+				HostInterfaceName: hostInterface,
+			})
+		}
+		return uplink
+	}
+
+	start := func(nodeLabels map[string]string, objects ...runtime.Object) {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName, Labels: nodeLabels}}
+		fakeClient = util.GetOVNClientset(append(objects, node)...)
+		var err error
+		wf, err = factory.NewNodeWatchFactory(fakeClient.GetNodeClientset(), nodeName)
+		Expect(err).NotTo(HaveOccurred())
+		c, err = NewController(nodeName, wf, fakeClient.UplinkClient)
+		Expect(err).NotTo(HaveOccurred())
+		c.discover = func(hostInterfaceName string) (*discovery, error) {
+			if d, ok := discovered[hostInterfaceName]; ok {
+				return d, nil
+			}
+			return nil, newDiscoveryError(uplinkv1alpha1.UplinkStateReasonHostInterfaceNotFound, "host interface %s not found", hostInterfaceName)
+		}
+		Expect(wf.Start()).To(Succeed())
+		Expect(c.Start()).To(Succeed())
+	}
+
+	getUplinkState := func() *uplinkv1alpha1.UplinkState {
+		state, err := fakeClient.UplinkClient.K8sV1alpha1().UplinkStates().Get(context.TODO(),
+			util.GetUplinkStateName(uplinkName, nodeName), metav1.GetOptions{})
+		if err != nil {
+			return nil
+		}
+		return state
+	}
+
+	getReadyReason := func() string {
+		state := getUplinkState()
+		if state == nil {
+			return ""
+		}
+		condition := meta.FindStatusCondition(state.Status.Conditions, uplinkv1alpha1.UplinkStateReady)
+		if condition == nil {
+			return ""
+		}
+		return condition.Reason
+	}
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+		config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+		config.OVNKubernetesFeature.EnableUplink = true
+		discovered = map[string]*discovery{
+			"br-blue": {
+				bridge:          "br-blue",
+				macAddress:      "02:42:c0:00:02:06",
+				ipAddresses:     []string{"192.0.2.6/24"},
+				defaultGateways: []string{"192.0.2.1"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		if c != nil {
+			c.Stop()
+		}
+		if wf != nil {
+			wf.Shutdown()
+		}
+	})
+
+	It("reports the discovered uplink state of the node", func() {
+		start(map[string]string{"pool-a": ""}, newUplink(map[string]string{"pool-a": "br-blue"}))
+
+		Eventually(getReadyReason).Should(Equal(uplinkv1alpha1.UplinkStateReasonReady))
+		state := getUplinkState()
+		Expect(state.Labels).To(Equal(util.GetUplinkStateLabels(uplinkName, nodeName)))
+		Expect(state.Status.UplinkName).To(Equal(uplinkName))
+		Expect(state.Status.NodeName).To(Equal(nodeName))
+		Expect(state.Status.HostInterfaceName).To(Equal("br-blue"))
+		Expect(state.Status.OVSBridge).To(Equal(&uplinkv1alpha1.OVSBridgeState{Name: "br-blue"}))
+		Expect(state.Status.MACAddress).To(Equal("02:42:c0:00:02:06"))
+		Expect(state.Status.IPAddresses).To(Equal([]string{"192.0.2.6/24"}))
+		Expect(state.Status.DefaultGateways).To(Equal([]string{"192.0.2.1"}))
+		Expect(util.IsUplinkStateReady(state)).To(BeTrue())
+	})
+
+	It("reports discovery failures", func() {
+		start(map[string]string{"pool-a": ""}, newUplink(map[string]string{"pool-a": "br-missing"}))
+
+		Eventually(getReadyReason).Should(Equal(uplinkv1alpha1.UplinkStateReasonHostInterfaceNotFound))
+		Expect(util.IsUplinkStateReady(getUplinkState())).To(BeFalse())
+	})
+
+	It("reports overlapping node configs", func() {
+		start(map[string]string{"pool-a": "", "pool-b": ""}, newUplink(map[string]string{"pool-a": "br-blue", "pool-b": "br-blue"}))
+
+		Eventually(getReadyReason).Should(Equal(uplinkv1alpha1.UplinkStateReasonNodeSelectorOverlap))
+	})
+
+	It("follows node label changes", func() {
+		start(map[string]string{"pool-a": ""}, newUplink(map[string]string{"pool-a": "br-blue"}))
+		Eventually(getReadyReason).Should(Equal(uplinkv1alpha1.UplinkStateReasonReady))
+
+		node, err := fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		node.Labels = map[string]string{"pool-b": ""}
+		_, err = fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(getUplinkState).Should(BeNil())
+	})
+
+	It("removes the uplink state when the uplink is deleted", func() {
+		start(map[string]string{"pool-a": ""}, newUplink(map[string]string{"pool-a": "br-blue"}))
+		Eventually(getReadyReason).Should(Equal(uplinkv1alpha1.UplinkStateReasonReady))
+
+		Expect(fakeClient.UplinkClient.K8sV1alpha1().Uplinks().Delete(context.TODO(), uplinkName, metav1.DeleteOptions{})).To(Succeed())
+
+		Eventually(getUplinkState).Should(BeNil())
+	})
+
+	It("removes stale uplink states on start", func() {
+		stale := &uplinkv1alpha1.UplinkState{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        util.GetUplinkStateName("red", nodeName),
+				Labels:      util.GetUplinkStateLabels("red", nodeName),
+				Annotations: map[string]string{util.UplinkAnnotation: "red", util.UplinkNodeAnnotation: nodeName},
+			},
+		}
+		start(nil, stale)
+
+		_, err := fakeClient.UplinkClient.K8sV1alpha1().UplinkStates().Get(context.TODO(), stale.Name, metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -553,10 +553,11 @@ func isOVNControllerReady() (bool, error) {
 	if err != nil {
 		return false, fmt.Errorf("could not get connection status: %w", err)
 	}
-	klog.Infof("Node connection status = %s", ret)
 	if ret != "connected" {
+		klog.Infof("Node connection status = %s", ret)
 		return false, nil
 	}
+	klog.V(5).Infof("Node connection status = %s", ret)
 
 	// check whether br-int exists on node
 	_, _, err = util.RunOVSVsctl("--", "br-exists", "br-int")
@@ -868,7 +869,8 @@ func (nc *DefaultNodeNetworkController) Init(ctx context.Context) error {
 		if nc.dpuNodeLeaseManager != nil {
 			dpuHealth = nc.dpuNodeLeaseManager
 		}
-		cniServer, err = cni.NewCNIServer(nc.watchFactory, kclient.KClient, nc.networkManager, nc.ovsClient, dpuHealth)
+		cniServer, err = cni.NewCNIServer(nc.watchFactory, kclient.KClient, nc.networkManager, nc.ovsClient, dpuHealth,
+			&cniNodeStatus{nc: nc})
 		if err != nil {
 			return err
 		}
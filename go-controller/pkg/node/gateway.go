@@ -60,6 +60,9 @@ type gateway struct {
 	bridgeEIPAddrManager *egressip.BridgeEIPAddrManager
 	initFunc             func() error
 	readyFunc            func() (bool, error)
+	// readiness checks OVN controller and readyFunc for CNI STATUS and
+	// caches the result
+	readiness *readinessCheck
 
 	servicesRetryFramework *retry.RetryFramework
 
@@ -344,13 +344,14 @@ func (nc *DefaultNodeNetworkController) initGatewayPreStart(
 		return gw.initFunc()
 	}
 
-	readyGwFunc := func() (bool, error) {
+	gw.readiness = newReadinessCheck(func() (bool, error) {
 		controllerReady, err := isOVNControllerReady()
 		if err != nil || !controllerReady {
 			return false, err
 		}
 		return gw.readyFunc()
-	}
+	})
+	readyGwFunc := gw.readiness.Run
 
 	if err := nodeAnnotator.Run(); err != nil {
 		return nil, fmt.Errorf("failed to set node %s annotations: %w", nc.name, err)
@@ -508,12 +509,15 @@ func (nc *DefaultNodeNetworkController) initGatewayDPUHostPreStart(kubeNodeIP ne
 	// - There's no OVS on the host (it runs on the DPU)
 	// - Traffic is handled on the DPU which has the EgressIP configuration
 	// - There's no openflow manager to use the mark-to-IP cache
-	nc.Gateway = &gateway{
+	gw := &gateway{
 		initFunc:     func() error { return nil },
 		readyFunc:    func() (bool, error) { return true, nil },
 		watchFactory: nc.watchFactory.(*factory.WatchFactory),
 		nextHops:     gatewayNextHops,
 	}
+	// the gateway runs on the DPU, there is nothing to wait for
+	gw.readiness = newReadinessCheck(gw.readyFunc)
+	nc.Gateway = gw
 	return nil
 }
 
@@ -528,7 +532,7 @@ func (nc *DefaultNodeNetworkController) initGatewayDPUHost() error {
 	gw := nc.Gateway.(*gateway)
 	gw.nodeIPManager = newAddressManager(nc.name, nc.Kube, nil, nc.watchFactory, nil, nc.ovsClient)
 	if config.Gateway.NodeportEnable {
-		if err := initSharedGatewayIPTables(); err != nil {
+		if err := initGatewayServiceNFTRules(); err != nil {
 			return err
 		}
 		if util.IsNetworkSegmentationSupportEnabled() {
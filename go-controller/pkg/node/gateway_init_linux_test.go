@@ -71,6 +71,46 @@ add rule inet ovn-kubernetes mgmtport-snat ip saddr @mgmtport-no-snat-subnets-v4
 add rule inet ovn-kubernetes mgmtport-snat counter snat ip to 10.1.1.2
 `
 
+// The additional rules expected once the service nftables rules are initialized.
+const nftablesRulesServices = `
+add chain inet ovn-kubernetes ovn-kube-service-nat-prerouting { type nat hook prerouting priority -100 ; comment "OVN services DNAT - Prerouting" ; }
+add rule inet ovn-kubernetes ovn-kube-service-nat-prerouting jump ovn-kube-service-etp
+add rule inet ovn-kubernetes ovn-kube-service-nat-prerouting jump ovn-kube-service-dnat
+add chain inet ovn-kubernetes ovn-kube-service-nat-output { type nat hook output priority -100 ; comment "OVN services DNAT - Output" ; }
+add rule inet ovn-kubernetes ovn-kube-service-nat-output jump ovn-kube-service-dnat
+add rule inet ovn-kubernetes ovn-kube-service-nat-output meta l4proto { tcp, udp, sctp } redirect to : ip daddr . meta l4proto . th dport map @service-itp-redirect-v4
+add rule inet ovn-kubernetes ovn-kube-service-nat-output meta l4proto { tcp, udp, sctp } redirect to : ip6 daddr . meta l4proto . th dport map @service-itp-redirect-v6
+add chain inet ovn-kubernetes ovn-kube-service-etp { comment "OVN services externalTrafficPolicy=Local DNAT" ; }
+add rule inet ovn-kubernetes ovn-kube-service-etp ip daddr . meta l4proto . th dport vmap @service-etp-lb-v4
+add rule inet ovn-kubernetes ovn-kube-service-etp meta l4proto { tcp, udp, sctp } dnat ip to ip daddr . meta l4proto . th dport map @service-etp-external-ips-v4
+add rule inet ovn-kubernetes ovn-kube-service-etp meta nfproto ipv4 fib daddr type local meta l4proto . th dport @service-etp-nodeports-v4 dnat ip to 169.254.169.3
+add rule inet ovn-kubernetes ovn-kube-service-etp ip6 daddr . meta l4proto . th dport vmap @service-etp-lb-v6
+add rule inet ovn-kubernetes ovn-kube-service-etp meta l4proto { tcp, udp, sctp } dnat ip6 to ip6 daddr . meta l4proto . th dport map @service-etp-external-ips-v6
+add rule inet ovn-kubernetes ovn-kube-service-etp meta nfproto ipv6 fib daddr type local meta l4proto . th dport @service-etp-nodeports-v6 dnat ip6 to fd69::3
+add chain inet ovn-kubernetes ovn-kube-service-dnat { comment "OVN services DNAT to ClusterIP" ; }
+add rule inet ovn-kubernetes ovn-kube-service-dnat meta nfproto ipv4 meta l4proto { tcp, udp, sctp } fib daddr type local dnat ip to meta l4proto . th dport map @service-nodeports-v4
+add rule inet ovn-kubernetes ovn-kube-service-dnat meta l4proto { tcp, udp, sctp } dnat ip to ip daddr . meta l4proto . th dport map @service-external-ips-v4
+add rule inet ovn-kubernetes ovn-kube-service-dnat meta nfproto ipv6 meta l4proto { tcp, udp, sctp } fib daddr type local dnat ip6 to meta l4proto . th dport map @service-nodeports-v6
+add rule inet ovn-kubernetes ovn-kube-service-dnat meta l4proto { tcp, udp, sctp } dnat ip6 to ip6 daddr . meta l4proto . th dport map @service-external-ips-v6
+add chain inet ovn-kubernetes ovn-kube-service-itp-mark { type route hook output priority -150 ; comment "OVN services internalTrafficPolicy=Local mark" ; }
+add rule inet ovn-kubernetes ovn-kube-service-itp-mark ip daddr . meta l4proto . th dport @service-itp-mark-v4 meta mark set 0x1745ec
+add rule inet ovn-kubernetes ovn-kube-service-itp-mark ip6 daddr . meta l4proto . th dport @service-itp-mark-v6 meta mark set 0x1745ec
+add set inet ovn-kubernetes service-etp-nodeports-v4 { type inet_proto . inet_service ; comment "eTP:Local NodePorts DNAT to masquerade IP (ipv4)" ; }
+add set inet ovn-kubernetes service-etp-nodeports-v6 { type inet_proto . inet_service ; comment "eTP:Local NodePorts DNAT to masquerade IP (ipv6)" ; }
+add set inet ovn-kubernetes service-itp-mark-v4 { type ipv4_addr . inet_proto . inet_service ; comment "iTP:Local mark towards management port (ipv4)" ; }
+add set inet ovn-kubernetes service-itp-mark-v6 { type ipv6_addr . inet_proto . inet_service ; comment "iTP:Local mark towards management port (ipv6)" ; }
+add map inet ovn-kubernetes service-etp-external-ips-v4 { type ipv4_addr . inet_proto . inet_service : ipv4_addr . inet_service ; comment "eTP:Local External IPs DNAT to masquerade IP (ipv4)" ; }
+add map inet ovn-kubernetes service-etp-external-ips-v6 { type ipv6_addr . inet_proto . inet_service : ipv6_addr . inet_service ; comment "eTP:Local External IPs DNAT to masquerade IP (ipv6)" ; }
+add map inet ovn-kubernetes service-etp-lb-v4 { type ipv4_addr . inet_proto . inet_service : verdict ; comment "eTP:Local LoadBalancers without NodePorts (ipv4)" ; }
+add map inet ovn-kubernetes service-etp-lb-v6 { type ipv6_addr . inet_proto . inet_service : verdict ; comment "eTP:Local LoadBalancers without NodePorts (ipv6)" ; }
+add map inet ovn-kubernetes service-external-ips-v4 { type ipv4_addr . inet_proto . inet_service : ipv4_addr . inet_service ; comment "External IPs DNAT to ClusterIP (ipv4)" ; }
+add map inet ovn-kubernetes service-external-ips-v6 { type ipv6_addr . inet_proto . inet_service : ipv6_addr . inet_service ; comment "External IPs DNAT to ClusterIP (ipv6)" ; }
+add map inet ovn-kubernetes service-itp-redirect-v4 { type ipv4_addr . inet_proto . inet_service : inet_service ; comment "iTP:Local redirect to host target port (ipv4)" ; }
+add map inet ovn-kubernetes service-itp-redirect-v6 { type ipv6_addr . inet_proto . inet_service : inet_service ; comment "iTP:Local redirect to host target port (ipv6)" ; }
+add map inet ovn-kubernetes service-nodeports-v4 { type inet_proto . inet_service : ipv4_addr . inet_service ; comment "NodePorts DNAT to ClusterIP (ipv4)" ; }
+add map inet ovn-kubernetes service-nodeports-v6 { type inet_proto . inet_service : ipv6_addr . inet_service ; comment "NodePorts DNAT to ClusterIP (ipv6)" ; }
+`
+
 // The additional rules expected with UDN enabled.
 const nftablesRulesUDN = `
 add map inet ovn-kubernetes udn-mark-nodeports { type inet_proto . inet_service : verdict ; comment "UDN services NodePorts mark" ; }
@@ -508,29 +548,9 @@ func shareGatewayInterfaceTest(app *cli.App, testNS ns.NetNS,
 		}
 
 		expectedTables := map[string]util.FakeTable{
-			"nat": {
-				"PREROUTING": []string{
-					"-j OVN-KUBE-ETP",
-					"-j OVN-KUBE-EXTERNALIP",
-					"-j OVN-KUBE-NODEPORT",
-				},
-				"OUTPUT": []string{
-					"-j OVN-KUBE-EXTERNALIP",
-					"-j OVN-KUBE-NODEPORT",
-					"-j OVN-KUBE-ITP",
-				},
-				"OVN-KUBE-NODEPORT":   []string{},
-				"OVN-KUBE-EXTERNALIP": []string{},
-				"OVN-KUBE-ETP":        []string{},
-				"OVN-KUBE-ITP":        []string{},
-			},
+			"nat":    {},
 			"filter": {},
-			"mangle": {
-				"OUTPUT": []string{
-					"-j OVN-KUBE-ITP",
-				},
-				"OVN-KUBE-ITP": []string{},
-			},
+			"mangle": {},
 		}
 		f4 := iptV4.(*util.FakeIPTables)
 		err = f4.MatchState(expectedTables, nil)
@@ -545,7 +565,7 @@ func shareGatewayInterfaceTest(app *cli.App, testNS ns.NetNS,
 		err = f6.MatchState(expectedTables, nil)
 		Expect(err).NotTo(HaveOccurred())
 
-		expectedNFT := nftablesRulesBase
+		expectedNFT := nftablesRulesBase + nftablesRulesServices
 		err = nodenft.MatchNFTRules(expectedNFT, nft.Dump())
 		Expect(err).NotTo(HaveOccurred())
 
@@ -1421,24 +1441,7 @@ OFPT_GET_CONFIG_REPLY (xid=0x4): frags=normal miss_send_len=0`
 		Eventually(fexec.CalledMatchesExpected, 5).Should(BeTrue(), fexec.ErrorDesc)
 
 		expectedTables := map[string]util.FakeTable{
-			"nat": {
-				"PREROUTING": []string{
-					"-j OVN-KUBE-ETP",
-					"-j OVN-KUBE-EXTERNALIP",
-					"-j OVN-KUBE-NODEPORT",
-				},
-				"OUTPUT": []string{
-					"-j OVN-KUBE-EXTERNALIP",
-					"-j OVN-KUBE-NODEPORT",
-					"-j OVN-KUBE-ITP",
-				},
-				"OVN-KUBE-NODEPORT": []string{},
-				"OVN-KUBE-EXTERNALIP": []string{
-					fmt.Sprintf("-p %s -d %s --dport %v -j DNAT --to-destination %s:%v", service.Spec.Ports[0].Protocol, externalIP, service.Spec.Ports[0].Port, service.Spec.ClusterIP, service.Spec.Ports[0].Port),
-				},
-				"OVN-KUBE-ETP": []string{},
-				"OVN-KUBE-ITP": []string{},
-			},
+			"nat": {},
 			"filter": {
 				"FORWARD": []string{
 					"-d 169.254.169.1 -j ACCEPT",
@@ -1454,12 +1457,7 @@ OFPT_GET_CONFIG_REPLY (xid=0x4): frags=normal miss_send_len=0`
 					"-i ovn-k8s-mp0 -m comment --comment from OVN to localhost -j ACCEPT",
 				},
 			},
-			"mangle": {
-				"OUTPUT": []string{
-					"-j OVN-KUBE-ITP",
-				},
-				"OVN-KUBE-ITP": []string{},
-			},
+			"mangle": {},
 		}
 		f4 := iptV4.(*util.FakeIPTables)
 		err = f4.MatchState(expectedTables, map[util.FakePolicyKey]string{{
@@ -1477,7 +1475,9 @@ OFPT_GET_CONFIG_REPLY (xid=0x4): frags=normal miss_send_len=0`
 		err = f6.MatchState(expectedTables, nil)
 		Expect(err).NotTo(HaveOccurred())
 
-		expectedNFT := nftablesRulesBase + nftablesRulesLocalGateway
+		expectedNFT := nftablesRulesBase + nftablesRulesServices + nftablesRulesLocalGateway
+		expectedNFT += fmt.Sprintf("add element inet ovn-kubernetes service-external-ips-v4 { %s . tcp . %v : %s . %v }\n",
+			externalIP, service.Spec.Ports[0].Port, service.Spec.ClusterIP, service.Spec.Ports[0].Port)
 		if util.IsNetworkSegmentationSupportEnabled() {
 			expectedNFT += nftablesRulesUDN
 		}
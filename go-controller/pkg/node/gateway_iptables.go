@@ -9,20 +9,22 @@ package node
 import (
 	"fmt"
 	"net"
+	"slices"
 
 	"github.com/coreos/go-iptables/iptables"
 
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	nodeipt "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/iptables"
-	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
 	utilerrors "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/errors"
 )
 
+// Legacy iptables chains that used to hold the NodePort, ExternalIP, ETP and ITP
+// service rules. These rules are now programmed via nftables (see gateway_nftables.go)
+// and the chains are only kept around so that they can be cleaned up on upgrade.
 const (
 	iptableNodePortChain   = "OVN-KUBE-NODEPORT"   // called from nat-PREROUTING and nat-OUTPUT
 	iptableExternalIPChain = "OVN-KUBE-EXTERNALIP" // called from nat-PREROUTING and nat-OUTPUT
@@ -49,34 +51,19 @@ func getIPTablesProtocol(ip string) iptables.Protocol {
 	return iptables.ProtocolIPv4
 }
 
-// getMasqueradeVIP returns the .3 masquerade VIP based on the protocol (v4/v6) of provided IP string
-func getMasqueradeVIP(ip string) string {
-	if utilnet.IsIPv6String(ip) {
-		return config.Gateway.MasqueradeIPs.V6HostETPLocalMasqueradeIP.String()
-	}
-	return config.Gateway.MasqueradeIPs.V4HostETPLocalMasqueradeIP.String()
-}
-
 // insertIptRules adds the provided rules in an insert fashion
 // i.e each rule gets added at the first position in the chain
 func insertIptRules(rules []nodeipt.Rule) error {
 	return nodeipt.AddRules(rules, false)
 }
 
-// restoreIptRulesFiltered restores the provided rules in an insert fashion with a filter for table/chain
-// i.e each rule gets added at the first position in the chain
-// filter is defined as a map of table/chains. Only rules matching this filter will be restored.
-// If no rules match the filter, the chain will still be restored as empty as specified in the filter.
-func restoreIptRulesFiltered(rules []nodeipt.Rule, filter map[string]map[string]struct{}) error {
-	return nodeipt.RestoreRulesFiltered(rules, filter)
-}
-
 // deleteIptRules removes provided rules from the chain
 func deleteIptRules(rules []nodeipt.Rule) error {
 	return nodeipt.DelRules(rules)
 }
 
-func getGatewayInitRules(chain string, proto iptables.Protocol) []nodeipt.Rule {
+// getLegacyGatewayJumpRules returns the rules jumping to the provided legacy service chain.
+func getLegacyGatewayJumpRules(chain string, proto iptables.Protocol) []nodeipt.Rule {
 	iptRules := []nodeipt.Rule{}
 	if chain == iptableITPChain {
 		iptRules = append(iptRules,
@@ -110,162 +97,6 @@ func getGatewayInitRules(chain string, proto iptables.Protocol) []nodeipt.Rule {
 	return iptRules
 }
 
-// getNodePortIPTRules returns the IPTable DNAT rules for a service of type nodePort
-// `svcPort` corresponds to port details for this service as specified in the service object
-// `targetIP` is clusterIP towards which the DNAT of nodePort service is to be added
-// `targetPort` is the port towards which the DNAT of the nodePort service is to be added
-//
-//	case1: if svcHasLocalHostNetEndPnt=false + isETPLocal=true targetIP=config.masqueradeIP["HostETPLocalMasqueradeIP"] and targetPort=svcPort.NodePort
-//	case2: default: targetIP=clusterIP and targetPort=svcPort.Port
-//
-// `svcHasLocalHostNetEndPnt` is true if this service has at least one host-networked endpoint that is local to this node
-// `isETPLocal` is true if the svc.Spec.ExternalTrafficPolicy=Local
-func getNodePortIPTRules(svcPort corev1.ServicePort, targetIP string, targetPort int32, svcHasLocalHostNetEndPnt, isETPLocal bool) []nodeipt.Rule {
-	chainName := iptableNodePortChain
-	if !svcHasLocalHostNetEndPnt && isETPLocal {
-		// DNAT it to the masqueradeIP:nodePort instead of clusterIP:targetPort
-		targetIP = getMasqueradeVIP(targetIP)
-		chainName = iptableETPChain
-	}
-	return []nodeipt.Rule{
-		{
-			Table: "nat",
-			Chain: chainName,
-			Args: []string{
-				"-p", string(svcPort.Protocol),
-				"-m", "addrtype",
-				"--dst-type", "LOCAL",
-				"--dport", fmt.Sprintf("%d", svcPort.NodePort),
-				"-j", "DNAT",
-				"--to-destination", util.JoinHostPortInt32(targetIP, targetPort),
-			},
-			Protocol: getIPTablesProtocol(targetIP),
-		},
-	}
-}
-
-// getITPLocalIPTRules returns the IPTable REDIRECT or MARK rules for the provided service
-// `svcPort` corresponds to port details for this service as specified in the service object
-// `clusterIP` is clusterIP is the VIP of the service to match on
-// `svcHasLocalHostNetEndPnt` is true if this service has at least one host-networked endpoint that is local to this node
-// NOTE: Currently invoked only for Internal Traffic Policy
-func getITPLocalIPTRules(svcPort corev1.ServicePort, clusterIP string, svcHasLocalHostNetEndPnt bool) []nodeipt.Rule {
-	if svcHasLocalHostNetEndPnt {
-		return []nodeipt.Rule{
-			{
-				Table: "nat",
-				Chain: iptableITPChain,
-				Args: []string{
-					"-p", string(svcPort.Protocol),
-					"-d", clusterIP,
-					"--dport", fmt.Sprintf("%v", svcPort.Port),
-					"-j", "REDIRECT",
-					"--to-port", fmt.Sprintf("%v", int32(svcPort.TargetPort.IntValue())),
-				},
-				Protocol: getIPTablesProtocol(clusterIP),
-			},
-		}
-	}
-	return []nodeipt.Rule{
-		{
-			Table: "mangle",
-			Chain: iptableITPChain,
-			Args: []string{
-				"-p", string(svcPort.Protocol),
-				"-d", string(clusterIP),
-				"--dport", fmt.Sprintf("%d", svcPort.Port),
-				"-j", "MARK",
-				"--set-xmark", string(types.OVNKubeITPMark),
-			},
-			Protocol: getIPTablesProtocol(clusterIP),
-		},
-	}
-}
-
-func computeProbability(n, i int) string {
-	return fmt.Sprintf("%0.10f", 1.0/float64(n-i+1))
-}
-
-// generateIPTRulesForLoadBalancersWithoutNodePorts generates iptables DNAT rules for load balancer services
-// without NodePort allocation. It performs statistical load balancing between endpoints via iptables.
-func generateIPTRulesForLoadBalancersWithoutNodePorts(svcPort corev1.ServicePort, externalIP string, localEndpoints util.PortToLBEndpoints) []nodeipt.Rule {
-	if len(localEndpoints) == 0 {
-		// either its smart nic mode; etp&itp not implemented, OR
-		// fetching endpointSlices error-ed out prior to reaching here so nothing to do
-		return []nodeipt.Rule{}
-	}
-
-	// Get the endpoints for the port key.
-	// svcPortKey is of format e.g. "TCP/my-port-name" or "TCP/" if name is empty
-	// (is the case when only a single ServicePort is defined on this service).
-	svcPortKey := util.GetServicePortKey(svcPort.Protocol, svcPort.Name)
-	lbEndpoints := localEndpoints[svcPortKey]
-
-	// Get IPv4 or IPv6 IPs, depending on the type of the service's external IP.
-	destinations := lbEndpoints.GetV4Destinations()
-	if utilnet.IsIPv6String(externalIP) {
-		destinations = lbEndpoints.GetV6Destinations()
-	}
-
-	numLocalEndpoints := len(destinations)
-	iptRules := make([]nodeipt.Rule, 0, numLocalEndpoints)
-	for i, destination := range destinations {
-		iptRules = append([]nodeipt.Rule{
-			{
-				Table: "nat",
-				Chain: iptableETPChain,
-				Args: []string{
-					"-p", string(svcPort.Protocol),
-					"-d", externalIP,
-					"--dport", fmt.Sprintf("%v", svcPort.Port),
-					"-j", "DNAT",
-					"--to-destination", util.JoinHostPortInt32(destination.IP, destination.Port),
-					"-m", "statistic",
-					"--mode", "random",
-					"--probability", computeProbability(numLocalEndpoints, i+1),
-				},
-				Protocol: getIPTablesProtocol(externalIP),
-			},
-		}, iptRules...)
-	}
-	return iptRules
-}
-
-// getExternalIPTRules returns the IPTable DNAT rules for a service of type LB or ExternalIP
-// `svcPort` corresponds to port details for this service as specified in the service object
-// `externalIP` can either be the externalIP or LB.status.ingressIP
-// `dstIP` corresponds to the IP to which the provided externalIP needs to be DNAT-ed to
-//
-//	case1: if svcHasLocalHostNetEndPnt=false + isETPLocal=true, dstIP=config.MasqueradeIP["HostETPLocalMasqueradeIP"]
-//	case2: default: dstIP=clusterIP
-//
-// `svcHasLocalHostNetEndPnt` is true if this service has at least one host-networked endpoint that is local to this node
-// `isETPLocal` is true if the svc.Spec.ExternalTrafficPolicy=Local
-func getExternalIPTRules(svcPort corev1.ServicePort, externalIP, dstIP string, svcHasLocalHostNetEndPnt, isETPLocal bool) []nodeipt.Rule {
-	targetPort := svcPort.Port
-	chainName := iptableExternalIPChain
-	if !svcHasLocalHostNetEndPnt && isETPLocal {
-		// DNAT it to the masqueradeIP:nodePort instead of clusterIP:targetPort
-		dstIP = getMasqueradeVIP(externalIP)
-		targetPort = svcPort.NodePort
-		chainName = iptableETPChain
-	}
-	return []nodeipt.Rule{
-		{
-			Table: "nat",
-			Chain: chainName,
-			Args: []string{
-				"-p", string(svcPort.Protocol),
-				"-d", externalIP,
-				"--dport", fmt.Sprintf("%v", svcPort.Port),
-				"-j", "DNAT",
-				"--to-destination", util.JoinHostPortInt32(dstIP, targetPort),
-			},
-			Protocol: getIPTablesProtocol(externalIP),
-		},
-	}
-}
-
 func getGatewayForwardRules(cidrs []*net.IPNet) []nodeipt.Rule {
 	var returnRules []nodeipt.Rule
 	protocols := make(map[iptables.Protocol]struct{})
@@ -415,145 +246,44 @@ func initLocalGatewayIPTFilterRules(ifname string, cidr *net.IPNet) error {
 	return nil
 }
 
-func addChaintoTable(ipt util.IPTablesHelper, tableName, chain string) {
-	if err := ipt.NewChain(tableName, chain); err != nil {
-		klog.V(5).Infof("Chain: \"%s\" in table: \"%s\" already exists, skipping creation: %v", chain, tableName, err)
-	}
-}
-
-func handleGatewayIPTables(iptCallback func(rules []nodeipt.Rule) error, genGatewayChainRules func(chain string, proto iptables.Protocol) []nodeipt.Rule) error {
-	rules := make([]nodeipt.Rule, 0)
-	// (NOTE: Order is important, add jump to iptableETPChain before jump to NP/EIP chains)
-	for _, chain := range []string{iptableITPChain, iptableNodePortChain, iptableExternalIPChain, iptableETPChain} {
-		for _, proto := range clusterIPTablesProtocols() {
-			ipt, err := util.GetIPTablesHelper(proto)
-			if err != nil {
-				return err
-			}
-			addChaintoTable(ipt, "nat", chain)
-			if chain == iptableITPChain {
-				addChaintoTable(ipt, "mangle", chain)
-			}
-			rules = append(rules, genGatewayChainRules(chain, proto)...)
-		}
-	}
-	if err := iptCallback(rules); err != nil {
-		return fmt.Errorf("failed to handle iptables rules %v: %v", rules, err)
-	}
-	return nil
-}
-
-func initSharedGatewayIPTables() error {
-	if err := handleGatewayIPTables(insertIptRules, getGatewayInitRules); err != nil {
-		return err
-	}
-	return nil
-}
-
-func initLocalGatewayIPTables() error {
-	if err := handleGatewayIPTables(insertIptRules, getGatewayInitRules); err != nil {
-		return err
-	}
-	return nil
-}
-
-func cleanupSharedGatewayIPTChains() {
-	for _, chain := range []string{iptableNodePortChain, iptableExternalIPChain} {
-		// We clean up both IPv4 and IPv6, regardless of what is currently in use
-		for _, proto := range []iptables.Protocol{iptables.ProtocolIPv4, iptables.ProtocolIPv6} {
-			ipt, err := util.GetIPTablesHelper(proto)
-			if err != nil {
-				return
-			}
-			_ = ipt.ClearChain("nat", chain)
-			_ = ipt.DeleteChain("nat", chain)
+// cleanupLegacyGatewayIPTChains removes the legacy OVN-KUBE-* service chains, and the
+// rules jumping to them, that were used before the service rules were moved to nftables.
+func cleanupLegacyGatewayIPTChains() error {
+	var errs []error
+	// We clean up both IPv4 and IPv6, regardless of what is currently in use
+	for _, proto := range []iptables.Protocol{iptables.ProtocolIPv4, iptables.ProtocolIPv6} {
+		ipt, err := util.GetIPTablesHelper(proto)
+		if err != nil {
+			errs = append(errs, err)
+			continue
 		}
-	}
-}
-
-func recreateIPTRules(table, chain string, keepIPTRules []nodeipt.Rule) error {
-	var errors []error
-	var err error
-	klog.Infof("Recreating iptables rules for table: %s, chain: %s", table, chain)
-	// filter is a map of the table/chain to program rules for, as all rules are included in keepIPTRules
-	filter := map[string]map[string]struct{}{table: {chain: {}}}
-	if err = restoreIptRulesFiltered(keepIPTRules, filter); err != nil {
-		errors = append(errors, err)
-	}
-	return utilerrors.Join(errors...)
-}
-
-// getGatewayIPTRules returns ClusterIP, NodePort, ExternalIP and LoadBalancer iptables
-// rules for service. This must be used in conjunction with getGatewayNFTRules.
-//
-// case1: If !svcHasLocalHostNetEndPnt and svcTypeIsETPLocal rules that redirect traffic
-// to ovn-k8s-mp0 preserving sourceIP are added.
-//
-// case2: (default) A DNAT rule towards clusterIP svc is added ALWAYS.
-//
-// case3: if svcHasLocalHostNetEndPnt and svcTypeIsITPLocal, rule that redirects clusterIP traffic to host targetPort is added.
-//
-//	if !svcHasLocalHostNetEndPnt and svcTypeIsITPLocal, rule that marks clusterIP traffic to steer it to ovn-k8s-mp0 is added.
-func getGatewayIPTRules(service *corev1.Service, localEndpoints util.PortToLBEndpoints, svcHasLocalHostNetEndPnt bool) []nodeipt.Rule {
-	rules := make([]nodeipt.Rule, 0)
-	clusterIPs := util.GetClusterIPs(service)
-	svcTypeIsETPLocal := util.ServiceExternalTrafficPolicyLocal(service)
-	svcTypeIsITPLocal := util.ServiceInternalTrafficPolicyLocal(service)
-	for _, svcPort := range service.Spec.Ports {
-		if util.ServiceTypeHasNodePort(service) {
-			err := util.ValidatePort(svcPort.Protocol, svcPort.NodePort)
-			if err != nil {
-				klog.Errorf("Skipping service: %s, invalid service NodePort: %v", svcPort.Name, err)
-				continue
+		for _, chain := range []string{iptableITPChain, iptableNodePortChain, iptableExternalIPChain, iptableETPChain} {
+			if err := deleteIptRules(getLegacyGatewayJumpRules(chain, proto)); err != nil {
+				errs = append(errs, err)
 			}
-			err = util.ValidatePort(svcPort.Protocol, svcPort.Port)
-			if err != nil {
-				klog.Errorf("Skipping service: %s, invalid service port %v", svcPort.Name, err)
-				continue
+			tables := []string{"nat"}
+			if chain == iptableITPChain {
+				tables = append(tables, "mangle")
 			}
-			for _, clusterIP := range clusterIPs {
-				if svcTypeIsETPLocal && !svcHasLocalHostNetEndPnt {
-					// case1 (see function description for details)
-					// A DNAT rule to masqueradeIP is added that takes priority over DNAT to clusterIP.
-					if config.Gateway.Mode == config.GatewayModeLocal {
-						rules = append(rules, getNodePortIPTRules(svcPort, clusterIP, svcPort.NodePort, svcHasLocalHostNetEndPnt, svcTypeIsETPLocal)...)
-					}
-					// Note: getGatewayNFTRules will add rules to ensure that sourceIP is preserved
+			for _, table := range tables {
+				chains, err := ipt.ListChains(table)
+				if err != nil {
+					klog.V(5).Infof("Unable to list chains in table %s, skipping cleanup of %s: %v", table, chain, err)
+					continue
 				}
-				// case2 (see function description for details)
-				rules = append(rules, getNodePortIPTRules(svcPort, clusterIP, svcPort.Port, svcHasLocalHostNetEndPnt, false)...)
-			}
-		}
-
-		externalIPs := util.GetExternalAndLBIPs(service)
-
-		for _, externalIP := range externalIPs {
-			err := util.ValidatePort(svcPort.Protocol, svcPort.Port)
-			if err != nil {
-				klog.Errorf("Skipping service: %s, invalid service port %v", svcPort.Name, err)
-				continue
-			}
-			if clusterIP, err := util.MatchIPStringFamily(utilnet.IsIPv6String(externalIP), clusterIPs); err == nil {
-				if svcTypeIsETPLocal && !svcHasLocalHostNetEndPnt {
-					// case1 (see function description for details)
-					// DNAT traffic to masqueradeIP:nodePort instead of clusterIP:Port. We are leveraging the existing rules for NODEPORT
-					// service so no need to add a rule to skip SNAT since the corresponding nodePort svc would have one.
-					if !util.ServiceTypeHasNodePort(service) {
-						rules = append(rules, generateIPTRulesForLoadBalancersWithoutNodePorts(svcPort, externalIP, localEndpoints)...)
-					} else {
-						rules = append(rules, getExternalIPTRules(svcPort, externalIP, "", svcHasLocalHostNetEndPnt, svcTypeIsETPLocal)...)
-					}
+				if !slices.Contains(chains, chain) {
+					continue
+				}
+				klog.Infof("Removing legacy iptables chain %s from table %s", chain, table)
+				if err := ipt.ClearChain(table, chain); err != nil {
+					errs = append(errs, fmt.Errorf("failed to flush iptables chain %s/%s: %w", table, chain, err))
+					continue
+				}
+				if err := ipt.DeleteChain(table, chain); err != nil {
+					errs = append(errs, fmt.Errorf("failed to delete iptables chain %s/%s: %w", table, chain, err))
 				}
-				// case2 (see function description for details)
-				rules = append(rules, getExternalIPTRules(svcPort, externalIP, clusterIP, svcHasLocalHostNetEndPnt, false)...)
-			}
-		}
-		if svcTypeIsITPLocal {
-			// case3 (see function decription for details)
-			for _, clusterIP := range clusterIPs {
-				rules = append(rules, getITPLocalIPTRules(svcPort, clusterIP, svcHasLocalHostNetEndPnt)...)
 			}
 		}
 	}
-	return rules
+	return utilerrors.Join(errs...)
 }
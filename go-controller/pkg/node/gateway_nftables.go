@@ -9,10 +9,13 @@ package node
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"net"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
 	"sigs.k8s.io/knftables"
 
@@ -21,10 +24,12 @@ import (
 	nodenft "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/nftables"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/errors"
 )
 
 // gateway_nftables.go contains code for dealing with nftables rules; it is used in
-// conjunction with gateway_iptables.go.
+// conjunction with gateway_iptables.go, which still handles a few forwarding and
+// filter rules that do not depend on services.
 //
 // For the most part, using a mix of iptables and nftables rules does not matter, since
 // both of them are handled by netfilter. However, in cases where there is a close
@@ -37,8 +42,316 @@ const (
 	nftablesLocalGatewayMasqChain = "ovn-kube-local-gw-masq"
 	nftablesPodSubnetMasqChain    = "ovn-kube-pod-subnet-masq"
 	nftablesUDNMasqChain          = "ovn-kube-udn-masq"
+
+	// nftablesServiceNATPreroutingChain and nftablesServiceNATOutputChain are base
+	// chains registered into the nat prerouting and output hooks, that jump to the
+	// service chains below.
+	nftablesServiceNATPreroutingChain = "ovn-kube-service-nat-prerouting"
+	nftablesServiceNATOutputChain     = "ovn-kube-service-nat-output"
+
+	// nftablesServiceETPChain is a regular chain, only called for external traffic,
+	// that steers `externalTrafficPolicy: Local` traffic towards the local endpoints
+	// of a service. It takes priority over nftablesServiceDNATChain.
+	nftablesServiceETPChain = "ovn-kube-service-etp"
+
+	// nftablesServiceDNATChain is a regular chain that DNATs NodePort, ExternalIP and
+	// LoadBalancer traffic towards the service ClusterIP.
+	nftablesServiceDNATChain = "ovn-kube-service-dnat"
+
+	// nftablesServiceITPMarkChain is a base chain registered into the output hook
+	// that marks host traffic towards `internalTrafficPolicy: Local` services without
+	// local host-networked endpoints, so it gets steered into the management port.
+	nftablesServiceITPMarkChain = "ovn-kube-service-itp-mark"
+
+	// nftablesServiceETPLBChainPrefix is the prefix of the per-service-port chains that
+	// load balance `externalTrafficPolicy: Local` LoadBalancer traffic, for services
+	// without NodePorts, across the local endpoints.
+	nftablesServiceETPLBChainPrefix = "ovn-kube-etp-lb-"
+)
+
+// nftables service set and map names. All of them exist in an IPv4 and IPv6 flavor.
+const (
+	// nftablesServiceNodePortsV[4|6]Map map protocol / NodePort keys to the
+	// ClusterIP / port the traffic is DNATed to.
+	nftablesServiceNodePortsV4Map = "service-nodeports-v4"
+	nftablesServiceNodePortsV6Map = "service-nodeports-v6"
+
+	// nftablesServiceExternalIPsV[4|6]Map map ExternalIP or LoadBalancer IP / protocol /
+	// port keys to the ClusterIP / port the traffic is DNATed to.
+	nftablesServiceExternalIPsV4Map = "service-external-ips-v4"
+	nftablesServiceExternalIPsV6Map = "service-external-ips-v6"
+
+	// nftablesServiceETPNodePortsV[4|6]Set contain protocol / NodePort keys of
+	// `externalTrafficPolicy: Local` services whose traffic is DNATed to the
+	// HostETPLocalMasqueradeIP, preserving the NodePort.
+	nftablesServiceETPNodePortsV4Set = "service-etp-nodeports-v4"
+	nftablesServiceETPNodePortsV6Set = "service-etp-nodeports-v6"
+
+	// nftablesServiceETPExternalIPsV[4|6]Map map ExternalIP or LoadBalancer IP / protocol /
+	// port keys of `externalTrafficPolicy: Local` services to the
+	// HostETPLocalMasqueradeIP / NodePort the traffic is DNATed to.
+	nftablesServiceETPExternalIPsV4Map = "service-etp-external-ips-v4"
+	nftablesServiceETPExternalIPsV6Map = "service-etp-external-ips-v6"
+
+	// nftablesServiceETPLBV[4|6]Map are verdict maps containing LoadBalancer IP /
+	// protocol / port keys of `externalTrafficPolicy: Local` services without NodePorts,
+	// pointing to the per-service-port chain that load balances across local endpoints.
+	nftablesServiceETPLBV4Map = "service-etp-lb-v4"
+	nftablesServiceETPLBV6Map = "service-etp-lb-v6"
+
+	// nftablesServiceITPRedirectV[4|6]Map map ClusterIP / protocol / port keys of
+	// `internalTrafficPolicy: Local` services with local host-networked endpoints to
+	// the target port the traffic is redirected to.
+	nftablesServiceITPRedirectV4Map = "service-itp-redirect-v4"
+	nftablesServiceITPRedirectV6Map = "service-itp-redirect-v6"
+
+	// nftablesServiceITPMarkV[4|6]Set contain ClusterIP / protocol / port keys of
+	// `internalTrafficPolicy: Local` services without local host-networked endpoints.
+	nftablesServiceITPMarkV4Set = "service-itp-mark-v4"
+	nftablesServiceITPMarkV6Set = "service-itp-mark-v6"
 )
 
+// serviceNFTFamily holds the per IP family names and parameters of the service
+// nftables sets, maps and rules.
+type serviceNFTFamily struct {
+	nfproto           string
+	ipPrefix          string
+	addrType          string
+	etpMasqueradeIP   net.IP
+	nodePortsMap      string
+	externalIPsMap    string
+	etpNodePortsSet   string
+	etpExternalIPsMap string
+	etpLBMap          string
+	itpRedirectMap    string
+	itpMarkSet        string
+}
+
+func getServiceNFTFamilies() []serviceNFTFamily {
+	return []serviceNFTFamily{
+		{
+			nfproto:           "ipv4",
+			ipPrefix:          "ip",
+			addrType:          "ipv4_addr",
+			etpMasqueradeIP:   config.Gateway.MasqueradeIPs.V4HostETPLocalMasqueradeIP,
+			nodePortsMap:      nftablesServiceNodePortsV4Map,
+			externalIPsMap:    nftablesServiceExternalIPsV4Map,
+			etpNodePortsSet:   nftablesServiceETPNodePortsV4Set,
+			etpExternalIPsMap: nftablesServiceETPExternalIPsV4Map,
+			etpLBMap:          nftablesServiceETPLBV4Map,
+			itpRedirectMap:    nftablesServiceITPRedirectV4Map,
+			itpMarkSet:        nftablesServiceITPMarkV4Set,
+		},
+		{
+			nfproto:           "ipv6",
+			ipPrefix:          "ip6",
+			addrType:          "ipv6_addr",
+			etpMasqueradeIP:   config.Gateway.MasqueradeIPs.V6HostETPLocalMasqueradeIP,
+			nodePortsMap:      nftablesServiceNodePortsV6Map,
+			externalIPsMap:    nftablesServiceExternalIPsV6Map,
+			etpNodePortsSet:   nftablesServiceETPNodePortsV6Set,
+			etpExternalIPsMap: nftablesServiceETPExternalIPsV6Map,
+			etpLBMap:          nftablesServiceETPLBV6Map,
+			itpRedirectMap:    nftablesServiceITPRedirectV6Map,
+			itpMarkSet:        nftablesServiceITPMarkV6Set,
+		},
+	}
+}
+
+// getServiceNFTFamily returns the serviceNFTFamily matching the IP family of ip.
+func getServiceNFTFamily(ip string) serviceNFTFamily {
+	families := getServiceNFTFamilies()
+	if utilnet.IsIPv6String(ip) {
+		return families[1]
+	}
+	return families[0]
+}
+
+// initGatewayServiceNFTRules configures the nftables chains, rules, sets and maps that
+// are used to handle NodePort, ExternalIP and LoadBalancer service traffic on the host,
+// and removes the legacy iptables chains that used to do the same.
+//
+//	chain ovn-kube-service-nat-prerouting {
+//		type nat hook prerouting priority dstnat; policy accept;
+//		jump ovn-kube-service-etp
+//		jump ovn-kube-service-dnat
+//	}
+//	chain ovn-kube-service-nat-output {
+//		type nat hook output priority dstnat; policy accept;
+//		jump ovn-kube-service-dnat
+//		meta l4proto { tcp, udp, sctp } redirect to : ip daddr . meta l4proto . th dport map @service-itp-redirect-v4
+//	}
+//	chain ovn-kube-service-etp {
+//		ip daddr . meta l4proto . th dport vmap @service-etp-lb-v4
+//		meta l4proto { tcp, udp, sctp } dnat ip to ip daddr . meta l4proto . th dport map @service-etp-external-ips-v4
+//		meta nfproto ipv4 fib daddr type local meta l4proto . th dport @service-etp-nodeports-v4 dnat ip to 169.254.169.3
+//	}
+//	chain ovn-kube-service-dnat {
+//		meta nfproto ipv4 meta l4proto { tcp, udp, sctp } fib daddr type local dnat ip to meta l4proto . th dport map @service-nodeports-v4
+//		meta l4proto { tcp, udp, sctp } dnat ip to ip daddr . meta l4proto . th dport map @service-external-ips-v4
+//	}
+//	chain ovn-kube-service-itp-mark {
+//		type route hook output priority mangle; policy accept;
+//		ip daddr . meta l4proto . th dport @service-itp-mark-v4 meta mark set 0x1745ec
+//	}
+//
+// (IPv6 rules omitted.)
+func initGatewayServiceNFTRules() error {
+	if err := cleanupLegacyGatewayIPTChains(); err != nil {
+		// not fatal, the legacy chains are no longer referenced by new rules
+		klog.Warningf("Failed to clean up legacy gateway iptables chains: %v", err)
+	}
+
+	nft, err := nodenft.GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+	tx := nft.NewTransaction()
+
+	natPreroutingChain := &knftables.Chain{
+		Name:     nftablesServiceNATPreroutingChain,
+		Comment:  knftables.PtrTo("OVN services DNAT - Prerouting"),
+		Type:     knftables.PtrTo(knftables.NATType),
+		Hook:     knftables.PtrTo(knftables.PreroutingHook),
+		Priority: knftables.PtrTo(knftables.DNATPriority),
+	}
+	natOutputChain := &knftables.Chain{
+		Name:     nftablesServiceNATOutputChain,
+		Comment:  knftables.PtrTo("OVN services DNAT - Output"),
+		Type:     knftables.PtrTo(knftables.NATType),
+		Hook:     knftables.PtrTo(knftables.OutputHook),
+		Priority: knftables.PtrTo(knftables.DNATPriority),
+	}
+	etpChain := &knftables.Chain{
+		Name:    nftablesServiceETPChain,
+		Comment: knftables.PtrTo("OVN services externalTrafficPolicy=Local DNAT"),
+	}
+	dnatChain := &knftables.Chain{
+		Name:    nftablesServiceDNATChain,
+		Comment: knftables.PtrTo("OVN services DNAT to ClusterIP"),
+	}
+	itpMarkChain := &knftables.Chain{
+		Name:     nftablesServiceITPMarkChain,
+		Comment:  knftables.PtrTo("OVN services internalTrafficPolicy=Local mark"),
+		Type:     knftables.PtrTo(knftables.RouteType),
+		Hook:     knftables.PtrTo(knftables.OutputHook),
+		Priority: knftables.PtrTo(knftables.ManglePriority),
+	}
+	for _, chain := range []*knftables.Chain{natPreroutingChain, natOutputChain, etpChain, dnatChain, itpMarkChain} {
+		tx.Add(chain)
+		tx.Flush(chain)
+	}
+
+	tx.Add(&knftables.Rule{
+		Chain: nftablesServiceNATPreroutingChain,
+		Rule:  knftables.Concat("jump", nftablesServiceETPChain),
+	})
+	tx.Add(&knftables.Rule{
+		Chain: nftablesServiceNATPreroutingChain,
+		Rule:  knftables.Concat("jump", nftablesServiceDNATChain),
+	})
+	tx.Add(&knftables.Rule{
+		Chain: nftablesServiceNATOutputChain,
+		Rule:  knftables.Concat("jump", nftablesServiceDNATChain),
+	})
+
+	for _, family := range getServiceNFTFamilies() {
+		ipKey := fmt.Sprintf("%s . inet_proto . inet_service", family.addrType)
+		tx.Add(&knftables.Map{
+			Name:    family.nodePortsMap,
+			Comment: knftables.PtrTo(fmt.Sprintf("NodePorts DNAT to ClusterIP (%s)", family.nfproto)),
+			Type:    fmt.Sprintf("inet_proto . inet_service : %s . inet_service", family.addrType),
+		})
+		tx.Add(&knftables.Map{
+			Name:    family.externalIPsMap,
+			Comment: knftables.PtrTo(fmt.Sprintf("External IPs DNAT to ClusterIP (%s)", family.nfproto)),
+			Type:    fmt.Sprintf("%s : %s . inet_service", ipKey, family.addrType),
+		})
+		tx.Add(&knftables.Set{
+			Name:    family.etpNodePortsSet,
+			Comment: knftables.PtrTo(fmt.Sprintf("eTP:Local NodePorts DNAT to masquerade IP (%s)", family.nfproto)),
+			Type:    "inet_proto . inet_service",
+		})
+		tx.Add(&knftables.Map{
+			Name:    family.etpExternalIPsMap,
+			Comment: knftables.PtrTo(fmt.Sprintf("eTP:Local External IPs DNAT to masquerade IP (%s)", family.nfproto)),
+			Type:    fmt.Sprintf("%s : %s . inet_service", ipKey, family.addrType),
+		})
+		tx.Add(&knftables.Map{
+			Name:    family.etpLBMap,
+			Comment: knftables.PtrTo(fmt.Sprintf("eTP:Local LoadBalancers without NodePorts (%s)", family.nfproto)),
+			Type:    fmt.Sprintf("%s : verdict", ipKey),
+		})
+		tx.Add(&knftables.Map{
+			Name:    family.itpRedirectMap,
+			Comment: knftables.PtrTo(fmt.Sprintf("iTP:Local redirect to host target port (%s)", family.nfproto)),
+			Type:    fmt.Sprintf("%s : inet_service", ipKey),
+		})
+		tx.Add(&knftables.Set{
+			Name:    family.itpMarkSet,
+			Comment: knftables.PtrTo(fmt.Sprintf("iTP:Local mark towards management port (%s)", family.nfproto)),
+			Type:    ipKey,
+		})
+
+		ipKeyExpr := knftables.Concat(family.ipPrefix, "daddr . meta l4proto . th dport")
+		tx.Add(&knftables.Rule{
+			Chain: nftablesServiceETPChain,
+			Rule:  knftables.Concat(ipKeyExpr, "vmap", "@", family.etpLBMap),
+		})
+		tx.Add(&knftables.Rule{
+			Chain: nftablesServiceETPChain,
+			Rule: knftables.Concat(
+				"meta l4proto { tcp, udp, sctp }",
+				"dnat", family.ipPrefix, "to", ipKeyExpr, "map", "@", family.etpExternalIPsMap,
+			),
+		})
+		tx.Add(&knftables.Rule{
+			Chain: nftablesServiceETPChain,
+			Rule: knftables.Concat(
+				"meta nfproto", family.nfproto,
+				"fib daddr type local",
+				"meta l4proto . th dport", "@", family.etpNodePortsSet,
+				"dnat", family.ipPrefix, "to", family.etpMasqueradeIP,
+			),
+		})
+		tx.Add(&knftables.Rule{
+			Chain: nftablesServiceDNATChain,
+			Rule: knftables.Concat(
+				"meta nfproto", family.nfproto,
+				"meta l4proto { tcp, udp, sctp }",
+				"fib daddr type local",
+				"dnat", family.ipPrefix, "to", "meta l4proto . th dport", "map", "@", family.nodePortsMap,
+			),
+		})
+		tx.Add(&knftables.Rule{
+			Chain: nftablesServiceDNATChain,
+			Rule: knftables.Concat(
+				"meta l4proto { tcp, udp, sctp }",
+				"dnat", family.ipPrefix, "to", ipKeyExpr, "map", "@", family.externalIPsMap,
+			),
+		})
+		tx.Add(&knftables.Rule{
+			Chain: nftablesServiceNATOutputChain,
+			Rule: knftables.Concat(
+				"meta l4proto { tcp, udp, sctp }",
+				"redirect to :", ipKeyExpr, "map", "@", family.itpRedirectMap,
+			),
+		})
+		tx.Add(&knftables.Rule{
+			Chain: nftablesServiceITPMarkChain,
+			Rule: knftables.Concat(
+				ipKeyExpr, "@", family.itpMarkSet,
+				"meta mark set", types.OVNKubeITPMark,
+			),
+		})
+	}
+
+	if err := nft.Run(context.TODO(), tx); err != nil {
+		return fmt.Errorf("failed to setup service nftables rules: %w", err)
+	}
+	return nil
+}
+
 // getNoSNATNodePortRules returns elements to add to the "mgmtport-no-snat-nodeports"
 // set to prevent SNAT of sourceIP when passing through the management port, for an
 // `externalTrafficPolicy: Local` service with NodePorts.
@@ -176,33 +489,324 @@ func recreateNFTMap(mapName string, keepNFTElems []*knftables.Element) error {
 	return err
 }
 
-// getGatewayNFTRules returns nftables rules for service. This must be used in conjunction
-// with getGatewayIPTRules.
-func getGatewayNFTRules(service *corev1.Service, localEndpoints util.PortToLBEndpoints, svcHasLocalHostNetEndPnt bool) []*knftables.Element {
-	rules := make([]*knftables.Element, 0)
+// getNodePortNFTRule returns the element DNATing the NodePort of svcPort to
+// `targetIP`:`targetPort`.
+func getNodePortNFTRule(svcPort corev1.ServicePort, targetIP string, targetPort int32) *knftables.Element {
+	return &knftables.Element{
+		Map:   getServiceNFTFamily(targetIP).nodePortsMap,
+		Key:   []string{strings.ToLower(string(svcPort.Protocol)), fmt.Sprintf("%d", svcPort.NodePort)},
+		Value: []string{targetIP, fmt.Sprintf("%d", targetPort)},
+	}
+}
+
+// getETPNodePortNFTRule returns the element DNATing the NodePort of svcPort to the
+// HostETPLocalMasqueradeIP of the IP family of clusterIP.
+func getETPNodePortNFTRule(svcPort corev1.ServicePort, clusterIP string) *knftables.Element {
+	return &knftables.Element{
+		Set: getServiceNFTFamily(clusterIP).etpNodePortsSet,
+		Key: []string{strings.ToLower(string(svcPort.Protocol)), fmt.Sprintf("%d", svcPort.NodePort)},
+	}
+}
+
+// getExternalIPNFTRule returns the element DNATing `externalIP`:svcPort to
+// `targetIP`:`targetPort`. mapName is either the externalIPsMap or the
+// etpExternalIPsMap of the IP family of externalIP.
+func getExternalIPNFTRule(mapName string, svcPort corev1.ServicePort, externalIP, targetIP string, targetPort int32) *knftables.Element {
+	return &knftables.Element{
+		Map:   mapName,
+		Key:   []string{externalIP, strings.ToLower(string(svcPort.Protocol)), fmt.Sprintf("%d", svcPort.Port)},
+		Value: []string{targetIP, fmt.Sprintf("%d", targetPort)},
+	}
+}
+
+// getITPLocalNFTRule returns the element redirecting `clusterIP`:svcPort towards the
+// host target port if svcHasLocalHostNetEndPnt, or marking it to be steered into the
+// management port otherwise.
+func getITPLocalNFTRule(svcPort corev1.ServicePort, clusterIP string, svcHasLocalHostNetEndPnt bool) *knftables.Element {
+	family := getServiceNFTFamily(clusterIP)
+	key := []string{clusterIP, strings.ToLower(string(svcPort.Protocol)), fmt.Sprintf("%d", svcPort.Port)}
+	if svcHasLocalHostNetEndPnt {
+		return &knftables.Element{
+			Map:   family.itpRedirectMap,
+			Key:   key,
+			Value: []string{fmt.Sprintf("%d", int32(svcPort.TargetPort.IntValue()))},
+		}
+	}
+	return &knftables.Element{
+		Set: family.itpMarkSet,
+		Key: key,
+	}
+}
+
+// getServiceETPLBChainName returns the name of the chain load balancing
+// `externalIP`:svcPort across local endpoints.
+func getServiceETPLBChainName(svcPort corev1.ServicePort, externalIP string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(fmt.Sprintf("%s/%s/%d", externalIP, svcPort.Protocol, svcPort.Port)))
+	return fmt.Sprintf("%s%016x", nftablesServiceETPLBChainPrefix, h.Sum64())
+}
+
+// getLoadBalancerWithoutNodePortsNFTRule returns the rule load balancing
+// `externalIP`:svcPort across the local endpoints of svcPort, in its own chain, along
+// with the verdict map element jumping to that chain. It returns nil if there are no
+// local endpoints of the IP family of externalIP.
+//
+//	chain ovn-kube-etp-lb-<hash> {
+//		meta l4proto tcp dnat ip to numgen random mod 2 map { 0 : 10.244.0.5 . 8080, 1 : 10.244.0.6 . 8080 }
+//	}
+func getLoadBalancerWithoutNodePortsNFTRule(svcPort corev1.ServicePort, externalIP string, localEndpoints util.PortToLBEndpoints) (*knftables.Rule, *knftables.Element) {
+	// Get the endpoints for the port key.
+	// svcPortKey is of format e.g. "TCP/my-port-name" or "TCP/" if name is empty
+	// (is the case when only a single ServicePort is defined on this service).
+	svcPortKey := util.GetServicePortKey(svcPort.Protocol, svcPort.Name)
+	lbEndpoints := localEndpoints[svcPortKey]
+
+	// Get IPv4 or IPv6 IPs, depending on the type of the service's external IP.
+	destinations := lbEndpoints.GetV4Destinations()
+	if utilnet.IsIPv6String(externalIP) {
+		destinations = lbEndpoints.GetV6Destinations()
+	}
+	if len(destinations) == 0 {
+		return nil, nil
+	}
+
+	family := getServiceNFTFamily(externalIP)
+	protocol := strings.ToLower(string(svcPort.Protocol))
+	var target string
+	if len(destinations) == 1 {
+		target = util.JoinHostPortInt32(destinations[0].IP, destinations[0].Port)
+	} else {
+		targets := make([]string, 0, len(destinations))
+		for i, destination := range destinations {
+			targets = append(targets, fmt.Sprintf("%d : %s . %d", i, destination.IP, destination.Port))
+		}
+		target = fmt.Sprintf("numgen random mod %d map { %s }", len(destinations), strings.Join(targets, ", "))
+	}
+
+	chainName := getServiceETPLBChainName(svcPort, externalIP)
+	rule := &knftables.Rule{
+		Chain: chainName,
+		Rule:  knftables.Concat("meta l4proto", protocol, "dnat", family.ipPrefix, "to", target),
+	}
+	elem := &knftables.Element{
+		Map:   family.etpLBMap,
+		Key:   []string{externalIP, protocol, fmt.Sprintf("%d", svcPort.Port)},
+		Value: []string{fmt.Sprintf("goto %s", chainName)},
+	}
+	return rule, elem
+}
+
+// getGatewayNFTRules returns the nftables set and map elements for service, along with
+// the rules of the per-service-port chains they reference. The chains must be created
+// with updateGatewayNFTChains before the elements are added, and deleted with
+// deleteGatewayNFTChains after the elements are removed.
+//
+// case1: If !svcHasLocalHostNetEndPnt and svcTypeIsETPLocal, elements that redirect
+// external traffic to ovn-k8s-mp0 preserving sourceIP are added.
+//
+// case2: (default) An element DNATing to the clusterIP svc is added ALWAYS.
+//
+// case3: if svcHasLocalHostNetEndPnt and svcTypeIsITPLocal, an element that redirects clusterIP traffic to host targetPort is added.
+//
+//	if !svcHasLocalHostNetEndPnt and svcTypeIsITPLocal, an element that marks clusterIP traffic to steer it to ovn-k8s-mp0 is added.
+func getGatewayNFTRules(service *corev1.Service, localEndpoints util.PortToLBEndpoints, svcHasLocalHostNetEndPnt bool) ([]*knftables.Element, []*knftables.Rule) {
+	elems := make([]*knftables.Element, 0)
+	chainRules := make([]*knftables.Rule, 0)
+	clusterIPs := util.GetClusterIPs(service)
+	externalIPs := util.GetExternalAndLBIPs(service)
 	svcTypeIsETPLocal := util.ServiceExternalTrafficPolicyLocal(service)
+	svcTypeIsITPLocal := util.ServiceInternalTrafficPolicyLocal(service)
 	for _, svcPort := range service.Spec.Ports {
+		if util.ServiceTypeHasNodePort(service) {
+			err := util.ValidatePort(svcPort.Protocol, svcPort.NodePort)
+			if err != nil {
+				klog.Errorf("Skipping service: %s, invalid service NodePort: %v", svcPort.Name, err)
+				continue
+			}
+			err = util.ValidatePort(svcPort.Protocol, svcPort.Port)
+			if err != nil {
+				klog.Errorf("Skipping service: %s, invalid service port %v", svcPort.Name, err)
+				continue
+			}
+			for _, clusterIP := range clusterIPs {
+				if svcTypeIsETPLocal && !svcHasLocalHostNetEndPnt && config.Gateway.Mode == config.GatewayModeLocal {
+					// case1 (see function description for details)
+					// DNAT to masqueradeIP:nodePort takes priority over DNAT to clusterIP.
+					elems = append(elems, getETPNodePortNFTRule(svcPort, clusterIP))
+				}
+				// case2 (see function description for details)
+				elems = append(elems, getNodePortNFTRule(svcPort, clusterIP, svcPort.Port))
+			}
+		}
+
+		for _, externalIP := range externalIPs {
+			err := util.ValidatePort(svcPort.Protocol, svcPort.Port)
+			if err != nil {
+				klog.Errorf("Skipping service: %s, invalid service port %v", svcPort.Name, err)
+				continue
+			}
+			clusterIP, err := util.MatchIPStringFamily(utilnet.IsIPv6String(externalIP), clusterIPs)
+			if err != nil {
+				continue
+			}
+			family := getServiceNFTFamily(externalIP)
+			if svcTypeIsETPLocal && !svcHasLocalHostNetEndPnt {
+				// case1 (see function description for details)
+				// DNAT traffic to masqueradeIP:nodePort instead of clusterIP:Port, leveraging the
+				// NodePort service, or straight to the local endpoints if there is no NodePort.
+				if !util.ServiceTypeHasNodePort(service) {
+					if rule, elem := getLoadBalancerWithoutNodePortsNFTRule(svcPort, externalIP, localEndpoints); rule != nil {
+						chainRules = append(chainRules, rule)
+						elems = append(elems, elem)
+					}
+				} else {
+					elems = append(elems, getExternalIPNFTRule(family.etpExternalIPsMap, svcPort, externalIP,
+						family.etpMasqueradeIP.String(), svcPort.NodePort))
+				}
+			}
+			// case2 (see function description for details)
+			elems = append(elems, getExternalIPNFTRule(family.externalIPsMap, svcPort, externalIP, clusterIP, svcPort.Port))
+		}
+
+		if svcTypeIsITPLocal {
+			// case3 (see function description for details)
+			for _, clusterIP := range clusterIPs {
+				elems = append(elems, getITPLocalNFTRule(svcPort, clusterIP, svcHasLocalHostNetEndPnt))
+			}
+		}
+
 		if svcTypeIsETPLocal && !svcHasLocalHostNetEndPnt {
 			// For `externalTrafficPolicy: Local` services with pod-network
 			// endpoints, we need to add rules to prevent them from being SNATted
 			// when entering the management port, to preserve the client IP.
 			if util.ServiceTypeHasNodePort(service) {
-				rules = append(rules, getNoSNATNodePortRules(svcPort)...)
-			} else if len(util.GetExternalAndLBIPs(service)) > 0 {
-				rules = append(rules, getNoSNATLoadBalancerIPRules(svcPort, localEndpoints)...)
+				elems = append(elems, getNoSNATNodePortRules(svcPort)...)
+			} else if len(externalIPs) > 0 {
+				elems = append(elems, getNoSNATLoadBalancerIPRules(svcPort, localEndpoints)...)
 			}
 		}
 	}
-	return rules
+	return elems, chainRules
 }
 
 // getGatewayNFTSets returns the names of all of the sets used by getGatewayNFTRules.
 func getGatewayNFTSets() []string {
-	return []string{
+	sets := []string{
 		types.NFTMgmtPortNoSNATNodePorts,
 		types.NFTMgmtPortNoSNATServicesV4,
 		types.NFTMgmtPortNoSNATServicesV6,
 	}
+	for _, family := range getServiceNFTFamilies() {
+		sets = append(sets, family.etpNodePortsSet, family.itpMarkSet)
+	}
+	return sets
+}
+
+// getGatewayNFTMaps returns the names of all of the maps used by getGatewayNFTRules.
+func getGatewayNFTMaps() []string {
+	var maps []string
+	for _, family := range getServiceNFTFamilies() {
+		maps = append(maps, family.nodePortsMap, family.externalIPsMap, family.etpExternalIPsMap,
+			family.etpLBMap, family.itpRedirectMap)
+	}
+	return maps
+}
+
+// updateGatewayNFTChains creates the per-service-port chains of the provided rules,
+// replacing their contents if they already exist.
+func updateGatewayNFTChains(chainRules []*knftables.Rule) error {
+	if len(chainRules) == 0 {
+		return nil
+	}
+	nft, err := nodenft.GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+	tx := nft.NewTransaction()
+	for _, rule := range chainRules {
+		chain := &knftables.Chain{Name: rule.Chain}
+		tx.Add(chain)
+		tx.Flush(chain)
+		tx.Add(rule)
+	}
+	return nft.Run(context.TODO(), tx)
+}
+
+// deleteGatewayNFTChains deletes the per-service-port chains of the provided rules.
+// The chains must no longer be referenced by any verdict map element.
+func deleteGatewayNFTChains(chainRules []*knftables.Rule) error {
+	if len(chainRules) == 0 {
+		return nil
+	}
+	nft, err := nodenft.GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+	tx := nft.NewTransaction()
+	for _, rule := range chainRules {
+		// Do Add+Delete, which ensures the chain is deleted whether or not it
+		// previously existed.
+		chain := &knftables.Chain{Name: rule.Chain}
+		tx.Add(chain)
+		tx.Delete(chain)
+	}
+	return nft.Run(context.TODO(), tx)
+}
+
+// deleteStaleGatewayNFTChains deletes all the per-service-port chains that are not
+// part of keepChainRules. It must be called once the stale chains are no longer
+// referenced by any verdict map element.
+func deleteStaleGatewayNFTChains(keepChainRules []*knftables.Rule) error {
+	nft, err := nodenft.GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+	chains, err := nft.List(context.TODO(), "chains")
+	if err != nil {
+		if knftables.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	keep := sets.New[string]()
+	for _, rule := range keepChainRules {
+		keep.Insert(rule.Chain)
+	}
+	tx := nft.NewTransaction()
+	for _, chain := range chains {
+		if strings.HasPrefix(chain, nftablesServiceETPLBChainPrefix) && !keep.Has(chain) {
+			tx.Delete(&knftables.Chain{Name: chain})
+		}
+	}
+	if tx.NumOperations() == 0 {
+		return nil
+	}
+	return nft.Run(context.TODO(), tx)
+}
+
+// syncGatewayNFTRules replaces the contents of all the service sets, maps and
+// per-service-port chains with keepNFTElems and keepNFTChainRules.
+func syncGatewayNFTRules(keepNFTElems []*knftables.Element, keepNFTChainRules []*knftables.Rule) error {
+	var errors []error
+	if err := updateGatewayNFTChains(keepNFTChainRules); err != nil {
+		errors = append(errors, err)
+	}
+	for _, set := range getGatewayNFTSets() {
+		if err := recreateNFTSet(set, keepNFTElems); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	for _, nftMap := range getGatewayNFTMaps() {
+		if err := recreateNFTMap(nftMap, keepNFTElems); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	if len(errors) == 0 {
+		if err := deleteStaleGatewayNFTChains(keepNFTChainRules); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	return utilerrors.Join(errors...)
 }
 
 // getUDNNFTRules generates nftables rules for a UDN service.
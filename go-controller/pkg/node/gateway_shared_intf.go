@@ -793,7 +793,7 @@ func (npw *nodePortWatcher) updateServiceInfo(index ktypes.NamespacedName, servi
 	return &ptrCopy, exists
 }
 
-// addServiceRules ensures the correct iptables rules and OpenFlow physical
+// addServiceRules ensures the correct nftables rules and OpenFlow physical
 // flows are programmed for a given service and endpoint configuration
 func addServiceRules(service *corev1.Service, netInfo util.NetInfo, localEndpoints util.PortToLBEndpoints, svcHasLocalHostNetEndPnt bool, npw *nodePortWatcher) error {
 	// For dpu or Full mode
@@ -812,20 +812,17 @@ func addServiceRules(service *corev1.Service, netInfo util.NetInfo, localEndpoin
 	}
 
 	if npw == nil || !npw.dpuMode {
-		// add iptables/nftables rules only in full mode
-		iptRules := getGatewayIPTRules(service, localEndpoints, svcHasLocalHostNetEndPnt)
-		if len(iptRules) > 0 {
-			if err := insertIptRules(iptRules); err != nil {
-				err = fmt.Errorf("failed to add iptables rules for service %s/%s: %v",
-					service.Namespace, service.Name, err)
-				errors = append(errors, err)
-			}
-		}
-		nftElems := getGatewayNFTRules(service, localEndpoints, svcHasLocalHostNetEndPnt)
+		// add nftables rules only in full mode
+		nftElems, nftChainRules := getGatewayNFTRules(service, localEndpoints, svcHasLocalHostNetEndPnt)
 		if netInfo.IsPrimaryNetwork() && activeNetwork != nil {
 			nftElems = append(nftElems, getUDNNFTRules(service, activeNetwork)...)
 		}
-		if len(nftElems) > 0 {
+		// the chains need to exist before the verdict map elements referencing them
+		if err := updateGatewayNFTChains(nftChainRules); err != nil {
+			err = fmt.Errorf("failed to update nftables chains for service %s/%s: %v",
+				service.Namespace, service.Name, err)
+			errors = append(errors, err)
+		} else if len(nftElems) > 0 {
 			if err := nodenft.UpdateNFTElements(nftElems); err != nil {
 				err = fmt.Errorf("failed to update nftables rules for service %s/%s: %v",
 					service.Namespace, service.Name, err)
@@ -837,7 +834,7 @@ func addServiceRules(service *corev1.Service, netInfo util.NetInfo, localEndpoin
 	return utilerrors.Join(errors...)
 }
 
-// delServiceRules deletes all possible iptables rules and OpenFlow physical
+// delServiceRules deletes all possible nftables rules and OpenFlow physical
 // flows for a service
 func delServiceRules(service *corev1.Service, localEndpoints util.PortToLBEndpoints, npw *nodePortWatcher) error {
 	var err error
@@ -851,7 +848,7 @@ func delServiceRules(service *corev1.Service, localEndpoints util.PortToLBEndpoi
 	}
 
 	if npw == nil || !npw.dpuMode {
-		// Always try and delete all rules here in full mode & in host only mode. We don't touch iptables in dpu mode.
+		// Always try and delete all rules here in full mode & in host only mode. We don't touch nftables in dpu mode.
 		// +--------------------------+-----------------------+-----------------------+--------------------------------+
 		// | svcHasLocalHostNetEndPnt | ExternalTrafficPolicy | InternalTrafficPolicy |     Scenario for deletion      |
 		// |--------------------------|-----------------------|-----------------------|--------------------------------|
@@ -877,17 +874,9 @@ func delServiceRules(service *corev1.Service, localEndpoints util.PortToLBEndpoi
 		// |                          |                       |                       |   + default dnat towards CIP   |
 		// +--------------------------+-----------------------+-----------------------+--------------------------------+
 
-		iptRules := getGatewayIPTRules(service, localEndpoints, true)
-		iptRules = append(iptRules, getGatewayIPTRules(service, localEndpoints, false)...)
-		if len(iptRules) > 0 {
-			if err := nodeipt.DelRules(iptRules); err != nil {
-				err := fmt.Errorf("failed to delete iptables rules for service %s/%s: %v",
-					service.Namespace, service.Name, err)
-				errors = append(errors, err)
-			}
-		}
-		nftElems := getGatewayNFTRules(service, localEndpoints, true)
-		nftElems = append(nftElems, getGatewayNFTRules(service, localEndpoints, false)...)
+		nftElems, _ := getGatewayNFTRules(service, localEndpoints, true)
+		nftElemsNoHostEp, nftChainRules := getGatewayNFTRules(service, localEndpoints, false)
+		nftElems = append(nftElems, nftElemsNoHostEp...)
 		if util.IsNetworkSegmentationSupportEnabled() {
 			nftElems = append(nftElems, getUDNNFTRules(service, nil)...)
 		}
@@ -896,6 +885,11 @@ func delServiceRules(service *corev1.Service, localEndpoints util.PortToLBEndpoi
 				err = fmt.Errorf("failed to delete nftables rules for service %s/%s: %v",
 					service.Namespace, service.Name, err)
 				errors = append(errors, err)
+			} else if err := deleteGatewayNFTChains(nftChainRules); err != nil {
+				// the chains can only be deleted once the verdict map elements referencing them are gone
+				err = fmt.Errorf("failed to delete nftables chains for service %s/%s: %v",
+					service.Namespace, service.Name, err)
+				errors = append(errors, err)
 			}
 		}
 	}
@@ -1239,8 +1233,8 @@ func (npw *nodePortWatcher) DeleteService(service *corev1.Service) error {
 func (npw *nodePortWatcher) SyncServices(services []interface{}) error {
 	var err error
 	var errors []error
-	var keepIPTRules []nodeipt.Rule
-	var keepNFTSetElems, keepNFTMapElems []*knftables.Element
+	var keepNFTElems, keepNFTMapElems []*knftables.Element
+	var keepNFTChainRules []*knftables.Rule
 	for _, serviceInterface := range services {
 		name := ktypes.NamespacedName{Namespace: serviceInterface.(*corev1.Service).Namespace, Name: serviceInterface.(*corev1.Service).Name}
 
@@ -1294,8 +1288,9 @@ func (npw *nodePortWatcher) SyncServices(services []interface{}) error {
 		}
 		// Add correct netfilter rules only for Full mode
 		if !npw.dpuMode {
-			keepIPTRules = append(keepIPTRules, getGatewayIPTRules(service, localEndpoints, hasLocalHostNetworkEp)...)
-			keepNFTSetElems = append(keepNFTSetElems, getGatewayNFTRules(service, localEndpoints, hasLocalHostNetworkEp)...)
+			nftElems, nftChainRules := getGatewayNFTRules(service, localEndpoints, hasLocalHostNetworkEp)
+			keepNFTElems = append(keepNFTElems, nftElems...)
+			keepNFTChainRules = append(keepNFTChainRules, nftChainRules...)
 			if util.IsNetworkSegmentationSupportEnabled() && netInfo.IsPrimaryNetwork() {
 				netConfig := npw.ofm.getActiveNetwork(netInfo)
 				if netConfig == nil {
@@ -1310,21 +1305,9 @@ func (npw *nodePortWatcher) SyncServices(services []interface{}) error {
 	npw.ofm.requestFlowSync()
 	// sync netfilter rules once only for Full mode
 	if !npw.dpuMode {
-		// (NOTE: Order is important, add jump to iptableETPChain before jump to NP/EIP chains)
-		for _, chain := range []string{iptableITPChain, iptableNodePortChain, iptableExternalIPChain, iptableETPChain} {
-			if err = recreateIPTRules("nat", chain, keepIPTRules); err != nil {
-				errors = append(errors, err)
-			}
-		}
-		if err = recreateIPTRules("mangle", iptableITPChain, keepIPTRules); err != nil {
+		if err = syncGatewayNFTRules(keepNFTElems, keepNFTChainRules); err != nil {
 			errors = append(errors, err)
 		}
-
-		for _, set := range getGatewayNFTSets() {
-			if err = recreateNFTSet(set, keepNFTSetElems); err != nil {
-				errors = append(errors, err)
-			}
-		}
 		if util.IsNetworkSegmentationSupportEnabled() {
 			for _, nftMap := range getUDNNFTMaps() {
 				if err = recreateNFTMap(nftMap, keepNFTMapElems); err != nil {
@@ -1676,8 +1659,8 @@ func (npwipt *nodePortWatcherIptables) DeleteService(service *corev1.Service) er
 func (npwipt *nodePortWatcherIptables) SyncServices(services []interface{}) error {
 	var err error
 	var errors []error
-	keepIPTRules := []nodeipt.Rule{}
 	keepNFTElems := []*knftables.Element{}
+	keepNFTChainRules := []*knftables.Rule{}
 	for _, serviceInterface := range services {
 		service, ok := serviceInterface.(*corev1.Service)
 		if !ok {
@@ -1704,23 +1687,16 @@ func (npwipt *nodePortWatcherIptables) SyncServices(services []interface{}) erro
 			// network not on our node
 			continue
 		}
-		// Add correct iptables rules.
+		// Add correct nftables rules.
 		// TODO: ETP and ITP is not implemented for smart NIC mode.
-		keepIPTRules = append(keepIPTRules, getGatewayIPTRules(service, nil, false)...)
-		keepNFTElems = append(keepNFTElems, getGatewayNFTRules(service, nil, false)...)
+		nftElems, nftChainRules := getGatewayNFTRules(service, nil, false)
+		keepNFTElems = append(keepNFTElems, nftElems...)
+		keepNFTChainRules = append(keepNFTChainRules, nftChainRules...)
 	}
 
 	// sync rules once
-	for _, chain := range []string{iptableNodePortChain, iptableExternalIPChain} {
-		if err = recreateIPTRules("nat", chain, keepIPTRules); err != nil {
-			errors = append(errors, err)
-		}
-	}
-
-	for _, set := range getGatewayNFTSets() {
-		if err = recreateNFTSet(set, keepNFTElems); err != nil {
-			errors = append(errors, err)
-		}
+	if err = syncGatewayNFTRules(keepNFTElems, keepNFTChainRules); err != nil {
+		errors = append(errors, err)
 	}
 
 	return utilerrors.Join(errors...)
@@ -1887,15 +1863,11 @@ func newNodePortWatcher(
 	// In the shared gateway mode, the NodePort service is handled by the OpenFlow flows configured
 	// on the OVS bridge in the host. These flows act only on the packets coming in from outside
 	// of the node. If someone on the node is trying to access the NodePort service, those packets
-	// will not be processed by the OpenFlow flows, so we need to add iptable rules that DNATs the
+	// will not be processed by the OpenFlow flows, so we need to add nftables rules that DNATs the
 	// NodePortIP:NodePort to ClusterServiceIP:Port. We don't need to do this on DPU.
 	if config.IsModeFull() {
-		if config.Gateway.Mode == config.GatewayModeLocal {
-			if err := initLocalGatewayIPTables(); err != nil {
-				return nil, err
-			}
-		} else if config.Gateway.Mode == config.GatewayModeShared {
-			if err := initSharedGatewayIPTables(); err != nil {
+		if config.Gateway.Mode != config.GatewayModeDisabled {
+			if err := initGatewayServiceNFTRules(); err != nil {
 				return nil, err
 			}
 		}
@@ -1997,7 +1969,9 @@ func cleanupSharedGateway(ovsClient libovsdbclient.Client) error {
 	}
 
 	if config.IsModeDPUHost() || config.IsModeFull() {
-		cleanupSharedGatewayIPTChains()
+		if err := cleanupLegacyGatewayIPTChains(); err != nil {
+			klog.Warningf("Failed to clean up legacy gateway iptables chains: %v", err)
+		}
 	}
 	return nil
 }
@@ -7,8 +7,10 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"testing"
 
@@ -20,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/knftables"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	adminpolicybasedrouteclient "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned/fake"
@@ -27,6 +30,7 @@ import (
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
+	nodenft "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/nftables"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/routemanager"
 	ovntest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
 	netlink_mocks "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/mocks/github.com/vishvananda/netlink"
@@ -195,13 +199,18 @@ func (m *mockNetworkManagerWithActiveUDN) GetActiveNetworkForNamespace(_ string)
 	return m.netInfo, nil
 }
 
-// verifyIPTablesRule checks if an iptables rule exists and asserts the expected state
-func verifyIPTablesRule(ipt util.IPTablesHelper, serviceIP string, servicePort, nodePort int32, shouldExist bool, message string) {
-	exists, err := ipt.Exists("nat", "OVN-KUBE-NODEPORT",
-		"-p", "TCP", "-m", "addrtype", "--dst-type", "LOCAL",
-		"--dport", fmt.Sprintf("%d", nodePort), "-j", "DNAT",
-		"--to-destination", fmt.Sprintf("%s:%d", serviceIP, servicePort))
+// verifyNFTNodePortElement checks if a service NodePort nftables element exists and asserts the expected state
+func verifyNFTNodePortElement(nft *knftables.Fake, serviceIP string, servicePort, nodePort int32, shouldExist bool, message string) {
+	elements, err := nft.ListElements(context.TODO(), "map", nftablesServiceNodePortsV4Map)
 	Expect(err).NotTo(HaveOccurred())
+	exists := false
+	for _, elem := range elements {
+		if slices.Equal(elem.Key, []string{"tcp", fmt.Sprintf("%d", nodePort)}) &&
+			slices.Equal(elem.Value, []string{serviceIP, fmt.Sprintf("%d", servicePort)}) {
+			exists = true
+			break
+		}
+	}
 	if shouldExist {
 		Expect(exists).To(BeTrue(), message)
 	} else {
@@ -210,8 +219,8 @@ func verifyIPTablesRule(ipt util.IPTablesHelper, serviceIP string, servicePort,
 }
 
 // setupServiceAndEndpointSliceWithRules creates a service and endpoint slice, adds them to npw,
-// and verifies iptables rules are created. Returns the created endpoint slice.
-func setupServiceAndEndpointSliceWithRules(npw *nodePortWatcher, ipt util.IPTablesHelper, svcName, namespace, serviceIP, endpointIP string, servicePort, nodePort int32, annotations map[string]string) *discovery.EndpointSlice {
+// and verifies nftables elements are created. Returns the created endpoint slice.
+func setupServiceAndEndpointSliceWithRules(npw *nodePortWatcher, nft *knftables.Fake, svcName, namespace, serviceIP, endpointIP string, servicePort, nodePort int32, annotations map[string]string) *discovery.EndpointSlice {
 	// Create service
 	service := newService(svcName, namespace, serviceIP,
 		[]corev1.ServicePort{{
@@ -261,8 +270,8 @@ func setupServiceAndEndpointSliceWithRules(npw *nodePortWatcher, ipt util.IPTabl
 	err = npw.AddEndpointSlice(epSlice)
 	Expect(err).NotTo(HaveOccurred())
 
-	// Verify iptables rules were created
-	verifyIPTablesRule(ipt, serviceIP, servicePort, nodePort, true, "iptables rule should exist before deletion")
+	// Verify nftables elements were created
+	verifyNFTNodePortElement(nft, serviceIP, servicePort, nodePort, true, "nftables element should exist before deletion")
 
 	return epSlice
 }
@@ -272,7 +281,7 @@ var _ = Describe("DeleteEndpointSlice", func() {
 		fakeClient *util.OVNNodeClientset
 		watcher    *factory.WatchFactory
 		npw        *nodePortWatcher
-		iptV4      util.IPTablesHelper
+		nft        *knftables.Fake
 	)
 
 	const (
@@ -302,7 +311,10 @@ var _ = Describe("DeleteEndpointSlice", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		// Initialize nodePortWatcher with default network manager
-		iptV4, _ = util.SetFakeIPTablesHelpers()
+		util.SetFakeIPTablesHelpers()
+		nft = nodenft.SetFakeNFTablesHelper()
+		Expect(nft.ParseDump(nftablesRulesBase)).To(Succeed())
+		Expect(initGatewayServiceNFTRules()).To(Succeed())
 		npw = initFakeNodePortWatcher()
 		npw.watchFactory = watcher
 		npw.networkManager = networkmanager.Default().Interface()
@@ -318,9 +330,9 @@ var _ = Describe("DeleteEndpointSlice", func() {
 
 	Context("when UDN is deleted before processing endpoint slice", func() {
 		It("should execute delServiceRules and gracefully skip addServiceRules", func() {
-			// Setup service and endpoint slice with iptables rules
+			// Setup service and endpoint slice with nftables elements
 			// Add UDN annotation to simulate a mirrored UDN EndpointSlice
-			epSlice := setupServiceAndEndpointSliceWithRules(npw, iptV4, testService, testNamespace, "10.96.0.2", "10.244.0.2", 80, 30081,
+			epSlice := setupServiceAndEndpointSliceWithRules(npw, nft, testService, testNamespace, "10.96.0.2", "10.244.0.2", 80, 30081,
 				map[string]string{types.UserDefinedNetworkEndpointSliceAnnotation: "test-udn"})
 
 			// Replace network manager with one that returns InvalidPrimaryNetworkError
@@ -333,15 +345,15 @@ var _ = Describe("DeleteEndpointSlice", func() {
 			// Should gracefully handle UDN deletion (no error)
 			Expect(err).NotTo(HaveOccurred())
 
-			// iptables rules should be deleted even when UDN is deleted
-			verifyIPTablesRule(iptV4, "10.96.0.2", 80, 30081, false, "iptables rule should be deleted even when UDN is deleted")
+			// nftables elements should be deleted even when UDN is deleted
+			verifyNFTNodePortElement(nft, "10.96.0.2", 80, 30081, false, "nftables element should be deleted even when UDN is deleted")
 		})
 	})
 
 	Context("when network lookup returns other errors", func() {
 		It("should execute delServiceRules but return error from network lookup", func() {
-			// Setup service and endpoint slice with iptables rules
-			epSlice := setupServiceAndEndpointSliceWithRules(npw, iptV4, testService, testNamespace, "10.96.0.3", "10.244.0.3", 80, 30082, nil)
+			// Setup service and endpoint slice with nftables elements
+			epSlice := setupServiceAndEndpointSliceWithRules(npw, nft, testService, testNamespace, "10.96.0.3", "10.244.0.3", 80, 30082, nil)
 
 			// Replace network manager with one that returns a generic error
 			npw.networkManager = &mockNetworkManagerWithError{}
@@ -355,8 +367,8 @@ var _ = Describe("DeleteEndpointSlice", func() {
 			Expect(err.Error()).To(ContainSubstring(testNamespace))
 			Expect(err.Error()).To(ContainSubstring(testService))
 
-			// iptables rules should still be deleted even when error is returned
-			verifyIPTablesRule(iptV4, "10.96.0.3", 80, 30082, false, "iptables rule should be deleted even when error occurs")
+			// nftables elements should still be deleted even when error is returned
+			verifyNFTNodePortElement(nft, "10.96.0.3", 80, 30082, false, "nftables element should be deleted even when error occurs")
 		})
 	})
 
@@ -375,8 +387,8 @@ var _ = Describe("DeleteEndpointSlice", func() {
 
 	Context("when namespace is deleted before processing endpoint slice", func() {
 		It("should clean up old rules even when namespace is gone", func() {
-			// Setup service and endpoint slice with iptables rules
-			epSlice := setupServiceAndEndpointSliceWithRules(npw, iptV4, testService, testNamespace, "10.96.0.10", "10.244.0.5", 80, 30090, nil)
+			// Setup service and endpoint slice with nftables elements
+			epSlice := setupServiceAndEndpointSliceWithRules(npw, nft, testService, testNamespace, "10.96.0.10", "10.244.0.5", 80, 30090, nil)
 
 			// Simulate namespace not found error
 			npw.networkManager = &mockNetworkManagerWithNamespaceNotFoundError{}
@@ -384,8 +396,8 @@ var _ = Describe("DeleteEndpointSlice", func() {
 			// Verify no error (graceful handling)
 			Expect(err).NotTo(HaveOccurred())
 
-			// iptables rules should be deleted even though namespace lookup failed
-			verifyIPTablesRule(iptV4, "10.96.0.10", 80, 30090, false, "iptables rule should be deleted even when namespace lookup fails")
+			// nftables elements should be deleted even though namespace lookup failed
+			verifyNFTNodePortElement(nft, "10.96.0.10", 80, 30090, false, "nftables element should be deleted even when namespace lookup fails")
 		})
 	})
 })
@@ -395,7 +407,7 @@ var _ = Describe("SyncServices", func() {
 		fakeClient *util.OVNNodeClientset
 		watcher    *factory.WatchFactory
 		npw        *nodePortWatcher
-		iptV4      util.IPTablesHelper
+		nft        *knftables.Fake
 	)
 
 	const (
@@ -423,7 +435,10 @@ var _ = Describe("SyncServices", func() {
 		err = watcher.Start()
 		Expect(err).NotTo(HaveOccurred())
 
-		iptV4, _ = util.SetFakeIPTablesHelpers()
+		util.SetFakeIPTablesHelpers()
+		nft = nodenft.SetFakeNFTablesHelper()
+		Expect(nft.ParseDump(nftablesRulesBase)).To(Succeed())
+		Expect(initGatewayServiceNFTRules()).To(Succeed())
 		npw = initFakeNodePortWatcher()
 		npw.watchFactory = watcher
 		npw.networkManager = networkmanager.Default().Interface()
@@ -453,8 +468,8 @@ var _ = Describe("SyncServices", func() {
 			err := npw.SyncServices([]interface{}{service})
 			Expect(err).NotTo(HaveOccurred())
 
-			verifyIPTablesRule(iptV4, "10.96.0.20", 80, 30091, false,
-				"iptables rule should not be created when primary network is invalid")
+			verifyNFTNodePortElement(nft, "10.96.0.20", 80, 30091, false,
+				"nftables element should not be created when primary network is invalid")
 		})
 	})
 
@@ -475,8 +490,8 @@ var _ = Describe("SyncServices", func() {
 			err := npw.SyncServices([]interface{}{service})
 			Expect(err).NotTo(HaveOccurred())
 
-			verifyIPTablesRule(iptV4, "10.96.0.30", 80, 30092, false,
-				"iptables rule should not be created when UDN is inactive on this node")
+			verifyNFTNodePortElement(nft, "10.96.0.30", 80, 30092, false,
+				"nftables element should not be created when UDN is inactive on this node")
 		})
 	})
 
@@ -532,8 +547,8 @@ var _ = Describe("SyncServices", func() {
 			err = npw.SyncServices([]interface{}{service})
 			Expect(err).NotTo(HaveOccurred())
 
-			verifyIPTablesRule(iptV4, "10.96.0.40", 80, 30093, true,
-				"iptables rule should be created when UDN is active on this node")
+			verifyNFTNodePortElement(nft, "10.96.0.40", 80, 30093, true,
+				"nftables element should be created when UDN is active on this node")
 		})
 	})
 })
@@ -100,6 +100,10 @@ type UserDefinedNetworkGateway struct {
 	// gwInterfaceIndex holds the link index of gateway interface
 	gwInterfaceIndex int
 
+	// uplink holds the gateway data of the Uplink the network is attached
+	// to on this node, nil if the network uses the default external bridge
+	uplink *udnUplink
+
 	// save BGP state at the start of reconciliation loop run to handle it consistently throughout the run
 	isNetworkAdvertisedToDefaultVRF bool
 	isNetworkAdvertised             bool
@@ -252,6 +256,12 @@ func (udng *UserDefinedNetworkGateway) AddNetwork() error {
 		vrfTableId := util.CalculateRouteTableID(mplink.Attrs().Index)
 		udng.vrfTableId = vrfTableId
 
+		if util.UsesUplink(udng.NetInfo) {
+			if err = udng.addUplink(); err != nil {
+				return fmt.Errorf("failed to add uplink for network %s: %w", udng.GetNetworkName(), err)
+			}
+		}
+
 		vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
 		routes, err := udng.computeRoutesForUDN(mplink)
 		if err != nil {
@@ -288,6 +298,10 @@ func (udng *UserDefinedNetworkGateway) AddNetwork() error {
 		if err = udng.updateUDNVRFIPRoute(); err != nil {
 			return fmt.Errorf("failed to update ip routes for network %s: %w", udng.GetNetworkName(), err)
 		}
+
+		if err = udng.updateUplinkVRFAttachment(); err != nil {
+			return fmt.Errorf("failed to update uplink VRF attachment for network %s: %w", udng.GetNetworkName(), err)
+		}
 	}
 
 	if config.IsModeDPU() || config.IsModeFull() {
@@ -295,12 +309,25 @@ func (udng *UserDefinedNetworkGateway) AddNetwork() error {
 		for _, subnet := range nodeSubnets {
 			mgmtIPs = append(mgmtIPs, udng.GetNodeManagementIP(subnet))
 		}
-		if err = udng.openflowManager.addNetwork(udng.NetInfo, nodeSubnets, mgmtIPs, udng.masqCTMark, udng.pktMark, udng.v6MasqIPs, udng.v4MasqIPs); err != nil {
+		if udng.uplink != nil {
+			err = udng.openflowManager.addUplinkNetwork(udng.uplink.bridge, udng.newUplinkBridge, udng.NetInfo, nodeSubnets, mgmtIPs,
+				udng.masqCTMark, udng.pktMark, udng.v6MasqIPs, udng.v4MasqIPs)
+		} else {
+			err = udng.openflowManager.addNetwork(udng.NetInfo, nodeSubnets, mgmtIPs, udng.masqCTMark, udng.pktMark, udng.v6MasqIPs, udng.v4MasqIPs)
+		}
+		if err != nil {
 			return fmt.Errorf("could not add network %s: %v", udng.GetNetworkName(), err)
 		}
 
 		waiter := newStartupWaiterWithTimeout(waitForPatchPortTimeout)
 		readyFunc := func() (bool, error) {
+			if udng.uplink != nil {
+				if err := udng.openflowManager.getNetworkBridge(udng.GetNetworkName()).SetNetworkOfPatchPort(udng.GetNetworkName()); err != nil {
+					klog.V(3).Infof("Failed to set network %s's openflow ports for uplink bridge; error: %v", udng.GetNetworkName(), err)
+					return false, nil
+				}
+				return true, nil
+			}
 			if err := udng.openflowManager.defaultBridge.SetNetworkOfPatchPort(udng.GetNetworkName()); err != nil {
 				klog.V(3).Infof("Failed to set network %s's openflow ports for default bridge; error: %v", udng.GetNetworkName(), err)
 				return false, nil
@@ -351,6 +378,11 @@ func (udng *UserDefinedNetworkGateway) GetNetworkRuleMetadata() string {
 func (udng *UserDefinedNetworkGateway) DelNetwork() error {
 	var errs []error
 	vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
+	if util.UsesUplink(udng.NetInfo) && config.IsModeFull() {
+		if err := udng.delUplink(); err != nil {
+			errs = append(errs, fmt.Errorf("unable to delete uplink for network %s, err: %v", udng.GetNetworkName(), err))
+		}
+	}
 	if config.IsModeDPUHost() || config.IsModeFull() {
 		// delete the iprules for this network
 		if err := udng.ruleManager.DeleteWithMetadata(udng.GetNetworkRuleMetadata()); err != nil {
@@ -364,7 +396,11 @@ func (udng *UserDefinedNetworkGateway) DelNetwork() error {
 	if config.IsModeDPU() || config.IsModeFull() {
 		// delete the openflows for this network
 		if udng.openflowManager != nil {
-			udng.openflowManager.delNetwork(udng.NetInfo)
+			if util.UsesUplink(udng.NetInfo) {
+				udng.openflowManager.delUplinkNetwork(udng.NetInfo)
+			} else {
+				udng.openflowManager.delNetwork(udng.NetInfo)
+			}
 		}
 	}
 	if udng.openflowManager != nil || config.IsModeDPUHost() {
@@ -631,9 +667,15 @@ func (udng *UserDefinedNetworkGateway) getDefaultRoute() ([]netlink.Route, error
 		networkMTU = config.Default.MTU
 	}
 
+	nextHops := udng.gateway.nextHops
+	if udng.uplink != nil {
+		// the default route goes through the gateways of the Uplink
+		nextHops = udng.uplink.nextHops
+	}
+
 	var retVal []netlink.Route
 	var defaultAnyCIDR *net.IPNet
-	for _, nextHop := range udng.gateway.nextHops {
+	for _, nextHop := range nextHops {
 		isV6 := utilnet.IsIPv6(nextHop)
 		_, defaultAnyCIDR, _ = net.ParseCIDR("0.0.0.0/0")
 		if isV6 {
@@ -824,7 +866,7 @@ func (udng *UserDefinedNetworkGateway) doReconcile() error {
 
 	if config.IsModeDPU() || config.IsModeFull() {
 		// update bridge configuration
-		netConfig := udng.openflowManager.defaultBridge.GetNetworkConfig(udng.GetNetworkName())
+		netConfig := udng.openflowManager.getNetworkBridge(udng.GetNetworkName()).GetNetworkConfig(udng.GetNetworkName())
 		if netConfig == nil {
 			return fmt.Errorf("missing bridge configuration for network %s", udng.GetNetworkName())
 		}
@@ -839,6 +881,10 @@ func (udng *UserDefinedNetworkGateway) doReconcile() error {
 		if err := udng.updateUDNVRFIPRoute(); err != nil {
 			return fmt.Errorf("error while updating ip route for UDN %s: %s", udng.GetNetworkName(), err)
 		}
+
+		if err := udng.updateUplinkVRFAttachment(); err != nil {
+			return fmt.Errorf("error while updating uplink VRF attachment for UDN %s: %w", udng.GetNetworkName(), err)
+		}
 	}
 
 	if config.IsModeDPU() || config.IsModeFull() {
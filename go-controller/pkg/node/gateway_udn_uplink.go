@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/bridgeconfig"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// udnUplink holds the gateway data of the Uplink a network is attached to on
+// this node, as reported in its UplinkState.
+type udnUplink struct {
+	name          string
+	bridge        string
+	hostInterface string
+	ips           []*net.IPNet
+	macAddress    net.HardwareAddr
+	nextHops      []net.IP
+}
+
+// resolveUplink returns the gateway data of the Uplink of the network on this
+// node. It fails if the UplinkState of the node is missing or not ready.
+func (udng *UserDefinedNetworkGateway) resolveUplink() (*udnUplink, error) {
+	uplinkName := udng.Uplink()
+	if udng.watchFactory == nil {
+		return nil, fmt.Errorf("watch factory has not been provided to resolve uplink %s", uplinkName)
+	}
+	state, err := util.GetUplinkStateForNode(udng.watchFactory.UplinkStateInformer().Lister(), uplinkName, udng.node.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get state of uplink %s on node %s: %w", uplinkName, udng.node.Name, err)
+	}
+	if state == nil {
+		return nil, fmt.Errorf("uplink %s has no state on node %s", uplinkName, udng.node.Name)
+	}
+	if !util.IsUplinkStateReady(state) {
+		return nil, fmt.Errorf("uplink %s is not ready on node %s: %s", uplinkName, udng.node.Name,
+			util.GetUplinkStateReadyReason(state))
+	}
+	ips, nextHops, err := util.ParseUplinkStateGateway(state)
+	if err != nil {
+		return nil, err
+	}
+	macAddress, err := net.ParseMAC(state.Status.MACAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MAC address in uplink state %s: %w", state.Name, err)
+	}
+	return &udnUplink{
+		name:          uplinkName,
+		bridge:        state.Status.OVSBridge.Name,
+		hostInterface: state.Status.HostInterfaceName,
+		ips:           ips,
+		macAddress:    macAddress,
+		nextHops:      nextHops,
+	}, nil
+}
+
+// addUplink resolves the Uplink of the network and prepares the host side of
+// it: the network gateway traffic is routed through the Uplink host interface
+// and its localnet port is mapped to the Uplink bridge.
+func (udng *UserDefinedNetworkGateway) addUplink() error {
+	if !config.IsModeFull() {
+		return fmt.Errorf("uplink %s of network %s is only supported in full mode", udng.Uplink(), udng.GetNetworkName())
+	}
+	uplink, err := udng.resolveUplink()
+	if err != nil {
+		return err
+	}
+	link, err := util.GetNetLinkOps().LinkByName(uplink.hostInterface)
+	if err != nil {
+		return fmt.Errorf("unable to get link for uplink %s host interface %s, error: %v", uplink.name, uplink.hostInterface, err)
+	}
+	if err = addHostMACBindings(uplink.hostInterface); err != nil {
+		return fmt.Errorf("failed to add MAC bindings for uplink %s host interface %s: %w", uplink.name, uplink.hostInterface, err)
+	}
+	if udng.nodeIPManager == nil || udng.nodeIPManager.ovsClient == nil {
+		return fmt.Errorf("OVS client has not been provided to map network %s to uplink %s", udng.GetNetworkName(), uplink.name)
+	}
+	if err = bridgeconfig.SetBridgeMapping(udng.nodeIPManager.ovsClient, udng.GetNetworkName(), uplink.bridge); err != nil {
+		return fmt.Errorf("failed to map network %s to uplink %s bridge %s: %w", udng.GetNetworkName(), uplink.name, uplink.bridge, err)
+	}
+	udng.uplink = uplink
+	udng.gwInterfaceIndex = link.Attrs().Index
+	return nil
+}
+
+// delUplink removes the host side configuration of the Uplink of the network.
+func (udng *UserDefinedNetworkGateway) delUplink() error {
+	if err := udng.detachUplinkFromVRF(); err != nil {
+		return err
+	}
+	if udng.nodeIPManager == nil || udng.nodeIPManager.ovsClient == nil {
+		return nil
+	}
+	if err := bridgeconfig.RemoveBridgeMapping(udng.nodeIPManager.ovsClient, udng.GetNetworkName()); err != nil {
+		return fmt.Errorf("failed to unmap network %s from its uplink: %w", udng.GetNetworkName(), err)
+	}
+	return nil
+}
+
+// newUplinkBridge returns the configuration of the Uplink bridge, used when
+// the network is the first one attached to it.
+func (udng *UserDefinedNetworkGateway) newUplinkBridge() (*bridgeconfig.BridgeConfiguration, error) {
+	return bridgeconfig.NewUplinkBridgeConfiguration(udng.uplink.bridge, udng.uplink.hostInterface, udng.node.Name,
+		udng.uplink.ips, udng.uplink.macAddress)
+}
+
+// updateUplinkVRFAttachment attaches the Uplink host interface to the network
+// VRF when the network is advertised to its own VRF, that is VRF-Lite, and
+// leaves it in the default VRF otherwise.
+func (udng *UserDefinedNetworkGateway) updateUplinkVRFAttachment() error {
+	if udng.uplink == nil {
+		return nil
+	}
+	if udng.isNetworkAdvertised && !udng.isNetworkAdvertisedToDefaultVRF {
+		return udng.attachUplinkToVRF()
+	}
+	return udng.detachUplinkFromVRF()
+}
+
+func (udng *UserDefinedNetworkGateway) attachUplinkToVRF() error {
+	vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
+	link, err := util.GetNetLinkOps().LinkByName(udng.uplink.hostInterface)
+	if err != nil {
+		return fmt.Errorf("failed to get uplink %s host interface %s: %w", udng.uplink.name, udng.uplink.hostInterface, err)
+	}
+	vrfLink, err := util.GetNetLinkOps().LinkByName(vrfDeviceName)
+	if err != nil {
+		return fmt.Errorf("failed to get VRF device %s: %w", vrfDeviceName, err)
+	}
+	if link.Attrs().MasterIndex == vrfLink.Attrs().Index {
+		return nil
+	}
+	klog.Infof("Attaching uplink %s host interface %s to VRF %s", udng.uplink.name, udng.uplink.hostInterface, vrfDeviceName)
+	if err = util.GetNetLinkOps().LinkSetMaster(link, vrfLink); err != nil {
+		return fmt.Errorf("failed to attach uplink %s host interface %s to VRF %s: %w",
+			udng.uplink.name, udng.uplink.hostInterface, vrfDeviceName, err)
+	}
+	return nil
+}
+
+// detachUplinkFromVRF moves the Uplink host interface back to the default VRF
+// if it is attached to the network VRF. Other masters are left untouched.
+func (udng *UserDefinedNetworkGateway) detachUplinkFromVRF() error {
+	if udng.uplink == nil {
+		return nil
+	}
+	vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
+	link, err := util.GetNetLinkOps().LinkByName(udng.uplink.hostInterface)
+	if err != nil {
+		if util.GetNetLinkOps().IsLinkNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get uplink %s host interface %s: %w", udng.uplink.name, udng.uplink.hostInterface, err)
+	}
+	vrfLink, err := util.GetNetLinkOps().LinkByName(vrfDeviceName)
+	if err != nil {
+		if util.GetNetLinkOps().IsLinkNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get VRF device %s: %w", vrfDeviceName, err)
+	}
+	if link.Attrs().MasterIndex != vrfLink.Attrs().Index {
+		return nil
+	}
+	klog.Infof("Detaching uplink %s host interface %s from VRF %s", udng.uplink.name, udng.uplink.hostInterface, vrfDeviceName)
+	if err = util.GetNetLinkOps().LinkSetNoMaster(link); err != nil {
+		return fmt.Errorf("failed to detach uplink %s host interface %s from VRF %s: %w",
+			udng.uplink.name, udng.uplink.hostInterface, vrfDeviceName, err)
+	}
+	return nil
+}
@@ -6,13 +6,16 @@ package iprulemanager
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/vishvananda/netlink"
 
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 
+	nodeutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/util"
 	utilerrors "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/errors"
 )
 
@@ -29,6 +32,9 @@ type ipRule struct {
 	rule     *netlink.Rule
 	metadata string
 	delete   bool
+	// applied is set once the rule was found or added, so that it is
+	// considered drifted if it goes missing afterwards
+	applied bool
 }
 
 type Controller struct {
@@ -38,6 +44,7 @@ type Controller struct {
 	ownPriorities map[int]bool
 	v4            bool
 	v6            bool
+	drift         *nodeutil.DriftRecorder
 }
 
 // NewController creates a new linux IP rule manager
@@ -48,9 +55,16 @@ func NewController(v4, v6 bool) *Controller {
 		ownPriorities: make(map[int]bool, 0),
 		v4:            v4,
 		v6:            v6,
+		drift:         nodeutil.NewDriftRecorder("ip_rule"),
 	}
 }
 
+// SetEventRecorder sets the recorder used to emit events on the given node
+// about managed IP rules that keep being removed.
+func (rm *Controller) SetEventRecorder(recorder record.EventRecorder, nodeName string) {
+	rm.drift.SetEventRecorder(recorder, nodeName)
+}
+
 // Run starts manages linux IP rules
 func (rm *Controller) Run(stopCh <-chan struct{}, syncPeriod time.Duration) {
 	var err error
@@ -161,6 +175,7 @@ func (rm *Controller) reconcile() error {
 
 	rulesFound, err := netlink.RuleList(family)
 	if err != nil {
+		rm.drift.NetlinkError("list")
 		return err
 	}
 	var errors []error
@@ -170,19 +185,33 @@ func (rm *Controller) reconcile() error {
 		if r.delete {
 			if found, foundRoute := isNetlinkRuleInSlice(rulesFound, r.rule); found {
 				if err = netlink.RuleDel(foundRoute); err != nil {
+					rm.drift.NetlinkError("delete")
 					// retry later
 					rulesToKeep = append(rulesToKeep, r)
 					errors = append(errors, err)
+					continue
 				}
 			}
+			rm.drift.Forget(r.rule.String())
 		} else {
 			// add IP rule by first checking if it exists and if not, add it
-			rulesToKeep = append(rulesToKeep, r)
 			if found, _ := isNetlinkRuleInSlice(rulesFound, r.rule); !found {
+				table := strconv.Itoa(r.rule.Table)
+				if r.applied {
+					rm.drift.Detected(table)
+				}
 				if err = netlink.RuleAdd(r.rule); err != nil {
+					rm.drift.NetlinkError("add")
 					errors = append(errors, err)
+				} else if r.applied {
+					rm.drift.Repaired(table, r.rule.String())
+				} else {
+					r.applied = true
 				}
+			} else {
+				r.applied = true
 			}
+			rulesToKeep = append(rulesToKeep, r)
 		}
 	}
 
@@ -202,6 +231,7 @@ func (rm *Controller) reconcile() error {
 			if !found {
 				klog.Infof("Rule manager: deleting stale IP rule (%s) found at priority %d", ruleFound.String(), priority)
 				if err = netlink.RuleDel(&ruleFound); err != nil {
+					rm.drift.NetlinkError("delete")
 					errors = append(errors, fmt.Errorf("failed to delete stale IP rule (%s) found at priority %d: %v",
 						ruleFound.String(), priority, err))
 				}
@@ -210,7 +240,11 @@ func (rm *Controller) reconcile() error {
 	}
 
 	rm.rules = rulesToKeep
-	return utilerrors.Join(errors...)
+	if len(errors) > 0 {
+		return utilerrors.Join(errors...)
+	}
+	rm.drift.Synced()
+	return nil
 }
 
 func areNetlinkRulesEqual(r1, r2 *netlink.Rule) bool {
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	libovsdbcache "github.com/ovn-kubernetes/libovsdb/cache"
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/model"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/controller"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/vswitchd"
+)
+
+// hasLocalnetNativeVLAN returns whether the network is a localnet network in
+// trunk mode with a native VLAN
+func hasLocalnetNativeVLAN(netInfo util.NetInfo) bool {
+	return netInfo.TopologyType() == types.LocalnetTopology && netInfo.Vlan() != 0 && len(netInfo.VLANTrunks()) > 0
+}
+
+// localnetPatchPortName returns the name of the patch port ovn-controller
+// creates on the localnet bridge for the localnet port of the network
+func localnetPatchPortName(netInfo util.NetInfo) string {
+	return fmt.Sprintf("patch-%s-to-br-int", netInfo.GetNetworkScopedName(types.OVNLocalnetPort))
+}
+
+// localnetNativeVLANTrunks returns the VLANs the localnet patch port of a trunk
+// network carries: the native VLAN and the allowed VLANs
+func localnetNativeVLANTrunks(netInfo util.NetInfo) []int {
+	trunks := []int{int(netInfo.Vlan())}
+	for _, vid := range netInfo.VLANTrunks() {
+		trunks = append(trunks, int(vid))
+	}
+	return trunks
+}
+
+// syncLocalnetNativeVLAN configures the localnet patch port of a trunk network
+// in native-untagged mode: untagged traffic of the network leaves the node
+// tagged with the native VLAN and traffic of the native VLAN is delivered
+// untagged, while the traffic of the allowed VLANs stays tagged. It is a no-op
+// until ovn-controller creates the patch port.
+func syncLocalnetNativeVLAN(netInfo util.NetInfo) error {
+	native := strconv.FormatUint(uint64(netInfo.Vlan()), 10)
+	trunks := make([]string, 0, len(netInfo.VLANTrunks())+1)
+	for _, vid := range localnetNativeVLANTrunks(netInfo) {
+		trunks = append(trunks, strconv.Itoa(vid))
+	}
+	portName := localnetPatchPortName(netInfo)
+	_, stderr, err := util.RunOVSVsctl("--if-exists", "set", "Port", portName,
+		"vlan_mode=native-untagged", "tag="+native, "trunks="+strings.Join(trunks, ","))
+	if err != nil {
+		return fmt.Errorf("failed to set the native VLAN %s on port %s, stderr: %q: %w", native, portName, stderr, err)
+	}
+	return nil
+}
+
+// clearLocalnetNativeVLAN removes the native VLAN configuration from the
+// localnet patch port of a trunk network
+func clearLocalnetNativeVLAN(netInfo util.NetInfo) error {
+	portName := localnetPatchPortName(netInfo)
+	_, stderr, err := util.RunOVSVsctl("--if-exists", "clear", "Port", portName, "vlan_mode", "tag", "trunks")
+	if err != nil {
+		return fmt.Errorf("failed to clear the native VLAN on port %s, stderr: %q: %w", portName, stderr, err)
+	}
+	return nil
+}
+
+// hasLocalnetNativeVLANConfig returns whether the localnet patch port is
+// already configured with the native VLAN of the network
+func hasLocalnetNativeVLANConfig(port *vswitchd.Port, netInfo util.NetInfo) bool {
+	if port.VLANMode == nil || *port.VLANMode != vswitchd.PortVLANModeNativeUntagged {
+		return false
+	}
+	if port.Tag == nil || *port.Tag != int(netInfo.Vlan()) {
+		return false
+	}
+	trunks := slices.Clone(port.Trunks)
+	slices.Sort(trunks)
+	expected := localnetNativeVLANTrunks(netInfo)
+	slices.Sort(expected)
+	return slices.Equal(trunks, expected)
+}
+
+// LocalnetNativeVLANController configures the native VLAN of the localnet
+// networks in trunk mode. OVN can't express a native VLAN on a localnet port,
+// as the tag of the port would be pushed on the traffic of the allowed VLANs
+// too. The native VLAN is instead set on the patch port ovn-controller creates
+// on the localnet bridge, whenever ovn-controller creates or re-creates it.
+type LocalnetNativeVLANController struct {
+	ovsClient  libovsdbclient.Client
+	reconciler controller.Reconciler
+	// networks holds the networks with a native VLAN by localnet patch port name
+	networks sync.Map
+}
+
+// NewLocalnetNativeVLANController creates a controller watching the OVS ports
+// with the given OVS client.
+func NewLocalnetNativeVLANController(ovsClient libovsdbclient.Client) *LocalnetNativeVLANController {
+	c := &LocalnetNativeVLANController{
+		ovsClient: ovsClient,
+	}
+	c.reconciler = controller.NewReconciler("localnet-native-vlan", &controller.ReconcilerConfig{
+		RateLimiter: workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:   c.reconcile,
+		Threadiness: 1,
+		MaxAttempts: controller.InfiniteAttempts,
+	})
+	// the OVS cache doesn't allow removing event handlers, so a single handler
+	// is shared by all the networks
+	ovsClient.Cache().AddEventHandler(&libovsdbcache.EventHandlerFuncs{
+		AddFunc: func(_ string, obj model.Model) {
+			c.onPortEvent(obj)
+		},
+		UpdateFunc: func(_ string, _, obj model.Model) {
+			c.onPortEvent(obj)
+		},
+	})
+	return c
+}
+
+// Start starts the controller.
+func (c *LocalnetNativeVLANController) Start() error {
+	return controller.Start(c.reconciler)
+}
+
+// Stop stops the controller.
+func (c *LocalnetNativeVLANController) Stop() {
+	controller.Stop(c.reconciler)
+}
+
+// AddNetwork configures the native VLAN of the network on its localnet patch
+// port, now and every time ovn-controller creates or updates the port.
+func (c *LocalnetNativeVLANController) AddNetwork(netInfo util.NetInfo) {
+	portName := localnetPatchPortName(netInfo)
+	c.networks.Store(portName, netInfo)
+	c.reconciler.Reconcile(portName)
+}
+
+// RemoveNetwork stops configuring the native VLAN of the network. The
+// configuration of the patch port is left as is.
+func (c *LocalnetNativeVLANController) RemoveNetwork(netInfo util.NetInfo) {
+	c.networks.Delete(localnetPatchPortName(netInfo))
+}
+
+func (c *LocalnetNativeVLANController) onPortEvent(obj model.Model) {
+	port, ok := obj.(*vswitchd.Port)
+	if !ok {
+		return
+	}
+	value, ok := c.networks.Load(port.Name)
+	if !ok {
+		return
+	}
+	if hasLocalnetNativeVLANConfig(port, value.(util.NetInfo)) {
+		return
+	}
+	c.reconciler.Reconcile(port.Name)
+}
+
+func (c *LocalnetNativeVLANController) reconcile(portName string) error {
+	value, ok := c.networks.Load(portName)
+	if !ok {
+		return nil
+	}
+	netInfo := value.(util.NetInfo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), types.OVSDBTimeout)
+	defer cancel()
+	port := &vswitchd.Port{Name: portName}
+	if err := c.ovsClient.Get(ctx, port); err != nil {
+		if errors.Is(err, libovsdbclient.ErrNotFound) {
+			// synced once ovn-controller creates the port
+			return nil
+		}
+		return fmt.Errorf("failed to get port %s: %w", portName, err)
+	}
+	if hasLocalnetNativeVLANConfig(port, netInfo) {
+		return nil
+	}
+	klog.Infof("Setting the native VLAN %d of network %s on port %s", netInfo.Vlan(), netInfo.GetNetworkName(), portName)
+	return syncLocalnetNativeVLAN(netInfo)
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package node
+
+import (
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	ovntest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
+	libovsdbtest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/vswitchd"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Localnet native VLAN", func() {
+	var fexec *ovntest.FakeExec
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+		fexec = ovntest.NewFakeExec()
+		Expect(util.SetExec(fexec)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		util.ResetRunner()
+	})
+
+	newNetInfo := func(vlanConf string) util.NetInfo {
+		nad := ovntest.GenerateNADWithConfig("localnet1-nad", "ns1", `
+{
+        "cniVersion": "1.1.0",
+        "name": "localnet1",
+        "type": "ovn-k8s-cni-overlay",
+        "topology": "localnet",
+        "subnets": "192.168.100.0/24",
+        "physicalNetworkName": "physnet",
+        "netAttachDefName": "ns1/localnet1-nad"`+vlanConf+`
+}
+`)
+		netInfo, err := util.ParseNADInfo(nad)
+		Expect(err).NotTo(HaveOccurred())
+		return netInfo
+	}
+
+	It("is only configured for trunk networks with a native VLAN", func() {
+		Expect(hasLocalnetNativeVLAN(newNetInfo(``))).To(BeFalse())
+		Expect(hasLocalnetNativeVLAN(newNetInfo(`, "vlanID": 10`))).To(BeFalse())
+		Expect(hasLocalnetNativeVLAN(newNetInfo(`, "vlanTrunks": [100, 200]`))).To(BeFalse())
+		Expect(hasLocalnetNativeVLAN(newNetInfo(`, "vlanID": 10, "vlanTrunks": [100, 200]`))).To(BeTrue())
+	})
+
+	It("configures the localnet patch port in native-untagged mode", func() {
+		netInfo := newNetInfo(`, "vlanID": 10, "vlanTrunks": [100, 200]`)
+		portName := "patch-" + netInfo.GetNetworkScopedName("ovn_localnet_port") + "-to-br-int"
+		fexec.AddFakeCmdsNoOutputNoError([]string{
+			"ovs-vsctl --timeout=15 --if-exists set Port " + portName + " vlan_mode=native-untagged tag=10 trunks=10,100,200",
+			"ovs-vsctl --timeout=15 --if-exists clear Port " + portName + " vlan_mode tag trunks",
+		})
+		Expect(syncLocalnetNativeVLAN(netInfo)).To(Succeed())
+		Expect(clearLocalnetNativeVLAN(netInfo)).To(Succeed())
+		Expect(fexec.CalledMatchesExpected()).To(BeTrue(), fexec.ErrorDesc())
+	})
+
+	It("configures the localnet patch port once ovn-controller creates it", func() {
+		ovsClient, testCtx, err := libovsdbtest.NewOVSTestHarness(libovsdbtest.TestSetup{
+			OVSData: []libovsdbtest.TestData{
+				&vswitchd.OpenvSwitch{UUID: "root-ovs", Bridges: []string{"bridge-uuid"}},
+				&vswitchd.Bridge{UUID: "bridge-uuid", Name: "br-localnet"},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		defer testCtx.Cleanup()
+
+		netInfo := newNetInfo(`, "vlanID": 10, "vlanTrunks": [100, 200]`)
+		portName := localnetPatchPortName(netInfo)
+		fexec.AddFakeCmdsNoOutputNoError([]string{
+			"ovs-vsctl --timeout=15 --if-exists set Port " + portName + " vlan_mode=native-untagged tag=10 trunks=10,100,200",
+		})
+
+		c := NewLocalnetNativeVLANController(ovsClient)
+		Expect(c.Start()).To(Succeed())
+		defer c.Stop()
+		c.AddNetwork(netInfo)
+		Consistently(fexec.CalledMatchesExpected).Should(BeFalse())
+
+		By("creating the patch port")
+		Expect(libovsdbops.CreateOrUpdatePortWithInterface(ovsClient, "br-localnet", portName, nil, nil)).To(Succeed())
+		Eventually(fexec.CalledMatchesExpected).Should(BeTrue(), fexec.ErrorDesc)
+	})
+})
@@ -32,10 +32,20 @@ type openflowManager struct {
 	installedGroups map[string]struct{}
 	exGWFlowCache   map[string][]string
 	exGWFlowMutex   sync.Mutex
+	// uplinkBridges are the bridges of the Uplinks that user defined networks
+	// are attached to, indexed by bridge name, each with its own flow cache
+	uplinkBridges map[string]*uplinkBridgeFlows
+	uplinkMutex   sync.Mutex
 	// channel to indicate we need to update flows immediately
 	flowChan chan struct{}
 }
 
+// uplinkBridgeFlows is the configuration and the flow cache of an Uplink bridge
+type uplinkBridgeFlows struct {
+	bridge    *bridgeconfig.BridgeConfiguration
+	flowCache map[string][]string
+}
+
 var openFlowGroupIDRegexp = regexp.MustCompile(`(?:^|,)group_id=([^,]+)`)
 
 // UTILs Needed for UDN (also leveraged for default netInfo) in openflowmanager
@@ -68,9 +78,76 @@ func (c *openflowManager) delNetwork(nInfo util.NetInfo) {
 }
 
 func (c *openflowManager) getActiveNetwork(nInfo util.NetInfo) *bridgeconfig.BridgeUDNConfiguration {
+	if bridge := c.getUplinkBridge(nInfo.GetNetworkName()); bridge != nil {
+		return bridge.GetActiveNetworkBridgeConfigCopy(nInfo.GetNetworkName())
+	}
 	return c.defaultBridge.GetActiveNetworkBridgeConfigCopy(nInfo.GetNetworkName())
 }
 
+// addUplinkNetwork adds the network to the given Uplink bridge instead of the
+// default bridge. newBridge builds the bridge configuration when this is the
+// first network attached to the bridge.
+func (c *openflowManager) addUplinkNetwork(bridgeName string, newBridge func() (*bridgeconfig.BridgeConfiguration, error),
+	nInfo util.NetInfo, nodeSubnets, mgmtIPs []*net.IPNet, masqCTMark, pktMark uint, v6MasqIPs, v4MasqIPs *udn.MasqueradeIPs) error {
+	c.uplinkMutex.Lock()
+	defer c.uplinkMutex.Unlock()
+	uplinkBridge, found := c.uplinkBridges[bridgeName]
+	if !found {
+		bridge, err := newBridge()
+		if err != nil {
+			return fmt.Errorf("failed to configure uplink bridge %s: %w", bridgeName, err)
+		}
+		uplinkBridge = &uplinkBridgeFlows{bridge: bridge, flowCache: map[string][]string{}}
+		if c.uplinkBridges == nil {
+			c.uplinkBridges = map[string]*uplinkBridgeFlows{}
+		}
+		c.uplinkBridges[bridgeName] = uplinkBridge
+	}
+	return uplinkBridge.bridge.AddNetworkConfig(nInfo, nodeSubnets, mgmtIPs, masqCTMark, pktMark, v6MasqIPs, v4MasqIPs)
+}
+
+// delUplinkNetwork removes the network from the Uplink bridge it is attached
+// to. Once no network is left on the bridge, the flows of ovnkube-node are
+// removed from it and only the NORMAL action is left.
+func (c *openflowManager) delUplinkNetwork(nInfo util.NetInfo) {
+	c.uplinkMutex.Lock()
+	defer c.uplinkMutex.Unlock()
+	for bridgeName, uplinkBridge := range c.uplinkBridges {
+		if uplinkBridge.bridge.GetNetworkConfig(nInfo.GetNetworkName()) == nil {
+			continue
+		}
+		uplinkBridge.bridge.DelNetworkConfig(nInfo)
+		if uplinkBridge.bridge.HasNetworks() {
+			continue
+		}
+		delete(c.uplinkBridges, bridgeName)
+		if _, stderr, err := util.ReplaceOFFlows(bridgeName, []string{fmt.Sprintf("table=0,priority=0,actions=%s\n", util.NormalAction)}); err != nil {
+			klog.Errorf("Failed to reset flows for uplink bridge %s, error: %v, stderr, %s", bridgeName, err, stderr)
+		}
+	}
+}
+
+// getUplinkBridge returns the Uplink bridge the network is attached to, or nil
+// if the network uses the default bridge.
+func (c *openflowManager) getUplinkBridge(networkName string) *bridgeconfig.BridgeConfiguration {
+	c.uplinkMutex.Lock()
+	defer c.uplinkMutex.Unlock()
+	for _, uplinkBridge := range c.uplinkBridges {
+		if uplinkBridge.bridge.GetNetworkConfig(networkName) != nil {
+			return uplinkBridge.bridge
+		}
+	}
+	return nil
+}
+
+// getNetworkBridge returns the bridge the network is attached to.
+func (c *openflowManager) getNetworkBridge(networkName string) *bridgeconfig.BridgeConfiguration {
+	if bridge := c.getUplinkBridge(networkName); bridge != nil {
+		return bridge
+	}
+	return c.defaultBridge
+}
+
 // END UDN UTILs
 
 func (c *openflowManager) getDefaultBridgeName() string {
@@ -182,6 +259,17 @@ func (c *openflowManager) syncFlows() {
 				c.externalGatewayBridge.GetBridgeName(), err, stderr, len(exGWFlows))
 		}
 	}
+
+	c.uplinkMutex.Lock()
+	defer c.uplinkMutex.Unlock()
+	for bridgeName, uplinkBridge := range c.uplinkBridges {
+		uplinkFlows := flattenFlowCacheEntries(uplinkBridge.flowCache)
+		_, stderr, err := util.ReplaceOFFlows(bridgeName, uplinkFlows)
+		if err != nil {
+			klog.Errorf("Failed to add flows for uplink bridge %s, error: %v, stderr, %s, flow count: %d",
+				bridgeName, err, stderr, len(uplinkFlows))
+		}
+	}
 }
 
 func (c *openflowManager) syncGroups() bool {
@@ -324,6 +412,10 @@ func (c *openflowManager) Run(stopChan <-chan struct{}, doneWg *sync.WaitGroup)
 						continue
 					}
 				}
+				if err := c.checkUplinkBridgePorts(); err != nil {
+					klog.Errorf("Checkports failed %v", err)
+					continue
+				}
 				c.syncFlows()
 			case <-c.flowChan:
 				c.syncFlows()
@@ -345,6 +437,11 @@ func (c *openflowManager) updateBridgePMTUDFlowCache(key string, ipAddrs []strin
 		exGWBridgeDftFlows := c.externalGatewayBridge.PMTUDDropFlows(ipAddrs)
 		c.updateExBridgeFlowCacheEntry(key, exGWBridgeDftFlows)
 	}
+	c.uplinkMutex.Lock()
+	defer c.uplinkMutex.Unlock()
+	for _, uplinkBridge := range c.uplinkBridges {
+		uplinkBridge.flowCache[key] = uplinkBridge.bridge.PMTUDDropFlows(ipAddrs)
+	}
 }
 
 // updateBridgeFlowCache generates the "static" per-bridge flows
@@ -371,6 +468,30 @@ func (c *openflowManager) updateBridgeFlowCache(hostIPs []net.IP, hostSubnets []
 		c.updateExBridgeFlowCacheEntry("NORMAL", []string{fmt.Sprintf("table=0,priority=0,actions=%s\n", util.NormalAction)})
 		c.updateExBridgeFlowCacheEntry("DEFAULT", exGWBridgeDftFlows)
 	}
+
+	// the node IPs are not configured on Uplink bridges, they only get the
+	// flows of the networks attached to them
+	c.uplinkMutex.Lock()
+	defer c.uplinkMutex.Unlock()
+	for _, uplinkBridge := range c.uplinkBridges {
+		uplinkDftFlows, err := uplinkBridge.bridge.DefaultBridgeFlows(hostSubnets, nil)
+		if err != nil {
+			return err
+		}
+		uplinkBridge.flowCache["NORMAL"] = []string{fmt.Sprintf("table=0,priority=0,actions=%s\n", util.NormalAction)}
+		uplinkBridge.flowCache["DEFAULT"] = uplinkDftFlows
+	}
+	return nil
+}
+
+func (c *openflowManager) checkUplinkBridgePorts() error {
+	c.uplinkMutex.Lock()
+	defer c.uplinkMutex.Unlock()
+	for _, uplinkBridge := range c.uplinkBridges {
+		if err := checkPorts(uplinkBridge.bridge.GetPortConfigurations()); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -14,8 +15,10 @@ import (
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 
+	nodeutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/util"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
 )
 
@@ -29,6 +32,7 @@ type key struct {
 type Controller struct {
 	*sync.Mutex
 	store map[key]*netlink.Route
+	drift *nodeutil.DriftRecorder
 }
 
 // NewController manages routes which include adding and deletion of routes. It
@@ -40,9 +44,16 @@ func NewController() *Controller {
 	return &Controller{
 		Mutex: &sync.Mutex{},
 		store: make(map[key]*netlink.Route),
+		drift: nodeutil.NewDriftRecorder("route"),
 	}
 }
 
+// SetEventRecorder sets the recorder used to emit events on the given node
+// about managed routes that keep being removed or altered.
+func (c *Controller) SetEventRecorder(recorder record.EventRecorder, nodeName string) {
+	c.drift.SetEventRecorder(recorder, nodeName)
+}
+
 // Run starts route manager and syncs at least every syncPeriod
 func (c *Controller) Run(stopCh <-chan struct{}, syncPeriod time.Duration) {
 	var err error
@@ -138,6 +149,7 @@ func (c *Controller) delRoute(r *netlink.Route) error {
 		}
 	}
 	c.removeRouteFromStore(r)
+	c.drift.Forget(r.String())
 	return nil
 }
 
@@ -151,7 +163,12 @@ func (c *Controller) processNetlinkEvent(ru netlink.RouteUpdate) error {
 		return nil
 	}
 	if ru.Type == unix.RTM_DELROUTE || !routePartiallyEqualWantedToExisting(r, &ru.Route) {
-		return c.netlinkAddRoute(r)
+		table := strconv.Itoa(r.Table)
+		c.drift.Detected(table)
+		if err := c.netlinkAddRoute(r); err != nil {
+			return err
+		}
+		c.drift.Repaired(table, r.String())
 	}
 	return nil
 }
@@ -159,6 +176,7 @@ func (c *Controller) processNetlinkEvent(ru netlink.RouteUpdate) error {
 func (c *Controller) netlinkAddRoute(r *netlink.Route) error {
 	err := util.GetNetLinkOps().RouteReplace(r)
 	if err != nil {
+		c.drift.NetlinkError("add")
 		return fmt.Errorf("failed to add route %s: %w", r, err)
 	}
 	klog.V(5).Infof("Route Manager: added route %s", r)
@@ -168,6 +186,7 @@ func (c *Controller) netlinkAddRoute(r *netlink.Route) error {
 func (c *Controller) netlinkDelRoute(r *netlink.Route) error {
 	err := util.GetNetLinkOps().RouteDel(r)
 	if err != nil && !isRouteNotFoundError(err) {
+		c.drift.NetlinkError("delete")
 		return fmt.Errorf("failed to delete route %s: %w", r, err)
 	}
 	klog.V(5).Infof("Route Manager: deleted route %s", r)
@@ -219,7 +238,7 @@ func (c *Controller) sync() {
 	c.Lock()
 	defer c.Unlock()
 
-	var read, added, deleted int
+	var read, added, deleted, failed int
 	start := time.Now()
 	defer func() {
 		klog.V(5).Infof("Route Manager: synced routes: stored[%d] read[%d] added[%d] deleted[%d], took %s",
@@ -241,6 +260,7 @@ func (c *Controller) sync() {
 	mask := netlink.RT_FILTER_TABLE
 	existing, err := util.GetNetLinkOps().RouteListFiltered(netlink.FAMILY_ALL, filter, mask)
 	if err != nil {
+		c.drift.NetlinkError("list")
 		klog.Errorf("Route Manager: failed to list routes: %v", err)
 		return
 	}
@@ -261,6 +281,8 @@ func (c *Controller) sync() {
 		if len(existing) == 1 && routePartiallyEqualWantedToExisting(wants, existing[0]) {
 			continue
 		}
+		table := strconv.Itoa(wants.Table)
+		c.drift.Detected(table)
 		// take the safe approach to delete routes before adding ours to make
 		// sure we don't end up deleting what we shouldn't
 		// deleting now may cause network blips until we add our route but
@@ -269,6 +291,7 @@ func (c *Controller) sync() {
 			err := c.netlinkDelRoute(r)
 			if err != nil {
 				klog.Errorf("Route Manager: failed while syncing: %v", err)
+				failed++
 				continue
 			}
 			klog.Warningf("Route Manager: removed unexpected route %s", r)
@@ -277,10 +300,15 @@ func (c *Controller) sync() {
 		err := c.netlinkAddRoute(wants)
 		if err != nil {
 			klog.Errorf("Route Manager: failed while syncing: %v", err)
+			failed++
 			continue
 		}
+		c.drift.Repaired(table, wants.String())
 		added++
 	}
+	if failed == 0 {
+		c.drift.Synced()
+	}
 }
 
 func subscribeNetlinkRouteEvents(stopCh <-chan struct{}) (bool, chan netlink.RouteUpdate) {
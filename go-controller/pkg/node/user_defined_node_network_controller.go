@@ -30,6 +30,8 @@ type UserDefinedNodeNetworkController struct {
 	gateway *UserDefinedNetworkGateway
 	// management port device manager
 	mpdm *managementport.MgmtPortDeviceManager
+	// configures the native VLAN of localnet networks in trunk mode
+	localnetNativeVLANController *LocalnetNativeVLANController
 }
 
 // NewUserDefinedNodeNetworkController creates a new OVN controller for creating logical network
@@ -43,6 +45,7 @@ func NewUserDefinedNodeNetworkController(
 	ruleManager *iprulemanager.Controller,
 	mpdm *managementport.MgmtPortDeviceManager,
 	defaultNetworkGateway Gateway,
+	localnetNativeVLANController *LocalnetNativeVLANController,
 ) (*UserDefinedNodeNetworkController, error) {
 
 	snnc := &UserDefinedNodeNetworkController{
@@ -53,7 +56,8 @@ func NewUserDefinedNodeNetworkController(
 			wg:                              &sync.WaitGroup{},
 			networkManager:                  networkManager,
 		},
-		mpdm: mpdm,
+		mpdm:                         mpdm,
+		localnetNativeVLANController: localnetNativeVLANController,
 	}
 	if util.IsNetworkSegmentationSupportEnabled() && snnc.IsPrimaryNetwork() {
 		node, err := snnc.watchFactory.GetNode(snnc.name)
@@ -89,6 +93,9 @@ func (nc *UserDefinedNodeNetworkController) Start(_ context.Context) error {
 				nc.GetNetworkName(), nc.name, err)
 		}
 	}
+	if nc.localnetNativeVLANController != nil && hasLocalnetNativeVLAN(nc.GetNetInfo()) {
+		nc.localnetNativeVLANController.AddNetwork(nc.GetNetInfo())
+	}
 	return nil
 }
 
@@ -106,6 +113,9 @@ func (nc *UserDefinedNodeNetworkController) Stop() {
 	if nc.podHandler != nil {
 		nc.watchFactory.RemovePodHandler(nc.podHandler)
 	}
+	if nc.localnetNativeVLANController != nil && hasLocalnetNativeVLAN(nc.GetNetInfo()) {
+		nc.localnetNativeVLANController.RemoveNetwork(nc.GetNetInfo())
+	}
 }
 
 // Cleanup cleans up node entities for the given user-defined network
@@ -118,6 +128,11 @@ func (nc *UserDefinedNodeNetworkController) Cleanup() error {
 			errors = append(errors, fmt.Errorf("deleting network gateway for network %s failed: %v", nc.GetNetworkName(), err))
 		}
 	}
+	if hasLocalnetNativeVLAN(nc.GetNetInfo()) {
+		if err = clearLocalnetNativeVLAN(nc.GetNetInfo()); err != nil {
+			errors = append(errors, err)
+		}
+	}
 	if nc.mpdm != nil && util.IsNetworkSegmentationSupportEnabled() && nc.IsPrimaryNetwork() {
 		if err = nc.mpdm.ReleaseDeviceIDForNetwork(nc.GetNetworkName()); err != nil {
 			errors = append(errors, fmt.Errorf("deleting device ID for network %s failed: %v", nc.GetNetworkName(), err))
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/metrics"
+)
+
+const (
+	// driftEventThreshold is the number of times an object must be restored
+	// within driftEventInterval to emit an event
+	driftEventThreshold = 3
+	// driftEventInterval is the minimum interval between two events about
+	// the same object
+	driftEventInterval = 15 * time.Minute
+)
+
+type driftState struct {
+	// repairs is the number of times the object was restored since
+	// firstRepair
+	repairs     int
+	firstRepair time.Time
+	lastEvent   time.Time
+}
+
+// DriftRecorder records how often the kernel state managed by a node manager
+// (routes, VRFs or IP rules) is removed or altered by something else and
+// restored by the manager. Besides metrics, it emits a throttled node event
+// naming the objects that keep being restored, which usually means that a
+// third party agent fights with ovnkube-node over them.
+type DriftRecorder struct {
+	manager string
+
+	mu       sync.Mutex
+	recorder record.EventRecorder
+	nodeRef  *corev1.ObjectReference
+	objects  map[string]*driftState
+	now      func() time.Time
+}
+
+// NewDriftRecorder returns a DriftRecorder for the given manager, e.g.
+// "route". It only records metrics until SetEventRecorder is called.
+func NewDriftRecorder(manager string) *DriftRecorder {
+	return &DriftRecorder{
+		manager: manager,
+		objects: map[string]*driftState{},
+		now:     time.Now,
+	}
+}
+
+// SetEventRecorder sets the recorder used to emit events on the given node.
+func (d *DriftRecorder) SetEventRecorder(recorder record.EventRecorder, nodeName string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.recorder = recorder
+	d.nodeRef = &corev1.ObjectReference{
+		Kind: "Node",
+		Name: nodeName,
+	}
+}
+
+// Detected records that a managed object of the given routing table or VRF
+// was found removed or altered.
+func (d *DriftRecorder) Detected(table string) {
+	metrics.RecordNetlinkDriftDetected(d.manager, table)
+}
+
+// Repaired records that the given managed object of the given routing table
+// or VRF was restored, and emits an event if it keeps being restored.
+func (d *DriftRecorder) Repaired(table, object string) {
+	metrics.RecordNetlinkDriftRepaired(d.manager, table)
+	klog.Warningf("Restored %s managed by the %s manager: it was removed or altered by something else", object, d.manager)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.now()
+	state := d.objects[object]
+	if state == nil || now.Sub(state.firstRepair) > driftEventInterval {
+		lastEvent := time.Time{}
+		if state != nil {
+			lastEvent = state.lastEvent
+		}
+		state = &driftState{firstRepair: now, lastEvent: lastEvent}
+		d.objects[object] = state
+	}
+	state.repairs++
+	if d.recorder == nil || state.repairs < driftEventThreshold || now.Sub(state.lastEvent) < driftEventInterval {
+		return
+	}
+	d.recorder.Eventf(d.nodeRef, corev1.EventTypeWarning, "NetlinkStateDrift",
+		"The %s manager restored %s %d times since %s: it keeps being removed or altered by something else on the node",
+		d.manager, object, state.repairs, state.firstRepair.Format(time.RFC3339))
+	state.lastEvent = now
+	state.repairs = 0
+	state.firstRepair = now
+}
+
+// Forget stops tracking the restorations of an object that is no longer
+// managed.
+func (d *DriftRecorder) Forget(object string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.objects, object)
+}
+
+// NetlinkError records a failed netlink operation, e.g. "add".
+func (d *DriftRecorder) NetlinkError(operation string) {
+	metrics.RecordNetlinkError(d.manager, operation)
+}
+
+// Synced records a successful sync of the manager.
+func (d *DriftRecorder) Synced() {
+	metrics.RecordNetlinkSync(d.manager)
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"time"
+
+	"k8s.io/client-go/tools/record"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DriftRecorder", func() {
+	const object = "route 10.0.0.0/24 table 1000"
+
+	var (
+		recorder *record.FakeRecorder
+		drift    *DriftRecorder
+		now      time.Time
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		drift = NewDriftRecorder("route")
+		drift.SetEventRecorder(recorder, "node1")
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		drift.now = func() time.Time { return now }
+	})
+
+	It("emits an event when an object keeps being restored", func() {
+		drift.Repaired("1000", object)
+		drift.Repaired("1000", object)
+		Expect(recorder.Events).To(BeEmpty())
+		drift.Repaired("1000", object)
+		Expect(recorder.Events).To(Receive(ContainSubstring(object)))
+	})
+
+	It("throttles events about the same object", func() {
+		for range 6 {
+			drift.Repaired("1000", object)
+		}
+		Expect(recorder.Events).To(HaveLen(1))
+
+		now = now.Add(driftEventInterval + time.Second)
+		for range driftEventThreshold {
+			drift.Repaired("1000", object)
+		}
+		Expect(recorder.Events).To(HaveLen(2))
+	})
+
+	It("does not emit an event for objects restored now and then", func() {
+		for range 4 {
+			drift.Repaired("1000", object)
+			now = now.Add(driftEventInterval)
+		}
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("forgets objects no longer managed", func() {
+		drift.Repaired("1000", object)
+		drift.Repaired("1000", object)
+		drift.Forget(object)
+		drift.Repaired("1000", object)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
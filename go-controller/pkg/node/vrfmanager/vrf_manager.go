@@ -5,6 +5,7 @@ package vrfmanager
 
 import (
 	"fmt"
+	"net"
 	"slices"
 	"sync"
 	"time"
@@ -13,9 +14,11 @@ import (
 	"github.com/vishvananda/netlink"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/routemanager"
+	nodeutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/node/util"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
 	utilerrors "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/errors"
 )
@@ -38,6 +41,7 @@ type Controller struct {
 	mu           *sync.Mutex
 	vrfs         map[int]vrf
 	routeManager *routemanager.Controller
+	drift        *nodeutil.DriftRecorder
 }
 
 func NewController(routeManager *routemanager.Controller) *Controller {
@@ -45,9 +49,16 @@ func NewController(routeManager *routemanager.Controller) *Controller {
 		mu:           &sync.Mutex{},
 		vrfs:         make(map[int]vrf),
 		routeManager: routeManager,
+		drift:        nodeutil.NewDriftRecorder("vrf"),
 	}
 }
 
+// SetEventRecorder sets the recorder used to emit events on the given node
+// about managed VRF devices that keep being removed or altered.
+func (vrfm *Controller) SetEventRecorder(recorder record.EventRecorder, nodeName string) {
+	vrfm.drift.SetEventRecorder(recorder, nodeName)
+}
+
 // Run starts the VRF Manager to manage its devices
 func (vrfm *Controller) Run(stopCh <-chan struct{}, doneWg *sync.WaitGroup) error {
 	linkSubscribeOptions := netlink.LinkSubscribeOptions{
@@ -168,6 +179,7 @@ func (vrfm *Controller) reconcile() error {
 		return utilerrors.Join(errorAggregate...)
 	}
 
+	vrfm.drift.Synced()
 	return nil
 }
 
@@ -184,8 +196,10 @@ func (vrfm *Controller) syncVRF(link netlink.Link) error {
 // sync ensures that the netlink VRF device exists, and the managedSlave is enslaved to it.
 // It does not handle removal of the VRF or managedSlave, other than if it detects a conflict while adding.
 func (vrfm *Controller) sync(vrf vrf) error {
+	// drift is only accounted for VRFs that were already set up by us
+	managed := vrfm.isManaged(vrf.name)
 	vrfLink, err := util.GetNetLinkOps().LinkByName(vrf.name)
-	var mustRecreate bool
+	var mustRecreate, created bool
 	if err == nil {
 		vrfDev, ok := vrfLink.(*netlink.Vrf)
 		if !ok {
@@ -212,9 +226,17 @@ func (vrfm *Controller) sync(vrf vrf) error {
 			LinkAttrs: netlink.LinkAttrs{Name: vrf.name},
 			Table:     vrf.table,
 		}
+		if managed && !mustRecreate {
+			vrfm.drift.Detected(vrf.name)
+		}
 		if err = util.GetNetLinkOps().LinkAdd(vrfLink); err != nil {
+			vrfm.drift.NetlinkError("add")
 			return fmt.Errorf("failed to create VRF device %s, err: %v", vrf.name, err)
 		}
+		if managed && !mustRecreate {
+			vrfm.drift.Repaired(vrf.name, "VRF device "+vrf.name)
+		}
+		created = true
 	} else if err != nil {
 		return fmt.Errorf("failed to retrieve existing VRF device %s, err: %v", vrf.name, err)
 	}
@@ -223,9 +245,19 @@ func (vrfm *Controller) sync(vrf vrf) error {
 		return fmt.Errorf("failed to retrieve VRF device %s, err: %v", vrf.name, err)
 	}
 	if vrfLink.Attrs().OperState != netlink.OperUp {
+		// a VRF device created above is expected to be down, otherwise it
+		// drifted if it was set administratively down
+		drifted := managed && !created && vrfLink.Attrs().Flags&net.FlagUp == 0
+		if drifted {
+			vrfm.drift.Detected(vrf.name)
+		}
 		if err = util.GetNetLinkOps().LinkSetUp(vrfLink); err != nil {
+			vrfm.drift.NetlinkError("set_up")
 			return fmt.Errorf("failed to get VRF device %s operationally up, err: %v", vrf.name, err)
 		}
+		if drifted {
+			vrfm.drift.Repaired(vrf.name, "VRF device "+vrf.name+" state")
+		}
 	}
 	if len(vrf.managedSlave) > 0 {
 		alreadyEnslaved, err := isInterfaceSlaveOfVRF(vrf.managedSlave, vrfLink.Attrs().Index)
@@ -233,9 +265,17 @@ func (vrfm *Controller) sync(vrf vrf) error {
 			return fmt.Errorf("failed to check if %s is slave of VRF device %s, err: %v", vrf.managedSlave, vrfLink.Attrs().Name, err)
 		}
 		if !alreadyEnslaved {
+			drifted := managed && !created
+			if drifted {
+				vrfm.drift.Detected(vrf.name)
+			}
 			if err = enslaveInterfaceToVRF(vrf.name, vrf.managedSlave); err != nil {
+				vrfm.drift.NetlinkError("set_master")
 				return fmt.Errorf("failed to enslave interface %s into VRF device: %s, err: %v", vrf.managedSlave, vrf.name, err)
 			}
+			if drifted {
+				vrfm.drift.Repaired(vrf.name, "interface "+vrf.managedSlave+" of VRF device "+vrf.name)
+			}
 		}
 	}
 	// Handover vrf routes into route manager to manage it.
@@ -369,6 +409,7 @@ func (vrfm *Controller) Repair(validVRFs sets.Set[string]) error {
 func (vrfm *Controller) repair(validVRFs sets.Set[string]) error {
 	links, err := util.GetNetLinkOps().LinkList()
 	if err != nil {
+		vrfm.drift.NetlinkError("list")
 		return fmt.Errorf("failed to list links on the node, err: %v", err)
 	}
 
@@ -389,6 +430,7 @@ func (vrfm *Controller) repair(validVRFs sets.Set[string]) error {
 		}
 		err = util.GetNetLinkOps().LinkDelete(link)
 		if err != nil {
+			vrfm.drift.NetlinkError("delete")
 			klog.Errorf("VRF Manager: error deleting stale VRF device %s, err: %v", name, err)
 		}
 		delete(vrfm.vrfs, vrf.Index)
@@ -429,11 +471,31 @@ func (vrfm *Controller) DeleteVRF(name string) (err error) {
 	if err != nil {
 		return fmt.Errorf("failed to delete VRF device %s, err: %w", vrf.name, err)
 	}
+	vrfm.drift.Forget("VRF device " + vrf.name)
+	vrfm.drift.Forget("VRF device " + vrf.name + " state")
+	if len(vrf.managedSlave) > 0 {
+		vrfm.drift.Forget("interface " + vrf.managedSlave + " of VRF device " + vrf.name)
+	}
 	return nil
 }
 
 func (vrfm *Controller) deleteVRF(link netlink.Link) error {
-	return util.GetNetLinkOps().LinkDelete(link)
+	if err := util.GetNetLinkOps().LinkDelete(link); err != nil {
+		vrfm.drift.NetlinkError("delete")
+		return err
+	}
+	return nil
+}
+
+// isManaged returns whether a VRF with the given name was already set up.
+// Must be called with the controller locked
+func (vrfm *Controller) isManaged(name string) bool {
+	for _, vrf := range vrfm.vrfs {
+		if vrf.name == name {
+			return true
+		}
+	}
+	return false
 }
 
 // isInterfaceSlaveOfVRF checks if a specific interface is enslaved to a VRF
@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	ref "k8s.io/client-go/tools/reference"
 	"k8s.io/klog/v2"
@@ -111,6 +112,8 @@ type BaseNetworkController struct {
 	podHandler *factory.Handler
 	// namespace events factory Handler
 	namespaceHandler *factory.Handler
+	// uplink state events handler, only set for networks attached to an Uplink
+	uplinkStateHandler cache.ResourceEventHandlerRegistration
 
 	// A cache of all logical switches seen by the watcher and their subnets
 	lsManager *lsm.LogicalSwitchManager
@@ -521,6 +524,7 @@ func (bnc *BaseNetworkController) syncNodeClusterRouterPort(node *corev1.Node, h
 		if bnc.MTU() > 0 {
 			logicalRouterPort.Ipv6RaConfigs["mtu"] = fmt.Sprintf("%d", bnc.MTU())
 		}
+		kubevirt.AddIPv6RAConfigs(logicalRouterPort.Ipv6RaConfigs, bnc.DHCPOptions())
 	}
 
 	err = libovsdbops.CreateOrUpdateLogicalRouterPort(bnc.nbClient, &logicalRouter, &logicalRouterPort,
@@ -1244,5 +1248,8 @@ func (bnc *BaseNetworkController) ensureDHCP(pod *corev1.Pod, podAnnotation *uti
 
 	opts = append(opts, kubevirt.WithIPv4DNSServer(ipv4DNSServer), kubevirt.WithIPv6DNSServer(ipv6DNSServer))
 
+	// applied last as they override the router and DNS server options
+	opts = append(opts, kubevirt.WithDHCPOptions(bnc.DHCPOptions()))
+
 	return kubevirt.EnsureDHCPOptionsForLSP(bnc.controllerName, bnc.nbClient, pod, podAnnotation.IPs, lsp, opts...)
 }
@@ -6,6 +6,8 @@ package ovn
 import (
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
@@ -54,6 +56,7 @@ func (oc *BaseLayer2UserDefinedNetworkController) stop() {
 	if oc.namespaceHandler != nil {
 		oc.watchFactory.RemoveNamespaceHandler(oc.namespaceHandler)
 	}
+	oc.removeUplinkStateHandler()
 	if oc.routeImportManager != nil && config.Gateway.Mode == config.GatewayModeShared {
 		oc.routeImportManager.ForgetNetwork(oc.GetNetworkName())
 	}
@@ -173,7 +176,12 @@ func (oc *BaseLayer2UserDefinedNetworkController) initializeLogicalSwitch(switch
 	for _, clusterSubnet := range clusterSubnets {
 		subnet := clusterSubnet.CIDR
 		hostSubnets = append(hostSubnets, subnet)
+		// the first subnet of each IP family is the one the network was
+		// created with, others might have been appended later on
 		if utilnet.IsIPv6CIDR(subnet) {
+			if gwIfAddrv6 != nil {
+				continue
+			}
 			logicalSwitch.OtherConfig["ipv6_prefix"] = subnet.IP.String()
 			gwIfAddrv6 = oc.GetNodeGatewayIP(subnet)
 			if len(nodeLRPMAC) == 0 {
@@ -181,6 +189,9 @@ func (oc *BaseLayer2UserDefinedNetworkController) initializeLogicalSwitch(switch
 				nodeLRPMAC = util.IPAddrToHWAddr(gwIfAddrv6.IP)
 			}
 		} else {
+			if gwIfAddrv4 != nil {
+				continue
+			}
 			logicalSwitch.OtherConfig["subnet"] = subnet.String()
 			gwIfAddrv4 = oc.GetNodeGatewayIP(subnet)
 			nodeLRPMAC = util.IPAddrToHWAddr(gwIfAddrv4.IP)
@@ -222,6 +233,13 @@ func (oc *BaseLayer2UserDefinedNetworkController) initializeLogicalSwitch(switch
 		acls = getDenyARPAndNSOnMACVRF(oc.controllerName, macvrfportName, nodeLRPMAC, gwIfAddrv4, gwIfAddrv6)
 	}
 
+	if trunks := oc.VLANTrunks(); len(trunks) > 0 {
+		// let tagged traffic through the switch, the VLANs that are not
+		// allowed on the trunk are dropped by ACLs
+		logicalSwitch.OtherConfig["vlan-passthru"] = "true"
+		acls = append(acls, getDenyNotAllowedTrunkVLANs(oc.controllerName, trunks)...)
+	}
+
 	if clusterLoadBalancerGroupUUID != "" && switchLoadBalancerGroupUUID != "" {
 		logicalSwitch.LoadBalancerGroup = []string{clusterLoadBalancerGroupUUID, switchLoadBalancerGroupUUID}
 	}
@@ -332,6 +350,36 @@ func (oc *BaseLayer2UserDefinedNetworkController) syncNodes(nodes []interface{})
 	return nil
 }
 
+// getDenyNotAllowedTrunkVLANs returns the ACLs dropping the tagged traffic of
+// the VLANs that are not allowed on a trunk network, in both directions.
+func getDenyNotAllowedTrunkVLANs(controllerName string, trunks []uint) []*nbdb.ACL {
+	vids := make([]string, 0, len(trunks))
+	for _, vid := range trunks {
+		vids = append(vids, strconv.FormatUint(uint64(vid), 10))
+	}
+	match := fmt.Sprintf("vlan.present && vlan.vid != {%s}", strings.Join(vids, ", "))
+	acls := make([]*nbdb.ACL, 0, 2)
+	for _, aclDir := range []libovsdbutil.ACLDirection{libovsdbutil.ACLEgress, libovsdbutil.ACLIngress} {
+		acls = append(acls, libovsdbutil.BuildACL(
+			libovsdbops.NewDbObjectIDs(
+				libovsdbops.ACLUDN,
+				controllerName,
+				map[libovsdbops.ExternalIDKey]string{
+					libovsdbops.ObjectNameKey:      "DenyNotAllowedTrunkVLANs",
+					libovsdbops.PolicyDirectionKey: string(aclDir),
+				},
+			),
+			types.TrunkVLANDenyPriority,
+			match,
+			nbdb.ACLActionDrop,
+			nil,
+			libovsdbutil.ACLDirectionToACLPipeline(aclDir),
+			types.PrimaryACLTier,
+		))
+	}
+	return acls
+}
+
 // getDenyARPAndNSOnMACVRF provides ACLs to drop ARP and NS from pods to the
 // gateway IP on the MACVRF port. Even though these requests are unicast, OVN is
 // flooding them for historic reasons. We don't want these request to be flooded
@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ovn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/urfave/cli/v2"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	cnpapi "sigs.k8s.io/network-policy-api/apis/v1alpha2"
+	anpfake "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	addressset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	anpovn "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/controller/admin_network_policy"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
+	libovsdbtest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+)
+
+func newCNPObject(name string, tier cnpapi.Tier, priority int32, subject cnpapi.ClusterNetworkPolicySubject,
+	ingressRules []cnpapi.ClusterNetworkPolicyIngressRule, egressRules []cnpapi.ClusterNetworkPolicyEgressRule) *cnpapi.ClusterNetworkPolicy {
+	return &cnpapi.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: cnpapi.ClusterNetworkPolicySpec{
+			Tier:     tier,
+			Priority: priority,
+			Subject:  subject,
+			Ingress:  ingressRules,
+			Egress:   egressRules,
+		},
+	}
+}
+
+func getCNPPolicyKind(tier cnpapi.Tier) anpovn.PolicyKind {
+	if tier == cnpapi.BaselineTier {
+		return anpovn.CNPBaselineKind
+	}
+	return anpovn.CNPAdminKind
+}
+
+func getDefaultPGForCNPSubject(cnpName string, tier cnpapi.Tier, portUUIDs []string, acls []*nbdb.ACL) *nbdb.PortGroup {
+	lsps := []*nbdb.LogicalSwitchPort{}
+	for _, uuid := range portUUIDs {
+		lsps = append(lsps, &nbdb.LogicalSwitchPort{UUID: uuid})
+	}
+	pg := libovsdbutil.BuildPortGroup(
+		anpovn.GetANPPortGroupDbIDs(cnpName, getCNPPolicyKind(tier), types.DefaultNetworkControllerName),
+		lsps,
+		acls,
+	)
+	pg.UUID = pg.Name + "-UUID"
+	return pg
+}
+
+// getCNPIngressACL returns the ACL expected for an ingress rule without ports in a single stack IPv4 cluster
+func getCNPIngressACL(action, cnpName string, tier cnpapi.Tier, rulePriority, ruleIndex int32) *nbdb.ACL {
+	ownerType := libovsdbops.ClusterNetworkPolicyAdminOwnerType
+	aclTier := types.DefaultANPACLTier
+	if tier == cnpapi.BaselineTier {
+		ownerType = libovsdbops.ClusterNetworkPolicyBaselineOwnerType
+		aclTier = types.DefaultBANPACLTier
+	}
+	direction := string(libovsdbutil.ACLIngress)
+	pgName := libovsdbutil.GetPortGroupName(anpovn.GetANPPortGroupDbIDs(cnpName, getCNPPolicyKind(tier), types.DefaultNetworkControllerName))
+	asIndex := anpovn.GetANPPeerAddrSetDbIDs(cnpName, direction, fmt.Sprintf("%d", ruleIndex),
+		types.DefaultNetworkControllerName, getCNPPolicyKind(tier))
+	asv4, _ := addressset.GetHashNamesForAS(asIndex)
+	return &nbdb.ACL{
+		UUID:      fmt.Sprintf("%s_%s_%d-UUID", cnpName, direction, ruleIndex),
+		Action:    action,
+		Direction: nbdb.ACLDirectionToLport,
+		Log:       false,
+		Meter:     ptr.To(types.OvnACLLoggingMeter),
+		Priority:  int(rulePriority),
+		Tier:      aclTier,
+		Match:     fmt.Sprintf("outport == @%s && ((ip4.src == $%s))", pgName, asv4),
+		Name:      ptr.To(fmt.Sprintf("CNP:%s:%s:%d", cnpName, direction, ruleIndex)),
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerControllerKey.String():    types.DefaultNetworkControllerName,
+			libovsdbops.ObjectNameKey.String():         cnpName,
+			libovsdbops.GressIdxKey.String():           fmt.Sprintf("%d", ruleIndex),
+			libovsdbops.PolicyDirectionKey.String():    direction,
+			libovsdbops.PortPolicyProtocolKey.String(): "None",
+			libovsdbops.OwnerTypeKey.String():          string(ownerType),
+			libovsdbops.PrimaryIDKey.String(): fmt.Sprintf("%s:%s:%s:%s:%d:None",
+				types.DefaultNetworkControllerName, ownerType, cnpName, direction, ruleIndex),
+		},
+	}
+}
+
+func buildCNPAddressSets(cnpName string, tier cnpapi.Tier, index int32, ips []string, gressPrefix libovsdbutil.ACLDirection) (*nbdb.AddressSet, *nbdb.AddressSet) {
+	asIndex := anpovn.GetANPPeerAddrSetDbIDs(cnpName, string(gressPrefix),
+		fmt.Sprintf("%d", index), types.DefaultNetworkControllerName, getCNPPolicyKind(tier))
+	return addressset.GetTestDbAddrSets(asIndex, ips)
+}
+
+var _ = ginkgo.Describe("OVN CNP Operations", func() {
+	var (
+		app     *cli.App
+		fakeOVN *FakeOVN
+	)
+
+	const (
+		cnpSubjectNamespaceName = "cnp-subject-namespace"
+		cnpPeerNamespaceName    = "cnp-peer-namespace"
+		node1Name               = "node1"
+	)
+
+	ginkgo.BeforeEach(func() {
+		// Restore global default values before each testcase
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableAdminNetworkPolicy = true
+		config.OVNKubernetesFeature.EnableClusterNetworkPolicy = true
+
+		app = cli.NewApp()
+		app.Name = "test"
+		app.Flags = config.Flags
+
+		fakeOVN = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		fakeOVN.shutdown()
+	})
+
+	ginkgo.Context("on cluster network policy changes", func() {
+		ginkgo.It("should create/update/delete address-sets, acls, port-groups in the tier of the policy", func() {
+			app.Action = func(*cli.Context) error {
+				cnpNamespaceSubject := *testing.NewNamespaceWithLabels(cnpSubjectNamespaceName, anpLabel)
+				cnpNamespacePeer := *testing.NewNamespaceWithLabels(cnpPeerNamespaceName, peerDenyLabel)
+				config.IPv4Mode = true
+				node1 := nodeFor(node1Name, "100.100.100.0", "", "10.128.1.0/24", "", "", "")
+				node1Switch := &nbdb.LogicalSwitch{
+					Name: node1Name,
+					UUID: node1Name + "-UUID",
+				}
+				fakeOVN.startWithDBSetup(libovsdbtest.TestSetup{NBData: []libovsdbtest.TestData{node1Switch}},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							cnpNamespaceSubject,
+							cnpNamespacePeer,
+						},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{
+							*node1,
+						},
+					},
+				)
+				fakeOVN.controller.zone = node1Name
+				err := fakeOVN.controller.WatchNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOVN.controller.WatchPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOVN.InitAndRunANPController()
+				fakeOVN.fakeClient.ANPClient.(*anpfake.Clientset).PrependReactor("update", "clusternetworkpolicies", func(action clienttesting.Action) (handled bool, ret runtime.Object, err error) {
+					// the fake client does not differentiate between an update and an update of the status,
+					// so let us swallow the status updates to avoid them from overwriting the spec
+					update := action.(clienttesting.UpdateAction)
+					return action.GetSubresource() == "status", update.GetObject(), nil
+				})
+
+				ginkgo.By("1. creating an admin tier cluster network policy with one ingress rule; check if port group, acl and address-set are created")
+				subject := cnpapi.ClusterNetworkPolicySubject{
+					Namespaces: &metav1.LabelSelector{
+						MatchLabels: anpLabel,
+					},
+				}
+				ingressRules := []cnpapi.ClusterNetworkPolicyIngressRule{
+					{
+						Name:   "deny-from-peer",
+						Action: cnpapi.ClusterNetworkPolicyRuleActionDeny,
+						From: []cnpapi.ClusterNetworkPolicyIngressPeer{
+							{
+								Namespaces: &metav1.LabelSelector{
+									MatchLabels: peerDenyLabel,
+								},
+							},
+						},
+					},
+				}
+				cnp := newCNPObject("hermione", cnpapi.AdminTier, 5, subject, ingressRules, nil)
+				cnp.ResourceVersion = "1"
+				cnp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha2().ClusterNetworkPolicies().Create(context.TODO(), cnp, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				acl := getCNPIngressACL(nbdb.ACLActionDrop, cnp.Name, cnpapi.AdminTier, getANPRulePriority(getBaseRulePriority(5), 0), 0)
+				pg := getDefaultPGForCNPSubject(cnp.Name, cnpapi.AdminTier, nil, []*nbdb.ACL{acl})
+				asv4, _ := buildCNPAddressSets(cnp.Name, cnpapi.AdminTier, 0, []string{}, libovsdbutil.ACLIngress)
+				expectedDatabaseState := []libovsdbtest.TestData{node1Switch, pg, acl, asv4}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+				ginkgo.By("2. moving the cluster network policy to the baseline tier; check if objects are recreated in the baseline tier")
+				cnp = newCNPObject("hermione", cnpapi.BaselineTier, 5, subject, ingressRules, nil)
+				cnp.ResourceVersion = "2"
+				cnp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha2().ClusterNetworkPolicies().Update(context.TODO(), cnp, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				acl = getCNPIngressACL(nbdb.ACLActionDrop, cnp.Name, cnpapi.BaselineTier, getANPRulePriority(getBaseRulePriority(5), 0), 0)
+				pg = getDefaultPGForCNPSubject(cnp.Name, cnpapi.BaselineTier, nil, []*nbdb.ACL{acl})
+				asv4, _ = buildCNPAddressSets(cnp.Name, cnpapi.BaselineTier, 0, []string{}, libovsdbutil.ACLIngress)
+				expectedDatabaseState = []libovsdbtest.TestData{node1Switch, pg, acl, asv4}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+				ginkgo.By("3. deleting the cluster network policy; check if all objects are removed")
+				err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha2().ClusterNetworkPolicies().Delete(context.TODO(), cnp.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData([]libovsdbtest.TestData{node1Switch}))
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should not program cluster network policies with domainNames peers", func() {
+			app.Action = func(*cli.Context) error {
+				config.IPv4Mode = true
+				fakeOVN.startWithDBSetup(libovsdbtest.TestSetup{},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							*testing.NewNamespaceWithLabels(cnpSubjectNamespaceName, anpLabel),
+						},
+					},
+				)
+				fakeOVN.InitAndRunANPController()
+				egressRules := []cnpapi.ClusterNetworkPolicyEgressRule{
+					{
+						Name:   "allow-to-domain",
+						Action: cnpapi.ClusterNetworkPolicyRuleActionAccept,
+						To: []cnpapi.ClusterNetworkPolicyEgressPeer{
+							{
+								DomainNames: []cnpapi.DomainName{"kubernetes.io"},
+							},
+						},
+					},
+				}
+				cnp := newCNPObject("ron", cnpapi.AdminTier, 5, cnpapi.ClusterNetworkPolicySubject{
+					Namespaces: &metav1.LabelSelector{MatchLabels: anpLabel},
+				}, nil, egressRules)
+				_, err := fakeOVN.fakeClient.ANPClient.PolicyV1alpha2().ClusterNetworkPolicies().Create(context.TODO(), cnp, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(func() []metav1.Condition {
+					cnp, err := fakeOVN.fakeClient.ANPClient.PolicyV1alpha2().ClusterNetworkPolicies().Get(context.TODO(), cnp.Name, metav1.GetOptions{})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					return cnp.Status.Conditions
+				}).Should(gomega.ContainElement(gomega.HaveField("Status", metav1.ConditionFalse)))
+				gomega.Consistently(fakeOVN.nbClient).Should(libovsdbtest.HaveData([]libovsdbtest.TestData{}))
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+})
@@ -129,7 +129,7 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 	// 1) Construct Port Group name using ANP name and ports of pods in ANP subject
 	// 2) Construct Address-sets with IPs of the peers in the rules
 	// 3) Construct ACLs using AS-es and PGs
-	portGroupName := c.getANPPortGroupName(desiredANPState.name, false)
+	portGroupName := c.getANPPortGroupName(desiredANPState.name, ANPKind)
 
 	desiredPorts, err := c.convertANPSubjectToLSPs(desiredANPState)
 	if err != nil {
@@ -140,14 +140,14 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 		return fmt.Errorf("unable to convert peers to addresses for anp %s: %v", desiredANPState.name, err)
 	}
 	atLeastOneRuleUpdated := false
-	desiredACLs := c.convertANPRulesToACLs(desiredANPState, currentANPState, portGroupName, &atLeastOneRuleUpdated, false)
+	desiredACLs := c.convertANPRulesToACLs(desiredANPState, currentANPState, portGroupName, &atLeastOneRuleUpdated, ANPKind)
 
 	if !loaded {
 		// this is a fresh ANP create
 		klog.Infof("Creating admin network policy %s/%d", anp.Name, anp.Spec.Priority)
 		// 4) Create the PG/ACL/AS in same transact
 		// 5) Update the ANP caches to store all the created things if transact was successful
-		err = c.createNewANP(desiredANPState, desiredACLs, desiredPorts, false)
+		err = c.createNewANP(desiredANPState, desiredACLs, desiredPorts, ANPKind)
 		if err != nil {
 			return fmt.Errorf("failed to create ANP %s: %v", desiredANPState.name, err)
 		}
@@ -176,7 +176,7 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 	// ANP state existed in the cache, which means its either an ANP update or pod/namespace add/update/delete
 	klog.V(5).Infof("Admin network policy %s/%d was found in cache...Syncing it", currentANPState.name, currentANPState.anpPriority)
 	hasPriorityChanged := (currentANPState.anpPriority != desiredANPState.anpPriority)
-	err = c.updateExistingANP(currentANPState, desiredANPState, atLeastOneRuleUpdated, hasPriorityChanged, false, desiredACLs)
+	err = c.updateExistingANP(currentANPState, desiredANPState, atLeastOneRuleUpdated, hasPriorityChanged, ANPKind, desiredACLs)
 	if err != nil {
 		return fmt.Errorf("failed to update ANP %s: %v", desiredANPState.name, err)
 	}
@@ -212,7 +212,7 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 // if currentANPState exists; then we also see if any of the current v/s desired ACLs had a state change
 // and if so, we return atLeastOneRuleUpdated=true
 func (c *Controller) convertANPRulesToACLs(desiredANPState, currentANPState *adminNetworkPolicyState, pgName string,
-	atLeastOneRuleUpdated *bool, isBanp bool) []*nbdb.ACL {
+	atLeastOneRuleUpdated *bool, kind PolicyKind) []*nbdb.ACL {
 	acls := []*nbdb.ACL{}
 	// isAtLeastOneRuleUpdatedCheckRequired is set to true, if we had an anp already in cache (update) AND the rule lengths are the same
 	// if the rule lengths are different we do a full peer recompute in ensureAdminNetworkPolicy anyways
@@ -220,7 +220,7 @@ func (c *Controller) convertANPRulesToACLs(desiredANPState, currentANPState *adm
 		len(currentANPState.ingressRules) == len(desiredANPState.ingressRules) &&
 		len(currentANPState.egressRules) == len(desiredANPState.egressRules))
 	for i, ingressRule := range desiredANPState.ingressRules {
-		acl := c.convertANPRuleToACL(ingressRule, pgName, desiredANPState.name, desiredANPState.aclLoggingParams, isBanp)
+		acl := c.convertANPRuleToACL(ingressRule, pgName, desiredANPState.name, desiredANPState.aclLoggingParams, kind)
 		acls = append(acls, acl...)
 		if isAtLeastOneRuleUpdatedCheckRequired &&
 			!*atLeastOneRuleUpdated &&
@@ -232,7 +232,7 @@ func (c *Controller) convertANPRulesToACLs(desiredANPState, currentANPState *adm
 		}
 	}
 	for i, egressRule := range desiredANPState.egressRules {
-		acl := c.convertANPRuleToACL(egressRule, pgName, desiredANPState.name, desiredANPState.aclLoggingParams, isBanp)
+		acl := c.convertANPRuleToACL(egressRule, pgName, desiredANPState.name, desiredANPState.aclLoggingParams, kind)
 		acls = append(acls, acl...)
 		if isAtLeastOneRuleUpdatedCheckRequired &&
 			!*atLeastOneRuleUpdated &&
@@ -249,10 +249,10 @@ func (c *Controller) convertANPRulesToACLs(desiredANPState, currentANPState *adm
 
 // convertANPRuleToACL takes the given gressRule and converts it into an ACL(0 ports rule) or
 // multiple ACLs(ports are set) and returns those ACLs for a given gressRule
-func (c *Controller) convertANPRuleToACL(rule *gressRule, pgName, anpName string, aclLoggingParams *libovsdbutil.ACLLoggingLevels, isBanp bool) []*nbdb.ACL {
+func (c *Controller) convertANPRuleToACL(rule *gressRule, pgName, anpName string, aclLoggingParams *libovsdbutil.ACLLoggingLevels, kind PolicyKind) []*nbdb.ACL {
 	klog.V(5).Infof("Creating ACL for rule %d/%s belonging to ANP %s", rule.priority, rule.gressPrefix, anpName)
 	// create match based on direction and address-set name
-	asIndex := GetANPPeerAddrSetDbIDs(anpName, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), c.controllerName, isBanp)
+	asIndex := GetANPPeerAddrSetDbIDs(anpName, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), c.controllerName, kind)
 	l3Match := constructMatchFromAddressSet(rule.gressPrefix, asIndex)
 	// create match based on rule type (ingress/egress) and port-group
 	lportMatch := libovsdbutil.GetACLMatch(pgName, "", libovsdbutil.ACLDirection(rule.gressPrefix))
@@ -277,7 +277,7 @@ func (c *Controller) convertANPRuleToACL(rule *gressRule, pgName, anpName string
 			match = fmt.Sprintf("%s && %s && %s", lportMatch, l3Match, l4Match)
 		}
 		acl := libovsdbutil.BuildANPACL(
-			getANPRuleACLDbIDs(anpName, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), protocol, c.controllerName, isBanp),
+			getANPRuleACLDbIDs(anpName, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), protocol, c.controllerName, kind),
 			int(rule.priority),
 			match,
 			rule.action,
@@ -294,7 +294,7 @@ func (c *Controller) convertANPRuleToACL(rule *gressRule, pgName, anpName string
 			match = fmt.Sprintf("%s && %s", lportMatch, l3l4Match)
 		}
 		acl := libovsdbutil.BuildANPACL(
-			getANPRuleACLDbIDs(anpName, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), protocol+libovsdbutil.NamedPortL4MatchSuffix, c.controllerName, isBanp),
+			getANPRuleACLDbIDs(anpName, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), protocol+libovsdbutil.NamedPortL4MatchSuffix, c.controllerName, kind),
 			int(rule.priority),
 			match,
 			rule.action,
@@ -529,7 +529,7 @@ func (c *Controller) clearAdminNetworkPolicy(anpName string) error {
 	// clear NBDB objects for the given ANP (PG, ACLs on that PG, AddrSets used by the ACLs)
 	var err error
 	// remove PG for Subject (ACLs will get cleaned up automatically)
-	portGroupName := c.getANPPortGroupName(anp.name, false)
+	portGroupName := c.getANPPortGroupName(anp.name, ANPKind)
 	// no need to batch this with address-set deletes since this itself will contain a bunch of ACLs that need to be deleted which is heavy enough.
 	err = libovsdbops.DeletePortGroups(c.nbClient, portGroupName)
 	if err != nil {
@@ -567,11 +567,11 @@ func (c *Controller) clearASForPeers(anpName string, idType *libovsdbops.ObjectI
 
 // createNewANP takes the desired state of the anp and creates the corresponding objects in the NBDB
 func (c *Controller) createNewANP(desiredANPState *adminNetworkPolicyState, desiredACLs []*nbdb.ACL,
-	desiredPorts []*nbdb.LogicalSwitchPort, isBanp bool) error {
+	desiredPorts []*nbdb.LogicalSwitchPort, kind PolicyKind) error {
 	ops := []ovsdb.Operation{}
 
 	// now CreateOrUpdate the address-sets; add the right IPs - we treat the rest of the address-set cases as a fresh add or update
-	addrSetOps, err := c.constructOpsForRuleChanges(desiredANPState, isBanp)
+	addrSetOps, err := c.constructOpsForRuleChanges(desiredANPState, kind)
 	if err != nil {
 		return fmt.Errorf("failed to create address-sets, %v", err)
 	}
@@ -580,7 +580,7 @@ func (c *Controller) createNewANP(desiredANPState *adminNetworkPolicyState, desi
 	if err != nil {
 		return fmt.Errorf("failed to create ACL ops: %v", err)
 	}
-	pgDbIDs := GetANPPortGroupDbIDs(desiredANPState.name, isBanp, c.controllerName)
+	pgDbIDs := GetANPPortGroupDbIDs(desiredANPState.name, kind, c.controllerName)
 	pg := libovsdbutil.BuildPortGroup(pgDbIDs, desiredPorts, desiredACLs)
 	ops, err = libovsdbops.CreateOrUpdatePortGroupsOps(c.nbClient, ops, pg)
 	if err != nil {
@@ -594,10 +594,10 @@ func (c *Controller) createNewANP(desiredANPState *adminNetworkPolicyState, desi
 }
 
 func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNetworkPolicyState, atLeastOneRuleUpdated,
-	hasPriorityChanged, isBanp bool, desiredACLs []*nbdb.ACL) error {
+	hasPriorityChanged bool, kind PolicyKind, desiredACLs []*nbdb.ACL) error {
 	var ops []ovsdb.Operation
 	var err error
-	portGroupName := c.getANPPortGroupName(desiredANPState.name, isBanp)
+	portGroupName := c.getANPPortGroupName(desiredANPState.name, kind)
 	// Did ANP.Spec.Ingress Change (rule inserts/deletes)? && || Did ANP.Spec.Egress Change (rule inserts/deletes)? && ||
 	// If yes we need to fully recompute the acls present in our ANP's port group; Let's do a full recompute and return.
 	// Reason behind a full recompute: Each rule has precedence based on its position and priority of ANP; if any of that changes
@@ -611,7 +611,7 @@ func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNe
 		// full recompute
 		// which means update all ACLs and address-sets
 		klog.V(3).Infof("ANP %s with priority (old %d, new %d) was updated", desiredANPState.name, currentANPState.anpPriority, desiredANPState.anpPriority)
-		ops, err = c.constructOpsForRuleChanges(desiredANPState, isBanp)
+		ops, err = c.constructOpsForRuleChanges(desiredANPState, kind)
 		if err != nil {
 			return fmt.Errorf("failed to create update ANP ops %s: %v", desiredANPState.name, err)
 		}
@@ -631,7 +631,7 @@ func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNe
 	// If yes we need to recompute the IPs present in our ANP's peer's address-sets
 	if !fullPeerRecompute && !reflect.DeepEqual(desiredANPState.ingressRules, currentANPState.ingressRules) {
 		addrOps, err := c.constructOpsForPeerChanges(desiredANPState.ingressRules,
-			currentANPState.ingressRules, desiredANPState.name, isBanp)
+			currentANPState.ingressRules, desiredANPState.name, kind)
 		if err != nil {
 			return fmt.Errorf("failed to create ops for changes to ANP ingress peers: %v", err)
 		}
@@ -654,7 +654,7 @@ func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNe
 	// If yes we need to recompute the IPs present in our ANP's peer's address-sets
 	if !fullPeerRecompute && !reflect.DeepEqual(desiredANPState.egressRules, currentANPState.egressRules) {
 		addrOps, err := c.constructOpsForPeerChanges(desiredANPState.egressRules,
-			currentANPState.egressRules, desiredANPState.name, isBanp)
+			currentANPState.egressRules, desiredANPState.name, kind)
 		if err != nil {
 			return fmt.Errorf("failed to create ops for changes to ANP egress peers: %v", err)
 		}
@@ -662,7 +662,7 @@ func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNe
 	}
 	hasACLLoggingParamsChanged := currentANPState.aclLoggingParams.Allow != desiredANPState.aclLoggingParams.Allow ||
 		currentANPState.aclLoggingParams.Deny != desiredANPState.aclLoggingParams.Deny
-	if !isBanp {
+	if kind.supportsPass() {
 		hasACLLoggingParamsChanged = hasACLLoggingParamsChanged || currentANPState.aclLoggingParams.Pass != desiredANPState.aclLoggingParams.Pass
 	}
 	// The rules which didn't change -> those updates will be no-ops thanks to libovsdb
@@ -708,18 +708,14 @@ func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNe
 }
 
 // constructOpsForRuleChanges takes the desired state of the anp and returns the corresponding ops for updating NBDB objects
-func (c *Controller) constructOpsForRuleChanges(desiredANPState *adminNetworkPolicyState, isBanp bool) ([]ovsdb.Operation, error) {
+func (c *Controller) constructOpsForRuleChanges(desiredANPState *adminNetworkPolicyState, kind PolicyKind) ([]ovsdb.Operation, error) {
 	var ops []ovsdb.Operation
 	var err error
 	// Logic to delete address-sets:
 	// we need to delete address-sets only if the number of rules in the desiredANPState object is
 	// less than the number of rules in the currentANPState object (AddressSet indexes are calculated based on rule's position)
 	// rest of the cases will be createorupdate of existing existing address-sets in the cluster
-	idType := libovsdbops.AddressSetAdminNetworkPolicy
-	if isBanp {
-		idType = libovsdbops.AddressSetBaselineAdminNetworkPolicy
-	}
-	predicateIDs := libovsdbops.NewDbObjectIDs(idType, c.controllerName,
+	predicateIDs := libovsdbops.NewDbObjectIDs(kind.addressSetIDsType(), c.controllerName,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: desiredANPState.name,
 		})
@@ -739,7 +735,7 @@ func (c *Controller) constructOpsForRuleChanges(desiredANPState *adminNetworkPol
 	// Had briefly discussed this OVN team. We are not yet clear which is better since both have advantages and disadvantages.
 	// Decide this after doing some scale runs.
 	for _, rule := range desiredANPState.ingressRules {
-		asIndex := GetANPPeerAddrSetDbIDs(desiredANPState.name, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), c.controllerName, isBanp)
+		asIndex := GetANPPeerAddrSetDbIDs(desiredANPState.name, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), c.controllerName, kind)
 		_, addrSetOps, err := c.addressSetFactory.NewAddressSetOps(asIndex, rule.peerAddresses.UnsortedList())
 		if err != nil {
 			return nil, fmt.Errorf("failed to create address-sets for ANP %s's"+
@@ -748,7 +744,7 @@ func (c *Controller) constructOpsForRuleChanges(desiredANPState *adminNetworkPol
 		ops = append(ops, addrSetOps...)
 	}
 	for _, rule := range desiredANPState.egressRules {
-		asIndex := GetANPPeerAddrSetDbIDs(desiredANPState.name, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), c.controllerName, isBanp)
+		asIndex := GetANPPeerAddrSetDbIDs(desiredANPState.name, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), c.controllerName, kind)
 		_, addrSetOps, err := c.addressSetFactory.NewAddressSetOps(asIndex, rule.peerAddresses.UnsortedList())
 		if err != nil {
 			return nil, fmt.Errorf("failed to create address-sets for ANP %s's"+
@@ -763,13 +759,13 @@ func (c *Controller) constructOpsForRuleChanges(desiredANPState *adminNetworkPol
 // for updating NBDB AddressSet objects for those peers
 // This should be called if namespace/pod is being created/updated
 func (c *Controller) constructOpsForPeerChanges(desiredRules, currentRules []*gressRule,
-	anpName string, isBanp bool) ([]ovsdb.Operation, error) {
+	anpName string, kind PolicyKind) ([]ovsdb.Operation, error) {
 	var ops []ovsdb.Operation
 	for i := range desiredRules {
 		desiredRule := desiredRules[i]
 		currentRule := currentRules[i]
 		addressesToAdd := desiredRule.peerAddresses.Difference(currentRule.peerAddresses)
-		asIndex := GetANPPeerAddrSetDbIDs(anpName, desiredRule.gressPrefix, fmt.Sprintf("%d", desiredRule.gressIndex), c.controllerName, isBanp)
+		asIndex := GetANPPeerAddrSetDbIDs(anpName, desiredRule.gressPrefix, fmt.Sprintf("%d", desiredRule.gressIndex), c.controllerName, kind)
 		if len(addressesToAdd) > 0 {
 			as, err := c.addressSetFactory.GetAddressSet(asIndex)
 			if err != nil {
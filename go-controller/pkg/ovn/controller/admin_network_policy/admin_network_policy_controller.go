@@ -20,9 +20,12 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+	cnpapi "sigs.k8s.io/network-policy-api/apis/v1alpha2"
 	anpclientset "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned"
 	anpinformer "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha1"
+	cnpinformer "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha2"
 	anplister "sigs.k8s.io/network-policy-api/pkg/client/listers/apis/v1alpha1"
+	cnplister "sigs.k8s.io/network-policy-api/pkg/client/listers/apis/v1alpha2"
 
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
 
@@ -82,6 +85,11 @@ type Controller struct {
 	// This cache will always have only one entry since object is singleton in the cluster
 	banpCache *adminNetworkPolicyState
 
+	// cnp name is key -> cloned value of CNP kapi is value
+	// ClusterNetworkPolicies are only processed if the controller was given a CNP informer,
+	// in which case they co-exist with (B)ANPs so that users can migrate between the APIs
+	cnpCache map[string]*adminNetworkPolicyState
+
 	// queues for the CRDs where incoming work is placed to de-dup
 	anpQueue  workqueue.TypedRateLimitingInterface[string]
 	banpQueue workqueue.TypedRateLimitingInterface[string]
@@ -90,6 +98,10 @@ type Controller struct {
 	banpLister      anplister.BaselineAdminNetworkPolicyLister
 	anpCacheSynced  cache.InformerSynced
 	banpCacheSynced cache.InformerSynced
+	// queue, cache, lister for cnp objects; these are nil if CNP support is not enabled
+	cnpQueue       workqueue.TypedRateLimitingInterface[string]
+	cnpLister      cnplister.ClusterNetworkPolicyLister
+	cnpCacheSynced cache.InformerSynced
 	// namespace queue, cache, lister
 	anpNamespaceLister corev1listers.NamespaceLister
 	anpNamespaceSynced cache.InformerSynced
@@ -113,6 +125,7 @@ func NewController(
 	anpClient anpclientset.Interface,
 	anpInformer anpinformer.AdminNetworkPolicyInformer,
 	banpInformer anpinformer.BaselineAdminNetworkPolicyInformer,
+	cnpInformer cnpinformer.ClusterNetworkPolicyInformer,
 	namespaceInformer corev1informers.NamespaceInformer,
 	podInformer corev1informers.PodInformer,
 	nodeInformer corev1informers.NodeInformer,
@@ -132,6 +145,7 @@ func NewController(
 		anpCache:                  make(map[string]*adminNetworkPolicyState),
 		anpPriorityMap:            make(map[int32]string),
 		banpCache:                 &adminNetworkPolicyState{}, // safe to initialise pointer to empty struct than nil
+		cnpCache:                  make(map[string]*adminNetworkPolicyState),
 		observManager:             observManager,
 	}
 
@@ -170,6 +184,25 @@ func NewController(
 		return nil, fmt.Errorf("could not add Event Handler for banpInformer during admin network policy controller initialization, %w", err)
 	}
 
+	if cnpInformer != nil {
+		klog.V(5).Info("Setting up event handlers for Cluster Network Policy")
+		// setup cnp informers, listers, queue
+		c.cnpLister = cnpInformer.Lister()
+		c.cnpCacheSynced = cnpInformer.Informer().HasSynced
+		c.cnpQueue = workqueue.NewTypedRateLimitingQueueWithConfig(
+			controllerutil.DefaultRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "clusterNetworkPolicy"},
+		)
+		_, err = cnpInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.onCNPAdd,
+			UpdateFunc: c.onCNPUpdate,
+			DeleteFunc: c.onCNPDelete,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("could not add Event Handler for cnpInformer during admin network policy controller initialization, %w", err)
+		}
+	}
+
 	klog.V(5).Info("Setting up event handlers for Namespaces in Admin Network Policy controller")
 	c.anpNamespaceLister = namespaceInformer.Lister()
 	c.anpNamespaceSynced = namespaceInformer.Informer().HasSynced
@@ -224,7 +257,7 @@ func NewController(
 }
 
 // Run will not return until stopCh is closed. workers determines how many
-// objects (pods, namespaces, anps, banps) will be handled in parallel.
+// objects (pods, namespaces, anps, banps, cnps) will be handled in parallel.
 func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 
@@ -232,7 +265,11 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 
 	// Wait for the caches to be synced
 	klog.V(5).Info("Waiting for informer caches to sync")
-	if !util.WaitForInformerCacheSyncWithTimeout(c.controllerName, stopCh, c.anpCacheSynced, c.banpCacheSynced, c.anpNamespaceSynced, c.anpPodSynced) {
+	cachesSynced := []cache.InformerSynced{c.anpCacheSynced, c.banpCacheSynced, c.anpNamespaceSynced, c.anpPodSynced}
+	if c.cnpCacheSynced != nil {
+		cachesSynced = append(cachesSynced, c.cnpCacheSynced)
+	}
+	if !util.WaitForInformerCacheSyncWithTimeout(c.controllerName, stopCh, cachesSynced...) {
 		utilruntime.HandleError(fmt.Errorf("timed out waiting for admin network policy caches to sync"))
 		klog.Errorf("Error syncing caches for admin network policy and baseline admin network policy")
 		return
@@ -248,6 +285,13 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	if err != nil {
 		klog.Errorf("Failed to repair Baseline Admin Network Policy: %v", err)
 	}
+	if c.cnpLister != nil {
+		klog.Infof("Repairing Cluster Network Policies")
+		err = c.repairClusterNetworkPolicies()
+		if err != nil {
+			klog.Errorf("Failed to repair Cluster Network Policies: %v", err)
+		}
+	}
 
 	wg := &sync.WaitGroup{}
 	// Start the workers after the repair loop to avoid races
@@ -273,6 +317,19 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 		}()
 	}
 
+	if c.cnpQueue != nil {
+		klog.V(5).Info("Starting Cluster Network Policy workers")
+		for i := 0; i < threadiness; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				wait.Until(func() {
+					c.runCNPWorker(wg)
+				}, time.Second, stopCh)
+			}()
+		}
+	}
+
 	klog.V(5).Info("Starting Namespace Admin Network Policy workers")
 	for i := 0; i < threadiness; i++ {
 		wg.Add(1)
@@ -312,6 +369,9 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	klog.Infof("Shutting down controller %s", c.controllerName)
 	c.anpQueue.ShutDown()
 	c.banpQueue.ShutDown()
+	if c.cnpQueue != nil {
+		c.cnpQueue.ShutDown()
+	}
 	c.anpNamespaceQueue.ShutDown()
 	c.anpPodQueue.ShutDown()
 	c.anpNodeQueue.ShutDown()
@@ -333,6 +393,11 @@ func (c *Controller) runBANPWorker(wg *sync.WaitGroup) {
 	}
 }
 
+func (c *Controller) runCNPWorker(wg *sync.WaitGroup) {
+	for c.processNextCNPWorkItem(wg) {
+	}
+}
+
 func (c *Controller) runANPNamespaceWorker(wg *sync.WaitGroup) {
 	for c.processNextANPNamespaceWorkItem(wg) {
 	}
@@ -446,6 +511,53 @@ func (c *Controller) onBANPDelete(obj interface{}) {
 	c.banpQueue.Add(key)
 }
 
+// onCNPAdd queues the CNP for processing.
+func (c *Controller) onCNPAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	klog.V(4).Infof("Adding Cluster Network Policy %s", key)
+	c.cnpQueue.Add(key)
+}
+
+// onCNPUpdate queues the CNP for processing if its spec or ACL logging annotation changed.
+func (c *Controller) onCNPUpdate(oldObj, newObj interface{}) {
+	oldCNP := oldObj.(*cnpapi.ClusterNetworkPolicy)
+	newCNP := newObj.(*cnpapi.ClusterNetworkPolicy)
+
+	// don't process resync or objects that are marked for deletion
+	if oldCNP.ResourceVersion == newCNP.ResourceVersion ||
+		!newCNP.GetDeletionTimestamp().IsZero() {
+		return
+	}
+	oldCNPACLAnnotation := oldCNP.Annotations[util.AclLoggingAnnotation]
+	newCNPACLAnnotation := newCNP.Annotations[util.AclLoggingAnnotation]
+	if reflect.DeepEqual(oldCNP.Spec, newCNP.Spec) && oldCNPACLAnnotation == newCNPACLAnnotation {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(newObj)
+	if err == nil {
+		klog.V(4).Infof("Updating Cluster Network Policy %s: "+
+			"cnpTier: %v, cnpPriority: %v, cnpSubject %v, cnpIngress %v, cnpEgress %v"+
+			"aclAnnotation: %v", key, newCNP.Spec.Tier, newCNP.Spec.Priority, newCNP.Spec.Subject, newCNP.Spec.Ingress,
+			newCNP.Spec.Egress, newCNPACLAnnotation)
+		c.cnpQueue.Add(key)
+	}
+}
+
+// onCNPDelete queues the CNP for processing.
+func (c *Controller) onCNPDelete(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	klog.V(4).Infof("Deleting Cluster Network Policy %s", key)
+	c.cnpQueue.Add(key)
+}
+
 // onANPNamespaceAdd queues the namespace for processing.
 func (c *Controller) onANPNamespaceAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
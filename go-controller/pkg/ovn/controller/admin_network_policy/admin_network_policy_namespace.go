@@ -79,6 +79,9 @@ func (c *Controller) syncAdminNetworkPolicyNamespace(key string) error {
 			}
 			c.clearNamespaceForANP(name, anpObj, c.anpQueue)
 		}
+		for _, cnpObj := range c.cnpCache {
+			c.clearNamespaceForANP(name, cnpObj, c.cnpQueue)
+		}
 		banpObj := c.banpCache
 		if banpObj.name == "" {
 			return nil
@@ -94,6 +97,9 @@ func (c *Controller) syncAdminNetworkPolicyNamespace(key string) error {
 		}
 		c.setNamespaceForANP(namespace, anpObj, c.anpQueue)
 	}
+	for _, cnpObj := range c.cnpCache {
+		c.setNamespaceForANP(namespace, cnpObj, c.cnpQueue)
+	}
 	banpObj := c.banpCache
 	if banpObj.name == "" { // empty struct, BANP is not setup yet
 		return nil
@@ -81,6 +81,9 @@ func (c *Controller) syncAdminNetworkPolicyNode(key string) error {
 			}
 			c.clearNodeForANP(name, anpObj, c.anpQueue)
 		}
+		for _, cnpObj := range c.cnpCache {
+			c.clearNodeForANP(name, cnpObj, c.cnpQueue)
+		}
 		banpObj := c.banpCache
 		if banpObj.name == "" { // empty struct, BANP is not setup yet
 			return nil
@@ -96,6 +99,9 @@ func (c *Controller) syncAdminNetworkPolicyNode(key string) error {
 		}
 		c.setNodeForANP(node, anpObj, c.anpQueue)
 	}
+	for _, cnpObj := range c.cnpCache {
+		c.setNodeForANP(node, cnpObj, c.cnpQueue)
+	}
 	banpObj := c.banpCache
 	if banpObj.name == "" { // empty struct, BANP is not setup yet
 		return nil
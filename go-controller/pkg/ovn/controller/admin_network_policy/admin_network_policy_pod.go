@@ -90,6 +90,9 @@ func (c *Controller) syncAdminNetworkPolicyPod(key string) error {
 			}
 			c.clearPodForANP(namespace, name, anpObj, c.anpQueue)
 		}
+		for _, cnpObj := range c.cnpCache {
+			c.clearPodForANP(namespace, name, cnpObj, c.cnpQueue)
+		}
 		banpObj := c.banpCache
 		if banpObj.name == "" { // empty struct, BANP is not setup yet
 			return nil
@@ -114,6 +117,9 @@ func (c *Controller) syncAdminNetworkPolicyPod(key string) error {
 		}
 		c.setPodForANP(pod, anpObj, namespaceLabels, c.anpQueue)
 	}
+	for _, cnpObj := range c.cnpCache {
+		c.setPodForANP(pod, cnpObj, namespaceLabels, c.cnpQueue)
+	}
 	banpObj := c.banpCache
 	if banpObj.name == "" { // empty struct, BANP is not setup yet
 		return nil
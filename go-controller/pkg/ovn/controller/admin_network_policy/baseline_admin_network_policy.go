@@ -98,7 +98,7 @@ func (c *Controller) clearBaselineAdminNetworkPolicy(banpName string) error {
 
 	// clear NBDB objects for the given BANP (PG, ACLs on that PG, AddrSets used by the ACLs)
 	// remove PG for Subject (ACLs will get cleaned up automatically)
-	portGroupName := c.getANPPortGroupName(banp.name, true)
+	portGroupName := c.getANPPortGroupName(banp.name, BANPKind)
 	// no need to batch this with address-set deletes since this itself will contain a bunch of ACLs that need to be deleted which is heavy enough.
 	err := libovsdbops.DeletePortGroups(c.nbClient, portGroupName)
 	if err != nil {
@@ -131,7 +131,7 @@ func (c *Controller) ensureBaselineAdminNetworkPolicy(banp *anpapi.BaselineAdmin
 	// 1) Construct Port Group name using ANP name
 	// 2) Construct Address-sets with IPs of the peers in the rules
 	// 3) Construct ACLs using AS-es and PGs
-	portGroupName := c.getANPPortGroupName(desiredBANPState.name, true)
+	portGroupName := c.getANPPortGroupName(desiredBANPState.name, BANPKind)
 	desiredPorts, err := c.convertANPSubjectToLSPs(desiredBANPState)
 	if err != nil {
 		return fmt.Errorf("unable to fetch ports for banp %s: %v", desiredBANPState.name, err)
@@ -141,7 +141,7 @@ func (c *Controller) ensureBaselineAdminNetworkPolicy(banp *anpapi.BaselineAdmin
 		return fmt.Errorf("unable to convert peers to addresses for banp %s: %v", desiredBANPState.name, err)
 	}
 	atLeastOneRuleUpdated := false
-	desiredACLs := c.convertANPRulesToACLs(desiredBANPState, currentBANPState, portGroupName, &atLeastOneRuleUpdated, true)
+	desiredACLs := c.convertANPRulesToACLs(desiredBANPState, currentBANPState, portGroupName, &atLeastOneRuleUpdated, BANPKind)
 
 	// Comparing names for figuring out if cache is populated or not is safe
 	// because the singleton BANP will always be called "default" in any cluster
@@ -150,7 +150,7 @@ func (c *Controller) ensureBaselineAdminNetworkPolicy(banp *anpapi.BaselineAdmin
 		klog.Infof("Creating baseline admin network policy %s", banp.Name)
 		// 4) Create the PG/ACL/AS in same transact
 		// 6) Update the ANP caches to store all the created things if transact was successful
-		err = c.createNewANP(desiredBANPState, desiredACLs, desiredPorts, true)
+		err = c.createNewANP(desiredBANPState, desiredACLs, desiredPorts, BANPKind)
 		if err != nil {
 			return fmt.Errorf("failed to create BANP %s: %v", desiredBANPState.name, err)
 		}
@@ -161,7 +161,7 @@ func (c *Controller) ensureBaselineAdminNetworkPolicy(banp *anpapi.BaselineAdmin
 	}
 	// BANP state existed in the cache, which means its either a BANP update or pod/namespace add/update/delete
 	klog.V(5).Infof("Baseline Admin network policy %s was found in cache...Syncing it", currentBANPState.name)
-	err = c.updateExistingANP(currentBANPState, desiredBANPState, atLeastOneRuleUpdated, false, true, desiredACLs)
+	err = c.updateExistingANP(currentBANPState, desiredBANPState, atLeastOneRuleUpdated, false, BANPKind, desiredACLs)
 	if err != nil {
 		return fmt.Errorf("failed to update ANP %s: %v", desiredBANPState.name, err)
 	}
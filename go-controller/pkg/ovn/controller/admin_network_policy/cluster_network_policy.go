@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package adminnetworkpolicy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	cnpapi "sigs.k8s.io/network-policy-api/apis/v1alpha2"
+
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/metrics"
+)
+
+func (c *Controller) processNextCNPWorkItem(wg *sync.WaitGroup) bool {
+	wg.Add(1)
+	defer wg.Done()
+	cnpKey, quit := c.cnpQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.cnpQueue.Done(cnpKey)
+
+	err := c.syncClusterNetworkPolicy(cnpKey)
+	if err == nil {
+		c.cnpQueue.Forget(cnpKey)
+		return true
+	}
+	utilruntime.HandleError(fmt.Errorf("%v failed with: %v", cnpKey, err))
+
+	if c.cnpQueue.NumRequeues(cnpKey) < maxRetries {
+		c.cnpQueue.AddRateLimited(cnpKey)
+		return true
+	}
+
+	c.cnpQueue.Forget(cnpKey)
+	return true
+}
+
+// syncClusterNetworkPolicy decides the main logic everytime
+// we dequeue a key from the cnpQueue cache
+func (c *Controller) syncClusterNetworkPolicy(key string) error {
+	c.Lock()
+	defer c.Unlock()
+	startTime := time.Now()
+	_, cnpName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	klog.V(5).Infof("Processing sync for Cluster Network Policy %s", cnpName)
+
+	defer func() {
+		klog.V(5).Infof("Finished syncing Cluster Network Policy %s : %v", cnpName, time.Since(startTime))
+	}()
+
+	cnp, err := c.cnpLister.Get(cnpName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if cnp == nil {
+		// it was deleted; let's clear up all the related resources to that
+		err = c.clearClusterNetworkPolicy(cnpName)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+	// at this stage the CNP exists in the cluster
+	err = c.ensureClusterNetworkPolicy(cnp)
+	if err != nil {
+		// we can ignore the error if status update doesn't succeed; best effort
+		_ = c.updateCNPStatusToNotReady(cnp.Name, err.Error())
+		if errors.Is(err, ErrorANPPriorityUnsupported) || errors.Is(err, ErrorCNPDomainNamesUnsupported) {
+			// we don't want to retry for these specific errors since they
+			// need manual intervention from users to update their CRDs
+			return nil
+		}
+		return err
+	}
+	// we can ignore the error if status update doesn't succeed; best effort
+	_ = c.updateCNPStatusToReady(cnp.Name)
+	return nil
+}
+
+// ensureClusterNetworkPolicy will handle the main reconcile logic for any given cnp's
+// add/update that might be triggered either due to CNP changes or the corresponding
+// matching pod, namespace or node changes.
+// ClusterNetworkPolicies of the Admin tier are programmed in the same ACL tier as ANPs and
+// the ones of the Baseline tier in the same ACL tier as the BANP, so both APIs can be used
+// side by side while policies are migrated from the v1alpha1 APIs.
+func (c *Controller) ensureClusterNetworkPolicy(cnp *cnpapi.ClusterNetworkPolicy) error {
+	// Similar to ANPs we only support priority ranges 0-99 in OVN-K even if upstream
+	// supports upto 1000. The 0 (highest) corresponds to 30,000 in OVN world and
+	// 99 (lowest) corresponds to 20,100 in OVN world
+	if cnp.Spec.Priority > ovnkSupportedPriorityUpperBound {
+		c.eventRecorder.Eventf(&corev1.ObjectReference{
+			Kind: "ClusterNetworkPolicy",
+			Name: cnp.Name,
+		}, corev1.EventTypeWarning, ANPWithUnsupportedPriorityEvent, "This CNP %s has an unsupported priority %d; "+
+			"Please update the priority to a value between 0(highest priority) and 99(lowest priority)", cnp.Name, cnp.Spec.Priority)
+		return fmt.Errorf("error attempting to add CNP %s with priority %d because, "+
+			"%w", cnp.Name, cnp.Spec.Priority, ErrorANPPriorityUnsupported)
+	}
+	desiredCNPState, err := newClusterNetworkPolicyState(cnp)
+	if err != nil {
+		return err
+	}
+
+	// fetch the cnpState from our cache if it exists
+	currentCNPState, loaded := c.cnpCache[cnp.Name]
+	if loaded && currentCNPState.kind != desiredCNPState.kind {
+		// The tier decides which NBDB objects own this policy, so a tier change
+		// is handled by removing the old objects and creating the policy afresh.
+		klog.Infof("Cluster network policy %s moved from %s tier, recreating it", cnp.Name, currentCNPState.kind.cnpTier())
+		if err = c.clearClusterNetworkPolicy(cnp.Name); err != nil {
+			return err
+		}
+		currentCNPState, loaded = nil, false
+	}
+	// Based on the latest kapi CNP, namespace, pod and node objects:
+	// 1) Construct Port Group name using CNP name and ports of pods in CNP subject
+	// 2) Construct Address-sets with IPs of the peers in the rules
+	// 3) Construct ACLs using AS-es and PGs
+	portGroupName := c.getANPPortGroupName(desiredCNPState.name, desiredCNPState.kind)
+
+	desiredPorts, err := c.convertANPSubjectToLSPs(desiredCNPState)
+	if err != nil {
+		return fmt.Errorf("unable to fetch ports for cnp %s: %v", desiredCNPState.name, err)
+	}
+	err = c.expandANPRulePeers(desiredCNPState)
+	if err != nil {
+		return fmt.Errorf("unable to convert peers to addresses for cnp %s: %v", desiredCNPState.name, err)
+	}
+	atLeastOneRuleUpdated := false
+	desiredACLs := c.convertANPRulesToACLs(desiredCNPState, currentCNPState, portGroupName, &atLeastOneRuleUpdated, desiredCNPState.kind)
+
+	if !loaded {
+		// this is a fresh CNP create
+		klog.Infof("Creating cluster network policy %s/%s/%d", cnp.Name, cnp.Spec.Tier, cnp.Spec.Priority)
+		// 4) Create the PG/ACL/AS in same transact
+		// 5) Update the CNP caches to store all the created things if transact was successful
+		err = c.createNewANP(desiredCNPState, desiredACLs, desiredPorts, desiredCNPState.kind)
+		if err != nil {
+			return fmt.Errorf("failed to create CNP %s: %v", desiredCNPState.name, err)
+		}
+		c.warnOnDuplicateCNPPriority(desiredCNPState)
+		// since transact was successful we can finally populate the cache
+		c.cnpCache[cnp.Name] = desiredCNPState
+		metrics.IncrementCNPCount(string(cnp.Spec.Tier))
+		return nil
+	}
+	// CNP state existed in the cache, which means its either a CNP update or pod/namespace/node add/update/delete
+	klog.V(5).Infof("Cluster network policy %s/%d was found in cache...Syncing it", currentCNPState.name, currentCNPState.anpPriority)
+	hasPriorityChanged := (currentCNPState.anpPriority != desiredCNPState.anpPriority)
+	err = c.updateExistingANP(currentCNPState, desiredCNPState, atLeastOneRuleUpdated, hasPriorityChanged, desiredCNPState.kind, desiredACLs)
+	if err != nil {
+		return fmt.Errorf("failed to update CNP %s: %v", desiredCNPState.name, err)
+	}
+	if hasPriorityChanged {
+		c.warnOnDuplicateCNPPriority(desiredCNPState)
+	}
+	// since transact was successful we can finally replace the currentCNPState in the cache with the latest desired one
+	c.cnpCache[cnp.Name] = desiredCNPState
+	return nil
+}
+
+// warnOnDuplicateCNPPriority emits an event if another policy is programmed at the same ACL priority
+// in the same tier as the provided CNP. For the Admin tier this includes the ANPs since they share the
+// same priority range. Overlapping rules at the same priority lead to undefined behavior.
+func (c *Controller) warnOnDuplicateCNPPriority(cnp *adminNetworkPolicyState) {
+	existingName := ""
+	for name, other := range c.cnpCache {
+		if name != cnp.name && other.kind == cnp.kind && other.anpPriority == cnp.anpPriority {
+			existingName = "CNP " + name
+			break
+		}
+	}
+	if existingName == "" && cnp.kind == CNPAdminKind {
+		if name, loaded := c.anpPriorityMap[cnp.anpPriority]; loaded {
+			existingName = "ANP " + name
+		}
+	}
+	if existingName == "" {
+		return
+	}
+	klog.Warningf("Warning against attempting to add CNP %s with priority %d when at least one other policy %s, "+
+		"exists with the same priority", cnp.name, cnp.anpPriority, existingName)
+	c.eventRecorder.Eventf(&corev1.ObjectReference{
+		Kind: "ClusterNetworkPolicy",
+		Name: cnp.name,
+	}, corev1.EventTypeWarning, ANPWithDuplicatePriorityEvent, "This CNP %s has a conflicting priority with %s:"+
+		"Please verify your rules are non-lapping between all policies at same priority to avoid undefined behavior",
+		cnp.name, existingName)
+}
+
+// clearClusterNetworkPolicy will handle the logic for deleting all db objects related
+// to the provided cnp which got deleted.
+// uses externalIDs to figure out ownership
+func (c *Controller) clearClusterNetworkPolicy(cnpName string) error {
+	cnp, loaded := c.cnpCache[cnpName]
+	if !loaded {
+		// there is no existing CNP configured with this name, nothing to clean
+		klog.Infof("CNP %s not found in cache, nothing to clear", cnpName)
+		return nil
+	}
+
+	// clear NBDB objects for the given CNP (PG, ACLs on that PG, AddrSets used by the ACLs)
+	// remove PG for Subject (ACLs will get cleaned up automatically)
+	portGroupName := c.getANPPortGroupName(cnp.name, cnp.kind)
+	// no need to batch this with address-set deletes since this itself will contain a bunch of ACLs that need to be deleted which is heavy enough.
+	err := libovsdbops.DeletePortGroups(c.nbClient, portGroupName)
+	if err != nil {
+		return fmt.Errorf("unable to delete PG %s for CNP %s: %w", portGroupName, cnp.name, err)
+	}
+	// remove address-sets that were created for the peers of each rule for the whole CNP
+	// do this after ACLs are gone so that there is no lingering references
+	err = c.clearASForPeers(cnp.name, cnp.kind.addressSetIDsType())
+	if err != nil {
+		return fmt.Errorf("failed to delete address-sets for CNP %s/%d: %w", cnp.name, cnp.anpPriority, err)
+	}
+	// we can delete the object from the cache now.
+	delete(c.cnpCache, cnpName)
+	metrics.DecrementCNPCount(string(cnp.kind.cnpTier()))
+
+	return nil
+}
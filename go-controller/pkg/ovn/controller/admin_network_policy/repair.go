@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+	cnpapi "sigs.k8s.io/network-policy-api/apis/v1alpha2"
 
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
 
@@ -145,3 +146,63 @@ func (c *Controller) repairBaselineAdminNetworkPolicy() error {
 	}
 	return nil
 }
+
+// repairClusterNetworkPolicies is called at startup and as the name suggests
+// aims to repair the NBDB logical objects -> port groups, acls and address-sets
+// that are created for the cluster network policies in the cluster
+// Logic:
+// We fetch all the CNPs present in the cluster from the Lister
+// We fetch PGs and AddressSets that are owned by CNP objectIDs of either tier based on
+// externalIDs match from the NBDB. Using predicate search we check if
+// the relevant CNP still exists in that tier for these objects and if not we delete them
+func (c *Controller) repairClusterNetworkPolicies() error {
+	start := time.Now()
+	defer func() {
+		klog.Infof("Repairing cluster network policies took %v", time.Since(start))
+	}()
+	c.Lock()
+	defer c.Unlock()
+	cnps, err := c.cnpLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("unable to list CNPs from the lister, err: %v", err)
+	}
+	existingCNPs := map[string]*cnpapi.ClusterNetworkPolicy{}
+	for _, cnp := range cnps {
+		existingCNPs[cnp.Name] = cnp
+	}
+	// isStale returns true if the CNP that owns the object doesn't exist anymore or has moved to another tier
+	isStale := func(kind PolicyKind, externalIDs map[string]string) bool {
+		cnp, ok := existingCNPs[externalIDs[libovsdbops.ObjectNameKey.String()]]
+		return !ok || cnp.Spec.Tier != kind.cnpTier()
+	}
+
+	for _, kind := range []PolicyKind{CNPAdminKind, CNPBaselineKind} {
+		// Deal with PortGroup Repairs first - this will auto cleanup ACLs so no need to specifically delete ACLs
+		predicateIDs := libovsdbops.NewDbObjectIDs(kind.portGroupIDsType(), c.controllerName, nil)
+		p := libovsdbops.GetPredicate[*nbdb.PortGroup](predicateIDs, func(pg *nbdb.PortGroup) bool {
+			return isStale(kind, pg.ExternalIDs)
+		})
+		stalePGs, err := libovsdbops.FindPortGroupsWithPredicate(c.nbClient, p)
+		if err != nil {
+			return fmt.Errorf("unable to fetch port groups by predicate, err: %v", err)
+		}
+		if len(stalePGs) > 0 {
+			klog.Infof("Deleting Stale PortGroups +%v", stalePGs)
+			err = libovsdbops.DeletePortGroupsWithPredicate(c.nbClient, p)
+			if err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+				return fmt.Errorf("unable to delete stale port groups, err: %v", err)
+			}
+		}
+		// Deal with Address-Sets Repairs
+		// NOTE: When we call syncClusterNetworkPolicy function after this for every CNP on startup,
+		// the right Address-sets will be recreated.
+		asPredicateIDs := libovsdbops.NewDbObjectIDs(kind.addressSetIDsType(), c.controllerName, nil)
+		asPredicate := libovsdbops.GetPredicate[*nbdb.AddressSet](asPredicateIDs, func(as *nbdb.AddressSet) bool {
+			return isStale(kind, as.ExternalIDs)
+		})
+		if err := libovsdbops.DeleteAddressSetsWithPredicate(c.nbClient, asPredicate); err != nil {
+			return fmt.Errorf("failed to remove stale CNP address sets, err: %v", err)
+		}
+	}
+	return nil
+}
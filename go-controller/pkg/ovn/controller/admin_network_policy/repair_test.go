@@ -353,8 +353,15 @@ func TestBaselineAdminNetworkPolicyRepair(t *testing.T) {
 
 }
 
+func policyKind(banp bool) PolicyKind {
+	if banp {
+		return BANPKind
+	}
+	return ANPKind
+}
+
 func portGroup(name string, ports []*nbdb.LogicalSwitchPort, acls []*nbdb.ACL, banp bool) *nbdb.PortGroup {
-	pgDbIDs := GetANPPortGroupDbIDs(name, banp, "default-network-controller")
+	pgDbIDs := GetANPPortGroupDbIDs(name, policyKind(banp), "default-network-controller")
 	pg := libovsdbutil.BuildPortGroup(pgDbIDs, ports, acls)
 	pg.UUID = pgDbIDs.String() + "-UUID"
 	return pg
@@ -368,7 +375,7 @@ func stalePGWithoutExtIDs(name string, ports []*nbdb.LogicalSwitchPort, acls []*
 
 func accessControlList(name string, gressPrefix libovsdbutil.ACLDirection, priority int32, banp bool) *nbdb.ACL {
 	objIDs := getANPRuleACLDbIDs(name, string(gressPrefix), fmt.Sprintf("%d", priority), "None",
-		"default-network-controller", banp)
+		"default-network-controller", policyKind(banp))
 	acl := &nbdb.ACL{
 		UUID:        objIDs.String() + "-UUID",
 		Action:      nbdb.ACLActionAllow,
@@ -386,7 +393,7 @@ func accessControlList(name string, gressPrefix libovsdbutil.ACLDirection, prior
 
 func addressSet(name, gressPrefix string, priority int32, banp bool) *nbdb.AddressSet {
 	objIDs := GetANPPeerAddrSetDbIDs(name, gressPrefix, fmt.Sprintf("%d", priority),
-		"default-network-controller", banp)
+		"default-network-controller", policyKind(banp))
 	dbIDsWithIPFam := objIDs.AddIDs(map[libovsdbops.ExternalIDKey]string{libovsdbops.IPFamilyKey: "ipv4"})
 	as := &nbdb.AddressSet{
 		UUID:        dbIDsWithIPFam.String() + "-UUID",
@@ -10,8 +10,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	"k8s.io/klog/v2"
 	anpapiapply "sigs.k8s.io/network-policy-api/pkg/client/applyconfiguration/apis/v1alpha1"
+	cnpapiapply "sigs.k8s.io/network-policy-api/pkg/client/applyconfiguration/apis/v1alpha2"
 )
 
 // Defined status.type fields for Admin Network Policy - This is prefixed with the zone name thus
@@ -125,7 +127,7 @@ func (c *Controller) updateANPZoneStatusCondition(newCondition metav1.Condition,
 		newCondition = *existingCondition
 	}
 	applyObj := anpapiapply.AdminNetworkPolicy(anpName).
-		WithStatus(anpapiapply.AdminNetworkPolicyStatus().WithConditions(newCondition))
+		WithStatus(anpapiapply.AdminNetworkPolicyStatus().WithConditions(conditionApplyConfiguration(newCondition)))
 	_, err = c.anpClientSet.PolicyV1alpha1().AdminNetworkPolicies().
 		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.zone, Force: true})
 	if err == nil {
@@ -192,7 +194,7 @@ func (c *Controller) updateBANPZoneStatusCondition(newCondition metav1.Condition
 		newCondition = *existingCondition
 	}
 	applyObj := anpapiapply.BaselineAdminNetworkPolicy(banpName).
-		WithStatus(anpapiapply.BaselineAdminNetworkPolicyStatus().WithConditions(newCondition))
+		WithStatus(anpapiapply.BaselineAdminNetworkPolicyStatus().WithConditions(conditionApplyConfiguration(newCondition)))
 	_, err = c.anpClientSet.PolicyV1alpha1().BaselineAdminNetworkPolicies().
 		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.zone, Force: true})
 	if err == nil {
@@ -201,3 +203,83 @@ func (c *Controller) updateBANPZoneStatusCondition(newCondition metav1.Condition
 	}
 	return err
 }
+
+// updateCNPStatusToReady updates the status of the policy to reflect that it is ready
+// Each zone's ovnkube-controller will call this, hence let's update status using server-side-apply
+func (c *Controller) updateCNPStatusToReady(cnpName string) error {
+	readyCondition := metav1.Condition{
+		Type:    policyReadyStatusType + c.zone,
+		Status:  metav1.ConditionTrue,
+		Reason:  policyReadyReason,
+		Message: "Setting up OVN DB plumbing was successful",
+	}
+	err := c.updateCNPZoneStatusCondition(readyCondition, cnpName)
+	if err != nil {
+		return fmt.Errorf("unable to update the status of CNP %s, err: %v", cnpName, err)
+	}
+	return nil
+}
+
+// updateCNPStatusToNotReady updates the status of the policy to reflect that it is not ready
+// Each zone's ovnkube-controller will call this, hence let's update status using server-side-apply
+// status.message must be less than 32768 characters and is usually the error that occurred which is passed
+// to this function.
+func (c *Controller) updateCNPStatusToNotReady(cnpName, message string) error {
+	if len(message) >= 32767 { // max length of message can be 32768
+		message = message[:32766]
+	}
+	notReadyCondition := metav1.Condition{
+		Type:    policyReadyStatusType + c.zone,
+		Status:  metav1.ConditionFalse,
+		Reason:  policyNotReadyReason,
+		Message: message,
+	}
+	err := c.updateCNPZoneStatusCondition(notReadyCondition, cnpName)
+	if err != nil {
+		return fmt.Errorf("unable update the status of CNP %s, err: %v", cnpName, err)
+	}
+	return nil
+}
+
+func (c *Controller) updateCNPZoneStatusCondition(newCondition metav1.Condition, cnpName string) error {
+	cnp, err := c.cnpLister.Get(cnpName)
+	if err != nil {
+		return err
+	}
+	existingCondition := meta.FindStatusCondition(cnp.Status.Conditions, newCondition.Type)
+	if !doesStatusNeedAnUpdate(existingCondition, newCondition) {
+		// status is already in the desired state, skip the update to reduce API server load
+		return nil
+	}
+	if existingCondition == nil {
+		newCondition.LastTransitionTime = metav1.NewTime(time.Now())
+	} else {
+		if existingCondition.Status != newCondition.Status {
+			existingCondition.Status = newCondition.Status
+			existingCondition.LastTransitionTime = metav1.NewTime(time.Now())
+		}
+		existingCondition.Reason = newCondition.Reason
+		existingCondition.Message = newCondition.Message
+		newCondition = *existingCondition
+	}
+	applyObj := cnpapiapply.ClusterNetworkPolicy(cnpName).
+		WithStatus(cnpapiapply.ClusterNetworkPolicyStatus().WithConditions(conditionApplyConfiguration(newCondition)))
+	_, err = c.anpClientSet.PolicyV1alpha2().ClusterNetworkPolicies().
+		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.zone, Force: true})
+	if err == nil {
+		klog.V(5).Infof("Patched the status of CNP %s with condition type %s/%s, reason %s, message: %s",
+			cnpName, newCondition.Type, newCondition.Status, newCondition.Reason, newCondition.Message)
+	}
+	return err
+}
+
+// conditionApplyConfiguration converts the provided condition into its server-side-apply representation
+func conditionApplyConfiguration(condition metav1.Condition) *metaapplyv1.ConditionApplyConfiguration {
+	return metaapplyv1.Condition().
+		WithType(condition.Type).
+		WithStatus(condition.Status).
+		WithReason(condition.Reason).
+		WithMessage(condition.Message).
+		WithLastTransitionTime(condition.LastTransitionTime).
+		WithObservedGeneration(condition.ObservedGeneration)
+}
@@ -126,6 +126,7 @@ func newANPControllerWithDBSetup(dbSetup libovsdbtest.TestSetup, initANPs anpapi
 		fakeClient.ANPClient,
 		watcher.ANPInformer(),
 		watcher.BANPInformer(),
+		nil,
 		watcher.NamespaceCoreInformer(),
 		watcher.PodCoreInformer(),
 		watcher.NodeCoreInformer(),
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"net"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+	cnpapi "sigs.k8s.io/network-policy-api/apis/v1alpha2"
 
 	libovsdbutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	utilerrors "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util/errors"
@@ -27,6 +29,10 @@ const (
 	ANPMaxRulesPerObject            = 100
 	ovnkSupportedPriorityUpperBound = 99   // corresponds to 20100 ACL priority
 	BANPFlowPriority                = 1750 // down to 1651 (both inclusive, note that these ACLs will be in tier3)
+	// NOTE: ClusterNetworkPolicy uses the same priority range as ANP for both of its tiers.
+	// Admin tier CNPs share tier1 with ANPs, while Baseline tier CNPs are placed above the
+	// BANP range in tier3 which means that during migration they take precedence over the BANP.
+	CNPFlowStartPriority = ANPFlowStartPriority
 )
 
 type adminNetworkPolicySubject struct {
@@ -83,6 +89,8 @@ type gressRule struct {
 type adminNetworkPolicyState struct {
 	// name of the admin network policy (unique across cluster)
 	name string
+	// kind is the API (and tier) that this policy was created from
+	kind PolicyKind
 	// priority is anp.Spec.Priority (since BANP does not have priority, we hardcode to 0)
 	anpPriority int32
 	// priority is the OVN priority equivalent of anp.Spec.Priority
@@ -104,6 +112,7 @@ type adminNetworkPolicyState struct {
 func newAdminNetworkPolicyState(raw *anpapi.AdminNetworkPolicy) (*adminNetworkPolicyState, error) {
 	anp := &adminNetworkPolicyState{
 		name:         raw.Name,
+		kind:         ANPKind,
 		anpPriority:  raw.Spec.Priority,
 		ovnPriority:  (ANPFlowStartPriority - raw.Spec.Priority*ANPMaxRulesPerObject),
 		ingressRules: make([]*gressRule, 0),
@@ -362,6 +371,7 @@ func newAdminNetworkPolicyEgressRule(raw anpapi.AdminNetworkPolicyEgressRule, in
 func newBaselineAdminNetworkPolicyState(raw *anpapi.BaselineAdminNetworkPolicy) (*adminNetworkPolicyState, error) {
 	banp := &adminNetworkPolicyState{
 		name:         raw.Name,
+		kind:         BANPKind,
 		anpPriority:  0, // since BANP does not have priority, we hardcode to 0
 		ovnPriority:  BANPFlowPriority,
 		ingressRules: make([]*gressRule, 0),
@@ -451,7 +461,12 @@ func newBaselineAdminNetworkPolicyEgressRule(raw anpapi.BaselineAdminNetworkPoli
 		peerAddresses: sets.New[string](),
 	}
 	for _, peer := range raw.To {
-		banpPeer, err := newAdminNetworkPolicyEgressPeer(peer)
+		banpPeer, err := newAdminNetworkPolicyEgressPeer(anpapi.AdminNetworkPolicyEgressPeer{
+			Namespaces: peer.Namespaces,
+			Pods:       peer.Pods,
+			Nodes:      peer.Nodes,
+			Networks:   peer.Networks,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -478,3 +493,153 @@ func newBaselineAdminNetworkPolicyEgressRule(raw anpapi.BaselineAdminNetworkPoli
 	}
 	return banpRule, nil
 }
+
+// newClusterNetworkPolicyState takes the provided CNP API object and creates a new corresponding
+// adminNetworkPolicyState cache object for that API object.
+func newClusterNetworkPolicyState(raw *cnpapi.ClusterNetworkPolicy) (*adminNetworkPolicyState, error) {
+	cnp := &adminNetworkPolicyState{
+		name:         raw.Name,
+		kind:         CNPAdminKind,
+		anpPriority:  raw.Spec.Priority,
+		ovnPriority:  (CNPFlowStartPriority - raw.Spec.Priority*ANPMaxRulesPerObject),
+		ingressRules: make([]*gressRule, 0),
+		egressRules:  make([]*gressRule, 0),
+	}
+	if raw.Spec.Tier == cnpapi.BaselineTier {
+		cnp.kind = CNPBaselineKind
+	}
+	var err error
+	cnp.subject, err = newAdminNetworkPolicySubject(anpapi.AdminNetworkPolicySubject{
+		Namespaces: raw.Spec.Subject.Namespaces,
+		Pods:       (*anpapi.NamespacedPod)(raw.Spec.Subject.Pods),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for i, rule := range raw.Spec.Ingress {
+		cnpRule, err := newClusterNetworkPolicyIngressRule(rule, int32(i), cnp.ovnPriority-int32(i))
+		if err != nil {
+			err = fmt.Errorf("cannot create cnp ingress Rule %d in CNP %s: %w", i, raw.Name, err)
+			errs = append(errs, err)
+			continue
+		}
+		cnp.ingressRules = append(cnp.ingressRules, cnpRule)
+	}
+	for i, rule := range raw.Spec.Egress {
+		cnpRule, err := newClusterNetworkPolicyEgressRule(rule, int32(i), cnp.ovnPriority-int32(i))
+		if err != nil {
+			err = fmt.Errorf("cannot create cnp egress Rule %d in CNP %s: %w", i, raw.Name, err)
+			errs = append(errs, err)
+			continue
+		}
+		cnp.egressRules = append(cnp.egressRules, cnpRule)
+	}
+	cnp.aclLoggingParams, err = getACLLoggingLevelsForANP(raw.Annotations)
+	if err != nil {
+		err = fmt.Errorf("cannot parse CNP ACL logging annotation, disabling it for CNP %s: %w", raw.Name, err)
+		errs = append(errs, err)
+	}
+	klog.V(5).Infof("Logging parameters for CNP %s are Allow=%s/Deny=%s/Pass=%s", raw.Name,
+		cnp.aclLoggingParams.Allow, cnp.aclLoggingParams.Deny, cnp.aclLoggingParams.Pass)
+	return cnp, utilerrors.Join(errs...)
+}
+
+// newClusterNetworkPolicyProtocols takes the provided CNP API Protocols and creates the corresponding
+// port and named port cache objects for them.
+func newClusterNetworkPolicyProtocols(raw []cnpapi.ClusterNetworkPolicyProtocol) ([]*libovsdbutil.NetworkPolicyPort, map[string][]libovsdbutil.NamedNetworkPolicyPort) {
+	ports := make([]*libovsdbutil.NetworkPolicyPort, 0)
+	namedPorts := make(map[string][]libovsdbutil.NamedNetworkPolicyPort, 0)
+	for _, protocol := range raw {
+		switch {
+		case protocol.TCP != nil:
+			ports = append(ports, newClusterNetworkPolicyPort(corev1.ProtocolTCP, protocol.TCP.DestinationPort))
+		case protocol.UDP != nil:
+			ports = append(ports, newClusterNetworkPolicyPort(corev1.ProtocolUDP, protocol.UDP.DestinationPort))
+		case protocol.SCTP != nil:
+			ports = append(ports, newClusterNetworkPolicyPort(corev1.ProtocolSCTP, protocol.SCTP.DestinationPort))
+		case protocol.DestinationNamedPort != "":
+			namedPorts[protocol.DestinationNamedPort] = []libovsdbutil.NamedNetworkPolicyPort{}
+		}
+	}
+	return ports, namedPorts
+}
+
+// newClusterNetworkPolicyPort takes the provided CNP API Port for the given protocol and creates
+// a new corresponding port cache object for that Port. A nil port matches all ports of the protocol.
+func newClusterNetworkPolicyPort(protocol corev1.Protocol, raw *cnpapi.Port) *libovsdbutil.NetworkPolicyPort {
+	switch {
+	case raw == nil:
+		return libovsdbutil.GetNetworkPolicyPort(protocol, 0, 0)
+	case raw.Range != nil:
+		return libovsdbutil.GetNetworkPolicyPort(protocol, raw.Range.Start, raw.Range.End)
+	default:
+		return libovsdbutil.GetNetworkPolicyPort(protocol, raw.Number, 0)
+	}
+}
+
+// newClusterNetworkPolicyIngressRule takes the provided CNP API Ingress Rule and creates a new corresponding
+// gressRule cache object for that Rule.
+func newClusterNetworkPolicyIngressRule(raw cnpapi.ClusterNetworkPolicyIngressRule, index, priority int32) (*gressRule, error) {
+	cnpRule := &gressRule{
+		name:          raw.Name,
+		priority:      priority,
+		gressIndex:    index,
+		action:        GetACLActionForCNPRule(raw.Action),
+		gressPrefix:   string(libovsdbutil.ACLIngress),
+		peers:         make([]*adminNetworkPolicyPeer, 0),
+		peerAddresses: sets.New[string](),
+	}
+	for _, peer := range raw.From {
+		cnpPeer, err := newAdminNetworkPolicyPeer(peer.Namespaces, (*anpapi.NamespacedPod)(peer.Pods))
+		if err != nil {
+			return nil, err
+		}
+		cnpRule.peers = append(cnpRule.peers, cnpPeer)
+	}
+	cnpRule.ports, cnpRule.namedPorts = newClusterNetworkPolicyProtocols(raw.Protocols)
+	return cnpRule, nil
+}
+
+// newClusterNetworkPolicyEgressRule takes the provided CNP API Egress Rule and creates a new corresponding
+// gressRule cache object for that Rule.
+func newClusterNetworkPolicyEgressRule(raw cnpapi.ClusterNetworkPolicyEgressRule, index, priority int32) (*gressRule, error) {
+	cnpRule := &gressRule{
+		name:          raw.Name,
+		priority:      priority,
+		gressIndex:    index,
+		action:        GetACLActionForCNPRule(raw.Action),
+		gressPrefix:   string(libovsdbutil.ACLEgress),
+		peers:         make([]*adminNetworkPolicyPeer, 0),
+		peerAddresses: sets.New[string](),
+	}
+	for _, peer := range raw.To {
+		if len(peer.DomainNames) > 0 {
+			return nil, ErrorCNPDomainNamesUnsupported
+		}
+		networks := make([]anpapi.CIDR, 0, len(peer.Networks))
+		for _, cidr := range peer.Networks {
+			networks = append(networks, anpapi.CIDR(cidr))
+		}
+		cnpPeer, err := newAdminNetworkPolicyEgressPeer(anpapi.AdminNetworkPolicyEgressPeer{
+			Namespaces: peer.Namespaces,
+			Pods:       (*anpapi.NamespacedPod)(peer.Pods),
+			Nodes:      peer.Nodes,
+			Networks:   networks,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cnpRule.peers = append(cnpRule.peers, cnpPeer)
+		for _, cidr := range networks {
+			_, ipNet, err := net.ParseCIDR(string(cidr))
+			if err != nil {
+				return nil, err
+			}
+			cnpRule.peerAddresses.Insert(ipNet.String())
+		}
+	}
+	cnpRule.ports, cnpRule.namedPorts = newClusterNetworkPolicyProtocols(raw.Protocols)
+	return cnpRule, nil
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilnet "k8s.io/utils/net"
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+	cnpapi "sigs.k8s.io/network-policy-api/apis/v1alpha2"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
@@ -25,31 +26,103 @@ import (
 )
 
 var ErrorANPPriorityUnsupported = errors.New("OVNK only supports priority ranges 0-99")
+var ErrorCNPDomainNamesUnsupported = errors.New("OVNK does not support domainNames peers in ClusterNetworkPolicy")
 var ANPWithDuplicatePriorityEvent = "ANPWithDuplicatePriority"
 var ANPWithUnsupportedPriorityEvent = "ANPWithUnsupportedPriority"
 
-func GetANPPortGroupDbIDs(anpName string, isBanp bool, controller string) *libovsdbops.DbObjectIDs {
-	idsType := libovsdbops.PortGroupAdminNetworkPolicy
-	if isBanp {
-		idsType = libovsdbops.PortGroupBaselineAdminNetworkPolicy
+// PolicyKind identifies the policy API (and for ClusterNetworkPolicy, the tier) that owns
+// the port-group, address-sets and ACLs created by this controller
+type PolicyKind int
+
+const (
+	// ANPKind is an AdminNetworkPolicy
+	ANPKind PolicyKind = iota
+	// BANPKind is the BaselineAdminNetworkPolicy
+	BANPKind
+	// CNPAdminKind is a ClusterNetworkPolicy in the Admin tier
+	CNPAdminKind
+	// CNPBaselineKind is a ClusterNetworkPolicy in the Baseline tier
+	CNPBaselineKind
+)
+
+// String returns the short name of the policy kind used in logs and errors
+func (k PolicyKind) String() string {
+	switch k {
+	case BANPKind:
+		return "BANP"
+	case CNPAdminKind, CNPBaselineKind:
+		return "CNP"
+	default:
+		return "ANP"
+	}
+}
+
+func (k PolicyKind) portGroupIDsType() *libovsdbops.ObjectIDsType {
+	switch k {
+	case BANPKind:
+		return libovsdbops.PortGroupBaselineAdminNetworkPolicy
+	case CNPAdminKind:
+		return libovsdbops.PortGroupClusterNetworkPolicyAdmin
+	case CNPBaselineKind:
+		return libovsdbops.PortGroupClusterNetworkPolicyBaseline
+	default:
+		return libovsdbops.PortGroupAdminNetworkPolicy
+	}
+}
+
+func (k PolicyKind) aclIDsType() *libovsdbops.ObjectIDsType {
+	switch k {
+	case BANPKind:
+		return libovsdbops.ACLBaselineAdminNetworkPolicy
+	case CNPAdminKind:
+		return libovsdbops.ACLClusterNetworkPolicyAdmin
+	case CNPBaselineKind:
+		return libovsdbops.ACLClusterNetworkPolicyBaseline
+	default:
+		return libovsdbops.ACLAdminNetworkPolicy
 	}
-	return libovsdbops.NewDbObjectIDs(idsType, controller,
+}
+
+func (k PolicyKind) addressSetIDsType() *libovsdbops.ObjectIDsType {
+	switch k {
+	case BANPKind:
+		return libovsdbops.AddressSetBaselineAdminNetworkPolicy
+	case CNPAdminKind:
+		return libovsdbops.AddressSetClusterNetworkPolicyAdmin
+	case CNPBaselineKind:
+		return libovsdbops.AddressSetClusterNetworkPolicyBaseline
+	default:
+		return libovsdbops.AddressSetAdminNetworkPolicy
+	}
+}
+
+// cnpTier returns the ClusterNetworkPolicy tier that corresponds to this policy kind
+func (k PolicyKind) cnpTier() cnpapi.Tier {
+	if k == CNPBaselineKind {
+		return cnpapi.BaselineTier
+	}
+	return cnpapi.AdminTier
+}
+
+// supportsPass returns true if rules of this policy kind can carry the Pass action
+func (k PolicyKind) supportsPass() bool {
+	return k != BANPKind
+}
+
+func GetANPPortGroupDbIDs(anpName string, kind PolicyKind, controller string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(kind.portGroupIDsType(), controller,
 		map[libovsdbops.ExternalIDKey]string{
 			libovsdbops.ObjectNameKey: anpName,
 		})
 }
 
-func (c *Controller) getANPPortGroupName(anpName string, isBanp bool) string {
-	return libovsdbutil.GetPortGroupName(GetANPPortGroupDbIDs(anpName, isBanp, c.controllerName))
+func (c *Controller) getANPPortGroupName(anpName string, kind PolicyKind) string {
+	return libovsdbutil.GetPortGroupName(GetANPPortGroupDbIDs(anpName, kind, c.controllerName))
 }
 
 // getANPRuleACLDbIDs will return the dbObjectIDs for a given rule's ACLs
-func getANPRuleACLDbIDs(name, gressPrefix, gressIndex, protocol, controller string, isBanp bool) *libovsdbops.DbObjectIDs {
-	idType := libovsdbops.ACLAdminNetworkPolicy
-	if isBanp {
-		idType = libovsdbops.ACLBaselineAdminNetworkPolicy
-	}
-	return libovsdbops.NewDbObjectIDs(idType, controller, map[libovsdbops.ExternalIDKey]string{
+func getANPRuleACLDbIDs(name, gressPrefix, gressIndex, protocol, controller string, kind PolicyKind) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(kind.aclIDsType(), controller, map[libovsdbops.ExternalIDKey]string{
 		libovsdbops.ObjectNameKey:      name,
 		libovsdbops.PolicyDirectionKey: gressPrefix,
 		// gressidx is the unique id for address set within given objectName and gressPrefix
@@ -75,6 +148,22 @@ func GetACLActionForANPRule(action anpapi.AdminNetworkPolicyRuleAction) string {
 	return ovnACLAction
 }
 
+// GetACLActionForCNPRule returns the corresponding OVN ACL action for a given CNP rule action
+func GetACLActionForCNPRule(action cnpapi.ClusterNetworkPolicyRuleAction) string {
+	var ovnACLAction string
+	switch action {
+	case cnpapi.ClusterNetworkPolicyRuleActionAccept:
+		ovnACLAction = nbdb.ACLActionAllowRelated
+	case cnpapi.ClusterNetworkPolicyRuleActionDeny:
+		ovnACLAction = nbdb.ACLActionDrop
+	case cnpapi.ClusterNetworkPolicyRuleActionPass:
+		ovnACLAction = nbdb.ACLActionPass
+	default:
+		panic(fmt.Sprintf("Failed to build CNP ACL: unknown acl action %s", action))
+	}
+	return ovnACLAction
+}
+
 // GetACLActionForBANPRule returns the corresponding OVN ACL action for a given BANP rule action
 func GetACLActionForBANPRule(action anpapi.BaselineAdminNetworkPolicyRuleAction) string {
 	var ovnACLAction string
@@ -90,12 +179,8 @@ func GetACLActionForBANPRule(action anpapi.BaselineAdminNetworkPolicyRuleAction)
 }
 
 // GetANPPeerAddrSetDbIDs will return the dbObjectIDs for a given rule's address-set
-func GetANPPeerAddrSetDbIDs(name, gressPrefix, gressIndex, controller string, isBanp bool) *libovsdbops.DbObjectIDs {
-	idType := libovsdbops.AddressSetAdminNetworkPolicy
-	if isBanp {
-		idType = libovsdbops.AddressSetBaselineAdminNetworkPolicy
-	}
-	return libovsdbops.NewDbObjectIDs(idType, controller, map[libovsdbops.ExternalIDKey]string{
+func GetANPPeerAddrSetDbIDs(name, gressPrefix, gressIndex, controller string, kind PolicyKind) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(kind.addressSetIDsType(), controller, map[libovsdbops.ExternalIDKey]string{
 		libovsdbops.ObjectNameKey:      name,
 		libovsdbops.PolicyDirectionKey: gressPrefix,
 		// gressidx is the unique id for address set within given objectName and gressPrefix
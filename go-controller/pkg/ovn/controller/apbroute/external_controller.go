@@ -115,6 +115,27 @@ func (e *ExternalGatewayRouteInfoCache) Cleanup(podName ktypes.NamespacedName, f
 	})
 }
 
+// getGatewayRouters returns the gateway routers that the external routes of the pod are configured on, by gateway IP.
+func (e *ExternalGatewayRouteInfoCache) getGatewayRouters(podName ktypes.NamespacedName) map[string]sets.Set[string] {
+	gatewayRouters := map[string]sets.Set[string]{}
+	_ = e.routeInfos.DoWithLock(podName, func(key ktypes.NamespacedName) error {
+		routeInfo, loaded := e.routeInfos.Load(key)
+		if !loaded {
+			return nil
+		}
+		for _, routes := range routeInfo.PodExternalRoutes {
+			for gw, gr := range routes {
+				if gatewayRouters[gw] == nil {
+					gatewayRouters[gw] = sets.New[string]()
+				}
+				gatewayRouters[gw].Insert(gr)
+			}
+		}
+		return nil
+	})
+	return gatewayRouters
+}
+
 // CleanupNamespace wraps the cleanup call for all the pods in a given namespace.
 // The routeInfo reference for each pod in the given namespace is processed by the `f` function inside the `Cleanup` function
 func (e *ExternalGatewayRouteInfoCache) CleanupNamespace(nsName string, f func(routeInfo *RouteInfo) error) error {
@@ -758,6 +779,43 @@ func (m *externalPolicyManager) getAllRoutePolicies() ([]*adminpolicybasedroutea
 	return routePolicies, nil
 }
 
+// getBFDGatewayIPsByPod returns the gateway IPs with BFD enabled that are configured for every target pod of the policy.
+func (m *externalPolicyManager) getBFDGatewayIPsByPod(policyName string) map[ktypes.NamespacedName]sets.Set[string] {
+	bfdGWIPs := map[ktypes.NamespacedName]sets.Set[string]{}
+	_ = m.routePolicySyncCache.DoWithLock(policyName, func(policyName string) error {
+		policyState, found := m.routePolicySyncCache.Load(policyName)
+		if !found {
+			return nil
+		}
+		for _, targetPods := range policyState.targetNamespaces {
+			for podName, podConfig := range targetPods {
+				for _, gwList := range []*gateway_info.GatewayInfoList{podConfig.StaticGateways, podConfig.DynamicGateways} {
+					for _, gw := range gwList.Elems() {
+						if !gw.BFDEnabled {
+							continue
+						}
+						if bfdGWIPs[podName] == nil {
+							bfdGWIPs[podName] = sets.New[string]()
+						}
+						insertSet(bfdGWIPs[podName], gw.Gateways)
+					}
+				}
+			}
+		}
+		return nil
+	})
+	return bfdGWIPs
+}
+
+// onBFDStatusUpdate requeues the policies so that their status reports the latest state of the BFD sessions.
+// BFD sessions may be shared by several policies and state changes are rare, so all the policies are requeued.
+// Policy syncs are idempotent and don't change the northbound DB when the policy didn't change.
+func (m *externalPolicyManager) onBFDStatusUpdate() {
+	for _, policyName := range m.routePolicySyncCache.GetKeys() {
+		m.routeQueue.Add(policyName)
+	}
+}
+
 // getDynamicGatewayIPsForTargetNamespace is called by the annotation logic to identify if a namespace is managed by an CR.
 // Since the call can occur outside the lifecycle of the controller, it cannot rely on the namespace info cache object to have been populated.
 // Therefore it has to go through all policies until it identifies one that targets the namespace and retrieve the gateway IPs.
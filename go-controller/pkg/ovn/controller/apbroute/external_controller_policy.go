@@ -255,7 +255,7 @@ func (m *externalPolicyManager) processStaticHopsGatewayInformation(hops []*admi
 		if ip == nil {
 			return nil, fmt.Errorf("could not parse routing static gw annotation value '%s'", h.IP)
 		}
-		gwList.InsertOverwrite(gateway_info.NewGatewayInfo(sets.New(ip.String()), h.BFDEnabled))
+		gwList.InsertOverwrite(newGatewayInfo(sets.New(ip.String()), h.BFDEnabled, h.BFD))
 	}
 	return gwList, nil
 }
@@ -295,7 +295,7 @@ func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adm
 					continue
 				}
 				key := ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
-				podsInfo.InsertOverwrite(gateway_info.NewGatewayInfo(foundGws, h.BFDEnabled))
+				podsInfo.InsertOverwrite(newGatewayInfo(foundGws, h.BFDEnabled, h.BFD))
 				selectedPods.Insert(key)
 			}
 			selectedNamespaces.Insert(gwNamespace.Name)
@@ -304,6 +304,24 @@ func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adm
 	return podsInfo, selectedNamespaces, selectedPods, nil
 }
 
+// newGatewayInfo returns the GatewayInfo of a hop with the given gateway IPs and BFD configuration.
+func newGatewayInfo(gws sets.Set[string], bfdEnabled bool, bfd *adminpolicybasedrouteapi.BFDConfig) *gateway_info.GatewayInfo {
+	if !bfdEnabled || bfd == nil {
+		return gateway_info.NewGatewayInfo(gws, bfdEnabled)
+	}
+	bfdConfig := gateway_info.BFDConfig{}
+	if bfd.MinTx != nil {
+		bfdConfig.MinTx = int(*bfd.MinTx)
+	}
+	if bfd.MinRx != nil {
+		bfdConfig.MinRx = int(*bfd.MinRx)
+	}
+	if bfd.DetectMult != nil {
+		bfdConfig.DetectMult = int(*bfd.DetectMult)
+	}
+	return gateway_info.NewGatewayInfoWithBFDConfig(gws, bfdConfig)
+}
+
 // getPolicyConfigAndUpdatePolicyRefs lists and updates all referenced objects for a given policy and returns
 // routePolicyConfig to perform an update.
 // This function should be the only one that lists referenced objects, and updates policyReferencedObjects atomically.
@@ -150,32 +150,45 @@ func (g *GatewayInfoList) Equal(g2 *GatewayInfoList) bool {
 	return true
 }
 
+// BFDConfig contains the BFD session timers of the gateways, zero values use the OVN defaults.
+type BFDConfig struct {
+	MinTx      int
+	MinRx      int
+	DetectMult int
+}
+
 type GatewayInfo struct {
 	Gateways      sets.Set[string]
 	BFDEnabled    bool
+	BFDConfig     BFDConfig
 	failedToApply bool
 }
 
 func (g *GatewayInfo) String() string {
-	return fmt.Sprintf("BFDEnabled: %t, Gateways: %+v, failedToApply: %t", g.BFDEnabled, g.Gateways, g.failedToApply)
+	return fmt.Sprintf("BFDEnabled: %t, BFDConfig: %+v, Gateways: %+v, failedToApply: %t", g.BFDEnabled, g.BFDConfig, g.Gateways, g.failedToApply)
 }
 
 func NewGatewayInfo(items sets.Set[string], bfdEnabled bool) *GatewayInfo {
 	return &GatewayInfo{Gateways: items, BFDEnabled: bfdEnabled}
 }
 
+// NewGatewayInfoWithBFDConfig returns a GatewayInfo with BFD enabled and the given BFD session timers.
+func NewGatewayInfoWithBFDConfig(items sets.Set[string], bfdConfig BFDConfig) *GatewayInfo {
+	return &GatewayInfo{Gateways: items, BFDEnabled: true, BFDConfig: bfdConfig}
+}
+
 // SameSpec compares GatewayInfo fields, excluding applied
 func (g *GatewayInfo) SameSpec(g2 *GatewayInfo) bool {
-	return g.BFDEnabled == g2.BFDEnabled && g.Gateways.Equal(g2.Gateways)
+	return g.BFDEnabled == g2.BFDEnabled && g.BFDConfig == g2.BFDConfig && g.Gateways.Equal(g2.Gateways)
 }
 
 func (g *GatewayInfo) RemoveIPs(g2 *GatewayInfo) {
 	g.Gateways = g.Gateways.Difference(g2.Gateways)
 }
 
-// Equal compares all GatewayInfo fields, including BFDEnabled and applied
+// Equal compares all GatewayInfo fields, including BFDEnabled, BFDConfig and applied
 func (g *GatewayInfo) Equal(g2 *GatewayInfo) bool {
-	return g.BFDEnabled == g2.BFDEnabled && g.Gateways.Equal(g2.Gateways) && g.failedToApply == g2.failedToApply
+	return g.SameSpec(g2) && g.failedToApply == g2.failedToApply
 }
 
 func (g *GatewayInfo) Has(ip string) bool {
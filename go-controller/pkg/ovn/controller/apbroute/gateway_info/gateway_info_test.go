@@ -29,6 +29,15 @@ var _ = Describe("GatewayInfoList", func() {
 				NewGatewayInfo(sets.New("1.1.1.1"), true)))).To(BeTrue())
 		})
 
+		It("InsertOverwrite adds a new element in the slice when the duplicated value found during insertion has different BFD timers", func() {
+			s1 := NewGatewayInfoList(NewGatewayInfo(sets.New("1.1.1.1"), true))
+			s1.InsertOverwrite(NewGatewayInfoWithBFDConfig(sets.New("1.1.1.1"), BFDConfig{MinTx: 100, MinRx: 100, DetectMult: 3}))
+			Expect(s1.Equal(NewGatewayInfoList(
+				NewGatewayInfo(sets.New("1.1.1.1"), true)))).To(BeFalse())
+			Expect(s1.Equal(NewGatewayInfoList(
+				NewGatewayInfoWithBFDConfig(sets.New("1.1.1.1"), BFDConfig{MinTx: 100, MinRx: 100, DetectMult: 3})))).To(BeTrue())
+		})
+
 		It("InsertOverwrite adds an empty element in the slice and returns no changes in the list and no duplicates", func() {
 			s1 := NewGatewayInfoList(NewGatewayInfo(sets.New("1.1.1.1"), false))
 			s1.InsertOverwrite(NewGatewayInfo(sets.Set[string]{}, false))
@@ -4,9 +4,11 @@
 package apbroute
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 
@@ -16,14 +18,18 @@ import (
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
+	libovsdbcache "github.com/ovn-kubernetes/libovsdb/cache"
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/model"
 
 	adminpolicybasedrouteapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	adminpolicybasedrouteapply "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/applyconfiguration/adminpolicybasedroute/v1"
 	adminpolicybasedrouteclient "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned"
 	adminpolicybasedrouteinformer "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/informers/externalversions/adminpolicybasedroute/v1"
 	libovsdbutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
@@ -90,6 +96,20 @@ func NewExternalMasterController(
 func (c *ExternalGatewayMasterController) Run(wg *sync.WaitGroup, threadiness int) error {
 	klog.V(4).Info("Starting Admin Policy Based Route Controller")
 
+	// report BFD session state changes in the policies status
+	c.nbClient.nbClient.Cache().AddEventHandler(&libovsdbcache.EventHandlerFuncs{
+		UpdateFunc: func(table string, oldModel, newModel model.Model) {
+			if table != nbdb.BFDTable {
+				return
+			}
+			oldBFD, newBFD := oldModel.(*nbdb.BFD), newModel.(*nbdb.BFD)
+			if ptr.Equal(oldBFD.Status, newBFD.Status) {
+				return
+			}
+			c.mgr.onBFDStatusUpdate()
+		},
+	})
+
 	return c.mgr.Run(wg, threadiness)
 }
 
@@ -129,6 +149,10 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 		newMsg = fmt.Sprintf("%s %s: %v", c.zoneID, types.APBRouteErrorMsg, syncError.Error())
 	}
 	newMsg = types.GetZoneStatus(c.zoneID, newMsg)
+	nextHops, err := c.getNextHopStatuses(policyName)
+	if err != nil {
+		return err
+	}
 	needsUpdate := true
 	for _, message := range routePolicy.Status.Messages {
 		if message == newMsg {
@@ -137,6 +161,16 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 			break
 		}
 	}
+	if !needsUpdate {
+		// next hops of the local zone nodes are owned by the current zone
+		var existingNextHops []adminpolicybasedrouteapi.NextHopStatus
+		for _, nextHop := range routePolicy.Status.NextHops {
+			if c.isNodeInLocalZone(nextHop.Node) {
+				existingNextHops = append(existingNextHops, nextHop)
+			}
+		}
+		needsUpdate = !slices.Equal(existingNextHops, nextHops)
+	}
 	if !needsUpdate {
 		return nil
 	}
@@ -145,10 +179,20 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 		Force:        true,
 		FieldManager: c.zoneID,
 	}
+	applyStatus := adminpolicybasedrouteapply.AdminPolicyBasedRouteStatus().
+		WithMessages(newMsg).
+		WithLastTransitionTime(metav1.Now())
+	for _, nextHop := range nextHops {
+		nextHopStatus := adminpolicybasedrouteapply.NextHopStatus().
+			WithIP(nextHop.IP).
+			WithNode(nextHop.Node)
+		if nextHop.BFDStatus != "" {
+			nextHopStatus.WithBFDStatus(nextHop.BFDStatus)
+		}
+		applyStatus.WithNextHops(nextHopStatus)
+	}
 	applyObj := adminpolicybasedrouteapply.AdminPolicyBasedExternalRoute(policyName).
-		WithStatus(adminpolicybasedrouteapply.AdminPolicyBasedRouteStatus().
-			WithMessages(newMsg).
-			WithLastTransitionTime(metav1.Now()))
+		WithStatus(applyStatus)
 	_, err = c.apbRoutePolicyClient.K8sV1().AdminPolicyBasedExternalRoutes().ApplyStatus(context.TODO(), applyObj, applyOptions)
 
 	if err != nil {
@@ -157,6 +201,54 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 	return nil
 }
 
+// getNextHopStatuses returns the state of the BFD sessions from the local zone gateway routers to the policy next hops
+// that have BFD enabled, sorted by next hop IP and node.
+func (c *ExternalGatewayMasterController) getNextHopStatuses(policyName string) ([]adminpolicybasedrouteapi.NextHopStatus, error) {
+	// gateway routers of the BFD sessions, by next hop IP
+	bfdSessions := map[string]sets.Set[string]{}
+	for podName, bfdGWIPs := range c.mgr.getBFDGatewayIPsByPod(policyName) {
+		for gwIP, gatewayRouters := range c.ExternalGWRouteInfoCache.getGatewayRouters(podName) {
+			if !bfdGWIPs.Has(gwIP) {
+				continue
+			}
+			if bfdSessions[gwIP] == nil {
+				bfdSessions[gwIP] = sets.New[string]()
+			}
+			bfdSessions[gwIP] = bfdSessions[gwIP].Union(gatewayRouters)
+		}
+	}
+	var nextHops []adminpolicybasedrouteapi.NextHopStatus
+	for gwIP, gatewayRouters := range bfdSessions {
+		for gatewayRouter := range gatewayRouters {
+			status, found, err := c.nbClient.getBFDStatus(gwIP, gatewayRouter)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				continue
+			}
+			nextHops = append(nextHops, adminpolicybasedrouteapi.NextHopStatus{
+				IP:        gwIP,
+				Node:      util.GetWorkerFromGatewayRouter(gatewayRouter),
+				BFDStatus: adminpolicybasedrouteapi.BFDStatus(status),
+			})
+		}
+	}
+	slices.SortFunc(nextHops, func(a, b adminpolicybasedrouteapi.NextHopStatus) int {
+		return cmp.Or(cmp.Compare(a.IP, b.IP), cmp.Compare(a.Node, b.Node))
+	})
+	return nextHops, nil
+}
+
+// isNodeInLocalZone returns whether the node is in the zone of the controller.
+func (c *ExternalGatewayMasterController) isNodeInLocalZone(nodeName string) bool {
+	node, err := c.nbClient.nodeLister.Get(nodeName)
+	if err != nil {
+		return false
+	}
+	return util.GetNodeZone(node) == c.nbClient.zone
+}
+
 func (c *ExternalGatewayMasterController) GetDynamicGatewayIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
 	return c.mgr.getDynamicGatewayIPsForTargetNamespace(namespaceName)
 }
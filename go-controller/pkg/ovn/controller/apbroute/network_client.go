@@ -4,6 +4,7 @@
 package apbroute
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"regexp"
@@ -273,7 +274,7 @@ func (nb *northBoundClient) addGWRoutesForPod(gateways []*gateway_info.GatewayIn
 						continue
 					}
 					mask := util.GetIPFullMaskString(podIP)
-					if err := nb.createOrUpdateBFDStaticRoute(gateway.BFDEnabled, gw, podIP, gr, port, mask); err != nil {
+					if err := nb.createOrUpdateBFDStaticRoute(gateway, gw, podIP, gr, port, mask); err != nil {
 						return err
 					}
 					if routeInfo.PodExternalRoutes[podIP] == nil {
@@ -372,7 +373,7 @@ func (nb *northBoundClient) addHybridRoutePolicyForPod(podIP net.IP, node string
 	return nil
 }
 
-func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw string, podIP, gr, port, mask string) error {
+func (nb *northBoundClient) createOrUpdateBFDStaticRoute(gateway *gateway_info.GatewayInfo, gw string, podIP, gr, port, mask string) error {
 	lrsr := nbdb.LogicalRouterStaticRoute{
 		Policy: &nbdb.LogicalRouterStaticRoutePolicySrcIP,
 		Options: map[string]string{
@@ -385,10 +386,13 @@ func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw str
 
 	ops := []ovsdb.Operation{}
 	var err error
-	if bfdEnabled {
+	if gateway.BFDEnabled {
 		bfd := nbdb.BFD{
 			DstIP:       gw,
 			LogicalPort: port,
+			MinTx:       bfdTimer(gateway.BFDConfig.MinTx),
+			MinRx:       bfdTimer(gateway.BFDConfig.MinRx),
+			DetectMult:  bfdTimer(gateway.BFDConfig.DetectMult),
 		}
 		ops, err = libovsdbops.CreateOrUpdateBFDOps(nb.nbClient, ops, &bfd)
 		if err != nil {
@@ -418,7 +422,15 @@ func (nb *northBoundClient) createOrUpdateBFDStaticRoute(bfdEnabled bool, gw str
 	return nil
 }
 
-func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP, gwIP, nodeName, gr string, bfdEnabled bool, namespacedName ktypes.NamespacedName) error {
+// bfdTimer returns the value of a BFD timer column, nil if the timer is unset so that OVN uses its default.
+func bfdTimer(value int) *int {
+	if value == 0 {
+		return nil
+	}
+	return &value
+}
+
+func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP, gwIP, nodeName, gr string, gateway *gateway_info.GatewayInfo, namespacedName ktypes.NamespacedName) error {
 	return nb.externalGatewayRouteInfo.CreateOrLoad(namespacedName, func(routeInfo *RouteInfo) error {
 		// if route was already programmed, skip it
 		if foundGR, ok := routeInfo.PodExternalRoutes[podIP][gwIP]; ok && foundGR == gr {
@@ -431,10 +443,10 @@ func (nb *northBoundClient) updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP
 			klog.Warningf("Failed to find ext switch prefix for %s %v", nodeName, err)
 			return err
 		}
-		if bfdEnabled {
+		if gateway.BFDEnabled {
 			port := portPrefix + types.GWRouterToExtSwitchPrefix + gr
 			// update the BFD static route just in case it has changed
-			if err := nb.createOrUpdateBFDStaticRoute(bfdEnabled, gwIP, podIP, gr, port, mask); err != nil {
+			if err := nb.createOrUpdateBFDStaticRoute(gateway, gwIP, podIP, gr, port, mask); err != nil {
 				return err
 			}
 		} else {
@@ -638,6 +650,30 @@ func (nb *northBoundClient) lookupBFDEntry(gatewayIP, gatewayRouter, prefix stri
 	return found, nil
 }
 
+// getBFDStatus returns the state of the BFD session to the gateway IP from the gateway router, empty if OVN didn't
+// report it yet. It returns false if there is no BFD session.
+func (nb *northBoundClient) getBFDStatus(gatewayIP, gatewayRouter string) (string, bool, error) {
+	prefix, err := nb.extSwitchPrefix(util.GetWorkerFromGatewayRouter(gatewayRouter))
+	if err != nil {
+		return "", false, err
+	}
+	bfd := &nbdb.BFD{
+		LogicalPort: prefix + types.GWRouterToExtSwitchPrefix + gatewayRouter,
+		DstIP:       gatewayIP,
+	}
+	bfd, err = libovsdbops.LookupBFD(nb.nbClient, bfd)
+	if err != nil {
+		if errors.Is(err, libovsdbclient.ErrNotFound) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to lookup BFD for gateway IP %s and gateway router %s: %w", gatewayIP, gatewayRouter, err)
+	}
+	if bfd.Status == nil {
+		return "", true, nil
+	}
+	return *bfd.Status, true, nil
+}
+
 // buildPodSNAT builds per pod SNAT rules towards the nodeIP that are applied to the GR where the pod resides
 // if allSNATs flag is set, then all the SNATs (including against egressIPs if any) for that pod will be returned
 func buildPodSNAT(extIPs, podIPNets []*net.IPNet) ([]*nbdb.NAT, error) {
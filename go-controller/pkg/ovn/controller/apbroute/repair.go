@@ -260,7 +260,7 @@ func (c *ExternalGatewayMasterController) processOVNRoute(ovnRoute *ovnRoute, gw
 					return true
 				}
 				err := c.nbClient.updateExternalGWInfoCacheForPodIPWithGatewayIP(podIP, ovnRoute.nextHop, managedIPGWInfo.nodeName,
-					util.GetGatewayRouterFromNode(managedIPGWInfo.nodeName), gwInfo.BFDEnabled, managedIPGWInfo.namespacedName)
+					util.GetGatewayRouterFromNode(managedIPGWInfo.nodeName), gwInfo, managedIPGWInfo.namespacedName)
 				if err == nil {
 					return true
 				}
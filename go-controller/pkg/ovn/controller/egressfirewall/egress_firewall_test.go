@@ -17,7 +17,9 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 	anpfake "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
 
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
@@ -32,6 +34,7 @@ import (
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/kube"
 	fakenetworkmanager "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
+	addressset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	libovsdbtest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
@@ -75,6 +78,31 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 					util.OVNNodeHostCIDRs: fmt.Sprintf("[\"%s/24\"]", node2Addr),
 				},
 			}}
+		namespaceLabel = map[string]string{"team": "a"}
+		podLabel       = map[string]string{"app": "db"}
+		namespace1     = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "namespace1",
+				Labels: namespaceLabel,
+			}}
+		pod1 = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod1",
+				Namespace: namespace1.Name,
+				Labels:    podLabel,
+				Annotations: map[string]string{
+					types.OvnPodAnnotationName: `{"default":{"ip_addresses":["10.128.0.5/24"],"mac_address":"0a:58:0a:80:00:05","role":"primary"},` +
+						`"namespace1/localnet":{"ip_addresses":["192.168.10.5/24"],"mac_address":"0a:58:c0:a8:0a:05","role":"secondary"}}`,
+				},
+			}}
+		pod2 = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pod2",
+				Namespace: namespace1.Name,
+				Annotations: map[string]string{
+					types.OvnPodAnnotationName: `{"namespace1/localnet":{"ip_addresses":["192.168.10.6/24"],"mac_address":"0a:58:c0:a8:0a:06","role":"secondary"}}`,
+				},
+			}}
 	)
 
 	ginkgo.BeforeEach(func() {
@@ -82,7 +110,7 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
 		config.Gateway.Mode = config.GatewayModeShared
 		config.OVNKubernetesFeature.EnableEgressFirewall = true
-		fakeClient = fake.NewSimpleClientset(node1, node2)
+		fakeClient = fake.NewSimpleClientset(node1, node2, namespace1, pod1, pod2)
 		app = cli.NewApp()
 		app.Name = "test"
 		app.Flags = config.Flags
@@ -100,8 +128,9 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 		nbClient, _, nbsbCleanup, err = libovsdbtest.NewNBSBTestHarness(initialDB)
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		networkManager := &fakenetworkmanager.FakeNetworkManager{}
-		efController, err = NewEFController("test", "global", kubeInterface, nbClient, iFactory.NamespaceInformer().Lister(),
-			iFactory.NodeCoreInformer(), iFactory.EgressFirewallInformer(), networkManager, nil, nil)
+		efController, err = NewEFController("test", "global", kubeInterface, nbClient, addressset.NewFakeAddressSetFactory("test"),
+			iFactory.NamespaceInformer(), iFactory.NodeCoreInformer(), iFactory.PodCoreInformer(), iFactory.EgressFirewallInformer(),
+			networkManager, nil, nil)
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		err = iFactory.Start()
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
@@ -162,6 +191,26 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 				},
 				expectedMatch: "((udp && ( udp.dst == 400 )) || (tcp && ( tcp.dst == 100 || tcp.dst == 102 )) || (sctp && ( sctp.dst == 13 )))",
 			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{
+						Protocol: "TCP",
+						Port:     100,
+						EndPort:  ptr.To[int32](200),
+					},
+					{
+						Protocol: "TCP",
+						Port:     8080,
+						EndPort:  ptr.To[int32](8080),
+					},
+					{
+						Protocol: "UDP",
+						Port:     32768,
+						EndPort:  ptr.To[int32](60999),
+					},
+				},
+				expectedMatch: "((udp && ( 32768<=udp.dst<=60999 )) || (tcp && ( 100<=tcp.dst<=200 || tcp.dst == 8080 )))",
+			},
 		}
 		for _, test := range testcases {
 			l4Match := egressGetL4Match(test.ports)
@@ -289,9 +338,13 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 			output             egressFirewallRule
 			clusterSubnets     []string
 			hasNodeSelector    bool
+			hasPodSelector     bool
+			selectedPods       []string
 		}
 		_, clusterSubnetV4, _ := net.ParseCIDR("10.128.0.0/16")
 		_, clusterSubnetV6, _ := net.ParseCIDR("2002:0:0:1234::/64")
+		namespaceSelector, _ := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: namespaceLabel})
+		podSelector, _ := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: podLabel})
 		testcases := []testcase{
 			{
 				egressFirewallRule: egressfirewallapi.EgressFirewallRule{
@@ -479,6 +532,76 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 				},
 				hasNodeSelector: true,
 			},
+			// namespaceSelector tests
+			// all pods in the selected namespace, IPs in the cluster subnet are skipped
+			{
+				clusterSubnets: []string{"10.128.0.0/16"},
+				egressFirewallRule: egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleDeny,
+					To: egressfirewallapi.EgressFirewallDestination{
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: namespaceLabel}},
+				},
+				id:  1,
+				err: false,
+				output: egressFirewallRule{
+					id:     1,
+					access: egressfirewallapi.EgressFirewallRuleDeny,
+					to: destination{namespaceSelector: namespaceSelector, podSelector: labels.Everything(),
+						podAddrs: []string{"192.168.10.5", "192.168.10.6"}},
+				},
+				hasPodSelector: true,
+				selectedPods:   []string{"namespace1/pod1", "namespace1/pod2"},
+			},
+			// pods selected by podSelector with a port range
+			{
+				clusterSubnets: []string{"10.128.0.0/16"},
+				egressFirewallRule: egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					Ports: []egressfirewallapi.EgressFirewallPort{
+						{Protocol: "TCP", Port: 5432, EndPort: ptr.To[int32](5440)},
+					},
+					To: egressfirewallapi.EgressFirewallDestination{
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: namespaceLabel},
+						PodSelector:       &metav1.LabelSelector{MatchLabels: podLabel}},
+				},
+				id:  1,
+				err: false,
+				output: egressFirewallRule{
+					id:     1,
+					access: egressfirewallapi.EgressFirewallRuleAllow,
+					ports: []egressfirewallapi.EgressFirewallPort{
+						{Protocol: "TCP", Port: 5432, EndPort: ptr.To[int32](5440)},
+					},
+					to: destination{namespaceSelector: namespaceSelector, podSelector: podSelector,
+						podAddrs: []string{"192.168.10.5"}},
+				},
+				hasPodSelector: true,
+				selectedPods:   []string{"namespace1/pod1"},
+			},
+			// podSelector without namespaceSelector
+			{
+				egressFirewallRule: egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					To: egressfirewallapi.EgressFirewallDestination{
+						PodSelector: &metav1.LabelSelector{MatchLabels: podLabel}},
+				},
+				id:        1,
+				err:       true,
+				errOutput: "rule destination has a pod selector without a namespace selector",
+			},
+			// invalid port range
+			{
+				egressFirewallRule: egressfirewallapi.EgressFirewallRule{
+					Type: egressfirewallapi.EgressFirewallRuleAllow,
+					Ports: []egressfirewallapi.EgressFirewallPort{
+						{Protocol: "UDP", Port: 100, EndPort: ptr.To[int32](99)},
+					},
+					To: egressfirewallapi.EgressFirewallDestination{CIDRSelector: "1.2.3.4/32"},
+				},
+				id:        1,
+				err:       true,
+				errOutput: "rule has invalid port: endPort 99 must be greater than or equal to port 100",
+			},
 		}
 		for _, tc := range testcases {
 			subnets := []config.CIDRNetworkEntry{}
@@ -497,7 +620,76 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 				gomega.Expect(*output).To(gomega.Equal(tc.output))
 			}
 			gomega.Expect(entry.hasNodeSelector).To(gomega.Equal(tc.hasNodeSelector))
+			gomega.Expect(entry.hasPodSelector).To(gomega.Equal(tc.hasPodSelector))
+			gomega.Expect(entry.selectedPods.UnsortedList()).To(gomega.ConsistOf(tc.selectedPods))
+		}
+	})
+	ginkgo.It("only syncs the egress firewalls selecting a changed pod", func() {
+		_, clusterSubnet, _ := net.ParseCIDR("10.128.0.0/16")
+		config.Default.ClusterSubnets = []config.CIDRNetworkEntry{{CIDR: clusterSubnet}}
+		entry := &cacheEntry{subnets: subnetsForNetInfo(&util.DefaultNetInfo{})}
+		_, err := efController.newEgressFirewallRule("default", egressfirewallapi.EgressFirewallRule{
+			Type: egressfirewallapi.EgressFirewallRuleAllow,
+			To: egressfirewallapi.EgressFirewallDestination{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: namespaceLabel},
+				PodSelector:       &metav1.LabelSelector{MatchLabels: podLabel}},
+		}, 1, entry)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		namespace2 := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "namespace2"}}
+		newPod := func(namespace, name string, labels map[string]string) *corev1.Pod {
+			return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
 		}
+		// selected pod
+		gomega.Expect(entry.selectsPod("namespace1/pod1", namespace1, pod1)).To(gomega.BeTrue())
+		// selected pod whose labels don't match anymore
+		gomega.Expect(entry.selectsPod("namespace1/pod1", namespace1, newPod(namespace1.Name, pod1.Name, nil))).To(gomega.BeTrue())
+		// deleted selected pod
+		gomega.Expect(entry.selectsPod("namespace1/pod1", nil, nil)).To(gomega.BeTrue())
+		// pod whose labels now match
+		gomega.Expect(entry.selectsPod("namespace1/pod2", namespace1, newPod(namespace1.Name, pod2.Name, podLabel))).To(gomega.BeTrue())
+		// pod that is not selected
+		gomega.Expect(entry.selectsPod("namespace1/pod2", namespace1, pod2)).To(gomega.BeFalse())
+		// pod with matching labels in a namespace that is not selected
+		gomega.Expect(entry.selectsPod("namespace2/pod1", namespace2, newPod(namespace2.Name, pod1.Name, podLabel))).To(gomega.BeFalse())
+		// deleted pod that was not selected
+		gomega.Expect(entry.selectsPod("namespace1/pod3", nil, nil)).To(gomega.BeFalse())
+		// egress firewall without pod selectors
+		gomega.Expect((&cacheEntry{}).selectsPod("namespace1/pod1", namespace1, pod1)).To(gomega.BeFalse())
+	})
+	ginkgo.It("ignores pod changes while no egress firewall has namespaceSelector rules", func() {
+		updatedPod1 := pod1.DeepCopy()
+		updatedPod1.Labels = nil
+		ef := &egressfirewallapi.EgressFirewall{
+			ObjectMeta: metav1.ObjectMeta{Name: egressFirewallName, Namespace: namespace1.Name},
+			Spec: egressfirewallapi.EgressFirewallSpec{
+				Egress: []egressfirewallapi.EgressFirewallRule{
+					{
+						Type: egressfirewallapi.EgressFirewallRuleAllow,
+						To:   egressfirewallapi.EgressFirewallDestination{CIDRSelector: "1.2.3.4/32"},
+					},
+				},
+			},
+		}
+		efStore := iFactory.EgressFirewallInformer().Informer().GetStore()
+		gomega.Expect(efStore.Add(ef)).To(gomega.Succeed())
+		_ = efController.sync(namespace1.Name + "/" + egressFirewallName)
+		gomega.Expect(efController.efPodNeedsUpdate(pod1, updatedPod1)).To(gomega.BeFalse())
+
+		ef = ef.DeepCopy()
+		ef.Spec.Egress = append(ef.Spec.Egress, egressfirewallapi.EgressFirewallRule{
+			Type: egressfirewallapi.EgressFirewallRuleAllow,
+			To: egressfirewallapi.EgressFirewallDestination{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: namespaceLabel}},
+		})
+		gomega.Expect(efStore.Update(ef)).To(gomega.Succeed())
+		_ = efController.sync(namespace1.Name + "/" + egressFirewallName)
+		gomega.Expect(efController.efPodNeedsUpdate(pod1, updatedPod1)).To(gomega.BeTrue())
+		gomega.Expect(efController.efPodNeedsUpdate(pod1, pod1)).To(gomega.BeFalse())
+
+		gomega.Expect(efStore.Delete(ef)).To(gomega.Succeed())
+		_ = efController.sync(namespace1.Name + "/" + egressFirewallName)
+		gomega.Expect(efController.efPodNeedsUpdate(pod1, updatedPod1)).To(gomega.BeFalse())
 	})
 })
 
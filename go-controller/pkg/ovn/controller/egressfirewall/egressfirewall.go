@@ -44,6 +44,7 @@ import (
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/observability"
+	addressset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	dnsnameresolver "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/syncmap"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
@@ -94,6 +95,11 @@ type destination struct {
 	// nodeName: nodeIPs
 	nodeAddrs    map[string][]string
 	nodeSelector *metav1.LabelSelector
+	// namespaceSelector and podSelector select the pods the rule applies to
+	namespaceSelector labels.Selector
+	podSelector       labels.Selector
+	// podAddrs holds the IPs of the selected pods that are outside the cluster subnets
+	podAddrs []string
 }
 
 type matchTarget struct {
@@ -111,9 +117,19 @@ type matchKind int
 type cacheEntry struct {
 	pgName            string
 	hasNodeSelector   bool
+	hasPodSelector    bool
 	subnets           []*net.IPNet
 	efResourceVersion string
 	logHash           string
+	// podSelectors holds the selectors of the rules selecting pods
+	podSelectors []efPodSelector
+	// selectedPods holds the keys of the pods selected by the rules
+	selectedPods sets.Set[string]
+}
+
+type efPodSelector struct {
+	namespaceSelector labels.Selector
+	podSelector       labels.Selector
 }
 
 type EFController struct {
@@ -130,44 +146,61 @@ type EFController struct {
 
 	nodeLister      corelisters.NodeLister
 	namespaceLister corelisters.NamespaceLister
+	podLister       corelisters.PodLister
 	efLister        v2.EgressFirewallLister
 
-	controller      controller.Controller
-	nodeController  controller.Controller
-	networkManager  networkmanager.Interface
-	nadReconciler   networkmanager.NADReconciler
-	nadReconcilerID uint64
+	controller          controller.Controller
+	nodeController      controller.Controller
+	namespaceController controller.Controller
+	podController       controller.Controller
+	networkManager      networkmanager.Interface
+	nadReconciler       networkmanager.NADReconciler
+	nadReconcilerID     uint64
 	// dnsNameResolver is used for resolving the IP addresses of DNS names
 	// used in egress firewall rules
 	dnsNameResolver dnsnameresolver.DNSNameResolver
-	observManager   *observability.Manager
+	// addressSetFactory is used for the address sets of the pods
+	// selected by namespaceSelector rules
+	addressSetFactory addressset.AddressSetFactory
+	// podSelectorEFs holds the namespaces of the egress firewalls with
+	// namespaceSelector rules, pod events are ignored while there are none
+	podSelectorEFs sync.Map
+	observManager  *observability.Manager
 }
 
+// NewEFController creates the egress firewall controller. Besides the egress
+// firewalls, it watches the nodes for the nodeSelector rules, and the
+// namespaces and pods for the namespaceSelector rules, whose pod IPs are kept
+// in address sets created with addressSetFactory.
 func NewEFController(
 	name string,
 	zone string,
 	kube *kube.KubeOVN,
 	nbClient libovsdbclient.Client,
-	namespaceLister corelisters.NamespaceLister,
+	addressSetFactory addressset.AddressSetFactory,
+	namespaceInformer coreinformers.NamespaceInformer,
 	nodeInformer coreinformers.NodeInformer,
+	podInformer coreinformers.PodInformer,
 	efInformer v1.EgressFirewallInformer,
 	networkManager networkmanager.Interface,
 	dnsNameResolver dnsnameresolver.DNSNameResolver,
 	observManager *observability.Manager,
 ) (*EFController, error) {
 	c := &EFController{
-		name:            name,
-		zone:            zone,
-		cache:           syncmap.NewSyncMap[*cacheEntry](),
-		nbClient:        nbClient,
-		kube:            kube,
-		nodeLister:      nodeInformer.Lister(),
-		namespaceLister: namespaceLister,
-		efLister:        efInformer.Lister(),
-		networkManager:  networkManager,
-		dnsNameResolver: dnsNameResolver,
-		observManager:   observManager,
-		ruleCounter:     sync.Map{},
+		name:              name,
+		zone:              zone,
+		cache:             syncmap.NewSyncMap[*cacheEntry](),
+		nbClient:          nbClient,
+		kube:              kube,
+		nodeLister:        nodeInformer.Lister(),
+		namespaceLister:   namespaceInformer.Lister(),
+		podLister:         podInformer.Lister(),
+		efLister:          efInformer.Lister(),
+		networkManager:    networkManager,
+		dnsNameResolver:   dnsNameResolver,
+		addressSetFactory: addressSetFactory,
+		observManager:     observManager,
+		ruleCounter:       sync.Map{},
 	}
 
 	controllerConfig := &controller.ControllerConfig[egressfirewallapi.EgressFirewall]{
@@ -201,6 +234,34 @@ func NewEFController(
 		nodeControllerConfig,
 	)
 
+	namespaceControllerConfig := &controller.ControllerConfig[corev1.Namespace]{
+		Informer:       namespaceInformer.Informer(),
+		Lister:         namespaceInformer.Lister().List,
+		MaxAttempts:    controller.InfiniteAttempts,
+		ObjNeedsUpdate: efNamespaceNeedsUpdate,
+		Reconcile:      c.updateEgressFirewallForNamespace,
+		Threadiness:    1,
+	}
+
+	c.namespaceController = controller.NewController(
+		c.name+"-namespace",
+		namespaceControllerConfig,
+	)
+
+	podControllerConfig := &controller.ControllerConfig[corev1.Pod]{
+		Informer:       podInformer.Informer(),
+		Lister:         podInformer.Lister().List,
+		MaxAttempts:    controller.InfiniteAttempts,
+		ObjNeedsUpdate: c.efPodNeedsUpdate,
+		Reconcile:      c.updateEgressFirewallForPod,
+		Threadiness:    1,
+	}
+
+	c.podController = controller.NewController(
+		c.name+"-pod",
+		podControllerConfig,
+	)
+
 	// this controller does not feed from an informer, nads are added
 	// to the queue by NAD Controller
 	nadReconcilerConfig := &controller.ReconcilerConfig{
@@ -361,6 +422,14 @@ func (oc *EFController) initialSync() error {
 		return err
 	}
 
+	// Delete stale address sets of namespaceSelector rules for Egress Firewalls that don't exist anymore.
+	err = oc.deletePodSelectorAddrSets(func(namespace string, _ int) bool {
+		return !existingEFNamespaces[namespace]
+	})
+	if err != nil {
+		return err
+	}
+
 	// Delete stale address sets related to EgressFirewallDNS which are not referenced by any ACL.
 	return oc.dnsNameResolver.DeleteStaleAddrSets(oc.nbClient)
 }
@@ -374,7 +443,8 @@ func (oc *EFController) Start() (err error) {
 			oc.nadReconcilerID = 0
 		}
 	}()
-	return controller.StartWithInitialSync(oc.initialSync, oc.controller, oc.nodeController, oc.nadReconciler)
+	return controller.StartWithInitialSync(oc.initialSync, oc.controller, oc.nodeController, oc.namespaceController,
+		oc.podController, oc.nadReconciler)
 }
 
 func (oc *EFController) Stop() {
@@ -382,7 +452,7 @@ func (oc *EFController) Stop() {
 	if oc.nadReconcilerID != 0 {
 		oc.networkManager.DeRegisterNADReconciler(oc.nadReconcilerID)
 	}
-	controller.Stop(oc.nodeController, oc.controller, oc.nadReconciler)
+	controller.Stop(oc.nodeController, oc.namespaceController, oc.podController, oc.controller, oc.nadReconciler)
 	oc.nadReconciler = nil
 	oc.nadReconcilerID = 0
 }
@@ -404,7 +474,15 @@ func (oc *EFController) sync(key string) (updateErr error) {
 		if !apierrors.IsNotFound(err) {
 			return err
 		}
+		oc.podSelectorEFs.Delete(namespace)
 	} else {
+		// track the namespaceSelector rules before listing the selected pods,
+		// so that no pod event is missed
+		if hasPodSelectorRules(ef) {
+			oc.podSelectorEFs.Store(namespace, true)
+		} else {
+			oc.podSelectorEFs.Delete(namespace)
+		}
 		skipStatusUpdate := false
 		defer func() {
 			if skipStatusUpdate {
@@ -442,8 +520,8 @@ func (oc *EFController) sync(key string) (updateErr error) {
 	}
 
 	// If nothing relevant changed since last apply, skip update.
-	if existingEntry != nil && existingEntry.hasNodeSelector {
-		// Node selector rules depend on node state; always recalculate.
+	if existingEntry != nil && (existingEntry.hasNodeSelector || existingEntry.hasPodSelector) {
+		// Node and namespace selector rules depend on node and pod state; always recalculate.
 	} else if entriesEqual(existingEntry, newEntry) {
 		return updateErr
 	}
@@ -463,6 +541,11 @@ func (oc *EFController) sync(key string) (updateErr error) {
 				return fmt.Errorf("error deleting stale ACLs for egress firewall %s: %w", key, err)
 			}
 		}
+		if err := oc.deletePodSelectorAddrSets(func(asNamespace string, _ int) bool {
+			return asNamespace == namespace
+		}); err != nil {
+			return fmt.Errorf("error deleting address sets for egress firewall %s: %w", key, err)
+		}
 		oc.cache.Delete(namespace)
 		if err := oc.dnsNameResolver.Delete(namespace); err != nil {
 			return err
@@ -521,6 +604,14 @@ func (oc *EFController) sync(key string) (updateErr error) {
 		}
 	}
 
+	// Clean up the address sets of namespaceSelector rules that are no longer referenced by ACLs.
+	if err := oc.deletePodSelectorAddrSets(func(asNamespace string, ruleIdx int) bool {
+		return asNamespace == namespace && (ruleIdx >= len(ef.Spec.Egress) || ef.Spec.Egress[ruleIdx].To.NamespaceSelector == nil)
+	}); err != nil {
+		updateErr = utilerrors.Join(updateErr, fmt.Errorf("error deleting stale pod address sets for egress firewall %s/%s: %w",
+			namespace, efName, err))
+	}
+
 	// Clean up any DNS address sets that are no longer referenced by ACLs.
 	if err := oc.dnsNameResolver.DeleteStaleAddrSets(oc.nbClient); err != nil {
 		updateErr = utilerrors.Join(updateErr, fmt.Errorf("error deleting stale DNS address sets for egress firewall %s/%s: %w",
@@ -642,7 +733,7 @@ func (oc *EFController) validateAndGetEgressFirewallDestination(namespace string
 				clusterSubnetIntersection = append(clusterSubnetIntersection, clusterSubnet)
 			}
 		}
-	} else {
+	} else if egressFirewallDestination.NodeSelector != nil {
 		// Validate node selector.
 		_, err := metav1.LabelSelectorAsSelector(egressFirewallDestination.NodeSelector)
 		if err != nil {
@@ -666,6 +757,10 @@ func (oc *EFController) newEgressFirewallRule(namespace string, rawEgressFirewal
 	// Validate the egress firewall rule destination and update the appropriate
 	// fields of efr.
 	var err error
+	efr.to.namespaceSelector, efr.to.podSelector, err = util.ValidateAndGetEgressFirewallPodSelectors(rawEgressFirewallRule.To)
+	if err != nil {
+		return efr, err
+	}
 	efr.to.cidrSelector, efr.to.dnsName, efr.to.clusterSubnetIntersection, efr.to.nodeSelector, err =
 		oc.validateAndGetEgressFirewallDestination(namespace, rawEgressFirewallRule.To, entry)
 	if err != nil {
@@ -693,11 +788,80 @@ func (oc *EFController) newEgressFirewallRule(namespace string, rawEgressFirewal
 			efr.to.nodeAddrs[node.Name] = hostAddresses
 		}
 	}
+	// If namespaceSelector is set then fetch the addresses of the selected pods.
+	if efr.to.namespaceSelector != nil {
+		if entry != nil {
+			entry.hasPodSelector = true
+		}
+		efr.to.podAddrs, err = oc.getEgressFirewallPodAddrs(namespace, efr.to.namespaceSelector, efr.to.podSelector, entry)
+		if err != nil {
+			return efr, err
+		}
+	}
+	for _, port := range rawEgressFirewallRule.Ports {
+		if err := util.ValidateEgressFirewallPort(port); err != nil {
+			return efr, fmt.Errorf("rule has invalid port: %w", err)
+		}
+	}
 	efr.ports = rawEgressFirewallRule.Ports
 
 	return efr, nil
 }
 
+// getEgressFirewallPodAddrs returns the IPs of the pods selected by the given selectors. Only the IPs
+// outside the cluster subnets of the egress firewall's network are returned, since traffic to the pods
+// on that network is not subject to the egress firewall.
+func (oc *EFController) getEgressFirewallPodAddrs(namespace string, namespaceSelector, podSelector labels.Selector,
+	entry *cacheEntry) ([]string, error) {
+	if entry == nil || entry.subnets == nil {
+		return nil, fmt.Errorf("failed to "+
+			"validate egress firewall destination: missing cached subnets for namespace %s", namespace)
+	}
+	entry.podSelectors = append(entry.podSelectors, efPodSelector{namespaceSelector: namespaceSelector, podSelector: podSelector})
+	if entry.selectedPods == nil {
+		entry.selectedPods = sets.New[string]()
+	}
+	namespaces, err := oc.namespaceLister.List(namespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query namespaces for egress firewall: %w", err)
+	}
+	podAddrs := sets.New[string]()
+	for _, ns := range namespaces {
+		pods, err := oc.podLister.Pods(ns.Name).List(podSelector)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query pods in namespace %s for egress firewall: %w", ns.Name, err)
+		}
+		for _, pod := range pods {
+			entry.selectedPods.Insert(pod.Namespace + "/" + pod.Name)
+			if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) {
+				continue
+			}
+			podNetworks, err := util.UnmarshalPodAnnotationAllNetworks(pod.Annotations)
+			if err != nil {
+				klog.Warningf("Skipping pod %s/%s for egress firewall in namespace %s: %v", pod.Namespace, pod.Name, namespace, err)
+				continue
+			}
+			for _, podNetwork := range podNetworks {
+				if podNetwork.Role == types.NetworkRoleInfrastructure {
+					continue
+				}
+				for _, podIP := range podNetwork.IPs {
+					ip, _, err := net.ParseCIDR(podIP)
+					if err != nil {
+						klog.Warningf("Skipping invalid IP %s of pod %s/%s for egress firewall: %v", podIP, pod.Namespace, pod.Name, err)
+						continue
+					}
+					if util.IsContainedInAnyCIDR(&net.IPNet{IP: ip, Mask: util.GetIPFullMask(ip)}, entry.subnets...) {
+						continue
+					}
+					podAddrs.Insert(ip.String())
+				}
+			}
+		}
+	}
+	return sets.List(podAddrs), nil
+}
+
 func efNodeNeedsUpdate(oldNode, newNode *corev1.Node) bool {
 	if oldNode == nil || newNode == nil {
 		return true
@@ -706,13 +870,107 @@ func efNodeNeedsUpdate(oldNode, newNode *corev1.Node) bool {
 		util.NodeHostCIDRsAnnotationChanged(oldNode, newNode)
 }
 
+func efNamespaceNeedsUpdate(oldNamespace, newNamespace *corev1.Namespace) bool {
+	if oldNamespace == nil || newNamespace == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldNamespace.Labels, newNamespace.Labels)
+}
+
+// efPodNeedsUpdate returns true if the pod change is relevant for the
+// namespaceSelector rules, if any
+func (oc *EFController) efPodNeedsUpdate(oldPod, newPod *corev1.Pod) bool {
+	if !oc.hasPodSelectorEFs() {
+		return false
+	}
+	if oldPod == nil || newPod == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldPod.Labels, newPod.Labels) ||
+		oldPod.Annotations[types.OvnPodAnnotationName] != newPod.Annotations[types.OvnPodAnnotationName] ||
+		util.PodCompleted(oldPod) != util.PodCompleted(newPod)
+}
+
+// hasPodSelectorEFs returns true if any egress firewall has namespaceSelector rules
+func (oc *EFController) hasPodSelectorEFs() bool {
+	found := false
+	oc.podSelectorEFs.Range(func(_, _ any) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// hasPodSelectorRules returns true if the egress firewall has namespaceSelector rules
+func hasPodSelectorRules(ef *egressfirewallapi.EgressFirewall) bool {
+	for _, rule := range ef.Spec.Egress {
+		if rule.To.NamespaceSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (oc *EFController) updateEgressFirewallForNode(nodeName string) error {
 	klog.V(3).Infof("Syncing node %q for egress firewall", nodeName)
+	return oc.reconcileEgressFirewalls(fmt.Sprintf("node %q", nodeName), func(entry *cacheEntry) bool {
+		return entry.hasNodeSelector
+	})
+}
+
+func (oc *EFController) updateEgressFirewallForNamespace(namespace string) error {
+	klog.V(5).Infof("Syncing namespace %q for egress firewall", namespace)
+	return oc.reconcileEgressFirewalls(fmt.Sprintf("namespace %q", namespace), func(entry *cacheEntry) bool {
+		return entry.hasPodSelector
+	})
+}
 
+// updateEgressFirewallForPod queues the egress firewalls that select the pod, or selected it
+// when they were last applied, e.g. before a label change or the pod deletion.
+func (oc *EFController) updateEgressFirewallForPod(podKey string) error {
+	klog.V(5).Infof("Syncing pod %q for egress firewall", podKey)
+	podNamespace, podName, err := cache.SplitMetaNamespaceKey(podKey)
+	if err != nil {
+		return fmt.Errorf("invalid resource key for pod: %s", podKey)
+	}
+	pod, err := oc.podLister.Pods(podNamespace).Get(podName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	namespace, err := oc.namespaceLister.Get(podNamespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return oc.reconcileEgressFirewalls(fmt.Sprintf("pod %q", podKey), func(entry *cacheEntry) bool {
+		return entry.selectsPod(podKey, namespace, pod)
+	})
+}
+
+// selectsPod returns true if the pod was selected by the egress firewall when it was last applied,
+// or is selected by it now. namespace and pod are nil if they don't exist anymore.
+func (entry *cacheEntry) selectsPod(podKey string, namespace *corev1.Namespace, pod *corev1.Pod) bool {
+	if entry.selectedPods.Has(podKey) {
+		return true
+	}
+	if namespace == nil || pod == nil {
+		return false
+	}
+	for _, selector := range entry.podSelectors {
+		if selector.namespaceSelector.Matches(labels.Set(namespace.Labels)) &&
+			selector.podSelector.Matches(labels.Set(pod.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileEgressFirewalls queues the egress firewalls whose cache entry matches needsSync
+// due to a change of the given object.
+func (oc *EFController) reconcileEgressFirewalls(objDesc string, needsSync func(entry *cacheEntry) bool) error {
 	efNamespaces := oc.cache.GetKeys()
 	for _, namespace := range efNamespaces {
 		if err := oc.cache.DoWithLock(namespace, func(key string) error {
-			if entry, ok := oc.cache.Load(key); ok && entry.hasNodeSelector {
+			if entry, ok := oc.cache.Load(key); ok && needsSync(entry) {
 				ef, err := oc.efLister.EgressFirewalls(namespace).Get(egressFirewallName)
 				if err != nil {
 					if !apierrors.IsNotFound(err) {
@@ -724,7 +982,7 @@ func (oc *EFController) updateEgressFirewallForNode(nodeName string) error {
 				if err != nil {
 					return fmt.Errorf("couldn't get key for egress firewall object %+v: %v", ef, err)
 				}
-				klog.Infof("Syncing egress firewall %s due to node %q change", efKey, nodeName)
+				klog.Infof("Syncing egress firewall %s due to %s change", efKey, objDesc)
 				oc.controller.Reconcile(efKey)
 			}
 			return nil
@@ -807,6 +1065,22 @@ func (oc *EFController) addEgressFirewallRules(ef *egressFirewall, pgName string
 			if dnsNameIPv6ASHashName != "" {
 				matchTargets = append(matchTargets, matchTarget{matchKindV6AddressSet, dnsNameIPv6ASHashName, rule.to.clusterSubnetIntersection})
 			}
+		} else if rule.to.namespaceSelector != nil {
+			// rule based on the IPs of the selected pods
+			podAddrSet, asOps, err := oc.addressSetFactory.NewAddressSetOps(
+				getEgressFirewallPodSelectorAddrSetDbIDs(ef.namespace, rule.id), rule.to.podAddrs)
+			if err != nil {
+				return fmt.Errorf("failed to create address set for egress firewall rule %d in namespace %s: %w",
+					rule.id, ef.namespace, err)
+			}
+			ops = append(ops, asOps...)
+			podIPv4ASHashName, podIPv6ASHashName := podAddrSet.GetASHashNames()
+			if podIPv4ASHashName != "" {
+				matchTargets = append(matchTargets, matchTarget{matchKindV4AddressSet, podIPv4ASHashName, nil})
+			}
+			if podIPv6ASHashName != "" {
+				matchTargets = append(matchTargets, matchTarget{matchKindV6AddressSet, podIPv6ASHashName, nil})
+			}
 		}
 
 		if len(matchTargets) == 0 {
@@ -951,6 +1225,30 @@ func (oc *EFController) GetEgressFirewallACLDbIDs(namespace string, ruleIdx int)
 		})
 }
 
+func getEgressFirewallPodSelectorAddrSetDbIDs(namespace string, ruleIdx int) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressFirewallPodSelector, types.DefaultNetworkControllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: namespace,
+			libovsdbops.RuleIndex:     strconv.Itoa(ruleIdx),
+		})
+}
+
+// deletePodSelectorAddrSets deletes the address sets of namespaceSelector rules for which isStale
+// returns true. Address sets with an invalid rule index are always deleted.
+func (oc *EFController) deletePodSelectorAddrSets(isStale func(namespace string, ruleIdx int) bool) error {
+	return oc.addressSetFactory.ProcessEachAddressSet(types.DefaultNetworkControllerName, libovsdbops.AddressSetEgressFirewallPodSelector,
+		func(dbIDs *libovsdbops.DbObjectIDs) error {
+			ruleIdx, err := strconv.Atoi(dbIDs.GetObjectID(libovsdbops.RuleIndex))
+			if err == nil && !isStale(dbIDs.GetObjectID(libovsdbops.ObjectNameKey), ruleIdx) {
+				return nil
+			}
+			if err := oc.addressSetFactory.DestroyAddressSet(dbIDs); err != nil {
+				return fmt.Errorf("failed to delete egress firewall address set %s: %w", dbIDs.String(), err)
+			}
+			return nil
+		})
+}
+
 func (oc *EFController) deleteEgressFirewallRule(namespace, pgName string, ruleIdx int) error {
 	// Find ACLs for a given egressFirewall
 	aclIDs := oc.GetEgressFirewallACLDbIDs(namespace, ruleIdx)
@@ -1179,19 +1477,19 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 			if port.Port == 0 {
 				udpString = "udp"
 			} else {
-				udpString = fmt.Sprintf("%s udp.dst == %d ||", udpString, port.Port)
+				udpString = fmt.Sprintf("%s %s ||", udpString, egressGetPortMatch("udp", port))
 			}
 		} else if corev1.Protocol(port.Protocol) == corev1.ProtocolTCP && tcpString != "tcp" {
 			if port.Port == 0 {
 				tcpString = "tcp"
 			} else {
-				tcpString = fmt.Sprintf("%s tcp.dst == %d ||", tcpString, port.Port)
+				tcpString = fmt.Sprintf("%s %s ||", tcpString, egressGetPortMatch("tcp", port))
 			}
 		} else if corev1.Protocol(port.Protocol) == corev1.ProtocolSCTP && sctpString != "sctp" {
 			if port.Port == 0 {
 				sctpString = "sctp"
 			} else {
-				sctpString = fmt.Sprintf("%s sctp.dst == %d ||", sctpString, port.Port)
+				sctpString = fmt.Sprintf("%s %s ||", sctpString, egressGetPortMatch("sctp", port))
 			}
 		}
 	}
@@ -1233,6 +1531,15 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 	return fmt.Sprintf("(%s)", l4Match)
 }
 
+// egressGetPortMatch returns the match for the destination port, or port range if endPort is set,
+// of the given egressFirewallPort.
+func egressGetPortMatch(protocol string, port egressfirewallapi.EgressFirewallPort) string {
+	if port.EndPort != nil && *port.EndPort > port.Port {
+		return fmt.Sprintf("%d<=%s.dst<=%d", port.Port, protocol, *port.EndPort)
+	}
+	return fmt.Sprintf("%s.dst == %d", protocol, port.Port)
+}
+
 func getV4ClusterSubnetsExclusion(subnets []*net.IPNet) string {
 	var exclusions []string
 	for _, clusterSubnet := range subnets {
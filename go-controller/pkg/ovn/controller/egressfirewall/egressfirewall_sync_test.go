@@ -133,16 +133,17 @@ func TestEFControllerSync_UpdatesOnSubnetChangeAndSkipsWhenUnchanged(t *testing.
 	efLister := egressfirewalllisters.NewEgressFirewallLister(efIndexer)
 
 	oc := &EFController{
-		name:            "test",
-		zone:            zone,
-		cache:           syncmap.NewSyncMap[*cacheEntry](),
-		nbClient:        nbClient,
-		kube:            nil, // status updates are no-op in this test due to pre-seeded status message
-		namespaceLister: namespaceLister,
-		efLister:        efLister,
-		networkManager:  networkManager,
-		ruleCounter:     sync.Map{},
-		dnsNameResolver: noopDNSNameResolver{},
+		name:              "test",
+		zone:              zone,
+		cache:             syncmap.NewSyncMap[*cacheEntry](),
+		nbClient:          nbClient,
+		kube:              nil, // status updates are no-op in this test due to pre-seeded status message
+		namespaceLister:   namespaceLister,
+		efLister:          efLister,
+		networkManager:    networkManager,
+		ruleCounter:       sync.Map{},
+		dnsNameResolver:   noopDNSNameResolver{},
+		addressSetFactory: addressset.NewFakeAddressSetFactory("test"),
 	}
 
 	// Pre-seed rule counter so status updates don't affect global metrics.
@@ -261,16 +262,17 @@ func TestEFControllerSync_AddsCIDRExclusionWhenPrimaryNetworkAddsOverlappingSubn
 	efLister := egressfirewalllisters.NewEgressFirewallLister(efIndexer)
 
 	oc := &EFController{
-		name:            "test",
-		zone:            zone,
-		cache:           syncmap.NewSyncMap[*cacheEntry](),
-		nbClient:        nbClient,
-		kube:            nil, // status updates are no-op in this test due to pre-seeded status message
-		namespaceLister: namespaceLister,
-		efLister:        efLister,
-		networkManager:  networkManager,
-		ruleCounter:     sync.Map{},
-		dnsNameResolver: noopDNSNameResolver{},
+		name:              "test",
+		zone:              zone,
+		cache:             syncmap.NewSyncMap[*cacheEntry](),
+		nbClient:          nbClient,
+		kube:              nil, // status updates are no-op in this test due to pre-seeded status message
+		namespaceLister:   namespaceLister,
+		efLister:          efLister,
+		networkManager:    networkManager,
+		ruleCounter:       sync.Map{},
+		dnsNameResolver:   noopDNSNameResolver{},
+		addressSetFactory: addressset.NewFakeAddressSetFactory("test"),
 	}
 
 	// Pre-seed rule counter so status updates don't affect global metrics.
@@ -6,6 +6,7 @@ package egressservice
 import (
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -97,7 +98,8 @@ type Controller struct {
 }
 
 type svcState struct {
-	node string
+	// the nodes the service's traffic is rerouted to
+	nodes sets.Set[string]
 	// service endpoints that are hosted in the local zone
 	v4LocalEndpoints sets.Set[string]
 	v6LocalEndpoints sets.Set[string]
@@ -342,19 +344,21 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		svcHost := es.Status.Host
-		if svcHost == "" || svcHost == ovntypes.EgressServiceNoSNATHost {
-			continue
-		}
+		svcHosts := sets.New[string]()
+		for _, svcHost := range util.GetEgressServiceHosts(es) {
+			node, found := allNodes[svcHost]
+			if !found {
+				klog.Errorf("Node %s not found for egress service %s", svcHost, key)
+				continue
+			}
 
-		node, found := allNodes[svcHost]
-		if !found {
-			klog.Errorf("Node %s not found: %v", svcHost, err)
-			continue
+			if !nodeIsReady(node) {
+				klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+				continue
+			}
+			svcHosts.Insert(svcHost)
 		}
-
-		if !nodeIsReady(node) {
-			klog.Infof("Node %s is not ready, it can not be used for egress service %s", svcHost, key)
+		if svcHosts.Len() == 0 {
 			continue
 		}
 
@@ -370,13 +374,20 @@ func (c *Controller) repair() error {
 			continue
 		}
 
-		nodeState, ok := c.nodes[svcHost]
-		if !ok {
-			nodeState, err = c.nodeStateFor(svcHost)
+		for _, svcHost := range svcHosts.UnsortedList() {
+			if _, ok := c.nodes[svcHost]; ok {
+				continue
+			}
+			nodeState, err := c.nodeStateFor(svcHost)
 			if err != nil {
 				klog.Errorf("Can't fetch egress service %s node %s state, err: %v", key, svcHost, err)
+				svcHosts.Delete(svcHost)
 				continue
 			}
+			c.nodes[svcHost] = nodeState
+		}
+		if svcHosts.Len() == 0 {
+			continue
 		}
 		svcKeyToLocalV4Endpoints[key] = v4Local
 		svcKeyToLocalV6Endpoints[key] = v6Local
@@ -385,13 +396,12 @@ func (c *Controller) repair() error {
 		svcKeyToLocalConfiguredV4Endpoints[key] = []string{}
 		svcKeyToLocalConfiguredV6Endpoints[key] = []string{}
 		svcState := &svcState{
-			node:              svcHost,
+			nodes:             svcHosts,
 			v4LocalEndpoints:  sets.New[string](),
 			v6LocalEndpoints:  sets.New[string](),
 			v4RemoteEndpoints: sets.New[string](),
 			v6RemoteEndpoints: sets.New[string](),
 		}
-		c.nodes[svcHost] = nodeState
 		c.services[key] = svcState
 	}
 
@@ -431,25 +441,17 @@ func (c *Controller) repair() error {
 			return true
 		}
 
-		if len(item.Nexthops) != 1 {
-			klog.Infof("Egress service repair will delete lrp for service %s because it has more than one nexthop: %v", svcKey, item)
-			return true
-		}
-
-		node := c.nodes[svc.node]
-		nextHopV4 := node.v4MgmtIP.String()
-		nextHopV6 := node.v6MgmtIP.String()
-		svcNodeInLocalZone, zoneKnown := c.nodesZoneState[node.name]
-		if !zoneKnown {
-			klog.Errorf("Failed to verify whether the svc node: %s is in the local zone, deleting lrp", node.name)
+		v4NextHops, v6NextHops, err := c.nextHopsFor(svc.nodes, false)
+		if err != nil {
+			klog.Errorf("Failed to get the nexthops of service %s, deleting lrp: %v", svcKey, err)
 			return true
 		}
-		if !svcNodeInLocalZone {
-			nextHopV4 = node.transitIPV4.String()
-			nextHopV6 = node.transitIPV6.String()
+		nextHops := v4NextHops
+		if utilnet.IsIPv6String(logicalIP) {
+			nextHops = v6NextHops
 		}
 
-		if item.Nexthops[0] != nextHopV4 && item.Nexthops[0] != nextHopV6 {
+		if !sets.New(item.Nexthops...).Equal(sets.New(nextHops...)) {
 			klog.Infof("Egress service repair will delete %s because it is uses a stale nexthop for service %s: %v", logicalIP, svcKey, item)
 			return true
 		}
@@ -495,13 +497,12 @@ func (c *Controller) repair() error {
 			klog.Infof("Egress service repair continues with repairing service %s because it is valid: %v", svcKey, item)
 		}
 
-		node := c.nodes[svc.node]
-		svcNodeInLocalZone, zoneKnown := c.nodesZoneState[node.name]
-		if !zoneKnown {
-			klog.Errorf("Egress service repair failed to verify whether the svc node %s is in the local zone, deleting lrp", node.name)
+		v4NextHops, v6NextHops, err := c.nextHopsFor(svc.nodes, true)
+		if err != nil {
+			klog.Errorf("Egress service repair failed to get the local nexthops of service %s, deleting lrp: %v", svcKey, err)
 			return true
 		}
-		if !svcNodeInLocalZone {
+		if len(v4NextHops)+len(v6NextHops) == 0 {
 			klog.Infof("Egress service repair will delete lrp for service %s because the service is no longer hosted in the local zone: %v", svcKey, item)
 			return true
 		}
@@ -518,12 +519,12 @@ func (c *Controller) repair() error {
 			return true
 		}
 
-		if len(item.Nexthops) != 1 {
-			klog.Infof("Egress service repair will delete lrp for service %s because it has more than one nexthop: %v", svcKey, item)
-			return true
+		nextHops := v4NextHops
+		if utilnet.IsIPv6String(logicalIP) {
+			nextHops = v6NextHops
 		}
 
-		if item.Nexthops[0] != node.v4MgmtIP.String() && item.Nexthops[0] != node.v6MgmtIP.String() {
+		if !sets.New(item.Nexthops...).Equal(sets.New(nextHops...)) {
 			klog.Infof("Egress service repair will delete %s lrp because it is uses a stale nexthop for service %s: %v", logicalIP, svcKey, item)
 			return true
 		}
@@ -735,36 +736,52 @@ func (c *Controller) syncEgressService(key string) error {
 		return c.clearServiceResourcesAndRequeue(key, state)
 	}
 
-	if state == nil {
-		// The service has a valid EgressService and wasn't configured before.
-		nodeName := es.Status.Host
-		newState := &svcState{
-			node:              nodeName,
-			v4LocalEndpoints:  sets.New[string](),
-			v6LocalEndpoints:  sets.New[string](),
-			v4RemoteEndpoints: sets.New[string](),
-			v6RemoteEndpoints: sets.New[string](),
-		}
-		c.services[key] = newState
-		if _, exists := c.nodes[nodeName]; !exists {
-			nodeState, err := c.nodeStateFor(nodeName)
+	// The service's traffic is rerouted to all of its hosts that are ready, using ECMP
+	// when there are several of them. The hosts that are not usable are skipped,
+	// the cluster manager takes care of replacing them.
+	hosts := sets.New[string]()
+	for _, nodeName := range util.GetEgressServiceHosts(es) {
+		node, ok := c.nodes[nodeName]
+		if !ok {
+			n, err := c.nodeLister.Get(nodeName)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			if n == nil || !nodeIsReady(n) {
+				klog.Warningf("EgressService %s/%s is assigned to non-existing or not ready node %s, skipping it", namespace, name, nodeName)
+				continue
+			}
+			node, err = c.nodeStateFor(nodeName)
 			if err != nil {
 				return err
 			}
-			c.nodes[nodeName] = nodeState
+			c.nodes[nodeName] = node
+		}
+		if node.draining {
+			klog.Warningf("EgressService %s/%s is assigned to draining node %s, skipping it", namespace, name, nodeName)
+			continue
 		}
-		state = newState
+		hosts.Insert(nodeName)
 	}
 
-	if state.node != es.Status.Host {
-		klog.Errorf("EgressService %s/%s is configured for %s instead of %s, removing any existing configuration", namespace, name, state.node, es.Status.Host)
+	if hosts.Len() == 0 {
+		klog.Warningf("EgressService %s/%s has no usable host, removing any existing configuration", namespace, name)
+		if state == nil {
+			return nil
+		}
 		return c.clearServiceResourcesAndRequeue(key, state)
 	}
 
-	node, ok := c.nodes[state.node]
-	if !ok || node.draining {
-		klog.Warningf("EgressService %s/%s is configured on non-existing or not ready node %s, removing", namespace, name, state.node)
-		return c.clearServiceResourcesAndRequeue(key, state)
+	if state == nil {
+		// The service has a valid EgressService and wasn't configured before.
+		state = &svcState{
+			nodes:             sets.New[string](),
+			v4LocalEndpoints:  sets.New[string](),
+			v6LocalEndpoints:  sets.New[string](),
+			v4RemoteEndpoints: sets.New[string](),
+			v6RemoteEndpoints: sets.New[string](),
+		}
+		c.services[key] = state
 	}
 
 	// At this point the states are valid and we should create the proper logical router policies and static routes.
@@ -772,7 +789,10 @@ func (c *Controller) syncEgressService(key string) error {
 	// to the known state:
 	// We need to create policies for endpoints that were fetched but not found in the cache,
 	// and delete the policies for those which are found in the cache but were not fetched.
+	// When the hosts of the service changed the policies of all of the endpoints are updated
+	// with the new nexthops.
 	// We do it in one transaction, if it succeeds we update the cache to reflect the new state.
+	hostsChanged := !state.nodes.Equal(hosts)
 
 	v4LocalToAdd := v4LocalEndpoints.Difference(state.v4LocalEndpoints).UnsortedList()
 	v6LocalToAdd := v6LocalEndpoints.Difference(state.v6LocalEndpoints).UnsortedList()
@@ -784,42 +804,55 @@ func (c *Controller) syncEgressService(key string) error {
 	v4RemoteToRemove := state.v4RemoteEndpoints.Difference(v4RemoteEndpoints).UnsortedList()
 	v6RemoteToRemove := state.v6RemoteEndpoints.Difference(v6RemoteEndpoints).UnsortedList()
 
+	if hostsChanged {
+		v4LocalToAdd = v4LocalEndpoints.UnsortedList()
+		v6LocalToAdd = v6LocalEndpoints.UnsortedList()
+		v4RemoteToAdd = v4RemoteEndpoints.UnsortedList()
+		v6RemoteToAdd = v6RemoteEndpoints.UnsortedList()
+	}
+
 	// v[4|6]LocalEndpoints represents endpoints local to the current zone.
 	// v[4|6]RemoteEndpoints represents endpoints remote to the current zone.
-	// If a service is hosted in the local zone:
-	//  - create LRPs for local endpoints with mgmt IP as a nextHop
-	//  - create LRSRs for remote endpoints with mgmt IP as a nextHop
-	// If a service is hosted in a remote zone:
-	//  - create LRPs for local endpoints with node router transit IP as a next hop as a nextHop
-	//  - do nothing for remote endpoints
-
-	nextHopV4 := node.v4MgmtIP.String()
-	nextHopV6 := node.v6MgmtIP.String()
-	svcNodeInLocalZone, zoneKnown := c.nodesZoneState[node.name]
-	if !zoneKnown {
-		return fmt.Errorf("failed to verify whether the svc node %s is in the local zone", node.name)
+	// For local endpoints, create LRPs with the nexthops of all of the service's hosts:
+	//  - the mgmt IP of the hosts in the local zone
+	//  - the node router transit IP of the hosts in a remote zone
+	// If some of the service's hosts are in the local zone:
+	//  - create LRPs for remote endpoints with the mgmt IPs of these hosts as nexthops
+	// Otherwise do nothing for remote endpoints.
+
+	v4NextHops, v6NextHops, err := c.nextHopsFor(hosts, false)
+	if err != nil {
+		return err
 	}
-	if !svcNodeInLocalZone {
-		nextHopV4 = node.transitIPV4.String()
-		nextHopV6 = node.transitIPV6.String()
+	v4LocalNextHops, v6LocalNextHops, err := c.nextHopsFor(hosts, true)
+	if err != nil {
+		return err
 	}
+	svcInLocalZone := len(v4LocalNextHops)+len(v6LocalNextHops) > 0
 
 	allOps := []ovsdb.Operation{}
-	createOps, err := c.createOrUpdateLogicalRouterPoliciesOps(key, nextHopV4, nextHopV6, v4LocalToAdd, v6LocalToAdd)
+	createOps, err := c.createOrUpdateLogicalRouterPoliciesOps(key, v4NextHops, v6NextHops, v4LocalToAdd, v6LocalToAdd)
 	if err != nil {
 		return err
 	}
 	allOps = append(allOps, createOps...)
 
-	if svcNodeInLocalZone && (len(v4RemoteToAdd)+len(v6RemoteToAdd)) > 0 {
+	if svcInLocalZone && (len(v4RemoteToAdd)+len(v6RemoteToAdd)) > 0 {
 		// When service is hosted in the local zone, create logical router policies for remote endpoints.
-		createOps, err = c.createOrUpdateLogicalRouterPoliciesOps(key+interconnectSuffix, node.v4MgmtIP.String(), node.v6MgmtIP.String(), v4RemoteToAdd, v6RemoteToAdd)
+		createOps, err = c.createOrUpdateLogicalRouterPoliciesOps(key+interconnectSuffix, v4LocalNextHops, v6LocalNextHops, v4RemoteToAdd, v6RemoteToAdd)
 		if err != nil {
 			return err
 		}
 		allOps = append(allOps, createOps...)
 	}
 
+	if !svcInLocalZone && hostsChanged {
+		// The service is no longer hosted in the local zone, remove the logical router policies
+		// configured for its remote endpoints.
+		v4RemoteToRemove = state.v4RemoteEndpoints.UnsortedList()
+		v6RemoteToRemove = state.v6RemoteEndpoints.UnsortedList()
+	}
+
 	// update egresssvc-served-pods address set used to ensure egress service
 	// does not affect pod -> node ip traffic
 	// https://github.com/ovn-kubernetes/ovn-kubernetes/blob/master/docs/egress-ip.md#pod-to-node-ip-traffic
@@ -862,9 +895,50 @@ func (c *Controller) syncEgressService(key string) error {
 	state.v4RemoteEndpoints.Delete(v4RemoteToRemove...)
 	state.v6RemoteEndpoints.Insert(v6RemoteToAdd...)
 	state.v6RemoteEndpoints.Delete(v6RemoteToRemove...)
+	state.v4RemoteEndpoints.Insert(v4RemoteEndpoints.UnsortedList()...)
+	state.v6RemoteEndpoints.Insert(v6RemoteEndpoints.UnsortedList()...)
+
+	state.nodes = hosts
 	return nil
 }
 
+// Returns the sorted nexthops the traffic of a service is rerouted to given its hosts:
+// the management port IP of the hosts in the local zone and the node router
+// transit IP of the hosts in remote zones. When localOnly is set only the hosts
+// in the local zone are considered.
+// This should only be called with the controller locked.
+func (c *Controller) nextHopsFor(hosts sets.Set[string], localOnly bool) ([]string, []string, error) {
+	v4NextHops := []string{}
+	v6NextHops := []string{}
+	for _, host := range sets.List(hosts) {
+		node, found := c.nodes[host]
+		if !found {
+			return nil, nil, fmt.Errorf("node %s is not known", host)
+		}
+		inLocalZone, zoneKnown := c.nodesZoneState[host]
+		if !zoneKnown {
+			return nil, nil, fmt.Errorf("failed to verify whether the svc node %s is in the local zone", host)
+		}
+		if localOnly && !inLocalZone {
+			continue
+		}
+
+		v4IP, v6IP := node.v4MgmtIP, node.v6MgmtIP
+		if !inLocalZone {
+			v4IP, v6IP = node.transitIPV4, node.transitIPV6
+		}
+		if v4IP != nil {
+			v4NextHops = append(v4NextHops, v4IP.String())
+		}
+		if v6IP != nil {
+			v6NextHops = append(v6NextHops, v6IP.String())
+		}
+	}
+	slices.Sort(v4NextHops)
+	slices.Sort(v6NextHops)
+	return v4NextHops, v6NextHops, nil
+}
+
 // Removes all the logical router policies that belong to the egress service.
 // This also requeues the service after cleaning up to be sure we are not
 // missing an event after marking it as stale that should be handled.
@@ -6,6 +6,7 @@ package egressservice
 import (
 	"fmt"
 	"net"
+	"slices"
 	"sync"
 	"time"
 
@@ -154,12 +155,8 @@ func (c *Controller) syncNode(key string) error {
 			// We mark it as draining and remove all the service configurations made for it,
 			// Services can't be configured for a node while it is in draining status.
 			state.draining = true
-			for svcKey, svcState := range c.services {
-				if svcState.node == state.name {
-					if err := c.clearServiceResourcesAndRequeue(svcKey, svcState); err != nil {
-						return err
-					}
-				}
+			if err := c.removeNodeFromServices(state.name); err != nil {
+				return err
 			}
 			delete(c.nodes, nodeName)
 		}
@@ -182,7 +179,7 @@ func (c *Controller) syncNode(key string) error {
 	// If the node is used by any service but is not in cache enqueue it
 	if state == nil {
 		for svcKey, svcState := range c.services {
-			if svcState.node == n.Name {
+			if svcState.nodes.Has(n.Name) || c.isServiceAssignedTo(svcKey, n.Name) {
 				c.egressServiceQueue.Add(svcKey)
 			}
 		}
@@ -193,12 +190,8 @@ func (c *Controller) syncNode(key string) error {
 		// The node hosting an egress service is draining and is not usable.
 		// We remove all the service configurations made for it,
 		// Services can't be configured for a node while it is in draining status.
-		for svcKey, svcState := range c.services {
-			if svcState.node == state.name {
-				if err := c.clearServiceResourcesAndRequeue(svcKey, svcState); err != nil {
-					return err
-				}
-			}
+		if err := c.removeNodeFromServices(state.name); err != nil {
+			return err
 		}
 		delete(c.nodes, nodeName)
 	}
@@ -206,6 +199,40 @@ func (c *Controller) syncNode(key string) error {
 	return nil
 }
 
+// Removes the given node from the services using it.
+// A service only using this node has all of its configuration removed, the others
+// are requeued to reroute their traffic to their remaining hosts.
+// This should only be called with the controller locked.
+func (c *Controller) removeNodeFromServices(nodeName string) error {
+	for svcKey, svcState := range c.services {
+		if !svcState.nodes.Has(nodeName) {
+			continue
+		}
+		if svcState.nodes.Len() == 1 {
+			if err := c.clearServiceResourcesAndRequeue(svcKey, svcState); err != nil {
+				return err
+			}
+			continue
+		}
+		c.egressServiceQueue.Add(svcKey)
+	}
+	return nil
+}
+
+// Returns if the given node is one of the hosts in the status of the EgressService
+// of the given service key.
+func (c *Controller) isServiceAssignedTo(svcKey, nodeName string) bool {
+	namespace, name, err := cache.SplitMetaNamespaceKey(svcKey)
+	if err != nil {
+		return false
+	}
+	es, err := c.egressServiceLister.EgressServices(namespace).Get(name)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(util.GetEgressServiceHosts(es), nodeName)
+}
+
 // Returns if the given node is in "Ready" state.
 func nodeIsReady(n *corev1.Node) bool {
 	for _, condition := range n.Status.Conditions {
@@ -230,16 +230,25 @@ func (c *Controller) setPodIPsInAddressSet(addrSetIPs []string) error {
 }
 
 // Returns the libovsdb operations to create or updates the logical router policies for the service,
-// given its key, the nexthops (mgmt ips) and endpoints to add.
-func (c *Controller) createOrUpdateLogicalRouterPoliciesOps(key, v4MgmtIP, v6MgmtIP string, v4Endpoints, v6Endpoints []string) ([]ovsdb.Operation, error) {
+// given its key, the nexthops and endpoints to add.
+// The traffic is spread across the nexthops using ECMP when there are several of them.
+// No policy is created for the endpoints of an IP family without nexthops.
+func (c *Controller) createOrUpdateLogicalRouterPoliciesOps(key string, v4NextHops, v6NextHops, v4Endpoints, v6Endpoints []string) ([]ovsdb.Operation, error) {
 	allOps := []ovsdb.Operation{}
 	var err error
 
+	if len(v4NextHops) == 0 {
+		v4Endpoints = nil
+	}
+	if len(v6NextHops) == 0 {
+		v6Endpoints = nil
+	}
+
 	for _, addr := range v4Endpoints {
 		lrp := &nbdb.LogicalRouterPolicy{
 			Match:    fmt.Sprintf("ip4.src == %s", addr),
 			Priority: ovntypes.EgressSVCReroutePriority,
-			Nexthops: []string{v4MgmtIP},
+			Nexthops: v4NextHops,
 			Action:   nbdb.LogicalRouterPolicyActionReroute,
 			ExternalIDs: map[string]string{
 				svcExternalIDKey: key,
@@ -259,7 +268,7 @@ func (c *Controller) createOrUpdateLogicalRouterPoliciesOps(key, v4MgmtIP, v6Mgm
 		lrp := &nbdb.LogicalRouterPolicy{
 			Match:    fmt.Sprintf("ip6.src == %s", addr),
 			Priority: ovntypes.EgressSVCReroutePriority,
-			Nexthops: []string{v6MgmtIP},
+			Nexthops: v6NextHops,
 			Action:   nbdb.LogicalRouterPolicyActionReroute,
 			ExternalIDs: map[string]string{
 				svcExternalIDKey: key,
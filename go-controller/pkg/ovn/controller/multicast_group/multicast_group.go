@@ -0,0 +1,743 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package multicastgroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	metaapplyv1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/controller"
+	mcgapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1"
+	mcgapply "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/applyconfiguration/multicastgroup/v1alpha1"
+	mcgclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/clientset/versioned"
+	mcginformer "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/informers/externalversions/multicastgroup/v1alpha1"
+	mcglister "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/listers/multicastgroup/v1alpha1"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
+	addressset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/syncmap"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// IPv6 multicast traffic destined to dynamic groups must have the "T" bit
+	// set to 1: https://tools.ietf.org/html/rfc3307#section-4.3
+	ipv6DynamicMulticastMatch = "(ip6.dst[120..127] == 0xff && ip6.dst[116] == 1)"
+
+	producersType = "Producers"
+	consumersType = "Consumers"
+
+	conditionTypeReady = "Ready-In-Zone-"
+	reasonSetupSuccess = "Success"
+	reasonSetupFailed  = "Failed"
+
+	MulticastGroupAppliedCorrectly = "MulticastGroup applied"
+)
+
+// cacheEntry holds what a MulticastGroup selected on its last sync, so that
+// pod and namespace changes only requeue the groups they affect.
+type cacheEntry struct {
+	// pods holds the namespace/name keys of the selected producers and consumers
+	pods sets.Set[string]
+	// namespaces holds the namespaces of the selected pods
+	namespaces sets.Set[string]
+}
+
+// networkGroup is the desired state of a MulticastGroup on a single network.
+type networkGroup struct {
+	netInfo util.NetInfo
+	// producerIPs holds the IPs of the producers in all zones
+	producerIPs []string
+	// producerPorts and consumerPorts hold the logical switch ports of the
+	// producers and consumers in the local zone
+	producerPorts []*nbdb.LogicalSwitchPort
+	consumerPorts []*nbdb.LogicalSwitchPort
+}
+
+// Controller programs the port groups, address sets and ACLs that allow
+// multicast traffic between the producers and consumers of MulticastGroups,
+// for the pods of the local zone. A MulticastGroup is configured on the
+// primary network of each selected pod, default or user defined, and its
+// objects are owned by the network controller of that network.
+type Controller struct {
+	name string
+	zone string
+	// cache stores the pods selected by a MulticastGroup, keyed by group name
+	cache *syncmap.SyncMap[*cacheEntry]
+
+	// libovsdb northbound client interface
+	nbClient  libovsdbclient.Client
+	mcgClient mcgclientset.Interface
+
+	mcgLister       mcglister.MulticastGroupLister
+	namespaceLister corelisters.NamespaceLister
+	podLister       corelisters.PodLister
+	nodeLister      corelisters.NodeLister
+
+	controller          controller.Controller
+	namespaceController controller.Controller
+	podController       controller.Controller
+	networkManager      networkmanager.Interface
+	nadReconciler       networkmanager.NADReconciler
+	nadReconcilerID     uint64
+	// addressSetFactory is used for the address sets of the producers
+	addressSetFactory addressset.AddressSetFactory
+}
+
+func NewController(
+	name string,
+	zone string,
+	mcgClient mcgclientset.Interface,
+	nbClient libovsdbclient.Client,
+	addressSetFactory addressset.AddressSetFactory,
+	namespaceInformer coreinformers.NamespaceInformer,
+	nodeInformer coreinformers.NodeInformer,
+	podInformer coreinformers.PodInformer,
+	mcgInformer mcginformer.MulticastGroupInformer,
+	networkManager networkmanager.Interface,
+) *Controller {
+	c := &Controller{
+		name:              name,
+		zone:              zone,
+		cache:             syncmap.NewSyncMap[*cacheEntry](),
+		nbClient:          nbClient,
+		mcgClient:         mcgClient,
+		mcgLister:         mcgInformer.Lister(),
+		namespaceLister:   namespaceInformer.Lister(),
+		podLister:         podInformer.Lister(),
+		nodeLister:        nodeInformer.Lister(),
+		networkManager:    networkManager,
+		addressSetFactory: addressSetFactory,
+	}
+
+	controllerConfig := &controller.ControllerConfig[mcgapi.MulticastGroup]{
+		RateLimiter: workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:    mcgInformer.Informer(),
+		Lister:      mcgInformer.Lister().List,
+		MaxAttempts: controller.InfiniteAttempts,
+		Reconcile:   c.sync,
+		ObjNeedsUpdate: func(old, new *mcgapi.MulticastGroup) bool {
+			return old == nil || new == nil || !reflect.DeepEqual(old.Spec, new.Spec)
+		},
+		Threadiness: 1,
+	}
+
+	c.controller = controller.NewController(
+		c.name,
+		controllerConfig,
+	)
+
+	namespaceControllerConfig := &controller.ControllerConfig[corev1.Namespace]{
+		Informer:       namespaceInformer.Informer(),
+		Lister:         namespaceInformer.Lister().List,
+		MaxAttempts:    controller.InfiniteAttempts,
+		ObjNeedsUpdate: mcgNamespaceNeedsUpdate,
+		Reconcile:      c.updateMulticastGroupsForNamespace,
+		Threadiness:    1,
+	}
+
+	c.namespaceController = controller.NewController(
+		c.name+"-namespace",
+		namespaceControllerConfig,
+	)
+
+	podControllerConfig := &controller.ControllerConfig[corev1.Pod]{
+		Informer:       podInformer.Informer(),
+		Lister:         podInformer.Lister().List,
+		MaxAttempts:    controller.InfiniteAttempts,
+		ObjNeedsUpdate: mcgPodNeedsUpdate,
+		Reconcile:      c.updateMulticastGroupsForPod,
+		Threadiness:    1,
+	}
+
+	c.podController = controller.NewController(
+		c.name+"-pod",
+		podControllerConfig,
+	)
+
+	// this controller does not feed from an informer, nads are added
+	// to the queue by NAD Controller
+	nadReconcilerConfig := &controller.ReconcilerConfig{
+		RateLimiter: workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:   c.syncNAD,
+		Threadiness: 1,
+		MaxAttempts: controller.InfiniteAttempts,
+	}
+	c.nadReconciler = controller.NewReconciler(
+		c.name+"-NAD",
+		nadReconcilerConfig,
+	)
+
+	return c
+}
+
+func (c *Controller) Start() (err error) {
+	klog.Infof("Starting MulticastGroup controller")
+	c.nadReconcilerID = c.networkManager.RegisterNADReconciler(c.nadReconciler)
+	defer func() {
+		if err != nil {
+			c.networkManager.DeRegisterNADReconciler(c.nadReconcilerID)
+			c.nadReconcilerID = 0
+		}
+	}()
+	return controller.StartWithInitialSync(c.initialSync, c.controller, c.namespaceController, c.podController,
+		c.nadReconciler)
+}
+
+func (c *Controller) Stop() {
+	klog.Infof("%s: shutting down", c.name)
+	if c.nadReconcilerID != 0 {
+		c.networkManager.DeRegisterNADReconciler(c.nadReconcilerID)
+	}
+	controller.Stop(c.namespaceController, c.podController, c.controller, c.nadReconciler)
+	c.nadReconcilerID = 0
+}
+
+// syncNAD requeues all the MulticastGroups when a primary network changes, since
+// the network of the selected pods may have changed.
+func (c *Controller) syncNAD(key string) error {
+	if ni := c.networkManager.GetNetInfoForNADKey(key); ni != nil && !ni.IsPrimaryNetwork() {
+		return nil
+	}
+	c.controller.ReconcileAll()
+	return nil
+}
+
+// initialSync removes the OVN objects of the MulticastGroups that don't exist anymore.
+func (c *Controller) initialSync() error {
+	mcgs, err := c.mcgLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("%s: failed to list multicast groups: %w", c.name, err)
+	}
+	existing := sets.New[string]()
+	for _, mcg := range mcgs {
+		existing.Insert(mcg.Name)
+	}
+	ops, err := c.deleteGroupsOps(nil, func(name, _ string) bool {
+		return !existing.Has(name)
+	})
+	if err != nil {
+		return err
+	}
+	_, err = libovsdbops.TransactAndCheck(c.nbClient, ops)
+	return err
+}
+
+func (c *Controller) sync(key string) (syncErr error) {
+	startTime := time.Now()
+	klog.V(5).Infof("%s: syncing MulticastGroup %s", c.name, key)
+	defer func() {
+		klog.V(4).Infof("%s: finished syncing MulticastGroup %s, took %v", c.name, key, time.Since(startTime))
+	}()
+
+	c.cache.LockKey(key)
+	defer c.cache.UnlockKey(key)
+
+	mcg, err := c.mcgLister.Get(key)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("%s: removing MulticastGroup %s", c.name, key)
+		ops, err := c.deleteGroupsOps(nil, func(name, _ string) bool {
+			return name == key
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+			return fmt.Errorf("failed to delete MulticastGroup %s: %w", key, err)
+		}
+		c.cache.Delete(key)
+		return nil
+	}
+
+	defer func() {
+		if statusErr := c.setMulticastGroupStatus(mcg, syncErr); statusErr != nil {
+			syncErr = errors.Join(syncErr, fmt.Errorf("failed to update status of MulticastGroup %s: %w", key, statusErr))
+		}
+	}()
+
+	entry := &cacheEntry{
+		pods:       sets.New[string](),
+		namespaces: sets.New[string](),
+	}
+	networks, err := c.getNetworkGroups(mcg, entry)
+	// store the selected pods even on error, so that a later change of these
+	// pods triggers a new sync
+	c.cache.Store(key, entry)
+	if err != nil {
+		return err
+	}
+
+	var ops []ovsdb.Operation
+	owners := sets.New[string]()
+	for _, group := range networks {
+		owner := getOwnerController(group.netInfo)
+		owners.Insert(owner)
+		ops, err = c.ensureGroupOps(ops, mcg, owner, group)
+		if err != nil {
+			return fmt.Errorf("failed to build ops for MulticastGroup %s on network %s: %w",
+				key, group.netInfo.GetNetworkName(), err)
+		}
+	}
+	// remove the group from the networks where no pod is selected anymore
+	ops, err = c.deleteGroupsOps(ops, func(name, owner string) bool {
+		return name == key && !owners.Has(owner)
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to configure MulticastGroup %s: %w", key, err)
+	}
+	return nil
+}
+
+// getNetworkGroups returns the desired state of the given MulticastGroup on every
+// network that has selected pods, and records the selected pods in entry.
+// The state is computed for all the pods that can be resolved, an error is
+// returned if any of the local pods could not be.
+func (c *Controller) getNetworkGroups(mcg *mcgapi.MulticastGroup, entry *cacheEntry) (map[string]*networkGroup, error) {
+	networks := map[string]*networkGroup{}
+	var errs []error
+	for _, role := range []string{producersType, consumersType} {
+		peers := mcg.Spec.Producers
+		if role == consumersType {
+			peers = mcg.Spec.Consumers
+		}
+		for _, peer := range peers {
+			pods, err := c.getPeerPods(peer)
+			if err != nil {
+				return nil, err
+			}
+			for _, pod := range pods {
+				entry.pods.Insert(pod.Namespace + "/" + pod.Name)
+				entry.namespaces.Insert(pod.Namespace)
+				if err := c.addPod(networks, role, pod); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	return networks, errors.Join(errs...)
+}
+
+// getPeerPods returns the pods selected by the given peer.
+func (c *Controller) getPeerPods(peer mcgapi.MulticastGroupPeer) ([]*corev1.Pod, error) {
+	namespaceSelector, err := metav1.LabelSelectorAsSelector(&peer.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector: %w", err)
+	}
+	podSelector, err := metav1.LabelSelectorAsSelector(&peer.PodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pod selector: %w", err)
+	}
+	namespaces, err := c.namespaceLister.List(namespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query namespaces: %w", err)
+	}
+	var selected []*corev1.Pod
+	for _, ns := range namespaces {
+		pods, err := c.podLister.Pods(ns.Name).List(podSelector)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query pods in namespace %s: %w", ns.Name, err)
+		}
+		for _, pod := range pods {
+			if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) {
+				continue
+			}
+			selected = append(selected, pod)
+		}
+	}
+	return selected, nil
+}
+
+// addPod adds the given pod with the given role to the group of its primary network.
+func (c *Controller) addPod(networks map[string]*networkGroup, role string, pod *corev1.Pod) error {
+	netInfo := c.networkManager.GetActiveNetworkForNamespaceFast(pod.Namespace)
+	if netInfo == nil {
+		// the namespace network is not served by this zone
+		return nil
+	}
+	nadKey := types.DefaultNetworkName
+	if !netInfo.IsDefault() {
+		var err error
+		nadKey, err = c.networkManager.GetPrimaryNADForNamespace(pod.Namespace)
+		if err != nil {
+			return fmt.Errorf("failed to get primary NAD for namespace %s: %w", pod.Namespace, err)
+		}
+	}
+	podAnnotation, err := util.UnmarshalPodAnnotation(pod.Annotations, nadKey)
+	if err != nil {
+		if util.IsAnnotationNotSetError(err) {
+			// the pod will be requeued once it gets its IPs
+			return nil
+		}
+		return fmt.Errorf("failed to get IPs of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+
+	group := networks[netInfo.GetNetworkName()]
+	if group == nil {
+		group = &networkGroup{netInfo: netInfo}
+		networks[netInfo.GetNetworkName()] = group
+	}
+	if role == producersType {
+		for _, ip := range podAnnotation.IPs {
+			group.producerIPs = append(group.producerIPs, ip.IP.String())
+		}
+	}
+
+	local, err := c.isPodInLocalZone(pod)
+	if err != nil || !local {
+		return err
+	}
+	portName := util.GetLogicalPortName(pod.Namespace, pod.Name)
+	if !netInfo.IsDefault() {
+		portName = util.GetUserDefinedNetworkLogicalPortName(pod.Namespace, pod.Name, nadKey)
+	}
+	lsp, err := libovsdbops.GetLogicalSwitchPort(c.nbClient, &nbdb.LogicalSwitchPort{Name: portName})
+	if err != nil {
+		return fmt.Errorf("failed to get logical switch port %s of pod %s/%s: %w", portName, pod.Namespace, pod.Name, err)
+	}
+	if role == producersType {
+		group.producerPorts = append(group.producerPorts, lsp)
+	} else {
+		group.consumerPorts = append(group.consumerPorts, lsp)
+	}
+	return nil
+}
+
+func (c *Controller) isPodInLocalZone(pod *corev1.Pod) (bool, error) {
+	node, err := c.nodeLister.Get(pod.Spec.NodeName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get node %s: %w", pod.Spec.NodeName, err)
+	}
+	return util.GetNodeZone(node) == c.zone, nil
+}
+
+// ensureGroupOps returns the ops to create or update the producer address set, the
+// producer and consumer port groups and their ACLs for the given network.
+func (c *Controller) ensureGroupOps(ops []ovsdb.Operation, mcg *mcgapi.MulticastGroup, owner string,
+	group *networkGroup) ([]ovsdb.Operation, error) {
+	as, asOps, err := c.addressSetFactory.NewAddressSetOps(getProducersAddrSetDbIDs(mcg.Name, owner), group.producerIPs)
+	if err != nil {
+		return nil, err
+	}
+	ops = append(ops, asOps...)
+	asNameV4, asNameV6 := as.GetASHashNames()
+
+	ipv4Mode, ipv6Mode := group.netInfo.IPMode()
+	v4Dst, v6Dst := getDstMatches(mcg.Spec.Groups)
+
+	// producers are allowed to send the multicast traffic of the families
+	// that have allowed destinations
+	producersPGIDs := getPortGroupDbIDs(mcg.Name, producersType, owner)
+	producersPGName := libovsdbutil.GetPortGroupName(producersPGIDs)
+	var producerACLs []*nbdb.ACL
+	if match := joinMatches(ipv4Mode && v4Dst != "", ipv6Mode && v6Dst != "", v4Dst, v6Dst); match != "" {
+		producerACLs = append(producerACLs, buildACL(mcg.Name, producersType, producersPGName, match, libovsdbutil.ACLEgress, owner))
+	}
+
+	// consumers are allowed to send group membership reports and to receive
+	// the multicast traffic of the producers
+	consumersPGIDs := getPortGroupDbIDs(mcg.Name, consumersType, owner)
+	consumersPGName := libovsdbutil.GetPortGroupName(consumersPGIDs)
+	consumerEgressMatch := joinMatches(ipv4Mode, ipv6Mode, "igmp", "(mldv1 || mldv2)")
+	var v4Ingress, v6Ingress string
+	if v4Dst != "" {
+		v4Ingress = "(igmp || (ip4.src == $" + asNameV4 + " && " + v4Dst + "))"
+	} else {
+		v4Ingress = "igmp"
+	}
+	if v6Dst != "" {
+		v6Ingress = "(mldv1 || mldv2 || (ip6.src == $" + asNameV6 + " && " + v6Dst + "))"
+	} else {
+		v6Ingress = "(mldv1 || mldv2)"
+	}
+	consumerIngressMatch := joinMatches(ipv4Mode, ipv6Mode, v4Ingress, v6Ingress)
+	consumerACLs := []*nbdb.ACL{
+		buildACL(mcg.Name, consumersType, consumersPGName, consumerEgressMatch, libovsdbutil.ACLEgress, owner),
+		buildACL(mcg.Name, consumersType, consumersPGName, consumerIngressMatch, libovsdbutil.ACLIngress, owner),
+	}
+
+	ops, err = libovsdbops.CreateOrUpdateACLsOps(c.nbClient, ops, nil, append(producerACLs, consumerACLs...)...)
+	if err != nil {
+		return nil, err
+	}
+	producersPG := libovsdbutil.BuildPortGroup(producersPGIDs, group.producerPorts, producerACLs)
+	consumersPG := libovsdbutil.BuildPortGroup(consumersPGIDs, group.consumerPorts, consumerACLs)
+	return libovsdbops.CreateOrUpdatePortGroupsOps(c.nbClient, ops, producersPG, consumersPG)
+}
+
+// deleteGroupsOps returns the ops to delete the port groups, and with them their
+// ACLs, and the address sets of the MulticastGroups for which isStale returns true.
+func (c *Controller) deleteGroupsOps(ops []ovsdb.Operation, isStale func(name, owner string) bool) ([]ovsdb.Operation, error) {
+	var err error
+	ops, err = libovsdbops.DeletePortGroupsWithPredicateOps(c.nbClient, ops, func(item *nbdb.PortGroup) bool {
+		return isStaleGroupObject(item.ExternalIDs, isStale)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete stale MulticastGroup port groups: %w", err)
+	}
+	ops, err = libovsdbops.DeleteAddressSetsWithPredicateOps(c.nbClient, ops, func(item *nbdb.AddressSet) bool {
+		return isStaleGroupObject(item.ExternalIDs, isStale)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete stale MulticastGroup address sets: %w", err)
+	}
+	return ops, nil
+}
+
+func isStaleGroupObject(externalIDs map[string]string, isStale func(name, owner string) bool) bool {
+	if externalIDs[libovsdbops.OwnerTypeKey.String()] != string(libovsdbops.MulticastGroupOwnerType) {
+		return false
+	}
+	return isStale(externalIDs[libovsdbops.ObjectNameKey.String()], externalIDs[libovsdbops.OwnerControllerKey.String()])
+}
+
+func (c *Controller) setMulticastGroupStatus(mcg *mcgapi.MulticastGroup, syncErr error) error {
+	newCondition := metav1.Condition{
+		Type:               conditionTypeReady + c.zone,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonSetupSuccess,
+		Message:            MulticastGroupAppliedCorrectly,
+		ObservedGeneration: mcg.Generation,
+	}
+	if syncErr != nil {
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = reasonSetupFailed
+		newCondition.Message = types.MulticastGroupErrorMsg + ": " + syncErr.Error()
+	}
+
+	existingCondition := meta.FindStatusCondition(mcg.Status.Conditions, newCondition.Type)
+	if existingCondition != nil && existingCondition.Status == newCondition.Status &&
+		existingCondition.Reason == newCondition.Reason && existingCondition.Message == newCondition.Message &&
+		existingCondition.ObservedGeneration == newCondition.ObservedGeneration {
+		return nil
+	}
+
+	newConditionApply := &metaapplyv1.ConditionApplyConfiguration{
+		Type:               &newCondition.Type,
+		Status:             &newCondition.Status,
+		ObservedGeneration: &newCondition.ObservedGeneration,
+		Reason:             &newCondition.Reason,
+		Message:            &newCondition.Message,
+	}
+	if existingCondition == nil || existingCondition.Status != newCondition.Status {
+		newConditionApply.LastTransitionTime = ptr.To(metav1.NewTime(time.Now()))
+	} else {
+		newConditionApply.LastTransitionTime = &existingCondition.LastTransitionTime
+	}
+
+	applyObj := mcgapply.MulticastGroup(mcg.Name).
+		WithStatus(mcgapply.MulticastGroupStatus().WithConditions(newConditionApply))
+	_, err := c.mcgClient.K8sV1alpha1().MulticastGroups().ApplyStatus(context.TODO(), applyObj,
+		metav1.ApplyOptions{FieldManager: c.zone, Force: true})
+	return err
+}
+
+func mcgNamespaceNeedsUpdate(oldNamespace, newNamespace *corev1.Namespace) bool {
+	if oldNamespace == nil || newNamespace == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldNamespace.Labels, newNamespace.Labels)
+}
+
+func mcgPodNeedsUpdate(oldPod, newPod *corev1.Pod) bool {
+	if oldPod == nil || newPod == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldPod.Labels, newPod.Labels) ||
+		oldPod.Spec.NodeName != newPod.Spec.NodeName ||
+		oldPod.Annotations[types.OvnPodAnnotationName] != newPod.Annotations[types.OvnPodAnnotationName] ||
+		util.PodCompleted(oldPod) != util.PodCompleted(newPod)
+}
+
+func (c *Controller) updateMulticastGroupsForNamespace(namespace string) error {
+	klog.V(5).Infof("Syncing namespace %q for multicast groups", namespace)
+	ns, err := c.namespaceLister.Get(namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return c.reconcileMulticastGroups(fmt.Sprintf("namespace %q", namespace), func(mcg *mcgapi.MulticastGroup, entry *cacheEntry) bool {
+		if entry != nil && entry.namespaces.Has(namespace) {
+			return true
+		}
+		return ns != nil && selectsNamespace(mcg, ns)
+	})
+}
+
+func (c *Controller) updateMulticastGroupsForPod(podKey string) error {
+	klog.V(5).Infof("Syncing pod %q for multicast groups", podKey)
+	namespace, name, err := cache.SplitMetaNamespaceKey(podKey)
+	if err != nil {
+		return err
+	}
+	var ns *corev1.Namespace
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else {
+		ns, err = c.namespaceLister.Get(namespace)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return c.reconcileMulticastGroups(fmt.Sprintf("pod %q", podKey), func(mcg *mcgapi.MulticastGroup, entry *cacheEntry) bool {
+		if entry != nil && entry.pods.Has(podKey) {
+			return true
+		}
+		return pod != nil && ns != nil && selectsPod(mcg, ns, pod)
+	})
+}
+
+// reconcileMulticastGroups queues the MulticastGroups for which needsSync returns
+// true due to a change of the given object.
+func (c *Controller) reconcileMulticastGroups(objDesc string, needsSync func(mcg *mcgapi.MulticastGroup, entry *cacheEntry) bool) error {
+	mcgs, err := c.mcgLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list multicast groups: %w", err)
+	}
+	for _, mcg := range mcgs {
+		if err := c.cache.DoWithLock(mcg.Name, func(key string) error {
+			entry, _ := c.cache.Load(key)
+			if needsSync(mcg, entry) {
+				klog.V(4).Infof("Syncing MulticastGroup %s due to %s change", key, objDesc)
+				c.controller.Reconcile(key)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func selectsNamespace(mcg *mcgapi.MulticastGroup, ns *corev1.Namespace) bool {
+	for _, peer := range slices.Concat(mcg.Spec.Producers, mcg.Spec.Consumers) {
+		if matchesLabels(&peer.NamespaceSelector, ns.Labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func selectsPod(mcg *mcgapi.MulticastGroup, ns *corev1.Namespace, pod *corev1.Pod) bool {
+	for _, peer := range slices.Concat(mcg.Spec.Producers, mcg.Spec.Consumers) {
+		if matchesLabels(&peer.NamespaceSelector, ns.Labels) && matchesLabels(&peer.PodSelector, pod.Labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesLabels(selector *metav1.LabelSelector, objLabels map[string]string) bool {
+	sel, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false
+	}
+	return sel.Matches(labels.Set(objLabels))
+}
+
+func getOwnerController(netInfo util.NetInfo) string {
+	return netInfo.GetNetworkName() + "-network-controller"
+}
+
+func getProducersAddrSetDbIDs(name, owner string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetMulticastGroup, owner,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: name,
+		})
+}
+
+func getPortGroupDbIDs(name, role, owner string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.PortGroupMulticastGroup, owner,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: name,
+			libovsdbops.TypeKey:       role,
+		})
+}
+
+func getACLDbIDs(name, role string, aclDir libovsdbutil.ACLDirection, owner string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLMulticastGroup, owner,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey:      name,
+			libovsdbops.TypeKey:            role,
+			libovsdbops.PolicyDirectionKey: string(aclDir),
+		})
+}
+
+func buildACL(name, role, pgName, match string, aclDir libovsdbutil.ACLDirection, owner string) *nbdb.ACL {
+	return libovsdbutil.BuildACLWithDefaultTier(getACLDbIDs(name, role, aclDir, owner), types.DefaultMcastAllowPriority,
+		libovsdbutil.GetACLMatch(pgName, match, aclDir), nbdb.ACLActionAllow, nil, libovsdbutil.ACLDirectionToACLPipeline(aclDir))
+}
+
+// getDstMatches returns the destination match of the multicast traffic allowed for
+// each IP family, empty if no traffic is allowed for that family.
+func getDstMatches(groups []mcgapi.MulticastGroupAddress) (v4Dst, v6Dst string) {
+	if len(groups) == 0 {
+		return "ip4.mcast", ipv6DynamicMulticastMatch
+	}
+	var v4Groups, v6Groups []string
+	for _, group := range groups {
+		if utilnet.IsIPv6String(string(group)) {
+			v6Groups = append(v6Groups, string(group))
+		} else {
+			v4Groups = append(v4Groups, string(group))
+		}
+	}
+	if len(v4Groups) > 0 {
+		v4Dst = "ip4.dst == {" + strings.Join(v4Groups, ", ") + "}"
+	}
+	if len(v6Groups) > 0 {
+		v6Dst = "ip6.dst == {" + strings.Join(v6Groups, ", ") + "}"
+	}
+	return v4Dst, v6Dst
+}
+
+// joinMatches returns the match of the enabled IP families, empty if none is.
+func joinMatches(ipv4Mode, ipv6Mode bool, ipv4Match, ipv6Match string) string {
+	switch {
+	case ipv4Mode && ipv6Mode:
+		return "(" + ipv4Match + " || " + ipv6Match + ")"
+	case ipv4Mode:
+		return ipv4Match
+	case ipv6Mode:
+		return ipv6Match
+	default:
+		return ""
+	}
+}
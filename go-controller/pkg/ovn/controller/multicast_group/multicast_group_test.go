@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package multicastgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	mcgapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1"
+	mcgfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/clientset/versioned/fake"
+	mcginformerfactory "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/informers/externalversions"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
+	addressset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	ovntest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
+	libovsdbtest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	testZone     = "zone-local"
+	localNode    = "node-local"
+	remoteNode   = "node-remote"
+	mcgName      = "video"
+	defaultOwner = types.DefaultNetworkName + "-network-controller"
+)
+
+type testPod struct {
+	namespace string
+	name      string
+	node      string
+	ip        string
+	labels    map[string]string
+}
+
+func newTestNode(name, zone string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{util.OvnNodeZoneName: zone},
+		},
+	}
+}
+
+func newTestNamespace(name string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"kubernetes.io/metadata.name": name},
+		},
+	}
+}
+
+func newTestPod(t *testing.T, p testPod) *corev1.Pod {
+	annotations, err := util.MarshalPodAnnotation(nil, &util.PodAnnotation{
+		IPs: ovntest.MustParseIPNets(p.ip + "/24"),
+		MAC: util.IPAddrToHWAddr(ovntest.MustParseIP(p.ip)),
+	}, types.DefaultNetworkName)
+	if err != nil {
+		t.Fatalf("failed to marshal pod annotation: %v", err)
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   p.namespace,
+			Name:        p.name,
+			Labels:      p.labels,
+			Annotations: annotations,
+		},
+		Spec: corev1.PodSpec{NodeName: p.node},
+	}
+}
+
+func newTestMulticastGroup(groups ...mcgapi.MulticastGroupAddress) *mcgapi.MulticastGroup {
+	return &mcgapi.MulticastGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: mcgName, Generation: 1},
+		Spec: mcgapi.MulticastGroupSpec{
+			Producers: []mcgapi.MulticastGroupPeer{{
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "source"}},
+				PodSelector:       metav1.LabelSelector{MatchLabels: map[string]string{"role": "producer"}},
+			}},
+			Consumers: []mcgapi.MulticastGroupPeer{{
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "sink"}},
+			}},
+			Groups: groups,
+		},
+	}
+}
+
+// newTestController returns a controller whose listers are populated with the
+// given objects, with the logical switch ports of the local pods in the NB, and
+// the indexer of its MulticastGroup informer.
+func newTestController(t *testing.T, nbData []libovsdbtest.TestData, mcgs []*mcgapi.MulticastGroup, pods []testPod) (*Controller, cache.Indexer) {
+	nodeSwitch := &nbdb.LogicalSwitch{UUID: localNode + "-UUID", Name: localNode}
+	for _, p := range pods {
+		if p.node == localNode {
+			nbData = append(nbData, &nbdb.LogicalSwitchPort{
+				UUID: p.name + "-UUID",
+				Name: util.GetLogicalPortName(p.namespace, p.name),
+			})
+			nodeSwitch.Ports = append(nodeSwitch.Ports, p.name+"-UUID")
+		}
+	}
+	nbData = append(nbData, nodeSwitch)
+	nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{NBData: nbData}, nil)
+	if err != nil {
+		t.Fatalf("failed to create NB test harness: %v", err)
+	}
+	t.Cleanup(cleanup.Cleanup)
+
+	kubeFactory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	nodeInformer := kubeFactory.Core().V1().Nodes()
+	namespaceInformer := kubeFactory.Core().V1().Namespaces()
+	podInformer := kubeFactory.Core().V1().Pods()
+	for _, node := range []*corev1.Node{newTestNode(localNode, testZone), newTestNode(remoteNode, "zone-remote")} {
+		if err := nodeInformer.Informer().GetIndexer().Add(node); err != nil {
+			t.Fatalf("failed to add node: %v", err)
+		}
+	}
+	for _, ns := range []string{"source", "sink", "other"} {
+		if err := namespaceInformer.Informer().GetIndexer().Add(newTestNamespace(ns)); err != nil {
+			t.Fatalf("failed to add namespace: %v", err)
+		}
+	}
+	for _, p := range pods {
+		if err := podInformer.Informer().GetIndexer().Add(newTestPod(t, p)); err != nil {
+			t.Fatalf("failed to add pod: %v", err)
+		}
+	}
+
+	mcgClient := mcgfake.NewSimpleClientset()
+	mcgInformer := mcginformerfactory.NewSharedInformerFactory(mcgClient, 0).K8s().V1alpha1().MulticastGroups()
+	for _, mcg := range mcgs {
+		if _, err := mcgClient.K8sV1alpha1().MulticastGroups().Create(context.TODO(), mcg, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("failed to create multicast group: %v", err)
+		}
+		if err := mcgInformer.Informer().GetIndexer().Add(mcg); err != nil {
+			t.Fatalf("failed to add multicast group: %v", err)
+		}
+	}
+
+	c := NewController("test-multicast-group-controller", testZone, mcgClient, nbClient,
+		addressset.NewOvnAddressSetFactory(nbClient, config.IPv4Mode, config.IPv6Mode),
+		namespaceInformer, nodeInformer, podInformer, mcgInformer, &networkmanager.FakeNetworkManager{})
+	return c, mcgInformer.Informer().GetIndexer()
+}
+
+func getGroupPortGroup(g *gomega.WithT, c *Controller, role string) *nbdb.PortGroup {
+	pg, err := libovsdbops.GetPortGroup(c.nbClient, &nbdb.PortGroup{
+		Name: libovsdbutil.GetPortGroupName(getPortGroupDbIDs(mcgName, role, defaultOwner)),
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	return pg
+}
+
+func getPortUUID(g *gomega.WithT, c *Controller, namespace, name string) string {
+	lsp, err := libovsdbops.GetLogicalSwitchPort(c.nbClient, &nbdb.LogicalSwitchPort{
+		Name: util.GetLogicalPortName(namespace, name),
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	return lsp.UUID
+}
+
+func getGroupACLMatches(g *gomega.WithT, c *Controller, pg *nbdb.PortGroup) []string {
+	acls, err := libovsdbops.FindACLsWithPredicate(c.nbClient, func(acl *nbdb.ACL) bool {
+		for _, uuid := range pg.ACLs {
+			if acl.UUID == uuid {
+				return true
+			}
+		}
+		return false
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	matches := make([]string, 0, len(acls))
+	for _, acl := range acls {
+		g.Expect(acl.Priority).To(gomega.Equal(types.DefaultMcastAllowPriority))
+		g.Expect(acl.Action).To(gomega.Equal(nbdb.ACLActionAllow))
+		matches = append(matches, acl.Match)
+	}
+	return matches
+}
+
+func getGroupAddresses(g *gomega.WithT, c *Controller) []string {
+	asNameV4, _ := addressset.GetHashNamesForAS(getProducersAddrSetDbIDs(mcgName, defaultOwner))
+	addressSets, err := libovsdbops.FindAddressSetsWithPredicate(c.nbClient, func(as *nbdb.AddressSet) bool {
+		return as.Name == asNameV4
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(addressSets).To(gomega.HaveLen(1))
+	return addressSets[0].Addresses
+}
+
+func TestMulticastGroupSync(t *testing.T) {
+	pods := []testPod{
+		{namespace: "source", name: "producer-local", node: localNode, ip: "10.128.1.3", labels: map[string]string{"role": "producer"}},
+		{namespace: "source", name: "producer-remote", node: remoteNode, ip: "10.128.2.3", labels: map[string]string{"role": "producer"}},
+		{namespace: "source", name: "other", node: localNode, ip: "10.128.1.4"},
+		{namespace: "sink", name: "consumer-local", node: localNode, ip: "10.128.1.5"},
+		{namespace: "sink", name: "consumer-remote", node: remoteNode, ip: "10.128.2.5"},
+		{namespace: "other", name: "not-selected", node: localNode, ip: "10.128.1.6"},
+	}
+	producersPGName := libovsdbutil.GetPortGroupName(getPortGroupDbIDs(mcgName, producersType, defaultOwner))
+	consumersPGName := libovsdbutil.GetPortGroupName(getPortGroupDbIDs(mcgName, consumersType, defaultOwner))
+	asNameV4, _ := addressset.GetHashNamesForAS(getProducersAddrSetDbIDs(mcgName, defaultOwner))
+
+	tests := []struct {
+		name                 string
+		groups               []mcgapi.MulticastGroupAddress
+		expectedProducerACLs []string
+		expectedConsumerACLs []string
+	}{
+		{
+			name: "allows any multicast group when groups are not set",
+			expectedProducerACLs: []string{
+				"inport == @" + producersPGName + " && ip4.mcast",
+			},
+			expectedConsumerACLs: []string{
+				"inport == @" + consumersPGName + " && igmp",
+				"outport == @" + consumersPGName + " && (igmp || (ip4.src == $" + asNameV4 + " && ip4.mcast))",
+			},
+		},
+		{
+			name:   "allows only the listed multicast groups",
+			groups: []mcgapi.MulticastGroupAddress{"239.1.1.1", "239.1.1.2", "ff3e::4321:1234"},
+			expectedProducerACLs: []string{
+				"inport == @" + producersPGName + " && ip4.dst == {239.1.1.1, 239.1.1.2}",
+			},
+			expectedConsumerACLs: []string{
+				"inport == @" + consumersPGName + " && igmp",
+				"outport == @" + consumersPGName + " && (igmp || (ip4.src == $" + asNameV4 + " && ip4.dst == {239.1.1.1, 239.1.1.2}))",
+			},
+		},
+		{
+			name:                 "allows no multicast traffic of a family without listed groups",
+			groups:               []mcgapi.MulticastGroupAddress{"ff3e::4321:1234"},
+			expectedProducerACLs: []string{},
+			expectedConsumerACLs: []string{
+				"inport == @" + consumersPGName + " && igmp",
+				"outport == @" + consumersPGName + " && igmp",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+			config.IPv4Mode = true
+			config.IPv6Mode = false
+
+			c, _ := newTestController(t, nil, []*mcgapi.MulticastGroup{newTestMulticastGroup(tt.groups...)}, pods)
+			g.Expect(c.sync(mcgName)).To(gomega.Succeed())
+
+			g.Expect(getGroupAddresses(g, c)).To(gomega.ConsistOf("10.128.1.3", "10.128.2.3"))
+
+			producersPG := getGroupPortGroup(g, c, producersType)
+			g.Expect(producersPG.Ports).To(gomega.ConsistOf(getPortUUID(g, c, "source", "producer-local")))
+			g.Expect(getGroupACLMatches(g, c, producersPG)).To(gomega.ConsistOf(tt.expectedProducerACLs))
+
+			consumersPG := getGroupPortGroup(g, c, consumersType)
+			g.Expect(consumersPG.Ports).To(gomega.ConsistOf(getPortUUID(g, c, "sink", "consumer-local")))
+			g.Expect(getGroupACLMatches(g, c, consumersPG)).To(gomega.ConsistOf(tt.expectedConsumerACLs))
+
+			mcg, err := c.mcgClient.K8sV1alpha1().MulticastGroups().Get(context.TODO(), mcgName, metav1.GetOptions{})
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(mcg.Status.Conditions).To(gomega.HaveLen(1))
+			g.Expect(mcg.Status.Conditions[0].Type).To(gomega.Equal(conditionTypeReady + testZone))
+			g.Expect(mcg.Status.Conditions[0].Status).To(gomega.Equal(metav1.ConditionTrue))
+			g.Expect(mcg.Status.Conditions[0].Message).To(gomega.Equal(MulticastGroupAppliedCorrectly))
+		})
+	}
+}
+
+func TestMulticastGroupDelete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+	config.IPv4Mode = true
+	config.IPv6Mode = false
+
+	pods := []testPod{
+		{namespace: "source", name: "producer-local", node: localNode, ip: "10.128.1.3", labels: map[string]string{"role": "producer"}},
+		{namespace: "sink", name: "consumer-local", node: localNode, ip: "10.128.1.5"},
+	}
+	// an unrelated port group must survive the cleanup
+	otherPG := &nbdb.PortGroup{UUID: "other-pg-UUID", Name: "other", ExternalIDs: map[string]string{"name": "other"}}
+	c, mcgIndexer := newTestController(t, []libovsdbtest.TestData{otherPG}, []*mcgapi.MulticastGroup{newTestMulticastGroup()}, pods)
+	g.Expect(c.sync(mcgName)).To(gomega.Succeed())
+
+	isGroupObject := func(externalIDs map[string]string) bool {
+		return externalIDs[libovsdbops.OwnerTypeKey.String()] == string(libovsdbops.MulticastGroupOwnerType)
+	}
+	countObjects := func() (int, int) {
+		pgs, err := libovsdbops.FindPortGroupsWithPredicate(c.nbClient, func(pg *nbdb.PortGroup) bool {
+			return isGroupObject(pg.ExternalIDs)
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		addressSets, err := libovsdbops.FindAddressSetsWithPredicate(c.nbClient, func(as *nbdb.AddressSet) bool {
+			return isGroupObject(as.ExternalIDs)
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return len(pgs), len(addressSets)
+	}
+	pgCount, asCount := countObjects()
+	g.Expect(pgCount).To(gomega.Equal(2))
+	g.Expect(asCount).To(gomega.Equal(1))
+
+	// initial sync keeps the objects of existing groups
+	g.Expect(c.initialSync()).To(gomega.Succeed())
+	pgCount, asCount = countObjects()
+	g.Expect(pgCount).To(gomega.Equal(2))
+	g.Expect(asCount).To(gomega.Equal(1))
+
+	// and removes those of deleted groups
+	g.Expect(mcgIndexer.Delete(newTestMulticastGroup())).To(gomega.Succeed())
+	g.Expect(c.initialSync()).To(gomega.Succeed())
+	pgCount, asCount = countObjects()
+	g.Expect(pgCount).To(gomega.Equal(0))
+	g.Expect(asCount).To(gomega.Equal(0))
+	_, err := libovsdbops.GetPortGroup(c.nbClient, otherPG)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// as does a sync of a deleted group
+	g.Expect(mcgIndexer.Add(newTestMulticastGroup())).To(gomega.Succeed())
+	g.Expect(c.sync(mcgName)).To(gomega.Succeed())
+	pgCount, _ = countObjects()
+	g.Expect(pgCount).To(gomega.Equal(2))
+	g.Expect(mcgIndexer.Delete(newTestMulticastGroup())).To(gomega.Succeed())
+	g.Expect(c.sync(mcgName)).To(gomega.Succeed())
+	pgCount, asCount = countObjects()
+	g.Expect(pgCount).To(gomega.Equal(0))
+	g.Expect(asCount).To(gomega.Equal(0))
+	_, ok := c.cache.Load(mcgName)
+	g.Expect(ok).To(gomega.BeFalse())
+}
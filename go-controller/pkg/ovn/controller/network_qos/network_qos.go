@@ -6,12 +6,14 @@ package networkqos
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	knet "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -113,13 +115,42 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 		}
 	}
 
-	// set EgressRules to desiredNQOSState
+	// set EgressRules and IngressRules to desiredNQOSState
+	var err error
+	if desiredNQOSState.EgressRules, err = buildGressRules(nqos.Spec.Priority, nqos.Spec.Egress, knet.PolicyTypeEgress); err != nil {
+		c.updateNQOSStatusToNotReady(nqos.Namespace, nqos.Name, "failed to parse egress rules", err)
+		return nil
+	}
+	if desiredNQOSState.IngressRules, err = buildGressRules(nqos.Spec.Priority, nqos.Spec.Ingress, knet.PolicyTypeIngress); err != nil {
+		c.updateNQOSStatusToNotReady(nqos.Namespace, nqos.Name, "failed to parse ingress rules", err)
+		return nil
+	}
+	if err := desiredNQOSState.initAddressSets(c.addressSetFactory, c.controllerName); err != nil {
+		return err
+	}
+	if err := c.resyncPods(desiredNQOSState); err != nil {
+		return fmt.Errorf("failed to resync pods: %w", err)
+	}
+	// delete stale rules left from previous NetworkQoS definition, along with the address sets
+	if err := c.cleanupStaleOvnObjects(desiredNQOSState); err != nil {
+		return fmt.Errorf("failed to delete stale QoSes: %w", err)
+	}
+	c.nqosCache.Store(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name), desiredNQOSState)
+	if e := c.updateNQOSStatusToReady(nqos.Namespace, nqos.Name); e != nil {
+		return fmt.Errorf("successfully reconciled NetworkQoS %s/%s, but failed to patch status: %v", nqos.Namespace, nqos.Name, e)
+	}
+	return nil
+}
+
+// buildGressRules converts the egress or ingress rules of a NetworkQoS to their state objects. The peers of
+// a rule are taken from classifier.to for egress rules, and from classifier.from for ingress rules.
+func buildGressRules(qosPriority int, ruleSpecs []networkqosapi.Rule, direction knet.PolicyType) ([]*GressRule, error) {
 	rules := []*GressRule{}
-	for index, ruleSpec := range nqos.Spec.Egress {
+	for index, ruleSpec := range ruleSpecs {
 		bwRate := int(ruleSpec.Bandwidth.Rate)
 		bwBurst := int(ruleSpec.Bandwidth.Burst)
 		ruleState := &GressRule{
-			Priority: getQoSRulePriority(nqos.Spec.Priority, index),
+			Priority: getQoSRulePriority(qosPriority, index),
 			Dscp:     ruleSpec.DSCP,
 		}
 		if bwRate > 0 {
@@ -128,23 +159,27 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 		if bwBurst > 0 {
 			ruleState.Burst = &bwBurst
 		}
+		peers := ruleSpec.Classifier.To
+		if direction == knet.PolicyTypeIngress {
+			peers = ruleSpec.Classifier.From
+		}
 		destStates := []*Destination{}
-		for _, destSpec := range ruleSpec.Classifier.To {
+		for _, destSpec := range peers {
 			if destSpec.IPBlock != nil && (destSpec.PodSelector != nil || destSpec.NamespaceSelector != nil) {
-				return fmt.Errorf("specifying both ipBlock and podSelector/namespaceSelector is not allowed")
+				return nil, fmt.Errorf("specifying both ipBlock and podSelector/namespaceSelector is not allowed")
 			}
 			destState := &Destination{}
 			destState.IpBlock = destSpec.IPBlock.DeepCopy()
 			if destSpec.NamespaceSelector != nil && (len(destSpec.NamespaceSelector.MatchLabels) > 0 || len(destSpec.NamespaceSelector.MatchExpressions) > 0) {
 				if selector, err := metav1.LabelSelectorAsSelector(destSpec.NamespaceSelector); err != nil {
-					return fmt.Errorf("error parsing destination namespace selector: %v", err)
+					return nil, fmt.Errorf("error parsing %s peer namespace selector: %v", strings.ToLower(string(direction)), err)
 				} else {
 					destState.NamespaceSelector = selector
 				}
 			}
 			if destSpec.PodSelector != nil && (len(destSpec.PodSelector.MatchLabels) > 0 || len(destSpec.PodSelector.MatchExpressions) > 0) {
 				if selector, err := metav1.LabelSelectorAsSelector(destSpec.PodSelector); err != nil {
-					return fmt.Errorf("error parsing destination pod selector: %v", err)
+					return nil, fmt.Errorf("error parsing %s peer pod selector: %v", strings.ToLower(string(direction)), err)
 				} else {
 					destState.PodSelector = selector
 				}
@@ -157,22 +192,7 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 		ruleState.Classifier.Ports = ruleSpec.Classifier.Ports
 		rules = append(rules, ruleState)
 	}
-	desiredNQOSState.EgressRules = rules
-	if err := desiredNQOSState.initAddressSets(c.addressSetFactory, c.controllerName); err != nil {
-		return err
-	}
-	if err := c.resyncPods(desiredNQOSState); err != nil {
-		return fmt.Errorf("failed to resync pods: %w", err)
-	}
-	// delete stale rules left from previous NetworkQoS definition, along with the address sets
-	if err := c.cleanupStaleOvnObjects(desiredNQOSState); err != nil {
-		return fmt.Errorf("failed to delete stale QoSes: %w", err)
-	}
-	c.nqosCache.Store(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name), desiredNQOSState)
-	if e := c.updateNQOSStatusToReady(nqos.Namespace, nqos.Name); e != nil {
-		return fmt.Errorf("successfully reconciled NetworkQoS %s/%s, but failed to patch status: %v", nqos.Namespace, nqos.Name, e)
-	}
-	return nil
+	return rules, nil
 }
 
 // clearNetworkQos will handle the logic for deleting all db objects related
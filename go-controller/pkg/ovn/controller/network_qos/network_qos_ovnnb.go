@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"strconv"
 
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
 	"github.com/ovn-kubernetes/libovsdb/ovsdb"
@@ -35,32 +34,36 @@ func (c *Controller) addQoSToLogicalSwitch(qosState *networkQoSState, switchName
 	if err != nil {
 		return err
 	}
-	// construct qoses
+	// construct qoses, both egress and ingress rules are applied as to-lport QoSes on the switches
+	// of the selected pods, egress ones match the traffic sent by the pods and ingress ones the
+	// traffic received by them.
 	qoses := []*nbdb.QoS{}
 	ipv4Enabled, ipv6Enabled := c.IPMode()
-	for index, rule := range qosState.EgressRules {
-		dbIDs := qosState.getDbObjectIDs(c.controllerName, index)
-		qos := &nbdb.QoS{
-			Action:      map[string]int{},
-			Bandwidth:   map[string]int{},
-			Direction:   nbdb.QoSDirectionToLport,
-			ExternalIDs: dbIDs.GetExternalIDs(),
-			Match:       generateNetworkQoSMatch(qosState, rule, ipv4Enabled, ipv6Enabled),
-			Priority:    rule.Priority,
-		}
-		if c.IsUserDefinedNetwork() {
-			qos.ExternalIDs[types.NetworkExternalID] = c.GetNetworkName()
-		}
-		if rule.Dscp >= 0 {
-			qos.Action[nbdb.QoSActionDSCP] = rule.Dscp
-		}
-		if rule.Rate != nil && *rule.Rate > 0 {
-			qos.Bandwidth[nbdb.QoSBandwidthRate] = *rule.Rate
-		}
-		if rule.Burst != nil && *rule.Burst > 0 {
-			qos.Bandwidth[nbdb.QoSBandwidthBurst] = *rule.Burst
+	for _, direction := range policyDirections {
+		for index, rule := range qosState.getRules(direction) {
+			dbIDs := qosState.getDbObjectIDs(c.controllerName, direction, index)
+			qos := &nbdb.QoS{
+				Action:      map[string]int{},
+				Bandwidth:   map[string]int{},
+				Direction:   nbdb.QoSDirectionToLport,
+				ExternalIDs: dbIDs.GetExternalIDs(),
+				Match:       generateNetworkQoSMatch(qosState, rule, direction, ipv4Enabled, ipv6Enabled),
+				Priority:    rule.Priority,
+			}
+			if c.IsUserDefinedNetwork() {
+				qos.ExternalIDs[types.NetworkExternalID] = c.GetNetworkName()
+			}
+			if rule.Dscp >= 0 {
+				qos.Action[nbdb.QoSActionDSCP] = rule.Dscp
+			}
+			if rule.Rate != nil && *rule.Rate > 0 {
+				qos.Bandwidth[nbdb.QoSBandwidthRate] = *rule.Rate
+			}
+			if rule.Burst != nil && *rule.Burst > 0 {
+				qos.Bandwidth[nbdb.QoSBandwidthBurst] = *rule.Burst
+			}
+			qoses = append(qoses, qos)
 		}
-		qoses = append(qoses, qos)
 	}
 	ops := []ovsdb.Operation{}
 	ops, err = libovsdbops.CreateOrUpdateQoSesOps(c.nbClient, ops, qoses...)
@@ -123,16 +126,9 @@ func (c *Controller) cleanupStaleOvnObjects(qosState *networkQoSState) error {
 		return fmt.Errorf("error looking up existing QoSes for %s/%s: %v", qosState.namespace, qosState.name, err)
 	}
 	staleSwitchQoSMap := map[string][]*nbdb.QoS{}
-	totalNumOfRules := len(qosState.EgressRules)
 	for _, qos := range existingQoSes {
-		index := qos.ExternalIDs[libovsdbops.RuleIndex.String()]
-		numIndex, convError := strconv.Atoi(index)
-		indexWithinRange := false
-		if index != "" && convError == nil && numIndex < totalNumOfRules {
-			// rule index is valid
-			indexWithinRange = true
-		}
-		// qos is considered stale since the index is out of range
+		// qos is considered stale if there is no rule with its direction and index
+		ruleInUse := qosState.isRuleInUse(qos.ExternalIDs[libovsdbops.PolicyDirectionKey.String()], qos.ExternalIDs[libovsdbops.RuleIndex.String()])
 		// get switches that reference to the stale qos
 		switches, err := libovsdbops.FindLogicalSwitchesWithPredicate(c.nbClient, func(ls *nbdb.LogicalSwitch) bool {
 			return util.SliceHasStringItem(ls.QOSRules, qos.UUID)
@@ -145,7 +141,7 @@ func (c *Controller) cleanupStaleOvnObjects(qosState *networkQoSState) error {
 		}
 		// build map of switch->list(qos)
 		for _, ls := range switches {
-			if _, qosInUse := qosState.SwitchRefs.Load(ls.Name); indexWithinRange && qosInUse {
+			if _, qosInUse := qosState.SwitchRefs.Load(ls.Name); ruleInUse && qosInUse {
 				continue
 			}
 			qosList := staleSwitchQoSMap[ls.Name]
@@ -5,6 +5,7 @@ package networkqos
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -94,7 +95,7 @@ func (c *Controller) setPodForNQOS(pod *corev1.Pod, nqosState *networkQoSState,
 
 func reconcilePodForDestinations(nqosState *networkQoSState, podNs *corev1.Namespace, pod *corev1.Pod, addresses []string, addressSetMap map[string]sets.Set[string]) error {
 	fullPodName := joinMetaNamespaceAndName(pod.Namespace, pod.Name)
-	for _, rule := range nqosState.EgressRules {
+	for _, rule := range slices.Concat(nqosState.EgressRules, nqosState.IngressRules) {
 		for index, dest := range rule.Classifier.Destinations {
 			if dest.PodSelector == nil && dest.NamespaceSelector == nil {
 				continue
@@ -137,9 +138,9 @@ func (c *Controller) getNetworkQosForPodChange(eventData *eventData[*corev1.Pod]
 			affectedNetworkQoSes.Insert(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name))
 			continue
 		}
-		// check if pod matches any egress
-		for _, egress := range nqos.Spec.Egress {
-			if podMatchesEgressSelector(podNs, pod, nqos, &egress) {
+		// check if pod matches any egress or ingress rule peer
+		for _, rule := range getRules(nqos) {
+			if podMatchesRuleSelector(podNs, pod, nqos, &rule) {
 				affectedNetworkQoSes.Insert(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name))
 				continue
 			}
@@ -166,12 +167,12 @@ func podMatchesSourceSelector(pod *corev1.Pod, nqos *nqosv1alpha1.NetworkQoS) bo
 	return podSelector.Matches(labels.Set(pod.Labels))
 }
 
-func podMatchesEgressSelector(podNs *corev1.Namespace, pod *corev1.Pod, nqos *nqosv1alpha1.NetworkQoS, egress *nqosv1alpha1.Rule) bool {
+func podMatchesRuleSelector(podNs *corev1.Namespace, pod *corev1.Pod, nqos *nqosv1alpha1.NetworkQoS, rule *nqosv1alpha1.Rule) bool {
 	var nsSelector labels.Selector
 	var podSelector labels.Selector
 	var err error
 	match := false
-	for _, dest := range egress.Classifier.To {
+	for _, dest := range getRulePeers(rule) {
 		if dest.NamespaceSelector != nil {
 			if nsSelector, err = metav1.LabelSelectorAsSelector(dest.NamespaceSelector); err != nil {
 				klog.Errorf("Failed to convert namespace selector in %s/%s: %v", nqos.Namespace, nqos.Name, err)
@@ -212,8 +213,8 @@ func podSelectionChanged(nqos *nqosv1alpha1.NetworkQoS, new *corev1.Pod, old *co
 			return true
 		}
 	}
-	for _, egress := range nqos.Spec.Egress {
-		for _, dest := range egress.Classifier.To {
+	for _, rule := range getRules(nqos) {
+		for _, dest := range getRulePeers(&rule) {
 			if dest.PodSelector == nil {
 				continue
 			}
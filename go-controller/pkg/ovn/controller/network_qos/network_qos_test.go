@@ -331,21 +331,21 @@ var _ = Describe("NetworkQoS Controller", func() {
 
 			By("creates address sets for source and destination pod selectors")
 			{
-				eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName)
-				eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName)
-				eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "1", "0", defaultControllerName)
+				eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "", "src", "0", defaultControllerName)
+				eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName)
+				eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "1", "0", defaultControllerName)
 			}
 
 			By("creates QoS rules in ovn nb")
 			{
-				qos0 := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 0)
-				qos1 := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 1)
+				qos0 := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 0)
+				qos1 := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 1)
 				eventuallySwitchHasQoS("node1", qos0)
 				eventuallySwitchHasQoS("node1", qos1)
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName, "10.192.177.4")
-				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName)
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "", "src", "0", defaultControllerName, "10.192.177.4")
+				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "", "src", "0", defaultControllerName)
 				Expect(err).NotTo(HaveOccurred())
-				dst1AddrSet, err1 := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName)
+				dst1AddrSet, err1 := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName)
 				Expect(err1).NotTo(HaveOccurred())
 				srcHashName4, _ := sourceAddrSet.GetASHashNames()
 				dst1HashName4, _ := dst1AddrSet.GetASHashNames()
@@ -353,7 +353,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				Expect(qos0.Action).To(ContainElement(50))
 				Expect(qos0.Priority).To(Equal(11000))
 				Expect(qos0.Bandwidth).To(ContainElements(10000, 100000))
-				dst3AddrSet, err3 := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "1", "0", defaultControllerName)
+				dst3AddrSet, err3 := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "1", "0", defaultControllerName)
 				Expect(err3).NotTo(HaveOccurred())
 				dst3HashName4, _ := dst3AddrSet.GetASHashNames()
 				Expect(qos1.Match).Should(Equal(fmt.Sprintf("ip4.src == {$%s} && (ip4.dst == {$%s} || (ip4.dst == 128.118.0.0/17 && ip4.dst != {128.118.0.0,128.118.0.255})) && ((tcp && tcp.dst == {8080,8081}) || (udp && udp.dst == {9090,8080}))", srcHashName4, dst3HashName4)))
@@ -380,7 +380,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 			{
 				_, err := fakeKubeClient.CoreV1().Pods(app1Pod.Namespace).Create(context.TODO(), app1Pod, metav1.CreateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
 
 				By("updates match strings if egress rules change")
 				nqosUpdate, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
@@ -389,26 +389,26 @@ var _ = Describe("NetworkQoS Controller", func() {
 				nqosUpdate.Spec.Egress[1].Classifier.To[1].IPBlock.Except = nil
 				_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName)
+				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "", "src", "0", defaultControllerName)
 				Expect(err).NotTo(HaveOccurred())
-				dst1AddrSet, err1 := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName)
+				dst1AddrSet, err1 := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName)
 				Expect(err1).NotTo(HaveOccurred())
 				srcHashName4, _ := sourceAddrSet.GetASHashNames()
 				dst1HashName4, _ := dst1AddrSet.GetASHashNames()
 
 				Eventually(func() string {
-					qos, err := findQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+					qos, err := findQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 0)
 					if err != nil {
 						return err.Error()
 					}
 					return qos.Match
 				}).WithTimeout(10 * time.Second).Should(Equal(fmt.Sprintf("ip4.src == {$%s} && (ip4.dst == {$%s} || (ip4.dst == 128.116.0.0/17 && ip4.dst != {128.116.0.0,128.116.0.255})) && tcp && tcp.dst == {8080,8081}", srcHashName4, dst1HashName4)))
 
-				dst3AddrSet, err3 := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "1", "0", defaultControllerName)
+				dst3AddrSet, err3 := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "1", "0", defaultControllerName)
 				Expect(err3).NotTo(HaveOccurred())
 				dst3HashName4, _ := dst3AddrSet.GetASHashNames()
 				Eventually(func() string {
-					qos, err := findQoS(defaultControllerName, nqosNamespace, nqosName, 1)
+					qos, err := findQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 1)
 					if err != nil {
 						return err.Error()
 					}
@@ -423,7 +423,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				updatePod.ResourceVersion = time.Now().String()
 				_, err := fakeKubeClient.CoreV1().Pods(app1Pod.Namespace).Update(context.TODO(), updatePod, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
 			}
 
 			By("adds IP to destination address set again if pod's labels match the selector")
@@ -432,7 +432,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				updatePod.Labels["component"] = "service1"
 				_, err := fakeKubeClient.CoreV1().Pods(app1Pod.Namespace).Update(context.TODO(), updatePod, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
 			}
 
 			By("removes IP from destination address set if target namespace labels don't match the selector")
@@ -443,7 +443,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				ns.Labels["app"] = "dummy"
 				_, err = fakeKubeClient.CoreV1().Namespaces().Update(context.TODO(), ns, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
 			}
 
 			By("adds IP to destination address set again if namespace's labels match the selector")
@@ -454,7 +454,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				ns.Labels["app"] = "app1"
 				_, err = fakeKubeClient.CoreV1().Namespaces().Update(context.TODO(), ns, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
 			}
 
 			By("removes IP from destination address set if namespace selector changes")
@@ -469,7 +469,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				nqosUpdate.ResourceVersion = time.Now().String()
 				_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
 			}
 
 			By("adds IP to destination address set if namespace selector is restored")
@@ -484,7 +484,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				nqosUpdate.ResourceVersion = time.Now().String()
 				_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
 			}
 
 			app3Pod := &corev1.Pod{
@@ -508,7 +508,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 			{
 				_, err := fakeKubeClient.CoreV1().Pods(app3Pod.Namespace).Create(context.TODO(), app3Pod, metav1.CreateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "1", "0", defaultControllerName, "10.195.188.4")
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "1", "0", defaultControllerName, "10.195.188.4")
 			}
 
 			By("adds new QoS rule to ovn nb when a new Egress rule is added")
@@ -532,11 +532,89 @@ var _ = Describe("NetworkQoS Controller", func() {
 				nqosUpdate.ResourceVersion = time.Now().String()
 				_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 2)
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName, "10.192.177.4")
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "1", "0", defaultControllerName, "10.195.188.4")
-				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "2", "0", defaultControllerName, "10.194.188.4")
+				eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 2)
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "", "src", "0", defaultControllerName, "10.192.177.4")
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "1", "0", defaultControllerName, "10.195.188.4")
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "2", "0", defaultControllerName, "10.194.188.4")
+			}
+
+			By("adds ingress QoS rule to ovn nb when an Ingress rule is added")
+			{
+				nqosUpdate, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				nqosUpdate.Spec.Ingress = []nqostype.Rule{
+					{
+						DSCP: 20,
+						Bandwidth: nqostype.Bandwidth{
+							Rate:  5000,
+							Burst: 50000,
+						},
+						Classifier: nqostype.Classifier{
+							From: []nqostype.Destination{
+								{
+									PodSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											"component": "service1",
+										},
+									},
+									NamespaceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{
+											"app": "app1",
+										},
+									},
+								},
+								{
+									IPBlock: &networkingv1.IPBlock{
+										CIDR: "128.117.0.0/17",
+									},
+								},
+							},
+							Ports: []*nqostype.Port{
+								{
+									Protocol: "tcp",
+									Port:     &port8080,
+								},
+							},
+						},
+					},
+				}
+				nqosUpdate.ResourceVersion = time.Now().String()
+				_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				ingressQoS := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeIngress, 0)
+				eventuallySwitchHasQoS("node1", ingressQoS)
+				eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeIngress, "0", "0", defaultControllerName, "10.194.188.4")
+				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "", "src", "0", defaultControllerName)
+				Expect(err).NotTo(HaveOccurred())
+				ingressAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeIngress, "0", "0", defaultControllerName)
+				Expect(err).NotTo(HaveOccurred())
+				srcHashName4, _ := sourceAddrSet.GetASHashNames()
+				ingressHashName4, _ := ingressAddrSet.GetASHashNames()
+				Expect(ingressQoS.Direction).To(Equal(nbdb.QoSDirectionToLport))
+				Expect(ingressQoS.Match).Should(Equal(fmt.Sprintf("ip4.dst == {$%s} && (ip4.src == {$%s} || ip4.src == 128.117.0.0/17) && tcp && tcp.dst == 8080", srcHashName4, ingressHashName4)))
+				Expect(ingressQoS.Action).To(ContainElement(20))
+				Expect(ingressQoS.Priority).To(Equal(11000))
+				Expect(ingressQoS.Bandwidth).To(ContainElements(5000, 50000))
+				// egress rules are kept
+				for index := range 3 {
+					eventuallySwitchHasQoS("node1", eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, index))
+				}
+			}
+
+			By("deletes stale QoS from ovn nb when Ingress rule is deleted")
+			{
+				ingressQoS, err := findQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeIngress, 0)
+				Expect(err).NotTo(HaveOccurred())
+				nqosUpdate, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				nqosUpdate.ResourceVersion = time.Now().String()
+				nqosUpdate.Spec.Ingress = nil
+				_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				eventuallySwitchHasNoQoS("node1", ingressQoS)
+				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeIngress, 0)
+				eventuallySwitchHasQoS("node1", eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 0))
 			}
 
 			nqos4StreamNet := &nqostype.NetworkQoS{
@@ -592,7 +670,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 			{
 				_, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Create(context.TODO(), nqos4StreamNet, metav1.CreateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, "stream-qos", 0)
+				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, "stream-qos", networkingv1.PolicyTypeEgress, 0)
 			}
 
 			By("will not populate source address set NetworkQos with incorrect namespace selector in spec")
@@ -617,7 +695,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				nqos4StreamNet.ResourceVersion = time.Now().String()
 				_, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqos4StreamNet, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHasNo(streamAddrsetFactory, nqosNamespace, "stream-qos", "src", "0", streamControllerName, "10.128.2.3")
+				eventuallyAddressSetHasNo(streamAddrsetFactory, nqosNamespace, "stream-qos", "", "src", "0", streamControllerName, "10.128.2.3")
 			}
 
 			By("handles NetworkQos on secondary network")
@@ -637,9 +715,9 @@ var _ = Describe("NetworkQoS Controller", func() {
 				nqos4StreamNet.ResourceVersion = time.Now().String()
 				_, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqos4StreamNet, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				qos := eventuallyExpectQoS(streamControllerName, nqosNamespace, "stream-qos", 0)
+				qos := eventuallyExpectQoS(streamControllerName, nqosNamespace, "stream-qos", networkingv1.PolicyTypeEgress, 0)
 				eventuallySwitchHasQoS("stream_node1", qos)
-				eventuallyAddressSetHas(streamAddrsetFactory, nqosNamespace, "stream-qos", "src", "0", streamControllerName, "10.128.2.3")
+				eventuallyAddressSetHas(streamAddrsetFactory, nqosNamespace, "stream-qos", "", "src", "0", streamControllerName, "10.128.2.3")
 			}
 
 			By("uses NetworkQoS source address set if pod selector is not provided in source")
@@ -677,9 +755,9 @@ var _ = Describe("NetworkQoS Controller", func() {
 				}
 				_, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Create(context.TODO(), nqosWithoutSrcSelector, metav1.CreateOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				qos := eventuallyExpectQoS(defaultControllerName, nqosNamespace, "no-source-selector", 0)
-				eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, "no-source-selector", "src", "0", defaultControllerName)
-				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, "no-source-selector", "src", "0", defaultControllerName)
+				qos := eventuallyExpectQoS(defaultControllerName, nqosNamespace, "no-source-selector", networkingv1.PolicyTypeEgress, 0)
+				eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, "no-source-selector", "", "src", "0", defaultControllerName)
+				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, "no-source-selector", "", "src", "0", defaultControllerName)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(sourceAddrSet).NotTo(BeNil())
 				v4HashName, _ := sourceAddrSet.GetASHashNames()
@@ -720,7 +798,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				_, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosWithoutSrcSelector, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
 
-				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, "no-source-selector", "src", "0", defaultControllerName)
+				sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, "no-source-selector", "", "src", "0", defaultControllerName)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(sourceAddrSet).NotTo(BeNil())
 				v4HashName, _ := sourceAddrSet.GetASHashNames()
@@ -728,7 +806,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				// Ensure that QoS priority and Bandwidth have been properly changed by OVN
 				var qos *nbdb.QoS
 				Eventually(func() bool {
-					qos, err = findQoS(defaultControllerName, nqosNamespace, "no-source-selector", 0)
+					qos, err = findQoS(defaultControllerName, nqosNamespace, "no-source-selector", networkingv1.PolicyTypeEgress, 0)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(qos).NotTo(BeNil())
 					return qos.Priority == 10010 && len(qos.Bandwidth) == 0
@@ -740,21 +818,21 @@ var _ = Describe("NetworkQoS Controller", func() {
 			{
 				err := fakeKubeClient.CoreV1().Pods(app1Pod.Namespace).Delete(context.TODO(), app1Pod.Name, metav1.DeleteOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, "0", "0", defaultControllerName, "10.194.188.4")
+				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "0", "0", defaultControllerName, "10.194.188.4")
 			}
 
 			By("removes IP from destination address set of the second rule if namespace is deleted")
 			{
 				err := fakeKubeClient.CoreV1().Namespaces().Delete(context.TODO(), app3Pod.Namespace, metav1.DeleteOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, "1", "0", defaultControllerName, "10.195.188.4")
+				eventuallyAddressSetHasNo(defaultAddrsetFactory, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, "1", "0", defaultControllerName, "10.195.188.4")
 				err = fakeKubeClient.CoreV1().Pods(app3Pod.Namespace).Delete(context.TODO(), app3Pod.Name, metav1.DeleteOptions{})
 				Expect(err).NotTo(HaveOccurred())
 			}
 
 			By("deletes stale QoS from ovn nb when Egress rule is deleted")
 			{
-				qos2, err1 := findQoS(defaultControllerName, nqosNamespace, nqosName, 2)
+				qos2, err1 := findQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 2)
 				Expect(err1).NotTo(HaveOccurred())
 				nqosUpdate, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
 				Expect(err).NotTo(HaveOccurred())
@@ -763,14 +841,14 @@ var _ = Describe("NetworkQoS Controller", func() {
 				_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
 				Expect(err).NotTo(HaveOccurred())
 				eventuallySwitchHasNoQoS("node1", qos2)
-				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, nqosName, 2)
+				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 2)
 			}
 
 			By("unbinds QoS rule from logical switch when no source pods is selected")
 			{
-				qos0, err0 := findQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+				qos0, err0 := findQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 0)
 				Expect(err0).NotTo(HaveOccurred())
-				qos1, err1 := findQoS(defaultControllerName, nqosNamespace, nqosName, 1)
+				qos1, err1 := findQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 1)
 				Expect(err1).NotTo(HaveOccurred())
 				// qos should be present, as pod is not yet deleted
 				eventuallySwitchHasQoS("node1", qos0)
@@ -786,8 +864,8 @@ var _ = Describe("NetworkQoS Controller", func() {
 			{
 				err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Delete(context.TODO(), nqosName, metav1.DeleteOptions{})
 				Expect(err).NotTo(HaveOccurred())
-				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, nqosName, 0)
-				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, nqosName, 1)
+				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 0)
+				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeEgress, 1)
 			}
 
 			By("generates correct logical switch name for localnet topology")
@@ -930,7 +1008,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				klog.Infof("Code path for PrimaryUserDefinedNetworks has been successfully tested")
 
 				// Confirm the primary controller is processing this NetworkQoS and not the default controller
-				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, "primary-network-qos", 0)
+				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, "primary-network-qos", networkingv1.PolicyTypeEgress, 0)
 
 				// Clean up
 				err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Delete(context.TODO(), "primary-network-qos", metav1.DeleteOptions{})
@@ -1057,7 +1135,7 @@ var _ = Describe("NetworkQoS Controller", func() {
 				klog.Infof("Code path for SecondaryUserDefinedNetworks has been successfully tested")
 
 				// Confirm the secondary controller is processing this NetworkQoS and not the default controller
-				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, "secondary-network-qos", 0)
+				eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, "secondary-network-qos", networkingv1.PolicyTypeEgress, 0)
 
 				// Clean up
 				err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Delete(context.TODO(), "secondary-network-qos", metav1.DeleteOptions{})
@@ -1066,18 +1144,76 @@ var _ = Describe("NetworkQoS Controller", func() {
 		},
 		Entry("Interconnect enabled"),
 	)
+
+	Context("When repairing NetworkQoSes at startup", func() {
+		It("should delete the QoSes of gone NetworkQoS objects and rules", func() {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nqosNamespace,
+				},
+			}
+			nqos := &nqostype.NetworkQoS{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: nqosNamespace,
+					Name:      nqosName,
+				},
+				Spec: nqostype.Spec{
+					Priority: 100,
+					Egress: []nqostype.Rule{
+						{
+							DSCP: 50,
+						},
+					},
+				},
+			}
+			staleQoS := func(name string, direction networkingv1.PolicyType, index int) *nbdb.QoS {
+				nqosState := &networkQoSState{namespace: nqosNamespace, name: name}
+				return &nbdb.QoS{
+					UUID:        fmt.Sprintf("%s-%s-%d-UUID", name, direction, index),
+					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
+					Direction:   nbdb.QoSDirectionToLport,
+					Match:       "ip4",
+					Priority:    10100,
+					ExternalIDs: nqosState.getDbObjectIDs(defaultControllerName, direction, index).GetExternalIDs(),
+				}
+			}
+			goneObjectQoS := staleQoS("gone-network-qos", networkingv1.PolicyTypeEgress, 0)
+			goneRuleQoS := staleQoS(nqosName, networkingv1.PolicyTypeIngress, 0)
+			initialDB := &libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					goneObjectQoS,
+					goneRuleQoS,
+					&nbdb.LogicalSwitch{
+						Name:     "node1",
+						QOSRules: []string{goneObjectQoS.UUID, goneRuleQoS.UUID},
+					},
+				},
+			}
+
+			ovnClientset := util.GetOVNClientset(ns, nqos)
+			fakeKubeClient = ovnClientset.KubeClient
+			fakeNQoSClient = ovnClientset.NetworkQoSClient
+			initEnv(ovnClientset, initialDB)
+			initNetworkQoSController(&util.DefaultNetInfo{}, nil, defaultAddrsetFactory, defaultControllerName)
+
+			eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, "gone-network-qos", networkingv1.PolicyTypeEgress, 0)
+			eventuallyExpectNoQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeIngress, 0)
+			eventuallySwitchHasNoQoS("node1", goneObjectQoS)
+			eventuallySwitchHasNoQoS("node1", goneRuleQoS)
+		})
+	})
 })
 
-func eventuallyExpectAddressSet(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName string) {
+func eventuallyExpectAddressSet(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName string, direction networkingv1.PolicyType, qosRuleIndex, ipBlockIndex, controllerName string) {
 	Eventually(func() bool {
-		addrset, _ := findAddressSet(addrsetFactory, nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName)
+		addrset, _ := findAddressSet(addrsetFactory, nqosNamespace, nqosName, direction, qosRuleIndex, ipBlockIndex, controllerName)
 		return addrset != nil
-	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("address set not found for %s/%s, rule %s, address block %s", nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex))
+	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("address set not found for %s/%s, %s rule %s, address block %s", nqosNamespace, nqosName, direction, qosRuleIndex, ipBlockIndex))
 }
 
-func eventuallyAddressSetHas(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName, ip string) {
+func eventuallyAddressSetHas(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName string, direction networkingv1.PolicyType, qosRuleIndex, ipBlockIndex, controllerName, ip string) {
 	Eventually(func() bool {
-		addrset, _ := findAddressSet(addrsetFactory, nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName)
+		addrset, _ := findAddressSet(addrsetFactory, nqosNamespace, nqosName, direction, qosRuleIndex, ipBlockIndex, controllerName)
 		if addrset == nil {
 			return false
 		}
@@ -1086,9 +1222,9 @@ func eventuallyAddressSetHas(addrsetFactory addressset.AddressSetFactory, nqosNa
 	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("address set does not contain expected ip %s", ip))
 }
 
-func eventuallyAddressSetHasNo(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName, ip string) {
+func eventuallyAddressSetHasNo(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName string, direction networkingv1.PolicyType, qosRuleIndex, ipBlockIndex, controllerName, ip string) {
 	Eventually(func() bool {
-		addrset, _ := findAddressSet(addrsetFactory, nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName)
+		addrset, _ := findAddressSet(addrsetFactory, nqosNamespace, nqosName, direction, qosRuleIndex, ipBlockIndex, controllerName)
 		if addrset == nil {
 			return true
 		}
@@ -1097,37 +1233,39 @@ func eventuallyAddressSetHasNo(addrsetFactory addressset.AddressSetFactory, nqos
 	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("address set still has unexpected ip %s", ip))
 }
 
-func findAddressSet(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName string) (addressset.AddressSet, error) {
-	dbID := GetNetworkQoSAddrSetDbIDs(nqosNamespace, nqosName, qosRuleIndex, ipBlockIndex, controllerName)
+func findAddressSet(addrsetFactory addressset.AddressSetFactory, nqosNamespace, nqosName string, direction networkingv1.PolicyType, qosRuleIndex, ipBlockIndex, controllerName string) (addressset.AddressSet, error) {
+	dbID := GetNetworkQoSAddrSetDbIDs(nqosNamespace, nqosName, string(direction), qosRuleIndex, ipBlockIndex, controllerName)
 	return addrsetFactory.GetAddressSet(dbID)
 }
 
-func eventuallyExpectQoS(controllerName, qosNamespace, qosName string, index int) *nbdb.QoS {
+func eventuallyExpectQoS(controllerName, qosNamespace, qosName string, direction networkingv1.PolicyType, index int) *nbdb.QoS {
 	var qos *nbdb.QoS
 	Eventually(func() bool {
-		qos, _ = findQoS(controllerName, qosNamespace, qosName, index)
+		qos, _ = findQoS(controllerName, qosNamespace, qosName, direction, index)
 		return qos != nil
 	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("QoS not found for %s/%s", qosNamespace, qosName))
 	return qos
 }
 
-func eventuallyExpectNoQoS(controllerName, qosNamespace, qosName string, index int) {
+func eventuallyExpectNoQoS(controllerName, qosNamespace, qosName string, direction networkingv1.PolicyType, index int) {
 	var qos *nbdb.QoS
 	Eventually(func() bool {
-		qos, _ = findQoS(controllerName, qosNamespace, qosName, index)
+		qos, _ = findQoS(controllerName, qosNamespace, qosName, direction, index)
 		return qos == nil
-	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("Unexpected QoS found for %s/%s, index %d", qosNamespace, qosName, index))
+	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("Unexpected QoS found for %s/%s, %s index %d", qosNamespace, qosName, direction, index))
 }
 
-func findQoS(controllerName, qosNamespace, qosName string, index int) (*nbdb.QoS, error) {
+func findQoS(controllerName, qosNamespace, qosName string, direction networkingv1.PolicyType, index int) (*nbdb.QoS, error) {
 	qosKey := joinMetaNamespaceAndName(qosNamespace, qosName, ":")
 	dbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.NetworkQoS, controllerName, map[libovsdbops.ExternalIDKey]string{
-		libovsdbops.ObjectNameKey: qosKey,
-		libovsdbops.RuleIndex:     fmt.Sprintf("%d", index),
+		libovsdbops.ObjectNameKey:      qosKey,
+		libovsdbops.PolicyDirectionKey: string(direction),
+		libovsdbops.RuleIndex:          fmt.Sprintf("%d", index),
 	})
 	predicate := libovsdbops.GetPredicate(dbIDs, func(item *nbdb.QoS) bool {
 		return item.ExternalIDs[libovsdbops.OwnerControllerKey.String()] == controllerName &&
 			item.ExternalIDs[libovsdbops.ObjectNameKey.String()] == qosKey &&
+			item.ExternalIDs[libovsdbops.PolicyDirectionKey.String()] == string(direction) &&
 			item.ExternalIDs[libovsdbops.RuleIndex.String()] == strconv.Itoa(index)
 	})
 	qoses, err := libovsdbops.FindQoSesWithPredicate(nbClient, predicate)
@@ -4,8 +4,10 @@
 package networkqos
 
 import (
+	"strconv"
 	"time"
 
+	knet "k8s.io/api/networking/v1"
 	"k8s.io/klog/v2"
 
 	networkqosapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
@@ -31,9 +33,9 @@ func (c *Controller) repairNetworkQoSes() error {
 	}
 
 	// delete stale ovn qos objects owned by NetworkQoS
-	if err := libovsdbops.DeleteQoSesWithPredicate(c.nbClient, func(qos *nbdb.QoS) bool {
+	staleQoSes, err := libovsdbops.FindQoSesWithPredicate(c.nbClient, func(qos *nbdb.QoS) bool {
 		if qos.ExternalIDs[libovsdbops.OwnerControllerKey.String()] != c.controllerName ||
-			qos.ExternalIDs[libovsdbops.OwnerTypeKey.String()] == string(libovsdbops.NetworkQoSOwnerType) {
+			qos.ExternalIDs[libovsdbops.OwnerTypeKey.String()] != string(libovsdbops.NetworkQoSOwnerType) {
 			return false
 		}
 		objName := qos.ExternalIDs[libovsdbops.ObjectNameKey.String()]
@@ -43,13 +45,24 @@ func (c *Controller) repairNetworkQoSes() error {
 			return true
 		}
 		// clean up qoses whose k8s object has gone
-		if _, exists := nqosMap[objName]; !exists {
+		nqos, exists := nqosMap[objName]
+		if !exists {
 			klog.Warningf("OVN QoS %s doesn't have expected NetworkQoS object %s", qos.UUID, objName)
 			return true
 		}
+		// clean up qoses whose egress or ingress rule has gone
+		direction := qos.ExternalIDs[libovsdbops.PolicyDirectionKey.String()]
+		ruleIndex := qos.ExternalIDs[libovsdbops.RuleIndex.String()]
+		if !nqosHasRule(nqos, direction, ruleIndex) {
+			klog.Warningf("OVN QoS %s doesn't have expected %s rule %s in NetworkQoS object %s", qos.UUID, direction, ruleIndex, objName)
+			return true
+		}
 		return false
-	}); err != nil {
-		klog.Errorf("Failed to get ops to clean up stale QoSes: %v", err)
+	})
+	if err != nil {
+		klog.Errorf("Failed to look up stale QoSes: %v", err)
+	} else if err = c.deleteOvnQoSes(staleQoSes); err != nil {
+		klog.Errorf("Failed to clean up stale QoSes: %v", err)
 	}
 
 	// delete address sets whose networkqos object has gone in k8s
@@ -76,3 +89,19 @@ func (c *Controller) repairNetworkQoSes() error {
 
 	return nil
 }
+
+// nqosHasRule returns true if the NetworkQoS has a rule with given direction and index
+func nqosHasRule(nqos *networkqosapi.NetworkQoS, direction, ruleIndex string) bool {
+	index, err := strconv.Atoi(ruleIndex)
+	if err != nil || index < 0 {
+		return false
+	}
+	switch knet.PolicyType(direction) {
+	case knet.PolicyTypeEgress:
+		return index < len(nqos.Spec.Egress)
+	case knet.PolicyTypeIngress:
+		return index < len(nqos.Spec.Ingress)
+	default:
+		return false
+	}
+}
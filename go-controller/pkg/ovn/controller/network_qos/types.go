@@ -40,35 +40,65 @@ type networkQoSState struct {
 
 	// egressRules stores the objects needed to track .Spec.Egress changes
 	EgressRules []*GressRule
+	// ingressRules stores the objects needed to track .Spec.Ingress changes
+	IngressRules []*GressRule
+}
+
+// policyDirections are the directions NetworkQoS rules are defined for
+var policyDirections = []knet.PolicyType{knet.PolicyTypeEgress, knet.PolicyTypeIngress}
+
+// getRules returns the egress or ingress rules of the network qos
+func (nqosState *networkQoSState) getRules(direction knet.PolicyType) []*GressRule {
+	if direction == knet.PolicyTypeIngress {
+		return nqosState.IngressRules
+	}
+	return nqosState.EgressRules
 }
 
 func (nqosState *networkQoSState) getObjectNameKey() string {
 	return joinMetaNamespaceAndName(nqosState.namespace, nqosState.name, ":")
 }
 
-func (nqosState *networkQoSState) getDbObjectIDs(controller string, ruleIndex int) *libovsdbops.DbObjectIDs {
+func (nqosState *networkQoSState) getDbObjectIDs(controller string, direction knet.PolicyType, ruleIndex int) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.NetworkQoS, controller, map[libovsdbops.ExternalIDKey]string{
-		libovsdbops.ObjectNameKey: nqosState.getObjectNameKey(),
-		libovsdbops.RuleIndex:     fmt.Sprintf("%d", ruleIndex),
+		libovsdbops.ObjectNameKey:      nqosState.getObjectNameKey(),
+		libovsdbops.PolicyDirectionKey: string(direction),
+		libovsdbops.RuleIndex:          fmt.Sprintf("%d", ruleIndex),
 	})
 }
 
+// isRuleInUse returns true if the network qos has a rule with given direction and index
+func (nqosState *networkQoSState) isRuleInUse(direction string, ruleIndex string) bool {
+	index, err := strconv.Atoi(ruleIndex)
+	if err != nil || index < 0 {
+		return false
+	}
+	switch knet.PolicyType(direction) {
+	case knet.PolicyTypeEgress, knet.PolicyTypeIngress:
+		return index < len(nqosState.getRules(knet.PolicyType(direction)))
+	default:
+		return false
+	}
+}
+
 func (nqosState *networkQoSState) initAddressSets(addressSetFactory addressset.AddressSetFactory, controllerName string) error {
 	var err error
 	// init source address set
-	nqosState.SrcAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, "src", "0", controllerName))
+	nqosState.SrcAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, "", "src", "0", controllerName))
 	if err != nil {
 		return fmt.Errorf("failed to init source address set for %s/%s: %w", nqosState.namespace, nqosState.name, err)
 	}
-	// ensure destination address sets
-	for ruleIndex, rule := range nqosState.EgressRules {
-		for destIndex, dest := range rule.Classifier.Destinations {
-			if dest.NamespaceSelector == nil && dest.PodSelector == nil {
-				continue
-			}
-			dest.DestAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, strconv.Itoa(ruleIndex), strconv.Itoa(destIndex), controllerName))
-			if err != nil {
-				return fmt.Errorf("failed to init destination address set for %s/%s: %w", nqosState.namespace, nqosState.name, err)
+	// ensure peer address sets: destinations of egress rules, sources of ingress rules
+	for _, direction := range policyDirections {
+		for ruleIndex, rule := range nqosState.getRules(direction) {
+			for destIndex, dest := range rule.Classifier.Destinations {
+				if dest.NamespaceSelector == nil && dest.PodSelector == nil {
+					continue
+				}
+				dest.DestAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, string(direction), strconv.Itoa(ruleIndex), strconv.Itoa(destIndex), controllerName))
+				if err != nil {
+					return fmt.Errorf("failed to init %s peer address set for %s/%s: %w", strings.ToLower(string(direction)), nqosState.namespace, nqosState.name, err)
+				}
 			}
 		}
 	}
@@ -170,7 +200,7 @@ func (nqosState *networkQoSState) getAddressSetHashNames() []string {
 		v4Hash, v6Hash := nqosState.SrcAddrSet.GetASHashNames()
 		addrsetNames = append(addrsetNames, v4Hash, v6Hash)
 	}
-	for _, rule := range nqosState.EgressRules {
+	for _, rule := range slices.Concat(nqosState.EgressRules, nqosState.IngressRules) {
 		for _, dest := range rule.Classifier.Destinations {
 			if dest.DestAddrSet != nil {
 				v4Hash, v6Hash := dest.DestAddrSet.GetASHashNames()
@@ -197,8 +227,8 @@ func (nqosState *networkQoSState) cleanupStaleAddresses(addressSetMap map[string
 			}
 		}
 	}
-	for _, egress := range nqosState.EgressRules {
-		for _, dest := range egress.Classifier.Destinations {
+	for _, rule := range slices.Concat(nqosState.EgressRules, nqosState.IngressRules) {
+		for _, dest := range rule.Classifier.Destinations {
 			if dest.DestAddrSet == nil {
 				continue
 			}
@@ -238,33 +268,37 @@ const (
 )
 
 type Classifier struct {
+	// Destinations are the peers of the rule: the destinations for egress
+	// rules, the sources for ingress rules.
 	Destinations []*Destination
 	Ports        []*networkqosv1alpha1.Port
 }
 
-// ToQosMatchString generates dest and protocol/port part of QoS match string, based on
+// ToQosMatchString generates peer and protocol/port part of QoS match string, based on
 // Classifier's destinations, protocol and port fields, example:
 // (ip4.dst == $addr_set_name || (ip4.dst == 128.116.0.0/17 && ip4.dst != {128.116.0.0,128.116.0.255})) && tcp && tcp.dst == 8080
-// Multiple destinations will be connected by "||".
+// Multiple destinations will be connected by "||". The peers are matched on peerDir,
+// which is the destination for egress rules and the source for ingress rules, while
+// ports are always matched on the destination.
 // See https://github.com/ovn-org/ovn/blob/2bdf1129c19d5bd2cd58a3ddcb6e2e7254b05054/ovn-nb.xml#L2942-L3025 for details
-func (c *Classifier) ToQosMatchString(ipv4Enabled, ipv6Enabled bool) string {
+func (c *Classifier) ToQosMatchString(peerDir trafficDirection, ipv4Enabled, ipv6Enabled bool) string {
 	if c == nil {
 		return ""
 	}
 	destMatchStrings := []string{}
 	for _, dest := range c.Destinations {
-		match := "ip4.dst == 0.0.0.0/0 || ip6.dst == ::/0"
+		match := fmt.Sprintf("ip4.%s == 0.0.0.0/0 || ip6.%s == ::/0", peerDir, peerDir)
 		if dest.DestAddrSet != nil {
-			match = addressSetToMatchString(dest.DestAddrSet, trafficDirDest, ipv4Enabled, ipv6Enabled)
+			match = addressSetToMatchString(dest.DestAddrSet, peerDir, ipv4Enabled, ipv6Enabled)
 		} else if dest.IpBlock != nil && dest.IpBlock.CIDR != "" {
 			ipVersion := "ip4"
 			if utilnet.IsIPv6CIDRString(dest.IpBlock.CIDR) {
 				ipVersion = "ip6"
 			}
 			if len(dest.IpBlock.Except) == 0 {
-				match = fmt.Sprintf("%s.%s == %s", ipVersion, trafficDirDest, dest.IpBlock.CIDR)
+				match = fmt.Sprintf("%s.%s == %s", ipVersion, peerDir, dest.IpBlock.CIDR)
 			} else {
-				match = fmt.Sprintf("%s.%s == %s && %s.%s != {%s}", ipVersion, trafficDirDest, dest.IpBlock.CIDR, ipVersion, trafficDirDest, strings.Join(dest.IpBlock.Except, ","))
+				match = fmt.Sprintf("%s.%s == %s && %s.%s != {%s}", ipVersion, peerDir, dest.IpBlock.CIDR, ipVersion, peerDir, strings.Join(dest.IpBlock.Except, ","))
 			}
 		}
 		destMatchStrings = append(destMatchStrings, match)
@@ -5,9 +5,12 @@ package networkqos
 
 import (
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
+	knet "k8s.io/api/networking/v1"
 
+	networkqosapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	addressset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
@@ -25,16 +28,28 @@ func joinMetaNamespaceAndName(namespace, name string, separator ...string) strin
 	return namespace + sep + name
 }
 
-func GetNetworkQoSAddrSetDbIDs(nqosNamespace, nqosName, ruleIndex, ipBlockIndex, controller string) *libovsdbops.DbObjectIDs {
+func GetNetworkQoSAddrSetDbIDs(nqosNamespace, nqosName, direction, ruleIndex, ipBlockIndex, controller string) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetNetworkQoS, controller,
 		map[libovsdbops.ExternalIDKey]string{
-			libovsdbops.ObjectNameKey: joinMetaNamespaceAndName(nqosNamespace, nqosName, ":"),
-			// rule index is the unique id for address set within given objectName
+			libovsdbops.ObjectNameKey:      joinMetaNamespaceAndName(nqosNamespace, nqosName, ":"),
+			libovsdbops.PolicyDirectionKey: direction,
+			// direction and rule index are the unique id for address set within given objectName
 			libovsdbops.RuleIndex:       ruleIndex,
 			libovsdbops.IpBlockIndexKey: ipBlockIndex,
 		})
 }
 
+// getRules returns both the egress and the ingress rules of the NetworkQoS
+func getRules(nqos *networkqosapi.NetworkQoS) []networkqosapi.Rule {
+	return slices.Concat(nqos.Spec.Egress, nqos.Spec.Ingress)
+}
+
+// getRulePeers returns the peers of the rule, classifier.to is only set for
+// egress rules and classifier.from for ingress rules.
+func getRulePeers(rule *networkqosapi.Rule) []networkqosapi.Destination {
+	return slices.Concat(rule.Classifier.To, rule.Classifier.From)
+}
+
 func getPodAddresses(pod *corev1.Pod, networkInfo ovnkutil.NetInfo, resolver func(nadKey string) string) ([]string, error) {
 	// check annotation "k8s.ovn.org/pod-networks" before calling GetPodIPsOfNetwork,
 	// as it's no easy to check if the error is caused by missing annotation, while
@@ -55,10 +70,16 @@ func getPodAddresses(pod *corev1.Pod, networkInfo ovnkutil.NetInfo, resolver fun
 	return addresses, nil
 }
 
-func generateNetworkQoSMatch(qosState *networkQoSState, rule *GressRule, ipv4Enabled, ipv6Enabled bool) string {
-	match := addressSetToMatchString(qosState.SrcAddrSet, trafficDirSource, ipv4Enabled, ipv6Enabled)
+// generateNetworkQoSMatch matches the traffic sent by the selected pods for egress rules,
+// and the traffic received by the selected pods for ingress rules.
+func generateNetworkQoSMatch(qosState *networkQoSState, rule *GressRule, direction knet.PolicyType, ipv4Enabled, ipv6Enabled bool) string {
+	podDir, peerDir := trafficDirSource, trafficDirDest
+	if direction == knet.PolicyTypeIngress {
+		podDir, peerDir = trafficDirDest, trafficDirSource
+	}
+	match := addressSetToMatchString(qosState.SrcAddrSet, podDir, ipv4Enabled, ipv6Enabled)
 
-	classiferMatchString := rule.Classifier.ToQosMatchString(ipv4Enabled, ipv6Enabled)
+	classiferMatchString := rule.Classifier.ToQosMatchString(peerDir, ipv4Enabled, ipv6Enabled)
 	if classiferMatchString != "" {
 		match = match + " && " + classiferMatchString
 	}
@@ -0,0 +1,621 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkconnect
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	metaapply "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-kubernetes/libovsdb/ovsdb"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	networkconnectv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1"
+	cncapply "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1/apis/applyconfiguration/clusternetworkconnect/v1"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	addressset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	ovntypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// allowedTrafficConditionTypePrefix is the prefix of the status condition type that every zone
+	// sets on a CNC with allowedTraffic rules, followed by the zone name.
+	allowedTrafficConditionTypePrefix = "AllowedTrafficReady-In-Zone-"
+	allowedTrafficReasonSuccess       = "Success"
+	allowedTrafficReasonFailed        = "Failed"
+	allowedTrafficAppliedMsg          = "allowedTraffic rules applied"
+
+	allowedTrafficDropACLType = "drop"
+)
+
+// getAllowedTrafficAddressSetDbIDs returns DbObjectIDs for the address set holding the IPs
+// of the pods selected by an allowedTraffic rule.
+func getAllowedTrafficAddressSetDbIDs(cncName string, ruleIndex int) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetClusterNetworkConnectAllowedTraffic, controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: cncName,
+			libovsdbops.RuleIndex:     strconv.Itoa(ruleIndex),
+		})
+}
+
+// buildAllowedTrafficACLDBIDs builds DbObjectIDs for an ACL implementing allowedTraffic on the switch
+// of a connected network.
+func buildAllowedTrafficACLDBIDs(cncName string, networkID int, aclType string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLClusterNetworkConnectAllowedTraffic, controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: cncName,
+			libovsdbops.NetworkIDKey:  strconv.Itoa(networkID),
+			libovsdbops.TypeKey:       aclType,
+		})
+}
+
+// connectedNamespace is a namespace whose primary network is connected by a CNC.
+type connectedNamespace struct {
+	namespace *corev1.Namespace
+	netInfo   util.NetInfo
+}
+
+// syncAllowedTraffic programs the allowedTraffic rules of a CNC:
+//   - an address set per rule with the IPs of the pods in the namespaces selected by the rule
+//   - on the local switch of every connected network, ACLs for the traffic coming from the other
+//     connected networks: a pass ACL per rule for the new connections towards the pods of the rule
+//     on its ports, and a lower priority drop ACL for any other new connection
+//
+// The network switches are stateful, so only the first packet of a connection is evaluated against
+// the rules: replies of allowed connections are let through by conntrack, while the connections
+// initiated by the selected pods towards the other networks are dropped like any other.
+// When the CNC has no allowedTraffic rules, any previously programmed ACL and address set is removed.
+func (c *Controller) syncAllowedTraffic(cnc *networkconnectv1.ClusterNetworkConnect, allocatedSubnets map[string][]*net.IPNet) error {
+	cncName := cnc.Name
+	if len(cnc.Spec.AllowedTraffic) == 0 {
+		return c.cleanupAllowedTraffic(cncName)
+	}
+
+	// the local zone node is needed to find the network switches, make sure it is up to date
+	// as the ACLs must be in place before the networks are connected
+	if _, _, err := c.computeNodeInfo(); err != nil {
+		return err
+	}
+	namespaces, err := c.getConnectedNamespaces(allocatedSubnets)
+	if err != nil {
+		return err
+	}
+
+	var ops []ovsdb.Operation
+	hashNamesV4 := make([]string, 0, len(cnc.Spec.AllowedTraffic))
+	hashNamesV6 := make([]string, 0, len(cnc.Spec.AllowedTraffic))
+	for i, rule := range cnc.Spec.AllowedTraffic {
+		selector, err := metav1.LabelSelectorAsSelector(&rule.NamespaceSelector)
+		if err != nil {
+			return fmt.Errorf("invalid namespace selector in allowedTraffic rule %d: %w", i, err)
+		}
+		podIPs, err := c.getAllowedTrafficPodIPs(selector, namespaces)
+		if err != nil {
+			return fmt.Errorf("failed to get the pod IPs of allowedTraffic rule %d: %w", i, err)
+		}
+		as, asOps, err := c.addressSetFactory.NewAddressSetOps(getAllowedTrafficAddressSetDbIDs(cncName, i), podIPs)
+		if err != nil {
+			return fmt.Errorf("failed to create address set for allowedTraffic rule %d: %w", i, err)
+		}
+		ops = append(ops, asOps...)
+		hashNameV4, hashNameV6 := as.GetASHashNames()
+		hashNamesV4 = append(hashNamesV4, hashNameV4)
+		hashNamesV6 = append(hashNamesV6, hashNameV6)
+	}
+
+	networks := map[int]util.NetInfo{}
+	for owner := range allocatedSubnets {
+		_, networkID, err := util.ParseNetworkOwner(owner)
+		if err != nil {
+			klog.Warningf("Failed to parse owner key %s: %v", owner, err)
+			continue
+		}
+		netInfo := c.networkManager.GetNetworkByID(networkID)
+		if netInfo == nil {
+			continue
+		}
+		networks[networkID] = netInfo
+	}
+
+	desiredACLs := sets.New[string]()
+	for networkID, netInfo := range networks {
+		// ACLs are attached to the switch, which only exists locally with dynamic UDN allocation
+		if c.localZoneNode == nil || !c.networkManager.NodeHasNetwork(c.localZoneNode.Name, netInfo.GetNetworkName()) {
+			continue
+		}
+		var srcSubnets []*net.IPNet
+		for otherID, otherNetInfo := range networks {
+			if otherID == networkID {
+				continue
+			}
+			for _, subnet := range otherNetInfo.Subnets() {
+				if subnet.CIDR != nil {
+					srcSubnets = append(srcSubnets, subnet.CIDR)
+				}
+			}
+		}
+		acls := buildAllowedTrafficACLs(cncName, networkID, srcSubnets, cnc.Spec.AllowedTraffic, hashNamesV4, hashNamesV6)
+		if len(acls) == 0 {
+			continue
+		}
+		switchName, err := c.getNetworkSwitchName(netInfo)
+		if err != nil {
+			return err
+		}
+		ops, err = libovsdbops.CreateOrUpdateACLsOps(c.nbClient, ops, nil, acls...)
+		if err != nil {
+			return fmt.Errorf("failed to create allowedTraffic ACL ops: %w", err)
+		}
+		ops, err = libovsdbops.AddACLsToLogicalSwitchOps(c.nbClient, ops, switchName, acls...)
+		if err != nil {
+			return fmt.Errorf("failed to add allowedTraffic ACLs to switch %s: %w", switchName, err)
+		}
+		for _, acl := range acls {
+			desiredACLs.Insert(acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()])
+		}
+	}
+
+	// remove the ACLs of rules and networks that don't exist anymore
+	ops, err = c.deleteAllowedTrafficACLsOps(ops, cncName, func(acl *nbdb.ACL) bool {
+		return !desiredACLs.Has(acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()])
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to program allowedTraffic rules: %w", err)
+	}
+	klog.V(4).Infof("CNC %s: programmed %d allowedTraffic rules with %d ACLs", cncName, len(cnc.Spec.AllowedTraffic), len(desiredACLs))
+
+	// address sets of removed rules are not referenced by any ACL anymore
+	return c.destroyAllowedTrafficAddressSets(cncName, len(cnc.Spec.AllowedTraffic))
+}
+
+// cleanupAllowedTraffic removes the allowedTraffic ACLs and address sets of a CNC.
+func (c *Controller) cleanupAllowedTraffic(cncName string) error {
+	ops, err := c.deleteAllowedTrafficACLsOps(nil, cncName, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to delete allowedTraffic ACLs for CNC %s: %w", cncName, err)
+	}
+	return c.destroyAllowedTrafficAddressSets(cncName, 0)
+}
+
+// deleteAllowedTrafficACLsOps returns ops to remove the allowedTraffic ACLs of a CNC matching the given
+// filter from the switches they are applied on. ACLs are owned by switches, so removing them from the
+// switches garbage-collects the ACL rows.
+func (c *Controller) deleteAllowedTrafficACLsOps(ops []ovsdb.Operation, cncName string, filter func(acl *nbdb.ACL) bool) ([]ovsdb.Operation, error) {
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLClusterNetworkConnectAllowedTraffic, controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: cncName,
+		})
+	acls, err := libovsdbops.FindACLsWithPredicate(c.nbClient, libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, filter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find allowedTraffic ACLs for CNC %s: %w", cncName, err)
+	}
+	if len(acls) == 0 {
+		return ops, nil
+	}
+	aclUUIDs := sets.New[string]()
+	for _, acl := range acls {
+		aclUUIDs.Insert(acl.UUID)
+	}
+	ops, err = libovsdbops.RemoveACLsFromLogicalSwitchesWithPredicateOps(c.nbClient, ops,
+		func(sw *nbdb.LogicalSwitch) bool {
+			return aclUUIDs.HasAny(sw.ACLs...)
+		}, acls...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove allowedTraffic ACLs of CNC %s from switches: %w", cncName, err)
+	}
+	return ops, nil
+}
+
+// destroyAllowedTrafficAddressSets destroys the allowedTraffic address sets of a CNC
+// for the rules with an index greater than or equal to fromRuleIndex.
+func (c *Controller) destroyAllowedTrafficAddressSets(cncName string, fromRuleIndex int) error {
+	return c.addressSetFactory.ProcessEachAddressSet(controllerName, libovsdbops.AddressSetClusterNetworkConnectAllowedTraffic,
+		func(dbIDs *libovsdbops.DbObjectIDs) error {
+			if dbIDs.GetObjectID(libovsdbops.ObjectNameKey) != cncName {
+				return nil
+			}
+			ruleIndex, err := strconv.Atoi(dbIDs.GetObjectID(libovsdbops.RuleIndex))
+			if err == nil && ruleIndex < fromRuleIndex {
+				return nil
+			}
+			if err := c.addressSetFactory.DestroyAddressSet(dbIDs); err != nil {
+				return fmt.Errorf("failed to delete allowedTraffic address set for CNC %s: %w", cncName, err)
+			}
+			return nil
+		})
+}
+
+// getConnectedNamespaces returns the namespaces whose primary network is one of the given connected networks.
+func (c *Controller) getConnectedNamespaces(allocatedSubnets map[string][]*net.IPNet) ([]connectedNamespace, error) {
+	namespaces, err := c.namespaceLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	var connected []connectedNamespace
+	for _, namespace := range namespaces {
+		owner, err := c.getNetworkOwnerKeyForNamespace(namespace.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := allocatedSubnets[owner]; !ok {
+			continue
+		}
+		_, networkID, err := util.ParseNetworkOwner(owner)
+		if err != nil {
+			return nil, err
+		}
+		netInfo := c.networkManager.GetNetworkByID(networkID)
+		if netInfo == nil {
+			continue
+		}
+		connected = append(connected, connectedNamespace{namespace: namespace, netInfo: netInfo})
+	}
+	return connected, nil
+}
+
+// getAllowedTrafficPodIPs returns the IPs of the pods of the connected namespaces matching the given selector.
+// The IPs are recorded in the pod IPs cache, so that they can be removed when the pods go away.
+func (c *Controller) getAllowedTrafficPodIPs(selector labels.Selector, namespaces []connectedNamespace) ([]string, error) {
+	var podIPs []string
+	for _, ns := range namespaces {
+		if !selector.Matches(labels.Set(ns.namespace.Labels)) {
+			continue
+		}
+		pods, err := c.podLister.Pods(ns.namespace.Name).List(labels.Everything())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods in namespace %s: %w", ns.namespace.Name, err)
+		}
+		for _, pod := range pods {
+			ips := c.getAllowedTrafficIPsOfPod(pod, ns.netInfo)
+			if len(ips) == 0 {
+				continue
+			}
+			c.allowedTrafficPods.set(pod.Namespace+"/"+pod.Name, ns.netInfo.GetNetworkID(), ips)
+			podIPs = append(podIPs, ips...)
+		}
+	}
+	return podIPs, nil
+}
+
+// getAllowedTrafficIPsOfPod returns the IPs of a pod on the given primary network, or nil if the pod
+// doesn't have any IP that can be selected by allowedTraffic rules.
+func (c *Controller) getAllowedTrafficIPsOfPod(pod *corev1.Pod, netInfo util.NetInfo) []string {
+	if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) {
+		return nil
+	}
+	ips, err := util.GetPodIPsOfNetwork(pod, netInfo, c.networkManager.GetNetworkNameForNADKey)
+	if err != nil {
+		// the pod is not annotated yet, it will be added on its update
+		klog.V(5).Infof("Skipping pod %s/%s for network connect allowedTraffic: %v", pod.Namespace, pod.Name, err)
+		return nil
+	}
+	podIPs := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		podIPs = append(podIPs, ip.String())
+	}
+	return podIPs
+}
+
+// syncAllowedTrafficPod adds the IPs of a pod to the address sets of the allowedTraffic rules selecting
+// its namespace, and removes the IPs it doesn't hold anymore from the address sets of all the rules of the
+// CNCs connecting its network. pod is nil if the pod doesn't exist anymore.
+func (c *Controller) syncAllowedTrafficPod(podKey string, pod *corev1.Pod) error {
+	networkID := c.allowedTrafficPods.networkID(podKey)
+	var namespace *corev1.Namespace
+	var podIPs []string
+	if pod != nil {
+		owner, err := c.getNetworkOwnerKeyForNamespace(pod.Namespace)
+		if err != nil {
+			return err
+		}
+		if owner != "" {
+			if _, networkID, err = util.ParseNetworkOwner(owner); err != nil {
+				return err
+			}
+			if netInfo := c.networkManager.GetNetworkByID(networkID); netInfo != nil {
+				podIPs = c.getAllowedTrafficIPsOfPod(pod, netInfo)
+			}
+		}
+		namespace, err = c.namespaceLister.Get(pod.Namespace)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	if networkID == ovntypes.InvalidID {
+		return nil
+	}
+
+	var selecting, connected []addressset.AddressSet
+	for cncName := range c.cncsByNetworkID[networkID] {
+		if c.cncCache[cncName] == nil {
+			// the pod IPs are added when the CNC is synced
+			continue
+		}
+		cnc, err := c.cncLister.Get(cncName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		for i, rule := range cnc.Spec.AllowedTraffic {
+			as, err := c.addressSetFactory.GetAddressSet(getAllowedTrafficAddressSetDbIDs(cncName, i))
+			if err != nil {
+				return fmt.Errorf("failed to get address set of allowedTraffic rule %d of CNC %s: %w", i, cncName, err)
+			}
+			connected = append(connected, as)
+			selector, err := metav1.LabelSelectorAsSelector(&rule.NamespaceSelector)
+			if err != nil {
+				// reported in the CNC status
+				continue
+			}
+			if namespace != nil && selector.Matches(labels.Set(namespace.Labels)) {
+				selecting = append(selecting, as)
+			}
+		}
+	}
+	if len(selecting) == 0 {
+		podIPs = nil
+	}
+
+	released := c.allowedTrafficPods.released(podKey, networkID, podIPs)
+	var ops []ovsdb.Operation
+	for _, as := range selecting {
+		asOps, err := as.AddAddressesReturnOps(podIPs)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, asOps...)
+	}
+	for _, as := range connected {
+		asOps, err := as.DeleteAddressesReturnOps(released)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, asOps...)
+	}
+	if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to update the allowedTraffic address sets with the IPs of pod %s: %w", podKey, err)
+	}
+	c.allowedTrafficPods.set(podKey, networkID, podIPs)
+	return nil
+}
+
+// allowedTrafficPod holds the primary network IPs of a pod added to allowedTraffic address sets.
+type allowedTrafficPod struct {
+	networkID int
+	ips       []string
+}
+
+// allowedTrafficPodCache tracks the IPs of the pods added to the allowedTraffic address sets,
+// so that a pod change only updates the address sets with the IPs of that pod.
+type allowedTrafficPodCache struct {
+	pods map[string]allowedTrafficPod
+	// owners holds the key of the pod last seen with an IP, by network ID and IP, as the IP
+	// of a deleted pod can be reused by a new pod before the deletion is processed.
+	owners map[string]string
+}
+
+func newAllowedTrafficPodCache() *allowedTrafficPodCache {
+	return &allowedTrafficPodCache{
+		pods:   map[string]allowedTrafficPod{},
+		owners: map[string]string{},
+	}
+}
+
+func allowedTrafficIPOwnerKey(networkID int, ip string) string {
+	return strconv.Itoa(networkID) + "/" + ip
+}
+
+// networkID returns the network ID of a cached pod, or InvalidID if the pod is not cached.
+func (pc *allowedTrafficPodCache) networkID(podKey string) int {
+	if pod, ok := pc.pods[podKey]; ok {
+		return pod.networkID
+	}
+	return ovntypes.InvalidID
+}
+
+// released returns the cached IPs of a pod that it doesn't hold anymore and that were not
+// taken over by another pod.
+func (pc *allowedTrafficPodCache) released(podKey string, networkID int, ips []string) []string {
+	pod, ok := pc.pods[podKey]
+	if !ok {
+		return nil
+	}
+	current := sets.New(ips...)
+	var released []string
+	for _, ip := range pod.ips {
+		if pod.networkID == networkID && current.Has(ip) {
+			continue
+		}
+		if pc.owners[allowedTrafficIPOwnerKey(pod.networkID, ip)] == podKey {
+			released = append(released, ip)
+		}
+	}
+	return released
+}
+
+// set records the IPs of a pod, the pod is forgotten if it doesn't have any.
+func (pc *allowedTrafficPodCache) set(podKey string, networkID int, ips []string) {
+	if pod, ok := pc.pods[podKey]; ok {
+		for _, ip := range pod.ips {
+			ownerKey := allowedTrafficIPOwnerKey(pod.networkID, ip)
+			if pc.owners[ownerKey] == podKey {
+				delete(pc.owners, ownerKey)
+			}
+		}
+	}
+	if len(ips) == 0 {
+		delete(pc.pods, podKey)
+		return
+	}
+	pc.pods[podKey] = allowedTrafficPod{networkID: networkID, ips: ips}
+	for _, ip := range ips {
+		pc.owners[allowedTrafficIPOwnerKey(networkID, ip)] = podKey
+	}
+}
+
+// buildAllowedTrafficACLs builds the to-lport ACLs implementing the allowedTraffic rules on the switch
+// of a connected network, for the traffic coming from the given subnets of the other connected networks.
+// The address sets of the rules are given by their hashed names per IP family. No ACL is built if there
+// is no other connected network.
+func buildAllowedTrafficACLs(cncName string, networkID int, srcSubnets []*net.IPNet,
+	rules []networkconnectv1.AllowedTrafficRule, hashNamesV4, hashNamesV6 []string) []*nbdb.ACL {
+	var v4Subnets, v6Subnets []string
+	for _, subnet := range srcSubnets {
+		if utilnet.IsIPv6CIDR(subnet) {
+			v6Subnets = append(v6Subnets, subnet.String())
+		} else {
+			v4Subnets = append(v4Subnets, subnet.String())
+		}
+	}
+
+	var srcMatches []string
+	if config.IPv4Mode && len(v4Subnets) > 0 {
+		srcMatches = append(srcMatches, fmt.Sprintf("ip4.src == {%s}", strings.Join(v4Subnets, ", ")))
+	}
+	if config.IPv6Mode && len(v6Subnets) > 0 {
+		srcMatches = append(srcMatches, fmt.Sprintf("ip6.src == {%s}", strings.Join(v6Subnets, ", ")))
+	}
+	if len(srcMatches) == 0 {
+		return nil
+	}
+
+	acls := make([]*nbdb.ACL, 0, len(rules)+1)
+	for i, rule := range rules {
+		var ruleMatches []string
+		for _, srcMatch := range srcMatches {
+			if strings.HasPrefix(srcMatch, "ip4") {
+				ruleMatches = append(ruleMatches, fmt.Sprintf("%s && ip4.dst == $%s", srcMatch, hashNamesV4[i]))
+			} else {
+				ruleMatches = append(ruleMatches, fmt.Sprintf("%s && ip6.dst == $%s", srcMatch, hashNamesV6[i]))
+			}
+		}
+		passMatch := ruleMatches[0]
+		if len(ruleMatches) > 1 {
+			passMatch = fmt.Sprintf("(%s) || (%s)", ruleMatches[0], ruleMatches[1])
+		}
+		if len(rule.Ports) > 0 {
+			var portMatches []string
+			for _, port := range rule.Ports {
+				portMatches = append(portMatches, allowedPortMatch(port))
+			}
+			passMatch = fmt.Sprintf("(%s) && (%s)", passMatch, strings.Join(portMatches, " || "))
+		}
+		dbIDs := buildAllowedTrafficACLDBIDs(cncName, networkID, fmt.Sprintf("pass-%d", i))
+		acls = append(acls, libovsdbutil.BuildACL(dbIDs, ovntypes.NetworkConnectPassAllowedTrafficPriority,
+			passMatch, nbdb.ACLActionPass, nil, libovsdbutil.LportIngress, ovntypes.PrimaryACLTier))
+	}
+
+	// ct.new: packets of established connections, including the replies of the connections
+	// initiated from this network, are not dropped
+	dropMatch := fmt.Sprintf("(%s) && ct.new", strings.Join(srcMatches, " || "))
+	dbIDs := buildAllowedTrafficACLDBIDs(cncName, networkID, allowedTrafficDropACLType)
+	acls = append(acls, libovsdbutil.BuildACL(dbIDs, ovntypes.NetworkConnectDropNotAllowedTrafficPriority,
+		dropMatch, nbdb.ACLActionDrop, nil, libovsdbutil.LportIngress, ovntypes.PrimaryACLTier))
+	return acls
+}
+
+// allowedPortMatch builds the L4 destination port match of an allowed port.
+func allowedPortMatch(port networkconnectv1.AllowedPort) string {
+	protocol := strings.ToLower(string(port.Protocol))
+	switch {
+	case port.Port == 0:
+		return protocol
+	case port.EndPort > port.Port:
+		return fmt.Sprintf("(%s && %s.dst >= %d && %s.dst <= %d)", protocol, protocol, port.Port, protocol, port.EndPort)
+	default:
+		return fmt.Sprintf("(%s && %s.dst == %d)", protocol, protocol, port.Port)
+	}
+}
+
+// allowedTrafficSelectsNamespace returns true if any allowedTraffic rule of the CNC selects the namespace.
+func allowedTrafficSelectsNamespace(cnc *networkconnectv1.ClusterNetworkConnect, namespace *corev1.Namespace) bool {
+	for _, rule := range cnc.Spec.AllowedTraffic {
+		selector, err := metav1.LabelSelectorAsSelector(&rule.NamespaceSelector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(namespace.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateAllowedTrafficStatus reports the result of programming the allowedTraffic rules in this zone
+// with a condition owned by the zone. The condition is removed when the CNC has no allowedTraffic rules.
+func (c *Controller) updateAllowedTrafficStatus(cnc *networkconnectv1.ClusterNetworkConnect, syncErr error) error {
+	conditionType := allowedTrafficConditionTypePrefix + c.zone
+	existingCondition := meta.FindStatusCondition(cnc.Status.Conditions, conditionType)
+	status := cncapply.ClusterNetworkConnectStatus()
+
+	if len(cnc.Spec.AllowedTraffic) == 0 {
+		if existingCondition == nil {
+			return nil
+		}
+	} else {
+		condition := metaapply.Condition().
+			WithType(conditionType).
+			WithStatus(metav1.ConditionTrue).
+			WithReason(allowedTrafficReasonSuccess).
+			WithMessage(allowedTrafficAppliedMsg).
+			WithObservedGeneration(cnc.Generation)
+		if syncErr != nil {
+			msg := ovntypes.ClusterNetworkConnectAllowedTrafficErrorMsg + ": " + syncErr.Error()
+			if len(msg) >= 32767 { // max length of message can be 32768
+				msg = msg[:32766]
+			}
+			condition = condition.WithStatus(metav1.ConditionFalse).
+				WithReason(allowedTrafficReasonFailed).
+				WithMessage(msg)
+		}
+		if existingCondition != nil &&
+			existingCondition.Status == *condition.Status &&
+			existingCondition.Reason == *condition.Reason &&
+			existingCondition.Message == *condition.Message &&
+			existingCondition.ObservedGeneration == *condition.ObservedGeneration {
+			return nil
+		}
+		if existingCondition == nil || existingCondition.Status != *condition.Status {
+			condition = condition.WithLastTransitionTime(metav1.NewTime(time.Now()))
+		} else {
+			condition = condition.WithLastTransitionTime(existingCondition.LastTransitionTime)
+		}
+		status = status.WithConditions(condition)
+	}
+
+	_, err := c.cncClient.K8sV1().ClusterNetworkConnects().ApplyStatus(
+		context.TODO(),
+		cncapply.ClusterNetworkConnect(cnc.Name).WithStatus(status),
+		metav1.ApplyOptions{
+			FieldManager: c.zone,
+			Force:        true,
+		},
+	)
+	return err
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	controllerutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/controller"
 	networkconnectv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1"
+	networkconnectclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1/apis/clientset/versioned"
 	networkconnectlisters "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1/apis/listers/clusternetworkconnect/v1"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
@@ -47,6 +48,7 @@ const (
 // - logical router policies on each selected (C)UDN's network router steering traffic to the connect-router
 // - logical router static routes on the connect-router routing traffic to the corresponding selected (C)UDNs
 // - load balancer attachments for ServiceNetwork connectivity enabled CNCs
+// - ACLs on each selected (C)UDN's switch and address sets implementing the allowedTraffic rules
 type Controller struct {
 	// zone is the name of the zone that this controller manages
 	zone string
@@ -54,14 +56,19 @@ type Controller struct {
 	// nbClient is the libovsdb northbound client interface
 	nbClient libovsdbclient.Client
 
+	// cncClient is used to report the allowedTraffic rules status of this zone
+	cncClient networkconnectclientset.Interface
+
 	// wf is the watch factory for accessing informers
 	wf *factory.WatchFactory
 
 	// listers
-	cncLister     networkconnectlisters.ClusterNetworkConnectLister
-	nodeLister    corev1listers.NodeLister
-	nadLister     nadlisters.NetworkAttachmentDefinitionLister
-	serviceLister corev1listers.ServiceLister
+	cncLister       networkconnectlisters.ClusterNetworkConnectLister
+	nodeLister      corev1listers.NodeLister
+	nadLister       nadlisters.NetworkAttachmentDefinitionLister
+	serviceLister   corev1listers.ServiceLister
+	namespaceLister corev1listers.NamespaceLister
+	podLister       corev1listers.PodLister
 
 	// networkManager provides access to network information
 	networkManager networkmanager.Interface
@@ -80,6 +87,10 @@ type Controller struct {
 	nadReconcilerID uint64
 	// serviceController handles Service events (for ServiceNetwork connectivity)
 	serviceController controllerutil.Controller
+	// namespaceController and podController handle Namespace and Pod events
+	// (for the pod IPs selected by allowedTraffic rules)
+	namespaceController controllerutil.Controller
+	podController       controllerutil.Controller
 	// networkRefQueue handles node+network activity-triggered CNC requeues
 	networkRefQueue controllerutil.Reconciler
 	// networkRefReconciler receives node+network activity notifications from networkmanager
@@ -97,6 +108,9 @@ type Controller struct {
 	// cncsByNetworkID indexes CNCs by desired owner network ID for targeted requeues.
 	cncsByNetworkID map[int]sets.Set[string]
 
+	// allowedTrafficPods caches the IPs of the pods added to the allowedTraffic address sets
+	allowedTrafficPods *allowedTrafficPodCache
+
 	// localZoneNode is the node in this controller's zone.
 	// We only support 1 node per zone for this feature.
 	// Updated during each CNC reconciliation via computeNodeInfo().
@@ -131,6 +145,7 @@ func (f networkRefReconcilerFunc) Reconcile(node, networkName string) {
 func NewController(
 	zone string,
 	nbClient libovsdbclient.Client,
+	cncClient networkconnectclientset.Interface,
 	wf *factory.WatchFactory,
 	networkManager networkmanager.Interface,
 ) *Controller {
@@ -138,20 +153,26 @@ func NewController(
 	nodeLister := wf.NodeCoreInformer().Lister()
 	nadLister := wf.NADInformer().Lister()
 	serviceLister := wf.ServiceCoreInformer().Lister()
+	namespaceLister := wf.NamespaceCoreInformer().Lister()
+	podLister := wf.PodCoreInformer().Lister()
 
 	c := &Controller{
-		zone:              zone,
-		nbClient:          nbClient,
-		wf:                wf,
-		cncLister:         cncLister,
-		nodeLister:        nodeLister,
-		nadLister:         nadLister,
-		serviceLister:     serviceLister,
-		networkManager:    networkManager,
-		addressSetFactory: addressset.NewOvnAddressSetFactory(nbClient, config.IPv4Mode, config.IPv6Mode),
-		cncCache:          make(map[string]*networkConnectState),
-		cncNetworkIDs:     make(map[string]sets.Set[int]),
-		cncsByNetworkID:   make(map[int]sets.Set[string]),
+		zone:               zone,
+		nbClient:           nbClient,
+		cncClient:          cncClient,
+		wf:                 wf,
+		cncLister:          cncLister,
+		nodeLister:         nodeLister,
+		nadLister:          nadLister,
+		serviceLister:      serviceLister,
+		namespaceLister:    namespaceLister,
+		podLister:          podLister,
+		networkManager:     networkManager,
+		addressSetFactory:  addressset.NewOvnAddressSetFactory(nbClient, config.IPv4Mode, config.IPv6Mode),
+		cncCache:           make(map[string]*networkConnectState),
+		cncNetworkIDs:      make(map[string]sets.Set[int]),
+		cncsByNetworkID:    make(map[int]sets.Set[string]),
+		allowedTrafficPods: newAllowedTrafficPodCache(),
 	}
 
 	cncCfg := &controllerutil.ControllerConfig[networkconnectv1.ClusterNetworkConnect]{
@@ -226,6 +247,32 @@ func NewController(
 		serviceCfg,
 	)
 
+	namespaceCfg := &controllerutil.ControllerConfig[corev1.Namespace]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       wf.NamespaceCoreInformer().Informer(),
+		Lister:         namespaceLister.List,
+		Reconcile:      c.reconcileNamespace,
+		ObjNeedsUpdate: namespaceNeedsUpdate,
+		Threadiness:    1,
+	}
+	c.namespaceController = controllerutil.NewController(
+		"ovnkube-network-connect-namespace-controller",
+		namespaceCfg,
+	)
+
+	podCfg := &controllerutil.ControllerConfig[corev1.Pod]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       wf.PodCoreInformer().Informer(),
+		Lister:         podLister.List,
+		Reconcile:      c.reconcilePod,
+		ObjNeedsUpdate: podNeedsUpdate,
+		Threadiness:    1,
+	}
+	c.podController = controllerutil.NewController(
+		"ovnkube-network-connect-pod-controller",
+		podCfg,
+	)
+
 	return c
 }
 
@@ -239,6 +286,8 @@ func (c *Controller) Start() (err error) {
 		c.cncController,
 		c.nodeController,
 		c.serviceController,
+		c.namespaceController,
+		c.podController,
 	}
 	if c.nadReconciler != nil {
 		c.nadReconcilerID = c.networkManager.RegisterNADReconciler(c.nadReconciler)
@@ -277,6 +326,8 @@ func (c *Controller) Stop() {
 		c.cncController,
 		c.nodeController,
 		c.serviceController,
+		c.namespaceController,
+		c.podController,
 	}
 	if c.nadReconciler != nil {
 		controllers = append(controllers, c.nadReconciler)
@@ -456,6 +507,11 @@ func cncNeedsUpdate(oldObj, newObj *networkconnectv1.ClusterNetworkConnect) bool
 		return true
 	}
 
+	// Process if the allowedTraffic rules changed
+	if !reflect.DeepEqual(oldObj.Spec.AllowedTraffic, newObj.Spec.AllowedTraffic) {
+		return true
+	}
+
 	return false
 }
 
@@ -482,6 +538,26 @@ func nodeNeedsUpdate(oldObj, newObj *corev1.Node) bool {
 	return util.NodeIDAnnotationChanged(oldObj, newObj) && oldObj.Annotations[util.OvnNodeID] == ""
 }
 
+// namespaceNeedsUpdate determines if a namespace change requires reconciliation.
+// Only label changes matter, as they can change the namespaces selected by allowedTraffic rules.
+func namespaceNeedsUpdate(oldObj, newObj *corev1.Namespace) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldObj.Labels, newObj.Labels)
+}
+
+// podNeedsUpdate determines if a pod change requires reconciliation.
+// Only changes of the pod IPs matter, as they are the ones selected by allowedTraffic rules.
+func podNeedsUpdate(oldObj, newObj *corev1.Pod) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return oldObj.Spec.NodeName != newObj.Spec.NodeName ||
+		oldObj.Annotations[types.OvnPodAnnotationName] != newObj.Annotations[types.OvnPodAnnotationName] ||
+		util.PodCompleted(oldObj) != util.PodCompleted(newObj)
+}
+
 // serviceNeedsUpdate determines if a service change requires reconciliation.
 // We care about service CREATE and protocol-set changes for ServiceNetwork.
 // Service deletes don't need processing because:
@@ -635,6 +711,48 @@ func (c *Controller) reconcileService(key string) error {
 	return nil
 }
 
+// reconcileNamespace requeues the CNCs with allowedTraffic rules when a namespace changes.
+// All of them are requeued since a label change can both select and unselect the namespace.
+func (c *Controller) reconcileNamespace(key string) error {
+	klog.V(5).Infof("Reconciling namespace %s for network connect", key)
+	return c.requeueAllowedTrafficCNCs(func(*networkconnectv1.ClusterNetworkConnect) bool { return true })
+}
+
+// reconcilePod updates the allowedTraffic address sets with the IPs of a pod whose IPs changed.
+func (c *Controller) reconcilePod(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	klog.V(5).Infof("Reconciling pod %s for network connect", key)
+
+	c.Lock()
+	defer c.Unlock()
+
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		pod = nil
+	}
+	return c.syncAllowedTrafficPod(key, pod)
+}
+
+// requeueAllowedTrafficCNCs requeues the CNCs with allowedTraffic rules matching the given filter.
+func (c *Controller) requeueAllowedTrafficCNCs(filter func(cnc *networkconnectv1.ClusterNetworkConnect) bool) error {
+	cncs, err := c.cncLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list CNCs: %w", err)
+	}
+	for _, cnc := range cncs {
+		if len(cnc.Spec.AllowedTraffic) > 0 && filter(cnc) {
+			c.cncController.Reconcile(cnc.Name)
+		}
+	}
+	return nil
+}
+
 // getNetworkOwnerKeyForNamespace returns the owner key (e.g., "layer3_5") for the primary network
 // of the given namespace. Returns empty string if namespace uses default network.
 func (c *Controller) getNetworkOwnerKeyForNamespace(namespace string) (string, error) {
@@ -763,6 +881,17 @@ func (c *Controller) syncCNC(cnc *networkconnectv1.ClusterNetworkConnect) error
 		cncState.tunnelID = tunnelID
 	}
 
+	// Program the allowedTraffic rules on the network switches before the networks are connected,
+	// so that no traffic crosses the connect router before they are in place. The result is reported in the
+	// CNC status conditions of this zone.
+	allowedTrafficErr := c.syncAllowedTraffic(cnc, allocatedSubnets)
+	if err := c.updateAllowedTrafficStatus(cnc, allowedTrafficErr); err != nil {
+		klog.Errorf("Failed to update allowedTraffic status for CNC %s: %v", cnc.Name, err)
+	}
+	if allowedTrafficErr != nil {
+		return fmt.Errorf("failed to sync allowedTraffic rules for CNC %s: %w", cnc.Name, allowedTrafficErr)
+	}
+
 	if err := c.syncNetworkConnections(cnc, allocatedSubnets); err != nil {
 		return fmt.Errorf("failed to sync network connections for CNC %s: %v", cnc.Name, err)
 	}
@@ -785,6 +914,11 @@ func (c *Controller) cleanupCNC(cncName string) error {
 		return fmt.Errorf("failed to cleanup network connections for CNC %s: %v", cncName, err)
 	}
 
+	// Remove the allowedTraffic ACLs and address sets
+	if err := c.cleanupAllowedTraffic(cncName); err != nil {
+		return fmt.Errorf("failed to cleanup allowedTraffic rules for CNC %s: %v", cncName, err)
+	}
+
 	// Remove the connect router
 	if err := c.deleteConnectRouter(cncName); err != nil {
 		return fmt.Errorf("failed to delete connect router for CNC %s: %v", cncName, err)
@@ -5,6 +5,9 @@ package networkconnect
 
 import (
 	"context"
+	"net"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -20,11 +23,14 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	ovncnitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	controllerutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/controller"
 	networkconnectv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1"
 	crdtypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/networkmanager"
+	ovntest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
 	ovntypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
 )
@@ -226,6 +232,27 @@ func TestCNCNeedsUpdate(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "allowedTraffic changed",
+			oldObj: &networkconnectv1.ClusterNetworkConnect{
+				Spec: networkconnectv1.ClusterNetworkConnectSpec{
+					AllowedTraffic: []networkconnectv1.AllowedTrafficRule{
+						{NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}}},
+					},
+				},
+			},
+			newObj: &networkconnectv1.ClusterNetworkConnect{
+				Spec: networkconnectv1.ClusterNetworkConnectSpec{
+					AllowedTraffic: []networkconnectv1.AllowedTrafficRule{
+						{
+							NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+							Ports:             []networkconnectv1.AllowedPort{{Protocol: corev1.ProtocolTCP, Port: 8080}},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -236,6 +263,253 @@ func TestCNCNeedsUpdate(t *testing.T) {
 	}
 }
 
+// allowedTrafficTestPacket is the first packet of a connection, or a packet of an established one,
+// evaluated against the allowedTraffic ACLs of a switch.
+type allowedTrafficTestPacket struct {
+	src, dst string
+	protocol string
+	dstPort  int
+	ctNew    bool
+}
+
+// evalAllowedTrafficACLs returns the action of the highest priority ACL matching the packet,
+// or an empty string if no ACL matches. It only supports the matches built by buildAllowedTrafficACLs.
+func evalAllowedTrafficACLs(t *testing.T, acls []*nbdb.ACL, addressSets map[string][]string, packet allowedTrafficTestPacket) string {
+	action, priority := "", -1
+	for _, acl := range acls {
+		tokens := tokenizeAllowedTrafficMatch(acl.Match)
+		matched, rest := evalAllowedTrafficOr(t, tokens, addressSets, packet)
+		assert.Empty(t, rest, "unparsed tokens in match %q", acl.Match)
+		if matched && acl.Priority > priority {
+			action, priority = acl.Action, acl.Priority
+		}
+	}
+	return action
+}
+
+func tokenizeAllowedTrafficMatch(match string) []string {
+	var tokens []string
+	for len(match) > 0 {
+		switch {
+		case match[0] == ' ':
+			match = match[1:]
+		case match[0] == '(' || match[0] == ')':
+			tokens = append(tokens, match[:1])
+			match = match[1:]
+		case match[0] == '{':
+			end := strings.IndexByte(match, '}') + 1
+			tokens = append(tokens, match[:end])
+			match = match[end:]
+		default:
+			end := strings.IndexAny(match, " ()")
+			if end < 0 {
+				end = len(match)
+			}
+			tokens = append(tokens, match[:end])
+			match = match[end:]
+		}
+	}
+	return tokens
+}
+
+func evalAllowedTrafficOr(t *testing.T, tokens []string, addressSets map[string][]string, packet allowedTrafficTestPacket) (bool, []string) {
+	result, tokens := evalAllowedTrafficAnd(t, tokens, addressSets, packet)
+	for len(tokens) > 0 && tokens[0] == "||" {
+		var next bool
+		next, tokens = evalAllowedTrafficAnd(t, tokens[1:], addressSets, packet)
+		result = result || next
+	}
+	return result, tokens
+}
+
+func evalAllowedTrafficAnd(t *testing.T, tokens []string, addressSets map[string][]string, packet allowedTrafficTestPacket) (bool, []string) {
+	result, tokens := evalAllowedTrafficTerm(t, tokens, addressSets, packet)
+	for len(tokens) > 0 && tokens[0] == "&&" {
+		var next bool
+		next, tokens = evalAllowedTrafficTerm(t, tokens[1:], addressSets, packet)
+		result = result && next
+	}
+	return result, tokens
+}
+
+func evalAllowedTrafficTerm(t *testing.T, tokens []string, addressSets map[string][]string, packet allowedTrafficTestPacket) (bool, []string) {
+	if tokens[0] == "(" {
+		result, rest := evalAllowedTrafficOr(t, tokens[1:], addressSets, packet)
+		assert.Equal(t, ")", rest[0])
+		return result, rest[1:]
+	}
+	field := tokens[0]
+	switch field {
+	case "ct.new":
+		return packet.ctNew, tokens[1:]
+	case "tcp", "udp", "sctp":
+		return packet.protocol == field, tokens[1:]
+	}
+	op, value := tokens[1], tokens[2]
+	switch field {
+	case "ip4.src", "ip6.src", "ip4.dst", "ip6.dst":
+		ip := net.ParseIP(packet.src)
+		if strings.HasSuffix(field, ".dst") {
+			ip = net.ParseIP(packet.dst)
+		}
+		if (ip.To4() != nil) != strings.HasPrefix(field, "ip4") {
+			return false, tokens[3:]
+		}
+		var values []string
+		if strings.HasPrefix(value, "$") {
+			values = addressSets[value[1:]]
+		} else {
+			values = strings.Split(strings.Trim(value, "{}"), ", ")
+		}
+		for _, v := range values {
+			if _, cidr, err := net.ParseCIDR(v); err == nil && cidr.Contains(ip) || net.ParseIP(v).Equal(ip) {
+				return true, tokens[3:]
+			}
+		}
+		return false, tokens[3:]
+	default:
+		protocol, portField, _ := strings.Cut(field, ".")
+		assert.Equal(t, "dst", portField, "unexpected field %s", field)
+		if protocol != packet.protocol {
+			return false, tokens[3:]
+		}
+		port, err := strconv.Atoi(value)
+		assert.NoError(t, err)
+		switch op {
+		case "==":
+			return packet.dstPort == port, tokens[3:]
+		case ">=":
+			return packet.dstPort >= port, tokens[3:]
+		case "<=":
+			return packet.dstPort <= port, tokens[3:]
+		}
+		t.Fatalf("unexpected operator %s", op)
+		return false, nil
+	}
+}
+
+// Test for buildAllowedTrafficACLs function
+func TestBuildAllowedTrafficACLs(t *testing.T) {
+	oldIPv4Mode, oldIPv6Mode := config.IPv4Mode, config.IPv6Mode
+	defer func() {
+		config.IPv4Mode, config.IPv6Mode = oldIPv4Mode, oldIPv6Mode
+	}()
+	config.IPv4Mode, config.IPv6Mode = true, false
+
+	// the rule selects the namespace of the blue pods on the blue network
+	rules := []networkconnectv1.AllowedTrafficRule{
+		{
+			Ports: []networkconnectv1.AllowedPort{
+				{Protocol: corev1.ProtocolTCP, Port: 8080},
+				{Protocol: corev1.ProtocolUDP, Port: 5000, EndPort: 5010},
+			},
+		},
+	}
+	addressSets := map[string][]string{"as0": {"10.200.0.5"}}
+	redSwitchACLs := buildAllowedTrafficACLs("cnc", 1, []*net.IPNet{ovntest.MustParseIPNet("10.200.0.0/16")},
+		rules, []string{"as0"}, []string{""})
+	blueSwitchACLs := buildAllowedTrafficACLs("cnc", 2, []*net.IPNet{ovntest.MustParseIPNet("10.128.0.0/14")},
+		rules, []string{"as0"}, []string{""})
+
+	assert.Len(t, blueSwitchACLs, 2)
+	for _, acl := range blueSwitchACLs {
+		assert.Equal(t, nbdb.ACLDirectionToLport, acl.Direction)
+		assert.Equal(t, ovntypes.PrimaryACLTier, acl.Tier)
+	}
+	assert.Equal(t, "(ip4.src == {10.128.0.0/14} && ip4.dst == $as0) && ((tcp && tcp.dst == 8080) || (udp && udp.dst >= 5000 && udp.dst <= 5010))",
+		blueSwitchACLs[0].Match)
+	assert.Equal(t, ovntypes.NetworkConnectPassAllowedTrafficPriority, blueSwitchACLs[0].Priority)
+	assert.Equal(t, "(ip4.src == {10.128.0.0/14}) && ct.new", blueSwitchACLs[1].Match)
+	assert.Equal(t, ovntypes.NetworkConnectDropNotAllowedTrafficPriority, blueSwitchACLs[1].Priority)
+
+	tests := []struct {
+		name   string
+		acls   []*nbdb.ACL
+		packet allowedTrafficTestPacket
+		expect string
+	}{
+		{
+			name:   "new connection towards an allowed pod and port",
+			acls:   blueSwitchACLs,
+			packet: allowedTrafficTestPacket{src: "10.128.1.5", dst: "10.200.0.5", protocol: "tcp", dstPort: 8080, ctNew: true},
+			expect: nbdb.ACLActionPass,
+		},
+		{
+			name:   "new connection towards an allowed pod in a port range",
+			acls:   blueSwitchACLs,
+			packet: allowedTrafficTestPacket{src: "10.128.1.5", dst: "10.200.0.5", protocol: "udp", dstPort: 5005, ctNew: true},
+			expect: nbdb.ACLActionPass,
+		},
+		{
+			name:   "new connection towards an allowed pod on another port",
+			acls:   blueSwitchACLs,
+			packet: allowedTrafficTestPacket{src: "10.128.1.5", dst: "10.200.0.5", protocol: "tcp", dstPort: 22, ctNew: true},
+			expect: nbdb.ACLActionDrop,
+		},
+		{
+			name:   "new connection towards a pod that is not allowed",
+			acls:   blueSwitchACLs,
+			packet: allowedTrafficTestPacket{src: "10.128.1.5", dst: "10.200.0.6", protocol: "tcp", dstPort: 8080, ctNew: true},
+			expect: nbdb.ACLActionDrop,
+		},
+		{
+			name:   "reply of an allowed connection",
+			acls:   redSwitchACLs,
+			packet: allowedTrafficTestPacket{src: "10.200.0.5", dst: "10.128.1.5", protocol: "tcp", dstPort: 40000},
+		},
+		{
+			name:   "new connection initiated from an allowed pod",
+			acls:   redSwitchACLs,
+			packet: allowedTrafficTestPacket{src: "10.200.0.5", dst: "10.128.1.5", protocol: "tcp", dstPort: 40000, ctNew: true},
+			expect: nbdb.ACLActionDrop,
+		},
+		{
+			name:   "new connection initiated from an allowed pod towards an allowed port",
+			acls:   redSwitchACLs,
+			packet: allowedTrafficTestPacket{src: "10.200.0.5", dst: "10.128.1.5", protocol: "tcp", dstPort: 8080, ctNew: true},
+			expect: nbdb.ACLActionDrop,
+		},
+		{
+			name:   "new connection within the network",
+			acls:   redSwitchACLs,
+			packet: allowedTrafficTestPacket{src: "10.128.1.6", dst: "10.128.1.5", protocol: "tcp", dstPort: 22, ctNew: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, evalAllowedTrafficACLs(t, tt.acls, addressSets, tt.packet))
+		})
+	}
+
+	// no ACL without another connected network
+	assert.Empty(t, buildAllowedTrafficACLs("cnc", 1, nil, rules, []string{"as0"}, []string{""}))
+}
+
+// Test for allowedTrafficPodCache
+func TestAllowedTrafficPodCache(t *testing.T) {
+	pc := newAllowedTrafficPodCache()
+	assert.Equal(t, ovntypes.InvalidID, pc.networkID("ns/pod1"))
+	assert.Empty(t, pc.released("ns/pod1", 1, nil))
+
+	pc.set("ns/pod1", 1, []string{"10.128.1.5", "fd00:10:128:1::5"})
+	assert.Equal(t, 1, pc.networkID("ns/pod1"))
+	assert.Empty(t, pc.released("ns/pod1", 1, []string{"10.128.1.5", "fd00:10:128:1::5"}))
+	assert.ElementsMatch(t, []string{"fd00:10:128:1::5"}, pc.released("ns/pod1", 1, []string{"10.128.1.5"}))
+	assert.ElementsMatch(t, []string{"10.128.1.5", "fd00:10:128:1::5"}, pc.released("ns/pod1", 1, nil))
+
+	// the IP of a deleted pod is reused by a new pod before the deletion is processed
+	pc.set("ns/pod2", 1, []string{"10.128.1.5"})
+	assert.ElementsMatch(t, []string{"fd00:10:128:1::5"}, pc.released("ns/pod1", 1, nil))
+	pc.set("ns/pod1", 1, nil)
+	assert.Equal(t, ovntypes.InvalidID, pc.networkID("ns/pod1"))
+	assert.ElementsMatch(t, []string{"10.128.1.5"}, pc.released("ns/pod2", 1, nil))
+
+	// the same IP on another network is owned separately
+	pc.set("ns2/pod3", 2, []string{"10.128.1.5"})
+	assert.ElementsMatch(t, []string{"10.128.1.5"}, pc.released("ns/pod2", 1, nil))
+}
+
 // Test for nodeNeedsUpdate function
 func TestNodeNeedsUpdate(t *testing.T) {
 	tests := []struct {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -885,7 +886,7 @@ var _ = Describe("OVNKube Network Connect Controller Integration Tests", func()
 				}
 
 				// Create and start controller
-				controller = NewController(zoneName, nbClient, wf, fakeNM.Interface())
+				controller = NewController(zoneName, nbClient, fakeClientset.NetworkConnectClient, wf, fakeNM.Interface())
 
 				err = controller.Start()
 				Expect(err).NotTo(HaveOccurred())
@@ -2920,7 +2921,7 @@ var _ = Describe("OVNKube Network Connect Controller Integration Tests", func()
 						},
 					}
 
-					controller = NewController(zoneName, nbClient, wf, nm)
+					controller = NewController(zoneName, nbClient, fakeClientset.NetworkConnectClient, wf, nm)
 					err = controller.Start()
 					Expect(err).NotTo(HaveOccurred())
 
@@ -4063,6 +4064,281 @@ var _ = Describe("OVNKube Network Connect Controller Integration Tests", func()
 				})
 			})
 
+			// =============================================================================
+			// Context: Allowed Traffic Tests
+			// =============================================================================
+			Context("Allowed Traffic", func() {
+				const (
+					allowedCNCName  = "allowed-cnc"
+					allowedTunnelID = 300
+				)
+
+				BeforeEach(func() {
+					// allowedTraffic selects pods by the namespaces of primary networks
+					redNetwork.role = ovntypes.NetworkRolePrimary
+					blueNetwork.role = ovntypes.NetworkRolePrimary
+				})
+
+				// Helper: start both networks and register their NADs so pod IPs can be resolved
+				startAllowedTraffic := func() string {
+					subnetAnnotation := startBothNetworks()
+					fakeNM.Lock()
+					fakeNM.NADNetworks = map[string]util.NetInfo{
+						"red-ns/red-nad":   fakeNM.PrimaryNetworks["red-ns"],
+						"blue-ns/blue-nad": fakeNM.PrimaryNetworks["blue-ns"],
+					}
+					fakeNM.Unlock()
+					return subnetAnnotation
+				}
+
+				// Helper: create a namespace with the given labels in the fake API
+				createNamespace := func(name string, labels map[string]string) {
+					_, err := fakeClientset.KubeClient.CoreV1().Namespaces().Create(context.Background(),
+						&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				// Helper: create a running pod annotated with its primary network IPs in the fake API
+				createPod := func(namespace, name, nadKey string, v4IP, v6IP string) {
+					var ips []*net.IPNet
+					if config.IPv4Mode {
+						ips = append(ips, ovntest.MustParseIPNet(v4IP))
+					}
+					if config.IPv6Mode {
+						ips = append(ips, ovntest.MustParseIPNet(v6IP))
+					}
+					annotations, err := util.MarshalPodAnnotation(nil, &util.PodAnnotation{
+						IPs:  ips,
+						MAC:  util.IPAddrToHWAddr(ips[0].IP),
+						Role: ovntypes.NetworkRolePrimary,
+					}, nadKey)
+					Expect(err).NotTo(HaveOccurred())
+					pod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Annotations: annotations},
+						Spec:       corev1.PodSpec{NodeName: "node1"},
+						Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+					}
+					_, err = fakeClientset.KubeClient.CoreV1().Pods(namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				// Helper: get the allowedTraffic ACLs applied on a switch keyed by type
+				getAllowedTrafficACLs := func(switchName string) (map[string]*nbdb.ACL, error) {
+					sw, err := libovsdbops.GetLogicalSwitch(nbClient, &nbdb.LogicalSwitch{Name: switchName})
+					if err != nil {
+						return nil, err
+					}
+					predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLClusterNetworkConnectAllowedTraffic,
+						controllerName, map[libovsdbops.ExternalIDKey]string{
+							libovsdbops.ObjectNameKey: allowedCNCName,
+						})
+					acls, err := libovsdbops.FindACLsWithPredicate(nbClient,
+						libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, func(acl *nbdb.ACL) bool {
+							return slices.Contains(sw.ACLs, acl.UUID)
+						}))
+					if err != nil {
+						return nil, err
+					}
+					result := make(map[string]*nbdb.ACL, len(acls))
+					for _, acl := range acls {
+						result[acl.ExternalIDs[libovsdbops.TypeKey.String()]] = acl
+					}
+					return result, nil
+				}
+
+				// Helper: get the addresses of the address sets of an allowedTraffic rule
+				getAllowedTrafficAddresses := func(ruleIndex int) ([]string, error) {
+					predicateIDs := getAllowedTrafficAddressSetDbIDs(allowedCNCName, ruleIndex)
+					addressSets, err := libovsdbops.FindAddressSetsWithPredicate(nbClient,
+						libovsdbops.GetPredicate[*nbdb.AddressSet](predicateIDs, nil))
+					if err != nil {
+						return nil, err
+					}
+					var addresses []string
+					for _, as := range addressSets {
+						addresses = append(addresses, as.Addresses...)
+					}
+					return addresses, nil
+				}
+
+				// Helper: expected pod IPs for the enabled IP families
+				expectedIPs := func(v4IP, v6IP string) []string {
+					var ips []string
+					if config.IPv4Mode {
+						ips = append(ips, v4IP)
+					}
+					if config.IPv6Mode {
+						ips = append(ips, v6IP)
+					}
+					return ips
+				}
+
+				// Helper: get the allowedTraffic condition of this zone from the fake API
+				getAllowedTrafficCondition := func() *metav1.Condition {
+					cnc, err := fakeClientset.NetworkConnectClient.K8sV1().ClusterNetworkConnects().Get(
+						context.Background(), allowedCNCName, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					for i := range cnc.Status.Conditions {
+						if cnc.Status.Conditions[i].Type == allowedTrafficConditionTypePrefix+zoneName {
+							return &cnc.Status.Conditions[i]
+						}
+					}
+					return nil
+				}
+
+				It("should program ACLs and address sets for the allowed namespaces and ports", func() {
+					subnetAnnotation := startAllowedTraffic()
+					createNamespace("red-ns", map[string]string{"tier": "shared"})
+					createNamespace("blue-ns", nil)
+					createPod("red-ns", "red-pod", "red-ns/red-nad", "10.128.1.5/24", "fd00:10:128:1::5/64")
+					createPod("blue-ns", "blue-pod", "blue-ns/blue-nad", "10.129.1.5/24", "fd00:10:129:1::5/64")
+
+					cnc := createTestCNC(allowedCNCName, allowedTunnelID, defaultConnectSubnets(), subnetAnnotation)
+					cnc.Spec.AllowedTraffic = []networkconnectv1.AllowedTrafficRule{
+						{
+							NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}},
+							Ports: []networkconnectv1.AllowedPort{
+								{Protocol: corev1.ProtocolTCP, Port: 8080},
+								{Protocol: corev1.ProtocolUDP, Port: 5000, EndPort: 5010},
+							},
+						},
+					}
+					_, err := fakeClientset.NetworkConnectClient.K8sV1().ClusterNetworkConnects().Create(
+						context.Background(), cnc, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					// Verify the pass and drop ACLs on the switch of each network, for the traffic from the other one
+					verifyAllowedTrafficACLs := func(switchName string, srcSubnets ...string) error {
+						acls, err := getAllowedTrafficACLs(switchName)
+						if err != nil {
+							return err
+						}
+						pass, drop := acls["pass-0"], acls[allowedTrafficDropACLType]
+						if len(acls) != 2 || pass == nil || drop == nil {
+							return fmt.Errorf("expected pass and drop allowedTraffic ACLs on %s, got %v", switchName, acls)
+						}
+						if pass.Priority != ovntypes.NetworkConnectPassAllowedTrafficPriority ||
+							pass.Action != nbdb.ACLActionPass ||
+							pass.Direction != nbdb.ACLDirectionToLport ||
+							!strings.Contains(pass.Match, "(tcp && tcp.dst == 8080) || (udp && udp.dst >= 5000 && udp.dst <= 5010)") {
+							return fmt.Errorf("unexpected pass ACL %+v", pass)
+						}
+						if drop.Priority != ovntypes.NetworkConnectDropNotAllowedTrafficPriority ||
+							drop.Action != nbdb.ACLActionDrop ||
+							drop.Direction != nbdb.ACLDirectionToLport ||
+							!strings.HasSuffix(drop.Match, "&& ct.new") {
+							return fmt.Errorf("unexpected drop ACL %+v", drop)
+						}
+						for _, subnet := range srcSubnets {
+							if !strings.Contains(pass.Match, subnet) || !strings.Contains(drop.Match, subnet) {
+								return fmt.Errorf("allowedTraffic ACLs on %s don't match the traffic from %s", switchName, subnet)
+							}
+						}
+						return nil
+					}
+					Eventually(func() error {
+						if err := verifyAllowedTrafficACLs(redNetwork.SwitchName("node1"), blueNetwork.DefaultSubnets()...); err != nil {
+							return err
+						}
+						var redSubnets []string
+						for _, subnet := range redNetwork.DefaultSubnets() {
+							// layer3 subnets have the host subnet length appended
+							redSubnets = append(redSubnets, subnet[:strings.LastIndex(subnet, "/")])
+						}
+						return verifyAllowedTrafficACLs(blueNetwork.SwitchName("node1"), redSubnets...)
+					}).WithTimeout(5 * time.Second).Should(Succeed())
+
+					// Only the pod of the selected namespace is allowed
+					Eventually(func() ([]string, error) {
+						return getAllowedTrafficAddresses(0)
+					}).WithTimeout(5 * time.Second).Should(ConsistOf(expectedIPs("10.128.1.5", "fd00:10:128:1::5")))
+
+					// Status reports the rules as applied in this zone
+					Eventually(func() metav1.ConditionStatus {
+						condition := getAllowedTrafficCondition()
+						if condition == nil {
+							return ""
+						}
+						return condition.Status
+					}).WithTimeout(5 * time.Second).Should(Equal(metav1.ConditionTrue))
+
+					// Selecting the other namespace adds its pod
+					ns, err := fakeClientset.KubeClient.CoreV1().Namespaces().Get(context.Background(), "blue-ns", metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					ns.Labels = map[string]string{"tier": "shared"}
+					_, err = fakeClientset.KubeClient.CoreV1().Namespaces().Update(context.Background(), ns, metav1.UpdateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(func() ([]string, error) {
+						return getAllowedTrafficAddresses(0)
+					}).WithTimeout(5 * time.Second).Should(ConsistOf(append(
+						expectedIPs("10.128.1.5", "fd00:10:128:1::5"),
+						expectedIPs("10.129.1.5", "fd00:10:129:1::5")...)))
+
+					// Deleting the pod removes its IPs
+					err = fakeClientset.KubeClient.CoreV1().Pods("red-ns").Delete(context.Background(), "red-pod", metav1.DeleteOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(func() ([]string, error) {
+						return getAllowedTrafficAddresses(0)
+					}).WithTimeout(5 * time.Second).Should(ConsistOf(expectedIPs("10.129.1.5", "fd00:10:129:1::5")))
+
+					// Creating a pod in a selected namespace adds its IPs
+					createPod("red-ns", "red-pod2", "red-ns/red-nad", "10.128.1.6/24", "fd00:10:128:1::6/64")
+					Eventually(func() ([]string, error) {
+						return getAllowedTrafficAddresses(0)
+					}).WithTimeout(5 * time.Second).Should(ConsistOf(append(
+						expectedIPs("10.129.1.5", "fd00:10:129:1::5"),
+						expectedIPs("10.128.1.6", "fd00:10:128:1::6")...)))
+
+					// Removing the rules removes the ACLs and address sets
+					cnc, err = fakeClientset.NetworkConnectClient.K8sV1().ClusterNetworkConnects().Get(
+						context.Background(), allowedCNCName, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					cnc.Spec.AllowedTraffic = nil
+					_, err = fakeClientset.NetworkConnectClient.K8sV1().ClusterNetworkConnects().Update(
+						context.Background(), cnc, metav1.UpdateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(func() (int, error) {
+						redACLs, err := getAllowedTrafficACLs(redNetwork.SwitchName("node1"))
+						if err != nil {
+							return 0, err
+						}
+						blueACLs, err := getAllowedTrafficACLs(blueNetwork.SwitchName("node1"))
+						return len(redACLs) + len(blueACLs), err
+					}).WithTimeout(5 * time.Second).Should(Equal(0))
+					Eventually(func() ([]string, error) {
+						return getAllowedTrafficAddresses(0)
+					}).WithTimeout(5 * time.Second).Should(BeEmpty())
+
+					// Connect router is left in place
+					Expect(verifyConnectRouter(nbClient, allowedCNCName, allowedTunnelID)).To(Succeed())
+				})
+
+				It("should report invalid rules in the status", func() {
+					subnetAnnotation := startAllowedTraffic()
+					createNamespace("red-ns", nil)
+
+					cnc := createTestCNC(allowedCNCName, allowedTunnelID, defaultConnectSubnets(), subnetAnnotation)
+					cnc.Spec.AllowedTraffic = []networkconnectv1.AllowedTrafficRule{
+						{
+							NamespaceSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "tier", Operator: "Bogus"},
+							}},
+						},
+					}
+					_, err := fakeClientset.NetworkConnectClient.K8sV1().ClusterNetworkConnects().Create(
+						context.Background(), cnc, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(func() string {
+						condition := getAllowedTrafficCondition()
+						if condition == nil || condition.Status != metav1.ConditionFalse {
+							return ""
+						}
+						return condition.Message
+					}).WithTimeout(5 * time.Second).Should(ContainSubstring(ovntypes.ClusterNetworkConnectAllowedTrafficErrorMsg))
+				})
+			})
+
 		}) // end Context for ipMode
 	}
 })
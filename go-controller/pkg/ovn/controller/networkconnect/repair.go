@@ -17,7 +17,7 @@ import (
 	ovntypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
 )
 
-// repairStaleCNCs removes OVN objects (ACLs, LBGs, ports, policies, connect routers)
+// repairStaleCNCs removes OVN objects (ACLs, LBGs, ports, policies, connect routers, address sets)
 // that belong to CNCs no longer in the API. Called once at startup before workers run.
 // Cleanup is done per object type: for each type we find objects that don't belong to validCNCs and delete them.
 func (c *Controller) repairStaleCNCs() error {
@@ -49,6 +49,9 @@ func (c *Controller) repairStaleCNCs() error {
 	if err := c.cleanupStaleRouters(validCNCs); err != nil {
 		return fmt.Errorf("failed to cleanup stale connect routers: %w", err)
 	}
+	if err := c.cleanupStaleAllowedTraffic(validCNCs); err != nil {
+		return fmt.Errorf("failed to cleanup stale allowedTraffic ACLs and address sets: %w", err)
+	}
 
 	return nil
 }
@@ -171,3 +174,38 @@ func (c *Controller) cleanupStaleRouters(validCNCs sets.Set[string]) error {
 	}
 	return nil
 }
+
+// cleanupStaleAllowedTraffic finds CNC names that have allowedTraffic ACLs or address sets but are not
+// in validCNCs, and calls cleanupAllowedTraffic for each.
+func (c *Controller) cleanupStaleAllowedTraffic(validCNCs sets.Set[string]) error {
+	staleCNCNames := sets.New[string]()
+	aclPredicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLClusterNetworkConnectAllowedTraffic, controllerName, nil)
+	acls, err := libovsdbops.FindACLsWithPredicate(c.nbClient, libovsdbops.GetPredicate[*nbdb.ACL](aclPredicateIDs, nil))
+	if err != nil {
+		return err
+	}
+	for _, acl := range acls {
+		cncName := acl.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+		if cncName != "" && !validCNCs.Has(cncName) {
+			staleCNCNames.Insert(cncName)
+		}
+	}
+	err = c.addressSetFactory.ProcessEachAddressSet(controllerName, libovsdbops.AddressSetClusterNetworkConnectAllowedTraffic,
+		func(dbIDs *libovsdbops.DbObjectIDs) error {
+			cncName := dbIDs.GetObjectID(libovsdbops.ObjectNameKey)
+			if !validCNCs.Has(cncName) {
+				staleCNCNames.Insert(cncName)
+			}
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	for _, cncName := range staleCNCNames.UnsortedList() {
+		klog.Infof("Removing stale allowedTraffic ACLs and address sets of CNC %s", cncName)
+		if err := c.cleanupAllowedTraffic(cncName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -34,6 +34,9 @@ type lbConfig struct {
 
 	clusterEndpoints util.LBEndpoints            // addresses of cluster-wide endpoints
 	nodeEndpoints    map[string]util.LBEndpoints // node -> addresses of local endpoints
+	// node -> addresses of the endpoints preferred by the service's trafficDistribution
+	// for traffic originating on that node; nodes without an entry use all clusterEndpoints.
+	topologyEndpoints map[string]util.LBEndpoints
 
 	// if true, then vips added on the router are in "local" mode
 	// that means, skipSNAT, and remove any non-local endpoints.
@@ -46,6 +49,16 @@ type lbConfig struct {
 	hasNodePort bool
 }
 
+// endpointsForNode returns the endpoints that traffic hitting this config on the given node
+// is balanced across: the topology preferred endpoints if there are any for the node, all
+// cluster-wide endpoints otherwise.
+func (c *lbConfig) endpointsForNode(node string) util.LBEndpoints {
+	if topologyEndpoints, ok := c.topologyEndpoints[node]; ok {
+		return topologyEndpoints
+	}
+	return c.clusterEndpoints
+}
+
 func makeNodeSwitchTargetIPs(node string, clusterEntry util.LBEndpointEntry, c *lbConfig) (targetIPsV4, targetIPsV6 []string, v4Changed, v6Changed bool) {
 	targetIPsV4 = clusterEntry.V4IPs
 	targetIPsV6 = clusterEntry.V6IPs
@@ -131,18 +144,21 @@ var protos = []corev1.Protocol{
 // - services with host-network endpoints
 // - services with ExternalTrafficPolicy=Local
 // - services with InternalTrafficPolicy=Local
+// - services with trafficDistribution set, if the endpoint hints apply to any node
 //
 // Template LBs will be created for
-//   - services with NodePort set but *without* ExternalTrafficPolicy=Local or
-//     affinity timeout set.
+//   - services with NodePort set but *without* ExternalTrafficPolicy=Local,
+//     affinity timeout or topology aware trafficDistribution set.
 func buildServiceLBConfigs(service *corev1.Service, endpointSlices []*discovery.EndpointSlice, nodeInfos []nodeInfo,
 	useLBGroup, useTemplates bool, netInfo util.NetInfo) (perNodeConfigs, templateConfigs, clusterConfigs []lbConfig) {
 
 	needsAffinityTimeout := hasSessionAffinityTimeOut(service)
 
 	nodes := sets.New[string]()
+	nodeZones := make(map[string]string, len(nodeInfos))
 	for _, n := range nodeInfos {
 		nodes.Insert(n.name)
+		nodeZones[n.name] = n.topologyZone
 	}
 	// get all the endpoints classified by port and by port,node
 	needsLocalEndpoints := util.ServiceExternalTrafficPolicyLocal(service) || util.ServiceInternalTrafficPolicyLocal(service)
@@ -151,6 +167,11 @@ func buildServiceLBConfigs(service *corev1.Service, endpointSlices []*discovery.
 		klog.Warningf("Failed to get endpoints for service %s/%s during LB config build: %v",
 			service.Namespace, service.Name, err)
 	}
+	// get the endpoints preferred by each node according to trafficDistribution, classified by port,node
+	var portToNodeToTopologyEndpoints util.PortToNodeToLBEndpoints
+	if util.ServiceTrafficDistributionTopologyAware(service) {
+		portToNodeToTopologyEndpoints = util.GetTopologyEndpointsForService(endpointSlices, service, nodeZones)
+	}
 	for _, svcPort := range service.Spec.Ports {
 		svcPortKey := util.GetServicePortKey(svcPort.Protocol, svcPort.Name)
 		clusterEndpoints := portToClusterEndpoints[svcPortKey]
@@ -161,6 +182,10 @@ func buildServiceLBConfigs(service *corev1.Service, endpointSlices []*discovery.
 		// if ExternalTrafficPolicy or InternalTrafficPolicy is local, then we need to do things a bit differently
 		externalTrafficLocal := util.ServiceExternalTrafficPolicyLocal(service)
 		internalTrafficLocal := util.ServiceInternalTrafficPolicyLocal(service)
+		// trafficDistribution only narrows down the endpoints of Cluster traffic policies,
+		// Local traffic policies take precedence.
+		topologyEndpoints := portToNodeToTopologyEndpoints[svcPortKey]
+		hasTopologyEndpoints := len(topologyEndpoints) > 0
 
 		// NodePort services get a per-node load balancer, but with the node's physical IP as the vip
 		// Thus, the vip "node" will be expanded later.
@@ -176,9 +201,12 @@ func buildServiceLBConfigs(service *corev1.Service, endpointSlices []*discovery.
 				internalTrafficLocal: false, // always false for non-ClusterIPs
 				hasNodePort:          true,
 			}
-			// Only "plain" NodePort services (no ETP, no affinity timeout)
-			// can use load balancer templates.
-			if !useLBGroup || !useTemplates || externalTrafficLocal || needsAffinityTimeout {
+			if !externalTrafficLocal {
+				nodePortLBConfig.topologyEndpoints = topologyEndpoints
+			}
+			// Only "plain" NodePort services (no ETP, no affinity timeout, no topology
+			// aware endpoints) can use load balancer templates.
+			if !useLBGroup || !useTemplates || externalTrafficLocal || needsAffinityTimeout || hasTopologyEndpoints {
 				perNodeConfigs = append(perNodeConfigs, nodePortLBConfig)
 			} else {
 				templateConfigs = append(templateConfigs, nodePortLBConfig)
@@ -220,11 +248,15 @@ func buildServiceLBConfigs(service *corev1.Service, endpointSlices []*discovery.
 			internalTrafficLocal: internalTrafficLocal,
 			hasNodePort:          false,
 		}
+		if !internalTrafficLocal {
+			clusterIPConfig.topologyEndpoints = topologyEndpoints
+		}
 
 		// Normally, the ClusterIP LB is global (on all node switches and routers),
 		// unless any of the following are true:
 		// - Any of the endpoints are host-network
 		// - ETP=local service backed by non-local-host-networked endpoints
+		// - trafficDistribution is set and some nodes prefer a subset of the endpoints
 		//
 		// In that case, we need to create per-node LBs.
 		ips := []string{}
@@ -232,7 +264,7 @@ func buildServiceLBConfigs(service *corev1.Service, endpointSlices []*discovery.
 			ips = append(ips, ep.V4IPs...)
 			ips = append(ips, ep.V6IPs...)
 		}
-		if hasHostEndpoints(ips, netInfo) || internalTrafficLocal {
+		if hasHostEndpoints(ips, netInfo) || internalTrafficLocal || hasTopologyEndpoints {
 			perNodeConfigs = append(perNodeConfigs, clusterIPConfig)
 		} else {
 			clusterConfigs = append(clusterConfigs, clusterIPConfig)
@@ -647,7 +679,7 @@ func buildPerNodeLBs(service *corev1.Service, configs []lbConfig, nodes []nodeIn
 				switchV4LocalTargets := []Addr{}
 				switchV6LocalTargets := []Addr{}
 
-				for _, entry := range cfg.clusterEndpoints {
+				for _, entry := range cfg.endpointsForNode(node.name) {
 					switchV4TargetIPs, switchV6TargetIPs, _, _ := makeNodeSwitchTargetIPs(node.name, entry, &cfg)
 
 					routerV4TargetIPs, routerV6TargetIPs, _, _ := makeNodeRouterTargetIPs(
@@ -34,6 +34,8 @@ type nodeInfo struct {
 
 	// The node's zone
 	zone string
+	// The node's topology zone, from the topology.kubernetes.io/zone label
+	topologyZone string
 
 	// The list of node's management IPs
 	mgmtIPs []net.IP
@@ -116,6 +118,7 @@ func nodeInfoForNetwork(node *corev1.Node, netInfo util.NetInfo) (*nodeInfo, err
 		chassisID:          chassisID,
 		nodePortDisabled:   !nodePortEnabled,
 		zone:               util.GetNodeZone(node),
+		topologyZone:       node.Labels[corev1.LabelTopologyZone],
 	}
 	for i := range hsn {
 		ni.podSubnets = append(ni.podSubnets, *hsn[i])
@@ -1019,6 +1019,7 @@ func nodeChangedForAllNetworks(oldNode, newNode *corev1.Node) bool {
 		oldNode.Name != newNode.Name ||
 		util.NodeHostCIDRsAnnotationChanged(oldNode, newNode) ||
 		util.NodeZoneAnnotationChanged(oldNode, newNode) ||
+		oldNode.Labels[corev1.LabelTopologyZone] != newNode.Labels[corev1.LabelTopologyZone] ||
 		util.NoHostSubnet(oldNode) != util.NoHostSubnet(newNode)
 }
 
@@ -404,6 +404,11 @@ func TestNodeChangedPredicates(t *testing.T) {
 	otherNetworkSubnetChange.Annotations[types.NodeSubnetsAnnotation] = `{"default":["10.128.0.0/24"],"other":["10.129.0.0/24"]}`
 	g.Expect(nodeChangedForAnyNetwork(oldNode, otherNetworkSubnetChange)).To(gomega.BeTrue())
 	g.Expect(nodeChangedForNetwork(oldNode, otherNetworkSubnetChange, &util.DefaultNetInfo{})).To(gomega.BeFalse())
+
+	topologyZoneChange := oldNode.DeepCopy()
+	topologyZoneChange.Labels = map[string]string{corev1.LabelTopologyZone: "zone-a"}
+	g.Expect(nodeChangedForAnyNetwork(oldNode, topologyZoneChange)).To(gomega.BeTrue())
+	g.Expect(nodeChangedForNetwork(oldNode, topologyZoneChange, &util.DefaultNetInfo{})).To(gomega.BeTrue())
 }
 
 // TestSyncServices - an end-to-end test for the services controller.
@@ -1807,6 +1812,261 @@ func TestSyncServices(t *testing.T) {
 	}
 }
 
+// TestSyncServicesTrafficDistribution checks that services with spec.trafficDistribution
+// get per-node load balancers targeting the endpoints hinted for the node, and fall back
+// to the cluster-wide load balancer when hints cannot be honored.
+func TestSyncServicesTrafficDistribution(t *testing.T) {
+	const (
+		ns          = "testns"
+		serviceName = "foo"
+
+		serviceClusterIP = "192.168.1.1"
+		servicePort      = int32(80)
+		outPort          = int32(3456)
+
+		nodeAEndpoint = "10.128.0.2"
+		nodeBEndpoint = "10.128.1.2"
+		nodeCEndpoint = "10.128.2.2"
+
+		zoneA = "zone-a"
+		zoneB = "zone-b"
+		zoneC = "zone-c"
+	)
+	var (
+		nodeC = "node-c"
+
+		initialLsGroups = []string{types.ClusterLBGroupName, types.ClusterSwitchLBGroupName}
+		initialLrGroups = []string{types.ClusterLBGroupName, types.ClusterRouterLBGroupName}
+	)
+
+	oldGateway := config.Gateway.Mode
+	oldClusterSubnet := config.Default.ClusterSubnets
+	config.Gateway.Mode = config.GatewayModeShared
+	config.IPv4Mode = true
+	defer func() {
+		config.Gateway.Mode = oldGateway
+		config.Default.ClusterSubnets = oldClusterSubnet
+		config.IPv4Mode = false
+	}()
+	_, cidr4, _ := net.ParseCIDR("10.128.0.0/16")
+	config.Default.ClusterSubnets = []config.CIDRNetworkEntry{{CIDR: cidr4, HostSubnetLength: 24}}
+
+	nodeAInfo := getNodeInfo(nodeA, []string{"10.0.0.1"}, nil)
+	nodeAInfo.topologyZone = zoneA
+	nodeBInfo := getNodeInfo(nodeB, []string{"10.0.0.2"}, nil)
+	nodeBInfo.topologyZone = zoneB
+
+	endpoint := func(ip, node string, zones ...string) discovery.Endpoint {
+		ep := kubetest.MakeReadyEndpoint(node, ip)
+		if len(zones) > 0 {
+			ep.Hints = &discovery.EndpointHints{}
+			for _, zone := range zones {
+				ep.Hints.ForZones = append(ep.Hints.ForZones, discovery.ForZone{Name: zone})
+			}
+		}
+		return ep
+	}
+
+	nodeSwitchRouterLBName := func(node string) string {
+		return fmt.Sprintf("Service_%s_TCP_node_router+switch_%s", namespacedServiceName(ns, serviceName), node)
+	}
+
+	tests := []struct {
+		name                string
+		trafficDistribution *string
+		endpoints           []discovery.Endpoint
+		expectedDb          []libovsdbtest.TestData
+	}{
+		{
+			name:                "PreferClose with zone hints uses per-node load balancers with same zone endpoints",
+			trafficDistribution: ptr.To(corev1.ServiceTrafficDistributionPreferClose),
+			endpoints: []discovery.Endpoint{
+				endpoint(nodeAEndpoint, nodeA, zoneA),
+				endpoint(nodeBEndpoint, nodeB, zoneB),
+				endpoint(nodeCEndpoint, nodeC, zoneB),
+			},
+			expectedDb: []libovsdbtest.TestData{
+				&nbdb.LoadBalancer{
+					UUID:     nodeSwitchRouterLBName(nodeA),
+					Name:     nodeSwitchRouterLBName(nodeA),
+					Options:  servicesOptions(),
+					Protocol: &nbdb.LoadBalancerProtocolTCP,
+					Vips: map[string]string{
+						IPAndPort(serviceClusterIP, servicePort): formatEndpoints(outPort, nodeAEndpoint),
+					},
+					ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(ns, serviceName)),
+				},
+				&nbdb.LoadBalancer{
+					UUID:     nodeSwitchRouterLBName(nodeB),
+					Name:     nodeSwitchRouterLBName(nodeB),
+					Options:  servicesOptions(),
+					Protocol: &nbdb.LoadBalancerProtocolTCP,
+					Vips: map[string]string{
+						IPAndPort(serviceClusterIP, servicePort): formatEndpoints(outPort, nodeBEndpoint, nodeCEndpoint),
+					},
+					ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(ns, serviceName)),
+				},
+				nodeLogicalSwitch(nodeA, initialLsGroups, nodeSwitchRouterLBName(nodeA)),
+				nodeLogicalSwitch(nodeB, initialLsGroups, nodeSwitchRouterLBName(nodeB)),
+				nodeLogicalRouter(nodeA, initialLrGroups, nodeSwitchRouterLBName(nodeA)),
+				nodeLogicalRouter(nodeB, initialLrGroups, nodeSwitchRouterLBName(nodeB)),
+				lbGroup(types.ClusterLBGroupName),
+				lbGroup(types.ClusterSwitchLBGroupName),
+				lbGroup(types.ClusterRouterLBGroupName),
+				nodeIPTemplate(nodeAInfo),
+				nodeIPTemplate(nodeBInfo),
+			},
+		},
+		{
+			name:                "PreferSameZone without hints for a zone falls back to all endpoints on that node",
+			trafficDistribution: ptr.To(corev1.ServiceTrafficDistributionPreferSameZone),
+			endpoints: []discovery.Endpoint{
+				endpoint(nodeBEndpoint, nodeB, zoneB),
+				endpoint(nodeCEndpoint, nodeC, zoneC),
+			},
+			expectedDb: []libovsdbtest.TestData{
+				&nbdb.LoadBalancer{
+					UUID:     nodeSwitchRouterLBName(nodeA),
+					Name:     nodeSwitchRouterLBName(nodeA),
+					Options:  servicesOptions(),
+					Protocol: &nbdb.LoadBalancerProtocolTCP,
+					Vips: map[string]string{
+						IPAndPort(serviceClusterIP, servicePort): formatEndpoints(outPort, nodeBEndpoint, nodeCEndpoint),
+					},
+					ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(ns, serviceName)),
+				},
+				&nbdb.LoadBalancer{
+					UUID:     nodeSwitchRouterLBName(nodeB),
+					Name:     nodeSwitchRouterLBName(nodeB),
+					Options:  servicesOptions(),
+					Protocol: &nbdb.LoadBalancerProtocolTCP,
+					Vips: map[string]string{
+						IPAndPort(serviceClusterIP, servicePort): formatEndpoints(outPort, nodeBEndpoint),
+					},
+					ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(ns, serviceName)),
+				},
+				nodeLogicalSwitch(nodeA, initialLsGroups, nodeSwitchRouterLBName(nodeA)),
+				nodeLogicalSwitch(nodeB, initialLsGroups, nodeSwitchRouterLBName(nodeB)),
+				nodeLogicalRouter(nodeA, initialLrGroups, nodeSwitchRouterLBName(nodeA)),
+				nodeLogicalRouter(nodeB, initialLrGroups, nodeSwitchRouterLBName(nodeB)),
+				lbGroup(types.ClusterLBGroupName),
+				lbGroup(types.ClusterSwitchLBGroupName),
+				lbGroup(types.ClusterRouterLBGroupName),
+				nodeIPTemplate(nodeAInfo),
+				nodeIPTemplate(nodeBInfo),
+			},
+		},
+		{
+			name:                "PreferClose with an endpoint missing hints uses the cluster-wide load balancer",
+			trafficDistribution: ptr.To(corev1.ServiceTrafficDistributionPreferClose),
+			endpoints: []discovery.Endpoint{
+				endpoint(nodeAEndpoint, nodeA, zoneA),
+				endpoint(nodeBEndpoint, nodeB),
+			},
+			expectedDb: []libovsdbtest.TestData{
+				&nbdb.LoadBalancer{
+					UUID:     clusterWideTCPServiceLoadBalancerName(ns, serviceName),
+					Name:     clusterWideTCPServiceLoadBalancerName(ns, serviceName),
+					Options:  servicesOptions(),
+					Protocol: &nbdb.LoadBalancerProtocolTCP,
+					Vips: map[string]string{
+						IPAndPort(serviceClusterIP, servicePort): formatEndpoints(outPort, nodeAEndpoint, nodeBEndpoint),
+					},
+					ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(ns, serviceName)),
+				},
+				nodeLogicalSwitch(nodeA, initialLsGroups),
+				nodeLogicalSwitch(nodeB, initialLsGroups),
+				nodeLogicalRouter(nodeA, initialLrGroups),
+				nodeLogicalRouter(nodeB, initialLrGroups),
+				lbGroup(types.ClusterLBGroupName, clusterWideTCPServiceLoadBalancerName(ns, serviceName)),
+				lbGroup(types.ClusterSwitchLBGroupName),
+				lbGroup(types.ClusterRouterLBGroupName),
+				nodeIPTemplate(nodeAInfo),
+				nodeIPTemplate(nodeBInfo),
+			},
+		},
+		{
+			name: "zone hints are ignored without trafficDistribution",
+			endpoints: []discovery.Endpoint{
+				endpoint(nodeAEndpoint, nodeA, zoneA),
+				endpoint(nodeBEndpoint, nodeB, zoneB),
+			},
+			expectedDb: []libovsdbtest.TestData{
+				&nbdb.LoadBalancer{
+					UUID:     clusterWideTCPServiceLoadBalancerName(ns, serviceName),
+					Name:     clusterWideTCPServiceLoadBalancerName(ns, serviceName),
+					Options:  servicesOptions(),
+					Protocol: &nbdb.LoadBalancerProtocolTCP,
+					Vips: map[string]string{
+						IPAndPort(serviceClusterIP, servicePort): formatEndpoints(outPort, nodeAEndpoint, nodeBEndpoint),
+					},
+					ExternalIDs: loadBalancerExternalIDs(namespacedServiceName(ns, serviceName)),
+				},
+				nodeLogicalSwitch(nodeA, initialLsGroups),
+				nodeLogicalSwitch(nodeB, initialLsGroups),
+				nodeLogicalRouter(nodeA, initialLrGroups),
+				nodeLogicalRouter(nodeB, initialLrGroups),
+				lbGroup(types.ClusterLBGroupName, clusterWideTCPServiceLoadBalancerName(ns, serviceName)),
+				lbGroup(types.ClusterSwitchLBGroupName),
+				lbGroup(types.ClusterRouterLBGroupName),
+				nodeIPTemplate(nodeAInfo),
+				nodeIPTemplate(nodeBInfo),
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d_%s", i, tt.name), func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+
+			initialDb := []libovsdbtest.TestData{
+				nodeLogicalSwitch(nodeA, initialLsGroups),
+				nodeLogicalSwitch(nodeB, initialLsGroups),
+				nodeLogicalRouter(nodeA, initialLrGroups),
+				nodeLogicalRouter(nodeB, initialLrGroups),
+				lbGroup(types.ClusterLBGroupName),
+				lbGroup(types.ClusterSwitchLBGroupName),
+				lbGroup(types.ClusterRouterLBGroupName),
+			}
+			controller, err := newControllerWithDBSetupForNetwork(libovsdbtest.TestSetup{NBData: initialDb}, &util.DefaultNetInfo{}, ns)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			defer controller.close()
+
+			g.Expect(controller.endpointSliceStore.Add(&discovery.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      serviceName + "ab23",
+					Namespace: ns,
+					Labels:    map[string]string{discovery.LabelServiceName: serviceName},
+				},
+				Ports:       []discovery.EndpointPort{{Protocol: &tcp, Port: ptr.To(outPort)}},
+				AddressType: discovery.AddressTypeIPv4,
+				Endpoints:   tt.endpoints,
+			})).To(gomega.Succeed())
+			g.Expect(controller.serviceStore.Add(&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: ns},
+				Spec: corev1.ServiceSpec{
+					Type:                corev1.ServiceTypeClusterIP,
+					ClusterIP:           serviceClusterIP,
+					ClusterIPs:          []string{serviceClusterIP},
+					Selector:            map[string]string{"foo": "bar"},
+					TrafficDistribution: tt.trafficDistribution,
+					Ports: []corev1.ServicePort{{
+						Port:       servicePort,
+						Protocol:   corev1.ProtocolTCP,
+						TargetPort: intstr.FromInt32(outPort),
+					}},
+				},
+			})).To(gomega.Succeed())
+
+			controller.RequestFullSync(controller.testNodeInfos(nodeAInfo, nodeBInfo))
+
+			serviceKey := scopedServiceQueueKey(types.DefaultNetworkName, namespacedServiceName(ns, serviceName))
+			g.Expect(controller.syncService(serviceKey)).To(gomega.Succeed())
+			g.Expect(controller.nbClient).To(libovsdbtest.HaveData(tt.expectedDb))
+		})
+	}
+}
+
 func TestReconcileNetworkSkipsUnregisteredNetwork(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -34,6 +34,7 @@ import (
 	apbroutecontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/controller/apbroute"
 	efcontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/controller/egressfirewall"
 	egresssvc "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/controller/egressservice"
+	mcgcontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/controller/multicast_group"
 	networkconnectcontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/controller/networkconnect"
 	svccontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/controller/services"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/controller/unidling"
@@ -113,6 +114,9 @@ type DefaultNetworkController struct {
 	dnsNameResolver dnsnameresolver.DNSNameResolver
 	efController    *efcontroller.EFController
 
+	// Controller used for programming OVN for MulticastGroups
+	mcgController *mcgcontroller.Controller
+
 	// retry framework for egress IP
 	retryEgressIPs *retry.RetryFramework
 	// retry framework for egress IP Namespaces
@@ -350,6 +354,9 @@ func (oc *DefaultNetworkController) Stop() {
 	if oc.efController != nil {
 		oc.efController.Stop()
 	}
+	if oc.mcgController != nil {
+		oc.mcgController.Stop()
+	}
 	if oc.eIPC != nil {
 		oc.eIPC.StopNADReconciler()
 	}
@@ -681,6 +688,7 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 	if err := WithSyncDurationMetric("pod", oc.WatchPods); err != nil {
 		return err
 	}
+	oc.runPodIPUsageMetricsUpdater()
 
 	if config.OVNKubernetesFeature.EnableAdminNetworkPolicy {
 		err := oc.newANPController()
@@ -752,8 +760,8 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 		}
 
 		oc.efController, err = efcontroller.NewEFController("egress-firewall-controller", oc.zone, oc.kube, oc.nbClient,
-			oc.watchFactory.NamespaceInformer().Lister(), oc.watchFactory.NodeCoreInformer(), oc.watchFactory.EgressFirewallInformer(),
-			oc.networkManager, oc.dnsNameResolver, oc.observManager)
+			oc.addressSetFactory, oc.watchFactory.NamespaceInformer(), oc.watchFactory.NodeCoreInformer(), oc.watchFactory.PodCoreInformer(),
+			oc.watchFactory.EgressFirewallInformer(), oc.networkManager, oc.dnsNameResolver, oc.observManager)
 		if err != nil {
 			return err
 		}
@@ -763,6 +771,15 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 		}
 	}
 
+	if config.EnableMulticast && config.OVNKubernetesFeature.EnableMulticastGroup {
+		oc.mcgController = mcgcontroller.NewController("multicast-group-controller", oc.zone, oc.kube.MulticastGroupClient,
+			oc.nbClient, oc.addressSetFactory, oc.watchFactory.NamespaceInformer(), oc.watchFactory.NodeCoreInformer(),
+			oc.watchFactory.PodCoreInformer(), oc.watchFactory.MulticastGroupInformer(), oc.networkManager)
+		if err := oc.mcgController.Start(); err != nil {
+			return err
+		}
+	}
+
 	if config.OVNKubernetesFeature.EnableEgressQoS {
 		err := oc.initEgressQoSController(
 			oc.watchFactory.EgressQoSInformer(),
@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	egressfirewallapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
@@ -276,8 +277,10 @@ var _ = ginkgo.Describe("OVN EgressFirewall Operations", func() {
 			fakeOVN.controller.zone,
 			fakeOVN.controller.kube,
 			fakeOVN.controller.nbClient,
-			fakeOVN.controller.watchFactory.NamespaceInformer().Lister(),
+			fakeOVN.controller.addressSetFactory,
+			fakeOVN.controller.watchFactory.NamespaceInformer(),
 			fakeOVN.controller.watchFactory.NodeCoreInformer(),
+			fakeOVN.controller.watchFactory.PodCoreInformer(),
 			fakeOVN.controller.watchFactory.EgressFirewallInformer(),
 			fakeOVN.controller.networkManager,
 			fakeOVN.controller.dnsNameResolver,
@@ -1001,6 +1004,81 @@ var _ = ginkgo.Describe("OVN EgressFirewall Operations", func() {
 				err = app.Run([]string{app.Name})
 				gomega.Expect(err).NotTo(gomega.HaveOccurred())
 			})
+			ginkgo.It(fmt.Sprintf("egress firewall with namespace selector updates during pod and namespace update, gateway mode %s", gwMode), func() {
+				config.Gateway.Mode = gwMode
+				config.IPv4Mode = true
+				config.IPv6Mode = false
+
+				app.Action = func(*cli.Context) error {
+					namespace1 := *ovntest.NewNamespace("namespace1")
+					namespace2 := *ovntest.NewNamespace("namespace2")
+					labelKey := "team"
+					labelValue := "a"
+					namespace2.Labels = map[string]string{labelKey: labelValue}
+					selector := metav1.LabelSelector{MatchLabels: map[string]string{labelKey: labelValue}}
+					egressFirewall := newEgressFirewallObject("default", namespace1.Name, []egressfirewallapi.EgressFirewallRule{
+						{
+							Type: "Deny",
+							Ports: []egressfirewallapi.EgressFirewallPort{
+								{Protocol: "TCP", Port: 5432, EndPort: ptr.To[int32](5440)},
+							},
+							To: egressfirewallapi.EgressFirewallDestination{
+								NamespaceSelector: &selector,
+							},
+						},
+					})
+
+					startOvn(dbSetup, []corev1.Namespace{namespace1, namespace2}, []egressfirewallapi.EgressFirewall{*egressFirewall}, true)
+
+					asIDs := libovsdbops.NewDbObjectIDs(libovsdbops.AddressSetEgressFirewallPodSelector, t.DefaultNetworkControllerName,
+						map[libovsdbops.ExternalIDKey]string{
+							libovsdbops.ObjectNameKey: namespace1.Name,
+							libovsdbops.RuleIndex:     "0",
+						})
+					asV4, _ := addressset.GetTestDbAddrSets(asIDs, nil)
+					namespace2PortGroup := libovsdbutil.BuildPortGroup(getNamespacePortGroupDbIDs(namespace2.Name, t.DefaultNetworkControllerName), nil, nil)
+					namespace2PortGroup.UUID = namespace2PortGroup.Name + "-UUID"
+					expectedDatabaseState := getEFExpectedDb(append([]libovsdb.TestData{asV4, namespace2PortGroup}, initialData...), fakeOVN, namespace1.Name,
+						fmt.Sprintf("(ip4.dst == $%s)", asV4.Name), "((tcp && ( 5432<=tcp.dst<=5440 )))", nbdb.ACLActionDrop)
+					gomega.Eventually(fakeOVN.nbClient).Should(libovsdb.HaveData(expectedDatabaseState))
+
+					ginkgo.By("Adding a pod with a secondary network IP to the selected namespace")
+					pod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pod1",
+							Namespace: namespace2.Name,
+							Annotations: map[string]string{
+								t.OvnPodAnnotationName: `{"default":{"ip_addresses":["10.128.1.3/24"],"mac_address":"0a:58:0a:80:01:03","role":"primary"},` +
+									`"namespace2/localnet":{"ip_addresses":["192.168.10.5/24"],"mac_address":"0a:58:c0:a8:0a:05","role":"secondary"}}`,
+							},
+						},
+					}
+					_, err := fakeOVN.fakeClient.KubeClient.CoreV1().Pods(namespace2.Name).Create(context.TODO(), pod, metav1.CreateOptions{})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					asV4.Addresses = []string{"192.168.10.5"}
+					gomega.Eventually(fakeOVN.nbClient).Should(libovsdb.HaveData(expectedDatabaseState))
+
+					ginkgo.By("Updating the namespace to not match namespaceSelector on Egress Firewall")
+					namespace2.Labels = nil
+					_, err = fakeOVN.fakeClient.KubeClient.CoreV1().Namespaces().Update(context.TODO(), &namespace2, metav1.UpdateOptions{})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					asV4.Addresses = nil
+					gomega.Eventually(fakeOVN.nbClient).Should(libovsdb.HaveData(expectedDatabaseState))
+
+					ginkgo.By("Deleting the Egress Firewall")
+					err = fakeOVN.fakeClient.EgressFirewallClient.K8sV1().EgressFirewalls(egressFirewall.Namespace).
+						Delete(context.TODO(), egressFirewall.Name, metav1.DeleteOptions{})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					// the address set is removed together with the ACL
+					expectedDatabaseState = getEFExpectedDbAfterDelete(expectedDatabaseState)[1:]
+					gomega.Eventually(fakeOVN.nbClient).Should(libovsdb.HaveData(expectedDatabaseState))
+
+					return nil
+				}
+
+				err := app.Run([]string{app.Name})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			})
 			ginkgo.It(fmt.Sprintf("correctly retries deleting an egressfirewall, gateway mode %s", gwMode), func() {
 				config.Gateway.Mode = gwMode
 				app.Action = func(*cli.Context) error {
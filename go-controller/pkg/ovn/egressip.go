@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"net"
 	"reflect"
 	"slices"
@@ -312,11 +313,12 @@ func (e *EgressIPController) unlockNetwork(networkName string) {
 //	  CASE 3.2: Only Namespace selectors on Spec changed
 //	  CASE 3.3: Only Pod Selectors on Spec changed
 //	  CASE 3.4: Both Namespace && Pod Selectors on Spec changed
+//	  CASE 3.5: Distribution mode on Spec changed
 //	}
 //
 // NOTE: `Spec.EgressIPs" updates for EIP object are not processed here, that is the job of cluster manager
 //
-//	We only care about `Spec.NamespaceSelector`, `Spec.PodSelector` and `Status` field
+//	We only care about `Spec.NamespaceSelector`, `Spec.PodSelector`, `Spec.DistributionMode` and `Status` field
 func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (err error) {
 	var egressIPName string
 	if old != nil {
@@ -331,7 +333,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 	if old != nil && new == nil {
 		removeStatus := old.Status.Items
 		if len(removeStatus) > 0 {
-			if err := e.deleteEgressIPAssignments(old.Name, removeStatus); err != nil {
+			if err := e.deleteEgressIPAssignments(old.Name, nil, removeStatus); err != nil {
 				return err
 			}
 		}
@@ -345,7 +347,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 	if old == nil && new != nil {
 		addStatus := new.Status.Items
 		if len(addStatus) > 0 {
-			if err := e.addEgressIPAssignments(new.Name, addStatus, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
+			if err := e.addEgressIPAssignments(new, addStatus, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
 				return err
 			}
 		}
@@ -377,7 +379,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 				statusToDelete = append(statusToDelete, oldStatus)
 			}
 			if len(statusToDelete) > 0 {
-				if err := e.deleteEgressIPAssignments(old.Name, statusToDelete); err != nil {
+				if err := e.deleteEgressIPAssignments(old.Name, newEIP, statusToDelete); err != nil {
 					return err
 				}
 			}
@@ -389,13 +391,27 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 				}
 				statusToAdd = append(statusToAdd, newStatus)
 			}
+			// in per-pod sticky mode the pods served by a removed status have to be pinned to
+			// one of the remaining statuses
+			if isPerPodStickyEgressIP(newEIP) && len(statusToDelete) > 0 {
+				statusToAdd = newEIP.Status.Items
+			}
 			if len(statusToAdd) > 0 {
-				if err := e.addEgressIPAssignments(new.Name, statusToAdd, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
+				if err := e.addEgressIPAssignments(new, statusToAdd, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
 					return err
 				}
 			}
 		}
 
+		// CASE 3.5: Distribution mode on Spec changed
+		// Add all the statuses for the matching pods: in ECMP mode the pods get the statuses
+		// they were missing, in per-pod sticky mode the pods only keep the status they are pinned to.
+		if isPerPodStickyEgressIP(oldEIP) != isPerPodStickyEgressIP(newEIP) && len(newEIP.Status.Items) > 0 {
+			if err := e.addEgressIPAssignments(newEIP, newEIP.Status.Items, mark, newEIP.Spec.NamespaceSelector, newEIP.Spec.PodSelector); err != nil {
+				return err
+			}
+		}
+
 		oldNamespaceSelector, err := metav1.LabelSelectorAsSelector(&oldEIP.Spec.NamespaceSelector)
 		if err != nil {
 			return fmt.Errorf("invalid old namespaceSelector, err: %v", err)
@@ -453,7 +469,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 						// our node does not have this network
 						continue
 					}
-					if err := e.addNamespaceEgressIPAssignments(ni, newEIP.Name, newEIP.Status.Items, mark, namespace, newEIP.Spec.PodSelector); err != nil {
+					if err := e.addNamespaceEgressIPAssignments(ni, newEIP, newEIP.Status.Items, mark, namespace, newEIP.Spec.PodSelector); err != nil {
 						errs = append(errs, fmt.Errorf("network %s: failed to add namespace %s egress IP config: %v", ni.GetNetworkName(), namespace.Name, err))
 					}
 				}
@@ -504,7 +520,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 							// our node does not have this network
 							continue
 						}
-						if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+						if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP, newEIP.Status.Items, mark, pod); err != nil {
 							errs = append(errs, fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err))
 						}
 					}
@@ -552,7 +568,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 					for _, pod := range pods {
 						podLabels := labels.Set(pod.Labels)
 						if newPodSelector.Matches(podLabels) {
-							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP, newEIP.Status.Items, mark, pod); err != nil {
 								errs = append(errs, fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err))
 							}
 						}
@@ -573,7 +589,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 							}
 						}
 						if newPodSelector.Matches(podLabels) && !oldPodSelector.Matches(podLabels) {
-							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP, newEIP.Status.Items, mark, pod); err != nil {
 								errs = append(errs, fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err))
 							}
 						}
@@ -669,7 +685,7 @@ func (e *EgressIPController) reconcileEgressIPNamespace(old, new *corev1.Namespa
 					// our node does not have this network
 					return nil
 				}
-				if err := e.addNamespaceEgressIPAssignments(ni, eIP.Name, eIP.Status.Items, mark, newNamespace, eIP.Spec.PodSelector); err != nil {
+				if err := e.addNamespaceEgressIPAssignments(ni, eIP, eIP.Status.Items, mark, newNamespace, eIP.Spec.PodSelector); err != nil {
 					return fmt.Errorf("network %s: failed to add namespace %q for egress IP %q: %w",
 						ni.GetNetworkName(), namespaceName, eIP.Name, err)
 				}
@@ -826,7 +842,7 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 					// IPs assigned at that point and we need to continue trying the
 					// pod setup for every pod update as to make sure we process the
 					// pod IP assignment.
-					if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, newPod); err != nil {
+					if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP, eIP.Status.Items, mark, newPod); err != nil {
 						return fmt.Errorf("network %s: failed to add pod %s/%s for egress IP %q: %w",
 							ni.GetNetworkName(), newPod.Namespace, newPod.Name, eIP.Name, err)
 					}
@@ -843,7 +859,7 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 					return nil
 				}
 				// For all else, perform a setup for the pod
-				if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, newPod); err != nil {
+				if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP, eIP.Status.Items, mark, newPod); err != nil {
 					return fmt.Errorf("network %s: failed to add pod %s/%s for egress IP %q: %w",
 						ni.GetNetworkName(), newPod.Namespace, newPod.Name, eIP.Name, err)
 				}
@@ -859,7 +875,7 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 
 // main reconcile functions end here and local zone controller functions begin
 
-func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, namespaceSelector, podSelector metav1.LabelSelector) error {
+func (e *EgressIPController) addEgressIPAssignments(eIP *egressipv1.EgressIP, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, namespaceSelector, podSelector metav1.LabelSelector) error {
 	namespaces, err := e.watchFactory.GetNamespacesBySelector(namespaceSelector)
 	if err != nil {
 		return err
@@ -876,14 +892,14 @@ func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignmen
 		if ni == nil {
 			continue
 		}
-		if err := e.addNamespaceEgressIPAssignments(ni, name, statusAssignments, mark, namespace, podSelector); err != nil {
+		if err := e.addNamespaceEgressIPAssignments(ni, eIP, statusAssignments, mark, namespace, podSelector); err != nil {
 			errs = append(errs, err)
 		}
 	}
 	return utilerrors.Join(errs...)
 }
 
-func (e *EgressIPController) addNamespaceEgressIPAssignments(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark,
+func (e *EgressIPController) addNamespaceEgressIPAssignments(ni util.NetInfo, eIP *egressipv1.EgressIP, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark,
 	namespace *corev1.Namespace, podSelector metav1.LabelSelector) error {
 	var pods []*corev1.Pod
 	var err error
@@ -904,18 +920,18 @@ func (e *EgressIPController) addNamespaceEgressIPAssignments(ni util.NetInfo, na
 	}
 	var errs []error
 	for _, pod := range pods {
-		if err := e.addPodEgressIPAssignmentsWithLock(ni, name, statusAssignments, mark, pod); err != nil {
+		if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP, statusAssignments, mark, pod); err != nil {
 			errs = append(errs, err)
 		}
 	}
 	return utilerrors.Join(errs...)
 }
 
-func (e *EgressIPController) addPodEgressIPAssignmentsWithLock(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, pod *corev1.Pod) error {
+func (e *EgressIPController) addPodEgressIPAssignmentsWithLock(ni util.NetInfo, eIP *egressipv1.EgressIP, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, pod *corev1.Pod) error {
 	e.podAssignment.LockKey(getPodKey(pod))
 	defer e.podAssignment.UnlockKey(getPodKey(pod))
-	e.deletePreviousNetworkPodEgressIPAssignments(ni, name, statusAssignments, pod, false)
-	return e.addPodEgressIPAssignments(ni, name, statusAssignments, mark, pod)
+	e.deletePreviousNetworkPodEgressIPAssignments(ni, eIP.Name, statusAssignments, pod, false)
+	return e.addPodEgressIPAssignments(ni, eIP, statusAssignments, mark, pod)
 }
 
 // addPodEgressIPAssignments tracks the setup made for each egress IP matching
@@ -923,20 +939,33 @@ func (e *EgressIPController) addPodEgressIPAssignmentsWithLock(ni util.NetInfo,
 // work on ovnkube-controller restarts when all egress IP handlers will most likely
 // match and perform the setup for the same pod and status multiple times over.
 // requires holding the podAssignmentMutex lock
-func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, pod *corev1.Pod) error {
+func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, eIP *egressipv1.EgressIP, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, pod *corev1.Pod) error {
+	name := eIP.Name
 	podKey := getPodKey(pod)
 	// Ignore completed pods, host networked pods, pods not scheduled
 	if !util.PodNeedsSNAT(pod) {
 		klog.Infof("Pod %s is not in desired state, skipping egress ip assignment", podKey)
 		return nil
 	}
+	// In per-pod sticky mode the pod is only served by the statuses it is pinned to. These are picked
+	// among all the statuses of the egress IP object since only the statuses that changed might be provided.
+	sticky := isPerPodStickyEgressIP(eIP)
+	if sticky {
+		var current []egressipv1.EgressIPStatusItem
+		if podState, exists := e.podAssignment.Load(podKey); exists && podState.egressIPName == name {
+			for status := range podState.egressStatuses.statusMap {
+				current = append(current, status)
+			}
+		}
+		statusAssignments = getPerPodStickyStatuses(podKey, current, eIP.Status.Items)
+	}
 	// If statusAssignments is empty just return, not doing this will delete the
 	// external GW set up, even though there might be no egress IP set up to
 	// perform.
 	if len(statusAssignments) == 0 {
 		return nil
 	}
-	var remainingAssignments, staleAssignments, reprogramAssignments []egressipv1.EgressIPStatusItem
+	var remainingAssignments, staleAssignments, reprogramAssignments, unpinnedAssignments []egressipv1.EgressIPStatusItem
 	nadKey, err := e.getPodNADKeyForNetwork(ni, pod)
 	if err != nil {
 		return err
@@ -983,6 +1012,15 @@ func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name str
 				staleAssignments = append(staleAssignments, *staleStatus)
 			}
 		}
+		if sticky {
+			// Detect the statuses the pod is not pinned to anymore, e.g. when its egress IP
+			// is assigned again after a failure or the distribution mode changed.
+			for status := range podState.egressStatuses.statusMap {
+				if !slices.Contains(statusAssignments, status) && !slices.Contains(staleAssignments, status) {
+					unpinnedAssignments = append(unpinnedAssignments, status)
+				}
+			}
+		}
 		podState.egressIPName = name
 		podState.network = ni
 		podState.standbyEgressIPNames.Delete(name)
@@ -1016,6 +1054,17 @@ func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name str
 		}
 		delete(podState.egressStatuses.statusMap, staleStatus)
 	}
+	if len(unpinnedAssignments) > 0 {
+		klog.V(2).Infof("Pod %s is pinned to egress IP statuses %+v, deleting statuses: %+v", podKey, statusAssignments, unpinnedAssignments)
+		// don't promote a standby egress IP, the pinned statuses are added right after
+		if err := e.deletePodEgressIPAssignments(ni, name, unpinnedAssignments, pod, false); err != nil {
+			return fmt.Errorf("failed to delete unpinned statuses %v of pod %s for egress IP %s: %w",
+				unpinnedAssignments, podKey, name, err)
+		}
+		for _, status := range unpinnedAssignments {
+			delete(podState.egressStatuses.statusMap, status)
+		}
+	}
 	if len(reprogramAssignments) > 0 {
 		klog.V(2).Infof("Pod %s IPs changed, forcing egress IP status reprogram for statuses: %+v", podKey, reprogramAssignments)
 		if err := e.deletePodEgressIPAssignments(ni, name, reprogramAssignments, pod, false); err != nil {
@@ -1090,8 +1139,9 @@ func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name str
 // (egress IP name - status) basis. The idea is thus to list the full content of
 // the NB DB for that egress IP object and delete everything which match the
 // status. We also need to update the podAssignment cache and finally re-add the
-// external GW setup in case the pod still exists.
-func (e *EgressIPController) deleteEgressIPAssignments(name string, statusesToRemove []egressipv1.EgressIPStatusItem) error {
+// external GW setup in case the pod still exists. eIP is the egress IP object
+// being reconciled, nil if it was deleted.
+func (e *EgressIPController) deleteEgressIPAssignments(name string, eIP *egressipv1.EgressIP, statusesToRemove []egressipv1.EgressIPStatusItem) error {
 
 	podAssignments := e.podAssignment.GetKeys()
 
@@ -1123,7 +1173,7 @@ func (e *EgressIPController) deleteEgressIPAssignments(name string, statusesToRe
 					// this statusToRemove was managing at least one pod, hence let's tear down the setup for this status
 					if _, ok := processedNetworks[cachedNetwork.GetNetworkName()]; !ok {
 						klog.V(2).Infof("Deleting pod egress IP status: %v for EgressIP: %s", statusToRemove, name)
-						if err := e.deleteEgressIPStatusSetup(cachedNetwork, name, statusToRemove); err != nil {
+						if err := e.deleteEgressIPStatusSetup(cachedNetwork, name, eIP, statusToRemove); err != nil {
 							return fmt.Errorf("failed to delete EgressIP %s status setup for network %s: %v", name, cachedNetwork.GetNetworkName(), err)
 						}
 					}
@@ -1352,6 +1402,25 @@ type egressIPCache struct {
 	// packet mark for primary UDNs
 	// EgressIP name -> mark
 	markCache map[string]string
+	// names of the egressIP objects in per-pod sticky distribution mode
+	perPodStickyEgressIPs sets.Set[string]
+}
+
+// egressIPsForNextHops returns the egress IPs of the provided IP family of
+// egressIP object eIPName assigned to the nodes the next hops redirect to for
+// the provided network.
+func (c egressIPCache) egressIPsForNextHops(eIPName, networkName string, isIPv6 bool, nextHops []string) []string {
+	var egressIPs []string
+	for egressIPIP, nodeName := range c.egressIPToAssignedNodes[eIPName] {
+		if utilnet.IsIPv6String(egressIPIP) != isIPv6 {
+			continue
+		}
+		redirects, ok := c.egressNodeRedirectsCache.cache[networkName][nodeName]
+		if ok && slices.ContainsFunc(nextHops, redirects.containsIP) {
+			egressIPs = append(egressIPs, egressIPIP)
+		}
+	}
+	return egressIPs
 }
 
 type nodeNetworkRedirects struct {
@@ -1841,6 +1910,8 @@ func (e *EgressIPController) syncPodAssignmentCache(egressIPCache egressIPCache)
 					}
 
 					podState.standbyEgressIPNames.Insert(egressIPName)
+					// egress IPs found serving the pod, which per-pod sticky pods are pinned to
+					servingEgressIPs := sets.New[string]()
 					for _, policy := range reRoutePolicies {
 						splitMatch := strings.Split(policy.Match, " ")
 						if len(splitMatch) <= 0 {
@@ -1856,6 +1927,8 @@ func (e *EgressIPController) syncPodAssignmentCache(egressIPCache egressIPCache)
 							podState.egressIPName = egressIPName
 							podState.standbyEgressIPNames.Delete(egressIPName)
 							klog.Infof("EgressIP %s is managing pod %s for network %s", egressIPName, podKey, networkName)
+							servingEgressIPs.Insert(egressIPCache.egressIPsForNextHops(egressIPName, networkName,
+								utilnet.IsIPv6(parsedLogicalIP), policy.Nexthops)...)
 						}
 					}
 					// process SNAT only for CDN
@@ -1865,13 +1938,21 @@ func (e *EgressIPController) syncPodAssignmentCache(egressIPCache egressIPCache)
 								podState.egressIPName = egressIPName
 								podState.standbyEgressIPNames.Delete(egressIPName)
 								klog.Infof("EgressIP %s is managing pod %s for network %s", egressIPName, podKey, networkName)
+								if externalIP := net.ParseIP(snat.ExternalIP); externalIP != nil {
+									servingEgressIPs.Insert(externalIP.String())
+								}
 							}
 						}
 					}
 
 					// populate podState.egressStatuses with assigned node for active egressIP IPs.
+					// Per-pod sticky pods are only populated with the egress IPs they are pinned to,
+					// so that they keep them across restarts.
 					if podState.egressIPName == egressIPName {
 						for egressIPIP, nodeName := range egressIPCache.egressIPToAssignedNodes[egressIPName] {
+							if egressIPCache.perPodStickyEgressIPs.Has(egressIPName) && !servingEgressIPs.Has(egressIPIP) {
+								continue
+							}
 							podState.egressStatuses.statusMap[egressipv1.EgressIPStatusItem{
 								EgressIP: egressIPIP, Node: nodeName}] = egressStatusStatePending
 						}
@@ -2266,6 +2347,7 @@ func (e *EgressIPController) generateCacheForEgressIP() (egressIPCache, error) {
 	egressIPToAssignedNodes := make(map[string]map[string]string, 0)
 	cache.egressIPToAssignedNodes = egressIPToAssignedNodes
 	cache.markCache = make(map[string]string)
+	cache.perPodStickyEgressIPs = sets.New[string]()
 	egressIPs, err := e.watchFactory.GetEgressIPs()
 	if err != nil {
 		return cache, err
@@ -2276,6 +2358,9 @@ func (e *EgressIPController) generateCacheForEgressIP() (egressIPCache, error) {
 			klog.Errorf("Failed to parse EgressIP %s mark: %v", egressIP.Name, err)
 		}
 		cache.markCache[egressIP.Name] = mark.String()
+		if isPerPodStickyEgressIP(egressIP) {
+			cache.perPodStickyEgressIPs.Insert(egressIP.Name)
+		}
 		egressIPsCache[egressIP.Name] = make(map[string]selectedPods, 0)
 		egressIPNameNodesCache[egressIP.Name] = make([]string, 0, len(egressIP.Status.Items))
 		egressIPToAssignedNodes[egressIP.Name] = make(map[string]string, 0)
@@ -2701,7 +2786,7 @@ func (e *EgressIPController) addStandByEgressIPAssignment(ni util.NetInfo, podKe
 	}
 	e.podAssignment.Store(podKey, podState)
 	// NOTE: We let addPodEgressIPAssignments take care of setting egressIPName and egressStatuses and removing it from standBy
-	err = e.addPodEgressIPAssignments(ni, eipToAssign, eip.Status.Items, mark, pod)
+	err = e.addPodEgressIPAssignments(ni, eip, eip.Status.Items, mark, pod)
 	if err != nil {
 		return fmt.Errorf("failed to add standby pod %s/%s for network %s: %v", pod.Namespace, pod.Name, ni.GetNetworkName(), err)
 	}
@@ -3372,7 +3457,7 @@ func (e *EgressIPController) deleteGWMarkPolicyForStatusOps(ni util.NetInfo, ops
 // gatewayRouterIP corresponding to the node in the EgressIPStatusItem, else
 // just remove the gatewayRouterIP from the list of nexthops
 // This function should be called with a lock on e.nodeZoneState.status.Node
-func (e *EgressIPController) deleteEgressIPStatusSetup(ni util.NetInfo, name string, status egressipv1.EgressIPStatusItem) error {
+func (e *EgressIPController) deleteEgressIPStatusSetup(ni util.NetInfo, name string, eIP *egressipv1.EgressIP, status egressipv1.EgressIPStatusItem) error {
 	var err error
 	var ops []ovsdb.Operation
 	nextHopIP, err := e.attemptToGetNextHopIP(ni, name, status)
@@ -3407,7 +3492,7 @@ func (e *EgressIPController) deleteEgressIPStatusSetup(ni util.NetInfo, name str
 			return fmt.Errorf("error removing nexthop IP %s from egress ip %s policies on router %s: %v",
 				nextHopIP, name, router, err)
 		}
-	} else if ops, err = e.ensureOnlyValidNextHops(ni, name, status.Node, ops); err != nil {
+	} else if ops, err = e.ensureOnlyValidNextHops(ni, name, eIP, status.Node, ops); err != nil {
 		return err
 	}
 
@@ -3439,7 +3524,7 @@ func (e *EgressIPController) deleteEgressIPStatusSetup(ni util.NetInfo, name str
 	return nil
 }
 
-func (e *EgressIPController) ensureOnlyValidNextHops(ni util.NetInfo, name, nodeName string, ops []ovsdb.Operation) ([]ovsdb.Operation, error) {
+func (e *EgressIPController) ensureOnlyValidNextHops(ni util.NetInfo, name string, eIP *egressipv1.EgressIP, nodeName string, ops []ovsdb.Operation) ([]ovsdb.Operation, error) {
 	// When no nextHopIP is found, This may happen when node object is already deleted.
 	// So compare validNextHopIPs associated with current eIP.Status and Nexthops present
 	// in the LogicalRouterPolicy, then delete nexthop(s) from LogicalRouterPolicy if
@@ -3453,11 +3538,8 @@ func (e *EgressIPController) ensureOnlyValidNextHops(ni util.NetInfo, name, node
 	if err != nil {
 		return ops, err
 	}
-	eIP, err := e.watchFactory.GetEgressIP(name)
-	if err != nil && !apierrors.IsNotFound(err) {
-		return ops, fmt.Errorf("error retrieving EgressIP %s object for updating logical router policy nexthops, err: %w", name, err)
-	} else if err != nil && apierrors.IsNotFound(err) {
-		// EgressIP object is not found, so delete LRP associated with it.
+	if eIP == nil {
+		// EgressIP object was deleted, so delete LRP associated with it.
 		ops, err = libovsdbops.DeleteLogicalRouterPolicyWithPredicateOps(e.nbClient, ops, routerName, policyPred)
 		if err != nil {
 			return ops, fmt.Errorf("error creating ops to remove logical router policy for EgressIP %s from router %s: %v",
@@ -4277,6 +4359,44 @@ func getPodNamespaceAndNameFromKey(podKey string) (string, string) {
 	return parts[0], parts[1]
 }
 
+func isPerPodStickyEgressIP(eIP *egressipv1.EgressIP) bool {
+	return eIP.Spec.DistributionMode == egressipv1.PerPodStickyDistributionMode
+}
+
+// getPerPodStickyStatuses returns the statuses a pod is pinned to in per-pod sticky mode: one per IP family.
+// The pod keeps the egress IP of the statuses currently serving it as long as that egress IP is still assigned,
+// whichever node it is assigned to. Otherwise, e.g. for a new pod or when its egress IP isn't assigned anymore,
+// a status is picked with rendezvous hashing of the pod key and the egress IP. Adding an egress IP or assigning
+// it again after a failure therefore doesn't move any pod.
+func getPerPodStickyStatuses(podKey string, current, statuses []egressipv1.EgressIPStatusItem) []egressipv1.EgressIPStatusItem {
+	var pinned []egressipv1.EgressIPStatusItem
+	for _, isIPv6 := range []bool{false, true} {
+		var best *egressipv1.EgressIPStatusItem
+		var bestScore uint64
+		for i := range statuses {
+			ip := net.ParseIP(statuses[i].EgressIP)
+			if ip == nil || utilnet.IsIPv6(ip) != isIPv6 {
+				continue
+			}
+			if slices.ContainsFunc(current, func(status egressipv1.EgressIPStatusItem) bool {
+				return ip.Equal(net.ParseIP(status.EgressIP))
+			}) {
+				best = &statuses[i]
+				break
+			}
+			hash := fnv.New64a()
+			hash.Write([]byte(podKey + "/" + ip.String()))
+			if score := hash.Sum64(); best == nil || score > bestScore {
+				best, bestScore = &statuses[i], score
+			}
+		}
+		if best != nil {
+			pinned = append(pinned, *best)
+		}
+	}
+	return pinned
+}
+
 func getEgressIPPktMark(eipName string, annotations map[string]string) util.EgressIPMark {
 	var err error
 	var mark util.EgressIPMark
@@ -6,7 +6,9 @@ package ovn
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -6390,6 +6392,299 @@ var _ = ginkgo.Describe("OVN EgressIP Operations cluster default network", func(
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("should pin every pod to a single egress IP in per-pod sticky distribution mode", func() {
+			app.Action = func(*cli.Context) error {
+				egressIP1 := "192.168.126.101"
+				egressIP2 := "192.168.126.102"
+				node1IPv4CIDR := "192.168.126.12/24"
+				node2IPv4CIDR := "192.168.126.51/24"
+
+				egressPod1 := *ovntest.NewPodWithLabels(eipNamespace, podName, node1Name, podV4IP, egressPodLabel)
+				egressPod2 := *ovntest.NewPodWithLabels(eipNamespace, "egress-pod2", node2Name, podV4IP2, egressPodLabel)
+				egressNamespace := ovntest.NewNamespace(eipNamespace)
+				annotations := map[string]string{
+					"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\"}", node1IPv4CIDR),
+					"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":\"%s\"}", v4Node1Subnet),
+					"k8s.ovn.org/node-chassis-id":     "79fdcfc4-6fe6-4cd3-8242-c0f85a4668ec",
+					util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4CIDR),
+				}
+				labels := map[string]string{
+					"k8s.ovn.org/egress-assignable": "",
+				}
+				node1 := getNodeObj(node1Name, annotations, labels)
+				annotations = map[string]string{
+					"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\"}", node2IPv4CIDR),
+					"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":\"%s\"}", v4Node2Subnet),
+					"k8s.ovn.org/node-chassis-id":     "89fdcfc4-6fe6-4cd3-8242-c0f85a4668ec",
+					util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node2IPv4CIDR),
+				}
+				node2 := getNodeObj(node2Name, annotations, labels)
+
+				status := []egressipv1.EgressIPStatusItem{
+					{
+						Node:     node1Name,
+						EgressIP: egressIP1,
+					},
+					{
+						Node:     node2Name,
+						EgressIP: egressIP2,
+					},
+				}
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs:        []string{egressIP1, egressIP2},
+						DistributionMode: egressipv1.PerPodStickyDistributionMode,
+						PodSelector: metav1.LabelSelector{
+							MatchLabels: egressPodLabel,
+						},
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"name": egressNamespace.Name,
+							},
+						},
+					},
+					Status: egressipv1.EgressIPStatus{
+						Items: status,
+					},
+				}
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{
+						NBData: []libovsdbtest.TestData{
+							&nbdb.LogicalRouter{
+								Name: types.OVNClusterRouter,
+								UUID: types.OVNClusterRouter + "-UUID",
+							},
+							&nbdb.LogicalRouter{
+								Name:  types.GWRouterPrefix + node1.Name,
+								UUID:  types.GWRouterPrefix + node1.Name + "-UUID",
+								Ports: []string{types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1.Name + "-UUID"},
+							},
+							&nbdb.LogicalRouter{
+								Name:  types.GWRouterPrefix + node2.Name,
+								UUID:  types.GWRouterPrefix + node2.Name + "-UUID",
+								Ports: []string{types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node2.Name + "-UUID"},
+							},
+							&nbdb.LogicalRouterPort{
+								UUID:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1.Name + "-UUID",
+								Name:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1.Name,
+								Networks: []string{nodeLogicalRouterIfAddrV4},
+							},
+							&nbdb.LogicalRouterPort{
+								UUID:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node2.Name + "-UUID",
+								Name:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node2.Name,
+								Networks: []string{node2LogicalRouterIfAddrV4},
+							},
+							&nbdb.LogicalSwitch{
+								UUID: node1.Name + "-UUID",
+								Name: node1.Name,
+							},
+							&nbdb.LogicalSwitch{
+								UUID: node2.Name + "-UUID",
+								Name: node2.Name,
+							},
+						},
+					},
+					&egressipv1.EgressIPList{
+						Items: []egressipv1.EgressIP{eIP},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{node1, node2},
+					},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{*egressNamespace},
+					},
+					&corev1.PodList{
+						Items: []corev1.Pod{egressPod1, egressPod2},
+					},
+				)
+
+				for _, pod := range []*corev1.Pod{&egressPod1, &egressPod2} {
+					i, n, _ := net.ParseCIDR(pod.Status.PodIP + "/23")
+					n.IP = i
+					fakeOvn.controller.logicalPortCache.add(pod, "", types.DefaultNetworkName, "", nil, []*net.IPNet{n})
+				}
+
+				err := fakeOvn.controller.WatchEgressIPNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchEgressIPPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchEgressNodes()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				nodeNextHops := map[string]string{
+					node1Name: nodeLogicalRouterIPv4[0],
+					node2Name: node2LogicalRouterIPv4[0],
+				}
+				getPodNextHops := func(pod *corev1.Pod) func() []string {
+					return func() []string {
+						lrpIDs := getEgressIPLRPReRouteDbIDs(eIP.Name, pod.Namespace, pod.Name, IPFamilyValueV4,
+							types.DefaultNetworkName, fakeOvn.controller.eIPC.controllerName).GetExternalIDs()
+						lrps, err := libovsdbops.FindLogicalRouterPoliciesWithPredicate(fakeOvn.nbClient, func(item *nbdb.LogicalRouterPolicy) bool {
+							return maps.Equal(item.ExternalIDs, lrpIDs)
+						})
+						gomega.Expect(err).NotTo(gomega.HaveOccurred())
+						var nextHops []string
+						for _, lrp := range lrps {
+							nextHops = append(nextHops, lrp.Nexthops...)
+						}
+						return nextHops
+					}
+				}
+				getPodSNATIPs := func(pod *corev1.Pod) func() []string {
+					return func() []string {
+						natIDs := getEgressIPNATDbIDs(eIP.Name, pod.Namespace, pod.Name, IPFamilyValueV4,
+							fakeOvn.controller.controllerName).GetExternalIDs()
+						nats, err := libovsdbops.FindNATsWithPredicate(fakeOvn.nbClient, func(item *nbdb.NAT) bool {
+							return maps.Equal(item.ExternalIDs, natIDs)
+						})
+						gomega.Expect(err).NotTo(gomega.HaveOccurred())
+						var externalIPs []string
+						for _, nat := range nats {
+							externalIPs = append(externalIPs, nat.ExternalIP)
+						}
+						return externalIPs
+					}
+				}
+				expectPinned := func(pod *corev1.Pod, pinned egressipv1.EgressIPStatusItem) {
+					gomega.Eventually(getPodNextHops(pod)).Should(gomega.ConsistOf(nodeNextHops[pinned.Node]))
+					gomega.Eventually(getPodSNATIPs(pod)).Should(gomega.ConsistOf(pinned.EgressIP))
+				}
+
+				// preferred is the egress IP egressPod1 ranks first, other the one it only gets when
+				// preferred isn't assigned
+				preferred := getPerPodStickyStatuses(getPodKey(&egressPod1), nil, status)[0]
+				other := status[0]
+				if preferred == status[0] {
+					other = status[1]
+				}
+				pinned := map[*corev1.Pod]egressipv1.EgressIPStatusItem{}
+				updateStatus := func(items []egressipv1.EgressIPStatusItem) {
+					err := fakeOvn.controller.eIPC.patchReplaceEgressIPStatus(egressIPName, items)
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					gomega.Eventually(func() []egressipv1.EgressIPStatusItem {
+						eIP, err := fakeOvn.controller.watchFactory.GetEgressIP(egressIPName)
+						gomega.Expect(err).NotTo(gomega.HaveOccurred())
+						return eIP.Status.Items
+					}).Should(gomega.ConsistOf(items))
+					for pod, current := range pinned {
+						pinned[pod] = getPerPodStickyStatuses(getPodKey(pod), []egressipv1.EgressIPStatusItem{current}, items)[0]
+					}
+				}
+				expectStaysPinned := func(pod *corev1.Pod, status egressipv1.EgressIPStatusItem) {
+					gomega.Consistently(getPodNextHops(pod)).Should(gomega.ConsistOf(nodeNextHops[status.Node]))
+					gomega.Consistently(getPodSNATIPs(pod)).Should(gomega.ConsistOf(status.EgressIP))
+				}
+
+				ginkgo.By("pinning every pod to one of the assigned egress IPs")
+				for _, pod := range []*corev1.Pod{&egressPod1, &egressPod2} {
+					pinned[pod] = getPerPodStickyStatuses(getPodKey(pod), nil, status)[0]
+					expectPinned(pod, pinned[pod])
+				}
+				gomega.Expect(pinned[&egressPod1]).To(gomega.Equal(preferred))
+
+				ginkgo.By("moving the pods pinned to an egress IP that isn't assigned anymore")
+				updateStatus([]egressipv1.EgressIPStatusItem{other})
+				expectPinned(&egressPod1, other)
+				expectPinned(&egressPod2, other)
+
+				ginkgo.By("keeping the pods on their egress IP once the failed egress IP is assigned again")
+				updateStatus(status)
+				expectStaysPinned(&egressPod1, other)
+				expectStaysPinned(&egressPod2, pinned[&egressPod2])
+
+				ginkgo.By("recovering the egress IP the pods are pinned to on restart")
+				podKey := getPodKey(&egressPod1)
+				oldPodAssignment := fakeOvn.controller.eIPC.podAssignment
+				oldPodAssignment.LockKey(podKey)
+				fakeOvn.controller.eIPC.podAssignment = syncmap.NewSyncMap[*podAssignmentState]()
+				oldPodAssignment.UnlockKey(podKey)
+				egressIPCache, err := fakeOvn.controller.eIPC.generateCacheForEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.eIPC.syncPodAssignmentCache(egressIPCache)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				for _, pod := range []*corev1.Pod{&egressPod1, &egressPod2} {
+					podState, exists := fakeOvn.controller.eIPC.podAssignment.Load(getPodKey(pod))
+					gomega.Expect(exists).To(gomega.BeTrue())
+					gomega.Expect(podState.egressStatuses.statusMap).To(gomega.Equal(statusMap{pinned[pod]: egressStatusStatePending}))
+				}
+				restartedEIP, err := fakeOvn.controller.watchFactory.GetEgressIP(egressIPName)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.eIPC.reconcileEgressIP(nil, restartedEIP)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				expectStaysPinned(&egressPod1, other)
+				expectStaysPinned(&egressPod2, pinned[&egressPod2])
+
+				ginkgo.By("keeping the pods on their egress IP when another egress IP gets assigned")
+				updateStatus([]egressipv1.EgressIPStatusItem{other})
+				expectPinned(&egressPod1, other)
+				expectPinned(&egressPod2, other)
+				updateStatus(status)
+				expectStaysPinned(&egressPod1, other)
+				expectStaysPinned(&egressPod2, other)
+
+				ginkgo.By("serving every pod with all the egress IPs in ECMP distribution mode")
+				eIP.Spec.DistributionMode = egressipv1.ECMPDistributionMode
+				eIP.Status.Items = status
+				_, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), &eIP, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				for _, pod := range []*corev1.Pod{&egressPod1, &egressPod2} {
+					gomega.Eventually(getPodNextHops(pod)).Should(gomega.ConsistOf(nodeLogicalRouterIPv4[0], node2LogicalRouterIPv4[0]))
+					gomega.Eventually(getPodSNATIPs(pod)).Should(gomega.ConsistOf(egressIP1, egressIP2))
+				}
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("should only move the pods whose egress IP isn't assigned anymore in per-pod sticky distribution mode", func() {
+			app.Action = func(*cli.Context) error {
+				fakeOvn.startWithDBSetup(libovsdbtest.TestSetup{})
+				status := []egressipv1.EgressIPStatusItem{
+					{Node: node1Name, EgressIP: "192.168.126.101"},
+					{Node: node2Name, EgressIP: "192.168.126.102"},
+					{Node: node1Name, EgressIP: "192.168.126.103"},
+					{Node: node2Name, EgressIP: "fc00:f853:ccd:e793::101"},
+				}
+				for i := 0; i < 100; i++ {
+					podKey := getPodKey(ovntest.NewPod(eipNamespace, fmt.Sprintf("pod-%d", i), node1Name, ""))
+					pinned := getPerPodStickyStatuses(podKey, nil, status[:2])
+					gomega.Expect(pinned).To(gomega.HaveLen(1))
+
+					// the order of the statuses doesn't matter for a new pod
+					reversed := slices.Clone(status[:2])
+					slices.Reverse(reversed)
+					gomega.Expect(getPerPodStickyStatuses(podKey, nil, reversed)).To(gomega.Equal(pinned))
+
+					// another egress IP gets assigned: the pod keeps its egress IP
+					gomega.Expect(getPerPodStickyStatuses(podKey, pinned, status)).To(gomega.Equal(
+						[]egressipv1.EgressIPStatusItem{pinned[0], status[3]}))
+
+					// the egress IP is assigned to another node: the pod keeps its egress IP
+					moved := egressipv1.EgressIPStatusItem{Node: node2Name, EgressIP: pinned[0].EgressIP}
+					gomega.Expect(getPerPodStickyStatuses(podKey, pinned, []egressipv1.EgressIPStatusItem{status[2], moved})).To(gomega.Equal(
+						[]egressipv1.EgressIPStatusItem{moved}))
+
+					// the egress IP isn't assigned anymore: the pod is pinned to one of the remaining egress IPs
+					remaining := slices.DeleteFunc(slices.Clone(status[:3]), func(item egressipv1.EgressIPStatusItem) bool {
+						return item == pinned[0]
+					})
+					repinned := getPerPodStickyStatuses(podKey, pinned, remaining)
+					gomega.Expect(repinned).To(gomega.Equal(getPerPodStickyStatuses(podKey, nil, remaining)))
+
+					// the egress IP is assigned again: the pod keeps the egress IP it was moved to
+					gomega.Expect(getPerPodStickyStatuses(podKey, repinned, status[:3])).To(gomega.Equal(repinned))
+				}
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
 	})
 	ginkgo.Context("on invalid EgressIP selectors", func() {
 		ginkgo.It("reconcileEgressIP should return an error", func() {
@@ -7130,7 +7425,7 @@ var _ = ginkgo.Describe("OVN EgressIP Operations cluster default network", func(
 				// recreate pod with same name immediately; simulating handler race (pods v/s egressip) condition,
 				// so instead of proper pod create, we try out egressIP pod setup which will be a no-op since pod doesn't exist
 				ginkgo.By("should not add egress IP setup for a deleted pod whose entry exists in logicalPortCache")
-				err = fakeOvn.controller.eIPC.addPodEgressIPAssignments(fakeOvn.controller, egressIPName, eIP.Status.Items, util.EgressIPMark{}, &egressPod1)
+				err = fakeOvn.controller.eIPC.addPodEgressIPAssignments(fakeOvn.controller, &eIP, eIP.Status.Items, util.EgressIPMark{}, &egressPod1)
 				gomega.Expect(err).To(gomega.HaveOccurred())
 				// pod is gone but logicalPortCache holds the entry for 60seconds
 				egressPodPortInfo, err = fakeOvn.controller.logicalPortCache.get(&egressPod1, types.DefaultNetworkName)
@@ -50,10 +50,15 @@ const (
 	egressQoSReadyStatusType   = "Ready-In-Zone-"
 	egressQoSReadyReason       = "SetupSucceeded"
 	egressQoSNotReadyReason    = "SetupFailed"
+	egressQoSMeterFailedReason = "MeterSetupFailed"
 )
 
 var maxEgressQoSRetries = 10
 
+// errEgressQoSMeter is returned when the transaction programming the QoS rows
+// of an EgressQoS with bandwidth limits, which OVN implements with meters, fails.
+var errEgressQoSMeter = errors.New("failed to program bandwidth meters")
+
 type egressQoS struct {
 	sync.RWMutex
 	name      string
@@ -69,6 +74,7 @@ type egressQoSRule struct {
 	addrSet     addressset.AddressSet
 	pods        *sync.Map // pods name -> ips in the addrSet
 	podSelector metav1.LabelSelector
+	bandwidth   map[string]int // QoS bandwidth, nil when the rule is not rate limited
 }
 
 func getEgressQosAddrSetDbIDs(namespace, priority, controller string) *libovsdbops.DbObjectIDs {
@@ -138,6 +144,12 @@ func (oc *DefaultNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQo
 		destination: dst,
 		podSelector: raw.PodSelector,
 	}
+	if raw.Bandwidth != nil {
+		eqr.bandwidth = map[string]int{nbdb.QoSBandwidthRate: int(raw.Bandwidth.Rate)}
+		if raw.Bandwidth.Burst > 0 {
+			eqr.bandwidth[nbdb.QoSBandwidthBurst] = int(raw.Bandwidth.Burst)
+		}
+	}
 
 	return eqr, nil
 }
@@ -590,8 +602,8 @@ func (oc *DefaultNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS)
 		return err
 	}
 
-	allOps := []ovsdb.Operation{}
 	qoses := []*nbdb.QoS{}
+	meteredQoSes := []*nbdb.QoS{}
 	for _, r := range eq.rules {
 		hashedIPv4, hashedIPv6 := r.addrSet.GetASHashNames()
 		match := generateEgressQoSMatch(r, hashedIPv4, hashedIPv6)
@@ -600,33 +612,57 @@ func (oc *DefaultNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS)
 			Match:       match,
 			Priority:    r.priority,
 			Action:      map[string]int{nbdb.QoSActionDSCP: r.dscp},
+			Bandwidth:   r.bandwidth,
 			ExternalIDs: getEgressQoSRuleDbIDs(eq.namespace, r.priority).GetExternalIDs(),
 		}
+		if len(r.bandwidth) > 0 {
+			meteredQoSes = append(meteredQoSes, qos)
+			continue
+		}
 		qoses = append(qoses, qos)
 	}
 
-	ops, err := libovsdbops.CreateOrUpdateQoSesOps(oc.nbClient, nil, qoses...)
+	ops, err := oc.egressQoSOps(logicalSwitches, qoses)
 	if err != nil {
 		return err
 	}
-	allOps = append(allOps, ops...)
-
-	for _, sw := range logicalSwitches {
-		ops, err := libovsdbops.AddQoSesToLogicalSwitchOps(oc.nbClient, nil, sw, qoses...)
-		if err != nil {
-			return err
-		}
-		allOps = append(allOps, ops...)
+	if _, err := libovsdbops.TransactAndCheck(oc.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to create qos, err: %s", err)
 	}
 
-	if _, err := libovsdbops.TransactAndCheck(oc.nbClient, allOps); err != nil {
-		return fmt.Errorf("failed to create qos, err: %s", err)
+	// the rules with a bandwidth are programmed in their own transaction, so
+	// that only a failure to set up their meters is reported as such
+	ops, err = oc.egressQoSOps(logicalSwitches, meteredQoSes)
+	if err != nil {
+		return err
+	}
+	if _, err := libovsdbops.TransactAndCheck(oc.nbClient, ops); err != nil {
+		return fmt.Errorf("%w: failed to create qos, err: %s", errEgressQoSMeter, err)
 	}
 
 	eq.stale = false // we can mark it as "ready" now
 	return nil
 }
 
+// egressQoSOps returns the ops creating or updating the given QoS rows and
+// adding them to the given logical switches.
+func (oc *DefaultNetworkController) egressQoSOps(logicalSwitches []string, qoses []*nbdb.QoS) ([]ovsdb.Operation, error) {
+	if len(qoses) == 0 {
+		return nil, nil
+	}
+	ops, err := libovsdbops.CreateOrUpdateQoSesOps(oc.nbClient, nil, qoses...)
+	if err != nil {
+		return nil, err
+	}
+	for _, sw := range logicalSwitches {
+		ops, err = libovsdbops.AddQoSesToLogicalSwitchOps(oc.nbClient, ops, sw, qoses...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ops, nil
+}
+
 func generateEgressQoSMatch(eq *egressQoSRule, hashedAddressSetNameIPv4, hashedAddressSetNameIPv6 string) string {
 	var src string
 	var dst string
@@ -1066,11 +1102,15 @@ func (oc *DefaultNetworkController) updateEgressQoSZoneStatusToNotReady(egressQo
 	if egressQoS == nil {
 		return nil
 	}
+	reason := egressQoSNotReadyReason
+	if errors.Is(handlerErr, errEgressQoSMeter) {
+		reason = egressQoSMeterFailedReason
+	}
 	notReadyCondition := metav1.Condition{
 		Type:               egressQoSReadyStatusType + oc.zone,
 		Status:             metav1.ConditionFalse,
 		LastTransitionTime: metav1.NewTime(time.Now()),
-		Reason:             egressQoSNotReadyReason,
+		Reason:             reason,
 		Message:            types.EgressQoSErrorMsg + ": " + handlerErr.Error(),
 	}
 	return oc.updateEgressQoSZoneStatusCondition(notReadyCondition, egressQoS.Namespace, egressQoS.Name)
@@ -517,6 +517,233 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	ginkgo.It("should rate limit the traffic of rules with bandwidth", func() {
+		app.Action = func(*cli.Context) error {
+			namespaceT := *testing.NewNamespace("namespace1")
+
+			node1Switch := &nbdb.LogicalSwitch{
+				UUID: "node1-UUID",
+				Name: node1Name,
+			}
+
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					node1Switch,
+				},
+			}
+
+			fakeOVN.startWithDBSetup(dbSetup,
+				&corev1.NamespaceList{
+					Items: []corev1.Namespace{
+						namespaceT,
+					},
+				},
+			)
+
+			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
+				{
+					DstCIDR:   ptr.To("1.2.3.0/24"),
+					DSCP:      50,
+					Bandwidth: &egressqosapi.Bandwidth{Rate: 10000, Burst: 1000},
+				},
+				{
+					DstCIDR:   ptr.To("5.6.7.8/32"),
+					DSCP:      60,
+					Bandwidth: &egressqosapi.Bandwidth{Rate: 20000},
+				},
+			})
+			_, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Create(context.TODO(), eq, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Expect(fakeOVN.InitAndRunEgressQoSController()).To(gomega.Succeed())
+
+			qos1 := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.0/24) && ip4.src == $%s", qosRule0ASv4),
+				Priority:    EgressQoSFlowStartPriority,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
+				Bandwidth:   map[string]int{nbdb.QoSBandwidthRate: 10000, nbdb.QoSBandwidthBurst: 1000},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				UUID:        "qos1-UUID",
+			}
+			qos2 := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", qosRule1ASv4),
+				Priority:    EgressQoSFlowStartPriority - 1,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 60},
+				Bandwidth:   map[string]int{nbdb.QoSBandwidthRate: 20000},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				UUID:        "qos2-UUID",
+			}
+			node1Switch.QOSRules = []string{qos1.UUID, qos2.UUID}
+			expectedDatabaseState := []libovsdbtest.TestData{
+				qos1,
+				qos2,
+				node1Switch,
+			}
+
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+			expectEgressQoSStatusMessageEventually(fakeOVN, namespaceT.Name, false)
+
+			ginkgo.By("Removing the bandwidth of the first rule")
+			eq.Spec.Egress[0].Bandwidth = nil
+			eq.ResourceVersion = "2"
+			_, err = fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Update(context.TODO(), eq, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			qos1.Bandwidth = nil
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+
+			return nil
+		}
+
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should report meters that can't be programmed in the status", func() {
+		app.Action = func(*cli.Context) error {
+			namespaceT := *testing.NewNamespace("namespace1")
+			maxEgressQoSRetries = 0
+			defer func() {
+				maxEgressQoSRetries = 10
+			}()
+
+			node1Switch := &nbdb.LogicalSwitch{
+				UUID: "node1-UUID",
+				Name: node1Name,
+			}
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					node1Switch,
+				},
+			}
+
+			fakeOVN.startWithDBSetup(dbSetup,
+				&corev1.NamespaceList{
+					Items: []corev1.Namespace{
+						namespaceT,
+					},
+				},
+			)
+			gomega.Expect(fakeOVN.InitAndRunEgressQoSController()).To(gomega.Succeed())
+
+			ginkgo.By("Failing to program the bandwidth of a rule while nbdb is down")
+			fakeOVN.controller.nbClient.Close()
+			gomega.Eventually(fakeOVN.controller.nbClient.Connected).Should(gomega.BeFalse())
+
+			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
+				{
+					DstCIDR:   ptr.To("1.2.3.4/32"),
+					DSCP:      50,
+					Bandwidth: &egressqosapi.Bandwidth{Rate: 10000},
+				},
+			})
+			_, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Create(context.TODO(), eq, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			getReason := func() string {
+				defaultEq, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Get(context.TODO(),
+					"default", metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				if len(defaultEq.Status.Conditions) == 0 {
+					return ""
+				}
+				return defaultEq.Status.Conditions[0].Reason
+			}
+			gomega.Eventually(getReason).WithTimeout(2 * config.Default.OVSDBTxnTimeout).Should(gomega.Equal(egressQoSMeterFailedReason))
+			expectEgressQoSStatusMessageEventually(fakeOVN, namespaceT.Name, true)
+
+			ginkgo.By("Programming the bandwidth once nbdb is back")
+			connCtx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+			defer cancel()
+			resetNBClient(connCtx, fakeOVN.controller.nbClient)
+			eq.Spec.Egress[0].DSCP = 40
+			eq.ResourceVersion = "2"
+			_, err = fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Update(context.TODO(), eq, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			qos := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", qosRule0ASv4),
+				Priority:    EgressQoSFlowStartPriority,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 40},
+				Bandwidth:   map[string]int{nbdb.QoSBandwidthRate: 10000},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				UUID:        "qos-UUID",
+			}
+			node1Switch.QOSRules = []string{qos.UUID}
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs([]libovsdbtest.TestData{qos, node1Switch}))
+			gomega.Eventually(getReason).Should(gomega.Equal(egressQoSReadyReason))
+
+			return nil
+		}
+
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should report the generic failure when the rules without bandwidth can't be programmed", func() {
+		app.Action = func(*cli.Context) error {
+			namespaceT := *testing.NewNamespace("namespace1")
+			maxEgressQoSRetries = 0
+			defer func() {
+				maxEgressQoSRetries = 10
+			}()
+
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					&nbdb.LogicalSwitch{
+						UUID: "node1-UUID",
+						Name: node1Name,
+					},
+				},
+			}
+
+			fakeOVN.startWithDBSetup(dbSetup,
+				&corev1.NamespaceList{
+					Items: []corev1.Namespace{
+						namespaceT,
+					},
+				},
+			)
+			gomega.Expect(fakeOVN.InitAndRunEgressQoSController()).To(gomega.Succeed())
+
+			ginkgo.By("Failing to program a rule without bandwidth while nbdb is down")
+			fakeOVN.controller.nbClient.Close()
+			gomega.Eventually(fakeOVN.controller.nbClient.Connected).Should(gomega.BeFalse())
+
+			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
+				{
+					DstCIDR:   ptr.To("1.2.3.4/32"),
+					DSCP:      50,
+					Bandwidth: &egressqosapi.Bandwidth{Rate: 10000},
+				},
+				{
+					DstCIDR: ptr.To("5.6.7.8/32"),
+					DSCP:    40,
+				},
+			})
+			_, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Create(context.TODO(), eq, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			gomega.Eventually(func() string {
+				defaultEq, err := fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(namespaceT.Name).Get(context.TODO(),
+					"default", metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				if len(defaultEq.Status.Conditions) == 0 {
+					return ""
+				}
+				return defaultEq.Status.Conditions[0].Reason
+			}).WithTimeout(2 * config.Default.OVSDBTxnTimeout).Should(gomega.Equal(egressQoSNotReadyReason))
+
+			return nil
+		}
+
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	ginkgo.It("should respond to node events correctly", func() {
 		app.Action = func(*cli.Context) error {
 			namespaceT := *testing.NewNamespace("namespace1")
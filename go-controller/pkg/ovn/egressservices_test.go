@@ -736,6 +736,181 @@ var _ = ginkgo.Describe("OVN Egress Service Operations", func() {
 			gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("should spread the traffic across multiple hosts with node1 in the local zone and node2 remote", func() {
+			app.Action = func(*cli.Context) error {
+				namespaceT := *testing.NewNamespace("testns")
+				config.IPv6Mode = true
+				node1 := nodeFor(node1Name, node1IPv4, node1IPv6, node1IPv4Subnet, node1IPv6Subnet, node1transitIPv4, node1transitIPv6)
+				node1.Labels = map[string]string{"house": "Gryffindor"}
+				node2 := nodeFor(node2Name, node2IPv4, node2IPv6, node2IPv4Subnet, node2IPv6Subnet, node2transitIPv4, node2transitIPv6)
+				node2.Labels = map[string]string{"house": "Gryffindor"}
+
+				clusterRouter := &nbdb.LogicalRouter{
+					Name: ovntypes.OVNClusterRouter,
+					UUID: ovntypes.OVNClusterRouter + "-UUID",
+				}
+
+				dbSetup := libovsdbtest.TestSetup{
+					NBData: []libovsdbtest.TestData{
+						clusterRouter,
+					},
+				}
+
+				ginkgo.By("creating a service allocated to both nodes with v4 and v6 endpoints")
+				esvc1 := egressserviceapi.EgressService{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1",
+						Namespace: "testns",
+					},
+					Spec: egressserviceapi.EgressServiceSpec{
+						SourceIPBy:  egressserviceapi.SourceIPLoadBalancer,
+						ActiveHosts: 2,
+						NodeSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"house": "Gryffindor",
+							},
+						},
+					},
+					Status: egressserviceapi.EgressServiceStatus{
+						Host:  node2Name,
+						Hosts: []string{node2Name, node1Name},
+					},
+				}
+				svc1 := lbSvcFor("testns", "svc1")
+
+				v4EpSlice := discovery.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1-ipv4-epslice",
+						Namespace: "testns",
+						Labels: map[string]string{
+							discovery.LabelServiceName: "svc1",
+						},
+					},
+					AddressType: discovery.AddressTypeIPv4,
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"10.128.1.5"},
+							NodeName:  &node1.Name,
+						},
+						{
+							Addresses: []string{"10.128.2.5"},
+							NodeName:  &node2.Name,
+						},
+					},
+				}
+
+				v6EpSlice := discovery.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "svc1-ipv6-epslice",
+						Namespace: "testns",
+						Labels: map[string]string{
+							discovery.LabelServiceName: "svc1",
+						},
+					},
+					AddressType: discovery.AddressTypeIPv6,
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"fe00:10:128:1::5"},
+							NodeName:  &node1.Name,
+						},
+						{
+							Addresses: []string{"fe00:10:128:2::5"},
+							NodeName:  &node2.Name,
+						},
+					},
+				}
+
+				fakeOVN.startWithDBSetup(dbSetup,
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{
+							*node1,
+							*node2,
+						},
+					},
+					&corev1.ServiceList{
+						Items: []corev1.Service{
+							svc1,
+						},
+					},
+					&discovery.EndpointSliceList{
+						Items: []discovery.EndpointSlice{
+							v4EpSlice,
+							v6EpSlice,
+						},
+					},
+					&egressserviceapi.EgressServiceList{
+						Items: []egressserviceapi.EgressService{
+							esvc1,
+						},
+					},
+				)
+
+				fakeOVN.controller.zone = node1Name
+				fakeOVN.InitAndRunEgressSVCController()
+
+				v4lrp1 := egressServiceRouterPolicy("v4lrp1-UUID", "testns/svc1", "10.128.1.5", "10.128.1.2", node2transitIPv4)
+				v6lrp1 := egressServiceRouterPolicy("v6lrp1-UUID", "testns/svc1", "fe00:10:128:1::5", "fe00:10:128:1::2", node2transitIPv6)
+				v4lrsr := egressServiceRouterPolicy("v4lrsr-UUID", "testns/svc1:ic", "10.128.2.5", "10.128.1.2")
+				v6lrsr := egressServiceRouterPolicy("v6lrsr-UUID", "testns/svc1:ic", "fe00:10:128:2::5", "fe00:10:128:1::2")
+
+				clusterRouter.Policies = []string{"v4lrp1-UUID", "v6lrp1-UUID", "v4lrsr-UUID", "v6lrsr-UUID"}
+				expectedDatabaseState := []libovsdbtest.TestData{
+					clusterRouter,
+					v4lrp1,
+					v6lrp1,
+					v4lrsr,
+					v6lrsr,
+				}
+				expectedEgressSvcAddrSet := []string{"10.128.1.5", "fe00:10:128:1::5"}
+				expectedDatabaseState = appendDefaultNoRerouteData(expectedDatabaseState, clusterRouter, controllerName, []string{node1IPv4, node2IPv4, node1IPv6, node2IPv6})
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+				fakeOVN.asf.ExpectAddressSetWithAddresses(egresssvc.GetEgressServiceAddrSetDbIDs(controllerName), expectedEgressSvcAddrSet)
+
+				ginkgo.By("updating the first node to be not ready the traffic will only be sent to the second node")
+				node1.Status.Conditions = []corev1.NodeCondition{
+					{
+						Type:   corev1.NodeReady,
+						Status: corev1.ConditionFalse,
+					},
+				}
+				node1.ResourceVersion = "2"
+				_, err := fakeOVN.fakeClient.KubeClient.CoreV1().Nodes().Update(context.TODO(), node1, metav1.UpdateOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				v4lrp1 = egressServiceRouterPolicy("v4lrp1-UUID", "testns/svc1", "10.128.1.5", node2transitIPv4)
+				v6lrp1 = egressServiceRouterPolicy("v6lrp1-UUID", "testns/svc1", "fe00:10:128:1::5", node2transitIPv6)
+				clusterRouter.Policies = []string{"v4lrp1-UUID", "v6lrp1-UUID"}
+
+				expectedDatabaseState = []libovsdbtest.TestData{
+					clusterRouter,
+					v4lrp1,
+					v6lrp1,
+				}
+				expectedDatabaseState = appendDefaultNoRerouteData(expectedDatabaseState, clusterRouter, controllerName, []string{node1IPv4, node2IPv4, node1IPv6, node2IPv6})
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+				fakeOVN.asf.ExpectAddressSetWithAddresses(egresssvc.GetEgressServiceAddrSetDbIDs(controllerName), expectedEgressSvcAddrSet)
+
+				ginkgo.By("removing the EgressService its lrps will be removed")
+				err = fakeOVN.fakeClient.EgressServiceClient.K8sV1().EgressServices("testns").Delete(context.TODO(), esvc1.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+				clusterRouter.Policies = []string{}
+				expectedDatabaseState = []libovsdbtest.TestData{clusterRouter}
+				expectedDatabaseState = appendDefaultNoRerouteData(expectedDatabaseState, clusterRouter, controllerName, []string{node1IPv4, node2IPv4, node1IPv6, node2IPv6})
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+				fakeOVN.asf.ExpectAddressSetWithAddresses(egresssvc.GetEgressServiceAddrSetDbIDs(controllerName), []string{})
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+
 		ginkgo.It("should delete resources when host changes to ALL with node1 in the local zone and node2 remote", func() {
 			app.Action = func(*cli.Context) error {
 				namespaceT := *testing.NewNamespace("testns")
@@ -1388,7 +1563,7 @@ func (o *FakeOVN) InitAndRunEgressSVCController(tweak ...func(*DefaultNetworkCon
 }
 
 // creates a logical router policy for egress service
-func egressServiceRouterPolicy(uuid, key, addr, nexthop string) *nbdb.LogicalRouterPolicy {
+func egressServiceRouterPolicy(uuid, key, addr string, nexthops ...string) *nbdb.LogicalRouterPolicy {
 	match := fmt.Sprintf("ip4.src == %s", addr)
 	if utilnet.IsIPv6String(addr) {
 		match = fmt.Sprintf("ip6.src == %s", addr)
@@ -1398,7 +1573,7 @@ func egressServiceRouterPolicy(uuid, key, addr, nexthop string) *nbdb.LogicalRou
 		Action:      nbdb.LogicalRouterPolicyActionReroute,
 		ExternalIDs: map[string]string{"EgressSVC": key},
 		Match:       match,
-		Nexthops:    []string{nexthop},
+		Nexthops:    nexthops,
 		Priority:    ovntypes.EgressSVCReroutePriority,
 	}
 }
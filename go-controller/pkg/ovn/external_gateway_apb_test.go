@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	adminpolicybasedrouteapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
@@ -1260,6 +1261,154 @@ var _ = ginkgo.Describe("OVN for APB External Route Operations", func() {
 			))
 	})
 
+	ginkgo.Context("on setting BFD for static hops", func() {
+
+		ginkgo.It("configures the BFD timers and reports the BFD session status", func() {
+			app.Action = func(*cli.Context) error {
+
+				namespaceT := *testing.NewNamespace(namespaceName)
+
+				t := newTPod(
+					"node1",
+					"10.128.1.0/24",
+					"10.128.1.2",
+					"10.128.1.1",
+					"myPod",
+					"10.128.1.3",
+					"0a:58:0a:80:01:03",
+					namespaceT.Name,
+				)
+
+				policy := getStaticPolicy(true)
+				policy.Spec.NextHops.StaticHops[0].BFD = &adminpolicybasedrouteapi.BFDConfig{
+					MinTx:      ptr.To[int32](100),
+					MinRx:      ptr.To[int32](200),
+					DetectMult: ptr.To[int32](3),
+				}
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{
+						NBData: []libovsdbtest.TestData{
+							&nbdb.LogicalSwitch{
+								UUID: "node1",
+								Name: "node1",
+							},
+							&nbdb.LogicalRouter{
+								UUID: "GR_node1-UUID",
+								Name: "GR_node1",
+							},
+						},
+					},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							namespaceT,
+						},
+					},
+					&corev1.PodList{
+						Items: []corev1.Pod{
+							*testing.NewPod(t.namespace, t.podName, t.nodeName, t.podIP),
+						},
+					},
+					&adminpolicybasedrouteapi.AdminPolicyBasedExternalRouteList{
+						Items: []adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{
+							policy,
+						},
+					},
+				)
+
+				t.populateLogicalSwitchCache(fakeOvn)
+
+				injectNode(fakeOvn)
+				err := fakeOvn.controller.WatchNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOvn.RunAPBExternalPolicyController()
+
+				ginkgo.By("Validating the BFD timers are configured")
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData([]libovsdbtest.TestData{
+					&nbdb.LogicalSwitchPort{
+						UUID:      "lsp1",
+						Addresses: []string{"0a:58:0a:80:01:03 10.128.1.3"},
+						ExternalIDs: map[string]string{
+							"pod":       "true",
+							"namespace": namespaceName,
+						},
+						Name: "namespace1_myPod",
+						Options: map[string]string{
+							"iface-id-ver":               "myPod",
+							libovsdbops.RequestedChassis: chassisIDForNode("node1"),
+						},
+						PortSecurity: []string{"0a:58:0a:80:01:03 10.128.1.3"},
+					},
+					&nbdb.LogicalSwitch{
+						UUID:  "node1",
+						Name:  "node1",
+						Ports: []string{"lsp1"},
+					},
+					&nbdb.BFD{
+						UUID:        bfd1NamedUUID,
+						DstIP:       "9.0.0.1",
+						LogicalPort: "rtoe-GR_node1",
+						MinTx:       ptr.To(100),
+						MinRx:       ptr.To(200),
+						DetectMult:  ptr.To(3),
+					},
+					&nbdb.LogicalRouterStaticRoute{
+						UUID:       "static-route-1-UUID",
+						IPPrefix:   "10.128.1.3/32",
+						Nexthop:    "9.0.0.1",
+						BFD:        &bfd1NamedUUID,
+						Policy:     &nbdb.LogicalRouterStaticRoutePolicySrcIP,
+						OutputPort: &logicalRouterPort,
+						Options: map[string]string{
+							"ecmp_symmetric_reply": "true",
+						},
+					},
+					&nbdb.LogicalRouter{
+						UUID:         "GR_node1-UUID",
+						Name:         "GR_node1",
+						StaticRoutes: []string{"static-route-1-UUID"},
+					},
+				}))
+				checkAPBRouteStatus(fakeOvn, policyName, false)
+
+				ginkgo.By("Validating the BFD session status is reported once OVN sets it")
+				bfd, err := libovsdbops.LookupBFD(fakeOvn.nbClient, &nbdb.BFD{DstIP: "9.0.0.1", LogicalPort: "rtoe-GR_node1"})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				for _, bfdStatus := range []string{nbdb.BFDStatusDown, nbdb.BFDStatusUp} {
+					bfd.Status = &bfdStatus
+					ops, err := fakeOvn.nbClient.Where(bfd).Update(bfd, &bfd.Status)
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					_, err = libovsdbops.TransactAndCheck(fakeOvn.nbClient, ops)
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					gomega.Eventually(func() []adminpolicybasedrouteapi.NextHopStatus {
+						status, err := fakeOvn.controller.apbExternalRouteController.GetAPBRoutePolicyStatus(policyName)
+						gomega.Expect(err).NotTo(gomega.HaveOccurred())
+						return status.NextHops
+					}).Should(gomega.Equal([]adminpolicybasedrouteapi.NextHopStatus{
+						{IP: "9.0.0.1", Node: "node1", BFDStatus: adminpolicybasedrouteapi.BFDStatus(bfdStatus)},
+					}))
+				}
+
+				ginkgo.By("Validating the BFD timers are reset to the OVN defaults when unset")
+				p, err := fakeOvn.fakeClient.AdminPolicyRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Get(context.Background(), policyName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				p.Spec.NextHops.StaticHops[0].BFD = nil
+				p.Generation++
+				_, err = fakeOvn.fakeClient.AdminPolicyRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Update(context.Background(), p, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(func() bool {
+					bfd, err := libovsdbops.LookupBFD(fakeOvn.nbClient, &nbdb.BFD{DstIP: "9.0.0.1", LogicalPort: "rtoe-GR_node1"})
+					return err == nil && bfd.MinTx == nil && bfd.MinRx == nil && bfd.DetectMult == nil
+				}).Should(gomega.BeTrue())
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
 	ginkgo.Context("on setting pod dynamic gateways", func() {
 		ginkgo.DescribeTable("reconciles a host networked pod acting as a exgw for another namespace for new pod", func(bfd bool, finalNB []libovsdbtest.TestData) {
 			app.Action = func(*cli.Context) error {
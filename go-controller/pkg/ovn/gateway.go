@@ -26,6 +26,7 @@ import (
 	nodecontroller "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/controllers/node"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/kubevirt"
 	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/metrics"
@@ -505,6 +506,7 @@ func (gw *GatewayManager) createGWRouterPort(gwConfig *GatewayConfig,
 			if gw.netInfo.MTU() > 0 {
 				gwRouterPort.Ipv6RaConfigs["mtu"] = fmt.Sprintf("%d", gw.netInfo.MTU())
 			}
+			kubevirt.AddIPv6RAConfigs(gwRouterPort.Ipv6RaConfigs, gw.netInfo.DHCPOptions())
 		}
 	}
 
@@ -1715,6 +1717,10 @@ func (gw *GatewayManager) SyncGateway(
 }
 
 func physNetName(netInfo util.NetInfo) string {
+	// networks attached to an Uplink are mapped to its bridge by their name
+	if util.UsesUplink(netInfo) {
+		return netInfo.GetNetworkName()
+	}
 	if netInfo.IsDefault() || netInfo.IsPrimaryNetwork() {
 		return types.PhysicalNetworkName
 	}
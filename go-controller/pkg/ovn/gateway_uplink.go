@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ovn
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// errUplinkNotReady is returned when the gateway of a node can't be configured
+// yet because the network Uplink is not ready on the node. The gateway is
+// synced again from the UplinkState event handler once it turns ready.
+var errUplinkNotReady = errors.New("uplink is not ready")
+
+// applyUplinkGatewayConfig replaces the default external bridge data of the
+// node gateway config with the data of the Uplink the network is attached to,
+// as reported by the node in its UplinkState. It must be called before the
+// network scoped interface ID is derived from the bridge ID.
+func applyUplinkGatewayConfig(watchFactory *factory.WatchFactory, netInfo util.NetInfo, node *corev1.Node,
+	l3GatewayConfig *util.L3GatewayConfig) error {
+	uplinkName := netInfo.Uplink()
+	state, err := util.GetUplinkStateForNode(watchFactory.UplinkStateInformer().Lister(), uplinkName, node.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get state of uplink %s on node %s: %w", uplinkName, node.Name, err)
+	}
+	if !util.IsUplinkStateReady(state) {
+		return fmt.Errorf("%w: uplink %s of network %s on node %s", errUplinkNotReady, uplinkName, netInfo.GetNetworkName(), node.Name)
+	}
+	ips, nextHops, err := util.ParseUplinkStateGateway(state)
+	if err != nil {
+		return err
+	}
+	macAddress, err := net.ParseMAC(state.Status.MACAddress)
+	if err != nil {
+		return fmt.Errorf("invalid MAC address in uplink state %s: %w", state.Name, err)
+	}
+	l3GatewayConfig.BridgeID = state.Status.OVSBridge.Name
+	l3GatewayConfig.MACAddress = macAddress
+	l3GatewayConfig.IPAddresses = ips
+	l3GatewayConfig.NextHops = nextHops
+	// the Uplink bridge is untagged and has no egress gateway bridge
+	l3GatewayConfig.VLANID = nil
+	l3GatewayConfig.EgressGWInterfaceID = ""
+	l3GatewayConfig.EgressGWMACAddress = nil
+	l3GatewayConfig.EgressGWIPAddresses = nil
+	return nil
+}
+
+// addUplinkStateHandler requeues the gateway sync of a local zone node whenever the
+// state of the network Uplink changes on that node. The gateway router is only
+// configured once the UplinkState is ready, and nothing else triggers a node
+// sync when it turns ready. setGatewayFailed marks the gateway of the node for
+// sync on the next node reconciliation.
+func (bnc *BaseNetworkController) addUplinkStateHandler(setGatewayFailed func(nodeName string)) error {
+	onEvent := func(obj interface{}) {
+		state, ok := obj.(*uplinkv1alpha1.UplinkState)
+		if !ok {
+			tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+			if !ok {
+				return
+			}
+			state, ok = tombstone.Obj.(*uplinkv1alpha1.UplinkState)
+			if !ok {
+				return
+			}
+		}
+		uplinkName, nodeName := util.GetUplinkStateIdentity(state)
+		if uplinkName != bnc.GetNetInfo().Uplink() || nodeName == "" {
+			return
+		}
+		if _, local := bnc.localZoneNodes.Load(nodeName); !local {
+			return
+		}
+		klog.V(5).Infof("State of uplink %s changed on node %s, syncing the gateway of network %s",
+			uplinkName, nodeName, bnc.GetNetworkName())
+		setGatewayFailed(nodeName)
+		bnc.nodeReconciler.ReconcileNetwork(nodeName, bnc.GetNetworkName())
+	}
+	handler, err := bnc.watchFactory.UplinkStateInformer().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: onEvent,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldState, oldOK := oldObj.(*uplinkv1alpha1.UplinkState)
+				newState, newOK := newObj.(*uplinkv1alpha1.UplinkState)
+				if oldOK && newOK && equality.Semantic.DeepEqual(oldState.Status, newState.Status) {
+					return
+				}
+				onEvent(newObj)
+			},
+			DeleteFunc: onEvent,
+		})
+	if err != nil {
+		return fmt.Errorf("failed to add uplink state event handler for network %s: %w", bnc.GetNetworkName(), err)
+	}
+	bnc.uplinkStateHandler = handler
+	return nil
+}
+
+func (bnc *BaseNetworkController) removeUplinkStateHandler() {
+	if bnc.uplinkStateHandler == nil {
+		return
+	}
+	if err := bnc.watchFactory.UplinkStateInformer().Informer().RemoveEventHandler(bnc.uplinkStateHandler); err != nil {
+		klog.Errorf("Failed to remove uplink state event handler for network %s: %v", bnc.GetNetworkName(), err)
+	}
+	bnc.uplinkStateHandler = nil
+}
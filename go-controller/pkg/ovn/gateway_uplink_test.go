@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ovn
+
+import (
+	"context"
+	"time"
+
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/urfave/cli/v2"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
+	libovsdbtest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OVN gateway on an Uplink", func() {
+	const (
+		uplinkName    = "tenant-uplink"
+		uplinkBridge  = "br-tenant"
+		uplinkMAC     = "0a:58:ac:14:00:05"
+		uplinkIP      = "172.20.0.5/24"
+		uplinkNextHop = "172.20.0.1"
+	)
+
+	var (
+		app     *cli.App
+		fakeOvn *FakeOVN
+	)
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+
+		app = cli.NewApp()
+		app.Name = "test"
+		app.Flags = config.Flags
+
+		fakeOvn = NewFakeOVN(false)
+
+		config.OVNKubernetesFeature = *minimalFeatureConfig()
+		config.OVNKubernetesFeature.EnableUplink = true
+		config.Gateway.Mode = config.GatewayModeShared
+		config.Gateway.V4MasqueradeSubnet = dummyMasqueradeSubnet().String()
+		config.Default.Zone = nodeName
+	})
+
+	AfterEach(func() {
+		fakeOvn.shutdown()
+	})
+
+	It("programs the gateway router of a node once the UplinkState turns ready", func() {
+		app.Action = func(*cli.Context) error {
+			netInfo := dummyPrimaryLayer3UserDefinedNetwork("192.168.0.0/16", "192.168.1.0/24")
+			netInfo.uplink = uplinkName
+			nad, err := newNetworkAttachmentDefinition(ns, nadName, *netInfo.netconf())
+			Expect(err).NotTo(HaveOccurred())
+			testNode, err := newNodeWithUserDefinedNetworks(nodeName, "192.168.126.202/24", netInfo)
+			Expect(err).NotTo(HaveOccurred())
+
+			state := &uplinkv1alpha1.UplinkState{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   util.GetUplinkStateName(uplinkName, nodeName),
+					Labels: util.GetUplinkStateLabels(uplinkName, nodeName),
+				},
+				Status: uplinkv1alpha1.UplinkStateStatus{
+					UplinkName:        uplinkName,
+					NodeName:          nodeName,
+					Type:              uplinkv1alpha1.UplinkTypeOVSBridge,
+					HostInterfaceName: "eth1",
+					Conditions: []metav1.Condition{
+						{
+							Type:               uplinkv1alpha1.UplinkStateReady,
+							Status:             metav1.ConditionFalse,
+							Reason:             uplinkv1alpha1.UplinkStateReasonBridgeNotFound,
+							LastTransitionTime: metav1.Now(),
+						},
+					},
+				},
+			}
+
+			fakeOvn.startWithDBSetup(
+				libovsdbtest.TestSetup{
+					NBData: []libovsdbtest.TestData{
+						&nbdb.LogicalSwitch{Name: nodeName},
+					},
+				},
+				&corev1.NamespaceList{
+					Items: []corev1.Namespace{*newUDNNamespace(ns)},
+				},
+				&corev1.NodeList{
+					Items: []corev1.Node{*testNode},
+				},
+				&nadapi.NetworkAttachmentDefinitionList{
+					Items: []nadapi.NetworkAttachmentDefinition{*nad},
+				},
+				&uplinkv1alpha1.UplinkStateList{
+					Items: []uplinkv1alpha1.UplinkState{*state},
+				},
+			)
+
+			// succeed the check for Load_Balancer_Group support
+			fexec := testing.NewFakeExec()
+			fexec.AddFakeCmdsNoOutputNoError([]string{"ovn-nbctl --timeout=15 --columns=_uuid list Load_Balancer_Group"})
+			Expect(util.SetExec(fexec)).To(Succeed())
+
+			udnController := fakeOvn.fullL3UDNControllers[userDefinedNetworkName]
+			Expect(udnController).NotTo(BeNil())
+			Expect(udnController.init()).To(Succeed())
+			udnController.ovnClusterLRPToJoinIfAddrs = dummyJoinIPs()
+			Expect(udnController.WatchUplinkState()).To(Succeed())
+			Expect(fakeOvn.registerUDNNodeHandler(userDefinedNetworkName)).To(Succeed())
+
+			gwRouterName := udnController.GetNetworkScopedGWRouterName(nodeName)
+			getGWRouter := func() error {
+				_, err := libovsdbops.GetLogicalRouter(fakeOvn.nbClient, &nbdb.LogicalRouter{Name: gwRouterName})
+				return err
+			}
+
+			By("not programming the gateway router while the uplink is not ready")
+			Eventually(func() bool {
+				_, failed := udnController.gatewaysFailed.Load(nodeName)
+				return failed
+			}).Should(BeTrue())
+			Consistently(getGWRouter).WithTimeout(time.Second).Should(MatchError(libovsdbclient.ErrNotFound))
+
+			By("programming the gateway router on the uplink bridge once the uplink is ready")
+			state.Status.OVSBridge = &uplinkv1alpha1.OVSBridgeState{Name: uplinkBridge}
+			state.Status.MACAddress = uplinkMAC
+			state.Status.IPAddresses = []string{uplinkIP}
+			state.Status.DefaultGateways = []string{uplinkNextHop}
+			state.Status.Conditions = []metav1.Condition{
+				{
+					Type:               uplinkv1alpha1.UplinkStateReady,
+					Status:             metav1.ConditionTrue,
+					Reason:             uplinkv1alpha1.UplinkStateReasonReady,
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+			_, err = fakeOvn.fakeClient.UplinkClient.K8sV1alpha1().UplinkStates().UpdateStatus(
+				context.Background(), state, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(getGWRouter).WithTimeout(5 * time.Second).Should(Succeed())
+			Eventually(func() error {
+				_, err := libovsdbops.GetLogicalSwitchPort(fakeOvn.nbClient, &nbdb.LogicalSwitchPort{
+					Name: udnController.GetNetworkScopedExtPortName(uplinkBridge, nodeName),
+				})
+				return err
+			}).Should(Succeed())
+			externalRouterPort, err := libovsdbops.GetLogicalRouterPort(fakeOvn.nbClient, &nbdb.LogicalRouterPort{
+				Name: types.GWRouterToExtSwitchPrefix + gwRouterName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(externalRouterPort.MAC).To(Equal(uplinkMAC))
+			Expect(externalRouterPort.Networks).To(ContainElement(uplinkIP))
+			Eventually(func() bool {
+				_, failed := udnController.gatewaysFailed.Load(nodeName)
+				return failed
+			}).Should(BeFalse())
+
+			return nil
+		}
+
+		Expect(app.Run([]string{app.Name})).To(Succeed())
+	})
+})
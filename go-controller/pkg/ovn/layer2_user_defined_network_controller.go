@@ -371,6 +371,11 @@ func (oc *Layer2UserDefinedNetworkController) run() error {
 			return err
 		}
 	}
+	if util.UsesUplink(oc.GetNetInfo()) {
+		if err := oc.WatchUplinkState(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -506,15 +511,50 @@ func (oc *Layer2UserDefinedNetworkController) Stop() {
 }
 
 func (oc *Layer2UserDefinedNetworkController) Reconcile(netInfo util.NetInfo) error {
+	subnetsChanged := clusterSubnetsChanged(oc, netInfo)
+	if subnetsChanged {
+		// subnets were appended to the network: let the switch allocate pod
+		// IPs from them before any pod is reconciled
+		if err := oc.updateLogicalSwitchSubnets(netInfo); err != nil {
+			return err
+		}
+	}
 	if err := oc.BaseNetworkController.reconcile(
 		netInfo,
 		func(node string) { oc.gatewaysFailed.Store(node, true) },
 	); err != nil {
 		return err
 	}
+	if subnetsChanged {
+		// gateway routers and management ports need to be configured on the
+		// appended subnets as well
+		oc.localZoneNodes.Range(func(key, _ any) bool {
+			nodeName := key.(string)
+			oc.gatewaysFailed.Store(nodeName, true)
+			oc.mgmtPortFailed.Store(nodeName, true)
+			oc.nodeReconciler.ReconcileNetwork(nodeName, oc.GetNetworkName())
+			return true
+		})
+	}
 	return oc.ReconcileServiceNetwork()
 }
 
+// updateLogicalSwitchSubnets updates the subnets the network switch manages
+// with the ones from the provided network information. Allocations on the
+// existing subnets are preserved.
+func (oc *Layer2UserDefinedNetworkController) updateLogicalSwitchSubnets(netInfo util.NetInfo) error {
+	hostSubnets := make([]*net.IPNet, 0, len(netInfo.Subnets()))
+	for _, subnet := range netInfo.Subnets() {
+		hostSubnets = append(hostSubnets, subnet.CIDR)
+	}
+	excludeSubnets := append(netInfo.ExcludeSubnets(), netInfo.InfrastructureSubnets()...)
+	switchName := oc.GetNetworkScopedSwitchName(types.OVNLayer2Switch)
+	if err := oc.lsManager.AddOrUpdateSwitch(switchName, hostSubnets, netInfo.ReservedSubnets(), excludeSubnets...); err != nil {
+		return fmt.Errorf("failed to update subnets of switch %s for network %s: %w", switchName, oc.GetNetworkName(), err)
+	}
+	return nil
+}
+
 func (oc *Layer2UserDefinedNetworkController) RegisterNodeHandler() error {
 	return oc.nodeReconciler.RegisterNetworkController(oc)
 }
@@ -715,7 +755,10 @@ func (oc *Layer2UserDefinedNetworkController) addUpdateLocalNodeEvent(node *core
 				return nil
 			}()
 
-			if err != nil {
+			if errors.Is(err, errUplinkNotReady) {
+				klog.Infof("Postponing the gateway sync of node %s for network %s: %v", node.Name, oc.GetNetworkName(), err)
+				oc.gatewaysFailed.Store(node.Name, true)
+			} else if err != nil {
 				errs = append(errs, err)
 				oc.gatewaysFailed.Store(node.Name, true)
 			}
@@ -1118,6 +1161,12 @@ func (oc *Layer2UserDefinedNetworkController) nodeGatewayConfig(node *corev1.Nod
 		return nil, fmt.Errorf("failed to get node %s network %s L3 gateway config: %v", node.Name, oc.GetNetworkName(), err)
 	}
 
+	if util.UsesUplink(oc.GetNetInfo()) {
+		if err := applyUplinkGatewayConfig(oc.watchFactory, oc.GetNetInfo(), node, l3GatewayConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	networkName := oc.GetNetworkName()
 	networkID := oc.GetNetworkID()
 
@@ -1617,3 +1666,9 @@ func (oc *Layer2UserDefinedNetworkController) HandleNetworkRefChange(nodeName st
 	}
 	oc.nodeReconciler.ReconcileNetwork(nodeName, oc.GetNetworkName())
 }
+
+// WatchUplinkState starts syncing the gateway of a node when the state of the
+// network Uplink changes on that node.
+func (oc *Layer2UserDefinedNetworkController) WatchUplinkState() error {
+	return oc.addUplinkStateHandler(func(nodeName string) { oc.gatewaysFailed.Store(nodeName, true) })
+}
@@ -5,6 +5,7 @@ package ovn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
@@ -377,6 +378,7 @@ func (oc *Layer3UserDefinedNetworkController) Stop() {
 	if oc.namespaceHandler != nil {
 		oc.watchFactory.RemoveNamespaceHandler(oc.namespaceHandler)
 	}
+	oc.removeUplinkStateHandler()
 	if oc.routeImportManager != nil {
 		oc.routeImportManager.ForgetNetwork(oc.GetNetworkName())
 	}
@@ -526,6 +528,7 @@ func (oc *Layer3UserDefinedNetworkController) run() error {
 	if err := oc.WatchPods(); err != nil {
 		return err
 	}
+	oc.runPodIPUsageMetricsUpdater()
 
 	if util.IsMultiNetworkPoliciesSupportEnabled() && !oc.IsPrimaryNetwork() {
 		// WatchMultiNetworkPolicy depends on WatchPods and WatchNamespaces
@@ -549,6 +552,12 @@ func (oc *Layer3UserDefinedNetworkController) run() error {
 		}
 	}
 
+	if util.UsesUplink(oc.GetNetInfo()) {
+		if err := oc.WatchUplinkState(); err != nil {
+			return err
+		}
+	}
+
 	// start NetworkQoS controller if feature is enabled
 	if config.OVNKubernetesFeature.EnableNetworkQoS {
 		err := oc.newNetworkQoSController()
@@ -861,7 +870,10 @@ func (oc *Layer3UserDefinedNetworkController) addUpdateLocalNodeEvent(node *core
 			oc.gatewayManagers.Store(node.Name, gwManager)
 
 			gwConfig, err := oc.nodeGatewayConfig(node)
-			if err != nil {
+			if errors.Is(err, errUplinkNotReady) {
+				klog.Infof("Postponing the gateway sync of node %s for network %s: %v", node.Name, oc.GetNetworkName(), err)
+				oc.gatewaysFailed.Store(node.Name, true)
+			} else if err != nil {
 				errs = append(errs, fmt.Errorf("failed to generate node GW configuration: %v", err))
 				oc.gatewaysFailed.Store(node.Name, true)
 			} else {
@@ -1147,6 +1159,12 @@ func (oc *Layer3UserDefinedNetworkController) nodeGatewayConfig(node *corev1.Nod
 		return nil, fmt.Errorf("failed to get node %s network %s L3 gateway config: %v", node.Name, oc.GetNetworkName(), err)
 	}
 
+	if util.UsesUplink(oc.GetNetInfo()) {
+		if err := applyUplinkGatewayConfig(oc.watchFactory, oc.GetNetInfo(), node, l3GatewayConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	networkName := oc.GetNetworkName()
 	networkID := oc.GetNetworkID()
 
@@ -1306,3 +1324,9 @@ func (oc *Layer3UserDefinedNetworkController) HandleNetworkRefChange(nodeName st
 	}
 	oc.nodeReconciler.ReconcileNetwork(nodeName, oc.GetNetworkName())
 }
+
+// WatchUplinkState starts syncing the gateway of a node when the state of the
+// network Uplink changes on that node.
+func (oc *Layer3UserDefinedNetworkController) WatchUplinkState() error {
+	return oc.addUplinkStateHandler(func(nodeName string) { oc.gatewaysFailed.Store(nodeName, true) })
+}
@@ -54,6 +54,7 @@ type userDefinedNetInfo struct {
 	hasEVPN            bool
 	transport          string // e.g., types.NetworkTransportNoOverlay
 	outboundSNAT       string // e.g., types.NoOverlaySNATEnabled
+	uplink             string
 }
 
 const (
@@ -1674,6 +1675,9 @@ func (sni *userDefinedNetInfo) netconf() *ovncnitypes.NetConf {
 	if sni.outboundSNAT != "" {
 		netconf.OutboundSNAT = sni.outboundSNAT
 	}
+	if sni.uplink != "" {
+		netconf.Uplink = sni.uplink
+	}
 
 	return netconf
 }
@@ -268,8 +268,11 @@ func (oc *LocalnetUserDefinedNetworkController) init() error {
 		Type:      "localnet",
 		Options:   oc.localnetPortNetworkNameOptions(),
 	}
+	// in trunk mode the VLAN ID is the native VLAN untagged traffic is
+	// assigned to: it is set by ovnkube-node on the localnet patch port as
+	// tagging on the localnet port would tag the trunk VLANs traffic too
 	intVlanID := int(oc.Vlan())
-	if intVlanID != 0 {
+	if intVlanID != 0 && len(oc.VLANTrunks()) == 0 {
 		logicalSwitchPort.TagRequest = &intVlanID
 	}
 
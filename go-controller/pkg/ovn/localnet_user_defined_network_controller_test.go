@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ovn
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/nbdb"
+	ovntest "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LocalnetUserDefinedNetworkController", func() {
+	const (
+		netName = "localnet1"
+		nadName = "localnet1-nad"
+		nadNS   = "ns1"
+	)
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+	})
+
+	type vlanTest struct {
+		vlanConf     string
+		expectedTag  *int
+		allowedVLANs []int
+		droppedVLANs []int
+	}
+
+	DescribeTable("should configure the localnet port VLAN", func(t vlanTest) {
+		nad := ovntest.GenerateNADWithConfig(nadName, nadNS, fmt.Sprintf(`
+{
+        "cniVersion": "1.1.0",
+        "name": %q,
+        "type": "ovn-k8s-cni-overlay",
+        "topology": "localnet",
+        "subnets": "192.168.100.0/24",
+        "physicalNetworkName": "physnet",
+        "netAttachDefName": "%s/%s"%s
+}
+`, netName, nadNS, nadName, t.vlanConf))
+
+		fakeOVN := NewFakeOVN(false)
+		fakeOVN.start()
+		DeferCleanup(fakeOVN.shutdown)
+		Expect(fakeOVN.NewUserDefinedNetworkController(nad)).To(Succeed())
+		oc, ok := fakeOVN.fullLocalnetUDNControllers[netName]
+		Expect(ok).To(BeTrue())
+		Expect(oc.init()).To(Succeed())
+
+		lsp, err := libovsdbops.GetLogicalSwitchPort(fakeOVN.nbClient,
+			&nbdb.LogicalSwitchPort{Name: oc.GetNetworkScopedName(types.OVNLocalnetPort)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lsp.Type).To(Equal("localnet"))
+		Expect(lsp.Options).To(Equal(map[string]string{"network_name": "physnet"}))
+		Expect(lsp.TagRequest).To(Equal(t.expectedTag))
+
+		ls, err := libovsdbops.GetLogicalSwitch(fakeOVN.nbClient,
+			&nbdb.LogicalSwitch{Name: oc.GetNetworkScopedSwitchName(types.OVNLocalnetSwitch)})
+		Expect(err).NotTo(HaveOccurred())
+		acls, err := libovsdbops.FindACLsWithPredicate(fakeOVN.nbClient, func(acl *nbdb.ACL) bool {
+			return slices.Contains(ls.ACLs, acl.UUID)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		if len(t.allowedVLANs) == 0 {
+			Expect(ls.OtherConfig).NotTo(HaveKey("vlan-passthru"))
+			Expect(acls).To(BeEmpty())
+			return
+		}
+		// tagged traffic goes through the switch but for the VLANs that are
+		// not allowed, which is dropped in both directions
+		Expect(ls.OtherConfig).To(HaveKeyWithValue("vlan-passthru", "true"))
+		Expect(acls).To(HaveLen(2))
+		Expect([]string{acls[0].Direction, acls[1].Direction}).To(ConsistOf(nbdb.ACLDirectionFromLport, nbdb.ACLDirectionToLport))
+		for _, vid := range t.allowedVLANs {
+			Expect(isTaggedTrafficDropped(acls, vid)).To(BeFalse(), "VLAN %d should be allowed", vid)
+		}
+		for _, vid := range t.droppedVLANs {
+			Expect(isTaggedTrafficDropped(acls, vid)).To(BeTrue(), "VLAN %d should be dropped", vid)
+		}
+	},
+		Entry("without VLAN", vlanTest{}),
+		Entry("in access mode", vlanTest{
+			vlanConf:    `, "vlanID": 10`,
+			expectedTag: ptr.To(10),
+		}),
+		Entry("in trunk mode", vlanTest{
+			vlanConf:     `, "vlanTrunks": [100, 200, 300]`,
+			allowedVLANs: []int{100, 200, 300},
+			droppedVLANs: []int{1, 10, 101, 400, 4094},
+		}),
+		Entry("in trunk mode with a native VLAN, without tagging the localnet port", vlanTest{
+			vlanConf:     `, "vlanID": 10, "vlanTrunks": [100, 200]`,
+			allowedVLANs: []int{100, 200},
+			droppedVLANs: []int{10, 300},
+		}),
+	)
+})
+
+var trunkVLANDropMatch = regexp.MustCompile(`^vlan\.present && vlan\.vid != \{([0-9, ]+)\}$`)
+
+// isTaggedTrafficDropped returns whether the given ACLs drop the traffic tagged
+// with the given VLAN ID in both directions.
+func isTaggedTrafficDropped(acls []*nbdb.ACL, vid int) bool {
+	dropped := map[string]bool{}
+	for _, acl := range acls {
+		if acl.Action != nbdb.ACLActionDrop || acl.Tier != types.PrimaryACLTier {
+			continue
+		}
+		m := trunkVLANDropMatch.FindStringSubmatch(acl.Match)
+		if m == nil {
+			continue
+		}
+		allowed := false
+		for _, allowedVID := range strings.Split(m[1], ", ") {
+			if allowedVID == strconv.Itoa(vid) {
+				allowed = true
+			}
+		}
+		if !allowed {
+			dropped[acl.Direction] = true
+		}
+	}
+	return dropped[nbdb.ACLDirectionFromLport] && dropped[nbdb.ACLDirectionToLport]
+}
@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"net"
 
-	knet "k8s.io/utils/net"
-
 	ipam "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/allocator/ip"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
@@ -22,6 +20,9 @@ type LogicalSwitchManager struct {
 	gatewayIPs []*net.IPNet
 	mgmtIPs    []*net.IPNet
 	reserveIPs bool
+	// allocatePerIPFamily allocates a single IP per IP family rather than per
+	// subnet of the switch
+	allocatePerIPFamily bool
 }
 
 // NewLogicalSwitchManager initializes a new logical switch manager for L3
@@ -51,10 +52,13 @@ func NewL2SwitchManager() *LogicalSwitchManager {
 // switch manager for L2 primary networks.
 // A user defined primary network auto-reserves the gateway and the node management IP addresses,
 // which are required for egressing the cluster over this user defined network.
+// Pods get a single IP per IP family, which allows subnets to be appended to
+// the network.
 func NewL2SwitchManagerForUserDefinedPrimaryNetwork(gatewayIPs, mgmtIPs []*net.IPNet) *LogicalSwitchManager {
 	lsm := NewLogicalSwitchManager()
 	lsm.gatewayIPs = gatewayIPs
 	lsm.mgmtIPs = mgmtIPs
+	lsm.allocatePerIPFamily = true
 	return lsm
 }
 
@@ -63,12 +67,12 @@ func NewL2SwitchManagerForUserDefinedPrimaryNetwork(gatewayIPs, mgmtIPs []*net.I
 func (manager *LogicalSwitchManager) AddOrUpdateSwitch(switchName string, hostSubnets []*net.IPNet, reservedSubnets []*net.IPNet, excludeSubnets ...*net.IPNet) error {
 	if manager.reserveIPs {
 		for _, hostSubnet := range hostSubnets {
-			gwIP, _ := util.MatchFirstIPNetFamily(knet.IsIPv6CIDR(hostSubnet), manager.gatewayIPs)
+			gwIP := matchFirstIPNetInSubnet(hostSubnet, manager.gatewayIPs)
 			if gwIP == nil {
 				gwIP = util.GetNodeGatewayIfAddr(hostSubnet)
 			}
 
-			mgmtIP, _ := util.MatchFirstIPNetFamily(knet.IsIPv6CIDR(hostSubnet), manager.mgmtIPs)
+			mgmtIP := matchFirstIPNetInSubnet(hostSubnet, manager.mgmtIPs)
 			if mgmtIP == nil {
 				mgmtIP = util.GetNodeManagementIfAddr(hostSubnet)
 			}
@@ -82,13 +86,25 @@ func (manager *LogicalSwitchManager) AddOrUpdateSwitch(switchName string, hostSu
 		}
 	}
 	return manager.allocator.AddOrUpdateSubnet(subnet.SubnetConfig{
-		Name:            switchName,
-		Subnets:         hostSubnets,
-		ReservedSubnets: reservedSubnets,
-		ExcludeSubnets:  excludeSubnets,
+		Name:                switchName,
+		Subnets:             hostSubnets,
+		ReservedSubnets:     reservedSubnets,
+		ExcludeSubnets:      excludeSubnets,
+		AllocatePerIPFamily: manager.allocatePerIPFamily,
 	})
 }
 
+// matchFirstIPNetInSubnet returns the first of the provided IPs that is
+// contained in subnet, if any.
+func matchFirstIPNetInSubnet(subnet *net.IPNet, ipnets []*net.IPNet) *net.IPNet {
+	for _, ipnet := range ipnets {
+		if subnet.Contains(ipnet.IP) {
+			return ipnet
+		}
+	}
+	return nil
+}
+
 // AddNoHostSubnetSwitch adds/updates a switch without any host subnets
 // to the logical switch manager
 func (manager *LogicalSwitchManager) AddNoHostSubnetSwitch(switchName string) error {
@@ -115,6 +131,12 @@ func (manager *LogicalSwitchManager) GetSwitchSubnets(switchName string) []*net.
 	return subnets
 }
 
+// GetSwitchSubnetsUsage returns the IP usage of each of the host subnets of
+// the switch
+func (manager *LogicalSwitchManager) GetSwitchSubnetsUsage(switchName string) ([]subnet.SubnetUsage, error) {
+	return manager.allocator.GetSubnetsUsage(switchName)
+}
+
 // AllocateUntilFull used for unit testing only, allocates the rest of the switch subnet
 func (manager *LogicalSwitchManager) AllocateUntilFull(switchName string) error {
 	return manager.allocator.AllocateUntilFull(switchName)
@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	ref "k8s.io/client-go/tools/reference"
 	"k8s.io/klog/v2"
+	cnpinformer "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha2"
 
 	libovsdbclient "github.com/ovn-kubernetes/libovsdb/client"
 
@@ -401,12 +402,17 @@ func (oc *DefaultNetworkController) InitEgressServiceZoneController() (*egresssv
 
 func (oc *DefaultNetworkController) newANPController() error {
 	var err error
+	var cnpInformer cnpinformer.ClusterNetworkPolicyInformer
+	if config.OVNKubernetesFeature.EnableClusterNetworkPolicy {
+		cnpInformer = oc.watchFactory.CNPInformer()
+	}
 	oc.anpController, err = anpcontroller.NewController(
 		ovntypes.DefaultNetworkControllerName,
 		oc.nbClient,
 		oc.kube.ANPClient,
 		oc.watchFactory.ANPInformer(),
 		oc.watchFactory.BANPInformer(),
+		cnpInformer,
 		oc.watchFactory.NamespaceCoreInformer(),
 		oc.watchFactory.PodCoreInformer(),
 		oc.watchFactory.NodeCoreInformer(),
@@ -423,6 +429,7 @@ func (oc *DefaultNetworkController) newNetworkConnectController() error {
 	oc.networkConnectController = networkconnectcontroller.NewController(
 		oc.zone,
 		oc.nbClient,
+		oc.kube.NetworkConnectClient,
 		oc.watchFactory,
 		oc.networkManager,
 	)
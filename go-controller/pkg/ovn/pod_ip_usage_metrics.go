@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ovn
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/metrics"
+)
+
+// podIPUsageMetricsPeriod is the period at which the pod IP usage of the node
+// switches is recorded
+const podIPUsageMetricsPeriod = 30 * time.Second
+
+// podIPUsage holds the state of the pod IP usage reported for the network
+type podIPUsage struct {
+	// recordedNodes are the nodes whose usage was recorded
+	recordedNodes sets.Set[string]
+	// subnetsAboveThreshold are the node host subnets, as node/subnet, whose
+	// utilization was above the configured threshold
+	subnetsAboveThreshold sets.Set[string]
+}
+
+// runPodIPUsageMetricsUpdater periodically records the pod IP usage of the
+// host subnets of the local zone nodes of the network, until the controller is
+// stopped. Only meant for networks with a switch per node.
+func (bnc *BaseNetworkController) runPodIPUsageMetricsUpdater() {
+	stopChan := bnc.stopChan
+	bnc.wg.Add(1)
+	go func() {
+		defer bnc.wg.Done()
+		ticker := time.NewTicker(podIPUsageMetricsPeriod)
+		defer ticker.Stop()
+		usage := podIPUsage{
+			recordedNodes:         sets.New[string](),
+			subnetsAboveThreshold: sets.New[string](),
+		}
+		for {
+			select {
+			case <-ticker.C:
+				usage = bnc.recordPodIPUsage(usage)
+			case <-stopChan:
+				metrics.DeleteNodePodIPUsage(bnc.GetNetworkName(), "")
+				return
+			}
+		}
+	}()
+}
+
+// recordPodIPUsage records the pod IP usage of the host subnets of the local
+// zone nodes and removes the usage of the nodes previously recorded that are
+// gone. A warning event is posted on the node every time one of its host
+// subnets crosses the configured utilization threshold. It returns the updated
+// usage state.
+func (bnc *BaseNetworkController) recordPodIPUsage(previous podIPUsage) podIPUsage {
+	networkName := bnc.GetNetworkName()
+	nodeNames, err := bnc.getLocalZoneNodeNames()
+	if err != nil {
+		klog.Warningf("Failed to get local zone nodes to record pod IP usage for network %s: %v", networkName, err)
+		return previous
+	}
+
+	threshold := config.Metrics.IPPoolUtilizationThreshold
+	current := podIPUsage{
+		recordedNodes:         sets.New[string](),
+		subnetsAboveThreshold: sets.New[string](),
+	}
+	for _, nodeName := range nodeNames {
+		usage, err := bnc.lsManager.GetSwitchSubnetsUsage(bnc.GetNetworkScopedSwitchName(nodeName))
+		if err != nil {
+			// the node switch is not created yet
+			continue
+		}
+		for _, subnetUsage := range usage {
+			hostSubnet := subnetUsage.Subnet.String()
+			used, capacity := uint64(subnetUsage.Used), uint64(subnetUsage.Capacity)
+			metrics.RecordNodePodIPUsage(networkName, nodeName, hostSubnet, float64(used), float64(capacity))
+			if threshold == 0 || capacity == 0 || used*100 < uint64(threshold)*capacity {
+				continue
+			}
+			key := nodeName + "/" + hostSubnet
+			current.subnetsAboveThreshold.Insert(key)
+			if previous.subnetsAboveThreshold.Has(key) {
+				continue
+			}
+			nodeRef := corev1.ObjectReference{
+				Kind: "Node",
+				Name: nodeName,
+			}
+			bnc.recorder.Eventf(&nodeRef, corev1.EventTypeWarning, "IPPoolUtilizationHigh",
+				"Utilization of network %s host subnet %s is %d%% (%d/%d), above the %d%% threshold",
+				networkName, hostSubnet, used*100/capacity, used, capacity, threshold)
+		}
+		current.recordedNodes.Insert(nodeName)
+	}
+	for nodeName := range previous.recordedNodes.Difference(current.recordedNodes) {
+		metrics.DeleteNodePodIPUsage(networkName, nodeName)
+	}
+	return current
+}
+
+// getLocalZoneNodeNames returns the names of the nodes that belong to the
+// local zone, or of all the nodes if the controller doesn't track them.
+func (bnc *BaseNetworkController) getLocalZoneNodeNames() ([]string, error) {
+	var nodeNames []string
+	if bnc.localZoneNodes != nil {
+		bnc.localZoneNodes.Range(func(key, _ any) bool {
+			nodeNames = append(nodeNames, key.(string))
+			return true
+		})
+		return nodeNames, nil
+	}
+	nodes, err := bnc.watchFactory.GetNodes()
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		nodeNames = append(nodeNames, node.Name)
+	}
+	return nodeNames, nil
+}
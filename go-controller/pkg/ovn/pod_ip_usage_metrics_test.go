@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ovn
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
+	lsm "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/ovn/logical_switch_manager"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func TestRecordPodIPUsageThreshold(t *testing.T) {
+	if err := config.PrepareTestConfig(); err != nil {
+		t.Fatal(err)
+	}
+	config.Metrics.IPPoolUtilizationThreshold = 50
+
+	const nodeName = "node1"
+	recorder := record.NewFakeRecorder(10)
+	bnc := &BaseNetworkController{
+		CommonNetworkControllerInfo: CommonNetworkControllerInfo{recorder: recorder},
+		ReconcilableNetInfo:         &util.DefaultNetInfo{},
+		lsManager:                   lsm.NewLogicalSwitchManager(),
+		localZoneNodes:              &sync.Map{},
+	}
+	bnc.localZoneNodes.Store(nodeName, true)
+
+	// 6 usable addresses, the gateway and management port ones already used
+	_, hostSubnet, _ := net.ParseCIDR("10.128.0.0/29")
+	switchName := bnc.GetNetworkScopedSwitchName(nodeName)
+	if err := bnc.lsManager.AddOrUpdateSwitch(switchName, []*net.IPNet{hostSubnet}, nil); err != nil {
+		t.Fatal(err)
+	}
+	allocate := func(ip string) {
+		t.Helper()
+		if err := bnc.lsManager.AllocateIPs(switchName, []*net.IPNet{{IP: net.ParseIP(ip), Mask: hostSubnet.Mask}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expectEvents := func(count int) {
+		t.Helper()
+		for i := 0; i < count; i++ {
+			select {
+			case event := <-recorder.Events:
+				if !strings.Contains(event, "IPPoolUtilizationHigh") || !strings.Contains(event, hostSubnet.String()) {
+					t.Fatalf("unexpected event: %s", event)
+				}
+			default:
+				t.Fatalf("expected %d events, got %d", count, i)
+			}
+		}
+		select {
+		case event := <-recorder.Events:
+			t.Fatalf("unexpected event: %s", event)
+		default:
+		}
+	}
+
+	usage := podIPUsage{recordedNodes: sets.New[string](), subnetsAboveThreshold: sets.New[string]()}
+	usage = bnc.recordPodIPUsage(usage)
+	expectEvents(0)
+	if !usage.recordedNodes.Has(nodeName) || usage.subnetsAboveThreshold.Len() != 0 {
+		t.Fatalf("unexpected usage below the threshold: %+v", usage)
+	}
+
+	allocate("10.128.0.3")
+	usage = bnc.recordPodIPUsage(usage)
+	expectEvents(1)
+	if !usage.subnetsAboveThreshold.Has(nodeName + "/" + hostSubnet.String()) {
+		t.Fatalf("expected host subnet above the threshold: %+v", usage)
+	}
+
+	// the event is only posted when the threshold is crossed
+	usage = bnc.recordPodIPUsage(usage)
+	expectEvents(0)
+
+	bnc.lsManager.ReleaseIPs(switchName, []*net.IPNet{{IP: net.ParseIP("10.128.0.3"), Mask: hostSubnet.Mask}})
+	usage = bnc.recordPodIPUsage(usage)
+	expectEvents(0)
+	if usage.subnetsAboveThreshold.Len() != 0 {
+		t.Fatalf("expected no host subnet above the threshold: %+v", usage)
+	}
+
+	allocate("10.128.0.3")
+	bnc.recordPodIPUsage(usage)
+	expectEvents(1)
+}
@@ -7,14 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sync"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
 	ipamclaimslister "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1/apis/listers/ipamclaims/v1alpha1"
+	nettypes "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	appslisters "k8s.io/client-go/listers/apps/v1"
 	"k8s.io/klog/v2"
 
 	ipam "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/allocator/ip"
@@ -43,6 +48,10 @@ type PersistentAllocations interface {
 	Reconcile(oldIPAMClaim *ipamclaimsapi.IPAMClaim, newIPAMClaim *ipamclaimsapi.IPAMClaim, ipReleaser IPReleaser) error
 
 	UpdateIPAMClaimStatus(ipamClaim *ipamclaimsapi.IPAMClaim, podAnnotation *util.PodAnnotation, podName string, allocationErr error) *ipamclaimsapi.IPAMClaim
+
+	EnsureStatefulSetIPAMClaim(pod *corev1.Pod, network *nettypes.NetworkSelectionElement) error
+
+	ReleaseStatefulSetIPAMClaim(namespace, claimName string) error
 }
 
 // IPAMClaimReconciler acts on IPAMClaim events handed off by the cluster network
@@ -57,14 +66,24 @@ type IPAMClaimReconciler struct {
 	netInfo util.NetInfo
 
 	lister ipamclaimslister.IPAMClaimLister
+
+	// statefulSetLister is used to find out whether the IPAMClaims derived for
+	// StatefulSet pods can be released
+	statefulSetLister appslisters.StatefulSetLister
+
+	// createdIPAMClaims holds the IPAMClaims created for StatefulSet pods,
+	// keyed by namespace/name, until the lister has them
+	createdIPAMClaims sync.Map
 }
 
 // NewIPAMClaimReconciler builds a new PersistentIPsAllocator
-func NewIPAMClaimReconciler(kube kube.InterfaceOVN, netConfig util.NetInfo, lister ipamclaimslister.IPAMClaimLister) *IPAMClaimReconciler {
+func NewIPAMClaimReconciler(kube kube.InterfaceOVN, netConfig util.NetInfo, lister ipamclaimslister.IPAMClaimLister,
+	statefulSetLister appslisters.StatefulSetLister) *IPAMClaimReconciler {
 	pipsAllocator := &IPAMClaimReconciler{
-		kube:    kube,
-		netInfo: netConfig,
-		lister:  lister,
+		kube:              kube,
+		netInfo:           netConfig,
+		lister:            lister,
+		statefulSetLister: statefulSetLister,
 	}
 	return pipsAllocator
 }
@@ -108,6 +127,10 @@ func (icr *IPAMClaimReconciler) Reconcile(
 				err,
 			)
 		}
+		key := newIPAMClaim.Namespace + "/" + newIPAMClaim.Name
+		if _, ok := icr.createdIPAMClaims.Load(key); ok {
+			icr.createdIPAMClaims.Store(key, newIPAMClaim)
+		}
 		return nil
 	}
 
@@ -148,9 +171,15 @@ func (icr *IPAMClaimReconciler) FindIPAMClaim(claimName string, namespace string
 		return nil, ErrPersistentIPsNotAvailableOnNetwork
 	}
 	claim, err := icr.lister.IPAMClaims(namespace).Get(claimName)
+	if apierrors.IsNotFound(err) {
+		if created, ok := icr.createdIPAMClaims.Load(namespace + "/" + claimName); ok {
+			return created.(*ipamclaimsapi.IPAMClaim), nil
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get IPAMClaim %q: %w", claimName, err)
 	}
+	icr.createdIPAMClaims.Delete(namespace + "/" + claimName)
 	return claim, nil
 }
 
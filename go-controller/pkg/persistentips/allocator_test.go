@@ -72,7 +72,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 			ipAllocator := subnet.NewAllocator()
 			Expect(ipAllocator.AddOrUpdateSubnet(subnet.SubnetConfig{Name: subnetName, Subnets: ovntest.MustParseIPNets("192.168.200.0/24", "fd10::/64")})).To(Succeed())
 			namedAllocator = ipAllocator.ForSubnet(subnetName)
-			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil)
+			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil, nil)
 			Expect(ipAllocator.AddOrUpdateSubnet(subnet.SubnetConfig{Name: subnetName, Subnets: ovntest.MustParseIPNets("192.168.200.0/24", "fd10::/64")})).To(Succeed())
 		})
 
@@ -145,7 +145,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 			}
 			Expect(ipAllocator.AddOrUpdateSubnet(subnet.SubnetConfig{Name: subnetName, Subnets: ovntest.MustParseIPNets("192.168.200.0/24", "fd10::/64")})).To(Succeed())
 			namedAllocator = ipAllocator.ForSubnet(subnetName)
-			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil)
+			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil, nil)
 		})
 
 		It("the IPAMClaim is *not* updated", func() {
@@ -176,7 +176,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 			netInfo, err := util.NewNetInfo(dummyNetconf(networkName))
 			Expect(err).NotTo(HaveOccurred())
 
-			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil)
+			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil, nil)
 		})
 
 		It("successfully handles being requested the same IPs again", func() {
@@ -253,7 +253,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 				netInfo, err := util.NewNetInfo(netConf)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(
-					NewIPAMClaimReconciler(nil, netInfo, lister).FindIPAMClaim(
+					NewIPAMClaimReconciler(nil, netInfo, lister, nil).FindIPAMClaim(
 						network.IPAMClaimReference,
 						network.Namespace,
 					),
@@ -292,7 +292,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 
 				netInfo, err := util.NewNetInfo(netConf)
 				Expect(err).NotTo(HaveOccurred())
-				_, actualError := NewIPAMClaimReconciler(nil, netInfo, lister).FindIPAMClaim(
+				_, actualError := NewIPAMClaimReconciler(nil, netInfo, lister, nil).FindIPAMClaim(
 					network.IPAMClaimReference,
 					network.Namespace,
 				)
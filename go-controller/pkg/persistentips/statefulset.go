@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentips
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
+	nettypes "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	ovnktypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+)
+
+const (
+	// StatefulSetLabel is set on the IPAMClaims derived for StatefulSet pods
+	// with the name of the StatefulSet.
+	StatefulSetLabel = ovnktypes.OvnK8sPrefix + "/statefulset"
+	// StatefulSetOrdinalLabel is set on the IPAMClaims derived for StatefulSet
+	// pods with the ordinal of the pod.
+	StatefulSetOrdinalLabel = ovnktypes.OvnK8sPrefix + "/statefulset-ordinal"
+)
+
+// StatefulSetIPAMClaimName returns the name of the IPAMClaim derived for a
+// StatefulSet pod attached to the network with the given NAD name.
+func StatefulSetIPAMClaimName(podName, nadName string) string {
+	return podName + "." + nadName
+}
+
+// SetStatefulSetIPAMClaimReference makes the network selection element of a
+// pod owned by a StatefulSet reference the IPAMClaim derived for the pod, so
+// that the pod keeps its IPs across restarts and reschedules. Returns true if
+// the reference was set. Network selection elements already referencing an
+// IPAMClaim, or referencing a NAD of another namespace, are left untouched.
+func SetStatefulSetIPAMClaimReference(pod *corev1.Pod, network *nettypes.NetworkSelectionElement) bool {
+	if network == nil || network.IPAMClaimReference != "" || network.Namespace != pod.Namespace {
+		return false
+	}
+	if _, _, ok := statefulSetPodOrdinal(pod); !ok {
+		return false
+	}
+	network.IPAMClaimReference = StatefulSetIPAMClaimName(pod.Name, network.Name)
+	return true
+}
+
+// statefulSetPodOrdinal returns the name of the StatefulSet owning the pod and
+// the ordinal of the pod.
+func statefulSetPodOrdinal(pod *corev1.Pod) (string, int, bool) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "StatefulSet" || !strings.HasPrefix(owner.APIVersion, appsv1.GroupName+"/") {
+		return "", 0, false
+	}
+	// StatefulSet pods are named <statefulset name>-<ordinal>
+	suffix, found := strings.CutPrefix(pod.Name, owner.Name+"-")
+	if !found {
+		return "", 0, false
+	}
+	ordinal, err := strconv.Atoi(suffix)
+	if err != nil || ordinal < 0 {
+		return "", 0, false
+	}
+	return owner.Name, ordinal, true
+}
+
+// statefulSetIPAMClaimOrdinal returns the name of the StatefulSet and the pod
+// ordinal an IPAMClaim was derived for.
+func statefulSetIPAMClaimOrdinal(ipamClaim *ipamclaimsapi.IPAMClaim) (string, int, bool) {
+	statefulSet, ok := ipamClaim.Labels[StatefulSetLabel]
+	if !ok || statefulSet == "" {
+		return "", 0, false
+	}
+	ordinal, err := strconv.Atoi(ipamClaim.Labels[StatefulSetOrdinalLabel])
+	if err != nil || ordinal < 0 {
+		return "", 0, false
+	}
+	return statefulSet, ordinal, true
+}
+
+// StatefulSetIPAMClaimPodName returns the name of the pod an IPAMClaim was
+// derived for, and false if the IPAMClaim was not derived for a StatefulSet
+// pod.
+func StatefulSetIPAMClaimPodName(ipamClaim *ipamclaimsapi.IPAMClaim) (string, bool) {
+	statefulSet, ordinal, ok := statefulSetIPAMClaimOrdinal(ipamClaim)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("%s-%d", statefulSet, ordinal), true
+}
+
+// EnsureStatefulSetIPAMClaim creates the IPAMClaim referenced by the network
+// selection element of a StatefulSet pod if it does not exist yet. The created
+// IPAMClaim is found by FindIPAMClaim until the lister has it, so that the pod
+// allocation right after gets its IPs.
+func (icr *IPAMClaimReconciler) EnsureStatefulSetIPAMClaim(pod *corev1.Pod, network *nettypes.NetworkSelectionElement) error {
+	statefulSet, ordinal, ok := statefulSetPodOrdinal(pod)
+	if !ok || network == nil || network.IPAMClaimReference == "" {
+		return nil
+	}
+	if icr.lister == nil {
+		return ErrPersistentIPsNotAvailableOnNetwork
+	}
+	_, err := icr.lister.IPAMClaims(pod.Namespace).Get(network.IPAMClaimReference)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get IPAMClaim %s/%s: %w", pod.Namespace, network.IPAMClaimReference, err)
+	}
+
+	ipamClaim := &ipamclaimsapi.IPAMClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      network.IPAMClaimReference,
+			Namespace: pod.Namespace,
+			Labels: map[string]string{
+				StatefulSetLabel:        statefulSet,
+				StatefulSetOrdinalLabel: strconv.Itoa(ordinal),
+			},
+		},
+		Spec: ipamclaimsapi.IPAMClaimSpec{
+			Network:   icr.netInfo.GetNetworkName(),
+			Interface: network.InterfaceRequest,
+		},
+	}
+	created, err := icr.kube.CreateIPAMClaim(ipamClaim)
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create IPAMClaim %s/%s: %w", pod.Namespace, ipamClaim.Name, err)
+	}
+	icr.createdIPAMClaims.Store(created.Namespace+"/"+created.Name, created)
+	klog.Infof("Created IPAMClaim %s/%s for pod %s/%s of StatefulSet %s on network %q",
+		pod.Namespace, ipamClaim.Name, pod.Namespace, pod.Name, statefulSet, icr.netInfo.GetNetworkName())
+	return nil
+}
+
+// ReleaseStatefulSetIPAMClaim deletes an IPAMClaim derived for a StatefulSet
+// pod once the StatefulSet no longer has the pod ordinal, i.e. it was scaled
+// down or deleted. The IPs of the IPAMClaim are released when its deletion is
+// reconciled. It must only be called once the pod is gone.
+func (icr *IPAMClaimReconciler) ReleaseStatefulSetIPAMClaim(namespace, claimName string) error {
+	if icr.lister == nil || icr.statefulSetLister == nil || claimName == "" {
+		return nil
+	}
+	icr.createdIPAMClaims.Delete(namespace + "/" + claimName)
+	ipamClaim, err := icr.lister.IPAMClaims(namespace).Get(claimName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get IPAMClaim %s/%s: %w", namespace, claimName, err)
+	}
+	if ipamClaim.Spec.Network != icr.netInfo.GetNetworkName() {
+		return nil
+	}
+	statefulSet, ordinal, ok := statefulSetIPAMClaimOrdinal(ipamClaim)
+	if !ok {
+		return nil
+	}
+	hasOrdinal, err := icr.statefulSetHasOrdinal(namespace, statefulSet, ordinal)
+	if err != nil || hasOrdinal {
+		return err
+	}
+	if err := icr.kube.DeleteIPAMClaim(namespace, claimName); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete IPAMClaim %s/%s: %w", namespace, claimName, err)
+	}
+	klog.Infof("Deleted IPAMClaim %s/%s: StatefulSet %s no longer has ordinal %d", namespace, claimName, statefulSet, ordinal)
+	return nil
+}
+
+// statefulSetHasOrdinal returns whether the StatefulSet exists and the given
+// ordinal is in its range of ordinals.
+func (icr *IPAMClaimReconciler) statefulSetHasOrdinal(namespace, name string, ordinal int) (bool, error) {
+	statefulSet, err := icr.statefulSetLister.StatefulSets(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get StatefulSet %s/%s: %w", namespace, name, err)
+	}
+	if statefulSet.DeletionTimestamp != nil {
+		return false, nil
+	}
+	replicas := 1
+	if statefulSet.Spec.Replicas != nil {
+		replicas = int(*statefulSet.Spec.Replicas)
+	}
+	start := 0
+	if statefulSet.Spec.Ordinals != nil {
+		start = int(statefulSet.Spec.Ordinals.Start)
+	}
+	return ordinal >= start && ordinal < start+replicas, nil
+}
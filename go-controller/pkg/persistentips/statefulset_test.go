@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentips
+
+import (
+	"context"
+
+	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
+	fakeipamclaimclient "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1/apis/clientset/versioned/fake"
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	ovnkclient "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Persistent IPs of StatefulSet pods", func() {
+	const (
+		namespace   = "ns1"
+		networkName = "justanetwork"
+		nadName     = "tenant"
+		statefulSet = "web"
+	)
+
+	DescribeTable(
+		"setting the IPAMClaim reference",
+		func(pod *corev1.Pod, network *nadapi.NetworkSelectionElement, expectedSet bool, expectedReference string) {
+			Expect(SetStatefulSetIPAMClaimReference(pod, network)).To(Equal(expectedSet))
+			if network != nil {
+				Expect(network.IPAMClaimReference).To(Equal(expectedReference))
+			}
+		},
+		Entry(
+			"derives the IPAMClaim of a StatefulSet pod",
+			statefulSetPod(namespace, statefulSet, "web-1"),
+			&nadapi.NetworkSelectionElement{Name: nadName, Namespace: namespace},
+			true,
+			"web-1.tenant",
+		),
+		Entry(
+			"keeps the IPAMClaim requested by the pod",
+			statefulSetPod(namespace, statefulSet, "web-1"),
+			&nadapi.NetworkSelectionElement{Name: nadName, Namespace: namespace, IPAMClaimReference: "claim1"},
+			false,
+			"claim1",
+		),
+		Entry(
+			"ignores NADs of other namespaces",
+			statefulSetPod(namespace, statefulSet, "web-1"),
+			&nadapi.NetworkSelectionElement{Name: nadName, Namespace: "ns2"},
+			false,
+			"",
+		),
+		Entry(
+			"ignores pods not owned by a StatefulSet",
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: namespace}},
+			&nadapi.NetworkSelectionElement{Name: nadName, Namespace: namespace},
+			false,
+			"",
+		),
+		Entry(
+			"ignores pods without an ordinal",
+			statefulSetPod(namespace, statefulSet, "web-abc"),
+			&nadapi.NetworkSelectionElement{Name: nadName, Namespace: namespace},
+			false,
+			"",
+		),
+		Entry(
+			"ignores pods without network selection element",
+			statefulSetPod(namespace, statefulSet, "web-1"),
+			nil,
+			false,
+			"",
+		),
+	)
+
+	It("creates the IPAMClaim of a StatefulSet pod", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		lister, listerTeardown := generateIPAMClaimsListerAndTeardownFunc(ctx.Done())
+		defer func() {
+			cancel()
+			listerTeardown()
+		}()
+
+		netInfo, err := util.NewNetInfo(dummyNetconf(networkName))
+		Expect(err).NotTo(HaveOccurred())
+		ipamClaimsClient := fakeipamclaimclient.NewSimpleClientset()
+		kube := &ovnkclient.KubeOVN{IPAMClaimsClient: ipamClaimsClient}
+
+		pod := statefulSetPod(namespace, statefulSet, "web-2")
+		network := &nadapi.NetworkSelectionElement{Name: nadName, Namespace: namespace, InterfaceRequest: "net1"}
+		Expect(SetStatefulSetIPAMClaimReference(pod, network)).To(BeTrue())
+		reconciler := NewIPAMClaimReconciler(kube, netInfo, lister, newStatefulSetLister())
+		Expect(reconciler.EnsureStatefulSetIPAMClaim(pod, network)).To(Succeed())
+
+		ipamClaim, err := ipamClaimsClient.K8sV1alpha1().IPAMClaims(namespace).Get(context.Background(), "web-2.tenant", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipamClaim.Labels).To(Equal(map[string]string{
+			StatefulSetLabel:        statefulSet,
+			StatefulSetOrdinalLabel: "2",
+		}))
+		Expect(ipamClaim.Spec).To(Equal(ipamclaimsapi.IPAMClaimSpec{Network: networkName, Interface: "net1"}))
+		podName, ok := StatefulSetIPAMClaimPodName(ipamClaim)
+		Expect(ok).To(BeTrue())
+		Expect(podName).To(Equal(pod.Name))
+
+		By("finding the IPAMClaim before the lister has it")
+		found, err := reconciler.FindIPAMClaim("web-2.tenant", namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(Equal(ipamClaim))
+	})
+
+	DescribeTable(
+		"releasing the IPAMClaim of a StatefulSet pod",
+		func(ipamClaim *ipamclaimsapi.IPAMClaim, statefulSetObj *appsv1.StatefulSet, expectDeleted bool) {
+			ctx, cancel := context.WithCancel(context.Background())
+			lister, listerTeardown := generateIPAMClaimsListerAndTeardownFunc(ctx.Done(), ipamClaim)
+			defer func() {
+				cancel()
+				listerTeardown()
+			}()
+
+			netInfo, err := util.NewNetInfo(dummyNetconf(networkName))
+			Expect(err).NotTo(HaveOccurred())
+			var statefulSets []*appsv1.StatefulSet
+			if statefulSetObj != nil {
+				statefulSets = append(statefulSets, statefulSetObj)
+			}
+			ipamClaimsClient := fakeipamclaimclient.NewSimpleClientset(ipamClaim)
+			kube := &ovnkclient.KubeOVN{IPAMClaimsClient: ipamClaimsClient}
+
+			Expect(NewIPAMClaimReconciler(kube, netInfo, lister, newStatefulSetLister(statefulSets...)).
+				ReleaseStatefulSetIPAMClaim(namespace, ipamClaim.Name)).To(Succeed())
+
+			_, err = ipamClaimsClient.K8sV1alpha1().IPAMClaims(namespace).Get(context.Background(), ipamClaim.Name, metav1.GetOptions{})
+			if expectDeleted {
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry(
+			"keeps it when the StatefulSet has the pod ordinal",
+			statefulSetIPAMClaim(namespace, "web-1.tenant", networkName, statefulSet, "1"),
+			dummyStatefulSet(namespace, statefulSet, 3, 0),
+			false,
+		),
+		Entry(
+			"deletes it when the StatefulSet was scaled down",
+			statefulSetIPAMClaim(namespace, "web-1.tenant", networkName, statefulSet, "1"),
+			dummyStatefulSet(namespace, statefulSet, 1, 0),
+			true,
+		),
+		Entry(
+			"deletes it when the StatefulSet ordinals start after the pod ordinal",
+			statefulSetIPAMClaim(namespace, "web-1.tenant", networkName, statefulSet, "1"),
+			dummyStatefulSet(namespace, statefulSet, 3, 2),
+			true,
+		),
+		Entry(
+			"deletes it when the StatefulSet was deleted",
+			statefulSetIPAMClaim(namespace, "web-1.tenant", networkName, statefulSet, "1"),
+			nil,
+			true,
+		),
+		Entry(
+			"keeps IPAMClaims not derived for StatefulSet pods",
+			ipamClaimWithIPs(namespace, "web-1.tenant", networkName, "192.10.10.10/24"),
+			nil,
+			false,
+		),
+		Entry(
+			"keeps IPAMClaims of other networks",
+			statefulSetIPAMClaim(namespace, "web-1.tenant", "othernetwork", statefulSet, "1"),
+			nil,
+			false,
+		),
+	)
+})
+
+func statefulSetPod(namespace, statefulSet, podName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName,
+			Namespace: namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: appsv1.SchemeGroupVersion.String(),
+					Kind:       "StatefulSet",
+					Name:       statefulSet,
+					Controller: ptr.To(true),
+				},
+			},
+		},
+	}
+}
+
+func statefulSetIPAMClaim(namespace, claimName, networkName, statefulSet, ordinal string) *ipamclaimsapi.IPAMClaim {
+	ipamClaim := ipamClaimWithIPs(namespace, claimName, networkName, "192.10.10.10/24")
+	ipamClaim.Labels = map[string]string{
+		StatefulSetLabel:        statefulSet,
+		StatefulSetOrdinalLabel: ordinal,
+	}
+	return ipamClaim
+}
+
+func dummyStatefulSet(namespace, name string, replicas, start int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(replicas),
+			Ordinals: &appsv1.StatefulSetOrdinals{Start: start},
+		},
+	}
+}
+
+func newStatefulSetLister(statefulSets ...*appsv1.StatefulSet) appslisters.StatefulSetLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, statefulSet := range statefulSets {
+		Expect(indexer.Add(statefulSet)).To(Succeed())
+	}
+	return appslisters.NewStatefulSetLister(indexer)
+}
@@ -44,6 +44,8 @@ type retryObjEntry struct {
 	backoff   time.Duration
 	// number of times this object has been unsuccessfully added/updated/deleted
 	failedAttempts uint8
+	// lastError holds the error returned by the last failed add/update/delete attempt
+	lastError error
 	// infiniteRetry indicates whether this object should be retried indefinitely, regardless of the number of failed attempts
 	// Used for pods only right now
 	infiniteRetry bool
@@ -159,6 +161,7 @@ func (r *RetryFramework) initRetryObjWithAddBackoff(obj interface{}, lockedKey s
 		entry.infiniteRetry = true
 	}
 	entry.failedAttempts = 0
+	entry.lastError = nil
 	entry.backoff = backoff
 	return entry
 }
@@ -181,6 +184,7 @@ func (r *RetryFramework) initRetryObjWithUpdate(oldObj, newObj interface{}, lock
 	}
 	entry.config = oldObj
 	entry.failedAttempts = 0
+	entry.lastError = nil
 	return entry
 }
 
@@ -206,6 +210,7 @@ func (r *RetryFramework) initRetryObjWithDeleteBackoff(obj interface{}, lockedKe
 		entry.config = config
 	}
 	entry.failedAttempts = 0
+	entry.lastError = nil
 	if noRetryAdd {
 		// will not be retried for addition
 		entry.newObj = nil
@@ -260,12 +265,13 @@ func (r *RetryFramework) removeDeleteFromRetryObj(entry *retryObjEntry) {
 }
 
 // increaseFailedAttemptsCounter increases by one the counter of failed add/update/delete attempts
-// for the given key
-func (r *RetryFramework) increaseFailedAttemptsCounter(entry *retryObjEntry) {
+// for the given key and records err as the last error
+func (r *RetryFramework) increaseFailedAttemptsCounter(entry *retryObjEntry, err error) {
 	// avoid overflowing the counter for infinite retries
 	if entry.failedAttempts < 255 {
 		entry.failedAttempts++
 	}
+	entry.lastError = err
 }
 
 // RequestRetryFramework allows a caller to immediately request to iterate through all objects that
@@ -386,7 +392,7 @@ func (r *RetryFramework) resourceRetry(objKey string, now time.Time) {
 				klog.Errorf("%s: %v retry: cannot update object that is not scheduled: %s", r.name, r.ResourceHandler.ObjType, objKey)
 			} else if err := r.ResourceHandler.UpdateResource(entry.config, entry.newObj, true); err != nil {
 				entry.timeStamp = time.Now()
-				r.increaseFailedAttemptsCounter(entry)
+				r.increaseFailedAttemptsCounter(entry, err)
 				if entry.failedAttempts >= MaxFailedAttempts && !entry.infiniteRetry {
 					klog.Errorf("%s: retry update failed final attempt for %s %s: error: %v", r.name, r.ResourceHandler.ObjType, objKey, err)
 				} else {
@@ -407,7 +413,7 @@ func (r *RetryFramework) resourceRetry(objKey string, now time.Time) {
 					klog.Errorf("%s: %v retry: cannot delete object that was not scheduled %s", r.name, r.ResourceHandler.ObjType, objKey)
 				} else if err := r.ResourceHandler.DeleteResource(entry.oldObj, entry.config); err != nil {
 					entry.timeStamp = time.Now()
-					r.increaseFailedAttemptsCounter(entry)
+					r.increaseFailedAttemptsCounter(entry, err)
 					if entry.failedAttempts >= MaxFailedAttempts && !entry.infiniteRetry {
 						klog.Errorf("%s: retry delete failed final attempt for %s %s: error: %v", r.name, r.ResourceHandler.ObjType, objKey, err)
 					} else {
@@ -430,7 +436,7 @@ func (r *RetryFramework) resourceRetry(objKey string, now time.Time) {
 					klog.Errorf("%s: %v retry: cannot create object that is not scheduled %s", r.name, r.ResourceHandler.ObjType, objKey)
 				} else if err := r.ResourceHandler.AddResource(entry.newObj, true); err != nil {
 					entry.timeStamp = time.Now()
-					r.increaseFailedAttemptsCounter(entry)
+					r.increaseFailedAttemptsCounter(entry, err)
 					if entry.failedAttempts >= MaxFailedAttempts && !entry.infiniteRetry {
 						klog.Errorf("%s: retry add failed final attempt for %s %s: error: %v", r.name, r.ResourceHandler.ObjType, objKey, err)
 					} else {
@@ -461,6 +467,7 @@ func (r *RetryFramework) resourceRetry(objKey string, now time.Time) {
 // Keys added after the snapshot was done won't be retried during this run.
 func (r *RetryFramework) iterateRetryResources() {
 	entriesKeys := r.retryEntries.GetKeys()
+	recordRetryEntries(r, len(entriesKeys))
 	if len(entriesKeys) == 0 {
 		return
 	}
@@ -485,6 +492,8 @@ func (r *RetryFramework) iterateRetryResources() {
 // periodicallyRetryResources tracks RetryFramework and checks if any object needs to be retried for add or delete every
 // RetryObjInterval seconds or when requested through retryChan.
 func (r *RetryFramework) periodicallyRetryResources() {
+	registerRetryFramework(r)
+	defer unregisterRetryFramework(r)
 	timer := time.NewTicker(RetryObjInterval)
 	defer timer.Stop()
 	for {
@@ -533,7 +542,7 @@ func (r *RetryFramework) processObjectInTerminalState(obj interface{}, lockedKey
 		klog.Errorf("%s: failed to delete object %s of type %s in terminal state, during %s event: %v",
 			r.name, lockedKey, r.ResourceHandler.ObjType, event, err)
 		r.ResourceHandler.RecordErrorEvent(obj, "ErrorDeletingResource", err)
-		r.increaseFailedAttemptsCounter(retryEntry)
+		r.increaseFailedAttemptsCounter(retryEntry, err)
 		return
 	}
 	r.DeleteRetryObj(lockedKey)
@@ -594,7 +603,7 @@ func (r *RetryFramework) WatchResourceFiltered(namespaceForFilteredHandler strin
 							klog.Errorf("%s: failed to delete old object %s of type %s,"+
 								" during add event: %v", r.name, key, r.ResourceHandler.ObjType, err)
 							r.ResourceHandler.RecordErrorEvent(obj, "ErrorDeletingResource", err)
-							r.increaseFailedAttemptsCounter(retryObj)
+							r.increaseFailedAttemptsCounter(retryObj, err)
 							return
 						}
 						r.removeDeleteFromRetryObj(retryObj)
@@ -607,7 +616,7 @@ func (r *RetryFramework) WatchResourceFiltered(namespaceForFilteredHandler strin
 						} else {
 							klog.Infof("%s: failed to create %s %s, error: %v", r.name, r.ResourceHandler.ObjType, key, err)
 						}
-						r.increaseFailedAttemptsCounter(retryObj)
+						r.increaseFailedAttemptsCounter(retryObj, err)
 						return
 					}
 					klog.V(5).Infof("%s: creating %s %s took: %v", r.name, r.ResourceHandler.ObjType, key, time.Since(start))
@@ -697,7 +706,7 @@ func (r *RetryFramework) WatchResourceFiltered(namespaceForFilteredHandler strin
 							klog.Errorf("%s: failed to delete stale object %s, during update: %v", r.name, oldKey, err)
 							r.ResourceHandler.RecordErrorEvent(retryEntryOrNil.oldObj, "ErrorDeletingResource", err)
 							retryEntry := r.initRetryObjWithAdd(latest, key)
-							r.increaseFailedAttemptsCounter(retryEntry)
+							r.increaseFailedAttemptsCounter(retryEntry, err)
 							return
 						}
 						// remove the old object from retry entry since it was correctly deleted
@@ -725,7 +734,7 @@ func (r *RetryFramework) WatchResourceFiltered(namespaceForFilteredHandler strin
 							r.ResourceHandler.RecordErrorEvent(old, "ErrorDeletingResource", err)
 							retryEntry := r.initRetryObjWithDelete(old, key, nil, false)
 							r.initRetryObjWithAdd(latest, key)
-							r.increaseFailedAttemptsCounter(retryEntry)
+							r.increaseFailedAttemptsCounter(retryEntry, err)
 							return
 						}
 						// remove the old object from retry entry since it was correctly deleted
@@ -753,13 +762,13 @@ func (r *RetryFramework) WatchResourceFiltered(namespaceForFilteredHandler strin
 							} else {
 								retryEntry = r.initRetryObjWithAdd(latest, key)
 							}
-							r.increaseFailedAttemptsCounter(retryEntry)
+							r.increaseFailedAttemptsCounter(retryEntry, err)
 							return
 						}
 					} else { // we previously deleted old object, now let's add the new one
 						if err := r.ResourceHandler.AddResource(latest, false); err != nil {
 							retryEntry := r.initRetryObjWithAdd(latest, key)
-							r.increaseFailedAttemptsCounter(retryEntry)
+							r.increaseFailedAttemptsCounter(retryEntry, err)
 							if !ovntypes.IsSuppressedError(err) {
 								klog.Errorf("%s: failed to add %s %s, during update: %v",
 									r.name, r.ResourceHandler.ObjType, newKey, err)
@@ -804,7 +813,7 @@ func (r *RetryFramework) WatchResourceFiltered(namespaceForFilteredHandler strin
 					internalCacheEntry := r.ResourceHandler.GetInternalCacheEntry(obj)
 					retryEntry := r.initRetryObjWithDelete(obj, key, internalCacheEntry, false) // set up the retry obj for deletion
 					if err = r.ResourceHandler.DeleteResource(obj, internalCacheEntry); err != nil {
-						r.increaseFailedAttemptsCounter(retryEntry)
+						r.increaseFailedAttemptsCounter(retryEntry, err)
 						klog.Errorf("%s: failed to delete %s %s, error: %v", r.name, r.ResourceHandler.ObjType, key, err)
 						return
 					}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/metrics"
+)
+
+// RetryEntryStatus is a snapshot of the state of an object in the retry cache
+type RetryEntryStatus struct {
+	Key string `json:"key"`
+	// PendingAdd is true if the object still needs to be added or updated
+	PendingAdd bool `json:"pendingAdd"`
+	// PendingDelete is true if the object still needs to be deleted
+	PendingDelete  bool   `json:"pendingDelete"`
+	FailedAttempts uint8  `json:"failedAttempts"`
+	LastError      string `json:"lastError,omitempty"`
+	// NextRetry is the earliest time at which the object will be retried
+	NextRetry time.Time `json:"nextRetry"`
+}
+
+// RetryFrameworkStatus is a snapshot of the retry cache of a RetryFramework
+type RetryFrameworkStatus struct {
+	Name         string             `json:"name"`
+	ResourceType string             `json:"resourceType"`
+	Entries      []RetryEntryStatus `json:"entries"`
+}
+
+// retryFrameworks tracks the running retry frameworks, along with the number of
+// entries they had in their retry cache the last time it was sampled.
+var retryFrameworks = struct {
+	sync.Mutex
+	entries map[*RetryFramework]int
+}{entries: map[*RetryFramework]int{}}
+
+func (r *RetryFramework) resourceType() string {
+	return fmt.Sprintf("%v", r.ResourceHandler.ObjType)
+}
+
+// registerRetryFramework makes r visible to the retry debug handler and metrics
+func registerRetryFramework(r *RetryFramework) {
+	retryFrameworks.Lock()
+	defer retryFrameworks.Unlock()
+	retryFrameworks.entries[r] = 0
+}
+
+// unregisterRetryFramework removes r from the retry debug handler and metrics
+func unregisterRetryFramework(r *RetryFramework) {
+	retryFrameworks.Lock()
+	defer retryFrameworks.Unlock()
+	delete(retryFrameworks.entries, r)
+	updateRetryEntriesMetric(r.resourceType())
+}
+
+// recordRetryEntries samples the number of entries in the retry cache of r
+func recordRetryEntries(r *RetryFramework, numEntries int) {
+	retryFrameworks.Lock()
+	defer retryFrameworks.Unlock()
+	if _, ok := retryFrameworks.entries[r]; !ok {
+		return
+	}
+	retryFrameworks.entries[r] = numEntries
+	updateRetryEntriesMetric(r.resourceType())
+}
+
+// updateRetryEntriesMetric sets the retry entries metric of resourceType to the sum of
+// the entries of all the retry frameworks handling that resource type.
+// Must be called with retryFrameworks locked.
+func updateRetryEntriesMetric(resourceType string) {
+	total := 0
+	found := false
+	for r, numEntries := range retryFrameworks.entries {
+		if r.resourceType() == resourceType {
+			total += numEntries
+			found = true
+		}
+	}
+	if !found {
+		metrics.MetricResourceRetryEntries.DeleteLabelValues(resourceType)
+		return
+	}
+	metrics.MetricResourceRetryEntries.WithLabelValues(resourceType).Set(float64(total))
+}
+
+// getRetryFrameworks returns the running retry frameworks, sorted by name and resource type
+func getRetryFrameworks() []*RetryFramework {
+	retryFrameworks.Lock()
+	defer retryFrameworks.Unlock()
+	frameworks := make([]*RetryFramework, 0, len(retryFrameworks.entries))
+	for r := range retryFrameworks.entries {
+		frameworks = append(frameworks, r)
+	}
+	sort.Slice(frameworks, func(i, j int) bool {
+		if frameworks[i].name != frameworks[j].name {
+			return frameworks[i].name < frameworks[j].name
+		}
+		return frameworks[i].resourceType() < frameworks[j].resourceType()
+	})
+	return frameworks
+}
+
+// findRetryFramework returns the running retry framework with the given name and resource type
+func findRetryFramework(name, resourceType string) *RetryFramework {
+	for _, r := range getRetryFrameworks() {
+		if r.name == name && r.resourceType() == resourceType {
+			return r
+		}
+	}
+	return nil
+}
+
+// Status returns a snapshot of the objects in the retry cache of r
+func (r *RetryFramework) Status() RetryFrameworkStatus {
+	status := RetryFrameworkStatus{
+		Name:         r.name,
+		ResourceType: r.resourceType(),
+		Entries:      []RetryEntryStatus{},
+	}
+	keys := r.retryEntries.GetKeys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		r.DoWithLock(key, func(key string) {
+			entry, found := r.getRetryObj(key)
+			if !found {
+				return
+			}
+			entryStatus := RetryEntryStatus{
+				Key:            key,
+				PendingAdd:     entry.newObj != nil,
+				PendingDelete:  entry.oldObj != nil,
+				FailedAttempts: entry.failedAttempts,
+				NextRetry:      entry.timeStamp.Add(entry.backoff),
+			}
+			if entry.lastError != nil {
+				entryStatus.LastError = entry.lastError.Error()
+			}
+			status.Entries = append(status.Entries, entryStatus)
+		})
+	}
+	return status
+}
+
+// DebugHandler returns an http.Handler that exposes the retry cache of all the running
+// retry frameworks:
+//   - GET returns the status of the retry frameworks as JSON, optionally filtered with
+//     the "name" and "resourceType" query parameters.
+//   - POST requests the immediate retry of the object identified by the "name",
+//     "resourceType" and "key" query parameters.
+func DebugHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		name, resourceType := query.Get("name"), query.Get("resourceType")
+		switch req.Method {
+		case http.MethodGet:
+			statuses := []RetryFrameworkStatus{}
+			for _, r := range getRetryFrameworks() {
+				if (name != "" && r.name != name) || (resourceType != "" && r.resourceType() != resourceType) {
+					continue
+				}
+				statuses = append(statuses, r.Status())
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(statuses); err != nil {
+				klog.Errorf("Failed to encode retry frameworks status: %v", err)
+			}
+		case http.MethodPost:
+			key := query.Get("key")
+			if name == "" || resourceType == "" || key == "" {
+				http.Error(w, "name, resourceType and key query parameters are required", http.StatusBadRequest)
+				return
+			}
+			r := findRetryFramework(name, resourceType)
+			if r == nil {
+				http.Error(w, fmt.Sprintf("retry framework %s for %s not found", name, resourceType), http.StatusNotFound)
+				return
+			}
+			requested, err := r.RequestRetryObjWithNoBackoff(cache.ExplicitKey(key))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if !requested {
+				http.Error(w, fmt.Sprintf("%s %s not found in the retry cache of %s", resourceType, key, name), http.StatusNotFound)
+				return
+			}
+			klog.Infof("%s: immediate retry of %s %s requested through the debug endpoint", name, resourceType, key)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			http.Error(w, "unsupported http method", http.StatusMethodNotAllowed)
+		}
+	})
+}
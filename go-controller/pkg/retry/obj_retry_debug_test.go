@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package retry
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/factory"
+)
+
+type fakeEventHandler struct {
+	DefaultEventHandler
+}
+
+func (h *fakeEventHandler) AddResource(_ interface{}, _ bool) error { return nil }
+
+func (h *fakeEventHandler) UpdateResource(_, _ interface{}, _ bool) error { return nil }
+
+func (h *fakeEventHandler) DeleteResource(_, _ interface{}) error { return nil }
+
+func (h *fakeEventHandler) GetResourceFromInformerCache(_ string) (interface{}, error) {
+	return nil, nil
+}
+
+func (h *fakeEventHandler) FilterOutResource(_ interface{}) bool { return false }
+
+func TestDebugHandler(t *testing.T) {
+	r := NewRetryFramework("test", nil, &sync.WaitGroup{}, nil, &ResourceHandler{
+		ObjType:      factory.PodType,
+		EventHandler: &fakeEventHandler{},
+	})
+	registerRetryFramework(r)
+	defer unregisterRetryFramework(r)
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod"}}
+	r.DoWithLock("ns/pod", func(key string) {
+		entry := r.initRetryObjWithAdd(pod, key)
+		r.increaseFailedAttemptsCounter(entry, errors.New("boom"))
+	})
+
+	handler := DebugHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/retry?name=test", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	var statuses []RetryFrameworkStatus
+	if err := json.Unmarshal(rec.Body.Bytes(), &statuses); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(statuses) != 1 || len(statuses[0].Entries) != 1 {
+		t.Fatalf("expected a single framework with a single entry, got %+v", statuses)
+	}
+	entry := statuses[0].Entries[0]
+	if statuses[0].ResourceType != "*v1.Pod" || entry.Key != "ns/pod" || !entry.PendingAdd || entry.PendingDelete ||
+		entry.FailedAttempts != 1 || entry.LastError != "boom" {
+		t.Fatalf("unexpected retry status %+v", statuses[0])
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/debug/retry?name=test&resourceType=*v1.Pod&key=ns/pod", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusAccepted, rec.Code, rec.Body.String())
+	}
+	if backoff := GetBackoffFromRetryObj("ns/pod", r); backoff != noBackoff {
+		t.Fatalf("expected no backoff after requesting a retry, got %v", backoff)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/debug/retry?name=test&resourceType=*v1.Pod&key=ns/other", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, rec.Code)
+	}
+}
@@ -16,6 +16,8 @@ import (
 	ovncnitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
 	networkconnectv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1"
 	networkconnectfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/clusternetworkconnect/v1/apis/clientset/versioned/fake"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinkfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/clientset/versioned/fake"
 	vtepv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/vtep/v1"
 	vtepfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/vtep/v1/apis/clientset/versioned/fake"
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
@@ -193,6 +195,59 @@ func AddVTEPApplyReactor(fakeClient *vtepfake.Clientset) {
 	})
 }
 
+// AddUplinkApplyReactor makes the fake Uplink client handle the server side
+// apply of finalizers and status, like AddVTEPApplyReactor does for VTEPs.
+func AddUplinkApplyReactor(fakeClient *uplinkfake.Clientset) {
+	fakeClient.PrependReactor("patch", "uplinks", func(action ktesting.Action) (bool, runtime.Object, error) {
+		patchAction := action.(ktesting.PatchAction)
+		name := patchAction.GetName()
+
+		existingObj, err := fakeClient.Tracker().Get(
+			uplinkv1alpha1.SchemeGroupVersion.WithResource("uplinks"), "", name)
+		if err != nil {
+			return true, nil, err
+		}
+
+		uplink := existingObj.(*uplinkv1alpha1.Uplink)
+		if patchAction.GetSubresource() == "status" {
+			type StatusPatch struct {
+				Status uplinkv1alpha1.UplinkStatus `json:"status"`
+			}
+
+			var patchData StatusPatch
+			if err := json.Unmarshal(patchAction.GetPatch(), &patchData); err != nil {
+				return true, nil, err
+			}
+
+			uplink.Status = patchData.Status
+		} else {
+			type MetadataPatch struct {
+				Metadata struct {
+					Finalizers []string `json:"finalizers"`
+				} `json:"metadata"`
+			}
+
+			var patchData MetadataPatch
+			if err := json.Unmarshal(patchAction.GetPatch(), &patchData); err != nil {
+				return true, nil, err
+			}
+
+			uplink.Finalizers = patchData.Metadata.Finalizers
+		}
+
+		_ = fakeClient.Tracker().Update(
+			uplinkv1alpha1.SchemeGroupVersion.WithResource("uplinks"), uplink, "")
+
+		// Simulate API server garbage collection of deleted objects without
+		// finalizers, see AddVTEPApplyReactor.
+		if !uplink.DeletionTimestamp.IsZero() && len(uplink.Finalizers) == 0 {
+			_ = fakeClient.Tracker().Delete(
+				uplinkv1alpha1.SchemeGroupVersion.WithResource("uplinks"), "", uplink.Name)
+		}
+		return true, uplink, nil
+	})
+}
+
 func BuildNAD(name, namespace string, network *ovncnitypes.NetConf) (*nadapi.NetworkAttachmentDefinition, error) {
 	config, err := json.Marshal(network)
 	if err != nil {
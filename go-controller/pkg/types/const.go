@@ -110,6 +110,12 @@ const (
 	NetworkConnectPassSameNetworkPriority = 475
 	// Priority for dropping pod-to-pod traffic between connected networks
 	NetworkConnectDropPodTrafficPriority = 450
+	// Priority for passing the new connections allowed by the allowedTraffic rules of a network connect
+	NetworkConnectPassAllowedTrafficPriority = 440
+	// Priority for dropping the other new connections from the connected networks when allowedTraffic is set
+	NetworkConnectDropNotAllowedTrafficPriority = 430
+	// Priority for dropping the tagged traffic of VLANs not allowed on trunk localnet networks
+	TrunkVLANDenyPriority = 1000
 
 	// ACL Tiers
 	// Tier 0 is called Primary as it is evaluated before any other feature-related Tiers.
@@ -117,9 +123,9 @@ const (
 	PrimaryACLTier = 0
 	// Default Tier for all ACLs
 	DefaultACLTier = 2
-	// Default Tier for all ACLs belonging to Admin Network Policy
+	// Default Tier for all ACLs belonging to Admin Network Policy and Admin tier Cluster Network Policy
 	DefaultANPACLTier = 1
-	// Default Tier for all ACLs belonging to Baseline Admin Network Policy
+	// Default Tier for all ACLs belonging to Baseline Admin Network Policy and Baseline tier Cluster Network Policy
 	DefaultBANPACLTier = 3
 
 	// priority of logical router policies on the OVNClusterRouter
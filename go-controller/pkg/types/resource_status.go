@@ -13,6 +13,9 @@ const (
 	EgressFirewallErrorMsg = "EgressFirewall Rules not correctly applied"
 	EgressQoSErrorMsg      = "EgressQoS Rules not correctly applied"
 	NetworkQoSErrorMsg     = "NetworkQoS Destinations not correctly applied"
+	MulticastGroupErrorMsg = "MulticastGroup not correctly applied"
+
+	ClusterNetworkConnectAllowedTrafficErrorMsg = "ClusterNetworkConnect allowedTraffic rules not correctly applied"
 )
 
 func GetZoneStatus(zoneID, message string) string {
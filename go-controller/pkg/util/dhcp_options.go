@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	utilnet "k8s.io/utils/net"
+
+	ovncnitypes "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
+)
+
+const (
+	// maxDHCPOptionValueLength is the maximum length of a DHCP option value,
+	// bounded by the single byte length field of DHCPv4 options.
+	maxDHCPOptionValueLength = 255
+)
+
+// DHCPExtraOptionInfo describes how an extra DHCP option is served to
+// KubeVirt virtual machines.
+type DHCPExtraOptionInfo struct {
+	// OVNName is the name of the option in the OVN DHCP_Options table.
+	OVNName string
+	// IPv6 is set if the option is also served over DHCPv6.
+	IPv6 bool
+	// IPv4Address is set if the option value must be an IPv4 address.
+	IPv4Address bool
+}
+
+// dhcpExtraOptions are the extra DHCP options that can be configured, mostly
+// needed for network boot. Options that OVN-Kubernetes manages, like the
+// router or the lease time, are not allowed.
+var dhcpExtraOptions = map[string]DHCPExtraOptionInfo{
+	"BootFileName":      {OVNName: "bootfile_name", IPv6: true},
+	"BootFileNameAlt":   {OVNName: "bootfile_name_alt", IPv6: true},
+	"TFTPServer":        {OVNName: "tftp_server"},
+	"TFTPServerAddress": {OVNName: "tftp_server_address", IPv4Address: true},
+	"NextServer":        {OVNName: "next_server", IPv4Address: true},
+	"DomainName":        {OVNName: "domain_name"},
+	"PathPrefix":        {OVNName: "path_prefix"},
+	"WPAD":              {OVNName: "wpad"},
+}
+
+// GetDHCPExtraOptionInfo returns how the extra DHCP option with the given
+// name is served, and false if the option is not supported.
+func GetDHCPExtraOptionInfo(name string) (DHCPExtraOptionInfo, bool) {
+	info, ok := dhcpExtraOptions[name]
+	return info, ok
+}
+
+// ValidateDHCPOptions validates the additional DHCP options of a network or a
+// virtual machine.
+func ValidateDHCPOptions(opts *ovncnitypes.DHCPOptions) error {
+	if opts == nil {
+		return nil
+	}
+	for _, domain := range opts.DomainSearch {
+		if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+			return fmt.Errorf("invalid search domain %q: %s", domain, strings.Join(errs, ", "))
+		}
+	}
+	for _, server := range opts.DNSServers {
+		if net.ParseIP(server) == nil {
+			return fmt.Errorf("invalid DNS server %q", server)
+		}
+	}
+	for _, server := range opts.NTPServers {
+		if !utilnet.IsIPv4String(server) {
+			return fmt.Errorf("invalid NTP server %q: must be an IPv4 address", server)
+		}
+	}
+	for _, route := range opts.Routes {
+		if err := validateDHCPRoute(route); err != nil {
+			return err
+		}
+	}
+	names := sets.New[string]()
+	for _, option := range opts.ExtraOptions {
+		if names.Has(option.Name) {
+			return fmt.Errorf("duplicate extra option %q", option.Name)
+		}
+		names.Insert(option.Name)
+		if err := validateDHCPExtraOption(option); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateDHCPRoute(route ovncnitypes.DHCPRoute) error {
+	_, destination, err := net.ParseCIDR(route.Destination)
+	if err != nil {
+		return fmt.Errorf("invalid route destination %q: %w", route.Destination, err)
+	}
+	if utilnet.IsIPv6CIDR(destination) {
+		if route.NextHop != "" {
+			return fmt.Errorf("invalid route to %s: IPv6 routes are advertised through the network gateway and can't set a next hop",
+				route.Destination)
+		}
+		return nil
+	}
+	if !utilnet.IsIPv4String(route.NextHop) {
+		return fmt.Errorf("invalid route to %s: next hop %q must be an IPv4 address", route.Destination, route.NextHop)
+	}
+	return nil
+}
+
+func validateDHCPExtraOption(option ovncnitypes.DHCPExtraOption) error {
+	info, ok := GetDHCPExtraOptionInfo(option.Name)
+	if !ok {
+		return fmt.Errorf("unsupported extra option %q", option.Name)
+	}
+	if option.Value == "" || len(option.Value) > maxDHCPOptionValueLength {
+		return fmt.Errorf("invalid value for extra option %q: must be 1 to %d characters long", option.Name, maxDHCPOptionValueLength)
+	}
+	if info.IPv4Address && !utilnet.IsIPv4String(option.Value) {
+		return fmt.Errorf("invalid value %q for extra option %q: must be an IPv4 address", option.Value, option.Name)
+	}
+	if strings.ContainsFunc(option.Value, func(r rune) bool { return r == '"' || r == '\\' || unicode.IsControl(r) }) {
+		return fmt.Errorf("invalid value %q for extra option %q: quotes, backslashes and control characters are not allowed",
+			option.Value, option.Name)
+	}
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/miekg/dns"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
@@ -46,6 +48,47 @@ func ValidateAndGetEgressFirewallDNSDestination(egressFirewallDestination egress
 	return
 }
 
+// ValidateAndGetEgressFirewallPodSelectors validates the namespaceSelector and podSelector of an egress
+// firewall rule destination and returns them as label selectors. A nil podSelector selects all the pods
+// in the selected namespaces. If the destination has no namespaceSelector, nil selectors are returned.
+func ValidateAndGetEgressFirewallPodSelectors(egressFirewallDestination egressfirewallv1.EgressFirewallDestination) (
+	namespaceSelector labels.Selector,
+	podSelector labels.Selector,
+	err error) {
+	if egressFirewallDestination.NamespaceSelector == nil {
+		if egressFirewallDestination.PodSelector != nil {
+			return nil, nil, fmt.Errorf("rule destination has a pod selector without a namespace selector")
+		}
+		return nil, nil, nil
+	}
+	namespaceSelector, err = metav1.LabelSelectorAsSelector(egressFirewallDestination.NamespaceSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("rule destination has invalid namespace selector, err: %v", err)
+	}
+	podSelector = labels.Everything()
+	if egressFirewallDestination.PodSelector != nil {
+		podSelector, err = metav1.LabelSelectorAsSelector(egressFirewallDestination.PodSelector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("rule destination has invalid pod selector, err: %v", err)
+		}
+	}
+	return
+}
+
+// ValidateEgressFirewallPort validates the port range of an egress firewall rule port.
+func ValidateEgressFirewallPort(egressFirewallPort egressfirewallv1.EgressFirewallPort) error {
+	if egressFirewallPort.EndPort == nil {
+		return nil
+	}
+	if egressFirewallPort.Port == 0 {
+		return fmt.Errorf("endPort %d is set without a port", *egressFirewallPort.EndPort)
+	}
+	if *egressFirewallPort.EndPort < egressFirewallPort.Port {
+		return fmt.Errorf("endPort %d must be greater than or equal to port %d", *egressFirewallPort.EndPort, egressFirewallPort.Port)
+	}
+	return nil
+}
+
 // IsWildcard checks if the domain name is wildcard.
 func IsWildcard(dnsName string) bool {
 	return strings.HasPrefix(dnsName, "*.")
@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/config"
 	egressfirewallapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
@@ -131,6 +133,116 @@ func TestValidateAndGetEgressFirewallDNSDestination(t *testing.T) {
 	}
 }
 
+func TestValidateEgressFirewallPort(t *testing.T) {
+	testcases := []struct {
+		name        string
+		port        egressfirewallapi.EgressFirewallPort
+		expectedErr bool
+	}{
+		{
+			name: "should accept a single port",
+			port: egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 80},
+		},
+		{
+			name: "should accept a port range",
+			port: egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 80, EndPort: ptr.To[int32](90)},
+		},
+		{
+			name: "should accept a port range with a single port",
+			port: egressfirewallapi.EgressFirewallPort{Protocol: "UDP", Port: 80, EndPort: ptr.To[int32](80)},
+		},
+		{
+			name:        "should throw an error for endPort lower than port",
+			port:        egressfirewallapi.EgressFirewallPort{Protocol: "TCP", Port: 80, EndPort: ptr.To[int32](79)},
+			expectedErr: true,
+		},
+		{
+			name:        "should throw an error for endPort without port",
+			port:        egressfirewallapi.EgressFirewallPort{Protocol: "SCTP", EndPort: ptr.To[int32](80)},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateEgressFirewallPort(tc.port)
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAndGetEgressFirewallPodSelectors(t *testing.T) {
+	testcases := []struct {
+		name                      string
+		egressFirewallDestination egressfirewallapi.EgressFirewallDestination
+		expectedErr               bool
+		expectedNamespaceSelector string
+		expectedPodSelector       string
+	}{
+		{
+			name: "should return no selectors for a cidr destination",
+			egressFirewallDestination: egressfirewallapi.EgressFirewallDestination{
+				CIDRSelector: "1.2.3.4/32",
+			},
+		},
+		{
+			name: "should select all pods if podSelector is not set",
+			egressFirewallDestination: egressfirewallapi.EgressFirewallDestination{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			},
+			expectedNamespaceSelector: "team=a",
+			expectedPodSelector:       "",
+		},
+		{
+			name: "should return both selectors",
+			egressFirewallDestination: egressfirewallapi.EgressFirewallDestination{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			},
+			expectedNamespaceSelector: "team=a",
+			expectedPodSelector:       "app=db",
+		},
+		{
+			name: "should throw an error for podSelector without namespaceSelector",
+			egressFirewallDestination: egressfirewallapi.EgressFirewallDestination{
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should throw an error for an invalid namespaceSelector",
+			egressFirewallDestination: egressfirewallapi.EgressFirewallDestination{
+				NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: "Invalid"},
+				}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespaceSelector, podSelector, err := ValidateAndGetEgressFirewallPodSelectors(tc.egressFirewallDestination)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tc.egressFirewallDestination.NamespaceSelector == nil {
+				assert.Nil(t, namespaceSelector)
+				assert.Nil(t, podSelector)
+				return
+			}
+			assert.Equal(t, tc.expectedNamespaceSelector, namespaceSelector.String())
+			assert.Equal(t, tc.expectedPodSelector, podSelector.String())
+		})
+	}
+}
+
 func TestIsWildcard(t *testing.T) {
 	tests := []struct {
 		dnsName        string
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	egressserviceapi "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
+	"github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/types"
+)
+
+// GetEgressServiceActiveHosts returns the number of nodes that should be selected
+// to handle the traffic of the given EgressService.
+func GetEgressServiceActiveHosts(es *egressserviceapi.EgressService) int {
+	if es.Spec.ActiveHosts < 1 {
+		return 1
+	}
+	return int(es.Spec.ActiveHosts)
+}
+
+// GetEgressServiceHosts returns the names of the nodes selected to handle the
+// traffic of the given EgressService, or nil if there are none.
+// The status of EgressServices written before multiple hosts were supported
+// only has the host field set, which is used in that case.
+func GetEgressServiceHosts(es *egressserviceapi.EgressService) []string {
+	if len(es.Status.Hosts) > 0 {
+		return es.Status.Hosts
+	}
+	if es.Status.Host == types.EgressServiceNoHost || es.Status.Host == types.EgressServiceNoSNATHost {
+		return nil
+	}
+	return []string{es.Status.Host}
+}
@@ -36,10 +36,14 @@ import (
 	egressqosfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned/fake"
 	egressservice "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
 	egressservicefake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned/fake"
+	multicastgroup "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1"
+	multicastgroupfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/clientset/versioned/fake"
 	networkqos "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	networkqosfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/clientset/versioned/fake"
 	routeadvertisements "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
 	routeadvertisementsfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/fake"
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinkfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/clientset/versioned/fake"
 	udnv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	udnfake "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/fake"
 	vtepv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/vtep/v1"
@@ -55,6 +59,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	apbExternalRouteObjects := []runtime.Object{}
 	anpObjects := []runtime.Object{}
 	networkQoSObjects := []runtime.Object{}
+	multicastGroupObjects := []runtime.Object{}
 	v1Objects := []runtime.Object{}
 	nads := []runtime.Object{}
 	cloudObjects := []runtime.Object{}
@@ -64,6 +69,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	frrObjects := []runtime.Object{}
 	networkConnectObjects := []runtime.Object{}
 	vtepObjects := []runtime.Object{}
+	uplinkObjects := []runtime.Object{}
 	for _, object := range objects {
 		switch object.(type) {
 		case *egressip.EgressIP:
@@ -94,10 +100,14 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 			frrObjects = append(frrObjects, object)
 		case *networkqos.NetworkQoS:
 			networkQoSObjects = append(networkQoSObjects, object)
+		case *multicastgroup.MulticastGroup:
+			multicastGroupObjects = append(multicastGroupObjects, object)
 		case *networkconnect.ClusterNetworkConnect:
 			networkConnectObjects = append(networkConnectObjects, object)
 		case *vtepv1.VTEP:
 			vtepObjects = append(vtepObjects, object)
+		case *uplinkv1alpha1.Uplink, *uplinkv1alpha1.UplinkState:
+			uplinkObjects = append(uplinkObjects, object)
 		default:
 			v1Objects = append(v1Objects, object)
 		}
@@ -126,8 +136,10 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 		RouteAdvertisementsClient: routeadvertisementsfake.NewSimpleClientset(raObjects...),
 		FRRClient:                 frrfake.NewSimpleClientset(frrObjects...),
 		NetworkQoSClient:          networkqosfake.NewSimpleClientset(networkQoSObjects...),
+		MulticastGroupClient:      multicastgroupfake.NewSimpleClientset(multicastGroupObjects...),
 		NetworkConnectClient:      networkconnectfake.NewSimpleClientset(networkConnectObjects...),
 		VTEPClient:                vtepfake.NewSimpleClientset(vtepObjects...),
+		UplinkClient:              uplinkfake.NewSimpleClientset(uplinkObjects...),
 	}
 }
 
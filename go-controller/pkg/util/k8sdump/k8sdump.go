@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package k8sdump reads JSON or YAML dumps of Kubernetes objects, e.g. to
+// troubleshoot a cluster offline.
+package k8sdump
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Read returns the objects of the given dump files, e.g. the output of
+// "kubectl get -A -o json pods,userdefinednetworks". The items of lists are
+// returned as individual objects, the objects without kind or name are skipped.
+func Read(paths ...string) ([]unstructured.Unstructured, error) {
+	objects := []unstructured.Unstructured{}
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		objects, err = decode(f, objects)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse kubernetes objects dump %s: %w", path, err)
+		}
+	}
+	return objects, nil
+}
+
+// decode appends the objects of a dump to objects
+func decode(r io.Reader, objects []unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		obj := map[string]any{}
+		if err := decoder.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objects, nil
+			}
+			return nil, err
+		}
+		objects = appendObject(objects, unstructured.Unstructured{Object: obj})
+	}
+}
+
+func appendObject(objects []unstructured.Unstructured, obj unstructured.Unstructured) []unstructured.Unstructured {
+	if obj.IsList() {
+		_ = obj.EachListItem(func(item runtime.Object) error {
+			if u, ok := item.(*unstructured.Unstructured); ok {
+				objects = appendObject(objects, *u)
+			}
+			return nil
+		})
+		return objects
+	}
+	if obj.GetKind() != "" && obj.GetName() != "" {
+		objects = append(objects, obj)
+	}
+	return objects
+}
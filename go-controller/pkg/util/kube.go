@@ -54,8 +54,10 @@ import (
 	egressipclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned"
 	egressqosclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned"
 	egressserviceclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned"
+	multicastgroupclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/multicastgroup/v1alpha1/apis/clientset/versioned"
 	networkqosclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/clientset/versioned"
 	routeadvertisementsclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned"
+	uplinkclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/clientset/versioned"
 	userdefinednetworkclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned"
 	vtepclientset "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/vtep/v1/apis/clientset/versioned"
 )
@@ -79,7 +81,9 @@ type OVNClientset struct {
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
 	FRRClient                 frrclientset.Interface
 	NetworkQoSClient          networkqosclientset.Interface
+	MulticastGroupClient      multicastgroupclientset.Interface
 	VTEPClient                vtepclientset.Interface
+	UplinkClient              uplinkclientset.Interface
 }
 
 // OVNKubeControllerClientset
@@ -98,8 +102,10 @@ type OVNKubeControllerClientset struct {
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
 	NetworkQoSClient          networkqosclientset.Interface
+	MulticastGroupClient      multicastgroupclientset.Interface
 	NetworkConnectClient      networkconnectclientset.Interface
 	VTEPClient                vtepclientset.Interface
+	UplinkClient              uplinkclientset.Interface
 }
 
 type OVNNodeClientset struct {
@@ -111,6 +117,7 @@ type OVNNodeClientset struct {
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
 	VTEPClient                vtepclientset.Interface
+	UplinkClient              uplinkclientset.Interface
 }
 
 type OVNClusterManagerClientset struct {
@@ -130,7 +137,9 @@ type OVNClusterManagerClientset struct {
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
 	FRRClient                 frrclientset.Interface
 	NetworkQoSClient          networkqosclientset.Interface
+	MulticastGroupClient      multicastgroupclientset.Interface
 	VTEPClient                vtepclientset.Interface
+	UplinkClient              uplinkclientset.Interface
 }
 
 const (
@@ -159,8 +168,10 @@ func (cs *OVNClientset) GetOVNKubeControllerClientset() *OVNKubeControllerClient
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
 		NetworkQoSClient:          cs.NetworkQoSClient,
+		MulticastGroupClient:      cs.MulticastGroupClient,
 		NetworkConnectClient:      cs.NetworkConnectClient,
 		VTEPClient:                cs.VTEPClient,
+		UplinkClient:              cs.UplinkClient,
 	}
 }
 
@@ -182,7 +193,9 @@ func (cs *OVNClientset) GetClusterManagerClientset() *OVNClusterManagerClientset
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
 		FRRClient:                 cs.FRRClient,
 		NetworkQoSClient:          cs.NetworkQoSClient,
+		MulticastGroupClient:      cs.MulticastGroupClient,
 		VTEPClient:                cs.VTEPClient,
+		UplinkClient:              cs.UplinkClient,
 	}
 }
 
@@ -196,6 +209,7 @@ func (cs *OVNClientset) GetNodeClientset() *OVNNodeClientset {
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
 		VTEPClient:                cs.VTEPClient,
+		UplinkClient:              cs.UplinkClient,
 	}
 }
 
@@ -488,11 +502,21 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		return nil, err
 	}
 
+	multicastGroupClientset, err := multicastgroupclientset.NewForConfig(kconfig)
+	if err != nil {
+		return nil, err
+	}
+
 	vtepClientset, err := vtepclientset.NewForConfig(kconfig)
 	if err != nil {
 		return nil, err
 	}
 
+	uplinkClientset, err := uplinkclientset.NewForConfig(kconfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return &OVNClientset{
 		KubeClient:                kclientset,
 		ANPClient:                 anpClientset,
@@ -511,7 +535,9 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		RouteAdvertisementsClient: routeAdvertisementsClientset,
 		FRRClient:                 frrClientset,
 		NetworkQoSClient:          networkqosClientset,
+		MulticastGroupClient:      multicastGroupClientset,
 		VTEPClient:                vtepClientset,
+		UplinkClient:              uplinkClientset,
 	}, nil
 }
 
@@ -603,6 +629,21 @@ func ServiceInternalTrafficPolicyLocal(service *corev1.Service) bool {
 	return service.Spec.InternalTrafficPolicy != nil && *service.Spec.InternalTrafficPolicy == corev1.ServiceInternalTrafficPolicyLocal
 }
 
+// ServiceTrafficDistributionTopologyAware returns true if the service requests topology aware routing
+// through spec.trafficDistribution (PreferClose, PreferSameZone or PreferSameNode).
+func ServiceTrafficDistributionTopologyAware(service *corev1.Service) bool {
+	if service == nil || service.Spec.TrafficDistribution == nil {
+		return false
+	}
+	switch *service.Spec.TrafficDistribution {
+	case corev1.ServiceTrafficDistributionPreferClose,
+		corev1.ServiceTrafficDistributionPreferSameZone,
+		corev1.ServiceTrafficDistributionPreferSameNode:
+		return true
+	}
+	return false
+}
+
 // GetClusterSubnetsWithHostPrefix returns the v4 and v6 cluster subnets, along with their host prefix,
 // in two separate slices
 func GetClusterSubnetsWithHostPrefix() ([]config.CIDRNetworkEntry, []config.CIDRNetworkEntry) {
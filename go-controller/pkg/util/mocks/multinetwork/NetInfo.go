@@ -11,6 +11,8 @@ import (
 
 	net "net"
 
+	types "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/cni/types"
+
 	util "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/util"
 )
 
@@ -37,6 +39,26 @@ func (_m *NetInfo) AllowsPersistentIPs() bool {
 	return r0
 }
 
+// DHCPOptions provides a mock function with no fields
+func (_m *NetInfo) DHCPOptions() *types.DHCPOptions {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DHCPOptions")
+	}
+
+	var r0 *types.DHCPOptions
+	if rf, ok := ret.Get(0).(func() *types.DHCPOptions); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.DHCPOptions)
+		}
+	}
+
+	return r0
+}
+
 // EVPNIPVRFRouteTarget provides a mock function with no fields
 func (_m *NetInfo) EVPNIPVRFRouteTarget() string {
 	ret := _m.Called()
@@ -965,6 +987,44 @@ func (_m *NetInfo) Transport() string {
 	return r0
 }
 
+// Uplink provides a mock function with no fields
+func (_m *NetInfo) Uplink() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Uplink")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// VLANTrunks provides a mock function with no fields
+func (_m *NetInfo) VLANTrunks() []uint {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VLANTrunks")
+	}
+
+	var r0 []uint
+	if rf, ok := ret.Get(0).(func() []uint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	return r0
+}
+
 // Vlan provides a mock function with no fields
 func (_m *NetInfo) Vlan() uint {
 	ret := _m.Called()
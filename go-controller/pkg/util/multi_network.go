@@ -57,10 +57,13 @@ type NetInfo interface {
 	JoinSubnets() []*net.IPNet
 	TransitSubnets() []*net.IPNet
 	Vlan() uint
+	VLANTrunks() []uint
 	AllowsPersistentIPs() bool
 	PhysicalNetworkName() string
 	Transport() string
 	OutboundSNAT() string
+	Uplink() string
+	DHCPOptions() *ovncnitypes.DHCPOptions
 	EVPNVTEPName() string
 	EVPNMACVRFVNI() int32
 	EVPNMACVRFRouteTarget() string
@@ -246,7 +249,7 @@ type mutableNetInfo struct {
 	podNetworkAdvertisements map[string][]string
 	eipAdvertisements        map[string][]string
 
-	// subnets can be added for Layer3 networks
+	// subnets can be added for Layer3 networks and Layer2 primary networks
 	subnets []config.CIDRNetworkEntry
 
 	// information generated from previous fields, not used in comparisons
@@ -661,6 +664,11 @@ func (nInfo *DefaultNetInfo) Vlan() uint {
 	return config.Gateway.VLANID
 }
 
+// VLANTrunks has no impact on defaultNetConfInfo (localnet feature)
+func (nInfo *DefaultNetInfo) VLANTrunks() []uint {
+	return nil
+}
+
 // AllowsPersistentIPs returns the defaultNetConfInfo's AllowPersistentIPs value
 func (nInfo *DefaultNetInfo) AllowsPersistentIPs() bool {
 	return false
@@ -681,6 +689,17 @@ func (nInfo *DefaultNetInfo) OutboundSNAT() string {
 	return config.NoOverlay.OutboundSNAT
 }
 
+// Uplink returns empty as the default network always uses the default external bridge
+func (nInfo *DefaultNetInfo) Uplink() string {
+	return ""
+}
+
+// DHCPOptions returns nil as the default network does not configure
+// additional DHCP options
+func (nInfo *DefaultNetInfo) DHCPOptions() *ovncnitypes.DHCPOptions {
+	return nil
+}
+
 // EVPNVTEPName returns empty as EVPN is not supported on the default network
 func (nInfo *DefaultNetInfo) EVPNVTEPName() string {
 	return ""
@@ -735,6 +754,7 @@ type userDefinedNetInfo struct {
 	topology           string
 	mtu                int
 	vlan               uint
+	vlanTrunks         []uint
 	allowPersistentIPs bool
 
 	ipv4mode, ipv6mode    bool
@@ -751,6 +771,8 @@ type userDefinedNetInfo struct {
 	transport    string
 	evpn         *ovncnitypes.EVPNConfig
 	outboundSNAT string
+	uplink       string
+	dhcpOptions  *ovncnitypes.DHCPOptions
 }
 
 func (nInfo *userDefinedNetInfo) GetNetInfo() NetInfo {
@@ -871,6 +893,11 @@ func (nInfo *userDefinedNetInfo) Vlan() uint {
 	return nInfo.vlan
 }
 
+// VLANTrunks returns the VLAN IDs whose tagged traffic is carried by the network
+func (nInfo *userDefinedNetInfo) VLANTrunks() []uint {
+	return nInfo.vlanTrunks
+}
+
 // AllowsPersistentIPs returns the defaultNetConfInfo's AllowPersistentIPs value
 func (nInfo *userDefinedNetInfo) AllowsPersistentIPs() bool {
 	return nInfo.allowPersistentIPs
@@ -891,6 +918,18 @@ func (nInfo *userDefinedNetInfo) OutboundSNAT() string {
 	return nInfo.outboundSNAT
 }
 
+// Uplink returns the name of the Uplink the network uses for its north/south
+// traffic, or empty if it uses the default external bridge
+func (nInfo *userDefinedNetInfo) Uplink() string {
+	return nInfo.uplink
+}
+
+// DHCPOptions returns the additional DHCP and IPv6 router advertisement
+// options served to KubeVirt virtual machines attached to the network
+func (nInfo *userDefinedNetInfo) DHCPOptions() *ovncnitypes.DHCPOptions {
+	return nInfo.dhcpOptions
+}
+
 // EVPNVTEPName returns the name of the VTEP CR for EVPN
 func (nInfo *userDefinedNetInfo) EVPNVTEPName() string {
 	if nInfo.evpn == nil {
@@ -947,30 +986,46 @@ func (nInfo *userDefinedNetInfo) EVPNIPVRFVID() int {
 	return nInfo.evpn.IPVRF.VID
 }
 
+// GetNodeGatewayIP returns the gateway IP for the provided host subnet. The
+// preconfigured default gateway IPs of layer2 primary networks only apply to
+// the subnet they belong to: subnets appended to the network later on get the
+// gateway IP they would get otherwise.
 func (nInfo *userDefinedNetInfo) GetNodeGatewayIP(hostSubnet *net.IPNet) *net.IPNet {
 	if IsPreconfiguredUDNAddressesEnabled() && nInfo.TopologyType() == types.Layer2Topology && nInfo.IsPrimaryNetwork() {
 		isIPV6 := knet.IsIPv6CIDR(hostSubnet)
 		gwIP, _ := MatchFirstIPFamily(isIPV6, nInfo.defaultGatewayIPs)
-		return &net.IPNet{
-			IP:   gwIP,
-			Mask: hostSubnet.Mask,
+		if !isAppendedSubnet(hostSubnet, gwIP) {
+			return &net.IPNet{
+				IP:   gwIP,
+				Mask: hostSubnet.Mask,
+			}
 		}
 	}
 	return GetNodeGatewayIfAddr(hostSubnet)
 }
 
+// GetNodeManagementIP returns the management port IP for the provided host
+// subnet, following the same rules as GetNodeGatewayIP.
 func (nInfo *userDefinedNetInfo) GetNodeManagementIP(hostSubnet *net.IPNet) *net.IPNet {
 	if IsPreconfiguredUDNAddressesEnabled() && nInfo.TopologyType() == types.Layer2Topology && nInfo.IsPrimaryNetwork() {
 		isIPV6 := knet.IsIPv6CIDR(hostSubnet)
 		mgmtIP, _ := MatchFirstIPFamily(isIPV6, nInfo.managementIPs)
-		return &net.IPNet{
-			IP:   mgmtIP,
-			Mask: hostSubnet.Mask,
+		if !isAppendedSubnet(hostSubnet, mgmtIP) {
+			return &net.IPNet{
+				IP:   mgmtIP,
+				Mask: hostSubnet.Mask,
+			}
 		}
 	}
 	return GetNodeManagementIfAddr(hostSubnet)
 }
 
+// isAppendedSubnet returns true if the preconfigured IP is set but does not
+// belong to hostSubnet, which means hostSubnet was appended to the network.
+func isAppendedSubnet(hostSubnet *net.IPNet, preconfiguredIP net.IP) bool {
+	return preconfiguredIP != nil && !hostSubnet.Contains(preconfiguredIP)
+}
+
 // IPMode returns the ipv4/ipv6 mode
 func (nInfo *userDefinedNetInfo) IPMode() (bool, bool) {
 	return nInfo.ipv4mode, nInfo.ipv6mode
@@ -1057,6 +1112,9 @@ func (nInfo *userDefinedNetInfo) canReconcile(other NetInfo) bool {
 	if nInfo.vlan != other.Vlan() {
 		return false
 	}
+	if !slices.Equal(nInfo.vlanTrunks, other.VLANTrunks()) {
+		return false
+	}
 	if nInfo.allowPersistentIPs != other.AllowsPersistentIPs() {
 		return false
 	}
@@ -1087,12 +1145,19 @@ func (nInfo *userDefinedNetInfo) canReconcile(other NetInfo) bool {
 	if nInfo.OutboundSNAT() != other.OutboundSNAT() {
 		return false
 	}
+	if nInfo.uplink != other.Uplink() {
+		return false
+	}
+	if !reflect.DeepEqual(nInfo.dhcpOptions, other.DHCPOptions()) {
+		return false
+	}
 
 	lessCIDRNetworkEntry := func(a, b config.CIDRNetworkEntry) bool { return a.String() < b.String() }
 	if !cmp.Equal(nInfo.Subnets(), other.Subnets(), cmpopts.SortSlices(lessCIDRNetworkEntry)) {
-		// For Layer3 topology, adding subnets is considered compatible and reconcilable
-		// CRD validation ensures only subnet additions are allowed
-		if nInfo.topology != types.Layer3Topology {
+		// For Layer3 topology and Layer2 primary networks, adding subnets is
+		// considered compatible and reconcilable. CRD validation ensures only
+		// subnet additions are allowed
+		if nInfo.topology != types.Layer3Topology && (nInfo.topology != types.Layer2Topology || !nInfo.primaryNetwork) {
 			return false
 		}
 	}
@@ -1121,6 +1186,7 @@ func (nInfo *userDefinedNetInfo) copy() *userDefinedNetInfo {
 		topology:              nInfo.topology,
 		mtu:                   nInfo.mtu,
 		vlan:                  nInfo.vlan,
+		vlanTrunks:            nInfo.vlanTrunks,
 		allowPersistentIPs:    nInfo.allowPersistentIPs,
 		ipv4mode:              nInfo.ipv4mode,
 		ipv6mode:              nInfo.ipv6mode,
@@ -1135,6 +1201,8 @@ func (nInfo *userDefinedNetInfo) copy() *userDefinedNetInfo {
 		transport:             nInfo.transport,
 		evpn:                  nInfo.evpn,
 		outboundSNAT:          nInfo.outboundSNAT,
+		uplink:                nInfo.uplink,
+		dhcpOptions:           nInfo.dhcpOptions,
 	}
 	// copy mutables
 	c.mutableNetInfo.copyFrom(&nInfo.mutableNetInfo)
@@ -1160,6 +1228,7 @@ func newLayer3NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		transport:      netconf.Transport,
 		evpn:           netconf.EVPN,
 		outboundSNAT:   netconf.OutboundSNAT,
+		uplink:         netconf.Uplink,
 		mutableNetInfo: mutableNetInfo{
 			id:      types.InvalidID,
 			nads:    sets.Set[string]{},
@@ -1237,6 +1306,8 @@ func newLayer2NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		managementIPs:         managementIPs,
 		transport:             netconf.Transport,
 		evpn:                  netconf.EVPN,
+		uplink:                netconf.Uplink,
+		dhcpOptions:           netconf.DHCPOptions,
 		mutableNetInfo: mutableNetInfo{
 			id:      types.InvalidID,
 			nads:    sets.Set[string]{},
@@ -1262,12 +1333,18 @@ func newLocalnetNetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error
 		return nil, err
 	}
 
+	var vlanTrunks []uint
+	for _, vid := range netconf.VLANTrunks {
+		vlanTrunks = append(vlanTrunks, uint(vid))
+	}
+
 	ni := &userDefinedNetInfo{
 		netName:             netconf.Name,
 		topology:            types.LocalnetTopology,
 		excludeSubnets:      excludes,
 		mtu:                 netconf.MTU,
 		vlan:                uint(netconf.VLANID),
+		vlanTrunks:          vlanTrunks,
 		allowPersistentIPs:  netconf.AllowPersistentIPs,
 		physicalNetworkName: netconf.PhysicalNetworkName,
 		mutableNetInfo: mutableNetInfo{
@@ -1590,6 +1667,25 @@ func ValidateNetConf(nadName string, netconf *ovncnitypes.NetConf) error {
 		}
 	}
 
+	if netconf.Uplink != "" {
+		if netconf.Role != types.NetworkRolePrimary ||
+			(netconf.Topology != types.Layer2Topology && netconf.Topology != types.Layer3Topology) {
+			return fmt.Errorf("uplink is only supported for layer2 and layer3 primary networks")
+		}
+		if netconf.Transport == types.NetworkTransportEVPN {
+			return fmt.Errorf("uplink is not supported with transport %q", types.NetworkTransportEVPN)
+		}
+	}
+
+	if netconf.DHCPOptions != nil {
+		if netconf.Role != types.NetworkRolePrimary || netconf.Topology != types.Layer2Topology {
+			return fmt.Errorf("dhcpOptions is only supported for layer2 primary networks")
+		}
+		if err := ValidateDHCPOptions(netconf.DHCPOptions); err != nil {
+			return fmt.Errorf("invalid dhcpOptions: %w", err)
+		}
+	}
+
 	if netconf.JoinSubnet != "" && netconf.Topology == types.LocalnetTopology {
 		return fmt.Errorf("localnet topology does not allow specifying join-subnet as services are not supported")
 	}
@@ -1610,6 +1706,15 @@ func ValidateNetConf(nadName string, netconf *ovncnitypes.NetConf) error {
 		return fmt.Errorf("defaultGatewayIPs is only supported for layer2 topology")
 	}
 
+	if len(netconf.VLANTrunks) > 0 {
+		if netconf.Topology != types.LocalnetTopology {
+			return fmt.Errorf("vlanTrunks is only supported for localnet topology")
+		}
+		if err := validateVLANTrunks(netconf.VLANTrunks, netconf.VLANID); err != nil {
+			return err
+		}
+	}
+
 	if netconf.TransitSubnet == "" && netconf.Role == types.NetworkRolePrimary && netconf.Topology == types.Layer2Topology {
 		klog.Warningf("transitSubnet is not specified for layer2 primary NAD %s, dynamic transit subnet will be used", netconf.Name)
 		if err := SetTransitSubnets(netconf); err != nil {
@@ -1626,6 +1731,24 @@ func ValidateNetConf(nadName string, netconf *ovncnitypes.NetConf) error {
 	return nil
 }
 
+// validateVLANTrunks checks the trunk VLAN IDs are valid and unique, and don't include the native VLAN ID.
+func validateVLANTrunks(vlanTrunks []int, nativeVLANID int) error {
+	seen := sets.New[int]()
+	for _, vid := range vlanTrunks {
+		if vid < 1 || vid > 4094 {
+			return fmt.Errorf("invalid vlanTrunks VLAN ID %d: must be between 1 and 4094", vid)
+		}
+		if seen.Has(vid) {
+			return fmt.Errorf("invalid vlanTrunks: duplicate VLAN ID %d", vid)
+		}
+		if vid == nativeVLANID {
+			return fmt.Errorf("invalid vlanTrunks: VLAN ID %d is the native vlanID", vid)
+		}
+		seen.Insert(vid)
+	}
+	return nil
+}
+
 // SubnetOverlapCheck validates whether user-configured networks (e.g. POD and join subnet) mentioned in
 // a net-attach-def with topology "layer2" and "layer3" overlaps with internal and reserved networks
 // (e.g. ClusterSubnets, ServiceCIDRs, join subnet, etc.).
@@ -1927,6 +2050,24 @@ func IsEVPNEnabled() bool {
 	return IsRouteAdvertisementsEnabled() && config.Gateway.Mode == config.GatewayModeLocal && config.OVNKubernetesFeature.EnableEVPN
 }
 
+// IsUplinkEnabled indicates if the Uplink API is enabled. Networks can only
+// use uplinks in shared gateway mode, see IsUplinkSupported.
+func IsUplinkEnabled() bool {
+	return IsNetworkSegmentationSupportEnabled() && config.OVNKubernetesFeature.EnableUplink
+}
+
+// IsUplinkSupported indicates if networks can use the uplinks they reference
+// with the current gateway mode.
+func IsUplinkSupported() bool {
+	return IsUplinkEnabled() && config.Gateway.Mode == config.GatewayModeShared
+}
+
+// UsesUplink indicates if the north/south traffic of the network goes through
+// the Uplink it references instead of the default external bridge.
+func UsesUplink(netInfo NetInfo) bool {
+	return netInfo.Uplink() != "" && IsUplinkSupported()
+}
+
 // IsPreconfiguredUDNAddressesEnabled indicates if user defined IPs / MAC
 // addresses can be set in primary UDNs
 func IsPreconfiguredUDNAddressesEnabled() bool {
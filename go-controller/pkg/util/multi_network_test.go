@@ -330,6 +330,81 @@ func TestParseNetconf(t *testing.T) {
 				NetConf:  cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
 			},
 		},
+		{
+			desc: "valid attachment definition for a localnet topology with trunk VLANs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+            "vlanID": 10,
+            "vlanTrunks": [100, 200],
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:   "localnet",
+				NADName:    "ns1/nad1",
+				MTU:        1400,
+				VLANID:     10,
+				VLANTrunks: []int{100, 200},
+				NetConf:    cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "invalid attachment definition for a localnet topology with duplicate trunk VLANs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+            "vlanTrunks": [100, 100],
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("invalid vlanTrunks: duplicate VLAN ID 100"),
+		},
+		{
+			desc: "invalid attachment definition for a localnet topology with the native VLAN in the trunk VLANs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+            "vlanID": 100,
+            "vlanTrunks": [100, 200],
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("invalid vlanTrunks: VLAN ID 100 is the native vlanID"),
+		},
+		{
+			desc: "invalid attachment definition for a localnet topology with an out of range trunk VLAN",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+            "vlanTrunks": [4095],
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("invalid vlanTrunks VLAN ID 4095: must be between 1 and 4094"),
+		},
+		{
+			desc: "invalid attachment definition for a layer2 topology with trunk VLANs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+            "subnets": "192.168.200.0/16",
+            "vlanTrunks": [100],
+            "netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("vlanTrunks is only supported for localnet topology"),
+		},
 		{
 			desc: "valid attachment definition for the default network",
 			inputNetAttachDefConfigSpec: `
@@ -1827,6 +1902,142 @@ func TestValidateNetConfOutboundSNAT(t *testing.T) {
 	}
 }
 
+func TestValidateNetConfDHCPOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		topology      string
+		role          string
+		dhcpOptions   *ovncnitypes.DHCPOptions
+		expectedError string
+	}{
+		{
+			name:     "valid options are accepted for layer2 primary networks",
+			topology: ovntypes.Layer2Topology,
+			role:     ovntypes.NetworkRolePrimary,
+			dhcpOptions: &ovncnitypes.DHCPOptions{
+				DomainSearch: []string{"example.com"},
+				DNSServers:   []string{"10.1.1.1", "fd00::53"},
+				NTPServers:   []string{"10.1.1.2"},
+				Routes: []ovncnitypes.DHCPRoute{
+					{Destination: "10.2.0.0/16", NextHop: "10.0.0.254"},
+					{Destination: "fd00:10::/64"},
+				},
+				ExtraOptions: []ovncnitypes.DHCPExtraOption{
+					{Name: "BootFileName", Value: "pxelinux.0"},
+					{Name: "TFTPServerAddress", Value: "10.1.1.3"},
+				},
+			},
+		},
+		{
+			name:          "options are rejected for layer2 secondary networks",
+			topology:      ovntypes.Layer2Topology,
+			role:          ovntypes.NetworkRoleSecondary,
+			dhcpOptions:   &ovncnitypes.DHCPOptions{DomainSearch: []string{"example.com"}},
+			expectedError: "dhcpOptions is only supported for layer2 primary networks",
+		},
+		{
+			name:          "options are rejected for layer3 primary networks",
+			topology:      ovntypes.Layer3Topology,
+			role:          ovntypes.NetworkRolePrimary,
+			dhcpOptions:   &ovncnitypes.DHCPOptions{DomainSearch: []string{"example.com"}},
+			expectedError: "dhcpOptions is only supported for layer2 primary networks",
+		},
+		{
+			name:          "invalid search domains are rejected",
+			topology:      ovntypes.Layer2Topology,
+			role:          ovntypes.NetworkRolePrimary,
+			dhcpOptions:   &ovncnitypes.DHCPOptions{DomainSearch: []string{"Example_com"}},
+			expectedError: `invalid search domain "Example_com"`,
+		},
+		{
+			name:          "IPv6 NTP servers are rejected",
+			topology:      ovntypes.Layer2Topology,
+			role:          ovntypes.NetworkRolePrimary,
+			dhcpOptions:   &ovncnitypes.DHCPOptions{NTPServers: []string{"fd00::123"}},
+			expectedError: `invalid NTP server "fd00::123"`,
+		},
+		{
+			name:          "IPv4 routes without next hop are rejected",
+			topology:      ovntypes.Layer2Topology,
+			role:          ovntypes.NetworkRolePrimary,
+			dhcpOptions:   &ovncnitypes.DHCPOptions{Routes: []ovncnitypes.DHCPRoute{{Destination: "10.2.0.0/16"}}},
+			expectedError: `next hop "" must be an IPv4 address`,
+		},
+		{
+			name:     "IPv6 routes with next hop are rejected",
+			topology: ovntypes.Layer2Topology,
+			role:     ovntypes.NetworkRolePrimary,
+			dhcpOptions: &ovncnitypes.DHCPOptions{Routes: []ovncnitypes.DHCPRoute{
+				{Destination: "fd00:10::/64", NextHop: "fd00::1"},
+			}},
+			expectedError: "IPv6 routes are advertised through the network gateway",
+		},
+		{
+			name:     "managed options are rejected",
+			topology: ovntypes.Layer2Topology,
+			role:     ovntypes.NetworkRolePrimary,
+			dhcpOptions: &ovncnitypes.DHCPOptions{ExtraOptions: []ovncnitypes.DHCPExtraOption{
+				{Name: "router", Value: "10.0.0.1"},
+			}},
+			expectedError: `unsupported extra option "router"`,
+		},
+		{
+			name:     "duplicate options are rejected",
+			topology: ovntypes.Layer2Topology,
+			role:     ovntypes.NetworkRolePrimary,
+			dhcpOptions: &ovncnitypes.DHCPOptions{ExtraOptions: []ovncnitypes.DHCPExtraOption{
+				{Name: "BootFileName", Value: "pxelinux.0"},
+				{Name: "BootFileName", Value: "ipxe.efi"},
+			}},
+			expectedError: `duplicate extra option "BootFileName"`,
+		},
+		{
+			name:     "quoted option values are rejected",
+			topology: ovntypes.Layer2Topology,
+			role:     ovntypes.NetworkRolePrimary,
+			dhcpOptions: &ovncnitypes.DHCPOptions{ExtraOptions: []ovncnitypes.DHCPExtraOption{
+				{Name: "BootFileName", Value: `pxe"linux`},
+			}},
+			expectedError: "quotes, backslashes and control characters are not allowed",
+		},
+		{
+			name:     "non IPv4 values of address options are rejected",
+			topology: ovntypes.Layer2Topology,
+			role:     ovntypes.NetworkRolePrimary,
+			dhcpOptions: &ovncnitypes.DHCPOptions{ExtraOptions: []ovncnitypes.DHCPExtraOption{
+				{Name: "NextServer", Value: "boot.example.com"},
+			}},
+			expectedError: `invalid value "boot.example.com" for extra option "NextServer"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			config.IPv4Mode = true
+			nadName := "namespace/network"
+			netconf := &ovncnitypes.NetConf{
+				NetConf: cnitypes.NetConf{
+					Name: "network",
+				},
+				NADName:       nadName,
+				Topology:      test.topology,
+				Role:          test.role,
+				Subnets:       "10.0.0.0/24",
+				TransitSubnet: "100.88.0.0/16",
+				DHCPOptions:   test.dhcpOptions,
+			}
+
+			err := ValidateNetConf(nadName, netconf)
+			if test.expectedError != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(test.expectedError)))
+			} else {
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestNewNetInfo(t *testing.T) {
 	type testConfig struct {
 		desc          string
@@ -1933,6 +2144,51 @@ func TestAreNetworksCompatible(t *testing.T) {
 			expectedResult:         true,
 			expectationDescription: "networks with no EVPN config should be compatible",
 		},
+		{
+			desc: "subnets appended to a layer2 primary network",
+			aNetwork: &userDefinedNetInfo{
+				topology:       ovntypes.Layer2Topology,
+				primaryNetwork: true,
+				mutableNetInfo: mutableNetInfo{subnets: []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.0.0.0/24")}}},
+			},
+			anotherNetwork: &userDefinedNetInfo{
+				topology:       ovntypes.Layer2Topology,
+				primaryNetwork: true,
+				mutableNetInfo: mutableNetInfo{subnets: []config.CIDRNetworkEntry{
+					{CIDR: ovntest.MustParseIPNet("10.0.0.0/24")},
+					{CIDR: ovntest.MustParseIPNet("10.0.1.0/24")},
+				}},
+			},
+			expectedResult:         true,
+			expectationDescription: "we should reconcile subnets appended to layer2 primary networks",
+		},
+		{
+			desc: "subnets appended to a layer2 secondary network",
+			aNetwork: &userDefinedNetInfo{
+				topology:       ovntypes.Layer2Topology,
+				mutableNetInfo: mutableNetInfo{subnets: []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.0.0.0/24")}}},
+			},
+			anotherNetwork: &userDefinedNetInfo{
+				topology: ovntypes.Layer2Topology,
+				mutableNetInfo: mutableNetInfo{subnets: []config.CIDRNetworkEntry{
+					{CIDR: ovntest.MustParseIPNet("10.0.0.0/24")},
+					{CIDR: ovntest.MustParseIPNet("10.0.1.0/24")},
+				}},
+			},
+			expectedResult:         false,
+			expectationDescription: "we should re-create layer2 secondary networks on subnet updates",
+		},
+		{
+			desc: "dhcp options update",
+			aNetwork: &userDefinedNetInfo{
+				dhcpOptions: &ovncnitypes.DHCPOptions{DomainSearch: []string{"example.com"}},
+			},
+			anotherNetwork: &userDefinedNetInfo{
+				dhcpOptions: &ovncnitypes.DHCPOptions{DomainSearch: []string{"example.org"}},
+			},
+			expectedResult:         false,
+			expectationDescription: "we should re-create networks on dhcp options updates",
+		},
 	}
 
 	for _, test := range tests {
@@ -2005,6 +2261,18 @@ func TestGetNodeManagementIP(t *testing.T) {
 			hostSubnet: "10.0.0.0/24",
 			expectedIP: ovntest.MustParseIPNet("10.0.0.5/24"),
 		},
+		{
+			name: "Layer2 primary UDN with infrastructure subnets should return traditional .2 address for appended subnets",
+			netConf: &ovncnitypes.NetConf{
+				NetConf:               cnitypes.NetConf{Name: "l2-network"},
+				Topology:              ovntypes.Layer2Topology,
+				Role:                  ovntypes.NetworkRolePrimary,
+				Subnets:               "10.0.0.0/24,10.0.1.0/24",
+				InfrastructureSubnets: "10.0.0.4/30",
+			},
+			hostSubnet: "10.0.1.0/24",
+			expectedIP: ovntest.MustParseIPNet("10.0.1.2/24"),
+		},
 		{
 			name: "Layer2 primary UDN with infrastructure subnets should allocate from infrastructure subnet skipping the broadcast IP",
 			netConf: &ovncnitypes.NetConf{
@@ -2421,6 +2689,18 @@ func TestGetNodeGatewayIP(t *testing.T) {
 			hostSubnet: "10.0.0.0/24",
 			expectedIP: ovntest.MustParseIPNet("10.0.0.5/24"),
 		},
+		{
+			name: "Layer2 primary UDN with custom default gateway IP should return traditional .1 address for appended subnets",
+			netConf: &ovncnitypes.NetConf{
+				NetConf:           cnitypes.NetConf{Name: "l2-network"},
+				Topology:          ovntypes.Layer2Topology,
+				Role:              ovntypes.NetworkRolePrimary,
+				DefaultGatewayIPs: "10.0.0.5",
+				Subnets:           "10.0.0.0/24,10.0.1.0/24",
+			},
+			hostSubnet: "10.0.1.0/24",
+			expectedIP: ovntest.MustParseIPNet("10.0.1.1/24"),
+		},
 		{
 			name: "Layer2 primary UDN with infrastructure subnets should allocate the first usable IP from infrastructure subnet",
 			netConf: &ovncnitypes.NetConf{
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinklisters "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/listers/uplink/v1alpha1"
+)
+
+const (
+	// UplinkLabel and UplinkNodeLabel are the lookup labels set on
+	// UplinkState objects. Their values are the Uplink and node names, or a
+	// stable hash of them when the names do not fit a label value.
+	UplinkLabel     = "k8s.ovn.org/uplink"
+	UplinkNodeLabel = "k8s.ovn.org/node"
+	// UplinkAnnotation and UplinkNodeAnnotation hold the full Uplink and node
+	// names on UplinkState objects, so that the identity is available even
+	// before status is set.
+	UplinkAnnotation     = "k8s.ovn.org/uplink"
+	UplinkNodeAnnotation = "k8s.ovn.org/node"
+
+	uplinkStateHashLength = 16
+)
+
+func uplinkHash(uplinkName, nodeName string) string {
+	sum := sha256.Sum256([]byte(uplinkName + "/" + nodeName))
+	return hex.EncodeToString(sum[:])[:uplinkStateHashLength]
+}
+
+func uplinkNamePrefix(name string, length int) string {
+	if len(name) > length {
+		name = name[:length]
+	}
+	return strings.TrimRight(name, ".-")
+}
+
+// GetUplinkStateName returns the name of the UplinkState object of the given
+// Uplink and node. It is <uplink>.<node> when that fits an object name,
+// otherwise truncated prefixes of both names followed by a stable hash of the
+// pair.
+func GetUplinkStateName(uplinkName, nodeName string) string {
+	name := uplinkName + "." + nodeName
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+	prefixLength := (validation.DNS1123SubdomainMaxLength - uplinkStateHashLength - 2) / 2
+	return fmt.Sprintf("%s.%s.%s",
+		uplinkNamePrefix(uplinkName, prefixLength),
+		uplinkNamePrefix(nodeName, prefixLength),
+		uplinkHash(uplinkName, nodeName),
+	)
+}
+
+// GetUplinkStateLabelValue returns the label value used to look up
+// UplinkState objects by Uplink or node name.
+func GetUplinkStateLabelValue(name string) string {
+	if len(validation.IsValidLabelValue(name)) == 0 {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(sum[:])[:uplinkStateHashLength]
+}
+
+// GetUplinkStateLabels returns the lookup labels of the UplinkState object of
+// the given Uplink and node.
+func GetUplinkStateLabels(uplinkName, nodeName string) map[string]string {
+	return map[string]string{
+		UplinkLabel:     GetUplinkStateLabelValue(uplinkName),
+		UplinkNodeLabel: GetUplinkStateLabelValue(nodeName),
+	}
+}
+
+// GetUplinkStateIdentity returns the Uplink and node names an UplinkState
+// belongs to, from its status or, when status is not yet set, from its
+// annotations.
+func GetUplinkStateIdentity(state *uplinkv1alpha1.UplinkState) (string, string) {
+	if state.Status.UplinkName != "" && state.Status.NodeName != "" {
+		return state.Status.UplinkName, state.Status.NodeName
+	}
+	return state.Annotations[UplinkAnnotation], state.Annotations[UplinkNodeAnnotation]
+}
+
+// GetUplinkStatesForUplink returns all UplinkState objects of the given Uplink.
+func GetUplinkStatesForUplink(lister uplinklisters.UplinkStateLister, uplinkName string) ([]*uplinkv1alpha1.UplinkState, error) {
+	selector := labels.SelectorFromSet(labels.Set{UplinkLabel: GetUplinkStateLabelValue(uplinkName)})
+	states, err := lister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	matching := make([]*uplinkv1alpha1.UplinkState, 0, len(states))
+	for _, state := range states {
+		if uplink, _ := GetUplinkStateIdentity(state); uplink == uplinkName {
+			matching = append(matching, state)
+		}
+	}
+	return matching, nil
+}
+
+// GetUplinkStateForNode returns the UplinkState of the given Uplink and node,
+// or nil if there is none.
+func GetUplinkStateForNode(lister uplinklisters.UplinkStateLister, uplinkName, nodeName string) (*uplinkv1alpha1.UplinkState, error) {
+	states, err := lister.List(labels.SelectorFromSet(GetUplinkStateLabels(uplinkName, nodeName)))
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		if uplink, node := GetUplinkStateIdentity(state); uplink == uplinkName && node == nodeName {
+			return state, nil
+		}
+	}
+	return nil, nil
+}
+
+// IsUplinkStateReady returns true if the UplinkState reports discovery
+// completed with the data needed to configure a gateway on it.
+func IsUplinkStateReady(state *uplinkv1alpha1.UplinkState) bool {
+	if state == nil || state.Status.OVSBridge == nil || state.Status.OVSBridge.Name == "" ||
+		state.Status.MACAddress == "" || len(state.Status.IPAddresses) == 0 {
+		return false
+	}
+	return meta.IsStatusConditionTrue(state.Status.Conditions, uplinkv1alpha1.UplinkStateReady)
+}
+
+// GetUplinkStateReadyReason returns the reason of the Ready condition of the
+// UplinkState, or empty if it has none.
+func GetUplinkStateReadyReason(state *uplinkv1alpha1.UplinkState) string {
+	if state == nil {
+		return ""
+	}
+	condition := meta.FindStatusCondition(state.Status.Conditions, uplinkv1alpha1.UplinkStateReady)
+	if condition == nil {
+		return ""
+	}
+	return condition.Reason
+}
+
+// MatchingUplinkNodeConfigs returns the node configs of the Uplink that select
+// the node.
+func MatchingUplinkNodeConfigs(uplink *uplinkv1alpha1.Uplink, node *corev1.Node) ([]uplinkv1alpha1.UplinkNodeConfig, error) {
+	var matching []uplinkv1alpha1.UplinkNodeConfig
+	for i := range uplink.Spec.NodeConfigs {
+		nodeConfig := uplink.Spec.NodeConfigs[i]
+		selector, err := metav1.LabelSelectorAsSelector(&nodeConfig.NodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node selector in node config %d of uplink %s: %w", i, uplink.Name, err)
+		}
+		if selector.Matches(labels.Set(node.Labels)) {
+			matching = append(matching, nodeConfig)
+		}
+	}
+	return matching, nil
+}
+
+// ParseUplinkStateGateway returns the gateway IP addresses and next hops
+// reported in the UplinkState.
+func ParseUplinkStateGateway(state *uplinkv1alpha1.UplinkState) ([]*net.IPNet, []net.IP, error) {
+	ips, err := ParseIPNets(state.Status.IPAddresses)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid IP addresses in uplink state %s: %w", state.Name, err)
+	}
+	nextHops := make([]net.IP, 0, len(state.Status.DefaultGateways))
+	for _, gw := range state.Status.DefaultGateways {
+		ip := net.ParseIP(gw)
+		if ip == nil {
+			return nil, nil, fmt.Errorf("invalid default gateway %q in uplink state %s", gw, state.Name)
+		}
+		nextHops = append(nextHops, ip)
+	}
+	return ips, nextHops, nil
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+
+	uplinkv1alpha1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1"
+	uplinklisters "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/uplink/v1alpha1/apis/listers/uplink/v1alpha1"
+)
+
+func TestGetUplinkStateName(t *testing.T) {
+	if got := GetUplinkStateName("blue", "worker-a"); got != "blue.worker-a" {
+		t.Errorf("expected blue.worker-a, got %s", got)
+	}
+
+	longUplink := strings.Repeat("u", 200)
+	longNode := strings.Repeat("n", 200)
+	got := GetUplinkStateName(longUplink, longNode)
+	if errs := validation.IsDNS1123Subdomain(got); len(errs) > 0 {
+		t.Errorf("expected a valid object name, got %s: %v", got, errs)
+	}
+	if got != GetUplinkStateName(longUplink, longNode) {
+		t.Errorf("expected a stable name")
+	}
+	if got == GetUplinkStateName(longUplink, longNode+"x") {
+		t.Errorf("expected different nodes to get different names")
+	}
+}
+
+func TestGetUplinkStateLabelValue(t *testing.T) {
+	if got := GetUplinkStateLabelValue("worker-a"); got != "worker-a" {
+		t.Errorf("expected worker-a, got %s", got)
+	}
+	got := GetUplinkStateLabelValue(strings.Repeat("n", 100))
+	if errs := validation.IsValidLabelValue(got); len(errs) > 0 {
+		t.Errorf("expected a valid label value, got %s: %v", got, errs)
+	}
+}
+
+func TestGetUplinkStateForNode(t *testing.T) {
+	newState := func(uplink, node string, setStatus bool) *uplinkv1alpha1.UplinkState {
+		state := &uplinkv1alpha1.UplinkState{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        GetUplinkStateName(uplink, node),
+				Labels:      GetUplinkStateLabels(uplink, node),
+				Annotations: map[string]string{UplinkAnnotation: uplink, UplinkNodeAnnotation: node},
+			},
+		}
+		if setStatus {
+			state.Status.UplinkName = uplink
+			state.Status.NodeName = node
+		}
+		return state
+	}
+	longNode := strings.Repeat("n", 100)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, state := range []*uplinkv1alpha1.UplinkState{
+		newState("blue", "worker-a", true),
+		newState("blue", "worker-b", false),
+		newState("red", "worker-a", true),
+		newState("blue", longNode, true),
+	} {
+		if err := indexer.Add(state); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lister := uplinklisters.NewUplinkStateLister(indexer)
+
+	tests := []struct {
+		uplink, node string
+		want         string
+	}{
+		{uplink: "blue", node: "worker-a", want: "blue.worker-a"},
+		{uplink: "blue", node: "worker-b", want: "blue.worker-b"},
+		{uplink: "red", node: "worker-b"},
+		{uplink: "blue", node: longNode, want: GetUplinkStateName("blue", longNode)},
+	}
+	for _, tt := range tests {
+		state, err := GetUplinkStateForNode(lister, tt.uplink, tt.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.want == "" {
+			if state != nil {
+				t.Errorf("%s/%s: expected no state, got %s", tt.uplink, tt.node, state.Name)
+			}
+			continue
+		}
+		if state == nil || state.Name != tt.want {
+			t.Errorf("%s/%s: expected state %s, got %v", tt.uplink, tt.node, tt.want, state)
+		}
+	}
+
+	states, err := GetUplinkStatesForUplink(lister, "blue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(states) != 3 {
+		t.Errorf("expected 3 states for uplink blue, got %d", len(states))
+	}
+}
+
+func TestMatchingUplinkNodeConfigs(t *testing.T) {
+	uplink := &uplinkv1alpha1.Uplink{
+		ObjectMeta: metav1.ObjectMeta{Name: "blue"},
+		Spec: uplinkv1alpha1.UplinkSpec{
+			NodeConfigs: []uplinkv1alpha1.UplinkNodeConfig{
+				{
+					Type:              uplinkv1alpha1.UplinkTypeOVSBridge,
+					NodeSelector:      metav1.LabelSelector{MatchLabels: map[string]string{"pool": "a"}},
+					HostInterfaceName: "br-blue",
+				},
+				{
+					Type:              uplinkv1alpha1.UplinkTypeOVSBridge,
+					NodeSelector:      metav1.LabelSelector{MatchLabels: map[string]string{"rack": "1"}},
+					HostInterfaceName: "br-blue1",
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   int
+	}{
+		{name: "no match", labels: map[string]string{"pool": "b"}, want: 0},
+		{name: "single match", labels: map[string]string{"pool": "a"}, want: 1},
+		{name: "overlap", labels: map[string]string{"pool": "a", "rack": "1"}, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: tt.labels}}
+			got, err := MatchingUplinkNodeConfigs(uplink, node)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("expected %d matching node configs, got %d", tt.want, len(got))
+			}
+		})
+	}
+}
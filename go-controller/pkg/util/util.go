@@ -914,6 +914,136 @@ func GetEndpointsForService(endpointSlices []*discoveryv1.EndpointSlice, service
 	return globalEndpoints, localEndpoints, errors.Join(validationErrors...)
 }
 
+// GetTopologyEndpointsForService extracts, for every node in nodeZones, the endpoints that traffic
+// originating on that node should be load balanced to according to the EndpointSlice topology hints.
+// It mirrors the kube-proxy logic used for spec.trafficDistribution:
+//   - if every ready endpoint has node hints and at least one is hinted for the node, only those are used;
+//   - else, if every ready endpoint has zone hints and at least one is hinted for the node's zone,
+//     only the endpoints hinted for that zone are used;
+//   - otherwise the node is omitted from the result and callers must fall back to all cluster endpoints.
+//
+// Parameters:
+//   - slices: EndpointSlices associated with the service
+//   - service: The Kubernetes Service object, must not be nil
+//   - nodeZones: node name -> topology zone (topology.kubernetes.io/zone label) of the nodes in the OVN zone
+//
+// Example output:
+//
+//	{"TCP/http": {"node1": {Port: 8080, V4IPs: ["192.168.1.10"]}}}
+func GetTopologyEndpointsForService(endpointSlices []*discoveryv1.EndpointSlice, service *corev1.Service,
+	nodeZones map[string]string) PortToNodeToLBEndpoints {
+
+	topologyEndpoints := make(PortToNodeToLBEndpoints)
+
+	validServicePortKeys := map[string]bool{}
+	for _, servicePort := range service.Spec.Ports {
+		validServicePortKeys[GetServicePortKey(servicePort.Protocol, servicePort.Name)] = true
+	}
+
+	for portName, protocolMap := range newTargetEndpoints(endpointSlices) {
+		for protocol, portNumberMap := range protocolMap {
+			slicePortKey := GetServicePortKey(protocol, portName)
+			if !validServicePortKeys[slicePortKey] {
+				continue
+			}
+
+			// The topology mode is decided over all the endpoints of the service port, regardless
+			// of the target port number they expose.
+			portNumbers := maps.Keys(portNumberMap)
+			slices.Sort(portNumbers)
+			var allEndpoints []discoveryv1.Endpoint
+			for _, targetPortNumber := range portNumbers {
+				allEndpoints = append(allEndpoints, portNumberMap[targetPortNumber]...)
+			}
+
+			for node, zone := range nodeZones {
+				mode := topologyModeFromHints(allEndpoints, node, zone)
+				if mode == "" {
+					continue
+				}
+				for _, targetPortNumber := range portNumbers {
+					var endpoints []discoveryv1.Endpoint
+					for _, endpoint := range portNumberMap[targetPortNumber] {
+						if availableForTopology(endpoint, mode, node, zone) {
+							endpoints = append(endpoints, endpoint)
+						}
+					}
+					if len(endpoints) == 0 {
+						continue
+					}
+					entry, err := buildLBEndpointEntry(service, targetPortNumber, endpoints)
+					if err != nil {
+						klog.V(5).Infof("Failed to build topology endpoints for node %s port %s/%d: %v",
+							node, slicePortKey, targetPortNumber, err)
+						continue
+					}
+					if topologyEndpoints[slicePortKey] == nil {
+						topologyEndpoints[slicePortKey] = map[string]LBEndpoints{}
+					}
+					topologyEndpoints[slicePortKey][node] = append(topologyEndpoints[slicePortKey][node], entry)
+				}
+			}
+		}
+	}
+
+	klog.V(5).Infof("Topology endpoints for %s/%s: %v", service.Namespace, service.Name, topologyEndpoints)
+	return topologyEndpoints
+}
+
+// topologyModeFromHints returns the topology mode (PreferSameNode or PreferSameZone) that applies to
+// traffic originating on the given node, or an empty string if the endpoint hints cannot be honored
+// and all endpoints must be used.
+func topologyModeFromHints(endpoints []discoveryv1.Endpoint, nodeName, zone string) string {
+	hasEndpointForNode := false
+	allEndpointsHaveNodeHints := true
+	hasEndpointForZone := false
+	allEndpointsHaveZoneHints := true
+	hasReadyEndpoints := false
+
+	for _, endpoint := range endpoints {
+		if !IsEndpointReady(endpoint) {
+			continue
+		}
+		hasReadyEndpoints = true
+		if endpoint.Hints == nil || len(endpoint.Hints.ForNodes) == 0 {
+			allEndpointsHaveNodeHints = false
+		} else if slices.ContainsFunc(endpoint.Hints.ForNodes, func(n discoveryv1.ForNode) bool { return n.Name == nodeName }) {
+			hasEndpointForNode = true
+		}
+		if endpoint.Hints == nil || len(endpoint.Hints.ForZones) == 0 {
+			allEndpointsHaveZoneHints = false
+		} else if zone != "" && slices.ContainsFunc(endpoint.Hints.ForZones, func(z discoveryv1.ForZone) bool { return z.Name == zone }) {
+			hasEndpointForZone = true
+		}
+	}
+
+	if !hasReadyEndpoints {
+		return ""
+	}
+	if allEndpointsHaveNodeHints && hasEndpointForNode {
+		return corev1.ServiceTrafficDistributionPreferSameNode
+	}
+	if allEndpointsHaveZoneHints && hasEndpointForZone {
+		return corev1.ServiceTrafficDistributionPreferSameZone
+	}
+	return ""
+}
+
+// availableForTopology returns true if the endpoint can be used for traffic originating on the given
+// node and zone with the given topology mode.
+func availableForTopology(endpoint discoveryv1.Endpoint, mode, nodeName, zone string) bool {
+	if endpoint.Hints == nil {
+		return false
+	}
+	switch mode {
+	case corev1.ServiceTrafficDistributionPreferSameNode:
+		return slices.ContainsFunc(endpoint.Hints.ForNodes, func(n discoveryv1.ForNode) bool { return n.Name == nodeName })
+	case corev1.ServiceTrafficDistributionPreferSameZone:
+		return slices.ContainsFunc(endpoint.Hints.ForZones, func(z discoveryv1.ForZone) bool { return z.Name == zone })
+	}
+	return true
+}
+
 // FindServicePortForEndpointSlicePort returns the ServicePort that corresponds to an EndpointSlice port
 // by matching the port name and protocol. This is the canonical way to map EndpointSlice ports to
 // Service ports, as Kubernetes guarantees that ServicePort.Name matches EndpointPort.Name.
@@ -38,6 +38,7 @@ var _ = Describe("Network Segmentation: API validations", feature.NetworkSegment
 		Entry("UserDefinedNetwork, layer2", testscenariocudn.Layer2UDNInvalid),
 		Entry("ClusterUserDefinedNetwork, no-overlay, invalid", testscenariocudn.NoOverlayInvalid),
 		Entry("ClusterUserDefinedNetwork, layer3, multi-subnets", testscenariocudn.Layer3InvalidSubnets),
+		Entry("ClusterUserDefinedNetwork, layer2, multi-subnets", testscenariocudn.Layer2InvalidSubnets),
 	)
 
 	DescribeTable("api-server should accept valid CRs",
@@ -57,6 +58,7 @@ var _ = Describe("Network Segmentation: API validations", feature.NetworkSegment
 		Entry("UserDefinedNetwork, layer2", testscenariocudn.Layer2UDNValid),
 		Entry("ClusterUserDefinedNetwork, no-overlay, valid", testscenariocudn.NoOverlayValid),
 		Entry("ClusterUserDefinedNetwork, layer3, multi-subnets", testscenariocudn.Layer3ValidSubnets),
+		Entry("ClusterUserDefinedNetwork, layer2, multi-subnets", testscenariocudn.Layer2ValidSubnets),
 	)
 })
 
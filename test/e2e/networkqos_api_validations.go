@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	e2ekubectl "k8s.io/kubernetes/test/e2e/framework/kubectl"
+
+	"github.com/ovn-kubernetes/ovn-kubernetes/test/e2e/feature"
+	"github.com/ovn-kubernetes/ovn-kubernetes/test/e2e/testscenario"
+	testscenarionqos "github.com/ovn-kubernetes/ovn-kubernetes/test/e2e/testscenario/networkqos"
+)
+
+var _ = Describe("NetworkQoS: API validations", feature.NetworkQos, func() {
+	DescribeTable("api-server should reject invalid NetworkQoS CRs",
+		func(scenarios []testscenario.ValidateCRScenario) {
+			DeferCleanup(func() {
+				for _, s := range scenarios {
+					e2ekubectl.RunKubectlInput("", s.Manifest, "delete", "--ignore-not-found", "-f", "-")
+				}
+			})
+			for _, s := range scenarios {
+				By(s.Description)
+				_, stderr, err := runKubectlInputWithFullOutput("", s.Manifest, "create", "-f", "-")
+				Expect(err).To(HaveOccurred(), "should fail to create invalid NetworkQoS CR")
+				Expect(stderr).To(ContainSubstring(s.ExpectedErr))
+			}
+		},
+		Entry("Missing rules", testscenarionqos.InvalidScenarios),
+	)
+})
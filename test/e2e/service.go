@@ -415,7 +415,7 @@ var _ = ginkgo.Describe("Services", feature.Service, func() {
 							if isLocalGWModeEnabled() && hostNetwork {
 								// if local gateway mode the intermediary node will attempt to fragment the packet, if the DF
 								// bit is not set. However, the decision on setting DF bit is left up to the kernel, and
-								// is unpredictable. If the DF bit is set, the iptables rule that DNATs nodeport -> cluster IP
+								// is unpredictable. If the DF bit is set, the nftables rule that DNATs nodeport -> cluster IP
 								// will then attempt to route the packet, and hit our 1400 byte MTU route. This will cause:
 								// 172.18.0.2:37755->10.96.141.254:9881(udp) sk_skb_reason_drop(SKB_DROP_REASON_PKT_TOO_BIG)
 								packetSizes = []string{"small"}
@@ -1438,10 +1438,10 @@ spec:
 					// send ingress traffic from external container to egressNode where the pod lives
 					// On secondary bridges CI lane we will also created eth1 interface on each node
 					// in the cluster. In that case:
-					// (1) SGW: npclient's eth1 -> node's eth1-> node's breth1 -> iptables -> DNAT to CIP ->
+					// (1) SGW: npclient's eth1 -> node's eth1-> node's breth1 -> nftables -> DNAT to CIP ->
 					//          route to breth0 -> send to OVN -> hit GR; ETP=local will not be respected
 					//          in this case and its broken at the moment. (FIXME)
-					// (2) LGW: npclient's eth1 -> node's eth1-> node's breth1 -> iptables -> DNAT to .3 masquerade ->
+					// (2) LGW: npclient's eth1 -> node's eth1-> node's breth1 -> nftables -> DNAT to .3 masquerade ->
 					//          route to mp0 -> send to OVN -> hit switch; ETP=local will be respected
 					//          in this case and its delivered to the pod. (test works for this case)
 					if !isLocalGWModeEnabled() || serviceSpec.Name != etpLocalServiceName {
@@ -2881,8 +2881,12 @@ metadata:
 		svcLoadBalancerIP, err := getServiceLoadBalancerIP(f.ClientSet, namespaceName, svcName)
 		framework.ExpectNoError(err, fmt.Sprintf("failed to get service lb ip: %s, err: %v", svcName, err))
 
-		numberOfETPRules := pokeNodeIPTableRules(backendNodeName, "OVN-KUBE-EXTERNALIP")
-		gomega.Expect(numberOfETPRules).To(gomega.Equal(5))
+		externalIPsMap := "service-external-ips-v4"
+		if utilnet.IsIPv6String(svcLoadBalancerIP) {
+			externalIPsMap = "service-external-ips-v6"
+		}
+		numberOfExternalIPElements := countNFTablesMapElements(backendNodeName, externalIPsMap)
+		gomega.Expect(numberOfExternalIPElements).To(gomega.Equal(2))
 
 		primaryProviderNetwork, err := infraprovider.Get().PrimaryNetwork()
 		framework.ExpectNoError(err, "must fetch primary provider network")
@@ -3023,14 +3027,18 @@ spec:
 		time.Sleep(time.Second * 5) // buffer to ensure all rules are created correctly
 
 		noSNATServicesSet := "mgmtport-no-snat-services-v4"
+		externalIPsMap := "service-external-ips-v4"
+		etpLBMap := "service-etp-lb-v4"
 		if utilnet.IsIPv6String(svcLoadBalancerIP) {
 			noSNATServicesSet = "mgmtport-no-snat-services-v6"
+			externalIPsMap = "service-external-ips-v6"
+			etpLBMap = "service-etp-lb-v6"
 		}
 
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 2, "OVN-KUBE-ETP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 5, "OVN-KUBE-EXTERNALIP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 0, etpLBMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 2, externalIPsMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, noSNATServicesSet))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, "mgmtport-no-snat-nodeports"))
@@ -3062,8 +3070,8 @@ spec:
 
 		time.Sleep(time.Second * 5) // buffer to ensure all rules are created correctly
 
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 10, "OVN-KUBE-ETP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 2, etpLBMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 8, noSNATServicesSet))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, "mgmtport-no-snat-nodeports"))
@@ -3074,17 +3082,11 @@ spec:
 		_, err = wgetInExternalContainer(externalContainer, svcLoadBalancerIP, endpointHTTPPort, "big.iso")
 		framework.ExpectNoError(err, "failed to curl load balancer service")
 
-		pktSize := 60
-		if utilnet.IsIPv6String(svcLoadBalancerIP) {
-			pktSize = 80
-		}
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 1, fmt.Sprintf("[1:%d] -A OVN-KUBE-ETP", pktSize)))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
-		// FIXME: This used to check that the no-snat rule had been hit, but nftables
-		// doesn't attach counters to rules unless you explicitly request them, which
-		// we don't... Is this check really needed?
+		// FIXME: This used to check that the ETP DNAT rule and the no-snat rule had
+		// been hit, but nftables doesn't attach counters to rules unless you
+		// explicitly request them, which we don't... Is this check really needed?
 
-		ginkgo.By("Scale down endpoints of service: " + svcName + " to ensure iptable rules are also getting recreated correctly")
+		ginkgo.By("Scale down endpoints of service: " + svcName + " to ensure nftables rules are also getting recreated correctly")
 		e2ekubectl.RunKubectlOrDie("default", "scale", "deployment", backendName, "--replicas=3")
 		err = e2eendpointslice.WaitForEndpointCount(context.TODO(), f.ClientSet, namespaceName, svcName, 3)
 		framework.ExpectNoError(err, fmt.Sprintf("service: %s never had an endpoint, err: %v", svcName, err))
@@ -3092,8 +3094,8 @@ spec:
 
 		// number of rules/elements should have decreased by 2 (one for the TCP port,
 		// one for UDP)
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 8, "OVN-KUBE-ETP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 2, etpLBMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 6, noSNATServicesSet))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, "mgmtport-no-snat-nodeports"))
@@ -3104,11 +3106,9 @@ spec:
 		_, err = wgetInExternalContainer(externalContainer, svcLoadBalancerIP, endpointHTTPPort, "big.iso")
 		framework.ExpectNoError(err, "failed to curl load balancer service")
 
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 1, fmt.Sprintf("[1:%d] -A OVN-KUBE-ETP", pktSize)))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
-		// FIXME: This used to check that the no-snat rule had been hit, but nftables
-		// doesn't attach counters to rules unless you explicitly request them, which
-		// we don't... Is this check really needed?
+		// FIXME: This used to check that the ETP DNAT rule and the no-snat rule had
+		// been hit, but nftables doesn't attach counters to rules unless you
+		// explicitly request them, which we don't... Is this check really needed?
 	})
 
 	ginkgo.It("Should ensure load balancer service works with 0 node ports when named targetPorts are used and ETP=local", func() {
@@ -3120,7 +3120,7 @@ spec:
 
 		time.Sleep(time.Second * 5) // buffer to ensure all rules are created correctly
 
-		checkExactETPRules := func(noSNATServicesSet string) {
+		checkExactETPRules := func(noSNATServicesSet, etpLBMap string) {
 			svc, err := f.ClientSet.CoreV1().Services(namespaceName).Get(context.TODO(), svcName, metav1.GetOptions{})
 			framework.ExpectNoError(err)
 
@@ -3142,44 +3142,45 @@ spec:
 				uniqueAddresses = uniqueAddresses.Union(getServingAndReadyEndpointSliceAddresses(es))
 			}
 
-			mask := 32
-			if utilnet.IsIPv6String(lbIP) {
-				mask = 128
-			}
-
-			// Build regex patterns for iptables rules using lbIP and uniqueAddresses
+			// Build regex patterns for the rules of the per load balancer ETP chains
+			// using uniqueAddresses
 			var patterns []string
 			var sets [][]string
 			for address := range uniqueAddresses {
-				tcpPattern := fmt.Sprintf(".*-A OVN-KUBE-ETP -d %s/%d -p tcp -m tcp --dport 80 -m statistic "+
-					"--mode random --probability .* -j DNAT --to-destination %s$",
-					regexp.QuoteMeta(lbIP), mask, regexp.QuoteMeta(net.JoinHostPort(address, "80")))
+				tcpPattern := fmt.Sprintf("meta l4proto tcp dnat ip6? to .*[{,] [0-9]+ : %s \\. 80[,} ]",
+					regexp.QuoteMeta(address))
 				patterns = append(patterns, tcpPattern)
-				udpPattern := fmt.Sprintf(".*-A OVN-KUBE-ETP -d %s/%d -p udp -m udp --dport 10001 -m statistic "+
-					"--mode random --probability .* -j DNAT --to-destination %s$",
-					regexp.QuoteMeta(lbIP), mask, regexp.QuoteMeta(net.JoinHostPort(address, "10001")))
+				udpPattern := fmt.Sprintf("meta l4proto udp dnat ip6? to .*[{,] [0-9]+ : %s \\. 10001[,} ]",
+					regexp.QuoteMeta(address))
 				patterns = append(patterns, udpPattern)
 
 				sets = append(sets, []string{address, "tcp", "80"})
 				sets = append(sets, []string{address, "udp", "10001"})
 			}
-			err = wait.PollImmediate(retryInterval, retryTimeout, checkIPTablesRulesPresent(backendNodeName, patterns))
-			framework.ExpectNoError(err, "Couldn't fetch the correct iptables rules, expected to find: %v, err: %v", patterns, err)
+			keys := [][]string{{lbIP, "tcp", "80"}, {lbIP, "udp", "10001"}}
+			err = wait.PollImmediate(retryInterval, retryTimeout, checkNFTMapElementsPresent(backendNodeName, etpLBMap, keys))
+			framework.ExpectNoError(err, "Couldn't fetch the correct nft elements, expected to find: %v, err: %v", keys, err)
+			err = wait.PollImmediate(retryInterval, retryTimeout, checkNFTRulesPresent(backendNodeName, patterns))
+			framework.ExpectNoError(err, "Couldn't fetch the correct nftables rules, expected to find: %v, err: %v", patterns, err)
 			err = wait.PollImmediate(retryInterval, retryTimeout, checkNFTElementsPresent(backendNodeName, noSNATServicesSet, sets))
 			framework.ExpectNoError(err, "Couldn't fetch the correct nft elements, expected to find: %v, err: %v", sets, err)
 		}
 
 		noSNATServicesSet := "mgmtport-no-snat-services-v4"
+		externalIPsMap := "service-external-ips-v4"
+		etpLBMap := "service-etp-lb-v4"
 		if utilnet.IsIPv6String(svcLoadBalancerIP) {
 			noSNATServicesSet = "mgmtport-no-snat-services-v6"
+			externalIPsMap = "service-external-ips-v6"
+			etpLBMap = "service-etp-lb-v6"
 		}
 
 		// Initial sanity check.
 		ginkgo.By("checking number of firewall rules for baseline")
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 2, "OVN-KUBE-ETP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 5, "OVN-KUBE-EXTERNALIP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 0, etpLBMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 2, externalIPsMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, noSNATServicesSet))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, "mgmtport-no-snat-nodeports"))
@@ -3201,10 +3202,10 @@ spec:
 		gomega.Expect(output).To(gomega.Equal("'udp'"))
 		time.Sleep(time.Second * 5) // buffer to ensure all rules are created correctly
 		ginkgo.By("checking number of firewall rules for named ports")
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 2, "OVN-KUBE-ETP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 5, "OVN-KUBE-EXTERNALIP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 0, etpLBMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 2, externalIPsMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, noSNATServicesSet))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, "mgmtport-no-snat-nodeports"))
@@ -3231,31 +3232,25 @@ spec:
 		time.Sleep(time.Second * 5) // buffer to ensure all rules are created correctly
 
 		ginkgo.By("checking number of firewall rules for allocateLoadBalancerNodePorts=false and externalTrafficPolicy=local")
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 10, "OVN-KUBE-ETP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 2, etpLBMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 8, noSNATServicesSet))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, "mgmtport-no-snat-nodeports"))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 
 		ginkgo.By("checking exact ETP firewall rules for allocateLoadBalancerNodePorts=false and externalTrafficPolicy=local")
-		checkExactETPRules(noSNATServicesSet)
+		checkExactETPRules(noSNATServicesSet, etpLBMap)
 
 		ginkgo.By("by sending a TCP packet to service " + svcName + " with type=LoadBalancer in namespace " + namespaceName + " with backend pod " + backendName)
 
 		tryWgetLoadBalancer(externalContainer, svcLoadBalancerIP)
 
-		pktSize := 60
-		if utilnet.IsIPv6String(svcLoadBalancerIP) {
-			pktSize = 80
-		}
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 1, fmt.Sprintf("[1:%d] -A OVN-KUBE-ETP", pktSize)))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
-		// FIXME: This used to check that the no-snat rule had been hit, but nftables
-		// doesn't attach counters to rules unless you explicitly request them, which
-		// we don't... Is this check really needed?
+		// FIXME: This used to check that the ETP DNAT rule and the no-snat rule had
+		// been hit, but nftables doesn't attach counters to rules unless you
+		// explicitly request them, which we don't... Is this check really needed?
 
-		ginkgo.By("Scale down endpoints of service: " + svcName + " to ensure iptable rules are also getting recreated correctly")
+		ginkgo.By("Scale down endpoints of service: " + svcName + " to ensure nftables rules are also getting recreated correctly")
 		e2ekubectl.RunKubectlOrDie("default", "scale", "deployment", backendName, "--replicas=3")
 		err = WaitForServingAndReadyServiceEndpointsNum(context.TODO(), f.ClientSet, namespaceName, svcName, 3, time.Second, time.Second*180)
 		framework.ExpectNoError(err, fmt.Sprintf("service: %s never had an endpoint, err: %v", svcName, err))
@@ -3264,33 +3259,31 @@ spec:
 		// number of rules/elements should have decreased by 2 (one for the TCP port,
 		// one for UDP)
 		ginkgo.By("checking number of firewall rules after scale down")
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 8, "OVN-KUBE-ETP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 2, etpLBMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 6, noSNATServicesSet))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, "mgmtport-no-snat-nodeports"))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		ginkgo.By("checking exact ETP firewall rules for allocateLoadBalancerNodePorts=false and externalTrafficPolicy=local after scale down")
-		checkExactETPRules(noSNATServicesSet)
+		checkExactETPRules(noSNATServicesSet, etpLBMap)
 
 		ginkgo.By("by sending a TCP packet to service " + svcName + " with type=LoadBalancer in namespace " + namespaceName + " with backend pod " + backendName)
 
 		tryWgetLoadBalancer(externalContainer, svcLoadBalancerIP)
 
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 1, fmt.Sprintf("[1:%d] -A OVN-KUBE-ETP", pktSize)))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
-		// FIXME: This used to check that the no-snat rule had been hit, but nftables
-		// doesn't attach counters to rules unless you explicitly request them, which
-		// we don't... Is this check really needed?
+		// FIXME: This used to check that the ETP DNAT rule and the no-snat rule had
+		// been hit, but nftables doesn't attach counters to rules unless you
+		// explicitly request them, which we don't... Is this check really needed?
 
 		// Also test proper deletion logic.
 		ginkgo.By("deleting the service")
 		e2ekubectl.RunKubectlOrDie("default", "delete", "service", svcName)
 		ginkgo.By("checking number of firewall rules after service delete")
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 2, "OVN-KUBE-ETP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
-		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfETPRules(backendNodeName, 3, "OVN-KUBE-EXTERNALIP"))
-		framework.ExpectNoError(err, "Couldn't fetch the correct number of iptable rules, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 0, etpLBMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
+		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTMapElements(backendNodeName, 0, externalIPsMap))
+		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, noSNATServicesSet))
 		framework.ExpectNoError(err, "Couldn't fetch the correct number of nftables elements, err: %v", err)
 		err = wait.PollImmediate(retryInterval, retryTimeout, checkNumberOfNFTElements(backendNodeName, 0, "mgmtport-no-snat-nodeports"))
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cudn
+
+import "github.com/ovn-kubernetes/ovn-kubernetes/test/e2e/testscenario"
+
+var Layer2InvalidSubnets = []testscenario.ValidateCRScenario{
+	{
+		Description: "modifying Secondary network's subnets is not allowed",
+		ExpectedErr: `Subnets is immutable for Secondary role`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-secondary-modify-subnet
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Secondary
+      subnets:
+      - 10.1.0.0/24
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-secondary-modify-subnet
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Secondary
+      subnets:
+      - 10.1.0.0/24
+      - 10.2.0.0/24
+`,
+	},
+	{
+		Description: "Secondary network with 2 same-family subnets is not allowed",
+		ExpectedErr: `When 2 CIDRs are set for Secondary networks, they must be from different IP families`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-secondary-same-family-subnets
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Secondary
+      subnets:
+      - 10.1.0.0/24
+      - 10.2.0.0/24
+`,
+	},
+	{
+		Description: "IPv4: remove subnet is not allowed",
+		ExpectedErr: `Removing existing subnets is not allowed`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-remove-subnet-ipv4
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+      - 10.2.0.0/24
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-remove-subnet-ipv4
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+`,
+	},
+	{
+		Description: "IPv4: overlap subnets are not allowed",
+		ExpectedErr: `Subnets must not overlap or contain each other`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-overlap-subnets-ipv4
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/16
+      - 10.1.1.0/24
+`,
+	},
+	{
+		Description: "IPv4: same subnets are not allowed",
+		ExpectedErr: `Subnets with same CIDR are not allowed`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-same-subnets-ipv4
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+      - 10.1.0.0/24
+`,
+	},
+	{
+		Description: "adding a subnet of a new IP family is not allowed",
+		ExpectedErr: `Subnets can only be added for existing IP families`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-add-ip-family
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-add-ip-family
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+      - 2001:db8:1::/64
+`,
+	},
+	{
+		Description: "IPv6: remove subnet is not allowed",
+		ExpectedErr: `Removing existing subnets is not allowed`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-remove-subnet-ipv6
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 2001:db8:1::/64
+      - 2001:db8:2::/64
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-remove-subnet-ipv6
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 2001:db8:2::/64
+`,
+	},
+	{
+		Description: "modifying mtu is not allowed",
+		ExpectedErr: `mtu is immutable`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-modify-mtu
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      mtu: 1300
+      subnets:
+      - 10.1.0.0/24
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-modify-mtu
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      mtu: 1400
+      subnets:
+      - 10.1.0.0/24
+      - 10.2.0.0/24
+`,
+	},
+}
@@ -107,6 +107,132 @@ spec:
       vlan:
         mode: Access
         access: {id: 4095} 
+`,
+	},
+	{
+		Description: "invalid VLAN - mode is 'Trunk' but vlan trunk config is unset",
+		ExpectedErr: `vlan trunk config is required when vlan mode is 'Trunk', and forbidden otherwise`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: localnet-vlan-no-trunk-config-fail
+spec:
+  namespaceSelector: {matchLabels: { kubernetes.io/metadata.name: red}}
+  network:
+    topology: Localnet
+    localnet:
+      role: Secondary
+      physicalNetworkName: test
+      subnets: [192.168.0.0/16]
+      vlan:
+        mode: Trunk
+`,
+	},
+	{
+		Description: "invalid VLAN - mode is 'Access' but vlan trunk config is set",
+		ExpectedErr: `vlan trunk config is required when vlan mode is 'Trunk', and forbidden otherwise`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: localnet-vlan-access-with-trunk-config-fail
+spec:
+  namespaceSelector: {matchLabels: { kubernetes.io/metadata.name: red}}
+  network:
+    topology: Localnet
+    localnet:
+      role: Secondary
+      physicalNetworkName: test
+      subnets: [192.168.0.0/16]
+      vlan:
+        mode: Access
+        access: {id: 10}
+        trunk: {allowedVLANs: [20]}
+`,
+	},
+	{
+		Description: "invalid VLAN - vlan trunk allowed VLANs are empty",
+		ExpectedErr: `spec.network.localnet.vlan.trunk.allowedVLANs in body should have at least 1 items`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: localnet-vlan-trunk-empty-fail
+spec:
+  namespaceSelector: {matchLabels: { kubernetes.io/metadata.name: red}}
+  network:
+    topology: Localnet
+    localnet:
+      role: Secondary
+      physicalNetworkName: test
+      subnets: [192.168.0.0/16]
+      vlan:
+        mode: Trunk
+        trunk: {allowedVLANs: []}
+`,
+	},
+	{
+		Description: "invalid VLAN - vlan trunk allowed VLAN is 4095",
+		ExpectedErr: `spec.network.localnet.vlan.trunk.allowedVLANs[1] in body should be less than or equal to 4094`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: localnet-vlan-trunk-id-higher-then-4094-fail
+spec:
+  namespaceSelector: {matchLabels: { kubernetes.io/metadata.name: red}}
+  network:
+    topology: Localnet
+    localnet:
+      role: Secondary
+      physicalNetworkName: test
+      subnets: [192.168.0.0/16]
+      vlan:
+        mode: Trunk
+        trunk: {allowedVLANs: [10, 4095]}
+`,
+	},
+	{
+		Description: "invalid VLAN - vlan trunk allowed VLANs are duplicated",
+		ExpectedErr: `spec.network.localnet.vlan.trunk.allowedVLANs[1]: Duplicate value: 10`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: localnet-vlan-trunk-duplicate-fail
+spec:
+  namespaceSelector: {matchLabels: { kubernetes.io/metadata.name: red}}
+  network:
+    topology: Localnet
+    localnet:
+      role: Secondary
+      physicalNetworkName: test
+      subnets: [192.168.0.0/16]
+      vlan:
+        mode: Trunk
+        trunk: {allowedVLANs: [10, 10]}
+`,
+	},
+	{
+		Description: "invalid VLAN - vlan trunk native VLAN is one of the allowed VLANs",
+		ExpectedErr: `nativeVLAN must not be one of the allowedVLANs`,
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: localnet-vlan-trunk-native-allowed-fail
+spec:
+  namespaceSelector: {matchLabels: { kubernetes.io/metadata.name: red}}
+  network:
+    topology: Localnet
+    localnet:
+      role: Secondary
+      physicalNetworkName: test
+      subnets: [192.168.0.0/16]
+      vlan:
+        mode: Trunk
+        trunk: {allowedVLANs: [10, 20], nativeVLAN: 20}
 `,
 	},
 }
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cudn
+
+import "github.com/ovn-kubernetes/ovn-kubernetes/test/e2e/testscenario"
+
+var Layer2ValidSubnets = []testscenario.ValidateCRScenario{
+	{
+		Description: "IPv4: valid Primary network with multiple subnets - add subnet",
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-add-subnet-ipv4
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-add-subnet-ipv4
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+      - 10.2.0.0/24
+`,
+	},
+	{
+		Description: "IPv6: valid Primary network with multiple subnets - add subnet",
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-add-subnet-ipv6
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 2001:db8:1::/64
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-add-subnet-ipv6
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 2001:db8:1::/64
+      - 2001:db8:2::/64
+`,
+	},
+	{
+		Description: "Dual-stack: valid Primary network with multiple subnets - add subnet",
+		Manifest: `
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-add-subnet-dualstack
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+      - 2001:db8:1::/64
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: l2-primary-add-subnet-dualstack
+spec:
+  namespaceSelector: {matchLabels: {kubernetes.io/metadata.name: red}}
+  network:
+    topology: Layer2
+    layer2:
+      role: Primary
+      subnets:
+      - 10.1.0.0/24
+      - 2001:db8:1::/64
+      - 10.2.0.0/24
+`,
+	},
+}
@@ -81,6 +81,32 @@ spec:
         mode: Access
         access: {id: 4094}
       mtu: 9000
+`,
+	},
+	{
+		Description: "should create localnet topology successfully - trunk vlan",
+		Name:        "localnet-trunk-vlan-success",
+		Manifest: `
+---
+apiVersion: k8s.ovn.org/v1
+kind: ClusterUserDefinedNetwork
+metadata:
+  name: localnet-trunk-vlan-success
+spec:
+  namespaceSelector:
+    matchExpressions:
+      - key: kubernetes.io/metadata.name
+        operator: In
+        values: ["red", "blue"]
+  network:
+    topology: Localnet
+    localnet:
+      role: Secondary
+      physicalNetworkName: test
+      ipam: {mode: Disabled}
+      vlan:
+        mode: Trunk
+        trunk: {allowedVLANs: [10, 20, 4094], nativeVLAN: 1}
 `,
 	},
 }
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: Copyright The OVN-Kubernetes Contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package networkqos
+
+import "github.com/ovn-kubernetes/ovn-kubernetes/test/e2e/testscenario"
+
+var InvalidScenarios = []testscenario.ValidateCRScenario{
+	{
+		Description: "no egress or ingress rules",
+		ExpectedErr: "at least one egress or ingress rule is required",
+		Manifest: `
+apiVersion: k8s.ovn.org/v1alpha1
+kind: NetworkQoS
+metadata:
+  name: no-rules
+  namespace: default
+spec:
+  priority: 50
+`,
+	},
+	{
+		Description: "empty egress and ingress rules",
+		ExpectedErr: "at least one egress or ingress rule is required",
+		Manifest: `
+apiVersion: k8s.ovn.org/v1alpha1
+kind: NetworkQoS
+metadata:
+  name: empty-rules
+  namespace: default
+spec:
+  priority: 50
+  egress: []
+  ingress: []
+`,
+	},
+}
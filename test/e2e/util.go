@@ -963,36 +963,21 @@ func patchService(c kubernetes.Interface, serviceName, serviceNamespace, jsonPat
 	return nil
 }
 
-func getNodeIPTRules(nodeName string) string {
-	ipt4Rules, err := infraprovider.Get().ExecK8NodeCommand(nodeName, []string{"iptables-save", "-c"})
-	framework.ExpectNoError(err, "failed to get iptables rules from node %s", nodeName)
-	ipt6Rules, err := infraprovider.Get().ExecK8NodeCommand(nodeName, []string{"ip6tables-save", "-c"})
-	framework.ExpectNoError(err, "failed to get ip6tables rules from node %s", nodeName)
-	iptRules := ipt4Rules + ipt6Rules
-	framework.Logf("DEBUG: Dumping IPTRules %v", iptRules)
-	return iptRules
+func getNodeNFTRules(nodeName string) string {
+	nftRules, err := infraprovider.Get().ExecK8NodeCommand(nodeName, []string{"nft", "list", "table", "inet", "ovn-kubernetes"})
+	framework.ExpectNoError(err, "failed to get nftables rules from node %s", nodeName)
+	framework.Logf("DEBUG: Dumping NFTRules %v", nftRules)
+	return nftRules
 }
 
-// pokeNodeIPTableRules returns the number of iptables (both ipv6 and ipv4) rules that match the provided pattern
-func pokeNodeIPTableRules(nodeName, pattern string) int {
-	iptRules := getNodeIPTRules(nodeName)
-	numOfMatchRules := 0
-	for _, iptRule := range strings.Split(iptRules, "\n") {
-		match := strings.Contains(iptRule, pattern)
-		if match {
-			framework.Logf("DEBUG: Matched rule %s for pattern %s", iptRule, pattern)
-			numOfMatchRules++
-		}
-	}
-	return numOfMatchRules
-}
-
-func countIPTablesRulesMatches(nodeName string, patterns []string) int {
+// countNFTablesRulesMatches returns the number of rules of the "ovn-kubernetes" table
+// that match any of the provided patterns
+func countNFTablesRulesMatches(nodeName string, patterns []string) int {
 	numMatches := 0
-	iptRules := getNodeIPTRules(nodeName)
+	nftRules := getNodeNFTRules(nodeName)
 	for _, pattern := range patterns {
-		for _, iptRule := range strings.Split(iptRules, "\n") {
-			matched, err := regexp.MatchString(pattern, iptRule)
+		for _, nftRule := range strings.Split(nftRules, "\n") {
+			matched, err := regexp.MatchString(pattern, nftRule)
 			if err == nil && matched {
 				numMatches++
 			}
@@ -1007,6 +992,11 @@ func (e *Elem) UnmarshalJSON(data []byte) error {
 	var str string
 	var i int
 	var concatenation map[string][]json.RawMessage
+	var mapping []json.RawMessage
+	// map elements are [key, value] pairs; only the key is kept
+	if err := json.Unmarshal(data, &mapping); err == nil && len(mapping) == 2 {
+		return e.UnmarshalJSON(mapping[0])
+	}
 	if err := json.Unmarshal(data, &str); err == nil {
 		*e = []string{str}
 		return nil
@@ -1032,10 +1022,12 @@ func (e *Elem) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("could not unmarshal %s", string(data))
 }
 
-func getNFTablesElements(nodeName, name string) ([]Elem, error) {
+// getNFTablesElements returns the elements of the indicated set or map (depending
+// on kind) of the "ovn-kubernetes" table. For maps, only the keys are returned.
+func getNFTablesElements(nodeName, kind, name string) ([]Elem, error) {
 	array := []Elem{}
 
-	nftCmd := []string{"nft", "-j", "list", "set", "inet", "ovn-kubernetes", name}
+	nftCmd := []string{"nft", "-j", "list", kind, "inet", "ovn-kubernetes", name}
 	nftElements, err := infraprovider.Get().ExecK8NodeCommand(nodeName, nftCmd)
 	if err != nil {
 		return array, err
@@ -1051,7 +1043,7 @@ func getNFTablesElements(nodeName, name string) ([]Elem, error) {
 	//       }
 	//     },
 	//     {
-	//       "set": {
+	//       "set": {     (or "map")
 	//         ...
 	//         "elem": [
 	//           ...
@@ -1067,7 +1059,7 @@ func getNFTablesElements(nodeName, name string) ([]Elem, error) {
 	if err := json.Unmarshal([]byte(nftElements), &jsonResult); err != nil {
 		return array, err
 	}
-	elem := jsonResult["nftables"][1]["set"]["elem"]
+	elem := jsonResult["nftables"][1][kind]["elem"]
 	if elem == nil {
 		return array, err
 	}
@@ -1076,17 +1068,17 @@ func getNFTablesElements(nodeName, name string) ([]Elem, error) {
 }
 
 // countNFTablesElements returns the number of nftables elements in the indicated set
-// of the "ovn-kubernetes" table.
-func countNFTablesElements(nodeName, name string) int {
+// or map (depending on kind) of the "ovn-kubernetes" table.
+func countNFTablesElements(nodeName, kind, name string) int {
 	defer ginkgo.GinkgoRecover()
-	array, err := getNFTablesElements(nodeName, name)
+	array, err := getNFTablesElements(nodeName, kind, name)
 	framework.ExpectNoError(err, "failed to get nftables elements from node %s", nodeName)
 	return len(array)
 }
 
-func countNFTablesRulesMatches(nodeName, name string, sets [][]string) int {
+func countNFTablesElementsMatches(nodeName, kind, name string, sets [][]string) int {
 	numMatches := 0
-	array, err := getNFTablesElements(nodeName, name)
+	array, err := getNFTablesElements(nodeName, kind, name)
 	framework.ExpectNoError(err, "failed to get nftables elements from node %s", nodeName)
 	for _, set := range sets {
 		for _, elem := range array {
@@ -1098,19 +1090,17 @@ func countNFTablesRulesMatches(nodeName, name string, sets [][]string) int {
 	return numMatches
 }
 
-func checkNumberOfETPRules(backendNodeName string, value int, pattern string) wait.ConditionFunc {
-	return func() (bool, error) {
-		numberOfETPRules := pokeNodeIPTableRules(backendNodeName, pattern)
-		isExpected := numberOfETPRules == value
-		if !isExpected {
-			framework.Logf("numberOfETPRules got: %d, expected: %d", numberOfETPRules, value)
-		}
-		return isExpected, nil
-	}
-}
 func checkNumberOfNFTElements(backendNodeName string, value int, name string) wait.ConditionFunc {
+	return checkNumberOfNFTElementsOfKind(backendNodeName, value, "set", name)
+}
+
+func checkNumberOfNFTMapElements(backendNodeName string, value int, name string) wait.ConditionFunc {
+	return checkNumberOfNFTElementsOfKind(backendNodeName, value, "map", name)
+}
+
+func checkNumberOfNFTElementsOfKind(backendNodeName string, value int, kind, name string) wait.ConditionFunc {
 	return func() (bool, error) {
-		numberOfNFTElements := countNFTablesElements(backendNodeName, name)
+		numberOfNFTElements := countNFTablesElements(backendNodeName, kind, name)
 		isExpected := numberOfNFTElements == value
 		if !isExpected {
 			framework.Logf("numberOfNFTElements got: %d, expected: %d", numberOfNFTElements, value)
@@ -1119,20 +1109,29 @@ func checkNumberOfNFTElements(backendNodeName string, value int, name string) wa
 	}
 }
 
-func checkIPTablesRulesPresent(backendNodeName string, patterns []string) wait.ConditionFunc {
+func checkNFTRulesPresent(backendNodeName string, patterns []string) wait.ConditionFunc {
 	return func() (bool, error) {
-		numMatches := countIPTablesRulesMatches(backendNodeName, patterns)
+		numMatches := countNFTablesRulesMatches(backendNodeName, patterns)
 		isExpected := numMatches == len(patterns)
 		if !isExpected {
-			framework.Logf("checkIPTablesRulesPresent got: numMatches: %d, expected: %d",
+			framework.Logf("checkNFTRulesPresent got: numMatches: %d, expected: %d",
 				numMatches, len(patterns))
 		}
 		return isExpected, nil
 	}
 }
+
 func checkNFTElementsPresent(backendNodeName, name string, sets [][]string) wait.ConditionFunc {
+	return checkNFTElementsOfKindPresent(backendNodeName, "set", name, sets)
+}
+
+func checkNFTMapElementsPresent(backendNodeName, name string, keys [][]string) wait.ConditionFunc {
+	return checkNFTElementsOfKindPresent(backendNodeName, "map", name, keys)
+}
+
+func checkNFTElementsOfKindPresent(backendNodeName, kind, name string, sets [][]string) wait.ConditionFunc {
 	return func() (bool, error) {
-		numMatches := countNFTablesRulesMatches(backendNodeName, name, sets)
+		numMatches := countNFTablesElementsMatches(backendNodeName, kind, name, sets)
 		isExpected := numMatches == len(sets)
 		if !isExpected {
 			framework.Logf("checkNFTElementsPresent got: numMatches: %d, expected: %d",